@@ -0,0 +1,551 @@
+package chanbackup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// DefaultArchiveDirName is the default name of the directory, next to
+	// the main backup file, that previous generations of the multi-chan
+	// backup are archived in.
+	DefaultArchiveDirName = "channel-backup-archive"
+
+	// DefaultArchiveSize is the default number of backup generations that
+	// are kept in the archive.
+	DefaultArchiveSize = 100
+
+	// archiveFileExt is the extension of the files that hold a single
+	// archived backup generation.
+	archiveFileExt = ".scba"
+
+	// archiveVersion is the current version of the archive file format.
+	archiveVersion = 0
+
+	// outPointSize is the size of a serialized outpoint in an archive file.
+	outPointSize = chainhash.HashSize + 4
+)
+
+var (
+	// archiveMagic is the magic prefix of each archive file.
+	archiveMagic = [4]byte{'S', 'C', 'B', 'A'}
+
+	// ErrArchiveCorrupt is returned if an archived backup generation fails
+	// its integrity check.
+	ErrArchiveCorrupt = errors.New("archived backup is corrupt")
+
+	// ErrNoArchivedBackup is returned if the archive holds no backup
+	// generation for the requested time.
+	ErrNoArchivedBackup = errors.New("no archived backup found")
+)
+
+// ArchivedBackup is a previous generation of the packed multi-chan backup
+// that is kept in the archive.
+type ArchivedBackup struct {
+	// Generation is the sequence number of the generation. Newer
+	// generations have higher numbers.
+	Generation uint64
+
+	// Timestamp is the time the generation was archived.
+	Timestamp time.Time
+
+	// Channels is the set of channels that are contained in the backup.
+	Channels []wire.OutPoint
+
+	// Added is the set of channels that were added since the previous
+	// generation.
+	Added []wire.OutPoint
+
+	// Removed is the set of channels that were removed since the previous
+	// generation.
+	Removed []wire.OutPoint
+
+	// Backup is the encrypted multi-chan backup itself.
+	Backup PackedMulti
+}
+
+// Archive keeps a rotating history of the multi-chan backups written by the
+// SubSwapper. Each generation is stored in its own file along with its
+// timestamp, the channel set it contains and a checksum, so a corrupt or
+// truncated write only ever affects a single generation. A new generation is
+// only archived if the channel set changed since the previous one.
+//
+// NOTE: The archive stores the funding outpoints of the channels in plain
+// text, so unlike the backup file itself it shouldn't be shared with third
+// parties.
+type Archive struct {
+	// dir is the directory the generations are stored in.
+	dir string
+
+	// size is the maximum number of generations that are kept.
+	size uint32
+
+	// now returns the current time, and is used to timestamp new
+	// generations.
+	now func() time.Time
+
+	mu sync.Mutex
+
+	// loaded is true once the state below was initialized from disk.
+	loaded bool
+
+	// latest is the newest intact generation, or nil if there is none.
+	latest *ArchivedBackup
+
+	// nextGeneration is the number of the next generation.
+	nextGeneration uint64
+}
+
+// NewArchive creates a new archive in the given directory that keeps up to
+// size generations. The directory is created once the first generation is
+// added.
+func NewArchive(dir string, size uint32) *Archive {
+	return &Archive{
+		dir:  dir,
+		size: size,
+		now:  time.Now,
+	}
+}
+
+// Add archives a new generation of the multi-chan backup that contains the
+// given channels. If the channel set is the same as in the latest generation,
+// the backup isn't archived, and nil is returned.
+func (a *Archive) Add(backup PackedMulti,
+	chans []wire.OutPoint) (*ArchivedBackup, error) {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(); err != nil {
+		return nil, err
+	}
+
+	var prevChans []wire.OutPoint
+	if a.latest != nil {
+		prevChans = a.latest.Channels
+	}
+
+	added, removed := diffOutPoints(prevChans, chans)
+	if a.latest != nil && len(added) == 0 && len(removed) == 0 {
+		log.Debugf("Channel set unchanged since backup generation %v, "+
+			"not archiving backup", a.latest.Generation)
+
+		return nil, nil
+	}
+
+	channels := make([]wire.OutPoint, len(chans))
+	copy(channels, chans)
+	sortOutPoints(channels)
+
+	archived := &ArchivedBackup{
+		Generation: a.nextGeneration,
+		Timestamp:  a.now(),
+		Channels:   channels,
+		Added:      added,
+		Removed:    removed,
+		Backup:     backup,
+	}
+
+	var b bytes.Buffer
+	if err := archived.encode(&b); err != nil {
+		return nil, err
+	}
+
+	err := writeFileSync(a.generationFile(archived.Generation), b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to write archived backup: %w",
+			err)
+	}
+
+	log.Infof("Archived backup generation %v: num_chans=%v, added=%v, "+
+		"removed=%v", archived.Generation, len(channels), len(added),
+		len(removed))
+
+	a.latest = archived
+	a.nextGeneration++
+
+	return archived, a.prune()
+}
+
+// Generations returns all intact generations in the archive, ordered from
+// oldest to newest. Generations that fail their integrity check are skipped.
+func (a *Archive) Generations() ([]*ArchivedBackup, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.readGenerations()
+}
+
+// At returns the newest intact generation that was archived at or before the
+// given time.
+func (a *Archive) At(t time.Time) (*ArchivedBackup, error) {
+	generations, err := a.Generations()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(generations) - 1; i >= 0; i-- {
+		if !generations[i].Timestamp.After(t) {
+			return generations[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w at or before %v", ErrNoArchivedBackup,
+		t.Format(time.RFC3339))
+}
+
+// load initializes the archive state from the generations on disk, if this
+// wasn't done yet.
+//
+// NOTE: This method must be called with the mutex held.
+func (a *Archive) load() error {
+	if a.loaded {
+		return nil
+	}
+
+	if err := os.MkdirAll(a.dir, 0700); err != nil {
+		return fmt.Errorf("unable to create archive dir: %w", err)
+	}
+
+	numbers, err := a.generationNumbers()
+	if err != nil {
+		return err
+	}
+	if len(numbers) > 0 {
+		a.nextGeneration = numbers[len(numbers)-1] + 1
+	}
+
+	generations, err := a.readGenerations()
+	if err != nil {
+		return err
+	}
+	if len(generations) > 0 {
+		a.latest = generations[len(generations)-1]
+	}
+
+	a.loaded = true
+
+	return nil
+}
+
+// prune removes the oldest generations that exceed the size of the archive.
+//
+// NOTE: This method must be called with the mutex held.
+func (a *Archive) prune() error {
+	numbers, err := a.generationNumbers()
+	if err != nil {
+		return err
+	}
+
+	for len(numbers) > int(a.size) {
+		fileName := a.generationFile(numbers[0])
+		if err := os.Remove(fileName); err != nil {
+			return fmt.Errorf("unable to remove archived backup: "+
+				"%w", err)
+		}
+
+		log.Debugf("Removed archived backup generation %v", numbers[0])
+
+		numbers = numbers[1:]
+	}
+
+	return nil
+}
+
+// readGenerations reads all intact generations from disk, ordered from oldest
+// to newest.
+//
+// NOTE: This method must be called with the mutex held.
+func (a *Archive) readGenerations() ([]*ArchivedBackup, error) {
+	numbers, err := a.generationNumbers()
+	if err != nil {
+		return nil, err
+	}
+
+	generations := make([]*ArchivedBackup, 0, len(numbers))
+	for _, number := range numbers {
+		fileName := a.generationFile(number)
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		archived, err := decodeArchivedBackup(content)
+		if err == nil && archived.Generation != number {
+			err = fmt.Errorf("%w: generation mismatch",
+				ErrArchiveCorrupt)
+		}
+		if err != nil {
+			log.Warnf("Skipping archived backup %v: %v", fileName,
+				err)
+
+			continue
+		}
+
+		generations = append(generations, archived)
+	}
+
+	return generations, nil
+}
+
+// generationNumbers returns the sorted numbers of all generations that have
+// a file in the archive directory.
+func (a *Archive) generationNumbers() ([]uint64, error) {
+	entries, err := os.ReadDir(a.dir)
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("unable to read archive dir: %w", err)
+	}
+
+	var numbers []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, archiveFileExt) {
+			continue
+		}
+
+		number, err := strconv.ParseUint(
+			strings.TrimSuffix(name, archiveFileExt), 10, 64,
+		)
+		if err != nil {
+			continue
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] < numbers[j]
+	})
+
+	return numbers, nil
+}
+
+// generationFile returns the name of the file of the given generation.
+func (a *Archive) generationFile(generation uint64) string {
+	return filepath.Join(
+		a.dir, fmt.Sprintf("%020d%s", generation, archiveFileExt),
+	)
+}
+
+// encode writes the archived backup to the given writer, followed by the
+// SHA-256 checksum of the serialized generation.
+func (a *ArchivedBackup) encode(w io.Writer) error {
+	var b bytes.Buffer
+	b.Write(archiveMagic[:])
+	b.WriteByte(archiveVersion)
+
+	var scratch [8]byte
+	binary.BigEndian.PutUint64(scratch[:], a.Generation)
+	b.Write(scratch[:])
+	binary.BigEndian.PutUint64(scratch[:], uint64(a.Timestamp.UnixNano()))
+	b.Write(scratch[:])
+
+	for _, outPoints := range [][]wire.OutPoint{
+		a.Channels, a.Added, a.Removed,
+	} {
+		binary.BigEndian.PutUint32(scratch[:4], uint32(len(outPoints)))
+		b.Write(scratch[:4])
+
+		for _, outPoint := range outPoints {
+			b.Write(outPoint.Hash[:])
+			binary.BigEndian.PutUint32(scratch[:4], outPoint.Index)
+			b.Write(scratch[:4])
+		}
+	}
+
+	binary.BigEndian.PutUint32(scratch[:4], uint32(len(a.Backup)))
+	b.Write(scratch[:4])
+	b.Write(a.Backup)
+
+	checksum := sha256.Sum256(b.Bytes())
+	b.Write(checksum[:])
+
+	_, err := w.Write(b.Bytes())
+
+	return err
+}
+
+// decodeArchivedBackup decodes an archived backup, and verifies its checksum.
+func decodeArchivedBackup(content []byte) (*ArchivedBackup, error) {
+	if len(content) < sha256.Size {
+		return nil, fmt.Errorf("%w: file too short", ErrArchiveCorrupt)
+	}
+
+	body := content[:len(content)-sha256.Size]
+	checksum := sha256.Sum256(body)
+	if !bytes.Equal(checksum[:], content[len(body):]) {
+		return nil, fmt.Errorf("%w: checksum mismatch",
+			ErrArchiveCorrupt)
+	}
+
+	// The checksum protects against accidental corruption only, so we
+	// still make sure we never read beyond the end of the body.
+	r := bytes.NewReader(body)
+	readN := func(n int) ([]byte, error) {
+		if n > r.Len() {
+			return nil, fmt.Errorf("%w: unexpected end of file",
+				ErrArchiveCorrupt)
+		}
+
+		buf := make([]byte, n)
+		_, err := io.ReadFull(r, buf)
+
+		return buf, err
+	}
+	readUint32 := func() (uint32, error) {
+		buf, err := readN(4)
+		if err != nil {
+			return 0, err
+		}
+
+		return binary.BigEndian.Uint32(buf), nil
+	}
+
+	header, err := readN(len(archiveMagic) + 1 + 16)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(archiveMagic)], archiveMagic[:]) {
+		return nil, fmt.Errorf("%w: unknown file format",
+			ErrArchiveCorrupt)
+	}
+	if version := header[len(archiveMagic)]; version != archiveVersion {
+		return nil, fmt.Errorf("unknown archive version: %v", version)
+	}
+
+	header = header[len(archiveMagic)+1:]
+	archived := &ArchivedBackup{
+		Generation: binary.BigEndian.Uint64(header[:8]),
+		Timestamp: time.Unix(
+			0, int64(binary.BigEndian.Uint64(header[8:])),
+		),
+	}
+
+	for _, outPoints := range []*[]wire.OutPoint{
+		&archived.Channels, &archived.Added, &archived.Removed,
+	} {
+		numOutPoints, err := readUint32()
+		if err != nil {
+			return nil, err
+		}
+
+		buf, err := readN(int(numOutPoints) * outPointSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < int(numOutPoints); i++ {
+			entry := buf[i*outPointSize : (i+1)*outPointSize]
+
+			var outPoint wire.OutPoint
+			copy(outPoint.Hash[:], entry[:chainhash.HashSize])
+			outPoint.Index = binary.BigEndian.Uint32(
+				entry[chainhash.HashSize:],
+			)
+
+			*outPoints = append(*outPoints, outPoint)
+		}
+	}
+
+	backupLen, err := readUint32()
+	if err != nil {
+		return nil, err
+	}
+	backup, err := readN(int(backupLen))
+	if err != nil {
+		return nil, err
+	}
+	archived.Backup = backup
+
+	return archived, nil
+}
+
+// diffOutPoints returns the sorted outpoints that were added to and removed
+// from the old set to arrive at the new set.
+func diffOutPoints(oldSet, newSet []wire.OutPoint) ([]wire.OutPoint,
+	[]wire.OutPoint) {
+
+	oldIndex := make(map[wire.OutPoint]struct{}, len(oldSet))
+	for _, outPoint := range oldSet {
+		oldIndex[outPoint] = struct{}{}
+	}
+
+	newIndex := make(map[wire.OutPoint]struct{}, len(newSet))
+	for _, outPoint := range newSet {
+		newIndex[outPoint] = struct{}{}
+	}
+
+	var added, removed []wire.OutPoint
+	for outPoint := range newIndex {
+		if _, ok := oldIndex[outPoint]; !ok {
+			added = append(added, outPoint)
+		}
+	}
+	for outPoint := range oldIndex {
+		if _, ok := newIndex[outPoint]; !ok {
+			removed = append(removed, outPoint)
+		}
+	}
+
+	sortOutPoints(added)
+	sortOutPoints(removed)
+
+	return added, removed
+}
+
+// sortOutPoints sorts the given outpoints in place.
+func sortOutPoints(outPoints []wire.OutPoint) {
+	sort.Slice(outPoints, func(i, j int) bool {
+		cmp := bytes.Compare(outPoints[i].Hash[:], outPoints[j].Hash[:])
+		if cmp != 0 {
+			return cmp < 0
+		}
+
+		return outPoints[i].Index < outPoints[j].Index
+	})
+}
+
+// writeFileSync atomically replaces the given file with the content, and
+// makes sure the content is synced to disk before the file is renamed.
+func writeFileSync(fileName string, content []byte) error {
+	tempFileName := fileName + ".tmp"
+	tempFile, err := os.OpenFile(
+		tempFileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600,
+	)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFileName)
+
+	if _, err := tempFile.Write(content); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Sync(); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempFileName, fileName)
+}
@@ -0,0 +1,201 @@
+package chanbackup
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnencrypt"
+	"github.com/stretchr/testify/require"
+)
+
+// testOutPoint returns a test outpoint derived from the given number.
+func testOutPoint(i byte) wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  chainhash.Hash{i},
+		Index: uint32(i),
+	}
+}
+
+// newTestArchive creates an archive in a temporary directory that uses a
+// clock that advances by one minute with each new generation.
+func newTestArchive(t *testing.T, size uint32) (*Archive, time.Time) {
+	start := time.Unix(1_700_000_000, 0)
+	now := start
+
+	archive := NewArchive(filepath.Join(t.TempDir(), "archive"), size)
+	archive.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	return archive, start
+}
+
+// TestArchiveGenerations tests that the archive records the channel set diffs
+// of new generations, skips unchanged channel sets and rotates out the oldest
+// generations.
+func TestArchiveGenerations(t *testing.T) {
+	t.Parallel()
+
+	archive, start := newTestArchive(t, 3)
+	op1, op2, op3 := testOutPoint(1), testOutPoint(2), testOutPoint(3)
+
+	// The first generation contains all channels as added channels.
+	archived, err := archive.Add(
+		PackedMulti("gen0"), []wire.OutPoint{op2, op1},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, archived.Generation)
+	require.Equal(t, []wire.OutPoint{op1, op2}, archived.Added)
+	require.Empty(t, archived.Removed)
+
+	// An unchanged channel set isn't archived again.
+	archived, err = archive.Add(
+		PackedMulti("gen0-refresh"), []wire.OutPoint{op1, op2},
+	)
+	require.NoError(t, err)
+	require.Nil(t, archived)
+
+	archived, err = archive.Add(
+		PackedMulti("gen1"), []wire.OutPoint{op1, op3},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, archived.Generation)
+	require.Equal(t, []wire.OutPoint{op3}, archived.Added)
+	require.Equal(t, []wire.OutPoint{op2}, archived.Removed)
+
+	_, err = archive.Add(PackedMulti("gen2"), []wire.OutPoint{op3})
+	require.NoError(t, err)
+	_, err = archive.Add(PackedMulti("gen3"), nil)
+	require.NoError(t, err)
+
+	// Only the three newest generations are kept.
+	generations, err := archive.Generations()
+	require.NoError(t, err)
+	require.Len(t, generations, 3)
+	require.EqualValues(t, 1, generations[0].Generation)
+	require.Equal(t, PackedMulti("gen1"), generations[0].Backup)
+	require.Equal(t, []wire.OutPoint{op1, op3}, generations[0].Channels)
+	require.EqualValues(t, 3, generations[2].Generation)
+	require.Empty(t, generations[2].Channels)
+	require.Equal(t, []wire.OutPoint{op3}, generations[2].Removed)
+
+	// Generation 1 was archived after two minutes, so it's the newest
+	// generation at that time.
+	archived, err = archive.At(start.Add(2*time.Minute + time.Second))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, archived.Generation)
+
+	archived, err = archive.At(start.Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, archived.Generation)
+
+	// The first generation was rotated out already.
+	_, err = archive.At(start.Add(time.Minute))
+	require.ErrorIs(t, err, ErrNoArchivedBackup)
+}
+
+// TestArchiveCorruption tests that corrupt generations are skipped, and that
+// a new archive instance continues after the generations on disk.
+func TestArchiveCorruption(t *testing.T) {
+	t.Parallel()
+
+	archive, start := newTestArchive(t, 10)
+	op1, op2 := testOutPoint(1), testOutPoint(2)
+
+	_, err := archive.Add(PackedMulti("gen0"), []wire.OutPoint{op1})
+	require.NoError(t, err)
+	_, err = archive.Add(PackedMulti("gen1"), []wire.OutPoint{op1, op2})
+	require.NoError(t, err)
+
+	// Truncate the newest generation, like an interrupted write would.
+	fileName := archive.generationFile(1)
+	content, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(fileName, content[:20], 0600))
+
+	_, err = decodeArchivedBackup(content[:20])
+	require.ErrorIs(t, err, ErrArchiveCorrupt)
+
+	// Flip a bit in the payload of an intact copy as well.
+	content[len(content)-sha256.Size-1] ^= 1
+	_, err = decodeArchivedBackup(content)
+	require.ErrorIs(t, err, ErrArchiveCorrupt)
+
+	// The corrupt generation is skipped, so we restore the previous one.
+	archived, err := archive.At(start.Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, 0, archived.Generation)
+	require.Equal(t, PackedMulti("gen0"), archived.Backup)
+
+	// A new archive instance computes the diff against the newest intact
+	// generation, and doesn't reuse the number of the corrupt one.
+	reopened := NewArchive(archive.dir, 10)
+	archived, err = reopened.Add(
+		PackedMulti("gen2"), []wire.OutPoint{op1, op2},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, archived.Generation)
+	require.Equal(t, []wire.OutPoint{op2}, archived.Added)
+	require.Empty(t, archived.Removed)
+}
+
+// TestSubSwapperArchive tests that the SubSwapper archives each new backup
+// with a changed channel set.
+func TestSubSwapperArchive(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &lnencrypt.MockKeyRing{}
+	chanNotifier := newMockChannelNotifier()
+	swapper := newMockSwapper(keyRing)
+	archive, _ := newTestArchive(t, 10)
+
+	subSwapper, err := NewSubSwapper(
+		nil, chanNotifier, keyRing, swapper, WithArchive(archive),
+	)
+	require.NoError(t, err)
+	require.NoError(t, subSwapper.Start())
+	defer subSwapper.Stop()
+
+	backupSet := make(map[wire.OutPoint]Single)
+	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	newChannel, err := genRandomOpenChannelShell()
+	require.NoError(t, err)
+
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		NewChans: []ChannelWithAddrs{{OpenChannel: newChannel}},
+	}:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("update swapper didn't read new channel")
+	}
+
+	backupSet[newChannel.FundingOutpoint] = NewSingle(newChannel, nil)
+	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	// The backup is archived after the main backup was swapped, so we
+	// need to wait for it.
+	var generations []*ArchivedBackup
+	require.Eventually(t, func() bool {
+		generations, err = archive.Generations()
+		require.NoError(t, err)
+
+		return len(generations) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, generations[0].Channels)
+	require.Equal(
+		t, []wire.OutPoint{newChannel.FundingOutpoint},
+		generations[1].Added,
+	)
+
+	// The archived backup can be unpacked like the main backup.
+	multi, err := generations[1].Backup.Unpack(keyRing)
+	require.NoError(t, err)
+	require.Len(t, multi.StaticBackups, 1)
+}
@@ -96,16 +96,66 @@ type SubSwapper struct {
 
 	Swapper
 
+	// sinkWorkers write each new backup to the additional backup sinks.
+	sinkWorkers []*sinkWorker
+
+	// archive keeps the history of previous backups. It is nil if the
+	// archive is disabled.
+	archive *Archive
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
 
+// subSwapperOptions holds the optional parameters of the SubSwapper.
+type subSwapperOptions struct {
+	sinks       []BackupSink
+	sinkBackoff SinkBackoff
+	archive     *Archive
+}
+
+// SubSwapperOption is a functional option that modifies the optional
+// parameters of the SubSwapper.
+type SubSwapperOption func(*subSwapperOptions)
+
+// WithBackupSinks adds backup sinks that each new backup is copied to once
+// the main backup was updated.
+func WithBackupSinks(sinks ...BackupSink) SubSwapperOption {
+	return func(o *subSwapperOptions) {
+		o.sinks = append(o.sinks, sinks...)
+	}
+}
+
+// WithSinkBackoff sets the backoff used to retry failed writes to the backup
+// sinks.
+func WithSinkBackoff(backoff SinkBackoff) SubSwapperOption {
+	return func(o *subSwapperOptions) {
+		o.sinkBackoff = backoff
+	}
+}
+
+// WithArchive sets the archive each new backup is added to once the main
+// backup was updated.
+func WithArchive(archive *Archive) SubSwapperOption {
+	return func(o *subSwapperOptions) {
+		o.archive = archive
+	}
+}
+
 // NewSubSwapper creates a new instance of the SubSwapper given the starting
 // set of channels, and the required interfaces to be notified of new channel
 // updates, pack a multi backup, and swap the current best backup from its
 // storage location.
 func NewSubSwapper(startingChans []Single, chanNotifier ChannelNotifier,
-	keyRing keychain.KeyRing, backupSwapper Swapper) (*SubSwapper, error) {
+	keyRing keychain.KeyRing, backupSwapper Swapper,
+	options ...SubSwapperOption) (*SubSwapper, error) {
+
+	opts := subSwapperOptions{
+		sinkBackoff: DefaultSinkBackoff(),
+	}
+	for _, option := range options {
+		option(&opts)
+	}
 
 	// First, we'll subscribe to the latest set of channel updates given
 	// the set of channels we already know of.
@@ -125,11 +175,20 @@ func NewSubSwapper(startingChans []Single, chanNotifier ChannelNotifier,
 		backupState[chanBackup.FundingOutpoint] = chanBackup
 	}
 
+	sinkWorkers := make([]*sinkWorker, 0, len(opts.sinks))
+	for _, sink := range opts.sinks {
+		sinkWorkers = append(
+			sinkWorkers, newSinkWorker(sink, opts.sinkBackoff),
+		)
+	}
+
 	return &SubSwapper{
 		backupState: backupState,
 		chanEvents:  chanEvents,
 		keyRing:     keyRing,
 		Swapper:     backupSwapper,
+		sinkWorkers: sinkWorkers,
+		archive:     opts.archive,
 		quit:        make(chan struct{}),
 	}, nil
 }
@@ -140,6 +199,12 @@ func (s *SubSwapper) Start() error {
 	s.started.Do(func() {
 		log.Infof("chanbackup.SubSwapper starting")
 
+		// The sink workers need to be running before the first
+		// backup is handed to them.
+		for _, worker := range s.sinkWorkers {
+			worker.start()
+		}
+
 		// Before we enter our main loop, we'll update the on-disk
 		// state with the latest Single state, as nodes may have new
 		// advertised addresses.
@@ -164,10 +229,24 @@ func (s *SubSwapper) Stop() error {
 
 		close(s.quit)
 		s.wg.Wait()
+
+		for _, worker := range s.sinkWorkers {
+			worker.stop()
+		}
 	})
 	return nil
 }
 
+// SinkStatuses returns the current status of all backup sinks.
+func (s *SubSwapper) SinkStatuses() []SinkStatus {
+	statuses := make([]SinkStatus, 0, len(s.sinkWorkers))
+	for _, worker := range s.sinkWorkers {
+		statuses = append(statuses, worker.currentStatus())
+	}
+
+	return statuses
+}
+
 // updateBackupFile updates the backup file in place given the current state of
 // the SubSwapper. We accept the set of channels that were closed between this
 // update and the last to make sure we leave them out of our backup set union.
@@ -233,11 +312,31 @@ func (s *SubSwapper) updateBackupFile(closedChans ...wire.OutPoint) error {
 	// Finally, we'll swap out the old backup for this new one in a single
 	// atomic step, combining the file already on-disk with this set of new
 	// channels.
-	err = s.Swapper.UpdateAndSwap(PackedMulti(b.Bytes()))
+	newBackup := PackedMulti(b.Bytes())
+	err = s.Swapper.UpdateAndSwap(newBackup)
 	if err != nil {
 		return fmt.Errorf("unable to update multi backup: %w", err)
 	}
 
+	// Now that the main backup is updated, we'll hand the new backup to
+	// the sinks, which will write it in the background.
+	for _, worker := range s.sinkWorkers {
+		worker.submit(newBackup)
+	}
+
+	// We'll also keep the new backup in the archive. As the main backup
+	// was already updated, a failure to archive it isn't critical.
+	if s.archive != nil {
+		chanPoints := make([]wire.OutPoint, 0, len(combinedBackup))
+		for chanPoint := range combinedBackup {
+			chanPoints = append(chanPoints, chanPoint)
+		}
+
+		if _, err := s.archive.Add(newBackup, chanPoints); err != nil {
+			log.Errorf("Unable to archive backup: %v", err)
+		}
+	}
+
 	return nil
 }
 
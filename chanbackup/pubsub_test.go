@@ -277,4 +277,31 @@ func TestSubSwapperUpdater(t *testing.T) {
 	// Verify that the new set of backups, now has one less after the
 	// sub-swapper switches the new set with the old.
 	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	// Finally, we'll splice an existing channel, which replaces its backup
+	// with one of its new funding outpoint within a single update.
+	splicedChannel, err := genRandomOpenChannelShell()
+	require.NoError(t, err, "unable to create spliced chan")
+
+	prevChanPoint := initialChanSet[1].FundingOutpoint
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		NewChans: []ChannelWithAddrs{
+			{
+				OpenChannel: splicedChannel,
+			},
+		},
+		ClosedChans: []wire.OutPoint{prevChanPoint},
+	}:
+
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read spliced channel: %v", err)
+	}
+
+	delete(backupSet, prevChanPoint)
+	backupSet[splicedChannel.FundingOutpoint] = NewSingle(
+		splicedChannel, nil,
+	)
+
+	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
 }
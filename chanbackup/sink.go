@@ -0,0 +1,226 @@
+package chanbackup
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSinkRetryDelay is the default delay before the first retry of
+	// a failed backup sink write.
+	DefaultSinkRetryDelay = 5 * time.Second
+
+	// DefaultSinkMaxRetryDelay is the default upper bound of the delay
+	// between retries of a failed backup sink write.
+	DefaultSinkMaxRetryDelay = 10 * time.Minute
+)
+
+// BackupSink is a destination that the SubSwapper copies each new packed
+// multi-chan backup to, in addition to the main backup file. Sinks only ever
+// receive the encrypted backup.
+type BackupSink interface {
+	// Name returns a human readable name that identifies the sink.
+	Name() string
+
+	// Write stores the new packed multi-chan backup at the sink's location,
+	// replacing the previous backup. The passed context is canceled once
+	// the SubSwapper shuts down.
+	Write(ctx context.Context, newBackup PackedMulti) error
+}
+
+// SinkStatus describes the result of the latest writes to a backup sink.
+type SinkStatus struct {
+	// Name is the name of the sink.
+	Name string
+
+	// LastSuccess is the time of the last successful write to the sink.
+	// It is the zero time if the sink wasn't written to successfully yet.
+	LastSuccess time.Time
+
+	// LastAttempt is the time of the last write attempt to the sink.
+	LastAttempt time.Time
+
+	// LastErr is the error of the last write attempt, if it failed.
+	LastErr error
+
+	// NumFailures is the number of consecutive failed write attempts.
+	NumFailures uint32
+
+	// Pending is true if the latest backup wasn't written to the sink yet.
+	Pending bool
+}
+
+// SinkBackoff defines how failed writes to a backup sink are retried. The
+// delay between retries doubles after each failure, up to MaxDelay. Writes are
+// retried until they succeed or a newer backup supersedes the failed one.
+type SinkBackoff struct {
+	// InitialDelay is the delay before the first retry.
+	InitialDelay time.Duration
+
+	// MaxDelay is the maximum delay between two retries.
+	MaxDelay time.Duration
+}
+
+// DefaultSinkBackoff returns the default backoff of backup sink writes.
+func DefaultSinkBackoff() SinkBackoff {
+	return SinkBackoff{
+		InitialDelay: DefaultSinkRetryDelay,
+		MaxDelay:     DefaultSinkMaxRetryDelay,
+	}
+}
+
+// sinkWorker writes new backups to a single backup sink in its own goroutine,
+// so a slow or unavailable sink doesn't hold up the SubSwapper or any of the
+// other sinks.
+type sinkWorker struct {
+	sink    BackupSink
+	backoff SinkBackoff
+
+	// newBackups holds the latest backup that wasn't picked up by the
+	// worker yet. Older backups that weren't picked up are dropped, as
+	// each backup supersedes all prior ones.
+	newBackups chan PackedMulti
+
+	statusMtx sync.Mutex
+	status    SinkStatus
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newSinkWorker creates a new worker for the given sink.
+func newSinkWorker(sink BackupSink, backoff SinkBackoff) *sinkWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &sinkWorker{
+		sink:       sink,
+		backoff:    backoff,
+		newBackups: make(chan PackedMulti, 1),
+		status: SinkStatus{
+			Name: sink.Name(),
+		},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// start launches the main goroutine of the worker.
+func (w *sinkWorker) start() {
+	w.wg.Add(1)
+	go w.writer()
+}
+
+// stop signals the worker to exit and waits for it to do so.
+func (w *sinkWorker) stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+// submit hands a new backup to the worker, replacing any backup that wasn't
+// picked up yet.
+func (w *sinkWorker) submit(newBackup PackedMulti) {
+	// We hold the status mutex while replacing the backup, so the
+	// pending flag is consistent with the queued backup.
+	w.statusMtx.Lock()
+	defer w.statusMtx.Unlock()
+
+	select {
+	case <-w.newBackups:
+	default:
+	}
+
+	w.newBackups <- newBackup
+	w.status.Pending = true
+}
+
+// currentStatus returns a copy of the current status of the sink.
+func (w *sinkWorker) currentStatus() SinkStatus {
+	w.statusMtx.Lock()
+	defer w.statusMtx.Unlock()
+
+	return w.status
+}
+
+// writer is the main goroutine of the worker which writes each new backup to
+// the sink.
+func (w *sinkWorker) writer() {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case newBackup := <-w.newBackups:
+			w.writeWithRetry(newBackup)
+
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+// writeWithRetry writes the backup to the sink, retrying with an exponential
+// backoff until the write succeeds. If a newer backup is submitted while we
+// wait for the next retry, the newer backup is written instead.
+func (w *sinkWorker) writeWithRetry(backup PackedMulti) {
+	delay := w.backoff.InitialDelay
+
+	for {
+		err := w.sink.Write(w.ctx, backup)
+		w.recordAttempt(err)
+
+		if err == nil {
+			log.Debugf("Updated channel backup at sink %v",
+				w.sink.Name())
+
+			return
+		}
+
+		// There's no need to log the error if the write failed
+		// because we're shutting down.
+		if w.ctx.Err() != nil {
+			return
+		}
+
+		log.Warnf("Unable to update channel backup at sink %v, "+
+			"retrying in %v: %v", w.sink.Name(), delay, err)
+
+		select {
+		case <-time.After(delay):
+
+		case backup = <-w.newBackups:
+			log.Debugf("Retrying sink %v with newer channel backup",
+				w.sink.Name())
+
+		case <-w.ctx.Done():
+			return
+		}
+
+		delay *= 2
+		if delay > w.backoff.MaxDelay {
+			delay = w.backoff.MaxDelay
+		}
+	}
+}
+
+// recordAttempt updates the status of the sink with the result of a write.
+func (w *sinkWorker) recordAttempt(err error) {
+	w.statusMtx.Lock()
+	defer w.statusMtx.Unlock()
+
+	now := time.Now()
+	w.status.LastAttempt = now
+	w.status.LastErr = err
+
+	if err != nil {
+		w.status.NumFailures++
+
+		return
+	}
+
+	w.status.LastSuccess = now
+	w.status.NumFailures = 0
+
+	// A newer backup may already be waiting to be written.
+	w.status.Pending = len(w.newBackups) > 0
+}
@@ -0,0 +1,87 @@
+package chanbackup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultExecSinkTimeout is the default amount of time the command of
+	// an exec sink may run before it is killed.
+	DefaultExecSinkTimeout = time.Minute
+
+	// execWaitDelay is the amount of time we wait for the output pipes of
+	// a command to be closed after it was killed.
+	execWaitDelay = time.Second
+
+	// maxExecOutputLen is the maximum number of bytes of the output of a
+	// failed command that are included in the returned error.
+	maxExecOutputLen = 512
+)
+
+// ExecSink is a backup sink that runs an external command for each new
+// backup. The packed multi-chan backup is passed to the command on its
+// standard input, and a non-zero exit status is treated as a failed write.
+// This can be used to hook any custom upload mechanism into lnd.
+type ExecSink struct {
+	command string
+	args    []string
+	timeout time.Duration
+}
+
+// A compile-time check to ensure ExecSink implements BackupSink.
+var _ BackupSink = (*ExecSink)(nil)
+
+// NewExecSink creates a new exec sink that runs the given command with the
+// given arguments. The command is killed if it doesn't exit within the given
+// timeout.
+func NewExecSink(command string, args []string,
+	timeout time.Duration) *ExecSink {
+
+	return &ExecSink{
+		command: command,
+		args:    args,
+		timeout: timeout,
+	}
+}
+
+// Name returns a human readable name that identifies the sink.
+//
+// NOTE: This is part of the BackupSink interface.
+func (e *ExecSink) Name() string {
+	return fmt.Sprintf("exec:%v", e.command)
+}
+
+// Write runs the command with the new backup on its standard input.
+//
+// NOTE: This is part of the BackupSink interface.
+func (e *ExecSink) Write(ctx context.Context, newBackup PackedMulti) error {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.command, e.args...)
+	cmd.Stdin = bytes.NewReader(newBackup)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	// Child processes of a killed command may keep its output pipes
+	// open, so we don't wait for them to exit indefinitely.
+	cmd.WaitDelay = execWaitDelay
+
+	if err := cmd.Run(); err != nil {
+		out := strings.TrimSpace(output.String())
+		if len(out) > maxExecOutputLen {
+			out = out[:maxExecOutputLen]
+		}
+
+		return fmt.Errorf("backup command failed: %w, output: %v",
+			err, out)
+	}
+
+	return nil
+}
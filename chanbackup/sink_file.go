@@ -0,0 +1,93 @@
+package chanbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// sinkTempFileSuffix is appended to the name of the target file of a
+	// file sink to derive the name of its temporary file. Each file sink
+	// uses its own temporary file, so it never interferes with the main
+	// backup file or other sinks in the same directory.
+	sinkTempFileSuffix = ".sink-tmp"
+)
+
+// FileSink is a backup sink that atomically replaces a file on the local file
+// system, like the main backup file. This can be used to keep a copy of the
+// backup on another disk or on a mounted network file system.
+type FileSink struct {
+	multiFile *MultiFile
+}
+
+// A compile-time check to ensure FileSink implements BackupSink.
+var _ BackupSink = (*FileSink)(nil)
+
+// NewFileSink creates a new file sink that writes to the given file.
+func NewFileSink(fileName string) *FileSink {
+	return &FileSink{
+		multiFile: &MultiFile{
+			fileName:     fileName,
+			tempFileName: fileName + sinkTempFileSuffix,
+		},
+	}
+}
+
+// Name returns a human readable name that identifies the sink.
+//
+// NOTE: This is part of the BackupSink interface.
+func (f *FileSink) Name() string {
+	return fmt.Sprintf("file:%v", f.multiFile.fileName)
+}
+
+// Write atomically replaces the target file with the new backup.
+//
+// NOTE: This is part of the BackupSink interface.
+func (f *FileSink) Write(_ context.Context, newBackup PackedMulti) error {
+	return f.multiFile.UpdateAndSwap(newBackup)
+}
+
+// DirSink is a backup sink that mirrors the backup file into a directory,
+// using the default backup file name. Unlike the FileSink, the directory is
+// created if it doesn't exist yet, so it can point to a removable drive that
+// isn't always mounted.
+type DirSink struct {
+	dir string
+
+	fileSink *FileSink
+}
+
+// A compile-time check to ensure DirSink implements BackupSink.
+var _ BackupSink = (*DirSink)(nil)
+
+// NewDirSink creates a new sink that mirrors the backup into the given
+// directory.
+func NewDirSink(dir string) *DirSink {
+	fileName := filepath.Join(dir, DefaultBackupFileName)
+
+	return &DirSink{
+		dir:      dir,
+		fileSink: NewFileSink(fileName),
+	}
+}
+
+// Name returns a human readable name that identifies the sink.
+//
+// NOTE: This is part of the BackupSink interface.
+func (d *DirSink) Name() string {
+	return fmt.Sprintf("dir:%v", d.dir)
+}
+
+// Write creates the target directory if needed, and then atomically replaces
+// the backup file within it.
+//
+// NOTE: This is part of the BackupSink interface.
+func (d *DirSink) Write(ctx context.Context, newBackup PackedMulti) error {
+	if err := os.MkdirAll(d.dir, 0700); err != nil {
+		return fmt.Errorf("unable to create backup dir: %w", err)
+	}
+
+	return d.fileSink.Write(ctx, newBackup)
+}
@@ -0,0 +1,267 @@
+package chanbackup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultS3SinkTimeout is the default timeout of a single upload to an
+	// S3 compatible endpoint.
+	DefaultS3SinkTimeout = time.Minute
+
+	// DefaultS3Region is the region that is used to sign requests if none
+	// is configured. Most S3 compatible services accept any region.
+	DefaultS3Region = "us-east-1"
+
+	// s3SigningAlgorithm is the identifier of the AWS signature version 4
+	// signing algorithm.
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+
+	// s3TimeFormat is the format of the request timestamp used in AWS
+	// signature version 4.
+	s3TimeFormat = "20060102T150405Z"
+
+	// s3DateFormat is the format of the date of the credential scope used
+	// in AWS signature version 4.
+	s3DateFormat = "20060102"
+
+	// maxS3ErrorLen is the maximum number of bytes of an error response
+	// body that are included in the returned error.
+	maxS3ErrorLen = 512
+)
+
+// S3Config holds the parameters of an S3 compatible object storage endpoint.
+type S3Config struct {
+	// Endpoint is the base URL of the service, for example
+	// https://s3.us-east-1.amazonaws.com or http://localhost:9000.
+	Endpoint string
+
+	// Region is the region used to sign the requests.
+	Region string
+
+	// Bucket is the name of the bucket the backup is stored in.
+	Bucket string
+
+	// Key is the object key the backup is stored under.
+	Key string
+
+	// AccessKeyID is the ID of the access key used to sign the requests.
+	AccessKeyID string
+
+	// SecretAccessKey is the secret of the access key used to sign the
+	// requests.
+	SecretAccessKey string
+
+	// Timeout is the timeout of a single upload.
+	Timeout time.Duration
+}
+
+// S3Sink is a backup sink that uploads the backup to an S3 compatible object
+// storage endpoint. Requests use path style addressing and are signed with
+// AWS signature version 4, which is supported by AWS S3 as well as by
+// self-hosted alternatives.
+type S3Sink struct {
+	cfg S3Config
+
+	client *http.Client
+
+	// now returns the current time, which is used to sign requests.
+	now func() time.Time
+}
+
+// A compile-time check to ensure S3Sink implements BackupSink.
+var _ BackupSink = (*S3Sink)(nil)
+
+// NewS3Sink creates a new sink that uploads the backup with the given config.
+func NewS3Sink(cfg S3Config) (*S3Sink, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid S3 endpoint scheme: %v",
+			endpoint.Scheme)
+	}
+
+	switch {
+	case cfg.Bucket == "":
+		return nil, fmt.Errorf("S3 bucket must be set")
+
+	case cfg.Key == "":
+		return nil, fmt.Errorf("S3 object key must be set")
+
+	case cfg.AccessKeyID == "" || cfg.SecretAccessKey == "":
+		return nil, fmt.Errorf("S3 access key must be set")
+	}
+
+	if cfg.Region == "" {
+		cfg.Region = DefaultS3Region
+	}
+
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultS3SinkTimeout
+	}
+
+	return &S3Sink{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Name returns a human readable name that identifies the sink.
+//
+// NOTE: This is part of the BackupSink interface.
+func (s *S3Sink) Name() string {
+	endpoint := strings.TrimSuffix(s.cfg.Endpoint, "/")
+
+	return fmt.Sprintf("s3:%v/%v/%v", endpoint, s.cfg.Bucket, s.cfg.Key)
+}
+
+// objectURL returns the path style URL of the backup object.
+func (s *S3Sink) objectURL() (*url.URL, error) {
+	u, err := url.Parse(s.cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.cfg.Bucket + "/" +
+		strings.TrimPrefix(s.cfg.Key, "/")
+	u.RawPath = s3EscapePath(u.Path)
+
+	return u, nil
+}
+
+// Write uploads the new backup, replacing the previous object.
+//
+// NOTE: This is part of the BackupSink interface.
+func (s *S3Sink) Write(ctx context.Context, newBackup PackedMulti) error {
+	u, err := s.objectURL()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPut, u.String(), bytes.NewReader(newBackup),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	s.signRequest(req, newBackup, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to upload backup: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxS3ErrorLen))
+
+		return fmt.Errorf("unable to upload backup: %v: %s",
+			resp.Status, bytes.TrimSpace(body))
+	}
+
+	return nil
+}
+
+// signRequest adds the AWS signature version 4 authorization headers to the
+// request.
+func (s *S3Sink) signRequest(req *http.Request, payload []byte,
+	now time.Time) {
+
+	now = now.UTC()
+	amzDate := now.Format(s3TimeFormat)
+	payloadHash := sha256.Sum256(payload)
+	payloadHex := hex.EncodeToString(payloadHash[:])
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHex)
+
+	// The headers must be sorted by their lower case name.
+	canonicalHeaders := fmt.Sprintf(
+		"content-type:%v\nhost:%v\nx-amz-content-sha256:%v\n"+
+			"x-amz-date:%v\n", req.Header.Get("Content-Type"),
+		req.URL.Host, payloadHex, amzDate,
+	)
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHex,
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := fmt.Sprintf("%v/%v/s3/aws4_request",
+		now.Format(s3DateFormat), s.cfg.Region)
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	// The signing key is derived from the secret by successively
+	// hashing the elements of the credential scope.
+	key := []byte("AWS4" + s.cfg.SecretAccessKey)
+	for _, part := range []string{
+		now.Format(s3DateFormat), s.cfg.Region, "s3", "aws4_request",
+	} {
+		key = hmacSHA256(key, []byte(part))
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%v Credential=%v/%v, SignedHeaders=%v, Signature=%v",
+		s3SigningAlgorithm, s.cfg.AccessKeyID, scope, signedHeaders,
+		signature,
+	))
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of the data with the given key.
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+
+	return mac.Sum(nil)
+}
+
+// s3EscapePath escapes each segment of the path as required by AWS signature
+// version 4, which only leaves unreserved characters unescaped.
+func s3EscapePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z',
+			c >= '0' && c <= '9', c == '-', c == '_', c == '.',
+			c == '~', c == '/':
+
+			b.WriteByte(c)
+
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,357 @@
+package chanbackup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnencrypt"
+	"github.com/stretchr/testify/require"
+)
+
+// testSinkBackoff is a backoff with short delays to speed up the tests.
+var testSinkBackoff = SinkBackoff{
+	InitialDelay: time.Millisecond,
+	MaxDelay:     5 * time.Millisecond,
+}
+
+// mockSink is a backup sink that fails a configurable number of writes and
+// records all successfully written backups.
+type mockSink struct {
+	mu sync.Mutex
+
+	numFailures int
+	writes      []PackedMulti
+
+	written chan PackedMulti
+}
+
+func newMockSink(numFailures int) *mockSink {
+	return &mockSink{
+		numFailures: numFailures,
+		written:     make(chan PackedMulti, 10),
+	}
+}
+
+func (m *mockSink) Name() string {
+	return "mock"
+}
+
+func (m *mockSink) Write(_ context.Context, newBackup PackedMulti) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.numFailures > 0 {
+		m.numFailures--
+
+		return errors.New("sink unavailable")
+	}
+
+	m.writes = append(m.writes, newBackup)
+	m.written <- newBackup
+
+	return nil
+}
+
+// TestSinkWorkerRetry tests that failed sink writes are retried until they
+// succeed, and that the sink status reflects the writes.
+func TestSinkWorkerRetry(t *testing.T) {
+	t.Parallel()
+
+	sink := newMockSink(3)
+	worker := newSinkWorker(sink, testSinkBackoff)
+	worker.start()
+	defer worker.stop()
+
+	status := worker.currentStatus()
+	require.Equal(t, "mock", status.Name)
+	require.True(t, status.LastSuccess.IsZero())
+	require.False(t, status.Pending)
+
+	backup := PackedMulti("backup")
+	worker.submit(backup)
+
+	select {
+	case written := <-sink.written:
+		require.Equal(t, backup, written)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("backup not written to sink")
+	}
+
+	require.Eventually(t, func() bool {
+		status := worker.currentStatus()
+
+		return !status.LastSuccess.IsZero() && !status.Pending
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status = worker.currentStatus()
+	require.NoError(t, status.LastErr)
+	require.Zero(t, status.NumFailures)
+	require.Equal(t, status.LastSuccess, status.LastAttempt)
+}
+
+// TestSinkWorkerFailureStatus tests that a failing sink reports its failures,
+// and that a newer backup supersedes a backup that couldn't be written yet.
+func TestSinkWorkerFailureStatus(t *testing.T) {
+	t.Parallel()
+
+	sink := newMockSink(1000)
+	worker := newSinkWorker(sink, testSinkBackoff)
+	worker.start()
+	defer worker.stop()
+
+	worker.submit(PackedMulti("old"))
+
+	require.Eventually(t, func() bool {
+		return worker.currentStatus().NumFailures >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status := worker.currentStatus()
+	require.True(t, status.Pending)
+	require.True(t, status.LastSuccess.IsZero())
+	require.ErrorContains(t, status.LastErr, "sink unavailable")
+
+	// Let the sink recover, and submit a newer backup. Only the newer
+	// backup should be written.
+	sink.mu.Lock()
+	sink.numFailures = 0
+	worker.submit(PackedMulti("new"))
+	sink.mu.Unlock()
+
+	select {
+	case written := <-sink.written:
+		require.Equal(t, PackedMulti("new"), written)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("backup not written to sink")
+	}
+
+	require.Eventually(t, func() bool {
+		return !worker.currentStatus().Pending
+	}, 5*time.Second, 10*time.Millisecond)
+
+	sink.mu.Lock()
+	require.Equal(t, []PackedMulti{PackedMulti("new")}, sink.writes)
+	sink.mu.Unlock()
+}
+
+// TestFileSinks tests that the file and dir sinks replace their target file.
+func TestFileSinks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tempDir := t.TempDir()
+
+	fileName := filepath.Join(tempDir, "copy.backup")
+	fileSink := NewFileSink(fileName)
+
+	mirrorDir := filepath.Join(tempDir, "mirror", "nested")
+	dirSink := NewDirSink(mirrorDir)
+
+	for _, backup := range []string{"first", "second"} {
+		require.NoError(t, fileSink.Write(ctx, PackedMulti(backup)))
+		require.NoError(t, dirSink.Write(ctx, PackedMulti(backup)))
+
+		content, err := os.ReadFile(fileName)
+		require.NoError(t, err)
+		require.Equal(t, backup, string(content))
+
+		content, err = os.ReadFile(
+			filepath.Join(mirrorDir, DefaultBackupFileName),
+		)
+		require.NoError(t, err)
+		require.Equal(t, backup, string(content))
+	}
+
+	// No temporary files should be left behind.
+	files, err := os.ReadDir(mirrorDir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	// The file sink doesn't create missing directories.
+	missingSink := NewFileSink(filepath.Join(tempDir, "missing", "x"))
+	require.Error(t, missingSink.Write(ctx, PackedMulti("backup")))
+}
+
+// TestExecSink tests that the exec sink passes the backup to the command, and
+// that failing commands are reported.
+func TestExecSink(t *testing.T) {
+	t.Parallel()
+
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("no shell available")
+	}
+
+	ctx := context.Background()
+	fileName := filepath.Join(t.TempDir(), "exec.backup")
+
+	sink := NewExecSink(
+		"/bin/sh", []string{"-c", "cat > " + fileName}, time.Minute,
+	)
+	require.NoError(t, sink.Write(ctx, PackedMulti("backup")))
+
+	content, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	require.Equal(t, "backup", string(content))
+
+	failSink := NewExecSink(
+		"/bin/sh", []string{"-c", "echo upload failed; exit 3"},
+		time.Minute,
+	)
+	err = failSink.Write(ctx, PackedMulti("backup"))
+	require.ErrorContains(t, err, "upload failed")
+
+	slowSink := NewExecSink(
+		"/bin/sh", []string{"-c", "sleep 10"}, 50*time.Millisecond,
+	)
+	require.Error(t, slowSink.Write(ctx, PackedMulti("backup")))
+}
+
+// s3StandIn is a minimal S3 compatible server that verifies the request
+// signatures and stores the uploaded objects in memory.
+type s3StandIn struct {
+	t *testing.T
+
+	sink *S3Sink
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	fail    bool
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fail {
+		http.Error(w, "SlowDown", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	require.NoError(s.t, err)
+
+	// Re-sign the received request to make sure the signature covers
+	// the request as it arrived at the server.
+	amzDate, err := time.Parse(s3TimeFormat, r.Header.Get("X-Amz-Date"))
+	require.NoError(s.t, err)
+
+	authorization := r.Header.Get("Authorization")
+	received := r.Clone(context.Background())
+	received.URL.Host = r.Host
+	s.sink.signRequest(received, body, amzDate)
+
+	if r.Method != http.MethodPut ||
+		received.Header.Get("Authorization") != authorization {
+
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+
+		return
+	}
+
+	s.objects[r.URL.Path] = body
+}
+
+// TestS3Sink tests that the S3 sink uploads the backup to the configured
+// object with a valid signature.
+func TestS3Sink(t *testing.T) {
+	t.Parallel()
+
+	standIn := &s3StandIn{
+		t:       t,
+		objects: make(map[string][]byte),
+	}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	cfg := S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "backups",
+		Key:             "node 1/channel.backup",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+	}
+	sink, err := NewS3Sink(cfg)
+	require.NoError(t, err)
+	standIn.sink = sink
+
+	ctx := context.Background()
+	require.NoError(t, sink.Write(ctx, PackedMulti("backup")))
+	require.Equal(
+		t, []byte("backup"),
+		standIn.objects["/backups/node 1/channel.backup"],
+	)
+
+	// A request signed with a different secret is rejected.
+	cfg.SecretAccessKey = "wrong"
+	wrongSink, err := NewS3Sink(cfg)
+	require.NoError(t, err)
+	err = wrongSink.Write(ctx, PackedMulti("backup"))
+	require.ErrorContains(t, err, "SignatureDoesNotMatch")
+
+	// Server errors are returned as well.
+	standIn.mu.Lock()
+	standIn.fail = true
+	standIn.mu.Unlock()
+
+	err = sink.Write(ctx, PackedMulti("backup"))
+	require.ErrorContains(t, err, "SlowDown")
+
+	// Invalid configs are rejected.
+	_, err = NewS3Sink(S3Config{Endpoint: "ftp://localhost"})
+	require.Error(t, err)
+}
+
+// TestSubSwapperSinks tests that the SubSwapper hands each new backup to its
+// backup sinks, and reports their status.
+func TestSubSwapperSinks(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &lnencrypt.MockKeyRing{}
+	chanNotifier := newMockChannelNotifier()
+	swapper := newMockSwapper(keyRing)
+	sink := newMockSink(1)
+
+	subSwapper, err := NewSubSwapper(
+		nil, chanNotifier, keyRing, swapper, WithBackupSinks(sink),
+		WithSinkBackoff(testSinkBackoff),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, subSwapper.Start())
+	defer subSwapper.Stop()
+
+	var mainBackup PackedMulti
+	select {
+	case mainBackup = <-swapper.swaps:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("main backup not updated")
+	}
+
+	select {
+	case written := <-sink.written:
+		require.True(t, bytes.Equal(mainBackup, written))
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("backup not written to sink")
+	}
+
+	require.Eventually(t, func() bool {
+		statuses := subSwapper.SinkStatuses()
+
+		return len(statuses) == 1 &&
+			!statuses[0].LastSuccess.IsZero() &&
+			strings.HasPrefix(statuses[0].Name, "mock")
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -54,8 +54,12 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 
 	// sendChanOpenUpdate is a closure that sends a ChannelEvent to the
 	// chanUpdates channel to inform subscribers about new pending or
-	// confirmed channels.
-	sendChanOpenUpdate := func(newOrPendingChan *channeldb.OpenChannel) {
+	// confirmed channels. Any passed closed channels are removed within
+	// the same event, which is used for spliced channels that moved off
+	// their previous funding output.
+	sendChanOpenUpdate := func(newOrPendingChan *channeldb.OpenChannel,
+		closedChans ...wire.OutPoint) {
+
 		nodeAddrs, err := c.addrs.AddrsForNode(
 			newOrPendingChan.IdentityPub,
 		)
@@ -72,6 +76,7 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 					Addrs:       nodeAddrs,
 				},
 			},
+			ClosedChans: closedChans,
 		}
 
 		select {
@@ -117,6 +122,15 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 				case channelnotifier.OpenChannelEvent:
 					sendChanOpenUpdate(event.Channel)
 
+				// A channel was moved onto the new funding
+				// output of its splice, so its backup needs to
+				// be replaced with one of the new outpoint.
+				case channelnotifier.SplicedChannelEvent:
+					sendChanOpenUpdate(
+						event.Channel,
+						*event.PrevChannelPoint,
+					)
+
 				// An existing channel has been closed, we'll
 				// send only the chanPoint of the closed
 				// channel to the sub-swapper.
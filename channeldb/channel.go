@@ -133,6 +133,24 @@ var (
 	// shutdown on re-establish.
 	shutdownInfoKey = []byte("shutdown-info-key")
 
+	// dynCommitProposalKey points to the serialised DynPropose message
+	// that we sent to the remote party to initiate a dynamic commitment
+	// negotiation. The existence of this key means that the negotiation
+	// hasn't completed yet and the proposal should be re-sent on
+	// re-establish.
+	dynCommitProposalKey = []byte("dyn-commit-proposal-key")
+
+	// dynCommitHeightKey points to the number of dynamic commitments that
+	// were applied to the channel. It's sent to the remote party on
+	// re-establish, so that a dynamic commitment that was only applied by
+	// one of the parties can be reconciled.
+	dynCommitHeightKey = []byte("dyn-commit-height-key")
+
+	// csvDelayChangesKey points to the CSV delays that the commitments of
+	// either party used before a dynamic commitment changed them. The key
+	// only exists if the CSV delay of the channel was changed.
+	csvDelayChangesKey = []byte("csv-delay-changes-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -442,6 +460,21 @@ type ChannelConstraints struct {
 	CsvDelay uint16
 }
 
+// CsvDelayChange records the CSV delay that the commitments of one party used
+// before a dynamic commitment changed it.
+type CsvDelayChange struct {
+	// Party is the owner of the commitments whose CSV delay was changed.
+	Party lntypes.ChannelParty
+
+	// Height is the first commitment height of the party that uses the
+	// new CSV delay.
+	Height uint64
+
+	// CsvDelay is the CSV delay of the commitments of the party below
+	// Height, back to the previous change.
+	CsvDelay uint16
+}
+
 // ChannelConfig is a struct that houses the various configuration opens for
 // channels. Each side maintains an instance of this configuration file as it
 // governs: how the funding and commitment transaction to be created, the
@@ -850,13 +883,21 @@ type OpenChannel struct {
 
 	// confirmedScid is the confirmed ShortChannelID for a zero-conf
 	// channel. If the channel is unconfirmed, then this will be the
-	// default ShortChannelID. This is only set for zero-conf channels.
+	// default ShortChannelID. It's also set once a splice of the channel
+	// locked, in which case it's the ShortChannelID of the splice
+	// transaction.
 	confirmedScid lnwire.ShortChannelID
 
 	// Memo is any arbitrary information we wish to store locally about the
 	// channel that will be useful to our future selves.
 	Memo []byte
 
+	// CsvDelayChanges records the CSV delays that were replaced by dynamic
+	// commitments, in the order they were replaced. The commitments
+	// signed before a change keep using the previous CSV delay, which is
+	// needed to reconstruct their scripts.
+	CsvDelayChanges []CsvDelayChange
+
 	// TODO(roasbeef): eww
 	Db *ChannelStateDB
 
@@ -897,6 +938,20 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// RealScid returns the ShortChannelID the channel is announced under. This is
+// the ShortChannelID of its latest locked splice, if any, or otherwise its
+// ShortChannelID.
+func (c *OpenChannel) RealScid() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.confirmedScid != hop.Source {
+		return c.confirmedScid
+	}
+
+	return c.ShortChannelID
+}
+
 // ZeroConfRealScid returns the zero-conf channel's confirmed scid. This should
 // only be called if IsZeroConf returns true.
 func (c *OpenChannel) ZeroConfRealScid() lnwire.ShortChannelID {
@@ -1583,6 +1638,53 @@ func (c *OpenChannel) ChanSyncMsg() (*lnwire.ChannelReestablish, error) {
 		nextTaprootNonce = lnwire.SomeMusig2Nonce(nextNonce.PubNonce)
 	}
 
+	// If the signatures for a splice transaction weren't exchanged
+	// before the disconnection, we'll ask the remote party to retransmit
+	// its tx_signatures.
+	splices, err := c.pendingSplices()
+	if err != nil {
+		return nil, err
+	}
+	var nextFundingTxID fn.Option[lnwire.NextFundingTxID]
+	for _, splice := range splices {
+		// The kickoff_sig of an upgrade is retransmitted by the link
+		// instead.
+		if !splice.IsFinal() && !splice.IsUpgrade() {
+			nextFundingTxID = fn.Some(lnwire.NextFundingTxID(
+				splice.FundingTx.TxHash(),
+			))
+		}
+	}
+
+	// The same applies to the funding transaction of a pending
+	// co-funded channel.
+	if c.IsPending {
+		coFundTx, err := c.coFundTx()
+		switch {
+		case errors.Is(err, ErrCoFundTxNotFound):
+
+		case err != nil:
+			return nil, err
+
+		case !coFundTx.IsFinal():
+			nextFundingTxID = fn.Some(lnwire.NextFundingTxID(
+				coFundTx.FundingTx.TxHash(),
+			))
+		}
+	}
+
+	// If any dynamic commitments were applied to the channel, we'll let
+	// the remote party know how many, so it can apply a proposal of its
+	// own that we accepted but whose DynAck it never received.
+	dynHeight, err := c.dynCommitHeight()
+	if err != nil {
+		return nil, err
+	}
+	var optDynHeight fn.Option[lnwire.DynHeight]
+	if dynHeight > 0 {
+		optDynHeight = fn.Some(lnwire.DynHeight(dynHeight))
+	}
+
 	return &lnwire.ChannelReestablish{
 		ChanID: lnwire.NewChanIDFromOutPoint(
 			c.FundingOutpoint,
@@ -1593,7 +1695,9 @@ func (c *OpenChannel) ChanSyncMsg() (*lnwire.ChannelReestablish, error) {
 		LocalUnrevokedCommitPoint: input.ComputeCommitmentPoint(
 			currentCommitSecret[:],
 		),
-		LocalNonce: nextTaprootNonce,
+		LocalNonce:      nextTaprootNonce,
+		DynHeight:       optDynHeight,
+		NextFundingTxID: nextFundingTxID,
 	}, nil
 }
 
@@ -1670,6 +1774,242 @@ func (c *OpenChannel) ShutdownInfo() (fn.Option[ShutdownInfo], error) {
 	return fn.Some[ShutdownInfo](*shutdownInfo), nil
 }
 
+// StoreDynCommitProposal persists the DynPropose message that we sent to the
+// remote party to initiate a dynamic commitment negotiation. The proposal is
+// kept until it's either applied via ApplyDynCommitment or removed via
+// DeleteDynCommitProposal.
+func (c *OpenChannel) StoreDynCommitProposal(msg *lnwire.DynPropose) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var b bytes.Buffer
+	if err := msg.Encode(&b, 0); err != nil {
+		return err
+	}
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(dynCommitProposalKey, b.Bytes())
+	}, func() {})
+}
+
+// DynCommitProposal returns the pending dynamic commitment proposal that was
+// persisted for this channel, if any.
+func (c *OpenChannel) DynCommitProposal() (fn.Option[lnwire.DynPropose],
+	error) {
+
+	c.RLock()
+	defer c.RUnlock()
+
+	var proposal fn.Option[lnwire.DynPropose]
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNoChanDBExists),
+			errors.Is(err, ErrNoActiveChannels),
+			errors.Is(err, ErrChannelNotFound):
+
+			return nil
+		default:
+			return err
+		}
+
+		proposalBytes := chanBucket.Get(dynCommitProposalKey)
+		if proposalBytes == nil {
+			return nil
+		}
+
+		var msg lnwire.DynPropose
+		err = msg.Decode(bytes.NewReader(proposalBytes), 0)
+		if err != nil {
+			return err
+		}
+		proposal = fn.Some(msg)
+
+		return nil
+	}, func() {
+		proposal = fn.None[lnwire.DynPropose]()
+	})
+	if err != nil {
+		return fn.None[lnwire.DynPropose](), err
+	}
+
+	return proposal, nil
+}
+
+// DeleteDynCommitProposal removes any pending dynamic commitment proposal that
+// was persisted for this channel.
+func (c *OpenChannel) DeleteDynCommitProposal() error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(dynCommitProposalKey)
+	}, func() {})
+}
+
+// DynCommitHeight returns the number of dynamic commitments that were applied
+// to the channel.
+func (c *OpenChannel) DynCommitHeight() (uint64, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.dynCommitHeight()
+}
+
+// dynCommitHeight returns the number of dynamic commitments that were applied
+// to the channel.
+//
+// NOTE: The channel's mutex must be held when calling this method.
+func (c *OpenChannel) dynCommitHeight() (uint64, error) {
+	var height uint64
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNoChanDBExists),
+			errors.Is(err, ErrNoActiveChannels),
+			errors.Is(err, ErrChannelNotFound):
+
+			return nil
+		default:
+			return err
+		}
+
+		rawHeight := chanBucket.Get(dynCommitHeightKey)
+		if rawHeight != nil {
+			height = byteOrder.Uint64(rawHeight)
+		}
+
+		return nil
+	}, func() {
+		height = 0
+	})
+
+	return height, err
+}
+
+// ApplyDynCommitment replaces the channel constraints of both parties with
+// the ones that were agreed upon during a dynamic commitment negotiation. Any
+// pending dynamic commitment proposal is removed, and the dynamic commitment
+// height is incremented in the same transaction.
+func (c *OpenChannel) ApplyDynCommitment(localConstraints,
+	remoteConstraints ChannelConstraints) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	var changes []CsvDelayChange
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		// If a CSV delay changes, the current commitment of its owner
+		// keeps using the previous one, so we'll record it along with
+		// the height of the next commitment.
+		localCfg, remoteCfg := channel.LocalChanCfg,
+			channel.RemoteChanCfg
+		localHeight := channel.LocalCommitment.CommitHeight
+		remoteHeight := channel.RemoteCommitment.CommitHeight
+		if localConstraints.CsvDelay != localCfg.CsvDelay {
+			changes = append(changes, CsvDelayChange{
+				Party:    lntypes.Local,
+				Height:   localHeight + 1,
+				CsvDelay: localCfg.CsvDelay,
+			})
+		}
+		if remoteConstraints.CsvDelay != remoteCfg.CsvDelay {
+			changes = append(changes, CsvDelayChange{
+				Party:    lntypes.Remote,
+				Height:   remoteHeight + 1,
+				CsvDelay: remoteCfg.CsvDelay,
+			})
+		}
+
+		channel.LocalChanCfg.ChannelConstraints = localConstraints
+		channel.RemoteChanCfg.ChannelConstraints = remoteConstraints
+		channel.CsvDelayChanges = append(
+			channel.CsvDelayChanges, changes...,
+		)
+
+		if err := putChanInfo(chanBucket, channel); err != nil {
+			return err
+		}
+
+		// The remote party learns that we applied the dynamic
+		// commitment through the incremented height on re-establish.
+		var height [8]byte
+		rawHeight := chanBucket.Get(dynCommitHeightKey)
+		if rawHeight != nil {
+			copy(height[:], rawHeight)
+		}
+		byteOrder.PutUint64(height[:], byteOrder.Uint64(height[:])+1)
+		err = chanBucket.Put(dynCommitHeightKey, height[:])
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(dynCommitProposalKey)
+	}, func() {
+		changes = nil
+	}); err != nil {
+		return err
+	}
+
+	c.LocalChanCfg.ChannelConstraints = localConstraints
+	c.RemoteChanCfg.ChannelConstraints = remoteConstraints
+	c.CsvDelayChanges = append(c.CsvDelayChanges, changes...)
+
+	return nil
+}
+
+// CommitCsvDelay returns the CSV delay of the to_local and second-level HTLC
+// outputs on the commitment of the given party at the given height. This is
+// the CSV delay of the party's channel config, unless the commitment was
+// signed before a dynamic commitment changed it.
+func (c *OpenChannel) CommitCsvDelay(party lntypes.ChannelParty,
+	height uint64) uint16 {
+
+	for _, change := range c.CsvDelayChanges {
+		if change.Party == party && height < change.Height {
+			return change.CsvDelay
+		}
+	}
+
+	if party.IsLocal() {
+		return c.LocalChanCfg.CsvDelay
+	}
+
+	return c.RemoteChanCfg.CsvDelay
+}
+
 // isBorked returns true if the channel has been marked as borked in the
 // database. This requires an existing database transaction to already be
 // active.
@@ -2022,9 +2362,11 @@ func syncNewChannel(tx kvdb.RwTx, c *OpenChannel, addrs []net.Addr) error {
 //
 // A map is returned of all the htlc resolutions that were locked in this
 // commitment. Keys correspond to htlc indices and values indicate whether the
-// htlc was settled or failed.
+// htlc was settled or failed. The passed splice commitments are our new
+// commitments that spend the funding outputs of the pending splices.
 func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment,
-	unsignedAckedUpdates []LogUpdate) (map[uint64]bool, error) {
+	unsignedAckedUpdates []LogUpdate,
+	spliceCommits []SpliceCommitment) (map[uint64]bool, error) {
 
 	c.Lock()
 	defer c.Unlock()
@@ -2070,6 +2412,11 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment,
 				"revocations: %v", err)
 		}
 
+		err = putSpliceCommitments(chanBucket, spliceCommits, true)
+		if err != nil {
+			return err
+		}
+
 		// Persist unsigned but acked remote updates that need to be
 		// restored after a restart.
 		var b bytes.Buffer
@@ -2329,13 +2676,21 @@ type HTLC struct {
 	// HTLC. It is stored in the ExtraData field, which is used to store
 	// a TLV stream of additional information associated with the HTLC.
 	BlindingPoint lnwire.BlindingPointRecord
+
+	// Endorsed indicates that the HTLC carries the experimental
+	// endorsement signal.
+	//
+	// Note: like the blinding point, this field is stored in the ExtraData
+	// field rather than being a part of the on-disk representation of the
+	// HTLC.
+	Endorsed bool
 }
 
 // serializeExtraData encodes a TLV stream of extra data to be stored with a
 // HTLC. It uses the update_add_htlc TLV types, because this is where extra
-// data is passed with a HTLC. At present blinding points are the only extra
-// data that we will store, and the function is a no-op if a nil blinding
-// point is provided.
+// data is passed with a HTLC. At present blinding points and endorsement
+// signals are the only extra data that we will store, and the function is a
+// no-op if neither of them is set.
 //
 // This function MUST be called to persist all HTLC values when they are
 // serialized.
@@ -2347,6 +2702,11 @@ func (h *HTLC) serializeExtraData() error {
 		records = append(records, &b)
 	})
 
+	if h.Endorsed {
+		endorsement := lnwire.ExperimentalEndorsed
+		records = append(records, &endorsement)
+	}
+
 	return h.ExtraData.PackRecords(records...)
 }
 
@@ -2360,8 +2720,9 @@ func (h *HTLC) deserializeExtraData() error {
 		return nil
 	}
 
+	var endorsement lnwire.Endorsement
 	blindingPoint := h.BlindingPoint.Zero()
-	tlvMap, err := h.ExtraData.ExtractRecords(&blindingPoint)
+	tlvMap, err := h.ExtraData.ExtractRecords(&blindingPoint, &endorsement)
 	if err != nil {
 		return err
 	}
@@ -2370,6 +2731,11 @@ func (h *HTLC) deserializeExtraData() error {
 		h.BlindingPoint = tlv.SomeRecordT(blindingPoint)
 	}
 
+	endorsementType := lnwire.ExperimentalEndorsementType
+	if val, ok := tlvMap[endorsementType]; ok && val == nil {
+		h.Endorsed = endorsement == lnwire.ExperimentalEndorsed
+	}
+
 	return nil
 }
 
@@ -2593,6 +2959,13 @@ type CommitDiff struct {
 	// settles and fails from the forwarding packages of other channels,
 	// such that they will not be reforwarded internally after a restart.
 	SettleFailAcks []SettleFailRef
+
+	// SpliceCommitments are the new commitments of the remote party that
+	// spend the funding outputs of the pending splices of the channel.
+	//
+	// NOTE: This value is not serialized, as the commitments are stored
+	// along with their pending splice.
+	SpliceCommitments []SpliceCommitment
 }
 
 // serializeLogUpdates serializes provided list of updates to a stream.
@@ -2788,6 +3161,13 @@ func (c *OpenChannel) AppendRemoteCommitChain(diff *CommitDiff) error {
 			return err
 		}
 
+		err = putSpliceCommitments(
+			chanBucket, diff.SpliceCommitments, false,
+		)
+		if err != nil {
+			return err
+		}
+
 		// We are sending a commitment signature so lastWasRevokeKey should
 		// store false.
 		var b bytes.Buffer
@@ -2960,9 +3340,13 @@ func (c *OpenChannel) InsertNextRevocation(revKey *btcec.PublicKey) error {
 // remote party to the revocation log, and promote the current pending
 // commitment to the current remote commitment. The updates parameter is the
 // set of local updates that the peer still needs to send us a signature for.
-// We store this set of updates in case we go down.
+// We store this set of updates in case we go down. The commitments of the
+// remote party that spend the funding outputs of the pending splices are
+// advanced in the same way, for which spliceIndexes holds the output indexes
+// of the revoked commitments.
 func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg,
-	updates []LogUpdate, ourOutputIndex, theirOutputIndex uint32) error {
+	updates []LogUpdate, ourOutputIndex, theirOutputIndex uint32,
+	spliceIndexes []SpliceOutputIndexes) error {
 
 	c.Lock()
 	defer c.Unlock()
@@ -3043,6 +3427,13 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg,
 			return err
 		}
 
+		err = advanceSpliceCommitChains(
+			chanBucket, spliceIndexes, c.Db.parent.noRevLogAmtData,
+		)
+		if err != nil {
+			return err
+		}
+
 		// Lastly, we write the forwarding package to disk so that we
 		// can properly recover from failures and reforward HTLCs that
 		// have not received a corresponding settle/fail.
@@ -4040,6 +4431,10 @@ func putChanInfo(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
 		return err
 	}
 
+	if err := putCsvDelayChanges(chanBucket, channel); err != nil {
+		return err
+	}
+
 	// Finally, add optional shutdown scripts for the local and remote peer if
 	// they are present.
 	if err := putOptionalUpfrontShutdownScript(
@@ -4053,6 +4448,71 @@ func putChanInfo(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
 	)
 }
 
+// putCsvDelayChanges stores the CSV delay changes of the channel, if there are
+// any.
+func putCsvDelayChanges(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
+	if len(channel.CsvDelayChanges) == 0 {
+		return nil
+	}
+
+	var w bytes.Buffer
+	numChanges := uint16(len(channel.CsvDelayChanges))
+	if err := WriteElement(&w, numChanges); err != nil {
+		return err
+	}
+
+	for _, change := range channel.CsvDelayChanges {
+		err := WriteElements(
+			&w, change.Party.IsLocal(), change.Height,
+			change.CsvDelay,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return chanBucket.Put(csvDelayChangesKey, w.Bytes())
+}
+
+// fetchCsvDelayChanges reads the CSV delay changes of the channel. They're
+// optional, so the function returns with no error if there are none.
+func fetchCsvDelayChanges(chanBucket kvdb.RBucket,
+	channel *OpenChannel) error {
+
+	changesBytes := chanBucket.Get(csvDelayChangesKey)
+	if changesBytes == nil {
+		return nil
+	}
+
+	r := bytes.NewReader(changesBytes)
+
+	var numChanges uint16
+	if err := ReadElement(r, &numChanges); err != nil {
+		return err
+	}
+
+	channel.CsvDelayChanges = make([]CsvDelayChange, numChanges)
+	for i := range channel.CsvDelayChanges {
+		var (
+			isLocal bool
+			change  = &channel.CsvDelayChanges[i]
+		)
+		err := ReadElements(
+			r, &isLocal, &change.Height, &change.CsvDelay,
+		)
+		if err != nil {
+			return err
+		}
+
+		change.Party = lntypes.Remote
+		if isLocal {
+			change.Party = lntypes.Local
+		}
+	}
+
+	return nil
+}
+
 // putOptionalUpfrontShutdownScript adds a shutdown script under the key
 // provided if it has a non-zero length.
 func putOptionalUpfrontShutdownScript(chanBucket kvdb.RwBucket, key []byte,
@@ -4264,6 +4724,10 @@ func fetchChanInfo(chanBucket kvdb.RBucket, channel *OpenChannel) error {
 
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
+	if err := fetchCsvDelayChanges(chanBucket, channel); err != nil {
+		return err
+	}
+
 	// Finally, read the optional shutdown scripts.
 	if err := getOptionalUpfrontShutdownScript(
 		chanBucket, localUpfrontShutdownKey, &channel.LocalShutdownScript,
@@ -17,6 +17,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnmock"
@@ -658,7 +659,9 @@ func TestChannelStateTransition(t *testing.T) {
 		},
 	}
 
-	_, err = channel.UpdateCommitment(&commitment, unsignedAckedUpdates)
+	_, err = channel.UpdateCommitment(
+		&commitment, unsignedAckedUpdates, nil,
+	)
 	require.NoError(t, err, "unable to update commitment")
 
 	// Assert that update is correctly written to the database.
@@ -767,7 +770,7 @@ func TestChannelStateTransition(t *testing.T) {
 		diskCommitDiff.LogUpdates, nil)
 
 	err = channel.AdvanceCommitChainTail(
-		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex, nil,
 	)
 	require.NoError(t, err, "unable to append to revocation log")
 
@@ -819,7 +822,7 @@ func TestChannelStateTransition(t *testing.T) {
 	fwdPkg = NewFwdPkg(channel.ShortChanID(), oldRemoteCommit.CommitHeight, nil, nil)
 
 	err = channel.AdvanceCommitChainTail(
-		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex, nil,
 	)
 	require.NoError(t, err, "unable to append to revocation log")
 
@@ -1226,6 +1229,154 @@ func testShutdownInfo(t *testing.T, locallyInitiated bool) {
 	})
 }
 
+// TestDynCommitProposal tests that a channel's pending dynamic commitment
+// proposal can be persisted and applied.
+func TestDynCommitProposal(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb)
+
+	// We haven't persisted any proposal for this channel yet, nor applied
+	// any, so the dynamic commitment height shouldn't be sent on
+	// re-establish.
+	proposal, err := channel.DynCommitProposal()
+	require.NoError(t, err)
+	require.True(t, proposal.IsNone())
+
+	dynHeight, err := channel.DynCommitHeight()
+	require.NoError(t, err)
+	require.Zero(t, dynHeight)
+
+	chanSync, err := channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.True(t, chanSync.DynHeight.IsNone())
+
+	// Persist a new proposal and make sure it can be read back.
+	chanID := lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint)
+	msg := &lnwire.DynPropose{
+		ChanID:           chanID,
+		Initiator:        true,
+		DustLimit:        fn.Some(btcutil.Amount(600)),
+		MaxAcceptedHTLCs: fn.Some(uint16(30)),
+	}
+	require.NoError(t, channel.StoreDynCommitProposal(msg))
+
+	proposal, err = channel.DynCommitProposal()
+	require.NoError(t, err)
+	storedMsg := proposal.UnwrapOrFail(t)
+	require.Equal(t, msg.ChanID, storedMsg.ChanID)
+	require.True(t, storedMsg.Initiator)
+	require.Equal(t, msg.DustLimit, storedMsg.DustLimit)
+	require.Equal(t, msg.MaxAcceptedHTLCs, storedMsg.MaxAcceptedHTLCs)
+	require.True(t, storedMsg.ChannelReserve.IsNone())
+
+	// Applying the new constraints should update the channel, remove the
+	// pending proposal and increment the dynamic commitment height.
+	localConstraints := channel.LocalChanCfg.ChannelConstraints
+	localConstraints.DustLimit = 600
+	remoteConstraints := channel.RemoteChanCfg.ChannelConstraints
+	remoteConstraints.MaxAcceptedHtlcs = 30
+
+	err = channel.ApplyDynCommitment(localConstraints, remoteConstraints)
+	require.NoError(t, err)
+
+	proposal, err = channel.DynCommitProposal()
+	require.NoError(t, err)
+	require.True(t, proposal.IsNone())
+
+	dynHeight, err = channel.DynCommitHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, dynHeight)
+
+	chanSync, err = channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.Equal(t, fn.Some(lnwire.DynHeight(1)), chanSync.DynHeight)
+
+	channels, err := cdb.FetchOpenChannels(channel.IdentityPub)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	require.Equal(
+		t, localConstraints,
+		channels[0].LocalChanCfg.ChannelConstraints,
+	)
+	require.Equal(
+		t, remoteConstraints,
+		channels[0].RemoteChanCfg.ChannelConstraints,
+	)
+
+	// Finally, deleting a proposal should also remove it.
+	require.NoError(t, channel.StoreDynCommitProposal(msg))
+	require.NoError(t, channel.DeleteDynCommitProposal())
+
+	proposal, err = channel.DynCommitProposal()
+	require.NoError(t, err)
+	require.True(t, proposal.IsNone())
+}
+
+// TestCommitCsvDelay tests that changes of the CSV delays are persisted, and
+// that the delay of each commitment height is the one it was signed with.
+func TestCommitCsvDelay(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb)
+	localHeight := channel.LocalCommitment.CommitHeight
+	remoteHeight := channel.RemoteCommitment.CommitHeight
+	oldLocalDelay := channel.LocalChanCfg.CsvDelay
+	oldRemoteDelay := channel.RemoteChanCfg.CsvDelay
+
+	// Changing only the local delay should only record a change for our
+	// own commitments.
+	localConstraints := channel.LocalChanCfg.ChannelConstraints
+	localConstraints.CsvDelay = oldLocalDelay + 10
+	remoteConstraints := channel.RemoteChanCfg.ChannelConstraints
+
+	err = channel.ApplyDynCommitment(localConstraints, remoteConstraints)
+	require.NoError(t, err)
+	require.Len(t, channel.CsvDelayChanges, 1)
+
+	// Changing the remote delay afterwards records a second change.
+	remoteConstraints.CsvDelay = oldRemoteDelay + 20
+	err = channel.ApplyDynCommitment(localConstraints, remoteConstraints)
+	require.NoError(t, err)
+	require.Len(t, channel.CsvDelayChanges, 2)
+
+	channels, err := cdb.FetchOpenChannels(channel.IdentityPub)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+
+	dbChannel := channels[0]
+	require.Equal(t, channel.CsvDelayChanges, dbChannel.CsvDelayChanges)
+
+	// The current commitments were signed with the old delays, while the
+	// following ones use the new delays.
+	require.Equal(
+		t, oldLocalDelay,
+		dbChannel.CommitCsvDelay(lntypes.Local, localHeight),
+	)
+	require.Equal(
+		t, oldLocalDelay+10,
+		dbChannel.CommitCsvDelay(lntypes.Local, localHeight+1),
+	)
+	require.Equal(
+		t, oldRemoteDelay,
+		dbChannel.CommitCsvDelay(lntypes.Remote, remoteHeight),
+	)
+	require.Equal(
+		t, oldRemoteDelay+20,
+		dbChannel.CommitCsvDelay(lntypes.Remote, remoteHeight+1),
+	)
+}
+
 // TestRefresh asserts that Refresh updates the in-memory state of another
 // OpenChannel to reflect a preceding call to MarkOpen on a different
 // OpenChannel.
@@ -1629,6 +1780,10 @@ func TestHTLCsExtraData(t *testing.T) {
 		),
 	}
 
+	// Endorse a htlc that also has a blinding point.
+	endorsedHTLC := blindingPointHTLC
+	endorsedHTLC.Endorsed = true
+
 	testCases := []struct {
 		name        string
 		htlcs       []HTLC
@@ -1652,6 +1807,14 @@ func TestHTLCsExtraData(t *testing.T) {
 				mockHtlc,
 			},
 		},
+		{
+			// An endorsed HTLC is restored with its endorsement.
+			name: "endorsed",
+			htlcs: []HTLC{
+				endorsedHTLC,
+				mockHtlc,
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,201 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// coFundTxKey is stored in the bucket of a co-funded channel while
+	// it's pending. It maps to the state of the exchange of tx_signatures
+	// for its funding transaction.
+	coFundTxKey = []byte("co-fund-tx-key")
+
+	// ErrCoFundTxNotFound is returned when the funding transaction of a
+	// channel that wasn't co-funded is requested.
+	ErrCoFundTxNotFound = errors.New("co-funded funding tx not found")
+)
+
+// CoFundTx holds the funding transaction of a pending co-funded channel,
+// whose inputs are signed by both parties once the commitments of the channel
+// were signed. It's persisted along with our signatures, so they can be
+// retransmitted if the remote party didn't receive them, and the funding
+// transaction can be rebroadcast once it's final.
+type CoFundTx struct {
+	// FundingTx is the funding transaction of the channel. It only
+	// carries the witnesses of its inputs once both parties exchanged
+	// tx_signatures.
+	FundingTx *wire.MsgTx
+
+	// FundingPsbt is the funding transaction as a PSBT, which carries the
+	// previous outputs of all inputs and the witnesses of our inputs.
+	FundingPsbt []byte
+
+	// LocalTxSigs is our tx_signatures message for the funding
+	// transaction. It's retransmitted on reconnection until the remote
+	// party acknowledged it with its own signatures.
+	LocalTxSigs *lnwire.TxSignatures
+
+	// SignsFirst is true if we send our tx_signatures before we received
+	// the ones of the remote party.
+	SignsFirst bool
+}
+
+// IsFinal returns true if the funding transaction was signed by both parties.
+func (d *CoFundTx) IsFinal() bool {
+	return d.FundingTx.HasWitness()
+}
+
+// HasLocalInputs returns true if we contributed inputs to the funding
+// transaction, which the remote party is able to spend once it received our
+// signatures.
+func (d *CoFundTx) HasLocalInputs() bool {
+	return len(d.LocalTxSigs.Witnesses) > 0
+}
+
+// serializeCoFundTx writes the passed funding transaction state to w.
+func serializeCoFundTx(w io.Writer, d *CoFundTx) error {
+	return WriteElements(
+		w, d.FundingTx, d.FundingPsbt, d.LocalTxSigs, d.SignsFirst,
+	)
+}
+
+// deserializeCoFundTx reads a funding transaction state that was written
+// with serializeCoFundTx from r.
+func deserializeCoFundTx(r io.Reader) (*CoFundTx, error) {
+	d := &CoFundTx{}
+
+	var msg lnwire.Message
+	err := ReadElements(
+		r, &d.FundingTx, &d.FundingPsbt, &msg, &d.SignsFirst,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigs, ok := msg.(*lnwire.TxSignatures)
+	if !ok {
+		return nil, fmt.Errorf("expected tx_signatures, got %T", msg)
+	}
+	d.LocalTxSigs = txSigs
+
+	return d, nil
+}
+
+// PutCoFundTx persists the funding transaction of the pending co-funded
+// channel once we signed its inputs.
+func (c *OpenChannel) PutCoFundTx(coFundTx *CoFundTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeCoFundTx(&b, coFundTx); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(coFundTxKey, b.Bytes())
+	}, func() {})
+}
+
+// FinalizeCoFundTx stores the fully signed funding transaction of the
+// pending co-funded channel, so it can be rebroadcast.
+func (c *OpenChannel) FinalizeCoFundTx(fundingTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		coFundTx, err := fetchCoFundTx(chanBucket)
+		if err != nil {
+			return err
+		}
+
+		txid := coFundTx.FundingTx.TxHash()
+		if fundingTx.TxHash() != txid {
+			return fmt.Errorf("funding tx %v doesn't match %v",
+				fundingTx.TxHash(), txid)
+		}
+		coFundTx.FundingTx = fundingTx
+
+		var b bytes.Buffer
+		if err := serializeCoFundTx(&b, coFundTx); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(coFundTxKey, b.Bytes())
+	}, func() {})
+}
+
+// CoFundTx returns the funding transaction of the co-funded channel. If
+// the channel wasn't co-funded, or we didn't sign the funding transaction
+// yet, ErrCoFundTxNotFound is returned.
+func (c *OpenChannel) CoFundTx() (*CoFundTx, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.coFundTx()
+}
+
+// coFundTx returns the funding transaction of the co-funded channel.
+//
+// NOTE: The channel's mutex MUST be held when calling this method.
+func (c *OpenChannel) coFundTx() (*CoFundTx, error) {
+	var coFundTx *CoFundTx
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNoChanDBExists),
+			errors.Is(err, ErrNoActiveChannels),
+			errors.Is(err, ErrChannelNotFound):
+
+			return ErrCoFundTxNotFound
+		default:
+			return err
+		}
+
+		coFundTx, err = fetchCoFundTx(chanBucket)
+
+		return err
+	}, func() {
+		coFundTx = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return coFundTx, nil
+}
+
+// fetchCoFundTx reads the funding transaction of a co-funded channel from
+// the passed channel bucket.
+func fetchCoFundTx(chanBucket kvdb.RBucket) (*CoFundTx, error) {
+	coFundBytes := chanBucket.Get(coFundTxKey)
+	if coFundBytes == nil {
+		return nil, ErrCoFundTxNotFound
+	}
+
+	return deserializeCoFundTx(bytes.NewReader(coFundBytes))
+}
@@ -0,0 +1,97 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCoFundTx tests that the funding transaction of a pending co-funded
+// channel is persisted along with our signatures, and that the remote party
+// is asked to retransmit its signatures until the transaction is final.
+func TestCoFundTx(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb)
+	require.True(t, channel.IsPending)
+
+	// The channel wasn't co-funded yet.
+	_, err = channel.CoFundTx()
+	require.ErrorIs(t, err, ErrCoFundTxNotFound)
+
+	chanSync, err := channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.True(t, chanSync.NextFundingTxID.IsNone())
+
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash: chainhash.Hash{1},
+	}, nil, nil))
+	fundingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash: chainhash.Hash{2},
+	}, nil, nil))
+	fundingTx.AddTxOut(wire.NewTxOut(2_000_000, []byte{0x00, 0x20}))
+	txid := fundingTx.TxHash()
+
+	localWitness := wire.TxWitness{{0x01}, {0x02}}
+	coFundTx := &CoFundTx{
+		FundingTx:   fundingTx,
+		FundingPsbt: []byte{1, 2, 3},
+		LocalTxSigs: &lnwire.TxSignatures{
+			ChanID: lnwire.NewChanIDFromOutPoint(
+				channel.FundingOutpoint,
+			),
+			TxID:      txid,
+			Witnesses: []wire.TxWitness{localWitness},
+		},
+		SignsFirst: true,
+	}
+	require.NoError(t, channel.PutCoFundTx(coFundTx))
+
+	dbCoFundTx, err := channel.CoFundTx()
+	require.NoError(t, err)
+	require.Equal(t, txid, dbCoFundTx.FundingTx.TxHash())
+	require.Equal(t, coFundTx.FundingPsbt, dbCoFundTx.FundingPsbt)
+	require.Equal(t, coFundTx.LocalTxSigs, dbCoFundTx.LocalTxSigs)
+	require.True(t, dbCoFundTx.SignsFirst)
+	require.False(t, dbCoFundTx.IsFinal())
+	require.True(t, dbCoFundTx.HasLocalInputs())
+
+	// As long as the funding transaction isn't final, the remote party is
+	// asked to retransmit its signatures.
+	chanSync, err = channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.Equal(
+		t, lnwire.NextFundingTxID(txid),
+		chanSync.NextFundingTxID.UnsafeFromSome(),
+	)
+
+	// A different transaction can't finalize the funding transaction.
+	otherTx := fundingTx.Copy()
+	otherTx.LockTime = 1
+	require.Error(t, channel.FinalizeCoFundTx(otherTx))
+
+	signedTx := fundingTx.Copy()
+	signedTx.TxIn[0].Witness = localWitness
+	signedTx.TxIn[1].Witness = wire.TxWitness{{0x03}}
+	require.NoError(t, channel.FinalizeCoFundTx(signedTx))
+
+	dbCoFundTx, err = channel.CoFundTx()
+	require.NoError(t, err)
+	require.True(t, dbCoFundTx.IsFinal())
+	require.Equal(
+		t, signedTx.WitnessHash(), dbCoFundTx.FundingTx.WitnessHash(),
+	)
+
+	chanSync, err = channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.True(t, chanSync.NextFundingTxID.IsNone())
+}
@@ -1339,7 +1339,33 @@ func (c *ChannelStateDB) RestoreChannelShells(channelShells ...*ChannelShell) er
 func (d *DB) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr,
 	error) {
 
-	linkNode, err := d.channelStateDB.linkNodeDB.FetchLinkNode(nodePub)
+	return NewGraphAddrSource(d, d.graph).AddrsForNode(nodePub)
+}
+
+// GraphAddrSource is an address source that consults the link node database
+// of the channel database and the given channel graph for all addresses known
+// to a node. It's used if the channel graph isn't the KV graph of the channel
+// database.
+type GraphAddrSource struct {
+	db    *DB
+	graph GraphStore
+}
+
+// NewGraphAddrSource creates a new address source backed by the link node
+// database of the given channel database and the given channel graph.
+func NewGraphAddrSource(db *DB, graph GraphStore) *GraphAddrSource {
+	return &GraphAddrSource{
+		db:    db,
+		graph: graph,
+	}
+}
+
+// AddrsForNode consults the graph and channel database for all addresses known
+// to the passed node public key.
+func (g *GraphAddrSource) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr,
+	error) {
+
+	linkNode, err := g.db.channelStateDB.linkNodeDB.FetchLinkNode(nodePub)
 	if err != nil {
 		return nil, err
 	}
@@ -1351,7 +1377,7 @@ func (d *DB) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr,
 	if err != nil {
 		return nil, err
 	}
-	graphNode, err := d.graph.FetchLightningNode(pubKey)
+	graphNode, err := g.graph.FetchLightningNode(pubKey)
 	if err != nil && err != ErrGraphNodeNotFound {
 		return nil, err
 	} else if err == ErrGraphNodeNotFound {
@@ -0,0 +1,280 @@
+package channeldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+// MigrateGraphToSQL copies the channel graph of the KV database to the native
+// SQL graph store. This includes all nodes, the source node, all channels
+// together with their policies, the zombie index and the prune log. The
+// graph is copied within a single SQL transaction, so the migration either
+// completes or leaves the SQL graph untouched. As the source node is set last,
+// the migration is skipped if the SQL graph already has a source node, which
+// makes calling this function after the migration completed a noop.
+func MigrateGraphToSQL(ctx context.Context, kvGraph *ChannelGraph,
+	db BatchedSQLGraphQueries) error {
+
+	// If the KV graph doesn't have a source node, then lnd never started
+	// with it and there's nothing to migrate.
+	sourceNode, err := kvGraph.SourceNode()
+	switch {
+	case errors.Is(err, ErrSourceNodeNotSet),
+		errors.Is(err, ErrGraphNotFound):
+
+		log.Debugf("No KV channel graph found, skipping graph " +
+			"migration to SQL")
+
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to fetch KV source node: %w", err)
+	}
+
+	startTime := time.Now()
+	var numNodes, numChannels, numZombies, numPruneEntries int
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err = db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		_, err := db.GetSourceNode(ctx)
+		switch {
+		case err == nil:
+			log.Debugf("Graph migration to SQL already completed")
+
+			return nil
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+
+		log.Infof("Migrating channel graph to SQL")
+
+		numNodes, err = migrateGraphNodes(ctx, kvGraph, db)
+		if err != nil {
+			return fmt.Errorf("unable to migrate nodes: %w", err)
+		}
+
+		numChannels, err = migrateGraphChannels(ctx, kvGraph, db)
+		if err != nil {
+			return fmt.Errorf("unable to migrate channels: %w", err)
+		}
+
+		numZombies, err = migrateZombieIndex(ctx, kvGraph, db)
+		if err != nil {
+			return fmt.Errorf("unable to migrate zombie index: %w",
+				err)
+		}
+
+		numPruneEntries, err = migratePruneLog(ctx, kvGraph, db)
+		if err != nil {
+			return fmt.Errorf("unable to migrate prune log: %w",
+				err)
+		}
+
+		return db.InsertSourceNode(ctx, sourceNode.PubKeyBytes[:])
+	}, func() {
+		numNodes, numChannels, numZombies, numPruneEntries = 0, 0, 0, 0
+	})
+	if err != nil {
+		return err
+	}
+
+	if numNodes > 0 {
+		log.Infof("Migrated %d nodes, %d channels, %d zombie channels "+
+			"and %d prune log entries to SQL in %v", numNodes,
+			numChannels, numZombies, numPruneEntries,
+			time.Since(startTime))
+	}
+
+	return nil
+}
+
+// migrateGraphNodes copies all nodes of the KV graph, including the shell
+// nodes, to the SQL graph. The number of migrated nodes is returned.
+func migrateGraphNodes(ctx context.Context, kvGraph *ChannelGraph,
+	db SQLGraphQueries) (int, error) {
+
+	var numNodes int
+	err := kvGraph.ForEachNode(func(_ kvdb.RTx, node *LightningNode) error {
+		params, err := marshalSQLNode(node)
+		if err != nil {
+			return err
+		}
+
+		if err := db.UpsertNode(ctx, params); err != nil {
+			return fmt.Errorf("unable to insert node %x: %w",
+				node.PubKeyBytes, err)
+		}
+		numNodes++
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numNodes, nil
+}
+
+// migrateGraphChannels copies all channels of the KV graph together with
+// their policies to the SQL graph. The number of migrated channels is
+// returned.
+func migrateGraphChannels(ctx context.Context, kvGraph *ChannelGraph,
+	db SQLGraphQueries) (int, error) {
+
+	var numChannels int
+	err := kvGraph.ForEachChannel(func(info *models.ChannelEdgeInfo,
+		policy1, policy2 *models.ChannelEdgePolicy) error {
+
+		params, err := marshalSQLChannel(info)
+		if err != nil {
+			return err
+		}
+
+		// The KV graph always creates shell nodes for the nodes of a
+		// channel, but we make sure they exist to not violate the
+		// foreign keys of the channel.
+		err = db.InsertShellNode(ctx, info.NodeKey1Bytes[:])
+		if err != nil {
+			return err
+		}
+		err = db.InsertShellNode(ctx, info.NodeKey2Bytes[:])
+		if err != nil {
+			return err
+		}
+
+		if err := db.InsertChannel(ctx, params); err != nil {
+			return fmt.Errorf("unable to insert channel %v: %w",
+				info.ChannelID, err)
+		}
+
+		row, err := db.GetChannelBySCID(ctx, params.Scid)
+		if err != nil {
+			return err
+		}
+
+		policies := []*models.ChannelEdgePolicy{policy1, policy2}
+		for _, policy := range policies {
+			if policy == nil {
+				continue
+			}
+
+			err := db.UpsertChannelPolicy(
+				ctx, marshalSQLPolicy(row.ID, policy),
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert policy of "+
+					"channel %v: %w", info.ChannelID, err)
+			}
+		}
+		numChannels++
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numChannels, nil
+}
+
+// migrateZombieIndex copies the zombie index of the KV graph to the SQL graph.
+// The number of migrated zombie channels is returned.
+func migrateZombieIndex(ctx context.Context, kvGraph *ChannelGraph,
+	db SQLGraphQueries) (int, error) {
+
+	var numZombies int
+	err := kvdb.View(kvGraph.db, func(tx kvdb.RTx) error {
+		edges := tx.ReadBucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+
+		zombieIndex := edges.NestedReadBucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		// Each entry maps the channel ID to the public keys of the
+		// two nodes of the channel.
+		return zombieIndex.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || len(v) != 66 {
+				return nil
+			}
+
+			err := db.UpsertZombieChannel(
+				ctx, sqlc.UpsertZombieChannelParams{
+					Scid:     k,
+					NodeKey1: v[:33],
+					NodeKey2: v[33:],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			numZombies++
+
+			return nil
+		})
+	}, func() {
+		numZombies = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numZombies, nil
+}
+
+// migratePruneLog copies the prune log of the KV graph to the SQL graph. The
+// number of migrated prune log entries is returned.
+func migratePruneLog(ctx context.Context, kvGraph *ChannelGraph,
+	db SQLGraphQueries) (int, error) {
+
+	var numEntries int
+	err := kvdb.View(kvGraph.db, func(tx kvdb.RTx) error {
+		graphMeta := tx.ReadBucket(graphMetaBucket)
+		if graphMeta == nil {
+			return nil
+		}
+
+		pruneBucket := graphMeta.NestedReadBucket(pruneLogBucket)
+		if pruneBucket == nil {
+			return nil
+		}
+
+		// Each entry maps the block height to the block hash.
+		return pruneBucket.ForEach(func(k, v []byte) error {
+			if len(k) != 4 {
+				return nil
+			}
+
+			err := db.UpsertPruneLogEntry(
+				ctx, sqlc.UpsertPruneLogEntryParams{
+					BlockHeight: int64(byteOrder.Uint32(k)),
+					BlockHash:   v,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			numEntries++
+
+			return nil
+		})
+	}, func() {
+		numEntries = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numEntries, nil
+}
@@ -0,0 +1,121 @@
+package channeldb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrateGraphToSQL tests that the KV channel graph is copied to the SQL
+// graph store and that the migration is only executed once.
+func TestMigrateGraphToSQL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	kvGraph, err := MakeTestGraph(t)
+	require.NoError(t, err)
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) SQLGraphQueries {
+			return db.WithTx(tx)
+		},
+	)
+
+	// Without a source node, there's nothing to migrate.
+	require.NoError(t, MigrateGraphToSQL(ctx, kvGraph, executor))
+
+	sqlGraph := newTestSQLGraphStore(t, db, false)
+	_, err = sqlGraph.SourceNode()
+	require.ErrorIs(t, err, ErrSourceNodeNotSet)
+
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, kvGraph.SetSourceNode(sourceNode))
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, kvGraph.AddLightningNode(node1))
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, kvGraph.AddLightningNode(node2))
+
+	edgeInfo, edge1, edge2 := createChannelEdge(nil, node1, node2)
+	require.NoError(t, kvGraph.AddChannelEdge(edgeInfo))
+	require.NoError(t, kvGraph.UpdateEdgePolicy(edge1))
+	require.NoError(t, kvGraph.UpdateEdgePolicy(edge2))
+
+	// The channel to the source node only has a single policy.
+	sourceInfo, sourceEdge, _ := createChannelEdge(nil, sourceNode, node1)
+	sourceInfo.ChannelPoint.Index = 1
+	sourceEdge.ChannelID = sourceInfo.ChannelID
+	require.NoError(t, kvGraph.AddChannelEdge(sourceInfo))
+	require.NoError(t, kvGraph.UpdateEdgePolicy(sourceEdge))
+
+	zombieInfo, _, _ := createChannelEdge(nil, node1, node2)
+	zombieInfo.ChannelPoint.Index = 2
+	require.NoError(t, kvGraph.AddChannelEdge(zombieInfo))
+	err = kvGraph.DeleteChannelEdges(false, true, zombieInfo.ChannelID)
+	require.NoError(t, err)
+
+	blockHash := chainhash.Hash{1, 2, 3}
+	_, err = kvGraph.PruneGraph(nil, &blockHash, 100)
+	require.NoError(t, err)
+
+	require.NoError(t, MigrateGraphToSQL(ctx, kvGraph, executor))
+
+	dbSource, err := sqlGraph.SourceNode()
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(sourceNode, dbSource))
+
+	for _, node := range []*LightningNode{node1, node2} {
+		dbNode, err := sqlGraph.FetchLightningNode(node.PubKeyBytes)
+		require.NoError(t, err)
+		require.NoError(t, compareNodes(node, dbNode))
+	}
+
+	dbInfo, dbEdge1, dbEdge2, err := sqlGraph.FetchChannelEdgesByID(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, edgeInfo, dbInfo)
+	require.NoError(t, compareEdgePolicies(edge1, dbEdge1))
+	require.NoError(t, compareEdgePolicies(edge2, dbEdge2))
+
+	var numChans int
+	err = sqlGraph.ForEachChannel(func(*models.ChannelEdgeInfo,
+		*models.ChannelEdgePolicy, *models.ChannelEdgePolicy) error {
+
+		numChans++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numChans)
+
+	isZombie, pub1, pub2 := sqlGraph.IsZombieEdge(zombieInfo.ChannelID)
+	require.True(t, isZombie)
+	require.Equal(t, zombieInfo.NodeKey1Bytes, pub1)
+	require.Equal(t, zombieInfo.NodeKey2Bytes, pub2)
+
+	tipHash, tipHeight, err := sqlGraph.PruneTip()
+	require.NoError(t, err)
+	require.Equal(t, blockHash, *tipHash)
+	require.EqualValues(t, 100, tipHeight)
+
+	// Once the source node is set, the migration isn't executed again, so
+	// nodes added to the KV graph afterwards aren't copied.
+	node3, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, kvGraph.AddLightningNode(node3))
+
+	require.NoError(t, MigrateGraphToSQL(ctx, kvGraph, executor))
+
+	_, err = sqlGraph.FetchLightningNode(node3.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+}
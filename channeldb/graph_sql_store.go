@@ -0,0 +1,2377 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image/color"
+	"net"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/aliasmgr"
+	"github.com/lightningnetwork/lnd/batch"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+const (
+	// graphSQLPageSize is the number of nodes or channels that are fetched
+	// at once when iterating over the whole graph.
+	graphSQLPageSize = 1000
+)
+
+// SQLGraphQueries is an interface that defines the set of operations that can
+// be executed against the channel graph SQL database.
+type SQLGraphQueries interface { //nolint:interfacebloat
+	// Node specific methods.
+	UpsertNode(ctx context.Context, arg sqlc.UpsertNodeParams) error
+
+	InsertShellNode(ctx context.Context, pubKey []byte) error
+
+	GetNodeByPubKey(ctx context.Context, pubKey []byte) (sqlc.GraphNode,
+		error)
+
+	ListNodesPaginated(ctx context.Context,
+		arg sqlc.ListNodesPaginatedParams) ([]sqlc.GraphNode, error)
+
+	ListNodesUpdatedInRange(ctx context.Context,
+		arg sqlc.ListNodesUpdatedInRangeParams) ([]sqlc.GraphNode,
+		error)
+
+	DeleteUnconnectedNodes(ctx context.Context) ([][]byte, error)
+
+	GetSourceNode(ctx context.Context) (sqlc.GraphNode, error)
+
+	DeleteSourceNodes(ctx context.Context) error
+
+	InsertSourceNode(ctx context.Context, pubKey []byte) error
+
+	// Channel specific methods.
+	InsertChannel(ctx context.Context, arg sqlc.InsertChannelParams) error
+
+	UpdateChannel(ctx context.Context, arg sqlc.UpdateChannelParams) error
+
+	GetChannelBySCID(ctx context.Context, scid []byte) (sqlc.GraphChannel,
+		error)
+
+	GetChannelByOutpoint(ctx context.Context,
+		outpoint string) (sqlc.GraphChannel, error)
+
+	DeleteChannel(ctx context.Context, id int64) error
+
+	ListChannelsPaginated(ctx context.Context,
+		arg sqlc.ListChannelsPaginatedParams) ([]sqlc.GraphChannel,
+		error)
+
+	ListNodeChannels(ctx context.Context,
+		nodeKey []byte) ([]sqlc.GraphChannel, error)
+
+	ListChannelsInSCIDRange(ctx context.Context,
+		arg sqlc.ListChannelsInSCIDRangeParams) ([]sqlc.GraphChannel,
+		error)
+
+	ListChannelsUpdatedInRange(ctx context.Context,
+		arg sqlc.ListChannelsUpdatedInRangeParams) ([]sqlc.GraphChannel,
+		error)
+
+	ListDisabledChannelSCIDs(ctx context.Context) ([][]byte, error)
+
+	GetHighestSCID(ctx context.Context) ([]byte, error)
+
+	// Channel policy specific methods.
+	UpsertChannelPolicy(ctx context.Context,
+		arg sqlc.UpsertChannelPolicyParams) error
+
+	GetChannelPolicies(ctx context.Context,
+		channelID int64) ([]sqlc.GraphChannelPolicy, error)
+
+	ListChannelPoliciesInRange(ctx context.Context,
+		arg sqlc.ListChannelPoliciesInRangeParams) (
+		[]sqlc.GraphChannelPolicy, error)
+
+	ListNodeChannelPolicies(ctx context.Context,
+		nodeKey []byte) ([]sqlc.GraphChannelPolicy, error)
+
+	// Zombie index specific methods.
+	UpsertZombieChannel(ctx context.Context,
+		arg sqlc.UpsertZombieChannelParams) error
+
+	GetZombieChannel(ctx context.Context,
+		scid []byte) (sqlc.GraphZombieChannel, error)
+
+	DeleteZombieChannel(ctx context.Context, scid []byte) (sql.Result,
+		error)
+
+	CountZombieChannels(ctx context.Context) (int64, error)
+
+	// Closed SCID specific methods.
+	InsertClosedSCID(ctx context.Context, scid []byte) error
+
+	GetClosedSCID(ctx context.Context, scid []byte) (sqlc.GraphClosedScid,
+		error)
+
+	// Prune log specific methods.
+	UpsertPruneLogEntry(ctx context.Context,
+		arg sqlc.UpsertPruneLogEntryParams) error
+
+	GetPruneTip(ctx context.Context) (sqlc.GraphPruneLog, error)
+
+	DeletePruneLogEntriesFrom(ctx context.Context, blockHeight int64) error
+}
+
+// SQLGraphQueriesTxOptions defines the set of db txn options the
+// SQLGraphQueries understands.
+type SQLGraphQueriesTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions.
+func (a *SQLGraphQueriesTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewSQLGraphQueryReadTx creates a new read transaction option set.
+func NewSQLGraphQueryReadTx() SQLGraphQueriesTxOptions {
+	return SQLGraphQueriesTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedSQLGraphQueries is a version of the SQLGraphQueries that's capable
+// of batched database operations.
+type BatchedSQLGraphQueries interface {
+	SQLGraphQueries
+
+	sqldb.BatchedTx[SQLGraphQueries]
+}
+
+// SQLGraphStore is a channel graph that keeps its nodes, channels and
+// policies in a native SQL database. Lookups are answered by indexed queries,
+// and the optional in-memory graph cache is populated from paginated queries
+// over all nodes and channels.
+type SQLGraphStore struct {
+	db BatchedSQLGraphQueries
+
+	// graphCache is the in-memory graph used for path finding. It is nil
+	// if the cache is disabled.
+	graphCache *GraphCache
+}
+
+// NewSQLGraphStore creates a new SQLGraphStore given an open
+// BatchedSQLGraphQueries storage backend. If useGraphCache is set, the
+// in-memory graph cache is populated from the database.
+func NewSQLGraphStore(db BatchedSQLGraphQueries, preAllocCacheNumNodes int,
+	useGraphCache bool) (*SQLGraphStore, error) {
+
+	s := &SQLGraphStore{
+		db: db,
+	}
+
+	if !useGraphCache {
+		return s, nil
+	}
+
+	graphCache := NewGraphCache(preAllocCacheNumNodes)
+	startTime := time.Now()
+	log.Debugf("Populating in-memory channel graph from SQL database")
+
+	err := s.ForEachNode(func(_ kvdb.RTx, node *LightningNode) error {
+		graphCache.AddNodeFeatures(
+			newGraphCacheNode(node.PubKeyBytes, node.Features),
+		)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ForEachChannel(func(info *models.ChannelEdgeInfo,
+		policy1, policy2 *models.ChannelEdgePolicy) error {
+
+		graphCache.AddChannel(info, policy1, policy2)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Finished populating in-memory channel graph (took %v, %s)",
+		time.Since(startTime), graphCache.Stats())
+
+	s.graphCache = graphCache
+
+	return s, nil
+}
+
+// NewPathFindTx returns a new read transaction that can be used for a single
+// path finding session. As the SQL store doesn't use kvdb transactions, nil
+// is always returned and every query runs in its own transaction.
+func (s *SQLGraphStore) NewPathFindTx() (kvdb.RTx, error) {
+	return nil, nil
+}
+
+// ForEachNodeDirectedChannel iterates through all channels of a given node,
+// executing the passed callback on the directed edge representing the channel
+// and its incoming policy. If the callback returns an error, then the
+// iteration is halted with the error propagated back up to the caller. The
+// passed transaction is ignored.
+//
+// Unknown policies are passed into the callback as nil values.
+func (s *SQLGraphStore) ForEachNodeDirectedChannel(_ kvdb.RTx,
+	node route.Vertex, cb func(channel *DirectedChannel) error) error {
+
+	if s.graphCache != nil {
+		return s.graphCache.ForEachChannel(node, cb)
+	}
+
+	toNodeFeatures, err := s.FetchNodeFeatures(node)
+	if err != nil {
+		return err
+	}
+	toNodeCallback := func() route.Vertex {
+		return node
+	}
+
+	return s.ForEachNodeChannel(node, func(_ kvdb.RTx,
+		e *models.ChannelEdgeInfo, p1,
+		p2 *models.ChannelEdgePolicy) error {
+
+		var cachedInPolicy *models.CachedEdgePolicy
+		if p2 != nil {
+			cachedInPolicy = models.NewCachedPolicy(p2)
+			cachedInPolicy.ToNodePubKey = toNodeCallback
+			cachedInPolicy.ToNodeFeatures = toNodeFeatures
+		}
+
+		var inboundFee lnwire.Fee
+		if p1 != nil {
+			// Extract inbound fee. If there is a decoding error,
+			// skip this edge.
+			_, err := p1.ExtraOpaqueData.ExtractRecords(&inboundFee)
+			if err != nil {
+				return nil
+			}
+		}
+
+		directedChannel := &DirectedChannel{
+			ChannelID:    e.ChannelID,
+			IsNode1:      node == e.NodeKey1Bytes,
+			OtherNode:    e.NodeKey2Bytes,
+			Capacity:     e.Capacity,
+			OutPolicySet: p1 != nil,
+			InPolicy:     cachedInPolicy,
+			InboundFee:   inboundFee,
+		}
+
+		if node == e.NodeKey2Bytes {
+			directedChannel.OtherNode = e.NodeKey1Bytes
+		}
+
+		return cb(directedChannel)
+	})
+}
+
+// FetchNodeFeatures returns the features of a given node. If no features are
+// known for the node, an empty feature vector is returned.
+func (s *SQLGraphStore) FetchNodeFeatures(
+	node route.Vertex) (*lnwire.FeatureVector, error) {
+
+	if s.graphCache != nil {
+		return s.graphCache.GetFeatures(node), nil
+	}
+
+	targetNode, err := s.FetchLightningNode(node)
+	switch {
+	case err == nil:
+		return targetNode.Features, nil
+
+	case errors.Is(err, ErrGraphNodeNotFound):
+		return lnwire.EmptyFeatureVector(), nil
+
+	default:
+		return nil, err
+	}
+}
+
+// ForEachNode iterates through all the stored vertices/nodes in the graph,
+// executing the passed callback with each node encountered. The nodes are
+// fetched in pages, and the callback is executed outside of the database
+// transaction with a nil kvdb.RTx.
+func (s *SQLGraphStore) ForEachNode(
+	cb func(kvdb.RTx, *LightningNode) error) error {
+
+	ctx := context.TODO()
+
+	var lastID int64
+	for {
+		nodes, nextID, err := s.fetchNodePage(ctx, lastID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch nodes: %w", err)
+		}
+
+		for _, node := range nodes {
+			if err := cb(nil, node); err != nil {
+				return err
+			}
+		}
+
+		if len(nodes) < graphSQLPageSize {
+			return nil
+		}
+		lastID = nextID
+	}
+}
+
+// fetchNodePage fetches the next page of nodes that have a database ID larger
+// than the given one. The database ID of the last node of the page is
+// returned as well.
+func (s *SQLGraphStore) fetchNodePage(ctx context.Context,
+	lastID int64) ([]*LightningNode, int64, error) {
+
+	var (
+		nodes  []*LightningNode
+		nextID int64
+	)
+
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		rows, err := db.ListNodesPaginated(
+			ctx, sqlc.ListNodesPaginatedParams{
+				ID:    lastID,
+				Limit: graphSQLPageSize,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			node, err := unmarshalSQLNode(row)
+			if err != nil {
+				return err
+			}
+
+			nodes = append(nodes, node)
+			nextID = row.ID
+		}
+
+		return nil
+	}, func() {
+		nodes = nil
+		nextID = 0
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nodes, nextID, nil
+}
+
+// ForEachChannel iterates through all the channel edges stored within the
+// graph and invokes the passed callback for each edge. The channels and their
+// policies are fetched in pages, and the callback is executed outside of the
+// database transaction.
+//
+// NOTE: If an edge can't be found, or wasn't advertised, then a nil pointer
+// for that particular channel edge routing policy will be passed into the
+// callback.
+func (s *SQLGraphStore) ForEachChannel(cb func(*models.ChannelEdgeInfo,
+	*models.ChannelEdgePolicy, *models.ChannelEdgePolicy) error) error {
+
+	ctx := context.TODO()
+
+	var lastID int64
+	for {
+		channels, nextID, err := s.fetchChannelPage(ctx, lastID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch channels: %w", err)
+		}
+
+		for _, c := range channels {
+			if err := cb(c.Info, c.Policy1, c.Policy2); err != nil {
+				return err
+			}
+		}
+
+		if len(channels) < graphSQLPageSize {
+			return nil
+		}
+		lastID = nextID
+	}
+}
+
+// fetchChannelPage fetches the next page of channels, together with their
+// policies, that have a database ID larger than the given one. The database
+// ID of the last channel of the page is returned as well.
+func (s *SQLGraphStore) fetchChannelPage(ctx context.Context,
+	lastID int64) ([]ChannelEdge, int64, error) {
+
+	var (
+		channels []ChannelEdge
+		nextID   int64
+	)
+
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		rows, err := db.ListChannelsPaginated(
+			ctx, sqlc.ListChannelsPaginatedParams{
+				ID:    lastID,
+				Limit: graphSQLPageSize,
+			},
+		)
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+		nextID = rows[len(rows)-1].ID
+
+		// Fetch the policies of all channels of this page with a
+		// single range query over the channel IDs.
+		policies, err := db.ListChannelPoliciesInRange(
+			ctx, sqlc.ListChannelPoliciesInRangeParams{
+				StartID: lastID,
+				EndID:   nextID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		channels, err = unmarshalSQLChannels(rows, policies)
+
+		return err
+	}, func() {
+		channels = nil
+		nextID = 0
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return channels, nextID, nil
+}
+
+// ForEachNodeChannel iterates through all channels of the given node,
+// executing the passed callback with an edge info structure and the policies
+// of each end of the channel. The first edge policy is the outgoing edge *to*
+// the connecting node, while the second is the incoming edge *from* the
+// connecting node. The callback is executed with a nil kvdb.RTx.
+//
+// Unknown policies are passed into the callback as nil values.
+func (s *SQLGraphStore) ForEachNodeChannel(nodePub route.Vertex,
+	cb func(kvdb.RTx, *models.ChannelEdgeInfo, *models.ChannelEdgePolicy,
+		*models.ChannelEdgePolicy) error) error {
+
+	ctx := context.TODO()
+
+	var channels []ChannelEdge
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		var err error
+		channels, err = fetchSQLNodeChannels(ctx, db, nodePub)
+
+		return err
+	}, func() {
+		channels = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		outPolicy, inPolicy := channel.Policy1, channel.Policy2
+		if channel.Info.NodeKey2Bytes == nodePub {
+			outPolicy, inPolicy = inPolicy, outPolicy
+		}
+
+		err := cb(nil, channel.Info, outPolicy, inPolicy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ForEachNodeChannelTx iterates through all channels of the given node like
+// ForEachNodeChannel. The passed transaction is ignored.
+func (s *SQLGraphStore) ForEachNodeChannelTx(_ kvdb.RTx, nodePub route.Vertex,
+	cb func(kvdb.RTx, *models.ChannelEdgeInfo, *models.ChannelEdgePolicy,
+		*models.ChannelEdgePolicy) error) error {
+
+	return s.ForEachNodeChannel(nodePub, cb)
+}
+
+// FetchOtherNode attempts to fetch the full LightningNode that's opposite of
+// the target node in the channel. The passed transaction is ignored.
+func (s *SQLGraphStore) FetchOtherNode(_ kvdb.RTx,
+	channel *models.ChannelEdgeInfo, thisNodeKey []byte) (*LightningNode,
+	error) {
+
+	var targetNode route.Vertex
+	switch {
+	case bytes.Equal(channel.NodeKey1Bytes[:], thisNodeKey):
+		targetNode = channel.NodeKey2Bytes
+	case bytes.Equal(channel.NodeKey2Bytes[:], thisNodeKey):
+		targetNode = channel.NodeKey1Bytes
+	default:
+		return nil, fmt.Errorf("node not participating in this channel")
+	}
+
+	return s.FetchLightningNode(targetNode)
+}
+
+// ForEachNodeCached is similar to ForEachNode, but it utilizes the channel
+// graph cache instead. If the cache is disabled, the directed channels of
+// each node are fetched from the database.
+//
+// NOTE: The callback contents MUST not be modified.
+func (s *SQLGraphStore) ForEachNodeCached(cb func(node route.Vertex,
+	chans map[uint64]*DirectedChannel) error) error {
+
+	if s.graphCache != nil {
+		return s.graphCache.ForEachNode(cb)
+	}
+
+	return s.ForEachNode(func(_ kvdb.RTx, node *LightningNode) error {
+		channels := make(map[uint64]*DirectedChannel)
+		err := s.ForEachNodeDirectedChannel(nil, node.PubKeyBytes,
+			func(channel *DirectedChannel) error {
+				channels[channel.ChannelID] = channel
+
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		return cb(node.PubKeyBytes, channels)
+	})
+}
+
+// SourceNode returns the source node of the graph.
+func (s *SQLGraphStore) SourceNode() (*LightningNode, error) {
+	ctx := context.TODO()
+
+	var node *LightningNode
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		row, err := db.GetSourceNode(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSourceNodeNotSet
+		} else if err != nil {
+			return err
+		}
+
+		node, err = unmarshalSQLNode(row)
+
+		return err
+	}, func() {
+		node = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// SetSourceNode sets the source node within the graph database. The source
+// node is to be used as the center of a star-graph within path finding
+// algorithms.
+func (s *SQLGraphStore) SetSourceNode(node *LightningNode) error {
+	ctx := context.TODO()
+
+	params, err := marshalSQLNode(node)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		if err := db.UpsertNode(ctx, params); err != nil {
+			return err
+		}
+
+		if err := db.DeleteSourceNodes(ctx); err != nil {
+			return err
+		}
+
+		return db.InsertSourceNode(ctx, node.PubKeyBytes[:])
+	}, func() {})
+}
+
+// AddLightningNode adds a vertex/node to the graph database. If the node is
+// not in the database from before, this will add a new, unconnected one to
+// the graph. If it is present from before, this will update that node's
+// information.
+//
+// NOTE: The batch scheduler options are ignored as the node is written
+// directly.
+func (s *SQLGraphStore) AddLightningNode(node *LightningNode,
+	_ ...batch.SchedulerOption) error {
+
+	ctx := context.TODO()
+
+	params, err := marshalSQLNode(node)
+	if err != nil {
+		return err
+	}
+
+	var channels []ChannelEdge
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err = s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		if err := db.UpsertNode(ctx, params); err != nil {
+			return err
+		}
+
+		// The cache needs the channels of the node in case it was
+		// pruned from the cache before.
+		if s.graphCache == nil {
+			return nil
+		}
+
+		var err error
+		channels, err = fetchSQLNodeChannels(ctx, db, node.PubKeyBytes)
+
+		return err
+	}, func() {
+		channels = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.AddNodeFeatures(
+			newGraphCacheNode(node.PubKeyBytes, node.Features),
+		)
+
+		for _, channel := range channels {
+			s.graphCache.AddChannel(
+				channel.Info, channel.Policy1, channel.Policy2,
+			)
+		}
+	}
+
+	return nil
+}
+
+// HasLightningNode determines if the graph has a vertex identified by the
+// target node identity public key. If the node exists in the database, a
+// timestamp of when the data for the node was lasted updated is returned
+// along with a true boolean. Otherwise, an empty time.Time is returned with a
+// false boolean.
+func (s *SQLGraphStore) HasLightningNode(nodePub [33]byte) (time.Time, bool,
+	error) {
+
+	node, err := s.FetchLightningNode(nodePub)
+	switch {
+	case errors.Is(err, ErrGraphNodeNotFound):
+		return time.Time{}, false, nil
+
+	case err != nil:
+		return time.Time{}, false, err
+	}
+
+	return node.LastUpdate, true, nil
+}
+
+// FetchLightningNode attempts to look up a target node by its identity public
+// key. If the node isn't found in the database, then ErrGraphNodeNotFound is
+// returned.
+func (s *SQLGraphStore) FetchLightningNode(nodePub route.Vertex) (
+	*LightningNode, error) {
+
+	ctx := context.TODO()
+
+	var node *LightningNode
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		var err error
+		node, err = fetchSQLNode(ctx, db, nodePub[:])
+
+		return err
+	}, func() {
+		node = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// FetchLightningNodeTx attempts to look up a target node by its identity
+// public key. The passed transaction is ignored.
+func (s *SQLGraphStore) FetchLightningNodeTx(_ kvdb.RTx,
+	nodePub route.Vertex) (*LightningNode, error) {
+
+	return s.FetchLightningNode(nodePub)
+}
+
+// IsPublicNode is a helper method that determines whether the node with the
+// given public key is seen as a public node in the graph from the graph's
+// source node's point of view.
+func (s *SQLGraphStore) IsPublicNode(pubKey [33]byte) (bool, error) {
+	ctx := context.TODO()
+
+	var isPublic bool
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		source, err := db.GetSourceNode(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSourceNodeNotSet
+		} else if err != nil {
+			return err
+		}
+
+		if _, err := fetchSQLNode(ctx, db, pubKey[:]); err != nil {
+			return err
+		}
+
+		channels, err := db.ListNodeChannels(ctx, pubKey[:])
+		if err != nil {
+			return err
+		}
+
+		// The node is public if it has a channel to any node other
+		// than the source node, or an announced channel to the source
+		// node.
+		for _, channel := range channels {
+			if !bytes.Equal(channel.NodeKey1, source.PubKey) &&
+				!bytes.Equal(channel.NodeKey2, source.PubKey) {
+
+				isPublic = true
+				return nil
+			}
+
+			if len(channel.NodeSig1) != 0 {
+				isPublic = true
+				return nil
+			}
+		}
+
+		return nil
+	}, func() {
+		isPublic = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isPublic, nil
+}
+
+// LookupAlias attempts to return the alias as advertised by the target node.
+// ErrNodeAliasNotFound is returned if we don't have a node announcement of
+// the node.
+func (s *SQLGraphStore) LookupAlias(pub *btcec.PublicKey) (string, error) {
+	ctx := context.TODO()
+
+	var alias string
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		row, err := db.GetNodeByPubKey(ctx, pub.SerializeCompressed())
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNodeAliasNotFound
+		} else if err != nil {
+			return err
+		}
+
+		if !row.HaveAnnouncement {
+			return ErrNodeAliasNotFound
+		}
+		alias = row.Alias.String
+
+		return nil
+	}, func() {
+		alias = ""
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return alias, nil
+}
+
+// AddChannelEdge adds a new (undirected, blank) edge to the graph database. If
+// either of the nodes of the channel is unknown, a shell node is inserted for
+// it.
+//
+// NOTE: The batch scheduler options are ignored as the channel is written
+// directly.
+func (s *SQLGraphStore) AddChannelEdge(edge *models.ChannelEdgeInfo,
+	_ ...batch.SchedulerOption) error {
+
+	ctx := context.TODO()
+
+	params, err := marshalSQLChannel(edge)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err = s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		_, err := db.GetChannelBySCID(ctx, params.Scid)
+		switch {
+		case err == nil:
+			return ErrEdgeAlreadyExist
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+
+		err = db.InsertShellNode(ctx, edge.NodeKey1Bytes[:])
+		if err != nil {
+			return fmt.Errorf("unable to create shell node for: "+
+				"%x: %w", edge.NodeKey1Bytes, err)
+		}
+
+		err = db.InsertShellNode(ctx, edge.NodeKey2Bytes[:])
+		if err != nil {
+			return fmt.Errorf("unable to create shell node for: "+
+				"%x: %w", edge.NodeKey2Bytes, err)
+		}
+
+		return db.InsertChannel(ctx, params)
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.AddChannel(edge, nil, nil)
+	}
+
+	return nil
+}
+
+// UpdateChannelEdge retrieves and update edge of the graph database. Method
+// only reserved for updating an edge info after its already been created. In
+// order to maintain this constraints, we return an error in the scenario that
+// an edge info hasn't yet been created yet, but someone attempts to update
+// it.
+func (s *SQLGraphStore) UpdateChannelEdge(edge *models.ChannelEdgeInfo) error {
+	ctx := context.TODO()
+
+	params, err := marshalSQLChannel(edge)
+	if err != nil {
+		return err
+	}
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err = s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		_, err := db.GetChannelBySCID(ctx, params.Scid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrEdgeNotFound
+		} else if err != nil {
+			return err
+		}
+
+		return db.UpdateChannel(ctx, sqlc.UpdateChannelParams(params))
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.UpdateChannel(edge)
+	}
+
+	return nil
+}
+
+// UpdateEdgePolicy updates the edge routing policy for a single directed edge
+// within the database for the referenced channel. The direction bit of the
+// channel flags determines which of the directed edges is updated.
+//
+// NOTE: The batch scheduler options are ignored as the policy is written
+// directly.
+func (s *SQLGraphStore) UpdateEdgePolicy(edge *models.ChannelEdgePolicy,
+	_ ...batch.SchedulerOption) error {
+
+	ctx := context.TODO()
+
+	if len(edge.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
+		return ErrTooManyExtraOpaqueBytes(len(edge.ExtraOpaqueData))
+	}
+
+	isUpdate1 := edge.ChannelFlags&lnwire.ChanUpdateDirection == 0
+
+	var fromNode, toNode route.Vertex
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		scid := sqlSCID(edge.ChannelID)
+
+		channel, err := db.GetChannelBySCID(ctx, scid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrEdgeNotFound
+		} else if err != nil {
+			return err
+		}
+
+		copy(fromNode[:], channel.NodeKey1)
+		copy(toNode[:], channel.NodeKey2)
+		if !isUpdate1 {
+			fromNode, toNode = toNode, fromNode
+		}
+
+		return db.UpsertChannelPolicy(
+			ctx, marshalSQLPolicy(channel.ID, edge),
+		)
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.UpdatePolicy(edge, fromNode, toNode, isUpdate1)
+	}
+
+	return nil
+}
+
+// HasChannelEdge returns true if the database knows of a channel edge with the
+// passed channel ID, and false otherwise. If an edge with that ID is found
+// within the graph, then two time stamps representing the last time the edge
+// was updated for both directed edges are returned along with the boolean. If
+// it is not found, then the zombie index is checked and its result is returned
+// as the second boolean.
+func (s *SQLGraphStore) HasChannelEdge(
+	chanID uint64) (time.Time, time.Time, bool, bool, error) {
+
+	ctx := context.TODO()
+
+	var (
+		upd1Time time.Time
+		upd2Time time.Time
+		exists   bool
+		isZombie bool
+	)
+
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		scid := sqlSCID(chanID)
+
+		channel, err := db.GetChannelBySCID(ctx, scid)
+		if errors.Is(err, sql.ErrNoRows) {
+			_, err := db.GetZombieChannel(ctx, scid)
+			switch {
+			case err == nil:
+				isZombie = true
+				return nil
+
+			case errors.Is(err, sql.ErrNoRows):
+				return nil
+
+			default:
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		exists = true
+
+		policies, err := db.GetChannelPolicies(ctx, channel.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range policies {
+			lastUpdate := time.Unix(policy.LastUpdate, 0)
+			if policy.Direction == 0 {
+				upd1Time = lastUpdate
+			} else {
+				upd2Time = lastUpdate
+			}
+		}
+
+		return nil
+	}, func() {
+		upd1Time = time.Time{}
+		upd2Time = time.Time{}
+		exists = false
+		isZombie = false
+	})
+	if err != nil {
+		return time.Time{}, time.Time{}, false, false, err
+	}
+
+	return upd1Time, upd2Time, exists, isZombie, nil
+}
+
+// FetchChannelEdgesByID attempts to lookup the two directed edges for the
+// channel identified by the channel ID. If the channel can't be found, then
+// ErrEdgeNotFound is returned. If the channel is a zombie, ErrZombieEdge is
+// returned together with an edge info that only includes the public keys of
+// the nodes.
+func (s *SQLGraphStore) FetchChannelEdgesByID(chanID uint64) (
+	*models.ChannelEdgeInfo, *models.ChannelEdgePolicy,
+	*models.ChannelEdgePolicy, error) {
+
+	ctx := context.TODO()
+
+	var channel ChannelEdge
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		scid := sqlSCID(chanID)
+
+		row, err := db.GetChannelBySCID(ctx, scid)
+		if errors.Is(err, sql.ErrNoRows) {
+			zombie, err := db.GetZombieChannel(ctx, scid)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrEdgeNotFound
+			} else if err != nil {
+				return err
+			}
+
+			info := &models.ChannelEdgeInfo{}
+			copy(info.NodeKey1Bytes[:], zombie.NodeKey1)
+			copy(info.NodeKey2Bytes[:], zombie.NodeKey2)
+			channel.Info = info
+
+			return ErrZombieEdge
+		} else if err != nil {
+			return err
+		}
+
+		channel, err = fetchSQLChannel(ctx, db, row)
+
+		return err
+	}, func() {
+		channel = ChannelEdge{}
+	})
+	switch {
+	case errors.Is(err, ErrZombieEdge):
+		return channel.Info, nil, nil, err
+
+	case err != nil:
+		return nil, nil, nil, err
+	}
+
+	return channel.Info, channel.Policy1, channel.Policy2, nil
+}
+
+// ChannelID attempt to lookup the 8-byte compact channel ID which maps to the
+// passed channel point (outpoint). If the passed channel doesn't exist within
+// the database, then ErrEdgeNotFound is returned.
+func (s *SQLGraphStore) ChannelID(chanPoint *wire.OutPoint) (uint64, error) {
+	ctx := context.TODO()
+
+	var chanID uint64
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		row, err := db.GetChannelByOutpoint(ctx, chanPoint.String())
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrEdgeNotFound
+		} else if err != nil {
+			return err
+		}
+
+		chanID = byteOrder.Uint64(row.Scid)
+
+		return nil
+	}, func() {
+		chanID = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return chanID, nil
+}
+
+// FetchChannelEdgesByOutpoint attempts to lookup the two directed edges for
+// the channel identified by the funding outpoint. If the channel can't be
+// found, then ErrEdgeNotFound is returned.
+func (s *SQLGraphStore) FetchChannelEdgesByOutpoint(op *wire.OutPoint) (
+	*models.ChannelEdgeInfo, *models.ChannelEdgePolicy,
+	*models.ChannelEdgePolicy, error) {
+
+	ctx := context.TODO()
+
+	var channel ChannelEdge
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		row, err := db.GetChannelByOutpoint(ctx, op.String())
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: op=%v", ErrEdgeNotFound, op)
+		} else if err != nil {
+			return err
+		}
+
+		channel, err = fetchSQLChannel(ctx, db, row)
+
+		return err
+	}, func() {
+		channel = ChannelEdge{}
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return channel.Info, channel.Policy1, channel.Policy2, nil
+}
+
+// FetchChanInfos returns the set of channel edges that correspond to the
+// passed channel ID's. If an edge is the query is unknown to the database, it
+// will skipped and the result will contain only those edges that exist at the
+// time of the query.
+func (s *SQLGraphStore) FetchChanInfos(chanIDs []uint64) ([]ChannelEdge,
+	error) {
+
+	ctx := context.TODO()
+
+	var chanEdges []ChannelEdge
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		for _, chanID := range chanIDs {
+			row, err := db.GetChannelBySCID(ctx, sqlSCID(chanID))
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			channel, err := fetchSQLChannelWithNodes(ctx, db, row)
+			if err != nil {
+				return err
+			}
+
+			chanEdges = append(chanEdges, channel)
+		}
+
+		return nil
+	}, func() {
+		chanEdges = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanEdges, nil
+}
+
+// ChanUpdatesInHorizon returns all the known channel edges which have at least
+// one edge that has an update timestamp within the specified horizon.
+func (s *SQLGraphStore) ChanUpdatesInHorizon(startTime,
+	endTime time.Time) ([]ChannelEdge, error) {
+
+	ctx := context.TODO()
+
+	var chanEdges []ChannelEdge
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		rows, err := db.ListChannelsUpdatedInRange(
+			ctx, sqlc.ListChannelsUpdatedInRangeParams{
+				StartTime: startTime.Unix(),
+				EndTime:   endTime.Unix(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			channel, err := fetchSQLChannelWithNodes(ctx, db, row)
+			if err != nil {
+				return err
+			}
+
+			chanEdges = append(chanEdges, channel)
+		}
+
+		return nil
+	}, func() {
+		chanEdges = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanEdges, nil
+}
+
+// NodeUpdatesInHorizon returns all the known lightning node which have an
+// update timestamp within the passed range.
+func (s *SQLGraphStore) NodeUpdatesInHorizon(startTime,
+	endTime time.Time) ([]LightningNode, error) {
+
+	ctx := context.TODO()
+
+	var nodesInHorizon []LightningNode
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		rows, err := db.ListNodesUpdatedInRange(
+			ctx, sqlc.ListNodesUpdatedInRangeParams{
+				StartTime: startTime.Unix(),
+				EndTime:   endTime.Unix(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			node, err := unmarshalSQLNode(row)
+			if err != nil {
+				return err
+			}
+
+			nodesInHorizon = append(nodesInHorizon, *node)
+		}
+
+		return nil
+	}, func() {
+		nodesInHorizon = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesInHorizon, nil
+}
+
+// HighestChanID returns the "highest" known channel ID in the channel graph.
+// This represents the "newest" channel from the PoV of the chain. Zero is
+// returned if we don't know of any channels.
+func (s *SQLGraphStore) HighestChanID() (uint64, error) {
+	ctx := context.TODO()
+
+	var chanID uint64
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		scid, err := db.GetHighestSCID(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		chanID = byteOrder.Uint64(scid)
+
+		return nil
+	}, func() {
+		chanID = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return chanID, nil
+}
+
+// FilterKnownChanIDs takes a set of channel IDs and return the subset of chan
+// ID's that we don't know and are not known zombies of the passed set. Zombie
+// channels that would be resurrected by the passed update timestamps are
+// removed from the zombie index and returned as well.
+func (s *SQLGraphStore) FilterKnownChanIDs(chansInfo []ChannelUpdateInfo,
+	isZombieChan func(time.Time, time.Time) bool) ([]uint64, error) {
+
+	ctx := context.TODO()
+
+	var newChanIDs []uint64
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		for _, info := range chansInfo {
+			chanID := info.ShortChannelID.ToUint64()
+			scid := sqlSCID(chanID)
+
+			// If the edge is already known, skip it.
+			_, err := db.GetChannelBySCID(ctx, scid)
+			switch {
+			case err == nil:
+				continue
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			}
+
+			_, err = db.GetZombieChannel(ctx, scid)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			isZombie := err == nil
+
+			isStillZombie := isZombieChan(
+				info.Node1UpdateTimestamp,
+				info.Node2UpdateTimestamp,
+			)
+
+			switch {
+			// If the edge is a known zombie and if we would still
+			// consider it a zombie given the latest update
+			// timestamps, then we skip this channel.
+			case isZombie && isStillZombie:
+				continue
+
+			// Otherwise, if we have marked it as a zombie but the
+			// latest update timestamps could bring it back from
+			// the dead, then we mark it alive, and we let it be
+			// added to the set of IDs to query our peer for.
+			case isZombie && !isStillZombie:
+				_, err := db.DeleteZombieChannel(ctx, scid)
+				if err != nil {
+					return err
+				}
+			}
+
+			newChanIDs = append(newChanIDs, chanID)
+		}
+
+		return nil
+	}, func() {
+		newChanIDs = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newChanIDs, nil
+}
+
+// FilterChannelRange returns the channel ID's of all known channels which were
+// mined in a block height within the passed range. The channel IDs are grouped
+// by their common block height. If withTimestamps is true then the timestamp
+// info of the latest received channel update messages of the channel will be
+// included in the response.
+func (s *SQLGraphStore) FilterChannelRange(startHeight,
+	endHeight uint32, withTimestamps bool) ([]BlockChannelRange, error) {
+
+	ctx := context.TODO()
+
+	startChanID := lnwire.ShortChannelID{
+		BlockHeight: startHeight,
+	}
+
+	// The end of the range is exclusive, so we query up to the first
+	// channel of the block after the end height.
+	endChanID := lnwire.ShortChannelID{
+		BlockHeight: endHeight + 1,
+	}
+
+	var channelRanges []BlockChannelRange
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		rows, err := db.ListChannelsInSCIDRange(
+			ctx, sqlc.ListChannelsInSCIDRangeParams{
+				StartScid: sqlSCID(startChanID.ToUint64()),
+				EndScid:   sqlSCID(endChanID.ToUint64()),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// The rows are ordered by their SCID, so the channels of the
+		// same block are next to each other.
+		for _, row := range rows {
+			// Don't send alias SCIDs during gossip sync.
+			if len(row.NodeSig1) == 0 {
+				continue
+			}
+
+			cid := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(row.Scid),
+			)
+			chanInfo := ChannelUpdateInfo{
+				ShortChannelID: cid,
+			}
+
+			if withTimestamps {
+				policies, err := db.GetChannelPolicies(
+					ctx, row.ID,
+				)
+				if err != nil {
+					return err
+				}
+
+				for _, policy := range policies {
+					lastUpdate := time.Unix(
+						policy.LastUpdate, 0,
+					)
+					if policy.Direction == 0 {
+						chanInfo.Node1UpdateTimestamp =
+							lastUpdate
+					} else {
+						chanInfo.Node2UpdateTimestamp =
+							lastUpdate
+					}
+				}
+			}
+
+			// Start a new range if this is the first channel of
+			// its block.
+			numRanges := len(channelRanges)
+			if numRanges == 0 ||
+				channelRanges[numRanges-1].Height !=
+					cid.BlockHeight {
+
+				channelRanges = append(
+					channelRanges, BlockChannelRange{
+						Height: cid.BlockHeight,
+					},
+				)
+			}
+
+			lastRange := &channelRanges[len(channelRanges)-1]
+			lastRange.Channels = append(
+				lastRange.Channels, chanInfo,
+			)
+		}
+
+		return nil
+	}, func() {
+		channelRanges = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channelRanges, nil
+}
+
+// DisabledChannelIDs returns the channel ids of disabled channels. A channel
+// is disabled when both of its policies have the disabled bit set.
+func (s *SQLGraphStore) DisabledChannelIDs() ([]uint64, error) {
+	ctx := context.TODO()
+
+	var chanIDs []uint64
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		scids, err := db.ListDisabledChannelSCIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, scid := range scids {
+			chanIDs = append(chanIDs, byteOrder.Uint64(scid))
+		}
+
+		return nil
+	}, func() {
+		chanIDs = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanIDs, nil
+}
+
+// ChannelView returns the verifiable edge information for each active channel
+// within the known channel graph. The set of UTXO's (along with their scripts)
+// returned are the ones that need to be watched on chain to detect channel
+// closes on the resident blockchain.
+func (s *SQLGraphStore) ChannelView() ([]EdgePoint, error) {
+	var edgePoints []EdgePoint
+	err := s.ForEachChannel(func(info *models.ChannelEdgeInfo,
+		_, _ *models.ChannelEdgePolicy) error {
+
+		pkScript, err := genMultiSigP2WSH(
+			info.BitcoinKey1Bytes[:], info.BitcoinKey2Bytes[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		edgePoints = append(edgePoints, EdgePoint{
+			FundingPkScript: pkScript,
+			OutPoint:        info.ChannelPoint,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return edgePoints, nil
+}
+
+// PruneGraph prunes newly closed channels from the channel graph in response
+// to a new block being solved on the network. Any transactions which spend the
+// funding output of any known channels within he graph will be deleted.
+// Additionally, the "prune tip", or the last block which has been used to
+// prune the graph is stored so callers can ensure the graph is fully in sync
+// with the current UTXO state. A slice of channels that have been closed by
+// the target block are returned if the function succeeds without error.
+func (s *SQLGraphStore) PruneGraph(spentOutputs []*wire.OutPoint,
+	blockHash *chainhash.Hash, blockHeight uint32) (
+	[]*models.ChannelEdgeInfo, error) {
+
+	ctx := context.TODO()
+
+	var (
+		chansClosed []*models.ChannelEdgeInfo
+		nodesPruned [][]byte
+	)
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		for _, chanPoint := range spentOutputs {
+			row, err := db.GetChannelByOutpoint(
+				ctx, chanPoint.String(),
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			info, err := deleteSQLChannel(
+				ctx, db, row, false, false,
+			)
+			if err != nil {
+				return err
+			}
+
+			chansClosed = append(chansClosed, info)
+		}
+
+		err := db.UpsertPruneLogEntry(
+			ctx, sqlc.UpsertPruneLogEntryParams{
+				BlockHeight: int64(blockHeight),
+				BlockHash:   blockHash[:],
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// Now that the graph has been pruned, we'll also attempt to
+		// prune any nodes that have had a channel closed within the
+		// latest block.
+		nodesPruned, err = pruneSQLGraphNodes(ctx, db)
+
+		return err
+	}, func() {
+		chansClosed = nil
+		nodesPruned = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeFromCache(chansClosed, nodesPruned)
+
+	return chansClosed, nil
+}
+
+// PruneGraphNodes is a garbage collection method which attempts to prune out
+// any nodes from the channel graph that are currently unconnected. This ensure
+// that we only maintain a graph of reachable nodes. In the event that a pruned
+// node gains more channels, it will be re-added back to the graph.
+func (s *SQLGraphStore) PruneGraphNodes() error {
+	ctx := context.TODO()
+
+	var nodesPruned [][]byte
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		var err error
+		nodesPruned, err = pruneSQLGraphNodes(ctx, db)
+
+		return err
+	}, func() {
+		nodesPruned = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.removeFromCache(nil, nodesPruned)
+
+	return nil
+}
+
+// DisconnectBlockAtHeight is used to indicate that the block specified by the
+// passed height has been disconnected from the main chain. This will "rewind"
+// the graph back to the height below, deleting channels that are no longer
+// confirmed from the graph. The prune log will be set to the last prune
+// height valid for the remaining chain. Channels that were removed from the
+// graph resulting from the disconnected block are returned.
+func (s *SQLGraphStore) DisconnectBlockAtHeight(height uint32) (
+	[]*models.ChannelEdgeInfo, error) {
+
+	ctx := context.TODO()
+
+	// Every channel having a ShortChannelID starting at 'height' will no
+	// longer be confirmed. We delete everything after this height up
+	// until the SCID alias range.
+	startShortChanID := lnwire.ShortChannelID{
+		BlockHeight: height,
+	}
+	endShortChanID := aliasmgr.StartingAlias
+
+	var removedChans []*models.ChannelEdgeInfo
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		rows, err := db.ListChannelsInSCIDRange(
+			ctx, sqlc.ListChannelsInSCIDRangeParams{
+				StartScid: sqlSCID(startShortChanID.ToUint64()),
+				EndScid:   sqlSCID(endShortChanID.ToUint64()),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			info, err := deleteSQLChannel(
+				ctx, db, row, false, false,
+			)
+			if err != nil {
+				return err
+			}
+
+			removedChans = append(removedChans, info)
+		}
+
+		// Delete all the entries in the prune log having a height
+		// greater or equal to the block disconnected.
+		return db.DeletePruneLogEntriesFrom(ctx, int64(height))
+	}, func() {
+		removedChans = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeFromCache(removedChans, nil)
+
+	return removedChans, nil
+}
+
+// PruneTip returns the block height and hash of the latest block that has been
+// used to prune channels in the graph.
+func (s *SQLGraphStore) PruneTip() (*chainhash.Hash, uint32, error) {
+	ctx := context.TODO()
+
+	var (
+		tipHash   chainhash.Hash
+		tipHeight uint32
+	)
+
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		tip, err := db.GetPruneTip(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrGraphNeverPruned
+		} else if err != nil {
+			return err
+		}
+
+		copy(tipHash[:], tip.BlockHash)
+		tipHeight = uint32(tip.BlockHeight)
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &tipHash, tipHeight, nil
+}
+
+// DeleteChannelEdges removes edges with the given channel IDs from the
+// database and, if markZombie is set, marks them as zombies. If an edge does
+// not exist within the database, then ErrEdgeNotFound will be returned. If
+// strictZombiePruning is true, then only the node that failed to send a fresh
+// update can resurrect the channel from its zombie state.
+func (s *SQLGraphStore) DeleteChannelEdges(strictZombiePruning,
+	markZombie bool, chanIDs ...uint64) error {
+
+	ctx := context.TODO()
+
+	var deletedChans []*models.ChannelEdgeInfo
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		for _, chanID := range chanIDs {
+			row, err := db.GetChannelBySCID(ctx, sqlSCID(chanID))
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrEdgeNotFound
+			} else if err != nil {
+				return err
+			}
+
+			info, err := deleteSQLChannel(
+				ctx, db, row, markZombie, strictZombiePruning,
+			)
+			if err != nil {
+				return err
+			}
+
+			deletedChans = append(deletedChans, info)
+		}
+
+		return nil
+	}, func() {
+		deletedChans = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.removeFromCache(deletedChans, nil)
+
+	return nil
+}
+
+// MarkEdgeZombie attempts to mark a channel identified by its channel ID as a
+// zombie. This method is used on an ad-hoc basis, when channels need to be
+// marked as zombies outside the normal pruning cycle.
+func (s *SQLGraphStore) MarkEdgeZombie(chanID uint64,
+	pubKey1, pubKey2 [33]byte) error {
+
+	ctx := context.TODO()
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		return db.UpsertZombieChannel(
+			ctx, sqlc.UpsertZombieChannelParams{
+				Scid:     sqlSCID(chanID),
+				NodeKey1: pubKey1[:],
+				NodeKey2: pubKey2[:],
+			},
+		)
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.RemoveChannel(pubKey1, pubKey2, chanID)
+	}
+
+	return nil
+}
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live. If
+// the edge isn't a zombie, ErrZombieEdgeNotFound is returned.
+func (s *SQLGraphStore) MarkEdgeLive(chanID uint64) error {
+	ctx := context.TODO()
+
+	var channels []ChannelEdge
+	var writeTxOpts SQLGraphQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		scid := sqlSCID(chanID)
+
+		result, err := db.DeleteZombieChannel(ctx, scid)
+		if err != nil {
+			return err
+		}
+
+		numRows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if numRows == 0 {
+			return ErrZombieEdgeNotFound
+		}
+
+		// We need to add the channel back into our graph cache,
+		// otherwise we won't use it for path finding.
+		if s.graphCache == nil {
+			return nil
+		}
+
+		row, err := db.GetChannelBySCID(ctx, scid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		channel, err := fetchSQLChannel(ctx, db, row)
+		if err != nil {
+			return err
+		}
+		channels = append(channels, channel)
+
+		return nil
+	}, func() {
+		channels = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		for _, channel := range channels {
+			s.graphCache.AddChannel(
+				channel.Info, channel.Policy1, channel.Policy2,
+			)
+		}
+	}
+
+	return nil
+}
+
+// IsZombieEdge returns whether the edge is considered zombie. If it is a
+// zombie, then the two node public keys corresponding to this edge are also
+// returned.
+func (s *SQLGraphStore) IsZombieEdge(chanID uint64) (bool, [33]byte,
+	[33]byte) {
+
+	ctx := context.TODO()
+
+	var (
+		isZombie         bool
+		pubKey1, pubKey2 [33]byte
+	)
+
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		zombie, err := db.GetZombieChannel(ctx, sqlSCID(chanID))
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		isZombie = true
+		copy(pubKey1[:], zombie.NodeKey1)
+		copy(pubKey2[:], zombie.NodeKey2)
+
+		return nil
+	}, func() {
+		isZombie = false
+		pubKey1 = [33]byte{}
+		pubKey2 = [33]byte{}
+	})
+	if err != nil {
+		return false, [33]byte{}, [33]byte{}
+	}
+
+	return isZombie, pubKey1, pubKey2
+}
+
+// NumZombies returns the current number of zombie channels in the graph.
+func (s *SQLGraphStore) NumZombies() (uint64, error) {
+	ctx := context.TODO()
+
+	var numZombies int64
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		var err error
+		numZombies, err = db.CountZombieChannels(ctx)
+
+		return err
+	}, func() {
+		numZombies = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(numZombies), nil
+}
+
+// PutClosedScid stores a SCID for a closed channel in the database. This is
+// so that we can ignore channel announcements that we know to be closed
+// without having to validate them and fetch a block.
+func (s *SQLGraphStore) PutClosedScid(scid lnwire.ShortChannelID) error {
+	ctx := context.TODO()
+
+	var writeTxOpts SQLGraphQueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		return db.InsertClosedSCID(ctx, sqlSCID(scid.ToUint64()))
+	}, func() {})
+}
+
+// IsClosedScid checks whether a channel identified by the passed in scid is
+// closed. This helps avoid having to perform expensive validation checks.
+func (s *SQLGraphStore) IsClosedScid(scid lnwire.ShortChannelID) (bool,
+	error) {
+
+	ctx := context.TODO()
+
+	var isClosed bool
+	readTxOpt := NewSQLGraphQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLGraphQueries) error {
+		_, err := db.GetClosedSCID(ctx, sqlSCID(scid.ToUint64()))
+		switch {
+		case err == nil:
+			isClosed = true
+			return nil
+
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		default:
+			return err
+		}
+	}, func() {
+		isClosed = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isClosed, nil
+}
+
+// removeFromCache removes the given channels and nodes from the graph cache,
+// if it is enabled.
+func (s *SQLGraphStore) removeFromCache(channels []*models.ChannelEdgeInfo,
+	nodes [][]byte) {
+
+	if s.graphCache == nil {
+		return
+	}
+
+	for _, info := range channels {
+		s.graphCache.RemoveChannel(
+			info.NodeKey1Bytes, info.NodeKey2Bytes, info.ChannelID,
+		)
+	}
+
+	for _, node := range nodes {
+		var pubKey route.Vertex
+		copy(pubKey[:], node)
+
+		s.graphCache.RemoveNode(pubKey)
+	}
+
+	log.Debugf("Pruned graph, cache now has %s", s.graphCache.Stats())
+}
+
+// pruneSQLGraphNodes deletes all nodes that don't have any channels anymore,
+// except for the source node. The public keys of the deleted nodes are
+// returned.
+func pruneSQLGraphNodes(ctx context.Context,
+	db SQLGraphQueries) ([][]byte, error) {
+
+	log.Trace("Pruning nodes from graph with no open channels")
+
+	// Like the kv graph, we refuse to prune the graph if we don't know
+	// which node is our own.
+	_, err := db.GetSourceNode(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSourceNodeNotSet
+	} else if err != nil {
+		return nil, err
+	}
+
+	nodesPruned, err := db.DeleteUnconnectedNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodesPruned {
+		log.Infof("Pruned unconnected node %x from channel graph", node)
+	}
+
+	if len(nodesPruned) > 0 {
+		log.Infof("Pruned %v unconnected nodes from the channel graph",
+			len(nodesPruned))
+	}
+
+	return nodesPruned, nil
+}
+
+// deleteSQLChannel deletes the given channel together with its policies. If
+// markZombie is set, the channel is added to the zombie index.
+func deleteSQLChannel(ctx context.Context, db SQLGraphQueries,
+	row sqlc.GraphChannel, markZombie,
+	strictZombie bool) (*models.ChannelEdgeInfo, error) {
+
+	channel, err := fetchSQLChannel(ctx, db, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.DeleteChannel(ctx, row.ID); err != nil {
+		return nil, err
+	}
+
+	if !markZombie {
+		return channel.Info, nil
+	}
+
+	info := channel.Info
+	nodeKey1, nodeKey2 := info.NodeKey1Bytes, info.NodeKey2Bytes
+	if strictZombie {
+		nodeKey1, nodeKey2 = makeZombiePubkeys(
+			info, channel.Policy1, channel.Policy2,
+		)
+	}
+
+	err = db.UpsertZombieChannel(ctx, sqlc.UpsertZombieChannelParams{
+		Scid:     row.Scid,
+		NodeKey1: nodeKey1[:],
+		NodeKey2: nodeKey2[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// fetchSQLNode fetches the node with the given public key. If the node isn't
+// known, ErrGraphNodeNotFound is returned.
+func fetchSQLNode(ctx context.Context, db SQLGraphQueries,
+	pubKey []byte) (*LightningNode, error) {
+
+	row, err := db.GetNodeByPubKey(ctx, pubKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrGraphNodeNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSQLNode(row)
+}
+
+// fetchSQLChannel fetches the policies of the given channel and returns them
+// together with the channel's edge info.
+func fetchSQLChannel(ctx context.Context, db SQLGraphQueries,
+	row sqlc.GraphChannel) (ChannelEdge, error) {
+
+	policies, err := db.GetChannelPolicies(ctx, row.ID)
+	if err != nil {
+		return ChannelEdge{}, err
+	}
+
+	channels, err := unmarshalSQLChannels(
+		[]sqlc.GraphChannel{row}, policies,
+	)
+	if err != nil {
+		return ChannelEdge{}, err
+	}
+
+	return channels[0], nil
+}
+
+// fetchSQLChannelWithNodes is like fetchSQLChannel, but it also fetches the
+// two nodes of the channel.
+func fetchSQLChannelWithNodes(ctx context.Context, db SQLGraphQueries,
+	row sqlc.GraphChannel) (ChannelEdge, error) {
+
+	channel, err := fetchSQLChannel(ctx, db, row)
+	if err != nil {
+		return ChannelEdge{}, err
+	}
+
+	channel.Node1, err = fetchSQLNode(ctx, db, row.NodeKey1)
+	if err != nil {
+		return ChannelEdge{}, err
+	}
+
+	channel.Node2, err = fetchSQLNode(ctx, db, row.NodeKey2)
+	if err != nil {
+		return ChannelEdge{}, err
+	}
+
+	return channel, nil
+}
+
+// fetchSQLNodeChannels fetches all channels of the given node together with
+// their policies.
+func fetchSQLNodeChannels(ctx context.Context, db SQLGraphQueries,
+	nodePub route.Vertex) ([]ChannelEdge, error) {
+
+	rows, err := db.ListNodeChannels(ctx, nodePub[:])
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := db.ListNodeChannelPolicies(ctx, nodePub[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSQLChannels(rows, policies)
+}
+
+// sqlSCID encodes the given short channel ID the way it is stored in the
+// database. The big endian encoding makes sure that channels are ordered by
+// their block height.
+func sqlSCID(chanID uint64) []byte {
+	var scid [8]byte
+	byteOrder.PutUint64(scid[:], chanID)
+
+	return scid[:]
+}
+
+// marshalSQLNode converts the given node to the parameters of the UpsertNode
+// query.
+func marshalSQLNode(node *LightningNode) (sqlc.UpsertNodeParams, error) {
+	// If the node has the update time set, write it, else write 0.
+	updateUnix := int64(0)
+	if node.LastUpdate.Unix() > 0 {
+		updateUnix = node.LastUpdate.Unix()
+	}
+
+	params := sqlc.UpsertNodeParams{
+		PubKey:           node.PubKeyBytes[:],
+		HaveAnnouncement: node.HaveNodeAnnouncement,
+		LastUpdate:       updateUnix,
+	}
+
+	// If we got a node announcement for this node, we will have the rest
+	// of the data available. If not we don't have more data to write.
+	if !node.HaveNodeAnnouncement {
+		return params, nil
+	}
+
+	var features bytes.Buffer
+	if err := node.Features.Encode(&features); err != nil {
+		return sqlc.UpsertNodeParams{}, err
+	}
+
+	var addresses bytes.Buffer
+	for _, address := range node.Addresses {
+		if err := serializeAddr(&addresses, address); err != nil {
+			return sqlc.UpsertNodeParams{}, err
+		}
+	}
+
+	if len(node.AuthSigBytes) > 80 {
+		return sqlc.UpsertNodeParams{}, fmt.Errorf("max sig len "+
+			"allowed is 80, had %v", len(node.AuthSigBytes))
+	}
+
+	if len(node.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
+		return sqlc.UpsertNodeParams{}, ErrTooManyExtraOpaqueBytes(
+			len(node.ExtraOpaqueData),
+		)
+	}
+
+	params.Alias = sqldb.SQLStr(node.Alias)
+	params.Color = []byte{node.Color.R, node.Color.G, node.Color.B}
+	params.Features = features.Bytes()
+	params.Addresses = addresses.Bytes()
+	params.AuthSig = node.AuthSigBytes
+	params.ExtraOpaqueData = node.ExtraOpaqueData
+
+	return params, nil
+}
+
+// unmarshalSQLNode converts the given database row to a LightningNode.
+func unmarshalSQLNode(row sqlc.GraphNode) (*LightningNode, error) {
+	// Always populate a feature vector, even if we don't have a node
+	// announcement and short circuit below.
+	node := &LightningNode{
+		HaveNodeAnnouncement: row.HaveAnnouncement,
+		LastUpdate:           time.Unix(row.LastUpdate, 0),
+		Features:             lnwire.EmptyFeatureVector(),
+	}
+	copy(node.PubKeyBytes[:], row.PubKey)
+
+	if !node.HaveNodeAnnouncement {
+		return node, nil
+	}
+
+	if len(row.Color) == 3 {
+		node.Color = color.RGBA{
+			R: row.Color[0],
+			G: row.Color[1],
+			B: row.Color[2],
+		}
+	}
+
+	node.Alias = row.Alias.String
+
+	err := node.Features.Decode(bytes.NewReader(row.Features))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode features of node "+
+			"%x: %w", row.PubKey, err)
+	}
+
+	var addresses []net.Addr
+	r := bytes.NewReader(row.Addresses)
+	for r.Len() > 0 {
+		address, err := deserializeAddr(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode address of "+
+				"node %x: %w", row.PubKey, err)
+		}
+		addresses = append(addresses, address)
+	}
+	node.Addresses = addresses
+
+	node.AuthSigBytes = row.AuthSig
+	node.ExtraOpaqueData = row.ExtraOpaqueData
+
+	return node, nil
+}
+
+// marshalSQLChannel converts the given edge info to the parameters of the
+// InsertChannel query.
+func marshalSQLChannel(
+	edge *models.ChannelEdgeInfo) (sqlc.InsertChannelParams, error) {
+
+	if len(edge.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
+		return sqlc.InsertChannelParams{}, ErrTooManyExtraOpaqueBytes(
+			len(edge.ExtraOpaqueData),
+		)
+	}
+
+	params := sqlc.InsertChannelParams{
+		Scid:            sqlSCID(edge.ChannelID),
+		ChainHash:       edge.ChainHash[:],
+		NodeKey1:        edge.NodeKey1Bytes[:],
+		NodeKey2:        edge.NodeKey2Bytes[:],
+		BitcoinKey1:     edge.BitcoinKey1Bytes[:],
+		BitcoinKey2:     edge.BitcoinKey2Bytes[:],
+		Features:        edge.Features,
+		Outpoint:        edge.ChannelPoint.String(),
+		Capacity:        int64(edge.Capacity),
+		ExtraOpaqueData: edge.ExtraOpaqueData,
+	}
+
+	if edge.AuthProof != nil {
+		params.NodeSig1 = edge.AuthProof.NodeSig1Bytes
+		params.NodeSig2 = edge.AuthProof.NodeSig2Bytes
+		params.BitcoinSig1 = edge.AuthProof.BitcoinSig1Bytes
+		params.BitcoinSig2 = edge.AuthProof.BitcoinSig2Bytes
+	}
+
+	return params, nil
+}
+
+// unmarshalSQLChannels converts the given channel rows to channel edges and
+// attaches the given policies to them. Policies of channels that aren't part
+// of the rows are ignored.
+func unmarshalSQLChannels(rows []sqlc.GraphChannel,
+	policies []sqlc.GraphChannelPolicy) ([]ChannelEdge, error) {
+
+	channels := make([]ChannelEdge, 0, len(rows))
+	channelIndex := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		info, err := unmarshalSQLChannel(row)
+		if err != nil {
+			return nil, err
+		}
+
+		channelIndex[row.ID] = len(channels)
+		channels = append(channels, ChannelEdge{
+			Info: info,
+		})
+	}
+
+	for _, policy := range policies {
+		idx, ok := channelIndex[policy.ChannelID]
+		if !ok {
+			continue
+		}
+
+		channel := &channels[idx]
+		if policy.Direction == 0 {
+			channel.Policy1 = unmarshalSQLPolicy(
+				policy, channel.Info.ChannelID,
+				channel.Info.NodeKey2Bytes,
+			)
+		} else {
+			channel.Policy2 = unmarshalSQLPolicy(
+				policy, channel.Info.ChannelID,
+				channel.Info.NodeKey1Bytes,
+			)
+		}
+	}
+
+	return channels, nil
+}
+
+// unmarshalSQLChannel converts the given database row to a ChannelEdgeInfo.
+func unmarshalSQLChannel(
+	row sqlc.GraphChannel) (*models.ChannelEdgeInfo, error) {
+
+	chanPoint, err := wire.NewOutPointFromString(row.Outpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint of channel %x: %w",
+			row.Scid, err)
+	}
+
+	info := &models.ChannelEdgeInfo{
+		ChannelID:       byteOrder.Uint64(row.Scid),
+		Features:        row.Features,
+		ChannelPoint:    *chanPoint,
+		Capacity:        btcutil.Amount(row.Capacity),
+		ExtraOpaqueData: row.ExtraOpaqueData,
+	}
+	copy(info.ChainHash[:], row.ChainHash)
+	copy(info.NodeKey1Bytes[:], row.NodeKey1)
+	copy(info.NodeKey2Bytes[:], row.NodeKey2)
+	copy(info.BitcoinKey1Bytes[:], row.BitcoinKey1)
+	copy(info.BitcoinKey2Bytes[:], row.BitcoinKey2)
+
+	proof := &models.ChannelAuthProof{
+		NodeSig1Bytes:    row.NodeSig1,
+		NodeSig2Bytes:    row.NodeSig2,
+		BitcoinSig1Bytes: row.BitcoinSig1,
+		BitcoinSig2Bytes: row.BitcoinSig2,
+	}
+	if !proof.IsEmpty() {
+		info.AuthProof = proof
+	}
+
+	return info, nil
+}
+
+// marshalSQLPolicy converts the given policy of the channel with the given
+// database ID to the parameters of the UpsertChannelPolicy query.
+func marshalSQLPolicy(channelID int64,
+	edge *models.ChannelEdgePolicy) sqlc.UpsertChannelPolicyParams {
+
+	direction := int16(edge.ChannelFlags & lnwire.ChanUpdateDirection)
+
+	return sqlc.UpsertChannelPolicyParams{
+		ChannelID:       channelID,
+		Direction:       direction,
+		LastUpdate:      edge.LastUpdate.Unix(),
+		Signature:       edge.SigBytes,
+		MessageFlags:    int16(edge.MessageFlags),
+		ChannelFlags:    int16(edge.ChannelFlags),
+		Timelock:        int32(edge.TimeLockDelta),
+		MinHtlcMsat:     int64(edge.MinHTLC),
+		MaxHtlcMsat:     int64(edge.MaxHTLC),
+		BaseFeeMsat:     int64(edge.FeeBaseMSat),
+		FeePpm:          int64(edge.FeeProportionalMillionths),
+		Disabled:        edge.IsDisabled(),
+		ExtraOpaqueData: edge.ExtraOpaqueData,
+	}
+}
+
+// unmarshalSQLPolicy converts the given database row to a ChannelEdgePolicy
+// of the channel with the given ID that points to the given node.
+func unmarshalSQLPolicy(row sqlc.GraphChannelPolicy, chanID uint64,
+	toNode [33]byte) *models.ChannelEdgePolicy {
+
+	msgFlags := lnwire.ChanUpdateMsgFlags(row.MessageFlags)
+	chanFlags := lnwire.ChanUpdateChanFlags(row.ChannelFlags)
+
+	return &models.ChannelEdgePolicy{
+		SigBytes:                  row.Signature,
+		ChannelID:                 chanID,
+		LastUpdate:                time.Unix(row.LastUpdate, 0),
+		MessageFlags:              msgFlags,
+		ChannelFlags:              chanFlags,
+		TimeLockDelta:             uint16(row.Timelock),
+		MinHTLC:                   lnwire.MilliSatoshi(row.MinHtlcMsat),
+		MaxHTLC:                   lnwire.MilliSatoshi(row.MaxHtlcMsat),
+		FeeBaseMSat:               lnwire.MilliSatoshi(row.BaseFeeMsat),
+		FeeProportionalMillionths: lnwire.MilliSatoshi(row.FeePpm),
+		ToNode:                    toNode,
+		ExtraOpaqueData:           row.ExtraOpaqueData,
+	}
+}
@@ -0,0 +1,719 @@
+package channeldb
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// graphStore is the set of graph methods that are exercised against both the
+// kv and the SQL graph implementations.
+type graphStore interface { //nolint:interfacebloat
+	SourceNode() (*LightningNode, error)
+	SetSourceNode(node *LightningNode) error
+	AddLightningNode(node *LightningNode) error
+	FetchLightningNode(nodePub route.Vertex) (*LightningNode, error)
+	HasLightningNode(nodePub [33]byte) (time.Time, bool, error)
+	ForEachNode(cb func(kvdb.RTx, *LightningNode) error) error
+	IsPublicNode(pubKey [33]byte) (bool, error)
+	AddChannelEdge(edge *models.ChannelEdgeInfo) error
+	UpdateEdgePolicy(edge *models.ChannelEdgePolicy) error
+	HasChannelEdge(chanID uint64) (time.Time, time.Time, bool, bool,
+		error)
+	FetchChannelEdgesByID(chanID uint64) (*models.ChannelEdgeInfo,
+		*models.ChannelEdgePolicy, *models.ChannelEdgePolicy, error)
+	ForEachChannel(cb func(*models.ChannelEdgeInfo,
+		*models.ChannelEdgePolicy,
+		*models.ChannelEdgePolicy) error) error
+	ChanUpdatesInHorizon(startTime, endTime time.Time) ([]ChannelEdge,
+		error)
+	DisabledChannelIDs() ([]uint64, error)
+	ChannelView() ([]EdgePoint, error)
+	PruneGraph(spentOutputs []*wire.OutPoint, blockHash *chainhash.Hash,
+		blockHeight uint32) ([]*models.ChannelEdgeInfo, error)
+	PruneTip() (*chainhash.Hash, uint32, error)
+	DisconnectBlockAtHeight(height uint32) ([]*models.ChannelEdgeInfo,
+		error)
+	DeleteChannelEdges(strictZombiePruning, markZombie bool,
+		chanIDs ...uint64) error
+	IsZombieEdge(chanID uint64) (bool, [33]byte, [33]byte)
+	MarkEdgeLive(chanID uint64) error
+	NumZombies() (uint64, error)
+	ForEachNodeDirectedChannel(tx kvdb.RTx, node route.Vertex,
+		cb func(channel *DirectedChannel) error) error
+	HighestChanID() (uint64, error)
+	ChannelID(chanPoint *wire.OutPoint) (uint64, error)
+	FetchChannelEdgesByOutpoint(op *wire.OutPoint) (
+		*models.ChannelEdgeInfo, *models.ChannelEdgePolicy,
+		*models.ChannelEdgePolicy, error)
+	FilterChannelRange(startHeight, endHeight uint32,
+		withTimestamps bool) ([]BlockChannelRange, error)
+	FilterKnownChanIDs(chansInfo []ChannelUpdateInfo,
+		isZombieChan func(time.Time, time.Time) bool) ([]uint64, error)
+	NodeUpdatesInHorizon(startTime, endTime time.Time) ([]LightningNode,
+		error)
+	LookupAlias(pub *btcec.PublicKey) (string, error)
+}
+
+// kvGraphStore wraps the kv ChannelGraph to hide the batch scheduler options
+// of its write methods.
+type kvGraphStore struct {
+	*ChannelGraph
+}
+
+func (k *kvGraphStore) AddLightningNode(node *LightningNode) error {
+	return k.ChannelGraph.AddLightningNode(node)
+}
+
+func (k *kvGraphStore) AddChannelEdge(edge *models.ChannelEdgeInfo) error {
+	return k.ChannelGraph.AddChannelEdge(edge)
+}
+
+func (k *kvGraphStore) UpdateEdgePolicy(edge *models.ChannelEdgePolicy) error {
+	return k.ChannelGraph.UpdateEdgePolicy(edge)
+}
+
+// sqlGraphStore wraps the SQLGraphStore to hide the batch scheduler options
+// of its write methods.
+type sqlGraphStore struct {
+	*SQLGraphStore
+}
+
+func (s *sqlGraphStore) AddLightningNode(node *LightningNode) error {
+	return s.SQLGraphStore.AddLightningNode(node)
+}
+
+func (s *sqlGraphStore) AddChannelEdge(edge *models.ChannelEdgeInfo) error {
+	return s.SQLGraphStore.AddChannelEdge(edge)
+}
+
+func (s *sqlGraphStore) UpdateEdgePolicy(edge *models.ChannelEdgePolicy) error {
+	return s.SQLGraphStore.UpdateEdgePolicy(edge)
+}
+
+// newTestSQLGraphStore creates a SQLGraphStore backed by a fresh sqlite
+// database.
+func newTestSQLGraphStore(t *testing.T,
+	db *sqldb.BaseDB, useGraphCache bool) *SQLGraphStore {
+
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) SQLGraphQueries {
+			return db.WithTx(tx)
+		},
+	)
+
+	store, err := NewSQLGraphStore(executor, 0, useGraphCache)
+	require.NoError(t, err)
+
+	return store
+}
+
+// makeGraphStores returns constructors for all graph implementations.
+func makeGraphStores() map[string]func(t *testing.T) graphStore {
+	return map[string]func(t *testing.T) graphStore{
+		"kv": func(t *testing.T) graphStore {
+			graph, err := MakeTestGraph(t)
+			require.NoError(t, err)
+
+			return &kvGraphStore{graph}
+		},
+		"sqlite": func(t *testing.T) graphStore {
+			db := sqldb.NewTestSqliteDB(t).BaseDB
+			store := newTestSQLGraphStore(t, db, false)
+
+			return &sqlGraphStore{store}
+		},
+		"sqlite-cache": func(t *testing.T) graphStore {
+			db := sqldb.NewTestSqliteDB(t).BaseDB
+			store := newTestSQLGraphStore(t, db, true)
+
+			return &sqlGraphStore{store}
+		},
+	}
+}
+
+// TestGraphStores runs the graph tests against all graph implementations.
+func TestGraphStores(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		test func(t *testing.T, graph graphStore)
+	}{
+		{
+			name: "nodes",
+			test: testGraphStoreNodes,
+		},
+		{
+			name: "channels",
+			test: testGraphStoreChannels,
+		},
+		{
+			name: "zombies",
+			test: testGraphStoreZombies,
+		},
+		{
+			name: "pruning",
+			test: testGraphStorePruning,
+		},
+		{
+			name: "disconnect block",
+			test: testGraphStoreDisconnectBlock,
+		},
+		{
+			name: "gossip queries",
+			test: testGraphStoreGossipQueries,
+		},
+	}
+
+	for storeName, makeStore := range makeGraphStores() {
+		makeStore := makeStore
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(storeName+"/"+tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				tc.test(t, makeStore(t))
+			})
+		}
+	}
+}
+
+// testGraphStoreNodes tests that nodes and the source node can be stored and
+// retrieved.
+func testGraphStoreNodes(t *testing.T, graph graphStore) {
+	_, err := graph.SourceNode()
+	require.ErrorIs(t, err, ErrSourceNodeNotSet)
+
+	node, err := createTestVertex(nil)
+	require.NoError(t, err)
+
+	_, err = graph.FetchLightningNode(node.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+
+	_, exists, err := graph.HasLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, graph.AddLightningNode(node))
+
+	dbNode, err := graph.FetchLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(node, dbNode))
+	require.Equal(t, node.Features, dbNode.Features)
+
+	updateTime, exists, err := graph.HasLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, node.LastUpdate, updateTime)
+
+	// Updating the node should overwrite its announcement data.
+	node.Alias = "updated"
+	node.LastUpdate = node.LastUpdate.Add(time.Second)
+	require.NoError(t, graph.AddLightningNode(node))
+
+	dbNode, err = graph.FetchLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(node, dbNode))
+
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.SetSourceNode(sourceNode))
+
+	dbSource, err := graph.SourceNode()
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(sourceNode, dbSource))
+
+	var numNodes int
+	err = graph.ForEachNode(func(_ kvdb.RTx, _ *LightningNode) error {
+		numNodes++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numNodes)
+}
+
+// testGraphStoreChannels tests that channels and their policies can be stored
+// and retrieved.
+func testGraphStoreChannels(t *testing.T, graph graphStore) {
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.SetSourceNode(sourceNode))
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+
+	// The nodes of the channel don't have to be known when the channel is
+	// added.
+	edgeInfo, edge1, edge2 := createChannelEdge(nil, node1, node2)
+	require.NoError(t, graph.AddChannelEdge(edgeInfo))
+	require.ErrorIs(t, graph.AddChannelEdge(edgeInfo), ErrEdgeAlreadyExist)
+
+	_, exists, err := graph.HasLightningNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	dbInfo, dbEdge1, dbEdge2, err := graph.FetchChannelEdgesByID(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, edgeInfo, dbInfo)
+	require.Nil(t, dbEdge1)
+	require.Nil(t, dbEdge2)
+
+	// Policies of unknown channels are rejected.
+	unknownPolicy := *edge1
+	unknownPolicy.ChannelID++
+	require.ErrorIs(
+		t, graph.UpdateEdgePolicy(&unknownPolicy), ErrEdgeNotFound,
+	)
+
+	require.NoError(t, graph.UpdateEdgePolicy(edge1))
+	require.NoError(t, graph.UpdateEdgePolicy(edge2))
+
+	_, dbEdge1, dbEdge2, err = graph.FetchChannelEdgesByID(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	require.NoError(t, compareEdgePolicies(edge1, dbEdge1))
+	require.NoError(t, compareEdgePolicies(edge2, dbEdge2))
+
+	upd1, upd2, exists, isZombie, err := graph.HasChannelEdge(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.False(t, isZombie)
+	require.Equal(t, edge1.LastUpdate, upd1)
+	require.Equal(t, edge2.LastUpdate, upd2)
+
+	// The node with the smaller key is node 1 of the channel, so its
+	// outgoing policy is the first one.
+	var numChans int
+	directedChans := make(map[uint64]*DirectedChannel)
+	err = graph.ForEachNodeDirectedChannel(nil, edgeInfo.NodeKey1Bytes,
+		func(c *DirectedChannel) error {
+			directedChans[c.ChannelID] = c
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, directedChans, 1)
+	require.True(t, directedChans[edgeInfo.ChannelID].IsNode1)
+	require.True(t, directedChans[edgeInfo.ChannelID].OutPolicySet)
+	require.Equal(
+		t, route.Vertex(edgeInfo.NodeKey2Bytes),
+		directedChans[edgeInfo.ChannelID].OtherNode,
+	)
+
+	err = graph.ForEachChannel(func(info *models.ChannelEdgeInfo,
+		p1, p2 *models.ChannelEdgePolicy) error {
+
+		assertEdgeInfoEqual(t, edgeInfo, info)
+		require.NoError(t, compareEdgePolicies(edge1, p1))
+		require.NoError(t, compareEdgePolicies(edge2, p2))
+		numChans++
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, numChans)
+
+	chanView, err := graph.ChannelView()
+	require.NoError(t, err)
+	require.Len(t, chanView, 1)
+	require.Equal(t, edgeInfo.ChannelPoint, chanView[0].OutPoint)
+
+	// Only the update of the first policy is within the horizon.
+	chans, err := graph.ChanUpdatesInHorizon(
+		edge1.LastUpdate.Add(-time.Second),
+		edge1.LastUpdate.Add(time.Second),
+	)
+	require.NoError(t, err)
+	require.Len(t, chans, 1)
+	require.Equal(t, edgeInfo.ChannelID, chans[0].Info.ChannelID)
+
+	chans, err = graph.ChanUpdatesInHorizon(
+		edge1.LastUpdate.Add(time.Second),
+		edge1.LastUpdate.Add(time.Minute),
+	)
+	require.NoError(t, err)
+	require.Empty(t, chans)
+
+	// A channel is only disabled once both policies are disabled.
+	edge1.ChannelFlags |= lnwire.ChanUpdateDisabled
+	require.NoError(t, graph.UpdateEdgePolicy(edge1))
+
+	disabled, err := graph.DisabledChannelIDs()
+	require.NoError(t, err)
+	require.Empty(t, disabled)
+
+	edge2.ChannelFlags |= lnwire.ChanUpdateDisabled
+	require.NoError(t, graph.UpdateEdgePolicy(edge2))
+
+	disabled, err = graph.DisabledChannelIDs()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{edgeInfo.ChannelID}, disabled)
+
+	// The channel isn't announced, but it also isn't connected to our
+	// source node, so both nodes are public.
+	isPublic, err := graph.IsPublicNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.True(t, isPublic)
+}
+
+// testGraphStoreZombies tests that deleted channels can be marked as zombies
+// and be resurrected again.
+func testGraphStoreZombies(t *testing.T, graph graphStore) {
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+
+	edgeInfo, _, _ := createChannelEdge(nil, node1, node2)
+	require.NoError(t, graph.AddChannelEdge(edgeInfo))
+
+	require.ErrorIs(
+		t, graph.DeleteChannelEdges(false, true, edgeInfo.ChannelID+1),
+		ErrEdgeNotFound,
+	)
+	require.NoError(
+		t, graph.DeleteChannelEdges(false, true, edgeInfo.ChannelID),
+	)
+
+	isZombie, pub1, pub2 := graph.IsZombieEdge(edgeInfo.ChannelID)
+	require.True(t, isZombie)
+	require.Equal(t, edgeInfo.NodeKey1Bytes, pub1)
+	require.Equal(t, edgeInfo.NodeKey2Bytes, pub2)
+
+	numZombies, err := graph.NumZombies()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numZombies)
+
+	_, _, exists, isZombie, err := graph.HasChannelEdge(edgeInfo.ChannelID)
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.True(t, isZombie)
+
+	dbInfo, _, _, err := graph.FetchChannelEdgesByID(edgeInfo.ChannelID)
+	require.ErrorIs(t, err, ErrZombieEdge)
+	require.Equal(t, edgeInfo.NodeKey1Bytes, dbInfo.NodeKey1Bytes)
+
+	require.NoError(t, graph.MarkEdgeLive(edgeInfo.ChannelID))
+	require.ErrorIs(
+		t, graph.MarkEdgeLive(edgeInfo.ChannelID),
+		ErrZombieEdgeNotFound,
+	)
+
+	isZombie, _, _ = graph.IsZombieEdge(edgeInfo.ChannelID)
+	require.False(t, isZombie)
+
+	_, _, _, err = graph.FetchChannelEdgesByID(edgeInfo.ChannelID)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+}
+
+// testGraphStorePruning tests that spent channels are pruned from the graph
+// together with the nodes that don't have any channels left.
+func testGraphStorePruning(t *testing.T, graph graphStore) {
+	_, _, err := graph.PruneTip()
+	require.ErrorIs(t, err, ErrGraphNeverPruned)
+
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.SetSourceNode(sourceNode))
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node1))
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node2))
+
+	chan1, _, _ := createChannelEdge(nil, sourceNode, node1)
+	chan1.ChannelPoint.Index = 1
+	require.NoError(t, graph.AddChannelEdge(chan1))
+
+	chan2, _, _ := createChannelEdge(nil, node1, node2)
+	chan2.ChannelPoint.Index = 2
+	require.NoError(t, graph.AddChannelEdge(chan2))
+
+	blockHash := chainhash.Hash{1, 2, 3}
+	closed, err := graph.PruneGraph(
+		[]*wire.OutPoint{&chan2.ChannelPoint}, &blockHash, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, closed, 1)
+	require.Equal(t, chan2.ChannelID, closed[0].ChannelID)
+
+	tipHash, tipHeight, err := graph.PruneTip()
+	require.NoError(t, err)
+	require.Equal(t, blockHash, *tipHash)
+	require.EqualValues(t, 100, tipHeight)
+
+	// Node 2 lost its only channel and is pruned, while node 1 and the
+	// source node remain.
+	_, exists, err := graph.HasLightningNode(node2.PubKeyBytes)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, exists, err = graph.HasLightningNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	chanView, err := graph.ChannelView()
+	require.NoError(t, err)
+	require.Len(t, chanView, 1)
+	require.Equal(t, chan1.ChannelPoint, chanView[0].OutPoint)
+
+	// The channel to the source node isn't announced, so node 1 is private
+	// now.
+	chan1.AuthProof = nil
+	require.NoError(
+		t, graph.DeleteChannelEdges(false, false, chan1.ChannelID),
+	)
+	require.NoError(t, graph.AddChannelEdge(chan1))
+
+	isPublic, err := graph.IsPublicNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.False(t, isPublic)
+}
+
+// testGraphStoreDisconnectBlock tests that disconnecting a block removes the
+// channels confirmed at or after its height and rewinds the prune log.
+func testGraphStoreDisconnectBlock(t *testing.T, graph graphStore) {
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.SetSourceNode(sourceNode))
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+
+	const height = 100
+	edge1, _ := createEdge(height-1, 0, 0, 0, node1, node2)
+	edge2, _ := createEdge(height, 1, 0, 1, node1, node2)
+	edge3, _ := createEdge(height+1, 0, 0, 2, node1, node2)
+	for _, edge := range []models.ChannelEdgeInfo{edge1, edge2, edge3} {
+		edge := edge
+		require.NoError(t, graph.AddChannelEdge(&edge))
+	}
+
+	for h := uint32(height - 1); h <= height+1; h++ {
+		blockHash := chainhash.Hash{byte(h)}
+		_, err := graph.PruneGraph(nil, &blockHash, h)
+		require.NoError(t, err)
+	}
+
+	removed, err := graph.DisconnectBlockAtHeight(height)
+	require.NoError(t, err)
+	require.Len(t, removed, 2)
+	require.Equal(t, edge2.ChannelID, removed[0].ChannelID)
+	require.Equal(t, edge3.ChannelID, removed[1].ChannelID)
+
+	_, _, exists, _, err := graph.HasChannelEdge(edge1.ChannelID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, tipHeight, err := graph.PruneTip()
+	require.NoError(t, err)
+	require.EqualValues(t, height-1, tipHeight)
+}
+
+// testGraphStoreGossipQueries tests the queries the gossiper uses to sync the
+// graph with its peers.
+func testGraphStoreGossipQueries(t *testing.T, graph graphStore) {
+	highestID, err := graph.HighestChanID()
+	require.NoError(t, err)
+	require.Zero(t, highestID)
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node1))
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node2))
+
+	node1Pub, err := node1.PubKey()
+	require.NoError(t, err)
+	alias, err := graph.LookupAlias(node1Pub)
+	require.NoError(t, err)
+	require.Equal(t, node1.Alias, alias)
+
+	node2.LastUpdate = node1.LastUpdate.Add(time.Minute)
+	require.NoError(t, graph.AddLightningNode(node2))
+
+	nodes, err := graph.NodeUpdatesInHorizon(
+		node1.LastUpdate, node1.LastUpdate.Add(time.Second),
+	)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, node1.PubKeyBytes, nodes[0].PubKeyBytes)
+
+	edge1, scid1 := createEdge(100, 0, 0, 0, node1, node2)
+	edge2, scid2 := createEdge(100, 1, 0, 1, node1, node2)
+	edge3, scid3 := createEdge(102, 0, 0, 2, node1, node2)
+	for _, edge := range []models.ChannelEdgeInfo{edge1, edge2, edge3} {
+		edge := edge
+		require.NoError(t, graph.AddChannelEdge(&edge))
+	}
+
+	highestID, err = graph.HighestChanID()
+	require.NoError(t, err)
+	require.Equal(t, scid3.ToUint64(), highestID)
+
+	chanID, err := graph.ChannelID(&edge2.ChannelPoint)
+	require.NoError(t, err)
+	require.Equal(t, scid2.ToUint64(), chanID)
+
+	dbInfo, _, _, err := graph.FetchChannelEdgesByOutpoint(
+		&edge2.ChannelPoint,
+	)
+	require.NoError(t, err)
+	require.Equal(t, scid2.ToUint64(), dbInfo.ChannelID)
+
+	unknownOutpoint := wire.OutPoint{Index: 99}
+	_, err = graph.ChannelID(&unknownOutpoint)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+	_, _, _, err = graph.FetchChannelEdgesByOutpoint(&unknownOutpoint)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+
+	// The channels are grouped by the height they were mined at, and the
+	// end height of the range is inclusive.
+	ranges, err := graph.FilterChannelRange(100, 101, false)
+	require.NoError(t, err)
+	require.Len(t, ranges, 1)
+	require.EqualValues(t, 100, ranges[0].Height)
+	require.Len(t, ranges[0].Channels, 2)
+	require.Equal(t, scid1, ranges[0].Channels[0].ShortChannelID)
+	require.Equal(t, scid2, ranges[0].Channels[1].ShortChannelID)
+
+	ranges, err = graph.FilterChannelRange(0, 102, false)
+	require.NoError(t, err)
+	require.Len(t, ranges, 2)
+	require.EqualValues(t, 102, ranges[1].Height)
+
+	// Only the unknown channels are returned, unless they're zombies that
+	// are still considered zombies.
+	require.NoError(
+		t, graph.DeleteChannelEdges(false, true, scid3.ToUint64()),
+	)
+
+	unknownSCID := lnwire.ShortChannelID{BlockHeight: 103}
+	chansInfo := []ChannelUpdateInfo{
+		{ShortChannelID: scid1},
+		{ShortChannelID: scid3},
+		{ShortChannelID: unknownSCID},
+	}
+	isZombie := func(time.Time, time.Time) bool {
+		return true
+	}
+	newChanIDs, err := graph.FilterKnownChanIDs(chansInfo, isZombie)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{unknownSCID.ToUint64()}, newChanIDs)
+
+	// If the zombie channel received a fresh update, it's resurrected.
+	isZombie = func(time.Time, time.Time) bool {
+		return false
+	}
+	newChanIDs, err = graph.FilterKnownChanIDs(chansInfo, isZombie)
+	require.NoError(t, err)
+	require.Equal(
+		t, []uint64{scid3.ToUint64(), unknownSCID.ToUint64()},
+		newChanIDs,
+	)
+
+	numZombies, err := graph.NumZombies()
+	require.NoError(t, err)
+	require.Zero(t, numZombies)
+}
+
+// TestSQLGraphStoreClosedScids tests that the SQL graph store remembers the
+// SCIDs of closed channels.
+func TestSQLGraphStoreClosedScids(t *testing.T) {
+	t.Parallel()
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	graph := newTestSQLGraphStore(t, db, false)
+
+	scid := lnwire.NewShortChanIDFromInt(1234)
+
+	isClosed, err := graph.IsClosedScid(scid)
+	require.NoError(t, err)
+	require.False(t, isClosed)
+
+	require.NoError(t, graph.PutClosedScid(scid))
+	require.NoError(t, graph.PutClosedScid(scid))
+
+	isClosed, err = graph.IsClosedScid(scid)
+	require.NoError(t, err)
+	require.True(t, isClosed)
+}
+
+// TestSQLGraphStoreCacheLoading tests that the graph cache of the SQL graph
+// store is populated with the channels that are already in the database.
+func TestSQLGraphStoreCacheLoading(t *testing.T) {
+	t.Parallel()
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	store := newTestSQLGraphStore(t, db, false)
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, store.AddLightningNode(node1))
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, store.AddLightningNode(node2))
+
+	edgeInfo, edge1, edge2 := createChannelEdge(nil, node1, node2)
+	require.NoError(t, store.AddChannelEdge(edgeInfo))
+	require.NoError(t, store.UpdateEdgePolicy(edge1))
+	require.NoError(t, store.UpdateEdgePolicy(edge2))
+
+	collect := func(s *SQLGraphStore) map[uint64]*DirectedChannel {
+		chans := make(map[uint64]*DirectedChannel)
+		err := s.ForEachNodeDirectedChannel(
+			nil, node1.PubKeyBytes, func(c *DirectedChannel) error {
+				chans[c.ChannelID] = c.DeepCopy()
+				return nil
+			},
+		)
+		require.NoError(t, err)
+
+		return chans
+	}
+
+	cachedStore := newTestSQLGraphStore(t, db, true)
+	require.NotNil(t, cachedStore.graphCache)
+
+	expected := collect(store)
+	actual := collect(cachedStore)
+	require.Len(t, actual, 1)
+
+	for chanID, c := range expected {
+		require.Contains(t, actual, chanID)
+		require.Equal(t, c.OtherNode, actual[chanID].OtherNode)
+		require.Equal(t, c.Capacity, actual[chanID].Capacity)
+		require.Equal(t, c.OutPolicySet, actual[chanID].OutPolicySet)
+		require.Equal(
+			t, c.InPolicy.FeeBaseMSat,
+			actual[chanID].InPolicy.FeeBaseMSat,
+		)
+	}
+
+	features, err := cachedStore.FetchNodeFeatures(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.Equal(t, node1.Features, features)
+}
@@ -139,3 +139,7 @@ type graph interface {
 // A compile-time check to ensure that *channeldb.ChannelGraph implements the
 // graph interface.
 var _ graph = (*channeldb.ChannelGraph)(nil)
+
+// A compile-time check to ensure that *channeldb.SQLGraphStore implements the
+// graph interface.
+var _ graph = (*channeldb.SQLGraphStore)(nil)
@@ -288,6 +288,54 @@ func (d *DB) InvoicesAddedSince(_ context.Context, sinceAddIndex uint64) (
 	return newInvoices, nil
 }
 
+// ForEachInvoiceHash calls the passed callback for the add index and payment
+// hash of each invoice in the database. As the payment hash isn't part of the
+// serialized invoice, this is needed in order to migrate the invoices to
+// another store.
+//
+// NOTE: The callback may be called multiple times for the same invoice if the
+// underlying database transaction is retried.
+func (d *DB) ForEachInvoiceHash(_ context.Context,
+	cb func(addIndex uint64, hash lntypes.Hash) error) error {
+
+	return kvdb.View(d, func(tx kvdb.RTx) error {
+		invoices := tx.ReadBucket(invoiceBucket)
+		if invoices == nil {
+			return nil
+		}
+
+		invoiceIndex := invoices.NestedReadBucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return nil
+		}
+
+		return invoiceIndex.ForEach(func(k, invoiceKey []byte) error {
+			// The index also holds the number of invoices, so
+			// we skip every key that isn't a payment hash.
+			if len(k) != lntypes.HashSize {
+				return nil
+			}
+
+			invoiceBytes := invoices.Get(invoiceKey)
+			if invoiceBytes == nil {
+				return invpkg.ErrInvoiceNotFound
+			}
+
+			invoice, err := deserializeInvoice(
+				bytes.NewReader(invoiceBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			var hash lntypes.Hash
+			copy(hash[:], k)
+
+			return cb(invoice.AddIndex, hash)
+		})
+	}, func() {})
+}
+
 // LookupInvoice attempts to look up an invoice according to its 32 byte
 // payment hash. If an invoice which can settle the HTLC identified by the
 // passed payment hash isn't found, then an error is returned. Otherwise, the
@@ -140,6 +140,10 @@ func NewPaymentControl(db *DB) *PaymentControl {
 	}
 }
 
+// A compile-time check to ensure PaymentControl implements the PaymentStore
+// interface.
+var _ PaymentStore = (*PaymentControl)(nil)
+
 // InitPayment checks or records the given PaymentCreationInfo with the DB,
 // making sure it does not already exist as an in-flight payment. When this
 // method returns successfully, the payment is guaranteed to be in the InFlight
@@ -227,6 +231,19 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 			return err
 		}
 
+		// Store the type of the payment, or remove the type of a
+		// previous attempt if this is a regular payment.
+		if info.Type != PaymentTypeRegular {
+			err = bucket.Put(
+				paymentTypeKey, []byte{byte(info.Type)},
+			)
+		} else {
+			err = bucket.Delete(paymentTypeKey)
+		}
+		if err != nil {
+			return err
+		}
+
 		// We'll delete any lingering HTLCs to start with, in case we
 		// are initializing a payment that was attempted earlier, but
 		// left in a state where we could retry.
@@ -259,6 +276,33 @@ func (p *PaymentControl) DeleteFailedAttempts(hash lntypes.Hash) error {
 	return nil
 }
 
+// QueryPayments is a query to the payments database which is restricted to a
+// subset of payments by the payments query.
+func (p *PaymentControl) QueryPayments(query PaymentsQuery) (PaymentsResponse,
+	error) {
+
+	return p.db.QueryPayments(query)
+}
+
+// DeletePayment deletes a payment from the DB given its payment hash. If
+// failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
+// deleted.
+func (p *PaymentControl) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	return p.db.DeletePayment(paymentHash, failedHtlcsOnly)
+}
+
+// DeletePayments deletes all completed and failed payments from the DB. If
+// failedOnly is set, only failed payments will be considered for deletion. If
+// failedHtlcsOnly is set, the payment itself won't be deleted, only failed HTLC
+// attempts.
+func (p *PaymentControl) DeletePayments(failedOnly,
+	failedHtlcsOnly bool) error {
+
+	return p.db.DeletePayments(failedOnly, failedHtlcsOnly)
+}
+
 // paymentIndexTypeHash is a payment index type which indicates that we have
 // created an index of payment sequence number to payment hash.
 type paymentIndexType uint8
@@ -341,92 +385,10 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 		}
 
 		// Check if registering a new attempt is allowed.
-		if err := payment.Registrable(); err != nil {
+		if err := verifyAttempt(payment, attempt); err != nil {
 			return err
 		}
 
-		// If the final hop has encrypted data, then we know this is a
-		// blinded payment. In blinded payments, MPP records are not set
-		// for split payments and the recipient is responsible for using
-		// a consistent PathID across the various encrypted data
-		// payloads that we received from them for this payment. All we
-		// need to check is that the total amount field for each HTLC
-		// in the split payment is correct.
-		isBlinded := len(attempt.Route.FinalHop().EncryptedData) != 0
-
-		// Make sure any existing shards match the new one with regards
-		// to MPP options.
-		mpp := attempt.Route.FinalHop().MPP
-
-		// MPP records should not be set for attempts to blinded paths.
-		if isBlinded && mpp != nil {
-			return ErrMPPRecordInBlindedPayment
-		}
-
-		for _, h := range payment.InFlightHTLCs() {
-			hMpp := h.Route.FinalHop().MPP
-
-			// If this is a blinded payment, then no existing HTLCs
-			// should have MPP records.
-			if isBlinded && hMpp != nil {
-				return ErrMPPRecordInBlindedPayment
-			}
-
-			// If this is a blinded payment, then we just need to
-			// check that the TotalAmtMsat field for this shard
-			// is equal to that of any other shard in the same
-			// payment.
-			if isBlinded {
-				if attempt.Route.FinalHop().TotalAmtMsat !=
-					h.Route.FinalHop().TotalAmtMsat {
-
-					//nolint:lll
-					return ErrBlindedPaymentTotalAmountMismatch
-				}
-
-				continue
-			}
-
-			switch {
-			// We tried to register a non-MPP attempt for a MPP
-			// payment.
-			case mpp == nil && hMpp != nil:
-				return ErrMPPayment
-
-			// We tried to register a MPP shard for a non-MPP
-			// payment.
-			case mpp != nil && hMpp == nil:
-				return ErrNonMPPayment
-
-			// Non-MPP payment, nothing more to validate.
-			case mpp == nil:
-				continue
-			}
-
-			// Check that MPP options match.
-			if mpp.PaymentAddr() != hMpp.PaymentAddr() {
-				return ErrMPPPaymentAddrMismatch
-			}
-
-			if mpp.TotalMsat() != hMpp.TotalMsat() {
-				return ErrMPPTotalAmountMismatch
-			}
-		}
-
-		// If this is a non-MPP attempt, it must match the total amount
-		// exactly. Note that a blinded payment is considered an MPP
-		// attempt.
-		amt := attempt.Route.ReceiverAmt()
-		if !isBlinded && mpp == nil && amt != payment.Info.Value {
-			return ErrValueMismatch
-		}
-
-		// Ensure we aren't sending more than the total payment amount.
-		sentAmt, _ := payment.SentAmt()
-		if sentAmt+amt > payment.Info.Value {
-			return ErrValueExceedsAmt
-		}
-
 		htlcsBucket, err := bucket.CreateBucketIfNotExists(
 			paymentHtlcsBucket,
 		)
@@ -453,6 +415,94 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 	return payment, err
 }
 
+// verifyAttempt validates that the given attempt can be registered for the
+// payment, making sure it is consistent with the already registered attempts
+// and doesn't take the payment above its total amount.
+func verifyAttempt(payment *MPPayment, attempt *HTLCAttemptInfo) error {
+	// Check if registering a new attempt is allowed.
+	if err := payment.Registrable(); err != nil {
+		return err
+	}
+
+	// If the final hop has encrypted data, then we know this is a blinded
+	// payment. In blinded payments, MPP records are not set for split
+	// payments and the recipient is responsible for using a consistent
+	// PathID across the various encrypted data payloads that we received
+	// from them for this payment. All we need to check is that the total
+	// amount field for each HTLC in the split payment is correct.
+	isBlinded := len(attempt.Route.FinalHop().EncryptedData) != 0
+
+	// Make sure any existing shards match the new one with regards to MPP
+	// options.
+	mpp := attempt.Route.FinalHop().MPP
+
+	// MPP records should not be set for attempts to blinded paths.
+	if isBlinded && mpp != nil {
+		return ErrMPPRecordInBlindedPayment
+	}
+
+	for _, h := range payment.InFlightHTLCs() {
+		hMpp := h.Route.FinalHop().MPP
+
+		// If this is a blinded payment, then no existing HTLCs should
+		// have MPP records.
+		if isBlinded && hMpp != nil {
+			return ErrMPPRecordInBlindedPayment
+		}
+
+		// If this is a blinded payment, then we just need to check that
+		// the TotalAmtMsat field for this shard is equal to that of any
+		// other shard in the same payment.
+		if isBlinded {
+			if attempt.Route.FinalHop().TotalAmtMsat !=
+				h.Route.FinalHop().TotalAmtMsat {
+
+				return ErrBlindedPaymentTotalAmountMismatch
+			}
+
+			continue
+		}
+
+		switch {
+		// We tried to register a non-MPP attempt for a MPP payment.
+		case mpp == nil && hMpp != nil:
+			return ErrMPPayment
+
+		// We tried to register a MPP shard for a non-MPP payment.
+		case mpp != nil && hMpp == nil:
+			return ErrNonMPPayment
+
+		// Non-MPP payment, nothing more to validate.
+		case mpp == nil:
+			continue
+		}
+
+		// Check that MPP options match.
+		if mpp.PaymentAddr() != hMpp.PaymentAddr() {
+			return ErrMPPPaymentAddrMismatch
+		}
+
+		if mpp.TotalMsat() != hMpp.TotalMsat() {
+			return ErrMPPTotalAmountMismatch
+		}
+	}
+
+	// If this is a non-MPP attempt, it must match the total amount exactly.
+	// Note that a blinded payment is considered an MPP attempt.
+	amt := attempt.Route.ReceiverAmt()
+	if !isBlinded && mpp == nil && amt != payment.Info.Value {
+		return ErrValueMismatch
+	}
+
+	// Ensure we aren't sending more than the total payment amount.
+	sentAmt, _ := payment.SentAmt()
+	if sentAmt+amt > payment.Info.Value {
+		return ErrValueExceedsAmt
+	}
+
+	return nil
+}
+
 // SettleAttempt marks the given attempt settled with the preimage. If this is
 // a multi shard payment, this might implicitly mean that the full payment
 // succeeded.
@@ -0,0 +1,635 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+const (
+	// DefaultPaymentMigrationBatchSize is the default number of payments
+	// that are migrated from the KV store to the SQL store in a single
+	// transaction.
+	DefaultPaymentMigrationBatchSize = 1000
+)
+
+// KVPaymentSource is the subset of the KV payment store that is needed to
+// migrate its payments to the native SQL store.
+type KVPaymentSource interface {
+	// QueryPayments queries the payments within the specified sequence
+	// number range.
+	QueryPayments(query PaymentsQuery) (PaymentsResponse, error)
+
+	// FetchPayment returns the payment that is stored under the given
+	// payment hash.
+	FetchPayment(paymentHash lntypes.Hash) (*MPPayment, error)
+}
+
+// paymentMigrationState is the progress of the payment migration.
+type paymentMigrationState struct {
+	// LastSequenceNum is the sequence number of the last migrated payment.
+	LastSequenceNum uint64
+
+	// Completed is true if all payments were migrated and verified.
+	Completed bool
+}
+
+// MigratePaymentsToSQL copies all payments of the KV database to the native
+// SQL payment store, including their HTLC attempts and the failures of those
+// attempts. The sequence number of each payment is kept as its ID, so that
+// the payment indices that clients paginate with stay valid. Payments are
+// migrated in batches of batchSize, and the progress is recorded in the SQL
+// database along with each batch. If the migration is interrupted, calling
+// this function again resumes it from the last migrated batch. Once all
+// payments are copied, the migrated payments are verified against the KV
+// payments. Calling this function after the migration completed is a noop.
+//
+// NOTE: Old versions of lnd could store duplicate payments to the same payment
+// hash. As payment hashes are unique in the SQL store, only the payment that
+// the KV store returns for the hash is migrated, while its duplicates are
+// skipped.
+func MigratePaymentsToSQL(ctx context.Context, kvStore KVPaymentSource,
+	db BatchedSQLPaymentQueries, batchSize int) error {
+
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid migration batch size: %d",
+			batchSize)
+	}
+
+	state, err := fetchPaymentMigrationState(ctx, kvStore, db)
+	if err != nil {
+		return err
+	}
+
+	if state.Completed {
+		log.Debugf("Payment migration to SQL already completed")
+
+		return nil
+	}
+
+	startTime := time.Now()
+
+	log.Infof("Migrating payments to SQL, starting after sequence "+
+		"number %d", state.LastSequenceNum)
+
+	state, err = migratePayments(ctx, kvStore, db, state, batchSize)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Verifying migrated payments")
+
+	err = verifyMigratedPayments(ctx, kvStore, db, batchSize)
+	if err != nil {
+		return fmt.Errorf("payment migration verification failed: %w",
+			err)
+	}
+
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	err = db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		// Sqlite always uses the highest existing ID plus one for new
+		// rows, while postgres relies on a sequence that doesn't know
+		// about the explicitly inserted IDs.
+		if db.Backend() == sqlc.BackendTypePostgres {
+			err := db.ResetPaymentIDSequence(ctx)
+			if err != nil {
+				return fmt.Errorf("unable to reset payment ID "+
+					"sequence: %w", err)
+			}
+		}
+
+		state.Completed = true
+
+		return upsertPaymentMigrationState(ctx, db, state)
+	}, func() {})
+	if err != nil {
+		return fmt.Errorf("unable to complete payment migration: %w",
+			err)
+	}
+
+	log.Infof("Payment migration to SQL completed in %v, last sequence "+
+		"number %d", time.Since(startTime), state.LastSequenceNum)
+
+	return nil
+}
+
+// fetchPaymentMigrationState fetches the current progress of the payment
+// migration. If the migration hasn't been started yet, we'll make sure that
+// there are no payments in the SQL store that could conflict with the migrated
+// ones. The only exception is a KV store without any payments, in which case
+// there's nothing to migrate and the migration is marked as completed.
+func fetchPaymentMigrationState(ctx context.Context,
+	kvStore KVPaymentSource,
+	db BatchedSQLPaymentQueries) (*paymentMigrationState, error) {
+
+	resp, err := kvStore.QueryPayments(PaymentsQuery{
+		MaxPayments:       1,
+		IncludeIncomplete: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query KV payments: %w", err)
+	}
+	kvEmpty := len(resp.Payments) == 0
+
+	var (
+		state       *paymentMigrationState
+		writeTxOpts SQLPaymentQueriesTxOptions
+	)
+	err = db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		row, err := db.GetPaymentMigrationState(ctx)
+		switch {
+		case err == nil:
+			state = &paymentMigrationState{
+				LastSequenceNum: uint64(row.LastSequenceNum),
+				Completed:       row.Completed,
+			}
+
+			return nil
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+
+		state = &paymentMigrationState{}
+
+		if kvEmpty {
+			log.Debugf("No KV payments found, skipping payment " +
+				"migration to SQL")
+
+			state.Completed = true
+
+			return upsertPaymentMigrationState(ctx, db, state)
+		}
+
+		numPayments, err := db.CountPayments(ctx)
+		if err != nil {
+			return err
+		}
+
+		if numPayments != 0 {
+			return fmt.Errorf("unable to migrate KV payments, SQL "+
+				"store already contains %d payments",
+				numPayments)
+		}
+
+		return nil
+	}, func() {
+		state = nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch payment migration "+
+			"state: %w", err)
+	}
+
+	return state, nil
+}
+
+// upsertPaymentMigrationState stores the passed payment migration progress.
+func upsertPaymentMigrationState(ctx context.Context, db SQLPaymentQueries,
+	state *paymentMigrationState) error {
+
+	err := db.UpsertPaymentMigrationState(
+		ctx, sqlc.UpsertPaymentMigrationStateParams{
+			LastSequenceNum: int64(state.LastSequenceNum),
+			Completed:       state.Completed,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to store payment migration state: %w",
+			err)
+	}
+
+	return nil
+}
+
+// migratePayments copies the KV payments with a sequence number greater than
+// the last migrated one to the SQL store. The progress is stored along with
+// each batch, and the updated state is returned.
+func migratePayments(ctx context.Context, kvStore KVPaymentSource,
+	db BatchedSQLPaymentQueries, state *paymentMigrationState,
+	batchSize int) (*paymentMigrationState, error) {
+
+	var (
+		writeTxOpts   SQLPaymentQueriesTxOptions
+		numMigrated   int
+		numDuplicates int
+	)
+	for {
+		resp, err := kvStore.QueryPayments(PaymentsQuery{
+			IndexOffset:       state.LastSequenceNum,
+			MaxPayments:       uint64(batchSize),
+			IncludeIncomplete: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to query KV payments: "+
+				"%w", err)
+		}
+
+		if len(resp.Payments) == 0 {
+			break
+		}
+
+		newState := *state
+		var batchDuplicates int
+		err = db.ExecTx(ctx, &writeTxOpts, func(
+			db SQLPaymentQueries) error {
+
+			for _, payment := range resp.Payments {
+				migrated, err := migratePayment(
+					ctx, kvStore, db, payment,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to migrate "+
+						"payment(%v) with sequence "+
+						"number %d: %w",
+						payment.Info.PaymentIdentifier,
+						payment.SequenceNum, err)
+				}
+
+				if !migrated {
+					batchDuplicates++
+				}
+			}
+
+			newState.LastSequenceNum = resp.LastIndexOffset
+
+			return upsertPaymentMigrationState(ctx, db, &newState)
+		}, func() {
+			newState = *state
+			batchDuplicates = 0
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		state = &newState
+		numMigrated += len(resp.Payments) - batchDuplicates
+		numDuplicates += batchDuplicates
+
+		log.Infof("Migrated %d payments to SQL, skipped %d duplicate "+
+			"payments, last sequence number %d", numMigrated,
+			numDuplicates, state.LastSequenceNum)
+	}
+
+	return state, nil
+}
+
+// migratePayment inserts the passed KV payment along with its HTLC attempts
+// into the SQL store, using its sequence number as the payment ID. False is
+// returned if the payment is a legacy duplicate payment that isn't migrated.
+func migratePayment(ctx context.Context, kvStore KVPaymentSource,
+	db SQLPaymentQueries, payment *MPPayment) (bool, error) {
+
+	hash := payment.Info.PaymentIdentifier
+
+	// A payment with the same hash may already have been migrated if the
+	// KV store contains duplicate payments. We only keep the payment that
+	// the KV store returns for the hash, which may replace a duplicate
+	// that was migrated before.
+	row, err := db.FetchPayment(ctx, hash[:])
+	switch {
+	case err == nil:
+		isDuplicate, err := isDuplicatePayment(kvStore, payment)
+		if err != nil {
+			return false, err
+		}
+
+		if isDuplicate {
+			log.Debugf("Skipping duplicate payment(%v) with "+
+				"sequence number %d", hash, payment.SequenceNum)
+
+			return false, nil
+		}
+
+		if err := db.DeletePayment(ctx, row.ID); err != nil {
+			return false, fmt.Errorf("unable to delete duplicate "+
+				"payment: %w", err)
+		}
+
+	case !errors.Is(err, sql.ErrNoRows):
+		return false, fmt.Errorf("unable to fetch payment: %w", err)
+	}
+
+	var destination []byte
+	if dest := paymentDestination(payment.HTLCs); dest != nil {
+		destination = dest[:]
+	}
+
+	var failReason sql.NullInt16
+	if payment.FailureReason != nil {
+		failReason = sql.NullInt16{
+			Int16: int16(*payment.FailureReason),
+			Valid: true,
+		}
+	}
+
+	paymentID := int64(payment.SequenceNum)
+	err = db.InsertMigratedPayment(ctx, sqlc.InsertMigratedPaymentParams{
+		ID:             paymentID,
+		Hash:           hash[:],
+		AmountMsat:     int64(payment.Info.Value),
+		CreatedAt:      payment.Info.CreationTime.UTC(),
+		PaymentRequest: payment.Info.PaymentRequest,
+		Destination:    destination,
+		Status:         int16(payment.Status),
+		FailReason:     failReason,
+		PaymentType:    int16(payment.Info.Type),
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to insert payment: %w", err)
+	}
+
+	for i := range payment.HTLCs {
+		err := migrateHTLCAttempt(ctx, db, paymentID, &payment.HTLCs[i])
+		if err != nil {
+			return false, fmt.Errorf("unable to migrate htlc "+
+				"attempt %d: %w", payment.HTLCs[i].AttemptID,
+				err)
+		}
+	}
+
+	return true, nil
+}
+
+// isDuplicatePayment returns true if the passed KV payment isn't the payment
+// that the KV store returns for its payment hash, which means that it's a
+// legacy duplicate payment.
+func isDuplicatePayment(kvStore KVPaymentSource,
+	payment *MPPayment) (bool, error) {
+
+	kvPayment, err := kvStore.FetchPayment(payment.Info.PaymentIdentifier)
+	if err != nil {
+		return false, fmt.Errorf("unable to fetch KV payment: %w", err)
+	}
+
+	return kvPayment.SequenceNum != payment.SequenceNum, nil
+}
+
+// migrateHTLCAttempt inserts the passed KV HTLC attempt along with its
+// settlement or failure into the SQL store.
+func migrateHTLCAttempt(ctx context.Context, db SQLPaymentQueries,
+	paymentID int64, htlc *HTLCAttempt) error {
+
+	var b bytes.Buffer
+	if err := SerializeRoute(&b, htlc.Route); err != nil {
+		return err
+	}
+
+	var attemptHash []byte
+	if htlc.Hash != nil {
+		attemptHash = htlc.Hash[:]
+	}
+
+	htlcID, err := db.InsertHTLCAttempt(ctx, sqlc.InsertHTLCAttemptParams{
+		AttemptID:   int64(htlc.AttemptID),
+		PaymentID:   paymentID,
+		SessionKey:  htlc.sessionKey[:],
+		AttemptTime: htlc.AttemptTime.UTC(),
+		Hash:        attemptHash,
+		Route:       b.Bytes(),
+		AmountMsat:  int64(htlc.Route.ReceiverAmt()),
+		FeeMsat:     int64(htlc.Route.TotalFees()),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert htlc attempt: %w", err)
+	}
+
+	if htlc.Settle != nil {
+		err := db.SettleHTLCAttempt(ctx, sqlc.SettleHTLCAttemptParams{
+			ID:             htlcID,
+			SettlePreimage: htlc.Settle.Preimage[:],
+			SettledAt:      sqldb.SQLTime(htlc.Settle.SettleTime.UTC()),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to settle htlc attempt: %w",
+				err)
+		}
+	}
+
+	if htlc.Failure == nil {
+		return nil
+	}
+
+	var failureMsg []byte
+	if htlc.Failure.Message != nil {
+		var b bytes.Buffer
+		err := lnwire.EncodeFailureMessage(&b, htlc.Failure.Message, 0)
+		if err != nil {
+			return err
+		}
+		failureMsg = b.Bytes()
+	}
+
+	err = db.InsertHTLCFailure(ctx, sqlc.InsertHTLCFailureParams{
+		HtlcAttemptID:      htlcID,
+		FailedAt:           htlc.Failure.FailTime.UTC(),
+		Reason:             int16(htlc.Failure.Reason),
+		FailureMsg:         failureMsg,
+		FailureSourceIndex: int64(htlc.Failure.FailureSourceIndex),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert htlc failure: %w", err)
+	}
+
+	return nil
+}
+
+// verifyMigratedPayments compares each KV payment with its migrated
+// counterpart in the SQL store, and makes sure that the SQL store contains
+// exactly the KV payments that aren't legacy duplicates.
+func verifyMigratedPayments(ctx context.Context, kvStore KVPaymentSource,
+	db BatchedSQLPaymentQueries, batchSize int) error {
+
+	var (
+		readOpts      = NewSQLPaymentQueryReadTx()
+		indexOffset   uint64
+		numPayments   int64
+		numDuplicates int64
+	)
+	for {
+		resp, err := kvStore.QueryPayments(PaymentsQuery{
+			IndexOffset:       indexOffset,
+			MaxPayments:       uint64(batchSize),
+			IncludeIncomplete: true,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query KV payments: %w",
+				err)
+		}
+
+		if len(resp.Payments) == 0 {
+			break
+		}
+
+		var batchDuplicates int64
+		err = db.ExecTx(ctx, &readOpts, func(
+			db SQLPaymentQueries) error {
+
+			for _, payment := range resp.Payments {
+				migrated, err := verifyMigratedPayment(
+					ctx, kvStore, db, payment,
+				)
+				if err != nil {
+					return err
+				}
+
+				if !migrated {
+					batchDuplicates++
+				}
+			}
+
+			return nil
+		}, func() {
+			batchDuplicates = 0
+		})
+		if err != nil {
+			return err
+		}
+
+		numPayments += int64(len(resp.Payments))
+		numDuplicates += batchDuplicates
+		indexOffset = resp.LastIndexOffset
+	}
+
+	var numSQLPayments int64
+	err := db.ExecTx(ctx, &readOpts, func(db SQLPaymentQueries) error {
+		var err error
+		numSQLPayments, err = db.CountPayments(ctx)
+
+		return err
+	}, func() {})
+	if err != nil {
+		return fmt.Errorf("unable to count SQL payments: %w", err)
+	}
+
+	if numSQLPayments != numPayments-numDuplicates {
+		return fmt.Errorf("payment count mismatch: KV store has %d "+
+			"payments and %d duplicates, SQL store has %d",
+			numPayments-numDuplicates, numDuplicates,
+			numSQLPayments)
+	}
+
+	return nil
+}
+
+// verifyMigratedPayment compares the passed KV payment with the SQL payment
+// that is stored under the same payment hash. False is returned if the KV
+// payment is a legacy duplicate payment that wasn't migrated.
+func verifyMigratedPayment(ctx context.Context, kvStore KVPaymentSource,
+	db SQLPaymentQueries, kvPayment *MPPayment) (bool, error) {
+
+	hash := kvPayment.Info.PaymentIdentifier
+
+	p, err := fetchSQLPayment(ctx, db, hash)
+	if err != nil {
+		return false, err
+	}
+	sqlPayment := p.MPPayment
+
+	if sqlPayment.SequenceNum != kvPayment.SequenceNum {
+		isDuplicate, err := isDuplicatePayment(kvStore, kvPayment)
+		if err != nil {
+			return false, err
+		}
+
+		if !isDuplicate {
+			return false, fmt.Errorf("payment(%v) sequence number "+
+				"mismatch: KV %d, SQL %d", hash,
+				kvPayment.SequenceNum, sqlPayment.SequenceNum)
+		}
+
+		return false, nil
+	}
+
+	mismatch := func(field string, kvValue, sqlValue interface{}) error {
+		return fmt.Errorf("payment(%v) %s mismatch: KV %v, SQL %v",
+			hash, field, kvValue, sqlValue)
+	}
+
+	kvInfo, sqlInfo := kvPayment.Info, sqlPayment.Info
+	switch {
+	case sqlInfo.Value != kvInfo.Value:
+		return false, mismatch("value", kvInfo.Value, sqlInfo.Value)
+
+	case sqlInfo.CreationTime.Unix() != kvInfo.CreationTime.Unix():
+		return false, mismatch("creation time", kvInfo.CreationTime,
+			sqlInfo.CreationTime)
+
+	case !bytes.Equal(sqlInfo.PaymentRequest, kvInfo.PaymentRequest):
+		return false, mismatch("payment request",
+			string(kvInfo.PaymentRequest),
+			string(sqlInfo.PaymentRequest))
+
+	case sqlInfo.Type != kvInfo.Type:
+		return false, mismatch("type", kvInfo.Type, sqlInfo.Type)
+
+	case sqlPayment.Status != kvPayment.Status:
+		return false, mismatch("status", kvPayment.Status,
+			sqlPayment.Status)
+
+	case (sqlPayment.FailureReason == nil) !=
+		(kvPayment.FailureReason == nil):
+
+		return false, mismatch("failure reason",
+			kvPayment.FailureReason, sqlPayment.FailureReason)
+
+	case sqlPayment.FailureReason != nil &&
+		*sqlPayment.FailureReason != *kvPayment.FailureReason:
+
+		return false, mismatch("failure reason",
+			*kvPayment.FailureReason, *sqlPayment.FailureReason)
+
+	case len(sqlPayment.HTLCs) != len(kvPayment.HTLCs):
+		return false, mismatch("number of HTLC attempts",
+			len(kvPayment.HTLCs), len(sqlPayment.HTLCs))
+	}
+
+	// Both stores return the HTLC attempts ordered by their attempt ID.
+	for i := range kvPayment.HTLCs {
+		kvHtlc, sqlHtlc := &kvPayment.HTLCs[i], &sqlPayment.HTLCs[i]
+
+		switch {
+		case sqlHtlc.AttemptID != kvHtlc.AttemptID:
+			return false, mismatch("HTLC attempt ID",
+				kvHtlc.AttemptID, sqlHtlc.AttemptID)
+
+		case sqlHtlc.sessionKey != kvHtlc.sessionKey:
+			return false, fmt.Errorf("payment(%v) HTLC attempt %d "+
+				"session key mismatch", hash, kvHtlc.AttemptID)
+
+		case (sqlHtlc.Settle == nil) != (kvHtlc.Settle == nil):
+			return false, mismatch(fmt.Sprintf("HTLC attempt "+
+				"%d settlement", kvHtlc.AttemptID),
+				kvHtlc.Settle, sqlHtlc.Settle)
+
+		case sqlHtlc.Settle != nil &&
+			sqlHtlc.Settle.Preimage != kvHtlc.Settle.Preimage:
+
+			return false, mismatch(fmt.Sprintf("HTLC attempt "+
+				"%d preimage", kvHtlc.AttemptID),
+				kvHtlc.Settle.Preimage, sqlHtlc.Settle.Preimage)
+
+		case (sqlHtlc.Failure == nil) != (kvHtlc.Failure == nil):
+			return false, mismatch(fmt.Sprintf("HTLC attempt "+
+				"%d failure", kvHtlc.AttemptID),
+				kvHtlc.Failure, sqlHtlc.Failure)
+
+		case sqlHtlc.Failure != nil &&
+			sqlHtlc.Failure.Reason != kvHtlc.Failure.Reason:
+
+			return false, mismatch(fmt.Sprintf("HTLC attempt "+
+				"%d failure reason", kvHtlc.AttemptID),
+				kvHtlc.Failure.Reason, sqlHtlc.Failure.Reason)
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,239 @@
+package channeldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// failingPaymentSource wraps a KV payment source and fails all payment queries
+// after the given number of successful queries.
+type failingPaymentSource struct {
+	KVPaymentSource
+
+	numQueries int
+}
+
+// QueryPayments fails once the number of allowed queries is exhausted.
+func (f *failingPaymentSource) QueryPayments(
+	query PaymentsQuery) (PaymentsResponse, error) {
+
+	if f.numQueries == 0 {
+		return PaymentsResponse{}, errors.New("query failed")
+	}
+	f.numQueries--
+
+	return f.KVPaymentSource.QueryPayments(query)
+}
+
+// newPaymentMigrationTestStores creates a KV payment store and an empty
+// sqlite backed payment store along with its executor.
+func newPaymentMigrationTestStores(t *testing.T) (*PaymentControl,
+	BatchedSQLPaymentQueries, *SQLPaymentStore) {
+
+	kvDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) SQLPaymentQueries {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewPaymentControl(kvDB), executor,
+		NewSQLPaymentStore(executor, true)
+}
+
+// addMigrationTestPayments adds payments in all states to the KV store and
+// returns their hashes in the order of their sequence numbers.
+func addMigrationTestPayments(t *testing.T,
+	kvStore *PaymentControl) []lntypes.Hash {
+
+	hashes := []lntypes.Hash{
+		createStorePayment(t, kvStore, 1000, 100, vertex,
+			paymentSucceeded),
+		createStorePayment(t, kvStore, 2000, 200, vertex,
+			paymentFailed),
+		createStorePayment(t, kvStore, 3000, 300, vertex,
+			paymentInFlight),
+	}
+
+	// A payment without any attempts.
+	info, _, _ := newTestPayment(t, 4000, 400, vertex, 0)
+	info.Type = PaymentTypeRebalance
+	require.NoError(t, kvStore.InitPayment(info.PaymentIdentifier, info))
+	hashes = append(hashes, info.PaymentIdentifier)
+
+	hashes = append(hashes, createStorePayment(
+		t, kvStore, 5000, 500, vertex, paymentSucceeded,
+	))
+
+	return hashes
+}
+
+// assertPaymentsMigrated asserts that the payments with the given hashes are
+// equal in the KV and the SQL store, including their sequence numbers, and
+// that the SQL store contains no other payments.
+func assertPaymentsMigrated(t *testing.T, kvStore *PaymentControl,
+	sqlStore *SQLPaymentStore, hashes []lntypes.Hash) {
+
+	for _, hash := range hashes {
+		kvPayment, err := kvStore.FetchPayment(hash)
+		require.NoError(t, err)
+
+		sqlPayment, err := sqlStore.FetchPayment(hash)
+		require.NoError(t, err)
+
+		// The KV store returns nil instead of an empty slice for
+		// payments without any attempts.
+		if len(kvPayment.HTLCs) == 0 {
+			require.Empty(t, sqlPayment.HTLCs)
+			sqlPayment.HTLCs = nil
+		}
+
+		require.Equal(t, kvPayment, sqlPayment)
+	}
+
+	sqlResp, err := sqlStore.QueryPayments(PaymentsQuery{
+		MaxPayments:       100,
+		IncludeIncomplete: true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sqlResp.Payments, len(hashes))
+	for i, payment := range sqlResp.Payments {
+		require.Equal(
+			t, hashes[i], payment.Info.PaymentIdentifier,
+		)
+	}
+}
+
+// TestMigratePaymentsToSQL tests that the KV payments are copied to the SQL
+// payment store together with their HTLC attempts and sequence numbers, and
+// that the migration is only executed once.
+func TestMigratePaymentsToSQL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kvStore, executor, sqlStore := newPaymentMigrationTestStores(t)
+	hashes := addMigrationTestPayments(t, kvStore)
+
+	err := MigratePaymentsToSQL(ctx, kvStore, executor, 2)
+	require.NoError(t, err)
+
+	assertPaymentsMigrated(t, kvStore, sqlStore, hashes)
+
+	// New payments continue with the sequence numbers of the KV store.
+	hash := createStorePayment(t, sqlStore, 6000, 600, vertex,
+		paymentSucceeded)
+	payment, err := sqlStore.FetchPayment(hash)
+	require.NoError(t, err)
+	require.EqualValues(t, len(hashes)+1, payment.SequenceNum)
+
+	// Running the migration again is a noop, even though the SQL store
+	// now contains more payments than the KV store.
+	err = MigratePaymentsToSQL(ctx, kvStore, executor, 2)
+	require.NoError(t, err)
+}
+
+// TestMigratePaymentsToSQLResume tests that an interrupted migration can be
+// resumed.
+func TestMigratePaymentsToSQLResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kvStore, executor, sqlStore := newPaymentMigrationTestStores(t)
+	hashes := addMigrationTestPayments(t, kvStore)
+
+	// Interrupt the migration after the first batch of payments. The
+	// first query checks whether there are any KV payments at all.
+	source := &failingPaymentSource{
+		KVPaymentSource: kvStore,
+		numQueries:      2,
+	}
+	err := MigratePaymentsToSQL(ctx, source, executor, 3)
+	require.ErrorContains(t, err, "query failed")
+
+	// Only the first batch should have been migrated.
+	resp, err := sqlStore.QueryPayments(PaymentsQuery{
+		MaxPayments:       100,
+		IncludeIncomplete: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Payments, 3)
+
+	// Now resume the migration which should pick up the remaining
+	// payments.
+	err = MigratePaymentsToSQL(ctx, kvStore, executor, 3)
+	require.NoError(t, err)
+
+	assertPaymentsMigrated(t, kvStore, sqlStore, hashes)
+}
+
+// TestMigratePaymentsToSQLDuplicates tests that only the payment that the KV
+// store returns for a payment hash is migrated, and that legacy duplicate
+// payments are skipped, regardless of their sequence number.
+func TestMigratePaymentsToSQLDuplicates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kvStore, executor, sqlStore := newPaymentMigrationTestStores(t)
+
+	// Create two payments and delete the first one, so that we can add a
+	// duplicate of the second payment with a lower sequence number.
+	deleted := createStorePayment(t, kvStore, 1000, 100, vertex,
+		paymentFailed)
+	hash := createStorePayment(t, kvStore, 2000, 200, vertex,
+		paymentSucceeded)
+	require.NoError(t, kvStore.DeletePayment(deleted, false))
+
+	preimage, err := genPreimage()
+	require.NoError(t, err)
+	appendDuplicatePayment(t, kvStore.db, hash, 1, preimage)
+	appendDuplicatePayment(t, kvStore.db, hash, 3, preimage)
+
+	// With a batch size of one, the duplicate with the lower sequence
+	// number is migrated in its own batch before it's replaced.
+	err = MigratePaymentsToSQL(ctx, kvStore, executor, 1)
+	require.NoError(t, err)
+
+	assertPaymentsMigrated(t, kvStore, sqlStore, []lntypes.Hash{hash})
+
+	payment, err := sqlStore.FetchPayment(hash)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, payment.SequenceNum)
+}
+
+// TestMigratePaymentsToSQLNotEmpty tests that KV payments aren't migrated to
+// an SQL store that already contains payments, unless there are no KV
+// payments at all.
+func TestMigratePaymentsToSQLNotEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kvStore, executor, sqlStore := newPaymentMigrationTestStores(t)
+
+	createStorePayment(t, sqlStore, 1000, 100, vertex, paymentSucceeded)
+
+	// Without any KV payments, there's nothing to migrate.
+	err := MigratePaymentsToSQL(ctx, kvStore, executor, 2)
+	require.NoError(t, err)
+
+	// Once the migration is marked as completed, KV payments that show up
+	// later are never migrated.
+	_, executor2, sqlStore2 := newPaymentMigrationTestStores(t)
+	createStorePayment(t, sqlStore2, 1000, 100, vertex, paymentSucceeded)
+	addMigrationTestPayments(t, kvStore)
+
+	err = MigratePaymentsToSQL(ctx, kvStore, executor, 2)
+	require.NoError(t, err)
+
+	err = MigratePaymentsToSQL(ctx, kvStore, executor2, 2)
+	require.ErrorContains(t, err, "already contains 1 payments")
+}
@@ -0,0 +1,865 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+// SQLPaymentQueries is an interface that defines the set of operations that
+// can be executed against the payments SQL database.
+type SQLPaymentQueries interface { //nolint:interfacebloat
+	InsertPayment(ctx context.Context, arg sqlc.InsertPaymentParams) (int64,
+		error)
+
+	FetchPayment(ctx context.Context, hash []byte) (sqlc.Payment, error)
+
+	UpdatePaymentStatus(ctx context.Context,
+		arg sqlc.UpdatePaymentStatusParams) error
+
+	UpdatePaymentFailReason(ctx context.Context,
+		arg sqlc.UpdatePaymentFailReasonParams) error
+
+	UpdatePaymentDestination(ctx context.Context,
+		arg sqlc.UpdatePaymentDestinationParams) error
+
+	DeletePayment(ctx context.Context, id int64) error
+
+	DeletePayments(ctx context.Context, status sql.NullInt16) error
+
+	FilterPayments(ctx context.Context,
+		arg sqlc.FilterPaymentsParams) ([]sqlc.Payment, error)
+
+	CountPayments(ctx context.Context) (int64, error)
+
+	FetchInFlightPayments(ctx context.Context) ([]sqlc.Payment, error)
+
+	// HTLC attempt specific methods.
+	InsertHTLCAttempt(ctx context.Context,
+		arg sqlc.InsertHTLCAttemptParams) (int64, error)
+
+	SettleHTLCAttempt(ctx context.Context,
+		arg sqlc.SettleHTLCAttemptParams) error
+
+	InsertHTLCFailure(ctx context.Context,
+		arg sqlc.InsertHTLCFailureParams) error
+
+	FetchHTLCAttempts(ctx context.Context,
+		paymentID int64) ([]sqlc.FetchHTLCAttemptsRow, error)
+
+	DeleteFailedHTLCAttempts(ctx context.Context,
+		arg sqlc.DeleteFailedHTLCAttemptsParams) error
+
+	// Payment migration specific methods.
+	Backend() sqlc.BackendType
+
+	InsertMigratedPayment(ctx context.Context,
+		arg sqlc.InsertMigratedPaymentParams) error
+
+	ResetPaymentIDSequence(ctx context.Context) error
+
+	GetPaymentMigrationState(ctx context.Context) (
+		sqlc.PaymentMigrationState, error)
+
+	UpsertPaymentMigrationState(ctx context.Context,
+		arg sqlc.UpsertPaymentMigrationStateParams) error
+}
+
+var _ PaymentStore = (*SQLPaymentStore)(nil)
+
+// SQLPaymentQueriesTxOptions defines the set of db txn options the
+// SQLPaymentQueries understands.
+type SQLPaymentQueriesTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions.
+func (a *SQLPaymentQueriesTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewSQLPaymentQueryReadTx creates a new read transaction option set.
+func NewSQLPaymentQueryReadTx() SQLPaymentQueriesTxOptions {
+	return SQLPaymentQueriesTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedSQLPaymentQueries is a version of the SQLPaymentQueries that's
+// capable of batched database operations.
+type BatchedSQLPaymentQueries interface {
+	SQLPaymentQueries
+
+	sqldb.BatchedTx[SQLPaymentQueries]
+}
+
+// SQLPaymentStore is a PaymentStore that keeps the payments in a native SQL
+// database.
+type SQLPaymentStore struct {
+	db BatchedSQLPaymentQueries
+
+	// keepFailedPaymentAttempts determines whether failed HTLC attempts
+	// are kept once the payment reached a terminal state.
+	keepFailedPaymentAttempts bool
+}
+
+// NewSQLPaymentStore creates a new SQLPaymentStore instance given an open
+// BatchedSQLPaymentQueries storage backend.
+func NewSQLPaymentStore(db BatchedSQLPaymentQueries,
+	keepFailedPaymentAttempts bool) *SQLPaymentStore {
+
+	return &SQLPaymentStore{
+		db:                        db,
+		keepFailedPaymentAttempts: keepFailedPaymentAttempts,
+	}
+}
+
+// sqlPayment is a payment together with the database IDs of the payment and
+// its HTLC attempts.
+type sqlPayment struct {
+	*MPPayment
+
+	// id is the database ID of the payment.
+	id int64
+
+	// htlcIDs maps the attempt IDs of the HTLC attempts of the payment to
+	// their database IDs.
+	htlcIDs map[uint64]int64
+}
+
+// InitPayment checks or records the given PaymentCreationInfo with the DB,
+// making sure it does not already exist as an in-flight payment. When this
+// method returns successfully, the payment is guaranteed to be in the
+// Initiated state.
+func (s *SQLPaymentStore) InitPayment(paymentHash lntypes.Hash,
+	info *PaymentCreationInfo) error {
+
+	ctx := context.TODO()
+
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		row, err := db.FetchPayment(ctx, paymentHash[:])
+		switch {
+		// If we already have this payment, we'll check the status to
+		// decide whether we allow retrying the payment. A retried
+		// payment is deleted together with its HTLC attempts, so that
+		// it gets a fresh sequence number like in the kv store.
+		case err == nil:
+			status := PaymentStatus(row.Status)
+			if err := status.initializable(); err != nil {
+				return err
+			}
+
+			if err := db.DeletePayment(ctx, row.ID); err != nil {
+				return fmt.Errorf("unable to delete previous "+
+					"payment: %w", err)
+			}
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("unable to fetch payment: %w", err)
+		}
+
+		_, err = db.InsertPayment(ctx, sqlc.InsertPaymentParams{
+			Hash:           paymentHash[:],
+			AmountMsat:     int64(info.Value),
+			CreatedAt:      info.CreationTime.UTC(),
+			PaymentRequest: info.PaymentRequest,
+			Status:         int16(StatusInitiated),
+			PaymentType:    int16(info.Type),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert payment: %w", err)
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return fmt.Errorf("unable to init payment: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterAttempt atomically records the provided HTLCAttemptInfo to the DB.
+func (s *SQLPaymentStore) RegisterAttempt(paymentHash lntypes.Hash,
+	attempt *HTLCAttemptInfo) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	// Serialize the route before opening the db transaction.
+	var b bytes.Buffer
+	if err := SerializeRoute(&b, attempt.Route); err != nil {
+		return nil, err
+	}
+	routeBytes := b.Bytes()
+
+	var attemptHash []byte
+	if attempt.Hash != nil {
+		attemptHash = attempt.Hash[:]
+	}
+
+	var payment *MPPayment
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		p, err := fetchSQLPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		// Check if registering a new attempt is allowed.
+		if err := verifyAttempt(p.MPPayment, attempt); err != nil {
+			return err
+		}
+
+		_, err = db.InsertHTLCAttempt(ctx, sqlc.InsertHTLCAttemptParams{
+			AttemptID:   int64(attempt.AttemptID),
+			PaymentID:   p.id,
+			SessionKey:  attempt.sessionKey[:],
+			AttemptTime: attempt.AttemptTime.UTC(),
+			Hash:        attemptHash,
+			Route:       routeBytes,
+			AmountMsat:  int64(attempt.Route.ReceiverAmt()),
+			FeeMsat:     int64(attempt.Route.TotalFees()),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert htlc attempt: %w",
+				err)
+		}
+
+		// The destination of the payment is only set by its first
+		// attempt.
+		if finalHop := attempt.Route.FinalHop(); finalHop != nil {
+			err := db.UpdatePaymentDestination(
+				ctx, sqlc.UpdatePaymentDestinationParams{
+					ID:          p.id,
+					Destination: finalHop.PubKeyBytes[:],
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update payment "+
+					"destination: %w", err)
+			}
+		}
+
+		payment, err = updateSQLPaymentStatus(ctx, db, paymentHash)
+
+		return err
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// SettleAttempt marks the given attempt settled with the preimage. If this is
+// a multi shard payment, this might implicitly mean that the full payment
+// succeeded.
+//
+// After invoking this method, InitPayment should always return an error to
+// prevent us from making duplicate payments to the same payment hash. The
+// provided preimage is atomically saved to the DB for record keeping.
+func (s *SQLPaymentStore) SettleAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, settleInfo *HTLCSettleInfo) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	return s.updateHtlc(ctx, paymentHash, attemptID,
+		func(db SQLPaymentQueries, htlcID int64) error {
+			return db.SettleHTLCAttempt(
+				ctx, sqlc.SettleHTLCAttemptParams{
+					ID:             htlcID,
+					SettlePreimage: settleInfo.Preimage[:],
+					SettledAt: sqldb.SQLTime(
+						settleInfo.SettleTime.UTC(),
+					),
+				},
+			)
+		},
+	)
+}
+
+// FailAttempt marks the given payment attempt failed.
+func (s *SQLPaymentStore) FailAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, failInfo *HTLCFailInfo) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	// Encode the wire failure before opening the db transaction.
+	var failureMsg []byte
+	if failInfo.Message != nil {
+		var b bytes.Buffer
+		err := lnwire.EncodeFailureMessage(&b, failInfo.Message, 0)
+		if err != nil {
+			return nil, err
+		}
+		failureMsg = b.Bytes()
+	}
+
+	return s.updateHtlc(ctx, paymentHash, attemptID,
+		func(db SQLPaymentQueries, htlcID int64) error {
+			return db.InsertHTLCFailure(
+				ctx, sqlc.InsertHTLCFailureParams{
+					HtlcAttemptID: htlcID,
+					FailedAt:      failInfo.FailTime.UTC(),
+					Reason:        int16(failInfo.Reason),
+					FailureMsg:    failureMsg,
+					FailureSourceIndex: int64(
+						failInfo.FailureSourceIndex,
+					),
+				},
+			)
+		},
+	)
+}
+
+// updateHtlc resolves the given HTLC attempt of an in-flight payment with the
+// passed resolve function.
+func (s *SQLPaymentStore) updateHtlc(ctx context.Context,
+	paymentHash lntypes.Hash, attemptID uint64,
+	resolve func(db SQLPaymentQueries, htlcID int64) error) (*MPPayment,
+	error) {
+
+	var payment *MPPayment
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		p, err := fetchSQLPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		// We can only update HTLCs of in-flight payments. We allow
+		// updating HTLCs even if the payment has reached a terminal
+		// condition, since the HTLC outcomes must still be updated.
+		if err := p.Status.updatable(); err != nil {
+			return err
+		}
+
+		htlcID, ok := p.htlcIDs[attemptID]
+		if !ok {
+			return fmt.Errorf("HTLC with ID %v not registered",
+				attemptID)
+		}
+
+		// Make sure the shard is not already failed or settled.
+		htlc, err := p.GetAttempt(attemptID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case htlc.Failure != nil:
+			return ErrAttemptAlreadyFailed
+
+		case htlc.Settle != nil:
+			return ErrAttemptAlreadySettled
+		}
+
+		if err := resolve(db, htlcID); err != nil {
+			return fmt.Errorf("unable to update htlc attempt: %w",
+				err)
+		}
+
+		payment, err = updateSQLPaymentStatus(ctx, db, paymentHash)
+
+		return err
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// Fail transitions a payment into the Failed state, and records the reason the
+// payment failed. After invoking this method, InitPayment should return nil on
+// its next call for this payment hash, allowing the switch to make a
+// subsequent payment.
+func (s *SQLPaymentStore) Fail(paymentHash lntypes.Hash,
+	reason FailureReason) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	var payment *MPPayment
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		// We mark the payment as failed as long as it is known. This
+		// lets the last attempt to fail with a terminal write its
+		// failure to the store without synchronizing with other
+		// attempts.
+		p, err := fetchSQLPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		err = db.UpdatePaymentFailReason(
+			ctx, sqlc.UpdatePaymentFailReasonParams{
+				ID: p.id,
+				FailReason: sql.NullInt16{
+					Int16: int16(reason),
+					Valid: true,
+				},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update payment fail "+
+				"reason: %w", err)
+		}
+
+		payment, err = updateSQLPaymentStatus(ctx, db, paymentHash)
+
+		return err
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// FetchPayment returns information about a payment from the database.
+func (s *SQLPaymentStore) FetchPayment(paymentHash lntypes.Hash) (*MPPayment,
+	error) {
+
+	ctx := context.TODO()
+
+	var payment *MPPayment
+	readTxOpt := NewSQLPaymentQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLPaymentQueries) error {
+		p, err := fetchSQLPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		payment = p.MPPayment
+
+		return nil
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// FetchInFlightPayments returns all payments with status InFlight.
+func (s *SQLPaymentStore) FetchInFlightPayments() ([]*MPPayment, error) {
+	ctx := context.TODO()
+
+	var inFlights []*MPPayment
+	readTxOpt := NewSQLPaymentQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLPaymentQueries) error {
+		rows, err := db.FetchInFlightPayments(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch in-flight "+
+				"payments: %w", err)
+		}
+
+		for _, row := range rows {
+			p, err := unmarshalSQLPayment(ctx, db, row)
+			if err != nil {
+				return err
+			}
+
+			// Skip the payment if it's terminated.
+			if p.Terminated() {
+				continue
+			}
+
+			inFlights = append(inFlights, p.MPPayment)
+		}
+
+		return nil
+	}, func() {
+		inFlights = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inFlights, nil
+}
+
+// DeleteFailedAttempts deletes all failed htlcs for a payment if configured
+// by the store.
+func (s *SQLPaymentStore) DeleteFailedAttempts(paymentHash lntypes.Hash) error {
+	if s.keepFailedPaymentAttempts {
+		return nil
+	}
+
+	const failedHtlcsOnly = true
+
+	return s.DeletePayment(paymentHash, failedHtlcsOnly)
+}
+
+// QueryPayments is a query to the payments database which is restricted to a
+// subset of payments by the payments query, containing an offset index and a
+// maximum number of returned payments.
+func (s *SQLPaymentStore) QueryPayments(query PaymentsQuery) (PaymentsResponse,
+	error) {
+
+	ctx := context.TODO()
+
+	params := sqlc.FilterPaymentsParams{
+		Reverse:  query.Reversed,
+		NumLimit: math.MaxInt32,
+	}
+
+	if query.MaxPayments < math.MaxInt32 {
+		params.NumLimit = int32(query.MaxPayments)
+	}
+
+	// The index offset is exclusive in both directions.
+	if query.IndexOffset != 0 {
+		if query.Reversed {
+			params.IndexOffsetLt = sqldb.SQLInt64(query.IndexOffset)
+		} else {
+			params.IndexOffsetGt = sqldb.SQLInt64(query.IndexOffset)
+		}
+	}
+
+	// To keep compatibility with the old API, we only return
+	// non-succeeded payments if requested. An explicitly requested
+	// status takes precedence.
+	switch {
+	case query.Status != 0:
+		params.Status = sql.NullInt16{
+			Int16: int16(query.Status),
+			Valid: true,
+		}
+
+	case !query.IncludeIncomplete:
+		params.Status = sql.NullInt16{
+			Int16: int16(StatusSucceeded),
+			Valid: true,
+		}
+	}
+
+	// The creation dates are expressed in Unix seconds and inclusive,
+	// which means that the end date covers the whole second.
+	if query.CreationDateStart != 0 {
+		params.CreatedAfter = sqldb.SQLTime(
+			time.Unix(query.CreationDateStart, 0).UTC(),
+		)
+	}
+
+	if query.CreationDateEnd != 0 {
+		params.CreatedBefore = sqldb.SQLTime(
+			time.Unix(query.CreationDateEnd+1, 0).UTC(),
+		)
+	}
+
+	if query.Destination != nil {
+		params.Destination = query.Destination[:]
+	}
+
+	if query.MinAmount != 0 {
+		params.MinAmountMsat = sqldb.SQLInt64(query.MinAmount)
+	}
+
+	if query.MaxAmount != 0 {
+		params.MaxAmountMsat = sqldb.SQLInt64(query.MaxAmount)
+	}
+
+	var resp PaymentsResponse
+	readTxOpt := NewSQLPaymentQueryReadTx()
+	err := s.db.ExecTx(ctx, &readTxOpt, func(db SQLPaymentQueries) error {
+		rows, err := db.FilterPayments(ctx, params)
+		if err != nil {
+			return fmt.Errorf("unable to filter payments: %w", err)
+		}
+
+		for _, row := range rows {
+			p, err := unmarshalSQLPayment(ctx, db, row)
+			if err != nil {
+				return err
+			}
+
+			resp.Payments = append(resp.Payments, p.MPPayment)
+		}
+
+		if query.CountTotal {
+			count, err := db.CountPayments(ctx)
+			if err != nil {
+				return fmt.Errorf("unable to count payments: "+
+					"%w", err)
+			}
+
+			resp.TotalCount = uint64(count)
+		}
+
+		return nil
+	}, func() {
+		resp = PaymentsResponse{}
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	// Need to swap the payments slice order if reversed order.
+	if query.Reversed {
+		for l, r := 0, len(resp.Payments)-1; l < r; l, r = l+1, r-1 {
+			resp.Payments[l], resp.Payments[r] =
+				resp.Payments[r], resp.Payments[l]
+		}
+	}
+
+	// Set the first and last index of the returned payments so that the
+	// caller can resume from this point later on.
+	if len(resp.Payments) > 0 {
+		resp.FirstIndexOffset = resp.Payments[0].SequenceNum
+		resp.LastIndexOffset =
+			resp.Payments[len(resp.Payments)-1].SequenceNum
+	}
+
+	return resp, nil
+}
+
+// DeletePayment deletes a payment from the DB given its payment hash. If
+// failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
+// deleted.
+func (s *SQLPaymentStore) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	ctx := context.TODO()
+
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		row, err := db.FetchPayment(ctx, paymentHash[:])
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPaymentNotInitiated
+		} else if err != nil {
+			return fmt.Errorf("unable to fetch payment: %w", err)
+		}
+
+		// If the payment has inflight HTLCs, we cannot safely delete
+		// the payment information, so we return an error.
+		status := PaymentStatus(row.Status)
+		if err := status.removable(); err != nil {
+			return fmt.Errorf("payment '%v' has inflight HTLCs "+
+				"and therefore cannot be deleted: %w",
+				paymentHash.String(), err)
+		}
+
+		if failedHtlcsOnly {
+			return db.DeleteFailedHTLCAttempts(
+				ctx, sqlc.DeleteFailedHTLCAttemptsParams{
+					PaymentID: sqldb.SQLInt64(row.ID),
+				},
+			)
+		}
+
+		return db.DeletePayment(ctx, row.ID)
+	}, func() {})
+}
+
+// DeletePayments deletes all completed and failed payments from the DB. If
+// failedOnly is set, only failed payments will be considered for deletion. If
+// failedHtlcsOnly is set, the payment itself won't be deleted, only failed HTLC
+// attempts.
+func (s *SQLPaymentStore) DeletePayments(failedOnly,
+	failedHtlcsOnly bool) error {
+
+	ctx := context.TODO()
+
+	var status sql.NullInt16
+	if failedOnly {
+		status = sql.NullInt16{
+			Int16: int16(StatusFailed),
+			Valid: true,
+		}
+	}
+
+	var writeTxOpts SQLPaymentQueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLPaymentQueries) error {
+		// Payments with inflight HTLCs are never deleted by the
+		// queries below.
+		if failedHtlcsOnly {
+			return db.DeleteFailedHTLCAttempts(
+				ctx, sqlc.DeleteFailedHTLCAttemptsParams{
+					Status: status,
+				},
+			)
+		}
+
+		return db.DeletePayments(ctx, status)
+	}, func() {})
+}
+
+// updateSQLPaymentStatus fetches the payment with the given hash and stores
+// its freshly derived status, so that payments can be queried by status.
+func updateSQLPaymentStatus(ctx context.Context, db SQLPaymentQueries,
+	paymentHash lntypes.Hash) (*MPPayment, error) {
+
+	p, err := fetchSQLPayment(ctx, db, paymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.UpdatePaymentStatus(ctx, sqlc.UpdatePaymentStatusParams{
+		ID:     p.id,
+		Status: int16(p.Status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to update payment status: %w",
+			err)
+	}
+
+	return p.MPPayment, nil
+}
+
+// fetchSQLPayment fetches the payment with the given hash. If the payment
+// isn't found, it returns ErrPaymentNotInitiated.
+func fetchSQLPayment(ctx context.Context, db SQLPaymentQueries,
+	paymentHash lntypes.Hash) (*sqlPayment, error) {
+
+	row, err := db.FetchPayment(ctx, paymentHash[:])
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPaymentNotInitiated
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to fetch payment: %w", err)
+	}
+
+	return unmarshalSQLPayment(ctx, db, row)
+}
+
+// unmarshalSQLPayment converts a payment row along with its HTLC attempts to
+// an MPPayment and derives its state.
+func unmarshalSQLPayment(ctx context.Context, db SQLPaymentQueries,
+	row sqlc.Payment) (*sqlPayment, error) {
+
+	hash, err := lntypes.MakeHash(row.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcRows, err := db.FetchHTLCAttempts(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch htlc attempts: %w", err)
+	}
+
+	htlcs := make([]HTLCAttempt, 0, len(htlcRows))
+	htlcIDs := make(map[uint64]int64, len(htlcRows))
+	for _, htlcRow := range htlcRows {
+		htlc, err := unmarshalSQLHTLCAttempt(htlcRow)
+		if err != nil {
+			return nil, err
+		}
+
+		htlcs = append(htlcs, *htlc)
+		htlcIDs[htlc.AttemptID] = htlcRow.ID
+	}
+
+	var failureReason *FailureReason
+	if row.FailReason.Valid {
+		reason := FailureReason(row.FailReason.Int16)
+		failureReason = &reason
+	}
+
+	payment := &MPPayment{
+		SequenceNum: uint64(row.ID),
+		Info: &PaymentCreationInfo{
+			PaymentIdentifier: hash,
+			Value:             lnwire.MilliSatoshi(row.AmountMsat),
+			CreationTime:      row.CreatedAt.Local(),
+			PaymentRequest:    row.PaymentRequest,
+			Type:              PaymentType(row.PaymentType),
+		},
+		HTLCs:         htlcs,
+		FailureReason: failureReason,
+	}
+
+	// Set its state and status.
+	if err := payment.setState(); err != nil {
+		return nil, err
+	}
+
+	return &sqlPayment{
+		MPPayment: payment,
+		id:        row.ID,
+		htlcIDs:   htlcIDs,
+	}, nil
+}
+
+// unmarshalSQLHTLCAttempt converts an HTLC attempt row including its optional
+// failure to an HTLCAttempt.
+func unmarshalSQLHTLCAttempt(row sqlc.FetchHTLCAttemptsRow) (*HTLCAttempt,
+	error) {
+
+	rt, err := DeserializeRoute(bytes.NewReader(row.Route))
+	if err != nil {
+		return nil, fmt.Errorf("unable to deserialize route: %w", err)
+	}
+
+	htlc := &HTLCAttempt{
+		HTLCAttemptInfo: HTLCAttemptInfo{
+			AttemptID:   uint64(row.AttemptID),
+			Route:       rt,
+			AttemptTime: row.AttemptTime.Local(),
+		},
+	}
+	copy(htlc.sessionKey[:], row.SessionKey)
+
+	if row.Hash != nil {
+		hash, err := lntypes.MakeHash(row.Hash)
+		if err != nil {
+			return nil, err
+		}
+		htlc.Hash = &hash
+	}
+
+	if row.SettlePreimage != nil {
+		preimage, err := lntypes.MakePreimage(row.SettlePreimage)
+		if err != nil {
+			return nil, err
+		}
+
+		htlc.Settle = &HTLCSettleInfo{
+			Preimage: preimage,
+		}
+		if row.SettledAt.Valid {
+			htlc.Settle.SettleTime = row.SettledAt.Time.Local()
+		}
+	}
+
+	if row.FailedAt.Valid {
+		htlc.Failure = &HTLCFailInfo{
+			FailTime: row.FailedAt.Time.Local(),
+			Reason:   HTLCFailReason(row.Reason.Int16),
+			FailureSourceIndex: uint32(
+				row.FailureSourceIndex.Int64,
+			),
+		}
+
+		if len(row.FailureMsg) > 0 {
+			htlc.Failure.Message, err = lnwire.DecodeFailureMessage(
+				bytes.NewReader(row.FailureMsg), 0,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return htlc, nil
+}
@@ -0,0 +1,477 @@
+package channeldb
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// paymentStoreTestCase is a test that is run against all PaymentStore
+// implementations.
+type paymentStoreTestCase struct {
+	name string
+	test func(t *testing.T, store PaymentStore)
+}
+
+// makePaymentStores returns constructors for all PaymentStore
+// implementations.
+func makePaymentStores() map[string]func(t *testing.T) PaymentStore {
+	return map[string]func(t *testing.T) PaymentStore{
+		"kv": func(t *testing.T) PaymentStore {
+			db, err := MakeTestDB(t)
+			require.NoError(t, err)
+
+			return NewPaymentControl(db)
+		},
+		"sqlite": func(t *testing.T) PaymentStore {
+			db := sqldb.NewTestSqliteDB(t).BaseDB
+			executor := sqldb.NewTransactionExecutor(
+				db, func(tx *sql.Tx) SQLPaymentQueries {
+					return db.WithTx(tx)
+				},
+			)
+
+			return NewSQLPaymentStore(executor, true)
+		},
+	}
+}
+
+// TestPaymentStores runs the payment store tests against all PaymentStore
+// implementations.
+func TestPaymentStores(t *testing.T) {
+	t.Parallel()
+
+	testCases := []paymentStoreTestCase{
+		{
+			name: "lifecycle",
+			test: testPaymentStoreLifecycle,
+		},
+		{
+			name: "fail and retry",
+			test: testPaymentStoreFailRetry,
+		},
+		{
+			name: "query payments",
+			test: testPaymentStoreQueryPayments,
+		},
+		{
+			name: "delete payments",
+			test: testPaymentStoreDeletePayments,
+		},
+		{
+			name: "payment type",
+			test: testPaymentStoreType,
+		},
+	}
+
+	for storeName, makeStore := range makePaymentStores() {
+		makeStore := makeStore
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(storeName+"/"+tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				tc.test(t, makeStore(t))
+			})
+		}
+	}
+}
+
+// newTestPayment creates the creation info of a payment with the given
+// amount and creation time, and a single shot attempt paying it to the given
+// destination.
+func newTestPayment(t *testing.T, amt lnwire.MilliSatoshi,
+	creationTime int64, dest route.Vertex,
+	attemptID uint64) (*PaymentCreationInfo, *HTLCAttemptInfo,
+	lntypes.Preimage) {
+
+	preimage, err := genPreimage()
+	require.NoError(t, err)
+
+	rt := route.Route{
+		TotalTimeLock: 123,
+		TotalAmount:   amt,
+		SourcePubKey:  vertex,
+		Hops: []*route.Hop{{
+			PubKeyBytes:      dest,
+			ChannelID:        12345,
+			OutgoingTimeLock: 111,
+			AmtToForward:     amt,
+		}},
+	}
+
+	attempt := NewHtlcAttempt(
+		attemptID, priv, rt, time.Unix(creationTime+1, 0), nil,
+	)
+
+	return &PaymentCreationInfo{
+		PaymentIdentifier: sha256.Sum256(preimage[:]),
+		Value:             amt,
+		CreationTime:      time.Unix(creationTime, 0),
+		PaymentRequest:    []byte("hola"),
+	}, &attempt.HTLCAttemptInfo, preimage
+}
+
+// testPaymentStoreLifecycle tests registering, failing and settling HTLC
+// attempts of a payment.
+func testPaymentStoreLifecycle(t *testing.T, store PaymentStore) {
+	info, attempt, preimage := newTestPayment(t, 1000, 100, vertex, 0)
+	hash := info.PaymentIdentifier
+
+	require.NoError(t, store.InitPayment(hash, info))
+	require.ErrorIs(t, store.InitPayment(hash, info), ErrPaymentExists)
+
+	payment, err := store.FetchPayment(hash)
+	require.NoError(t, err)
+	require.Equal(t, StatusInitiated, payment.Status)
+	require.Equal(t, info, payment.Info)
+	require.Empty(t, payment.HTLCs)
+
+	// Register the first attempt, which moves the payment in flight.
+	payment, err = store.RegisterAttempt(hash, attempt)
+	require.NoError(t, err)
+	require.Equal(t, StatusInFlight, payment.Status)
+	require.Len(t, payment.HTLCs, 1)
+
+	htlc := payment.HTLCs[0]
+	require.Equal(t, attempt.AttemptID, htlc.AttemptID)
+	require.Equal(t, attempt.Route, htlc.Route)
+	require.Equal(t, attempt.AttemptTime, htlc.AttemptTime)
+	require.Equal(
+		t, attempt.SessionKey().Serialize(),
+		htlc.SessionKey().Serialize(),
+	)
+
+	// A second attempt for the full amount is not allowed while the first
+	// one is in flight.
+	secondAttempt := *attempt
+	secondAttempt.AttemptID = 1
+	_, err = store.RegisterAttempt(hash, &secondAttempt)
+	require.ErrorIs(t, err, ErrValueExceedsAmt)
+
+	inFlight, err := store.FetchInFlightPayments()
+	require.NoError(t, err)
+	require.Len(t, inFlight, 1)
+
+	// Fail the first attempt, the payment stays in flight.
+	failInfo := &HTLCFailInfo{
+		FailTime:           time.Unix(200, 0),
+		Message:            lnwire.NewFailIncorrectDetails(1000, 300),
+		Reason:             HTLCFailMessage,
+		FailureSourceIndex: 1,
+	}
+	payment, err = store.FailAttempt(hash, 0, failInfo)
+	require.NoError(t, err)
+	require.Equal(t, StatusInFlight, payment.Status)
+	require.Equal(t, failInfo, payment.HTLCs[0].Failure)
+
+	_, err = store.FailAttempt(hash, 0, failInfo)
+	require.ErrorIs(t, err, ErrAttemptAlreadyFailed)
+
+	// Now the second attempt can be registered and settled, which
+	// completes the payment.
+	_, err = store.RegisterAttempt(hash, &secondAttempt)
+	require.NoError(t, err)
+
+	settleInfo := &HTLCSettleInfo{
+		Preimage:   preimage,
+		SettleTime: time.Unix(300, 0),
+	}
+	payment, err = store.SettleAttempt(hash, 1, settleInfo)
+	require.NoError(t, err)
+	require.Equal(t, StatusSucceeded, payment.Status)
+	require.Equal(t, settleInfo, payment.HTLCs[1].Settle)
+
+	_, err = store.SettleAttempt(hash, 1, settleInfo)
+	require.ErrorIs(t, err, ErrPaymentAlreadySucceeded)
+
+	require.ErrorIs(t, store.InitPayment(hash, info), ErrAlreadyPaid)
+
+	inFlight, err = store.FetchInFlightPayments()
+	require.NoError(t, err)
+	require.Empty(t, inFlight)
+}
+
+// testPaymentStoreFailRetry tests that failed payments can be retried.
+func testPaymentStoreFailRetry(t *testing.T, store PaymentStore) {
+	info, attempt, _ := newTestPayment(t, 1000, 100, vertex, 0)
+	hash := info.PaymentIdentifier
+
+	_, err := store.Fail(hash, FailureReasonNoRoute)
+	require.ErrorIs(t, err, ErrPaymentNotInitiated)
+
+	require.NoError(t, store.InitPayment(hash, info))
+	_, err = store.RegisterAttempt(hash, attempt)
+	require.NoError(t, err)
+
+	// Failing the payment with an attempt in flight keeps it in flight
+	// until the attempt is resolved.
+	payment, err := store.Fail(hash, FailureReasonNoRoute)
+	require.NoError(t, err)
+	require.Equal(t, StatusInFlight, payment.Status)
+
+	payment, err = store.FailAttempt(hash, 0, &HTLCFailInfo{
+		FailTime: time.Unix(200, 0),
+		Reason:   HTLCFailUnreadable,
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatusFailed, payment.Status)
+	require.Equal(t, FailureReasonNoRoute, *payment.FailureReason)
+
+	// The failed payment can be retried, which removes all previous
+	// attempts.
+	require.NoError(t, store.InitPayment(hash, info))
+
+	payment, err = store.FetchPayment(hash)
+	require.NoError(t, err)
+	require.Equal(t, StatusInitiated, payment.Status)
+	require.Nil(t, payment.FailureReason)
+	require.Empty(t, payment.HTLCs)
+}
+
+// testPaymentStoreType tests that the type of a payment is stored, and that
+// it is replaced when the payment is retried.
+func testPaymentStoreType(t *testing.T, store PaymentStore) {
+	info, _, _ := newTestPayment(t, 1000, 100, vertex, 0)
+	hash := info.PaymentIdentifier
+
+	info.Type = PaymentTypeRebalance
+	require.NoError(t, store.InitPayment(hash, info))
+
+	payment, err := store.FetchPayment(hash)
+	require.NoError(t, err)
+	require.Equal(t, PaymentTypeRebalance, payment.Info.Type)
+
+	resp, err := store.QueryPayments(PaymentsQuery{
+		MaxPayments:       10,
+		IncludeIncomplete: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Payments, 1)
+	require.Equal(t, PaymentTypeRebalance, resp.Payments[0].Info.Type)
+
+	// A retry of the failed payment as a regular payment removes the type.
+	_, err = store.Fail(hash, FailureReasonNoRoute)
+	require.NoError(t, err)
+
+	info.Type = PaymentTypeRegular
+	require.NoError(t, store.InitPayment(hash, info))
+
+	payment, err = store.FetchPayment(hash)
+	require.NoError(t, err)
+	require.Equal(t, PaymentTypeRegular, payment.Info.Type)
+}
+
+// paymentState is the final state of a test payment.
+type paymentState uint8
+
+const (
+	paymentSucceeded paymentState = iota
+	paymentFailed
+	paymentInFlight
+)
+
+// createStorePayment creates a payment in the given state. Succeeded payments
+// have a failed and a settled attempt, failed payments have a failed attempt
+// and in-flight payments have a single in-flight attempt.
+func createStorePayment(t *testing.T, store PaymentStore,
+	amt lnwire.MilliSatoshi, creationTime int64, dest route.Vertex,
+	state paymentState) lntypes.Hash {
+
+	info, attempt, preimage := newTestPayment(
+		t, amt, creationTime, dest, 0,
+	)
+	hash := info.PaymentIdentifier
+
+	require.NoError(t, store.InitPayment(hash, info))
+	_, err := store.RegisterAttempt(hash, attempt)
+	require.NoError(t, err)
+
+	if state == paymentInFlight {
+		return hash
+	}
+
+	_, err = store.FailAttempt(hash, 0, &HTLCFailInfo{
+		FailTime: time.Unix(creationTime+2, 0),
+		Reason:   HTLCFailUnreadable,
+	})
+	require.NoError(t, err)
+
+	if state == paymentFailed {
+		_, err = store.Fail(hash, FailureReasonNoRoute)
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	secondAttempt := *attempt
+	secondAttempt.AttemptID = 1
+	_, err = store.RegisterAttempt(hash, &secondAttempt)
+	require.NoError(t, err)
+
+	_, err = store.SettleAttempt(hash, 1, &HTLCSettleInfo{
+		Preimage:   preimage,
+		SettleTime: time.Unix(creationTime+3, 0),
+	})
+	require.NoError(t, err)
+
+	return hash
+}
+
+// testPaymentStoreQueryPayments tests the filters and the pagination of
+// QueryPayments.
+func testPaymentStoreQueryPayments(t *testing.T, store PaymentStore) {
+	destA := route.Vertex{1}
+	destB := route.Vertex{2}
+
+	hashes := []lntypes.Hash{
+		createStorePayment(t, store, 1000, 1000, destA,
+			paymentSucceeded),
+		createStorePayment(t, store, 2000, 2000, destA,
+			paymentFailed),
+		createStorePayment(t, store, 3000, 3000, destB,
+			paymentInFlight),
+		createStorePayment(t, store, 4000, 4000, destB,
+			paymentSucceeded),
+	}
+
+	query := func(q PaymentsQuery) (PaymentsResponse, []lntypes.Hash) {
+		if q.MaxPayments == 0 {
+			q.MaxPayments = 100
+		}
+
+		resp, err := store.QueryPayments(q)
+		require.NoError(t, err)
+
+		var result []lntypes.Hash
+		for _, p := range resp.Payments {
+			result = append(result, p.Info.PaymentIdentifier)
+		}
+
+		return resp, result
+	}
+
+	// By default, only succeeded payments are returned.
+	_, result := query(PaymentsQuery{})
+	require.Equal(t, []lntypes.Hash{hashes[0], hashes[3]}, result)
+
+	resp, result := query(PaymentsQuery{
+		IncludeIncomplete: true,
+		CountTotal:        true,
+	})
+	require.Equal(t, hashes, result)
+	require.EqualValues(t, 4, resp.TotalCount)
+
+	seqNums := make([]uint64, len(resp.Payments))
+	for i, p := range resp.Payments {
+		seqNums[i] = p.SequenceNum
+	}
+	require.Equal(t, seqNums[0], resp.FirstIndexOffset)
+	require.Equal(t, seqNums[3], resp.LastIndexOffset)
+
+	// Filter by status, which takes precedence over IncludeIncomplete.
+	_, result = query(PaymentsQuery{
+		Status: StatusFailed,
+	})
+	require.Equal(t, []lntypes.Hash{hashes[1]}, result)
+
+	// Filter by destination.
+	_, result = query(PaymentsQuery{
+		IncludeIncomplete: true,
+		Destination:       &destB,
+	})
+	require.Equal(t, []lntypes.Hash{hashes[2], hashes[3]}, result)
+
+	// Filter by amount.
+	_, result = query(PaymentsQuery{
+		IncludeIncomplete: true,
+		MinAmount:         2000,
+		MaxAmount:         3000,
+	})
+	require.Equal(t, []lntypes.Hash{hashes[1], hashes[2]}, result)
+
+	// Filter by creation date, both bounds are inclusive.
+	_, result = query(PaymentsQuery{
+		IncludeIncomplete: true,
+		CreationDateStart: 2000,
+		CreationDateEnd:   3000,
+	})
+	require.Equal(t, []lntypes.Hash{hashes[1], hashes[2]}, result)
+
+	// Paginate backwards from the most recent payment.
+	resp, result = query(PaymentsQuery{
+		IncludeIncomplete: true,
+		Reversed:          true,
+		MaxPayments:       2,
+	})
+	require.Equal(t, []lntypes.Hash{hashes[2], hashes[3]}, result)
+
+	_, result = query(PaymentsQuery{
+		IncludeIncomplete: true,
+		Reversed:          true,
+		MaxPayments:       2,
+		IndexOffset:       resp.FirstIndexOffset,
+	})
+	require.Equal(t, []lntypes.Hash{hashes[0], hashes[1]}, result)
+
+	// Paginate forwards, the index offset is exclusive.
+	_, result = query(PaymentsQuery{
+		IncludeIncomplete: true,
+		MaxPayments:       1,
+		IndexOffset:       seqNums[1],
+	})
+	require.Equal(t, []lntypes.Hash{hashes[2]}, result)
+}
+
+// testPaymentStoreDeletePayments tests deleting payments and their failed HTLC
+// attempts.
+func testPaymentStoreDeletePayments(t *testing.T, store PaymentStore) {
+	succeeded := createStorePayment(
+		t, store, 1000, 1000, vertex, paymentSucceeded,
+	)
+	failed := createStorePayment(
+		t, store, 2000, 2000, vertex, paymentFailed,
+	)
+	inFlight := createStorePayment(
+		t, store, 3000, 3000, vertex, paymentInFlight,
+	)
+
+	assertNumHTLCs := func(hash lntypes.Hash, num int) {
+		t.Helper()
+
+		payment, err := store.FetchPayment(hash)
+		require.NoError(t, err)
+		require.Len(t, payment.HTLCs, num)
+	}
+
+	// Only the failed HTLC attempts of terminated payments are deleted.
+	require.NoError(t, store.DeletePayments(false, true))
+	assertNumHTLCs(succeeded, 1)
+	assertNumHTLCs(failed, 0)
+	assertNumHTLCs(inFlight, 1)
+
+	// Delete the failed payments only.
+	require.NoError(t, store.DeletePayments(true, false))
+	_, err := store.FetchPayment(failed)
+	require.ErrorIs(t, err, ErrPaymentNotInitiated)
+	assertNumHTLCs(succeeded, 1)
+
+	// In-flight payments can't be deleted.
+	require.ErrorIs(
+		t, store.DeletePayment(inFlight, false), ErrPaymentInFlight,
+	)
+	require.NoError(t, store.DeletePayment(succeeded, false))
+	_, err = store.FetchPayment(succeeded)
+	require.ErrorIs(t, err, ErrPaymentNotInitiated)
+
+	// All remaining payments are in flight, so nothing is deleted.
+	require.NoError(t, store.DeletePayments(false, false))
+	assertNumHTLCs(inFlight, 1)
+}
@@ -0,0 +1,57 @@
+package channeldb
+
+import (
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// PaymentStore is the persistence layer for outgoing payments and their HTLC
+// attempts. It is implemented by the kv based PaymentControl and by the
+// native SQL store.
+type PaymentStore interface {
+	// InitPayment checks or records the given PaymentCreationInfo with
+	// the DB, making sure it does not already exist as an in-flight
+	// payment.
+	InitPayment(paymentHash lntypes.Hash, info *PaymentCreationInfo) error
+
+	// RegisterAttempt atomically records the provided HTLCAttemptInfo.
+	RegisterAttempt(paymentHash lntypes.Hash,
+		attempt *HTLCAttemptInfo) (*MPPayment, error)
+
+	// SettleAttempt marks the given attempt settled with the preimage.
+	SettleAttempt(paymentHash lntypes.Hash, attemptID uint64,
+		settleInfo *HTLCSettleInfo) (*MPPayment, error)
+
+	// FailAttempt marks the given payment attempt failed.
+	FailAttempt(paymentHash lntypes.Hash, attemptID uint64,
+		failInfo *HTLCFailInfo) (*MPPayment, error)
+
+	// Fail transitions a payment into the Failed state, and records the
+	// reason the payment failed.
+	Fail(paymentHash lntypes.Hash, reason FailureReason) (*MPPayment,
+		error)
+
+	// FetchPayment returns information about a payment.
+	FetchPayment(paymentHash lntypes.Hash) (*MPPayment, error)
+
+	// FetchInFlightPayments returns all payments with status InFlight.
+	FetchInFlightPayments() ([]*MPPayment, error)
+
+	// DeleteFailedAttempts deletes all failed htlcs for a payment if
+	// configured to do so.
+	DeleteFailedAttempts(paymentHash lntypes.Hash) error
+
+	// QueryPayments is a query to the payments database which is
+	// restricted to a subset of payments by the payments query.
+	QueryPayments(query PaymentsQuery) (PaymentsResponse, error)
+
+	// DeletePayment deletes a payment given its payment hash. If
+	// failedHtlcsOnly is set, only failed HTLC attempts of the payment
+	// will be deleted.
+	DeletePayment(paymentHash lntypes.Hash, failedHtlcsOnly bool) error
+
+	// DeletePayments deletes all completed and failed payments. If
+	// failedOnly is set, only failed payments will be considered for
+	// deletion. If failedHtlcsOnly is set, the payment itself won't be
+	// deleted, only failed HTLC attempts.
+	DeletePayments(failedOnly, failedHtlcsOnly bool) error
+}
@@ -93,6 +93,11 @@ var (
 	// store information about the reason a payment failed.
 	paymentFailInfoKey = []byte("payment-fail-info")
 
+	// paymentTypeKey is a key used in the payment's sub-bucket to store
+	// the type of the payment. It is only set for payments that aren't
+	// regular payments.
+	paymentTypeKey = []byte("payment-type")
+
 	// paymentsIndexBucket is the name of the top-level bucket within the
 	// database that stores an index of payment sequence numbers to its
 	// payment hash.
@@ -180,6 +185,30 @@ func (r FailureReason) String() string {
 	return "unknown"
 }
 
+// PaymentType distinguishes payments by their purpose.
+type PaymentType byte
+
+const (
+	// PaymentTypeRegular is a payment to another node.
+	PaymentTypeRegular PaymentType = 0
+
+	// PaymentTypeRebalance is a circular payment to ourselves that moves
+	// liquidity between our channels.
+	PaymentTypeRebalance PaymentType = 1
+)
+
+// String returns a human-readable PaymentType.
+func (t PaymentType) String() string {
+	switch t {
+	case PaymentTypeRegular:
+		return "regular"
+	case PaymentTypeRebalance:
+		return "rebalance"
+	}
+
+	return "unknown"
+}
+
 // PaymentCreationInfo is the information necessary to have ready when
 // initiating a payment, moving it into state InFlight.
 type PaymentCreationInfo struct {
@@ -195,6 +224,9 @@ type PaymentCreationInfo struct {
 
 	// PaymentRequest is the full payment request, if any.
 	PaymentRequest []byte
+
+	// Type is the type of the payment.
+	Type PaymentType
 }
 
 // htlcBucketKey creates a composite key from prefix and id where the result is
@@ -268,7 +300,19 @@ func fetchCreationInfo(bucket kvdb.RBucket) (*PaymentCreationInfo, error) {
 	}
 
 	r := bytes.NewReader(b)
-	return deserializePaymentCreationInfo(r)
+	info, err := deserializePaymentCreationInfo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The type is stored separately, so that the creation info of
+	// existing payments stays valid. Payments without a type are regular
+	// payments.
+	if t := bucket.Get(paymentTypeKey); len(t) > 0 {
+		info.Type = PaymentType(t[0])
+	}
+
+	return info, nil
 }
 
 func fetchPayment(bucket kvdb.RBucket) (*MPPayment, error) {
@@ -482,6 +526,69 @@ type PaymentsQuery struct {
 	// CreationDateEnd, expressed in Unix seconds, if set, filters out all
 	// payments with a creation date less than or equal to it.
 	CreationDateEnd int64
+
+	// Status, if set, only returns payments with the given status. It
+	// takes precedence over IncludeIncomplete.
+	Status PaymentStatus
+
+	// Destination, if set, only returns payments to the given node. The
+	// destination of a payment is the final hop of its first HTLC attempt.
+	Destination *route.Vertex
+
+	// MinAmount, if set, filters out all payments with a value less than
+	// it.
+	MinAmount lnwire.MilliSatoshi
+
+	// MaxAmount, if set, filters out all payments with a value greater
+	// than it.
+	MaxAmount lnwire.MilliSatoshi
+}
+
+// matchesPayment returns true if the payment satisfies the status,
+// destination and amount filters of the query.
+func (q *PaymentsQuery) matchesPayment(payment *MPPayment) bool {
+	switch {
+	// If a status is requested, we only return payments with that status.
+	case q.Status != 0 && payment.Status != q.Status:
+		return false
+
+	// To keep compatibility with the old API, we only return
+	// non-succeeded payments if requested.
+	case q.Status == 0 && payment.Status != StatusSucceeded &&
+		!q.IncludeIncomplete:
+
+		return false
+
+	case q.MinAmount != 0 && payment.Info.Value < q.MinAmount:
+		return false
+
+	case q.MaxAmount != 0 && payment.Info.Value > q.MaxAmount:
+		return false
+	}
+
+	if q.Destination != nil {
+		dest := paymentDestination(payment.HTLCs)
+		if dest == nil || *dest != *q.Destination {
+			return false
+		}
+	}
+
+	return true
+}
+
+// paymentDestination returns the final hop of the first of the given HTLC
+// attempts, or nil if there are no attempts.
+func paymentDestination(htlcs []HTLCAttempt) *route.Vertex {
+	if len(htlcs) == 0 {
+		return nil
+	}
+
+	finalHop := htlcs[0].Route.FinalHop()
+	if finalHop == nil {
+		return nil
+	}
+
+	return &finalHop.PubKeyBytes
 }
 
 // PaymentsResponse contains the result of a query to the payments database.
@@ -553,12 +660,10 @@ func (d *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, error) {
 				return false, err
 			}
 
-			// To keep compatibility with the old API, we only
-			// return non-succeeded payments if requested.
-			if payment.Status != StatusSucceeded &&
-				!query.IncludeIncomplete {
-
-				return false, err
+			// Skip any payments that don't match the status,
+			// destination and amount filters of the query.
+			if !query.matchesPayment(payment) {
+				return false, nil
 			}
 
 			// Get the creation time in Unix seconds, this always
@@ -783,7 +783,9 @@ func putDuplicatePayment(t *testing.T, duplicateBucket kvdb.RwBucket,
 	_, err = b.Write(scratch[:])
 	require.NoError(t, err)
 
-	err = serializeTime(&b, info.CreationTime)
+	// Legacy duplicate payments store their creation time in seconds.
+	byteOrder.PutUint64(scratch[:], uint64(info.CreationTime.Unix()))
+	_, err = b.Write(scratch[:])
 	require.NoError(t, err)
 
 	byteOrder.PutUint32(scratch[:4], 0)
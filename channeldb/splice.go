@@ -0,0 +1,1081 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// pendingSplicesBucket is a sub-bucket of a channel's bucket that
+	// stores the splices of the channel that were negotiated, but whose
+	// splice transaction hasn't confirmed yet. The splices are keyed by
+	// their new funding outpoint.
+	pendingSplicesBucket = []byte("pending-splices-bucket")
+
+	// pendingSpliceLogsBucket is a sub-bucket of a channel's bucket that
+	// holds a revocation log for each pending splice, keyed by its new
+	// funding outpoint. It records the revoked commitments of the remote
+	// party that spend the new funding output, which replace the entries
+	// of the channel's revocation log once the splice confirms.
+	pendingSpliceLogsBucket = []byte("pending-splice-logs-bucket")
+
+	// spliceLockPendingKey is stored in the bucket of a channel once one
+	// of its splices confirmed, until both parties exchanged splice_locked
+	// and the channel has been assigned its new short channel id. It maps
+	// to the outpoint the channel was spliced from.
+	spliceLockPendingKey = []byte("splice-lock-pending-key")
+
+	// ErrSpliceNotFound is returned when a splice is applied to a channel
+	// that doesn't have a matching pending splice.
+	ErrSpliceNotFound = errors.New("pending splice not found")
+)
+
+// ChannelSplice holds the state of a channel on top of a new funding output
+// created by a splice. It's persisted once both parties exchanged signatures
+// for the new commitment transactions, so the channel can be moved onto the
+// new funding output once the splice transaction confirms. Until then, every
+// commitment of the channel is signed for both the current and the new
+// funding output, and the commitments of the splice are kept up to date.
+type ChannelSplice struct {
+	// FundingOutpoint is the new funding outpoint of the channel.
+	FundingOutpoint wire.OutPoint
+
+	// FundingTx is the splice transaction that creates the new funding
+	// output. It only carries the witnesses of its inputs once both
+	// parties exchanged tx_signatures.
+	FundingTx *wire.MsgTx
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// LocalContribution and RemoteContribution are the amounts the
+	// channel balances of both parties change by with the splice.
+	LocalContribution  btcutil.Amount
+	RemoteContribution btcutil.Amount
+
+	// LocalCommitment is our commitment that spends the new funding
+	// output, including the signature of the remote party.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the commitment of the remote party that spends
+	// the new funding output.
+	RemoteCommitment ChannelCommitment
+
+	// RemoteCommitmentTip is the pending commitment of the remote party
+	// that spends the new funding output, which is set if we signed a new
+	// commitment for the remote party that it didn't revoke its prior
+	// commitment for yet.
+	RemoteCommitmentTip *ChannelCommitment
+
+	// FundingPsbt is the splice transaction as a PSBT, which carries the
+	// previous outputs of all inputs and the witnesses of our inputs. It's
+	// set once we signed the splice transaction.
+	FundingPsbt []byte
+
+	// LocalTxSigs is our tx_signatures message for the splice
+	// transaction. It's retransmitted on reconnection until the remote
+	// party acknowledged it with its own signatures.
+	LocalTxSigs *lnwire.TxSignatures
+
+	// Upgrade is set if the splice upgrades the commitment type of the
+	// channel, in which case FundingTx is the kickoff transaction that
+	// moves the channel onto a funding output of the new type.
+	Upgrade *ChannelUpgrade
+}
+
+// ChannelUpgrade holds the parameters of a pending upgrade of the commitment
+// type of a channel. Unlike a splice, an upgrade is negotiated while the
+// channel is quiescent, and the channel stays quiescent until the kickoff
+// transaction confirms, so its commitments are never updated.
+type ChannelUpgrade struct {
+	// ChanType is the type of the channel after the upgrade.
+	ChanType ChannelType
+
+	// RemoteFundingKey is the funding key the remote party proposed for
+	// the new funding output. If nil, it keeps its current one.
+	RemoteFundingKey *btcec.PublicKey
+
+	// LocalProposal is our dyn_propose message for the upgrade, which is
+	// set if we proposed it. It's retransmitted on reconnection along with
+	// our kickoff_sig until the kickoff transaction is fully signed.
+	LocalProposal *lnwire.DynPropose
+
+	// LocalKickoffSig is our kickoff_sig message for the upgrade.
+	LocalKickoffSig *lnwire.KickoffSig
+}
+
+// IsSigned returns true if we signed the splice transaction. Whether our
+// signatures were sent depends on which party signs first.
+func (s *ChannelSplice) IsSigned() bool {
+	return s.LocalTxSigs != nil
+}
+
+// IsUpgrade returns true if the splice upgrades the commitment type of the
+// channel.
+func (s *ChannelSplice) IsUpgrade() bool {
+	return s.Upgrade != nil
+}
+
+// IsFinal returns true if the splice transaction was signed by both parties.
+func (s *ChannelSplice) IsFinal() bool {
+	return s.FundingTx.HasWitness()
+}
+
+// SpliceCommitment is a commitment of the channel that spends the funding
+// output of a pending splice.
+type SpliceCommitment struct {
+	// FundingOutpoint is the funding outpoint of the pending splice.
+	FundingOutpoint wire.OutPoint
+
+	// Commitment is the commitment that spends the funding outpoint.
+	Commitment ChannelCommitment
+}
+
+// SpliceOutputIndexes holds the indexes of the outputs of both parties in the
+// revoked commitment of the remote party that spends the funding output of a
+// pending splice.
+type SpliceOutputIndexes struct {
+	// FundingOutpoint is the funding outpoint of the pending splice.
+	FundingOutpoint wire.OutPoint
+
+	// OurOutputIndex is the index of our output in the commitment.
+	OurOutputIndex uint32
+
+	// TheirOutputIndex is the index of the remote party's output in the
+	// commitment.
+	TheirOutputIndex uint32
+}
+
+// serializeChannelSplice writes the passed splice to w.
+func serializeChannelSplice(w io.Writer, s *ChannelSplice) error {
+	err := WriteElements(
+		w, s.FundingOutpoint, s.FundingTx, s.Capacity,
+		s.LocalContribution, s.RemoteContribution,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeChanCommit(w, &s.LocalCommitment); err != nil {
+		return err
+	}
+	if err := serializeChanCommit(w, &s.RemoteCommitment); err != nil {
+		return err
+	}
+
+	hasTip := s.RemoteCommitmentTip != nil
+	if err := WriteElements(w, hasTip); err != nil {
+		return err
+	}
+	if hasTip {
+		err := serializeChanCommit(w, s.RemoteCommitmentTip)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElements(w, s.FundingPsbt, s.IsSigned()); err != nil {
+		return err
+	}
+	if s.IsSigned() {
+		if err := WriteElements(w, s.LocalTxSigs); err != nil {
+			return err
+		}
+	}
+
+	// The upgrade is only written if it's set, so a splice is stored the
+	// same way whether or not upgrades are supported.
+	if !s.IsUpgrade() {
+		return nil
+	}
+	if err := WriteElements(w, true); err != nil {
+		return err
+	}
+
+	return serializeChannelUpgrade(w, s.Upgrade)
+}
+
+// serializeChannelUpgrade writes the passed upgrade to w.
+func serializeChannelUpgrade(w io.Writer, u *ChannelUpgrade) error {
+	hasKey := u.RemoteFundingKey != nil
+	if err := WriteElements(w, u.ChanType, hasKey); err != nil {
+		return err
+	}
+	if hasKey {
+		if err := WriteElements(w, u.RemoteFundingKey); err != nil {
+			return err
+		}
+	}
+
+	hasProposal := u.LocalProposal != nil
+	if err := WriteElements(w, hasProposal); err != nil {
+		return err
+	}
+	if hasProposal {
+		if err := WriteElements(w, u.LocalProposal); err != nil {
+			return err
+		}
+	}
+
+	return WriteElements(w, u.LocalKickoffSig)
+}
+
+// deserializeChannelUpgrade reads an upgrade that was written with
+// serializeChannelUpgrade from r.
+func deserializeChannelUpgrade(r io.Reader) (*ChannelUpgrade, error) {
+	u := &ChannelUpgrade{}
+
+	var hasKey bool
+	if err := ReadElements(r, &u.ChanType, &hasKey); err != nil {
+		return nil, err
+	}
+	if hasKey {
+		if err := ReadElements(r, &u.RemoteFundingKey); err != nil {
+			return nil, err
+		}
+	}
+
+	var hasProposal bool
+	if err := ReadElements(r, &hasProposal); err != nil {
+		return nil, err
+	}
+	if hasProposal {
+		var msg lnwire.Message
+		if err := ReadElements(r, &msg); err != nil {
+			return nil, err
+		}
+
+		proposal, ok := msg.(*lnwire.DynPropose)
+		if !ok {
+			return nil, fmt.Errorf("expected dyn_propose, got %T",
+				msg)
+		}
+		u.LocalProposal = proposal
+	}
+
+	var msg lnwire.Message
+	if err := ReadElements(r, &msg); err != nil {
+		return nil, err
+	}
+
+	kickoffSig, ok := msg.(*lnwire.KickoffSig)
+	if !ok {
+		return nil, fmt.Errorf("expected kickoff_sig, got %T", msg)
+	}
+	u.LocalKickoffSig = kickoffSig
+
+	return u, nil
+}
+
+// deserializeChannelSplice reads a splice that was written with
+// serializeChannelSplice from r.
+func deserializeChannelSplice(r io.Reader) (*ChannelSplice, error) {
+	s := &ChannelSplice{}
+	err := ReadElements(
+		r, &s.FundingOutpoint, &s.FundingTx, &s.Capacity,
+		&s.LocalContribution, &s.RemoteContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.LocalCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RemoteCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasTip bool
+	if err := ReadElements(r, &hasTip); err != nil {
+		return nil, err
+	}
+	if hasTip {
+		tip, err := deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+		s.RemoteCommitmentTip = &tip
+	}
+
+	var isSigned bool
+	if err := ReadElements(r, &s.FundingPsbt, &isSigned); err != nil {
+		return nil, err
+	}
+	if len(s.FundingPsbt) == 0 {
+		s.FundingPsbt = nil
+	}
+	if isSigned {
+		var msg lnwire.Message
+		if err := ReadElements(r, &msg); err != nil {
+			return nil, err
+		}
+
+		txSigs, ok := msg.(*lnwire.TxSignatures)
+		if !ok {
+			return nil, fmt.Errorf("expected tx_signatures, got %T",
+				msg)
+		}
+		s.LocalTxSigs = txSigs
+	}
+
+	// Check if the splice upgrades the commitment type of the channel.
+	var isUpgrade bool
+	err = ReadElements(r, &isUpgrade)
+	if err == io.EOF {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if isUpgrade {
+		s.Upgrade, err = deserializeChannelUpgrade(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// fetchPendingSplice reads the pending splice with the given funding outpoint
+// from the passed channel bucket.
+func fetchPendingSplice(chanBucket kvdb.RBucket,
+	outpoint []byte) (*ChannelSplice, error) {
+
+	splicesBucket := chanBucket.NestedReadBucket(pendingSplicesBucket)
+	if splicesBucket == nil {
+		return nil, ErrSpliceNotFound
+	}
+
+	spliceBytes := splicesBucket.Get(outpoint)
+	if spliceBytes == nil {
+		return nil, ErrSpliceNotFound
+	}
+
+	return deserializeChannelSplice(bytes.NewReader(spliceBytes))
+}
+
+// putPendingSplice writes the passed pending splice to the given channel
+// bucket.
+func putPendingSplice(chanBucket kvdb.RwBucket, splice *ChannelSplice) error {
+	var b bytes.Buffer
+	if err := serializeChannelSplice(&b, splice); err != nil {
+		return err
+	}
+
+	var outpoint bytes.Buffer
+	err := writeOutpoint(&outpoint, &splice.FundingOutpoint)
+	if err != nil {
+		return err
+	}
+
+	splices, err := chanBucket.CreateBucketIfNotExists(
+		pendingSplicesBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	return splices.Put(outpoint.Bytes(), b.Bytes())
+}
+
+// updatePendingSplice applies the passed modification to the pending splice
+// with the given funding outpoint within the passed channel bucket.
+func updatePendingSplice(chanBucket kvdb.RwBucket, op wire.OutPoint,
+	update func(*ChannelSplice) error) error {
+
+	var outpoint bytes.Buffer
+	if err := writeOutpoint(&outpoint, &op); err != nil {
+		return err
+	}
+
+	splice, err := fetchPendingSplice(chanBucket, outpoint.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if err := update(splice); err != nil {
+		return err
+	}
+
+	return putPendingSplice(chanBucket, splice)
+}
+
+// putSpliceCommitments stores the passed commitments, which spend the funding
+// outputs of pending splices, as the latest commitments of either party.
+func putSpliceCommitments(chanBucket kvdb.RwBucket,
+	commits []SpliceCommitment, local bool) error {
+
+	for _, commit := range commits {
+		commitment := commit.Commitment
+		err := updatePendingSplice(
+			chanBucket, commit.FundingOutpoint,
+			func(s *ChannelSplice) error {
+				if local {
+					s.LocalCommitment = commitment
+				} else {
+					s.RemoteCommitmentTip = &commitment
+				}
+
+				return nil
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to update splice %v: %w",
+				commit.FundingOutpoint, err)
+		}
+	}
+
+	return nil
+}
+
+// advanceSpliceCommitChains adds the current commitment of the remote party
+// that spends the funding output of each pending splice to the revocation
+// log of the splice, and promotes the pending commitment to the current one.
+func advanceSpliceCommitChains(chanBucket kvdb.RwBucket,
+	indexes []SpliceOutputIndexes, noAmtData bool) error {
+
+	splicesBucket := chanBucket.NestedReadBucket(pendingSplicesBucket)
+	if splicesBucket == nil {
+		return nil
+	}
+
+	var splices []*ChannelSplice
+	err := splicesBucket.ForEach(func(_, v []byte) error {
+		splice, err := deserializeChannelSplice(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+		splices = append(splices, splice)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logsBucket, err := chanBucket.CreateBucketIfNotExists(
+		pendingSpliceLogsBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, splice := range splices {
+		// The commitments of an upgrade aren't updated, as the channel
+		// stays quiescent until the kickoff transaction confirms.
+		if splice.IsUpgrade() {
+			continue
+		}
+
+		if splice.RemoteCommitmentTip == nil {
+			return fmt.Errorf("splice %v has no pending remote "+
+				"commitment", splice.FundingOutpoint)
+		}
+
+		var spliceIndexes *SpliceOutputIndexes
+		for i := range indexes {
+			op := indexes[i].FundingOutpoint
+			if op == splice.FundingOutpoint {
+				spliceIndexes = &indexes[i]
+			}
+		}
+		if spliceIndexes == nil {
+			return fmt.Errorf("missing output indexes of splice %v",
+				splice.FundingOutpoint)
+		}
+
+		var outpoint bytes.Buffer
+		err := writeOutpoint(&outpoint, &splice.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		logBucket, err := logsBucket.CreateBucketIfNotExists(
+			outpoint.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = putRevocationLog(
+			logBucket, &splice.RemoteCommitment,
+			spliceIndexes.OurOutputIndex,
+			spliceIndexes.TheirOutputIndex, noAmtData,
+		)
+		if err != nil {
+			return err
+		}
+
+		splice.RemoteCommitment = *splice.RemoteCommitmentTip
+		splice.RemoteCommitmentTip = nil
+
+		if err := putPendingSplice(chanBucket, splice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPendingSplice persists a splice of the channel whose splice transaction
+// hasn't confirmed yet. A channel may have several pending splices, only one
+// of which can confirm.
+func (c *OpenChannel) AddPendingSplice(splice *ChannelSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return putPendingSplice(chanBucket, splice)
+	}, func() {})
+}
+
+// MarkSpliceSigned records that we signed the splice transaction of the
+// pending splice with the given funding outpoint. The passed PSBT carries the
+// witnesses of our inputs, and txSigs is the message we sent to the remote
+// party, so it can be retransmitted.
+func (c *OpenChannel) MarkSpliceSigned(op wire.OutPoint, fundingPsbt []byte,
+	txSigs *lnwire.TxSignatures) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return updatePendingSplice(
+			chanBucket, op, func(s *ChannelSplice) error {
+				s.FundingPsbt = fundingPsbt
+				s.LocalTxSigs = txSigs
+
+				return nil
+			},
+		)
+	}, func() {})
+}
+
+// FinalizeSplice stores the fully signed splice transaction of the pending
+// splice with the given funding outpoint, so it can be rebroadcast.
+func (c *OpenChannel) FinalizeSplice(op wire.OutPoint,
+	spliceTx *wire.MsgTx) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return updatePendingSplice(
+			chanBucket, op, func(s *ChannelSplice) error {
+				if s.FundingTx.TxHash() != spliceTx.TxHash() {
+					return fmt.Errorf("splice tx %v "+
+						"doesn't match %v",
+						spliceTx.TxHash(),
+						s.FundingTx.TxHash())
+				}
+				s.FundingTx = spliceTx
+
+				return nil
+			},
+		)
+	}, func() {})
+}
+
+// PendingSplices returns all splices of the channel whose splice transaction
+// hasn't confirmed yet.
+func (c *OpenChannel) PendingSplices() ([]*ChannelSplice, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.pendingSplices()
+}
+
+// pendingSplices returns all pending splices of the channel.
+//
+// NOTE: The channel's mutex MUST be held when calling this method.
+func (c *OpenChannel) pendingSplices() ([]*ChannelSplice, error) {
+	var splices []*ChannelSplice
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNoChanDBExists),
+			errors.Is(err, ErrNoActiveChannels),
+			errors.Is(err, ErrChannelNotFound):
+
+			return nil
+		default:
+			return err
+		}
+
+		splicesBucket := chanBucket.NestedReadBucket(
+			pendingSplicesBucket,
+		)
+		if splicesBucket == nil {
+			return nil
+		}
+
+		return splicesBucket.ForEach(func(_, v []byte) error {
+			splice, err := deserializeChannelSplice(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			splices = append(splices, splice)
+
+			return nil
+		})
+	}, func() {
+		splices = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splices, nil
+}
+
+// DeletePendingSplices removes all pending splices of the channel, which is
+// done once a splice is aborted before its transaction was broadcast.
+func (c *OpenChannel) DeletePendingSplices() error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = chanBucket.DeleteNestedBucket(pendingSplicesBucket)
+		if err != nil && !errors.Is(err, kvdb.ErrBucketNotFound) {
+			return err
+		}
+
+		err = chanBucket.DeleteNestedBucket(pendingSpliceLogsBucket)
+		if err != nil && !errors.Is(err, kvdb.ErrBucketNotFound) {
+			return err
+		}
+
+		return nil
+	}, func() {})
+}
+
+// ApplySplice moves the channel onto the new funding output of the pending
+// splice with the given funding outpoint once its splice transaction
+// confirmed. All data of the channel is re-keyed to the new outpoint, and the
+// commitments and capacity are replaced with the ones of the splice. Any
+// other pending splice is discarded, as it conflicts with the confirmed one.
+// As only the commitments that spend the new funding output can still be
+// broadcast, they replace the entries of the revocation log and the pending
+// commitment of the remote party. The channel is announced under the short
+// channel id of the splice transaction once MarkSpliceLocked is called.
+func (c *OpenChannel) ApplySplice(spliceOutpoint wire.OutPoint) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var splice *ChannelSplice
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chainBucket, err := fetchChainBucketRw(
+			tx, c.IdentityPub, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var oldKey, newKey bytes.Buffer
+		err = writeOutpoint(&oldKey, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+		if err := writeOutpoint(&newKey, &spliceOutpoint); err != nil {
+			return err
+		}
+
+		oldBucket := chainBucket.NestedReadWriteBucket(oldKey.Bytes())
+		if oldBucket == nil {
+			return ErrChannelNotFound
+		}
+
+		splice, err = fetchPendingSplice(oldBucket, newKey.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// Copy all data of the channel, including its revocation log,
+		// to a new bucket keyed by the new funding outpoint.
+		newBucket, err := chainBucket.CreateBucket(newKey.Bytes())
+		if err != nil {
+			return err
+		}
+		if err := copyBucket(newBucket, oldBucket); err != nil {
+			return err
+		}
+		if err := chainBucket.DeleteNestedBucket(
+			oldKey.Bytes(),
+		); err != nil {
+			return err
+		}
+
+		err = newBucket.DeleteNestedBucket(pendingSplicesBucket)
+		if err != nil {
+			return err
+		}
+		err = mergeSpliceLog(newBucket, newKey.Bytes())
+		if err != nil {
+			return err
+		}
+		if err := applySpliceCommitDiff(newBucket, splice); err != nil {
+			return err
+		}
+		err = newBucket.Put(spliceLockPendingKey, oldKey.Bytes())
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(newBucket, &spliceOutpoint)
+		if err != nil {
+			return err
+		}
+		applyChannelSplice(channel, splice)
+
+		if err := putOpenChannel(newBucket, channel); err != nil {
+			return err
+		}
+
+		return updateSpliceIndexes(
+			tx, c.FundingOutpoint, spliceOutpoint,
+		)
+	}, func() {
+		splice = nil
+	}); err != nil {
+		return err
+	}
+
+	applyChannelSplice(c, splice)
+
+	return nil
+}
+
+// applyChannelSplice replaces the funding related fields of the channel with
+// the ones of the passed splice.
+func applyChannelSplice(c *OpenChannel, splice *ChannelSplice) {
+	c.FundingOutpoint = splice.FundingOutpoint
+	c.Capacity = splice.Capacity
+	c.LocalCommitment = splice.LocalCommitment
+	c.RemoteCommitment = splice.RemoteCommitment
+
+	if splice.IsUpgrade() {
+		c.ChanType = splice.Upgrade.ChanType
+		if splice.Upgrade.RemoteFundingKey != nil {
+			c.RemoteChanCfg.MultiSigKey.PubKey =
+				splice.Upgrade.RemoteFundingKey
+		}
+	}
+
+	if fundingTxPresent(c) {
+		c.FundingTxn = splice.FundingTx
+	}
+}
+
+// mergeSpliceLog replaces the entries of the revocation log of the channel
+// with the revoked commitments of the remote party that spend the funding
+// output of the splice with the given outpoint, and removes the revocation
+// logs of all pending splices.
+func mergeSpliceLog(chanBucket kvdb.RwBucket, outpoint []byte) error {
+	logsBucket := chanBucket.NestedReadBucket(pendingSpliceLogsBucket)
+	if logsBucket == nil {
+		return nil
+	}
+
+	spliceLog := logsBucket.NestedReadBucket(outpoint)
+	if spliceLog != nil {
+		logBucket, err := chanBucket.CreateBucketIfNotExists(
+			revocationLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = spliceLog.ForEach(func(k, v []byte) error {
+			return logBucket.Put(k, v)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return chanBucket.DeleteNestedBucket(pendingSpliceLogsBucket)
+}
+
+// applySpliceCommitDiff replaces the pending commitment of the remote party,
+// if any, with the one that spends the funding output of the passed splice,
+// along with our signatures for it. The updates of the commit diff are
+// re-keyed to the channel id of the new funding outpoint, so they can be
+// retransmitted once the channel moved onto it.
+func applySpliceCommitDiff(chanBucket kvdb.RwBucket,
+	splice *ChannelSplice) error {
+
+	tipBytes := chanBucket.Get(commitDiffKey)
+	if tipBytes == nil {
+		return nil
+	}
+
+	diff, err := deserializeCommitDiff(bytes.NewReader(tipBytes))
+	if err != nil {
+		return err
+	}
+
+	if splice.RemoteCommitmentTip == nil {
+		return fmt.Errorf("splice %v has no pending remote commitment",
+			splice.FundingOutpoint)
+	}
+
+	var spliceSig *lnwire.SpliceSig
+	for i := range diff.CommitSig.SpliceSigs {
+		sig := &diff.CommitSig.SpliceSigs[i]
+		if sig.FundingTxID == splice.FundingOutpoint.Hash {
+			spliceSig = sig
+		}
+	}
+	if spliceSig == nil {
+		return fmt.Errorf("commit diff has no signature for splice %v",
+			splice.FundingOutpoint)
+	}
+
+	cid := lnwire.NewChanIDFromOutPoint(splice.FundingOutpoint)
+
+	diff.Commitment = *splice.RemoteCommitmentTip
+	diff.CommitSig.ChanID = cid
+	diff.CommitSig.CommitSig = spliceSig.CommitSig
+	diff.CommitSig.HtlcSigs = spliceSig.HtlcSigs
+	diff.CommitSig.SpliceSigs = nil
+
+	for _, update := range diff.LogUpdates {
+		switch msg := update.UpdateMsg.(type) {
+		case *lnwire.UpdateAddHTLC:
+			msg.ChanID = cid
+		case *lnwire.UpdateFulfillHTLC:
+			msg.ChanID = cid
+		case *lnwire.UpdateFailHTLC:
+			msg.ChanID = cid
+		case *lnwire.UpdateFailMalformedHTLC:
+			msg.ChanID = cid
+		case *lnwire.UpdateFee:
+			msg.ChanID = cid
+		}
+	}
+
+	var b bytes.Buffer
+	if err := serializeCommitDiff(&b, diff); err != nil {
+		return err
+	}
+
+	return chanBucket.Put(commitDiffKey, b.Bytes())
+}
+
+// updateSpliceIndexes marks the old funding outpoint of a spliced channel as
+// closed in the outpoint index, and adds the new one as open.
+func updateSpliceIndexes(tx kvdb.RwTx, oldOutpoint,
+	newOutpoint wire.OutPoint) error {
+
+	opBucket := tx.ReadWriteBucket(outpointBucket)
+	if opBucket == nil {
+		return ErrNoChanDBExists
+	}
+	cidBucket := tx.ReadWriteBucket(chanIDBucket)
+	if cidBucket == nil {
+		return ErrNoChanDBExists
+	}
+
+	putStatus := func(op wire.OutPoint, status indexStatus) error {
+		var opBuf bytes.Buffer
+		if err := writeOutpoint(&opBuf, &op); err != nil {
+			return err
+		}
+
+		s := uint8(status)
+		opStream, err := tlv.NewStream(
+			tlv.MakePrimitiveRecord(indexStatusType, &s),
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := opStream.Encode(&b); err != nil {
+			return err
+		}
+
+		return opBucket.Put(opBuf.Bytes(), b.Bytes())
+	}
+
+	if err := putStatus(oldOutpoint, outpointClosed); err != nil {
+		return err
+	}
+	if err := putStatus(newOutpoint, outpointOpen); err != nil {
+		return err
+	}
+
+	cid := lnwire.NewChanIDFromOutPoint(newOutpoint)
+
+	return cidBucket.Put(cid[:], []byte{})
+}
+
+// SpliceLockPending returns the outpoint the channel was spliced from if the
+// splice confirmed, but the channel wasn't assigned the short channel id of
+// the splice transaction via MarkSpliceLocked yet.
+func (c *OpenChannel) SpliceLockPending() (*wire.OutPoint, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var prevOutpoint *wire.OutPoint
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		opBytes := chanBucket.Get(spliceLockPendingKey)
+		if opBytes == nil {
+			return nil
+		}
+
+		var op wire.OutPoint
+		err = readOutpoint(bytes.NewReader(opBytes), &op)
+		if err != nil {
+			return err
+		}
+		prevOutpoint = &op
+
+		return nil
+	}, func() {
+		prevOutpoint = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prevOutpoint, nil
+}
+
+// MarkSpliceLocked records the short channel id of the splice transaction as
+// the real short channel id of the channel once both parties exchanged
+// splice_locked. The channel is announced under it from then on, while it
+// keeps its original ShortChannelID, which identifies its forwarding packages
+// and is still accepted when forwarding.
+func (c *OpenChannel) MarkSpliceLocked(scid lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.confirmedScid = scid
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(spliceLockPendingKey)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.confirmedScid = scid
+
+	return nil
+}
+
+// fetchChainBucketRw returns the bucket that holds the channels with the
+// given node on the given chain.
+func fetchChainBucketRw(tx kvdb.RwTx, nodeKey *btcec.PublicKey,
+	chainHash chainhash.Hash) (kvdb.RwBucket, error) {
+
+	openChanBucket := tx.ReadWriteBucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil, ErrNoChanDBExists
+	}
+
+	nodePub := nodeKey.SerializeCompressed()
+	nodeChanBucket := openChanBucket.NestedReadWriteBucket(nodePub)
+	if nodeChanBucket == nil {
+		return nil, ErrNoActiveChannels
+	}
+
+	chainBucket := nodeChanBucket.NestedReadWriteBucket(chainHash[:])
+	if chainBucket == nil {
+		return nil, ErrNoActiveChannels
+	}
+
+	return chainBucket, nil
+}
+
+// copyBucket recursively copies all keys and nested buckets of src into dst.
+func copyBucket(dst kvdb.RwBucket, src kvdb.RBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		// Nested buckets are reported with a nil value.
+		if v != nil {
+			return dst.Put(k, v)
+		}
+
+		srcNested := src.NestedReadBucket(k)
+		if srcNested == nil {
+			return fmt.Errorf("nested bucket %x not found", k)
+		}
+
+		dstNested, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+
+		return copyBucket(dstNested, srcNested)
+	})
+}
@@ -0,0 +1,399 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelSplice tests that a pending splice can be persisted and applied
+// to a channel, moving it onto the new funding output.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+	oldOutpoint := channel.FundingOutpoint
+
+	// The channel doesn't have any pending splice yet.
+	splices, err := channel.PendingSplices()
+	require.NoError(t, err)
+	require.Empty(t, splices)
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&oldOutpoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(2_000_000, []byte{0x00, 0x20}))
+
+	localCommit := channel.LocalCommitment
+	localCommit.LocalBalance += 1_000_000_000
+	remoteCommit := channel.RemoteCommitment
+	remoteCommit.RemoteBalance += 1_000_000_000
+
+	splice := &ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: 0,
+		},
+		FundingTx:        spliceTx,
+		Capacity:         channel.Capacity + 1_000_000,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+	}
+
+	// Persist two competing splices, only one of which can confirm.
+	otherSplice := *splice
+	otherSplice.FundingOutpoint = wire.OutPoint{Hash: chainhash.Hash{1}}
+	require.NoError(t, channel.AddPendingSplice(splice))
+	require.NoError(t, channel.AddPendingSplice(&otherSplice))
+
+	splices, err = channel.PendingSplices()
+	require.NoError(t, err)
+	require.Len(t, splices, 2)
+
+	// Deleting the pending splices removes both of them.
+	require.NoError(t, channel.DeletePendingSplices())
+	splices, err = channel.PendingSplices()
+	require.NoError(t, err)
+	require.Empty(t, splices)
+
+	// A splice can only be applied if it's pending.
+	err = channel.ApplySplice(splice.FundingOutpoint)
+	require.ErrorIs(t, err, ErrSpliceNotFound)
+
+	require.NoError(t, channel.AddPendingSplice(splice))
+	require.NoError(t, channel.ApplySplice(splice.FundingOutpoint))
+
+	require.Equal(t, splice.FundingOutpoint, channel.FundingOutpoint)
+	require.Equal(t, splice.Capacity, channel.Capacity)
+
+	// The channel can no longer be found under its old outpoint, but under
+	// the new one.
+	_, err = cdb.FetchChannel(nil, oldOutpoint)
+	require.ErrorIs(t, err, ErrChannelNotFound)
+
+	dbChannel, err := cdb.FetchChannel(nil, splice.FundingOutpoint)
+	require.NoError(t, err)
+	require.Equal(t, splice.Capacity, dbChannel.Capacity)
+	require.Equal(
+		t, localCommit.LocalBalance,
+		dbChannel.LocalCommitment.LocalBalance,
+	)
+	require.Equal(
+		t, remoteCommit.RemoteBalance,
+		dbChannel.RemoteCommitment.RemoteBalance,
+	)
+
+	// The applied splice is no longer pending, but the channel is waiting
+	// for splice_locked.
+	splices, err = dbChannel.PendingSplices()
+	require.NoError(t, err)
+	require.Empty(t, splices)
+
+	prevOutpoint, err := dbChannel.SpliceLockPending()
+	require.NoError(t, err)
+	require.NotNil(t, prevOutpoint)
+	require.Equal(t, oldOutpoint, *prevOutpoint)
+
+	// Once locked, the channel is assigned its new short channel id.
+	scid := lnwire.ShortChannelID{BlockHeight: 800_000, TxIndex: 7}
+	require.NoError(t, dbChannel.MarkSpliceLocked(scid))
+
+	prevOutpoint, err = dbChannel.SpliceLockPending()
+	require.NoError(t, err)
+	require.Nil(t, prevOutpoint)
+
+	// The channel is announced under the new short channel id, but keeps
+	// its original one.
+	dbChannel, err = cdb.FetchChannel(nil, splice.FundingOutpoint)
+	require.NoError(t, err)
+	require.Equal(t, scid, dbChannel.RealScid())
+	require.Equal(t, channel.ShortChannelID, dbChannel.ShortChannelID)
+	require.Equal(t, splice.Capacity, dbChannel.Capacity)
+}
+
+// TestChannelSpliceCommitChain tests that the commitments of a pending splice
+// are advanced along with the ones of the channel, and that they replace the
+// revocation log and the pending remote commitment once the splice is applied.
+func TestChannelSpliceCommitChain(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&channel.FundingOutpoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(2_000_000, []byte{0x00, 0x20}))
+	spliceOutpoint := wire.OutPoint{Hash: spliceTx.TxHash()}
+
+	// newSpliceCommit returns a copy of the passed commitment with a
+	// distinct commitment transaction.
+	newSpliceCommit := func(c ChannelCommitment) ChannelCommitment {
+		commitTx := wire.NewMsgTx(2)
+		commitTx.AddTxIn(wire.NewTxIn(&spliceOutpoint, nil, nil))
+		commitTx.AddTxOut(wire.NewTxOut(
+			int64(c.CommitHeight)+1, []byte{0x00, 0x14},
+		))
+		c.CommitTx = commitTx
+		c.LocalBalance += 1_000_000_000
+
+		return c
+	}
+
+	splice := &ChannelSplice{
+		FundingOutpoint:    spliceOutpoint,
+		FundingTx:          spliceTx,
+		Capacity:           channel.Capacity + 1_000_000,
+		LocalContribution:  1_000_000,
+		LocalCommitment:    newSpliceCommit(channel.LocalCommitment),
+		RemoteCommitment:   newSpliceCommit(channel.RemoteCommitment),
+		RemoteContribution: 0,
+	}
+	require.NoError(t, channel.AddPendingSplice(splice))
+
+	// Once we signed the splice transaction, our signatures are kept for
+	// retransmission.
+	txSigs := &lnwire.TxSignatures{
+		ChanID: lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint),
+		TxID:   spliceTx.TxHash(),
+	}
+	err = channel.MarkSpliceSigned(spliceOutpoint, []byte{1, 2, 3}, txSigs)
+	require.NoError(t, err)
+
+	splices, err := channel.PendingSplices()
+	require.NoError(t, err)
+	require.Len(t, splices, 1)
+	require.True(t, splices[0].IsSigned())
+	require.False(t, splices[0].IsFinal())
+	require.Equal(t, []byte{1, 2, 3}, splices[0].FundingPsbt)
+	require.Equal(t, txSigs.TxID, splices[0].LocalTxSigs.TxID)
+	require.EqualValues(t, 1_000_000, splices[0].LocalContribution)
+
+	// Until the splice transaction is complete, the remote party is asked
+	// to retransmit its tx_signatures on reconnection.
+	chanSync, err := channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.Equal(
+		t, fn.Some(lnwire.NextFundingTxID(spliceTx.TxHash())),
+		chanSync.NextFundingTxID,
+	)
+
+	// Our new commitment is stored along with the one of the splice.
+	localCommit := channel.LocalCommitment
+	localCommit.CommitHeight++
+	spliceLocalCommit := newSpliceCommit(localCommit)
+	_, err = channel.UpdateCommitment(
+		&localCommit, nil, []SpliceCommitment{{
+			FundingOutpoint: spliceOutpoint,
+			Commitment:      spliceLocalCommit,
+		}},
+	)
+	require.NoError(t, err)
+
+	splices, err = channel.PendingSplices()
+	require.NoError(t, err)
+	require.Equal(
+		t, spliceLocalCommit.CommitHeight,
+		splices[0].LocalCommitment.CommitHeight,
+	)
+
+	// appendRemoteCommit extends a new commitment to the remote party,
+	// along with the one that spends the funding output of the splice.
+	chanID := lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint)
+	appendRemoteCommit := func() *CommitDiff {
+		remoteCommit := channel.RemoteCommitment
+		remoteCommit.CommitHeight++
+		spliceRemoteCommit := newSpliceCommit(remoteCommit)
+
+		diff := &CommitDiff{
+			Commitment: remoteCommit,
+			CommitSig: &lnwire.CommitSig{
+				ChanID:    chanID,
+				CommitSig: wireSig,
+				SpliceSigs: lnwire.SpliceSigs{{
+					FundingTxID: spliceOutpoint.Hash,
+					CommitSig:   wireSig,
+					HtlcSigs:    []lnwire.Sig{wireSig},
+				}},
+			},
+			LogUpdates: []LogUpdate{{
+				LogIndex: 1,
+				UpdateMsg: &lnwire.UpdateFee{
+					ChanID:   chanID,
+					FeePerKw: 1000,
+				},
+			}},
+			SpliceCommitments: []SpliceCommitment{{
+				FundingOutpoint: spliceOutpoint,
+				Commitment:      spliceRemoteCommit,
+			}},
+		}
+		require.NoError(t, channel.AppendRemoteCommitChain(diff))
+
+		return diff
+	}
+
+	diff := appendRemoteCommit()
+	splices, err = channel.PendingSplices()
+	require.NoError(t, err)
+	require.NotNil(t, splices[0].RemoteCommitmentTip)
+
+	// Once the remote party revoked its prior commitment, the one of the
+	// splice is added to the revocation log of the splice.
+	revokedCommit := splices[0].RemoteCommitment
+	fwdPkg := NewFwdPkg(
+		channel.ShortChanID(), channel.RemoteCommitment.CommitHeight,
+		nil, nil,
+	)
+	err = channel.AdvanceCommitChainTail(
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		[]SpliceOutputIndexes{{
+			FundingOutpoint:  spliceOutpoint,
+			OurOutputIndex:   2,
+			TheirOutputIndex: 3,
+		}},
+	)
+	require.NoError(t, err)
+	channel.RemoteCommitment = diff.Commitment
+
+	splices, err = channel.PendingSplices()
+	require.NoError(t, err)
+	require.Nil(t, splices[0].RemoteCommitmentTip)
+	require.Equal(
+		t, diff.SpliceCommitments[0].Commitment.CommitHeight,
+		splices[0].RemoteCommitment.CommitHeight,
+	)
+
+	// Until the splice is applied, the revocation log holds the revoked
+	// commitment that spends the current funding output.
+	revLog, _, err := channel.FindPreviousState(revokedCommit.CommitHeight)
+	require.NoError(t, err)
+	require.EqualValues(t, dummyLocalOutputIndex, revLog.OurOutputIndex)
+
+	diff = appendRemoteCommit()
+	require.NoError(t, channel.ApplySplice(spliceOutpoint))
+
+	chanSync, err = channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.True(t, chanSync.NextFundingTxID.IsNone())
+
+	// The revoked commitment of the splice replaced the one of the
+	// channel in the revocation log.
+	revLog, _, err = channel.FindPreviousState(revokedCommit.CommitHeight)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, revLog.OurOutputIndex)
+	require.EqualValues(t, 3, revLog.TheirOutputIndex)
+	require.EqualValues(
+		t, revokedCommit.CommitTx.TxHash(), revLog.CommitTxHash,
+	)
+
+	// The pending remote commitment is replaced with the one of the splice,
+	// which is retransmitted with its own signatures under the new channel
+	// id.
+	newChanID := lnwire.NewChanIDFromOutPoint(spliceOutpoint)
+	tip, err := channel.RemoteCommitChainTip()
+	require.NoError(t, err)
+	require.Equal(
+		t, diff.SpliceCommitments[0].Commitment.CommitTx.TxHash(),
+		tip.Commitment.CommitTx.TxHash(),
+	)
+	require.Equal(t, newChanID, tip.CommitSig.ChanID)
+	require.Equal(t, []lnwire.Sig{wireSig}, tip.CommitSig.HtlcSigs)
+	require.Empty(t, tip.CommitSig.SpliceSigs)
+	require.Len(t, tip.LogUpdates, 1)
+	updateFee, ok := tip.LogUpdates[0].UpdateMsg.(*lnwire.UpdateFee)
+	require.True(t, ok)
+	require.Equal(t, newChanID, updateFee.ChanID)
+}
+
+// TestChannelUpgrade tests that a pending upgrade of the commitment type of a
+// channel is persisted along with the messages to retransmit, and that
+// applying it changes the channel type and funding key of the remote party.
+func TestChannelUpgrade(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+	oldOutpoint := channel.FundingOutpoint
+	cid := lnwire.NewChanIDFromOutPoint(oldOutpoint)
+
+	kickoffTx := wire.NewMsgTx(2)
+	kickoffTx.AddTxIn(wire.NewTxIn(&oldOutpoint, nil, nil))
+	kickoffTx.AddTxOut(wire.NewTxOut(999_000, []byte{0x51, 0x20}))
+
+	newType := channel.ChanType | SimpleTaprootFeatureBit
+	upgrade := &ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  kickoffTx.TxHash(),
+			Index: 0,
+		},
+		FundingTx:        kickoffTx,
+		Capacity:         999_000,
+		LocalCommitment:  channel.LocalCommitment,
+		RemoteCommitment: channel.RemoteCommitment,
+		Upgrade: &ChannelUpgrade{
+			ChanType:         newType,
+			RemoteFundingKey: pubKey,
+			LocalProposal: &lnwire.DynPropose{
+				ChanID:    cid,
+				Initiator: true,
+				KickoffFeerate: fn.Some(
+					chainfee.SatPerKWeight(253),
+				),
+			},
+			LocalKickoffSig: &lnwire.KickoffSig{
+				ChanID:    cid,
+				Signature: wireSig,
+			},
+		},
+	}
+	require.NoError(t, channel.AddPendingSplice(upgrade))
+
+	splices, err := channel.PendingSplices()
+	require.NoError(t, err)
+	require.Len(t, splices, 1)
+	require.True(t, splices[0].IsUpgrade())
+	require.False(t, splices[0].IsFinal())
+
+	dbUpgrade := splices[0].Upgrade
+	require.Equal(t, newType, dbUpgrade.ChanType)
+	require.True(t, pubKey.IsEqual(dbUpgrade.RemoteFundingKey))
+	require.NotNil(t, dbUpgrade.LocalProposal)
+	require.Equal(
+		t, upgrade.Upgrade.LocalProposal.KickoffFeerate,
+		dbUpgrade.LocalProposal.KickoffFeerate,
+	)
+	require.Equal(t, wireSig, dbUpgrade.LocalKickoffSig.Signature)
+
+	// The kickoff_sig of an upgrade isn't requested via
+	// channel_reestablish.
+	chanSync, err := channel.ChanSyncMsg()
+	require.NoError(t, err)
+	require.True(t, chanSync.NextFundingTxID.IsNone())
+
+	require.NoError(t, channel.ApplySplice(upgrade.FundingOutpoint))
+
+	dbChannel, err := cdb.FetchChannel(nil, upgrade.FundingOutpoint)
+	require.NoError(t, err)
+	require.Equal(t, newType, dbChannel.ChanType)
+	require.True(
+		t, pubKey.IsEqual(dbChannel.RemoteChanCfg.MultiSigKey.PubKey),
+	)
+	require.Equal(t, upgrade.Capacity, dbChannel.Capacity)
+}
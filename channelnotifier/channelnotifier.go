@@ -80,6 +80,16 @@ type FullyResolvedChannelEvent struct {
 	ChannelPoint *wire.OutPoint
 }
 
+// SplicedChannelEvent represents a new event where a channel was moved onto
+// the new funding output of its splice.
+type SplicedChannelEvent struct {
+	// PrevChannelPoint is the funding outpoint the channel was moved off.
+	PrevChannelPoint *wire.OutPoint
+
+	// Channel is the channel on its new funding output.
+	Channel *channeldb.OpenChannel
+}
+
 // New creates a new channel notifier. The ChannelNotifier gets channel
 // events from peers and from the chain arbitrator, and dispatches them to
 // its clients.
@@ -172,6 +182,29 @@ func (c *ChannelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
 	}
 }
 
+// NotifySplicedChannelEvent notifies the channelEventNotifier goroutine that a
+// channel was moved onto the new funding output of its splice.
+func (c *ChannelNotifier) NotifySplicedChannelEvent(prevChanPoint,
+	chanPoint wire.OutPoint) {
+
+	// Fetch the spliced channel from the database.
+	channel, err := c.chanDB.FetchChannel(nil, chanPoint)
+	if err != nil {
+		log.Warnf("Unable to fetch spliced channel from the db: %v",
+			err)
+		return
+	}
+
+	// Send the spliced event to all channel event subscribers.
+	event := SplicedChannelEvent{
+		PrevChannelPoint: &prevChanPoint,
+		Channel:          channel,
+	}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send spliced channel update: %v", err)
+	}
+}
+
 // NotifyFullyResolvedChannelEvent notifies the channelEventNotifier goroutine
 // that a channel was fully resolved on chain.
 func (c *ChannelNotifier) NotifyFullyResolvedChannelEvent(
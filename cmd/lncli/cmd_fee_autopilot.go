@@ -0,0 +1,43 @@
+package main
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var feeAutopilotPlanCommand = cli.Command{
+	Name:     "feeautopilotplan",
+	Category: "Channels",
+	Usage:    "Display the fee policies planned by the fee autopilot.",
+	Description: `
+	Returns the fee policies that the fee autopilot currently plans for our
+	channels, together with the action it takes for each channel. The
+	action "update" means that the channel is updated to the planned policy
+	on the next run of the fee autopilot, or only logged if it runs in dry
+	run mode. The action "rate_limited" means that the policy of the
+	channel was updated too recently.
+
+	The plan is evaluated on request, so this command doesn't change any
+	fee policies. It fails if the fee autopilot isn't active.
+	`,
+	Action: actionDecorator(feeAutopilotPlan),
+}
+
+func feeAutopilotPlan(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, err := client.FeeAutopilotPlan(
+		ctxc, &routerrpc.FeeAutopilotPlanRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
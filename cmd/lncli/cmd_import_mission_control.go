@@ -28,6 +28,7 @@ var importMissionControlCommand = cli.Command{
 			Name:  "force",
 			Usage: "whether to force the history entry import",
 		},
+		mcNamespaceFlag,
 	},
 }
 
@@ -91,7 +92,8 @@ func importMissionControl(ctx *cli.Context) error {
 		Pairs: []*routerrpc.PairHistory{
 			importResult,
 		},
-		Force: ctx.IsSet("force"),
+		Force:                   ctx.IsSet("force"),
+		MissionControlNamespace: ctx.String(mcNamespaceFlag.Name),
 	}
 
 	rpcCtx := context.Background()
@@ -90,10 +90,102 @@ var addInvoiceCommand = cli.Command{
 				"ephemeral key so as not to reveal the real " +
 				"node ID of this node.",
 		},
+		cli.UintFlag{
+			Name: "min_real_blinded_hops",
+			Usage: "(blinded paths) the minimum number of real " +
+				"hops to include in a blinded path. If not " +
+				"set, the configured default is used.",
+		},
+		cli.UintFlag{
+			Name: "num_blinded_hops",
+			Usage: "(blinded paths) the number of hops to " +
+				"include in a blinded path, padded with " +
+				"dummy hops. If not set, the configured " +
+				"default is used.",
+		},
+		cli.UintFlag{
+			Name: "max_blinded_paths",
+			Usage: "(blinded paths) the maximum number of " +
+				"blinded paths to include in the invoice. If " +
+				"not set, the configured default is used.",
+		},
+		cli.StringSliceFlag{
+			Name: "blinded_path_omit_node",
+			Usage: "(blinded paths) the hex encoded public key " +
+				"of a node that must not be used in any of " +
+				"the blinded paths. Can be specified multiple " +
+				"times.",
+		},
+		cli.StringSliceFlag{
+			Name: "blinded_path_intro_node",
+			Usage: "(blinded paths) the hex encoded public key " +
+				"of a node that may be used as introduction " +
+				"node of the blinded paths. Can be specified " +
+				"multiple times.",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
 
+// blindedPathConfigFlags are the flags of addinvoice that configure the
+// blinded paths of the invoice.
+var blindedPathConfigFlags = []string{
+	"min_real_blinded_hops", "num_blinded_hops", "max_blinded_paths",
+	"blinded_path_omit_node", "blinded_path_intro_node",
+}
+
+// parseBlindedPathConfig parses the blinded path flags of addinvoice. It
+// returns nil if none of the flags is set.
+func parseBlindedPathConfig(ctx *cli.Context) (*lnrpc.BlindedPathConfig,
+	error) {
+
+	var isSet bool
+	for _, flag := range blindedPathConfigFlags {
+		isSet = isSet || ctx.IsSet(flag)
+	}
+	if !isSet {
+		return nil, nil
+	}
+
+	if !ctx.Bool("blind") {
+		return nil, fmt.Errorf("blinded path flags can only be used " +
+			"with --blind")
+	}
+
+	// decodeNodes decodes a list of hex encoded public keys.
+	decodeNodes := func(flag string) ([][]byte, error) {
+		var nodes [][]byte
+		for _, node := range ctx.StringSlice(flag) {
+			b, err := hex.DecodeString(node)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %s: "+
+					"%w", flag, err)
+			}
+			nodes = append(nodes, b)
+		}
+
+		return nodes, nil
+	}
+
+	omit, err := decodeNodes("blinded_path_omit_node")
+	if err != nil {
+		return nil, err
+	}
+
+	intro, err := decodeNodes("blinded_path_intro_node")
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.BlindedPathConfig{
+		MinNumRealHops:   uint32(ctx.Uint("min_real_blinded_hops")),
+		NumHops:          uint32(ctx.Uint("num_blinded_hops")),
+		MaxNumPaths:      uint32(ctx.Uint("max_blinded_paths")),
+		NodeOmissionList: omit,
+		IntroNodeList:    intro,
+	}, nil
+}
+
 func addInvoice(ctx *cli.Context) error {
 	var (
 		preimage []byte
@@ -140,18 +232,24 @@ func addInvoice(ctx *cli.Context) error {
 			"blinded paths in the same invoice")
 	}
 
+	blindedPathCfg, err := parseBlindedPathConfig(ctx)
+	if err != nil {
+		return err
+	}
+
 	invoice := &lnrpc.Invoice{
-		Memo:            ctx.String("memo"),
-		RPreimage:       preimage,
-		Value:           amt,
-		ValueMsat:       amtMsat,
-		DescriptionHash: descHash,
-		FallbackAddr:    ctx.String("fallback_addr"),
-		Expiry:          ctx.Int64("expiry"),
-		CltvExpiry:      ctx.Uint64("cltv_expiry_delta"),
-		Private:         ctx.Bool("private"),
-		IsAmp:           ctx.Bool("amp"),
-		Blind:           ctx.Bool("blind"),
+		Memo:              ctx.String("memo"),
+		RPreimage:         preimage,
+		Value:             amt,
+		ValueMsat:         amtMsat,
+		DescriptionHash:   descHash,
+		FallbackAddr:      ctx.String("fallback_addr"),
+		Expiry:            ctx.Int64("expiry"),
+		CltvExpiry:        ctx.Uint64("cltv_expiry_delta"),
+		Private:           ctx.Bool("private"),
+		IsAmp:             ctx.Bool("amp"),
+		Blind:             ctx.Bool("blind"),
+		BlindedPathConfig: blindedPathCfg,
 	}
 
 	resp, err := client.AddInvoice(ctxc, invoice)
@@ -10,8 +10,16 @@ import (
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var mcNamespaceFlag = cli.StringFlag{
+	Name: "mc_namespace",
+	Usage: "(optional) the mission control namespace to use, if not " +
+		"set the default namespace is used",
+}
+
 var getCfgCommand = cli.Command{
 	Name:     "getmccfg",
 	Category: "Mission Control",
@@ -19,6 +27,7 @@ var getCfgCommand = cli.Command{
 	Description: `
 	Returns the config currently being used by mission control.
 	`,
+	Flags:  []cli.Flag{mcNamespaceFlag},
 	Action: actionDecorator(getCfg),
 }
 
@@ -30,7 +39,11 @@ func getCfg(ctx *cli.Context) error {
 	client := routerrpc.NewRouterClient(conn)
 
 	resp, err := client.GetMissionControlConfig(
-		ctxc, &routerrpc.GetMissionControlConfigRequest{},
+		ctxc, &routerrpc.GetMissionControlConfigRequest{
+			MissionControlNamespace: ctx.String(
+				mcNamespaceFlag.Name,
+			),
+		},
 	)
 	if err != nil {
 		return err
@@ -50,6 +63,7 @@ var setCfgCommand = cli.Command{
         probability that payment routes will succeed. The estimator type must be
         provided to set estimator-related parameters.`,
 	Flags: []cli.Flag{
+		mcNamespaceFlag,
 		// General settings.
 		cli.UintFlag{
 			Name: "pmtnr",
@@ -122,11 +136,21 @@ func setCfg(ctx *cli.Context) error {
 
 	client := routerrpc.NewRouterClient(conn)
 
+	namespace := ctx.String(mcNamespaceFlag.Name)
+
 	// Fetch current mission control config which we update to create our
-	// response.
+	// response. A namespace that doesn't exist yet is created by setting
+	// its config, so we start out from the default config then.
 	mcCfg, err := client.GetMissionControlConfig(
-		ctxc, &routerrpc.GetMissionControlConfigRequest{},
+		ctxc, &routerrpc.GetMissionControlConfigRequest{
+			MissionControlNamespace: namespace,
+		},
 	)
+	if status.Code(err) == codes.NotFound {
+		mcCfg, err = client.GetMissionControlConfig(
+			ctxc, &routerrpc.GetMissionControlConfigRequest{},
+		)
+	}
 	if err != nil {
 		return err
 	}
@@ -262,7 +286,8 @@ func setCfg(ctx *cli.Context) error {
 
 	_, err = client.SetMissionControlConfig(
 		ctxc, &routerrpc.SetMissionControlConfigRequest{
-			Config: mcCfg.Config,
+			Config:                  mcCfg.Config,
+			MissionControlNamespace: namespace,
 		},
 	)
 	return err
@@ -272,6 +297,7 @@ var queryMissionControlCommand = cli.Command{
 	Name:     "querymc",
 	Category: "Mission Control",
 	Usage:    "Query the internal mission control state.",
+	Flags:    []cli.Flag{mcNamespaceFlag},
 	Action:   actionDecorator(queryMissionControl),
 }
 
@@ -282,7 +308,9 @@ func queryMissionControl(ctx *cli.Context) error {
 
 	client := routerrpc.NewRouterClient(conn)
 
-	req := &routerrpc.QueryMissionControlRequest{}
+	req := &routerrpc.QueryMissionControlRequest{
+		MissionControlNamespace: ctx.String(mcNamespaceFlag.Name),
+	}
 	snapshot, err := client.QueryMissionControl(ctxc, req)
 	if err != nil {
 		return err
@@ -298,6 +326,7 @@ var queryProbCommand = cli.Command{
 	Category:  "Mission Control",
 	Usage:     "Deprecated. Estimate a success probability.",
 	ArgsUsage: "from-node to-node amt",
+	Flags:     []cli.Flag{mcNamespaceFlag},
 	Action:    actionDecorator(queryProb),
 	Hidden:    true,
 }
@@ -338,6 +367,8 @@ func queryProb(ctx *cli.Context) error {
 		FromNode: fromNode[:],
 		ToNode:   toNode[:],
 		AmtMsat:  int64(amtMsat),
+
+		MissionControlNamespace: ctx.String(mcNamespaceFlag.Name),
 	}
 
 	response, err := client.QueryProbability(ctxc, req)
@@ -354,7 +385,20 @@ var resetMissionControlCommand = cli.Command{
 	Name:     "resetmc",
 	Category: "Mission Control",
 	Usage:    "Reset internal mission control state.",
-	Action:   actionDecorator(resetMissionControl),
+	Description: `
+	Clears the history of a mission control namespace. With --delete, the
+	namespace is deleted together with its history and config. The default
+	namespace can't be deleted.
+	`,
+	Flags: []cli.Flag{
+		mcNamespaceFlag,
+		cli.BoolFlag{
+			Name: "delete",
+			Usage: "delete the namespace instead of only " +
+				"resetting its history",
+		},
+	},
+	Action: actionDecorator(resetMissionControl),
 }
 
 func resetMissionControl(ctx *cli.Context) error {
@@ -364,7 +408,10 @@ func resetMissionControl(ctx *cli.Context) error {
 
 	client := routerrpc.NewRouterClient(conn)
 
-	req := &routerrpc.ResetMissionControlRequest{}
+	req := &routerrpc.ResetMissionControlRequest{
+		MissionControlNamespace: ctx.String(mcNamespaceFlag.Name),
+		DeleteNamespace:         ctx.Bool("delete"),
+	}
 	_, err := client.ResetMissionControl(ctxc, req)
 	return err
 }
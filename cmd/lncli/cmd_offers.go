@@ -0,0 +1,238 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/urfave/cli"
+)
+
+var addOfferCommand = cli.Command{
+	Name:     "addoffer",
+	Category: "Invoices",
+	Usage:    "Create a new BOLT 12 offer.",
+	Description: `
+	Create a new BOLT 12 offer. An offer is a static payment code that can
+	be paid multiple times. Payers request an invoice for the offer from
+	this node with an onion message, so onion messages must be enabled
+	with --protocol.onion-messages.
+
+	If no amount is given, the payer chooses the amount. The offer can be
+	paid until it expires.
+	`,
+	ArgsUsage: "[description]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "description",
+			Usage: "a description of the purpose of the payment, " +
+				"required if an amount is set",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount to pay in satoshis",
+		},
+		cli.Int64Flag{
+			Name:  "amt_msat",
+			Usage: "the amount to pay in millisatoshis",
+		},
+		cli.StringFlag{
+			Name: "issuer",
+			Usage: "a human readable identification of the " +
+				"issuer of the offer",
+		},
+		cli.Uint64Flag{
+			Name: "expiry",
+			Usage: "the number of seconds after which the offer " +
+				"expires, if not set the offer doesn't expire",
+		},
+		cli.BoolFlag{
+			Name: "multiple_items",
+			Usage: "if set, the offer can be paid for multiple " +
+				"items at once",
+		},
+		cli.Uint64Flag{
+			Name: "quantity_max",
+			Usage: "the maximum number of items that can be paid " +
+				"for at once, only valid with " +
+				"--multiple_items",
+		},
+	},
+	Action: actionDecorator(addOffer),
+}
+
+func addOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	description := ctx.String("description")
+	if ctx.Args().Present() {
+		if ctx.IsSet("description") {
+			return errors.New("description can only be set once")
+		}
+		description = ctx.Args().First()
+	}
+
+	amt, err := lnrpc.UnmarshallAmt(
+		ctx.Int64("amt"), ctx.Int64("amt_msat"),
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddOffer(ctxc, &lnrpc.AddOfferRequest{
+		Description:   description,
+		AmountMsat:    uint64(amt),
+		Issuer:        ctx.String("issuer"),
+		Expiry:        ctx.Uint64("expiry"),
+		MultipleItems: ctx.Bool("multiple_items"),
+		QuantityMax:   ctx.Uint64("quantity_max"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var payOfferCommand = cli.Command{
+	Name:     "payoffer",
+	Category: "Payments",
+	Usage:    "Pay a BOLT 12 offer over lightning.",
+	Description: `
+	Request an invoice for a BOLT 12 offer from its issuer and pay it along
+	the blinded paths of the invoice. The invoice is requested with an
+	onion message, so onion messages must be enabled with
+	--protocol.onion-messages.
+
+	The amount is only required if the offer doesn't specify one, and the
+	quantity is only allowed if the offer can be paid for multiple items.
+	`,
+	ArgsUsage: "offer",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "offer",
+			Usage: "the bech32 encoded offer to pay",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "(optional) number of satoshis to pay, " +
+				"required if the offer has no amount",
+		},
+		cli.Uint64Flag{
+			Name: "quantity",
+			Usage: "(optional) the number of items to pay for, " +
+				"required if the offer can be paid for " +
+				"multiple items",
+		},
+		cli.StringFlag{
+			Name: "payer_note",
+			Usage: "(optional) a note to the issuer that is " +
+				"included in the invoice",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when sending " +
+				"the payment, if not set only zero-fee " +
+				"routes are used",
+		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "the maximum amount of time we should spend " +
+				"trying to fulfill the payment, failing " +
+				"after the timeout has elapsed",
+			Value: paymentTimeout,
+		},
+		cli.Int64SliceFlag{
+			Name: "outgoing_chan_id",
+			Usage: "short channel id of the outgoing channel to " +
+				"use for the first hop of the payment; can " +
+				"be specified multiple times in the same " +
+				"command",
+			Value: &cli.Int64Slice{},
+		},
+		cancelableFlag,
+		cltvLimitFlag,
+		inflightUpdatesFlag,
+		maxPartsFlag,
+		jsonFlag,
+	},
+	Action: actionDecorator(payOffer),
+}
+
+func payOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	args := ctx.Args()
+
+	var offer string
+	switch {
+	case ctx.IsSet("offer"):
+		offer = ctx.String("offer")
+	case args.Present():
+		offer = args.First()
+	default:
+		return fmt.Errorf("offer argument missing")
+	}
+
+	pmtTimeout := ctx.Duration("timeout")
+	if pmtTimeout <= 0 {
+		return errors.New("payment timeout must be greater than zero")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	// Always print in-flight updates for the table output.
+	printJSON := ctx.Bool(jsonFlag.Name)
+
+	req := &routerrpc.PayOfferRequest{
+		Offer: offer,
+		AmtMsat: int64(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(ctx.Int64("amt")),
+		)),
+		Quantity:       ctx.Uint64("quantity"),
+		PayerNote:      ctx.String("payer_note"),
+		TimeoutSeconds: int32(pmtTimeout.Seconds()),
+		FeeLimitMsat: int64(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(ctx.Int64("fee_limit")),
+		)),
+		CltvLimit: int32(ctx.Int(cltvLimitFlag.Name)),
+		MaxParts:  uint32(ctx.Uint(maxPartsFlag.Name)),
+		NoInflightUpdates: !ctx.Bool(inflightUpdatesFlag.Name) &&
+			printJSON,
+		Cancelable: ctx.Bool(cancelableFlag.Name),
+	}
+
+	for _, chanID := range ctx.Int64Slice("outgoing_chan_id") {
+		req.OutgoingChanIds = append(
+			req.OutgoingChanIds, uint64(chanID),
+		)
+	}
+
+	stream, err := routerClient.PayOffer(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	finalState, err := printLivePayment(ctxc, stream, client, printJSON)
+	if err != nil {
+		return err
+	}
+
+	// If we get a payment error back, we pass an error up to main which
+	// eventually calls fatal() and returns with a non-zero exit code.
+	if finalState.Status != lnrpc.Payment_SUCCEEDED {
+		return errors.New(finalState.Status.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var sendOnionMessageCommand = cli.Command{
+	Name:     "sendonionmessage",
+	Category: "Peers",
+	Usage:    "Send an onion message to a node or along a blinded path.",
+	Description: `
+	Send an onion message that is relayed through the network to the
+	destination node, or to the recipient of a blinded path. The message
+	content is given as a list of records with types of at least 64.
+
+	If --reply_path is set, a blinded path back to this node is attached
+	to the message. Replies along this path are reported by
+	subscribeonionmessages with the returned reply path ID.
+	`,
+	ArgsUsage: "--dest=<pubkey> | --blinded_path=<hex> --data=<records>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "dest",
+			Usage: "the hex-encoded public key of the recipient",
+		},
+		cli.StringFlag{
+			Name: "blinded_path",
+			Usage: "the hex-encoded blinded path to the recipient, " +
+				"for example the reply path of a received " +
+				"onion message",
+		},
+		cli.StringFlag{
+			Name: "data",
+			Usage: "the content of the message. The required " +
+				"format is: <record_type>=<hex_value>," +
+				"<record_type>=<hex_value>,.. For example: " +
+				"--data 64=0a21ff",
+		},
+		cli.BoolFlag{
+			Name: "reply_path",
+			Usage: "if set, a blinded path that the recipient " +
+				"can use to reply is attached to the message",
+		},
+	},
+	Action: actionDecorator(sendOnionMessage),
+}
+
+func sendOnionMessage(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	dest, err := hex.DecodeString(ctx.String("dest"))
+	if err != nil {
+		return fmt.Errorf("invalid dest: %w", err)
+	}
+
+	blindedPath, err := hex.DecodeString(ctx.String("blinded_path"))
+	if err != nil {
+		return fmt.Errorf("invalid blinded path: %w", err)
+	}
+
+	if len(dest) == 0 && len(blindedPath) == 0 {
+		return errors.New("either dest or blinded_path must be set")
+	}
+
+	records := make(map[uint64][]byte)
+	if data := ctx.String("data"); data != "" {
+		for _, r := range strings.Split(data, ",") {
+			kv := strings.Split(r, "=")
+			if len(kv) != 2 {
+				return errors.New("invalid data format: " +
+					"multiple equal signs in record")
+			}
+
+			recordType, err := strconv.ParseUint(kv[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid data format: %w",
+					err)
+			}
+
+			value, err := hex.DecodeString(kv[1])
+			if err != nil {
+				return fmt.Errorf("invalid data format: %w",
+					err)
+			}
+
+			records[recordType] = value
+		}
+	}
+
+	resp, err := client.SendOnionMessage(
+		ctxc, &lnrpc.SendOnionMessageRequest{
+			Destination: dest,
+			BlindedPath: blindedPath,
+			Records:     records,
+			ReplyPath:   ctx.Bool("reply_path"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var subscribeOnionMessagesCommand = cli.Command{
+	Name:     "subscribeonionmessages",
+	Category: "Peers",
+	Usage:    "Subscribe to onion messages addressed to this node.",
+	Action:   actionDecorator(subscribeOnionMessages),
+}
+
+func subscribeOnionMessages(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeOnionMessages(
+		ctxc, &lnrpc.SubscribeOnionMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
@@ -99,6 +99,33 @@ var (
 		Usage: "(optional) expresses time preference (range -1 to 1)",
 	}
 
+	trampolineNodeFlag = cli.StringFlag{
+		Name: "trampoline_node",
+		Usage: "(optional) the hex encoded public key of a trampoline " +
+			"node that finds the route to the destination on our " +
+			"behalf",
+	}
+
+	trampolineFeeFlag = cli.Int64Flag{
+		Name: "trampoline_fee_msat",
+		Usage: "(trampoline) the fee in milli-satoshis that the " +
+			"trampoline node may spend to reach the destination, " +
+			"taken from the fee limit",
+	}
+
+	trampolineCltvDeltaFlag = cli.UintFlag{
+		Name: "trampoline_cltv_delta",
+		Usage: "(trampoline) the number of blocks that the " +
+			"trampoline node may spend to reach the destination",
+	}
+
+	optimalSplitFlag = cli.BoolFlag{
+		Name: "optimal_split",
+		Usage: "if set, a payment that needs to be split is " +
+			"allocated across several paths at once instead of " +
+			"by repeatedly halving the amount",
+	}
+
 	introductionNodeFlag = cli.StringFlag{
 		Name: "introduction_node",
 		Usage: "(blinded paths) the hex encoded, cleartext node ID " +
@@ -198,7 +225,8 @@ func paymentFlags() []cli.Flag {
 		},
 		dataFlag, inflightUpdatesFlag, maxPartsFlag, jsonFlag,
 		maxShardSizeSatFlag, maxShardSizeMsatFlag, ampFlag,
-		timePrefFlag,
+		timePrefFlag, trampolineNodeFlag, trampolineFeeFlag,
+		trampolineCltvDeltaFlag, optimalSplitFlag, mcNamespaceFlag,
 	}
 }
 
@@ -598,6 +626,27 @@ func sendPaymentRequest(ctx *cli.Context,
 	// Set time pref.
 	req.TimePref = ctx.Float64(timePrefFlag.Name)
 
+	// Split the payment across several paths at once if requested.
+	req.OptimalSplit = ctx.Bool(optimalSplitFlag.Name)
+
+	// Keep the payment's history in the given mission control namespace.
+	req.MissionControlNamespace = ctx.String(mcNamespaceFlag.Name)
+
+	// Route the payment through a trampoline node if one is given.
+	if ctx.IsSet(trampolineNodeFlag.Name) {
+		node, err := hex.DecodeString(
+			ctx.String(trampolineNodeFlag.Name),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid trampoline node: %w", err)
+		}
+		req.TrampolineNode = node
+		req.TrampolineFeeMsat = ctx.Int64(trampolineFeeFlag.Name)
+		req.TrampolineCltvDelta = uint32(
+			ctx.Uint(trampolineCltvDeltaFlag.Name),
+		)
+	}
+
 	// Always print in-flight updates for the table output.
 	printJSON := ctx.Bool(jsonFlag.Name)
 	req.NoInflightUpdates = !ctx.Bool(inflightUpdatesFlag.Name) && printJSON
@@ -1418,6 +1467,27 @@ var listPaymentsCommand = cli.Command{
 				"payments with creation date less than or " +
 				"equal to it",
 		},
+		cli.StringFlag{
+			Name: "status",
+			Usage: "if set, only payments with the given status " +
+				"(initiated, in_flight, succeeded or failed) " +
+				"are returned",
+		},
+		cli.StringFlag{
+			Name: "destination",
+			Usage: "if set, only payments to the node with the " +
+				"given hex-encoded public key are returned",
+		},
+		cli.Int64Flag{
+			Name: "min_amt_msat",
+			Usage: "if set, only payments with a value greater " +
+				"than or equal to it are returned",
+		},
+		cli.Int64Flag{
+			Name: "max_amt_msat",
+			Usage: "if set, only payments with a value less " +
+				"than or equal to it are returned",
+		},
 	},
 	Action: actionDecorator(listPayments),
 }
@@ -1435,6 +1505,28 @@ func listPayments(ctx *cli.Context) error {
 		CountTotalPayments: ctx.Bool("count_total_payments"),
 		CreationDateStart:  ctx.Uint64("creation_date_start"),
 		CreationDateEnd:    ctx.Uint64("creation_date_end"),
+		MinAmountMsat:      ctx.Int64("min_amt_msat"),
+		MaxAmountMsat:      ctx.Int64("max_amt_msat"),
+	}
+
+	if ctx.IsSet("status") {
+		status, ok := lnrpc.Payment_PaymentStatus_value[strings.ToUpper(
+			ctx.String("status"),
+		)]
+		if !ok {
+			return fmt.Errorf("unknown payment status %v",
+				ctx.String("status"))
+		}
+		req.Status = lnrpc.Payment_PaymentStatus(status)
+	}
+
+	if ctx.IsSet("destination") {
+		dest, err := hex.DecodeString(ctx.String("destination"))
+		if err != nil {
+			return fmt.Errorf("unable to decode destination: %w",
+				err)
+		}
+		req.Destination = dest
 	}
 
 	payments, err := client.ListPayments(ctxc, req)
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var rebalanceCommand = cli.Command{
+	Name:     "rebalance",
+	Category: "Payments",
+	Usage:    "Move liquidity between our channels by paying ourselves.",
+	Description: `
+	Pay ourselves through the outgoing channels and back through the
+	incoming channel, which moves local balance from the outgoing channels
+	to the incoming channel. The payment is recorded as a rebalance.
+
+	The channels and the amount can be set explicitly, or derived from a
+	target ratio of the local balance to the channel balance. With a target
+	ratio, the outgoing channels default to all channels above it, the
+	incoming channel defaults to the channel furthest below it and the
+	amount defaults to what raises the incoming channel to the target.
+
+	The incoming channel must be the only channel with its peer, as the
+	returning payment may arrive over any channel with that peer.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64SliceFlag{
+			Name: "outgoing_chan_id",
+			Usage: "short channel id of an outgoing channel to " +
+				"move liquidity from; can be specified " +
+				"multiple times in the same command",
+			Value: &cli.Int64Slice{},
+		},
+		cli.Uint64Flag{
+			Name: "incoming_chan_id",
+			Usage: "short channel id of the incoming channel to " +
+				"move liquidity to",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "number of satoshis to move",
+		},
+		cli.Float64Flag{
+			Name: "target_ratio",
+			Usage: "the target ratio of the local balance to the " +
+				"channel balance between 0 and 1, required " +
+				"if the channels or the amount aren't set",
+		},
+		cli.Uint64Flag{
+			Name: "max_fee_rate_ppm",
+			Usage: "the maximum fee in parts per million of the " +
+				"amount",
+		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "the maximum amount of time we should spend " +
+				"trying to fulfill the payment, failing " +
+				"after the timeout has elapsed",
+			Value: paymentTimeout,
+		},
+		cancelableFlag,
+		inflightUpdatesFlag,
+		maxPartsFlag,
+		jsonFlag,
+	},
+	Action: actionDecorator(rebalance),
+}
+
+func rebalance(ctx *cli.Context) error {
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "rebalance")
+	}
+
+	ctxc := getContext()
+
+	pmtTimeout := ctx.Duration("timeout")
+	if pmtTimeout <= 0 {
+		return errors.New("payment timeout must be greater than zero")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	// Always print in-flight updates for the table output.
+	printJSON := ctx.Bool(jsonFlag.Name)
+
+	req := &routerrpc.RebalanceRequest{
+		IncomingChanId:   ctx.Uint64("incoming_chan_id"),
+		AmtSat:           ctx.Int64("amt"),
+		TargetLocalRatio: ctx.Float64("target_ratio"),
+		MaxFeeRatePpm:    ctx.Uint64("max_fee_rate_ppm"),
+		TimeoutSeconds:   int32(pmtTimeout.Seconds()),
+		MaxParts:         uint32(ctx.Uint(maxPartsFlag.Name)),
+		NoInflightUpdates: !ctx.Bool(inflightUpdatesFlag.Name) &&
+			printJSON,
+		Cancelable: ctx.Bool(cancelableFlag.Name),
+	}
+
+	for _, chanID := range ctx.Int64Slice("outgoing_chan_id") {
+		req.OutgoingChanIds = append(
+			req.OutgoingChanIds, uint64(chanID),
+		)
+	}
+
+	stream, err := routerClient.Rebalance(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	finalState, err := printLivePayment(ctxc, stream, client, printJSON)
+	if err != nil {
+		return err
+	}
+
+	// If we get a payment error back, we pass an error up to main which
+	// eventually calls fatal() and returns with a non-zero exit code.
+	if finalState.Status != lnrpc.Payment_SUCCEEDED {
+		return errors.New(finalState.Status.String())
+	}
+
+	return nil
+}
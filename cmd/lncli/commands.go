@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -798,6 +799,11 @@ var closeChannelCommand = cli.Command{
 	if an upfront shutdown address has not already been set. If neither are
 	set the funds will be delivered to a new wallet address.
 
+	If both peers support the RBF co-op close flow, then the closing
+	transaction of an ongoing cooperative closure can be fee bumped by
+	calling this command again with the --bump flag and a higher fee rate
+	set via either the --conf_target or --sat_per_vbyte arguments.
+
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
 	The format for a channel_point is 'funding_txid:output_index'.`,
@@ -861,6 +867,12 @@ var closeChannelCommand = cli.Command{
 				"success pobability of the negotiation if " +
 				"set higher",
 		},
+		cli.BoolFlag{
+			Name: "bump",
+			Usage: "(optional) replace the closing transaction " +
+				"of an ongoing RBF cooperative close with " +
+				"one that pays a higher fee rate",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -898,6 +910,7 @@ func closeChannel(ctx *cli.Context) error {
 		SatPerVbyte:     ctx.Uint64(feeRateFlag),
 		DeliveryAddress: ctx.String("delivery_addr"),
 		MaxFeePerVbyte:  ctx.Uint64("max_fee_rate"),
+		Bump:            ctx.Bool("bump"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -2471,6 +2484,125 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	return nil
 }
 
+var updateChannelParamsCommand = cli.Command{
+	Name:      "updatechanparams",
+	Category:  "Channels",
+	Usage:     "Update the parameters of an active channel.",
+	ArgsUsage: "channel_point",
+	Description: `
+	Updates the parameters of an active channel without closing it, by
+	running a dynamic commitment negotiation with the remote peer. Only the
+	parameters that are set are updated. The command returns once the peer
+	has accepted or rejected the new parameters.
+
+	Both peers need to signal support for dynamic commitments, and the
+	channel must not have any HTLCs or pending updates. Channel points are
+	encoded as: funding_txid:output_index
+
+	A private anchor channel that we opened can be upgraded to a simple
+	taproot channel with --channel_type=taproot. Its funds are moved to a
+	taproot funding output by a kickoff transaction that we pay for, and
+	the channel can't be used until it confirmed. The upgrade can't be
+	combined with other parameters.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "chan_point",
+			Usage: "the channel to update the parameters of",
+		},
+		cli.Uint64Flag{
+			Name: "dust_limit_sat",
+			Usage: "(optional) the new dust limit in satoshis of " +
+				"our commitment transaction",
+		},
+		cli.Uint64Flag{
+			Name: "max_value_in_flight_msat",
+			Usage: "(optional) the new maximum value in " +
+				"milli-satoshis of HTLCs that the remote " +
+				"peer may have pending towards us",
+		},
+		cli.Uint64Flag{
+			Name: "chan_reserve_sat",
+			Usage: "(optional) the new channel reserve in " +
+				"satoshis that the remote peer must maintain",
+		},
+		cli.Uint64Flag{
+			Name: "max_accepted_htlcs",
+			Usage: "(optional) the new maximum number of HTLCs " +
+				"that the remote peer may have pending " +
+				"towards us",
+		},
+		cli.Uint64Flag{
+			Name: "csv_delay",
+			Usage: "(optional) the new number of blocks that the " +
+				"remote peer has to wait before sweeping its " +
+				"funds after a force close",
+		},
+		cli.StringFlag{
+			Name: "channel_type",
+			Usage: fmt.Sprintf("(optional) the type to upgrade "+
+				"the channel to (%q)", channelTypeSimpleTaproot),
+		},
+		cli.Uint64Flag{
+			Name: "kickoff_sat_per_vbyte",
+			Usage: "(optional) the fee rate in sat/vbyte of the " +
+				"kickoff transaction of a commitment type " +
+				"upgrade",
+		},
+	},
+	Action: actionDecorator(updateChannelParams),
+}
+
+func updateChannelParams(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var chanPointStr string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+	case ctx.Args().Present():
+		chanPointStr = ctx.Args().First()
+	default:
+		return fmt.Errorf("chan_point argument missing")
+	}
+
+	chanPoint, err := parseChanPoint(chanPointStr)
+	if err != nil {
+		return fmt.Errorf("unable to parse chan_point: %w", err)
+	}
+
+	req := &lnrpc.UpdateChannelParamsRequest{
+		ChanPoint:            chanPoint,
+		DustLimitSat:         ctx.Uint64("dust_limit_sat"),
+		MaxValueInFlightMsat: ctx.Uint64("max_value_in_flight_msat"),
+		ChanReserveSat:       ctx.Uint64("chan_reserve_sat"),
+		MaxAcceptedHtlcs:     uint32(ctx.Uint64("max_accepted_htlcs")),
+		CsvDelay:             uint32(ctx.Uint64("csv_delay")),
+		KickoffSatPerVbyte:   ctx.Uint64("kickoff_sat_per_vbyte"),
+	}
+
+	channelType := ctx.String("channel_type")
+	switch channelType {
+	case "":
+		break
+	case channelTypeSimpleTaproot:
+		req.CommitmentType = lnrpc.CommitmentType_SIMPLE_TAPROOT
+	default:
+		return fmt.Errorf("unsupported channel type %v", channelType)
+	}
+
+	resp, err := client.UpdateChannelParams(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var fishCompletionCommand = cli.Command{
 	Name:   "fish-completion",
 	Hidden: true,
@@ -2745,12 +2877,42 @@ func verifyChanBackup(ctx *cli.Context) error {
 	return nil
 }
 
+var backupSinkStatusCommand = cli.Command{
+	Name:     "backupsinkstatus",
+	Category: "Channels",
+	Usage:    "Show the status of the channel backup sinks.",
+	Description: `
+    Returns the status of the additional destinations the static channel
+    backup is copied to whenever it is updated, as configured with the
+    backupsink.* options. For each destination, the time of the last
+    successful write and the error of the last failed write are shown.
+    `,
+	Action: actionDecorator(backupSinkStatus),
+}
+
+func backupSinkStatus(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BackupSinkStatus(
+		ctxc, &lnrpc.BackupSinkStatusRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var restoreChanBackupCommand = cli.Command{
 	Name:     "restorechanbackup",
 	Category: "Channels",
 	Usage: "Restore an existing single or multi-channel static channel " +
 		"backup.",
-	ArgsUsage: "[--single_backup] [--multi_backup] [--multi_file=",
+	ArgsUsage: "[--single_backup] [--multi_backup] [--multi_file=] " +
+		"[--at=] [--peer=]",
 	Description: `
 	Allows a user to restore a Static Channel Backup (SCB) that was
 	obtained either via the exportchanbackup command, or from lnd's
@@ -2775,6 +2937,18 @@ var restoreChanBackupCommand = cli.Command{
 	   * A file path which points to a packed multi-channel backup within a
 	     file, using the same format that lnd does in its channel.backup
 	     file.
+
+	Alternatively, a previous generation of the channel.backup file can be
+	restored from lnd's backup archive with the --at flag. The newest
+	generation that was archived at or before the given time is restored.
+	The time is either a unix timestamp, or a time relative to now, such as
+	-2d for two days ago.
+
+	If peer storage is enabled, the latest backup that one of our former
+	channel peers stores for us can be restored with the --peer flag
+	instead. lnd connects to the peer and restores the backup once the peer
+	hands it back. This only requires the node's seed and the address of
+	the peer.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -2799,6 +2973,21 @@ var restoreChanBackupCommand = cli.Command{
 			Usage:     "the path to a multi-channel back up file",
 			TakesFile: true,
 		},
+
+		cli.StringFlag{
+			Name: "at",
+			Usage: "restore the newest archived generation of the " +
+				"channel backup file that was created at or " +
+				"before this time, either a unix timestamp or " +
+				"a relative time such as -2d",
+		},
+
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "restore the backup that the peer with this " +
+				"address stores for us, in the format " +
+				"pubkey@host:port",
+		},
 	},
 	Action: actionDecorator(restoreChanBackup),
 }
@@ -2875,6 +3064,26 @@ func parseChanBackups(ctx *cli.Context) (*lnrpc.RestoreChanBackupRequest, error)
 			},
 		}, nil
 
+	case ctx.IsSet("at"):
+		archiveTime, err := parseTime(ctx.String("at"), time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse archive time: "+
+				"%v", err)
+		}
+
+		return &lnrpc.RestoreChanBackupRequest{
+			Backup: &lnrpc.RestoreChanBackupRequest_ArchiveTime{
+				ArchiveTime: int64(archiveTime),
+			},
+		}, nil
+
+	case ctx.IsSet("peer"):
+		return &lnrpc.RestoreChanBackupRequest{
+			Backup: &lnrpc.RestoreChanBackupRequest_PeerAddress{
+				PeerAddress: ctx.String("peer"),
+			},
+		}, nil
+
 	default:
 		return nil, errMissingChanBackup
 	}
@@ -467,8 +467,11 @@ func main() {
 		pendingChannelsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
+		payOfferCommand,
+		rebalanceCommand,
 		sendToRouteCommand,
 		addInvoiceCommand,
+		addOfferCommand,
 		lookupInvoiceCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
@@ -488,9 +491,11 @@ func main() {
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		updateChannelParamsCommand,
 		forwardingHistoryCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
+		backupSinkStatusCommand,
 		restoreChanBackupCommand,
 		bakeMacaroonCommand,
 		listMacaroonIDsCommand,
@@ -505,6 +510,8 @@ func main() {
 		deletePaymentsCommand,
 		sendCustomCommand,
 		subscribeCustomCommand,
+		sendOnionMessageCommand,
+		subscribeOnionMessagesCommand,
 		fishCompletionCommand,
 		listAliasesCommand,
 		estimateRouteFeeCommand,
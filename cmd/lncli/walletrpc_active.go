@@ -26,6 +26,8 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -96,6 +98,7 @@ func walletCommands() []cli.Command {
 				accountsCommand,
 				requiredReserveCommand,
 				addressesCommand,
+				spliceChannelCommand,
 			},
 		},
 	}
@@ -356,7 +359,7 @@ func bumpFee(ctx *cli.Context) error {
 
 var bumpCloseFeeCommand = cli.Command{
 	Name:      "bumpclosefee",
-	Usage:     "Bumps the fee of a channel force closing transaction.",
+	Usage:     "Bumps the fee of a channel closing transaction.",
 	ArgsUsage: "channel_point",
 	Hidden:    true,
 	Description: `
@@ -367,6 +370,13 @@ var bumpCloseFeeCommand = cli.Command{
 	fee rate or confirmation target. The specified fee rate will be the
 	effective fee rate taking the parent fee into account.
 	NOTE: This cmd is DEPRECATED please use bumpforceclosefee instead.
+
+	If the channel is being closed cooperatively and the node uses the RBF
+	co-op close flow for it, then the closing transaction is replaced by a
+	new one paying the requested fee rate or confirmation target instead,
+	just like closechannel --bump. The budget and immediate flags have no
+	effect in that case. Otherwise our wallet output of the closing
+	transaction is spent with CPFP.
 	`,
 	Flags: []cli.Flag{
 		cli.Uint64Flag{
@@ -412,7 +422,7 @@ var bumpCloseFeeCommand = cli.Command{
 	will be retained.`,
 		},
 	},
-	Action: actionDecorator(bumpForceCloseFee),
+	Action: actionDecorator(bumpCloseFee),
 }
 
 var bumpForceCloseFeeCommand = cli.Command{
@@ -474,6 +484,122 @@ var bumpForceCloseFeeCommand = cli.Command{
 	Action: actionDecorator(bumpForceCloseFee),
 }
 
+func bumpCloseFee(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "bumpclosefee")
+	}
+
+	// Validate the channel point.
+	channelPoint := ctx.Args().Get(0)
+	protoChanPoint, err := NewProtoOutPoint(channelPoint)
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	channel, err := getWaitingCloseChannel(ctxc, client, channelPoint)
+	if err != nil {
+		return err
+	}
+
+	// A force close is fee bumped by sweeping its anchors, so we only
+	// need to take a closer look at co-op closes, whose closing
+	// transaction isn't one of the commitment transactions.
+	commitments := channel.Commitments
+	switch channel.ClosingTxid {
+	case "", commitments.LocalTxid, commitments.RemoteTxid,
+		commitments.RemotePendingTxid:
+
+		return bumpForceCloseFee(ctx)
+	}
+
+	feeRateFlag, err := checkNotBothSet(
+		ctx, "sat_per_vbyte", "sat_per_byte",
+	)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.CloseChannelRequest{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+				FundingTxidStr: protoChanPoint.TxidStr,
+			},
+			OutputIndex: protoChanPoint.OutputIndex,
+		},
+		TargetConf:  int32(ctx.Uint64("conf_target")),
+		SatPerVbyte: ctx.Uint64(feeRateFlag),
+		Bump:        true,
+	}
+
+	// Only the node knows whether the co-op close uses the RBF flow, so
+	// we let it decide and fall back to CPFP if it doesn't.
+	txidChan := make(chan string, 1)
+	err = executeChannelClose(ctxc, client, req, txidChan, false)
+	if status.Code(err) == codes.FailedPrecondition {
+		return bumpCoopCloseFee(ctx, channel.ClosingTxid)
+	}
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		ClosingTxid string `json:"closing_txid"`
+	}{
+		ClosingTxid: <-txidChan,
+	})
+
+	return nil
+}
+
+// bumpCoopCloseFee fee bumps a co-op closing transaction that can't be
+// replaced by spending our own output of it with CPFP.
+func bumpCoopCloseFee(ctx *cli.Context, closingTxid string) error {
+	ctxc := getContext()
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := walletClient.ListUnspent(
+		ctxc, &walletrpc.ListUnspentRequest{UnconfirmedOnly: true},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, utxo := range resp.Utxos {
+		if utxo.Outpoint.TxidStr != closingTxid {
+			continue
+		}
+
+		resp, err := walletClient.BumpFee(
+			ctxc, &walletrpc.BumpFeeRequest{
+				Outpoint:    utxo.Outpoint,
+				TargetConf:  uint32(ctx.Uint64("conf_target")),
+				Budget:      ctx.Uint64("budget"),
+				Immediate:   ctx.Bool("immediate"),
+				SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+			})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Bumping fee of %v:%v: %v\n", closingTxid,
+			utxo.Outpoint.OutputIndex, resp.GetStatus())
+
+		return nil
+	}
+
+	return fmt.Errorf("closing transaction %v has no unconfirmed wallet "+
+		"output to fee bump", closingTxid)
+}
+
 func bumpForceCloseFee(ctx *cli.Context) error {
 	ctxc := getContext()
 
@@ -562,16 +688,28 @@ func getWaitingCloseCommitments(ctxc context.Context,
 	client lnrpc.LightningClient, channelPoint string) (
 	*lnrpc.PendingChannelsResponse_Commitments, error) {
 
+	// Lookup the channel commit tx hashes.
+	channel, err := getWaitingCloseChannel(ctxc, client, channelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return channel.Commitments, nil
+}
+
+func getWaitingCloseChannel(ctxc context.Context,
+	client lnrpc.LightningClient, channelPoint string) (
+	*lnrpc.PendingChannelsResponse_WaitingCloseChannel, error) {
+
 	req := &lnrpc.PendingChannelsRequest{}
 	resp, err := client.PendingChannels(ctxc, req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Lookup the channel commit tx hashes.
 	for _, channel := range resp.WaitingCloseChannels {
 		if channel.Channel.ChannelPoint == channelPoint {
-			return channel.Commitments, nil
+			return channel, nil
 		}
 	}
 
@@ -2213,3 +2351,73 @@ func importPubKey(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var spliceChannelCommand = cli.Command{
+	Name:      "splicechannel",
+	Usage:     "Resize an active channel by splicing funds in or out.",
+	ArgsUsage: "--chan_point=<funding_txid:output_index> --amt=<sats>",
+	Description: `
+	Splice funds into or out of an active channel, while the channel stays
+	usable. A positive amount is spliced from the wallet into the channel,
+	a negative amount is spliced out of the channel into a new wallet
+	address. The fee rate of the splice transaction is set with either
+	--conf_target or --sat_per_vbyte.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel to splice, in the format " +
+				"funding_txid:output_index",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "the amount in satoshis to splice into the " +
+				"channel, a negative amount splices out of it",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the splice " +
+				"transaction should confirm in",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "a manual fee expressed in sat/vbyte that " +
+				"should be used for the splice transaction",
+		},
+	},
+	Action: actionDecorator(spliceChannel),
+}
+
+func spliceChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 0 || !ctx.IsSet("chan_point") || !ctx.IsSet("amt") {
+		return cli.ShowCommandHelp(ctx, "splicechannel")
+	}
+
+	chanPoint, err := parseChanPoint(ctx.String("chan_point"))
+	if err != nil {
+		return fmt.Errorf("unable to parse chan_point: %w", err)
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := walletClient.SpliceChannel(
+		ctxc, &walletrpc.SpliceChannelRequest{
+			ChannelPoint: chanPoint,
+			AmountSat:    ctx.Int64("amt"),
+			TargetConf:   uint32(ctx.Uint64("conf_target")),
+			SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -16,6 +16,8 @@ func watchtowerCommands() []cli.Command {
 			Category: "Watchtower",
 			Subcommands: []cli.Command{
 				towerInfoCommand,
+				towerRewardsCommand,
+				towerSessionsCommand,
 			},
 		},
 	}
@@ -54,3 +56,56 @@ func towerInfo(ctx *cli.Context) error {
 
 	return nil
 }
+
+var towerRewardsCommand = cli.Command{
+	Name:   "rewards",
+	Usage:  "Lists the rewards earned by the active watchtower.",
+	Action: actionDecorator(towerRewards),
+}
+
+func towerRewards(ctx *cli.Context) error {
+	ctxc := getContext()
+	if ctx.NArg() != 0 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "rewards")
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.ListRewardsRequest{}
+	resp, err := client.ListRewards(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var towerSessionsCommand = cli.Command{
+	Name: "sessions",
+	Usage: "Lists the sessions stored by the active watchtower along " +
+		"with their storage usage.",
+	Action: actionDecorator(towerSessions),
+}
+
+func towerSessions(ctx *cli.Context) error {
+	ctxc := getContext()
+	if ctx.NArg() != 0 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "sessions")
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.ListSessionsRequest{}
+	resp, err := client.ListSessions(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
@@ -41,9 +43,11 @@ import (
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/peerstorage"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/webhook"
 )
 
 const (
@@ -361,6 +365,7 @@ type Config struct {
 	UnsafeReplay       bool   `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
 	MaxPendingChannels int    `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
 	BackupFilePath     string `long:"backupfilepath" description:"The target location of the channel backup file"`
+	BackupArchiveSize  uint32 `long:"backuparchivesize" description:"The number of previous generations of the channel backup file that are kept in an archive next to it, so an earlier generation can be restored if the backup file is damaged. A new generation is archived whenever the set of channels changes. Set to 0 to disable the archive."`
 
 	FeeURL string `long:"feeurl" description:"DEPRECATED: Use 'fee.url' option. Optional URL for external fee estimation. If no URL is specified, the method for fee estimation will depend on the chosen backend and network. Must be set for neutrino on mainnet." hidden:"true"`
 
@@ -462,6 +467,18 @@ type Config struct {
 
 	Gossip *lncfg.Gossip `group:"gossip" namespace:"gossip"`
 
+	BackupSinks *lncfg.BackupSinks `group:"backupsink" namespace:"backupsink"`
+
+	PeerStorage *lncfg.PeerStorage `group:"peerstorage" namespace:"peerstorage"`
+
+	Trampoline *lncfg.Trampoline `group:"trampoline" namespace:"trampoline"`
+
+	Endorsement *lncfg.Endorsement `group:"endorsement" namespace:"endorsement"`
+
+	Webhook *lncfg.Webhook `group:"webhook" namespace:"webhook"`
+
+	FeeAutopilot *lncfg.FeeAutopilot `group:"feeautopilot" namespace:"feeautopilot"`
+
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
@@ -592,6 +609,7 @@ func DefaultConfig() Config {
 		},
 		BlockCacheSize:     defaultBlockCacheSize,
 		MaxPendingChannels: lncfg.DefaultMaxPendingChannels,
+		BackupArchiveSize:  chanbackup.DefaultArchiveSize,
 		NoSeedBackup:       defaultNoSeedBackup,
 		MinBackoff:         defaultMinBackoff,
 		MaxBackoff:         defaultMaxBackoff,
@@ -690,6 +708,54 @@ func DefaultConfig() Config {
 				Backoff:  defaultLeaderCheckBackoff,
 			},
 		},
+		BackupSinks: &lncfg.BackupSinks{
+			ExecTimeout:   chanbackup.DefaultExecSinkTimeout,
+			RetryDelay:    chanbackup.DefaultSinkRetryDelay,
+			MaxRetryDelay: chanbackup.DefaultSinkMaxRetryDelay,
+			S3: &lncfg.S3BackupSink{
+				Region:  chanbackup.DefaultS3Region,
+				Timeout: chanbackup.DefaultS3SinkTimeout,
+			},
+		},
+		PeerStorage: &lncfg.PeerStorage{
+			MaxPeers:    peerstorage.DefaultMaxPeers,
+			MaxBlobSize: lnwire.MaxPeerStorageBlobSize,
+		},
+		Trampoline: &lncfg.Trampoline{
+			FeeBaseMsat: uint64(htlcswitch.DefaultTrampolineFeeBase),
+			FeeRatePPM:  htlcswitch.DefaultTrampolineFeeRate,
+			CltvDelta:   htlcswitch.DefaultTrampolineCltvDelta,
+			MppTimeout:  htlcswitch.DefaultTrampolineMppTimeout,
+		},
+		Endorsement: &lncfg.Endorsement{
+			GeneralSlotFraction: htlcswitch.
+				DefaultGeneralSlotFraction,
+			GeneralLiquidityFraction: htlcswitch.
+				DefaultGeneralLiquidityFraction,
+			ResolutionPeriod:   htlcswitch.DefaultResolutionPeriod,
+			ReputationHalfLife: htlcswitch.DefaultReputationHalfLife,
+			RevenueHalfLife:    htlcswitch.DefaultRevenueHalfLife,
+		},
+		Webhook: &lncfg.Webhook{
+			Timeout:       webhook.DefaultTimeout,
+			RetryDelay:    webhook.DefaultRetryDelay,
+			MaxRetryDelay: webhook.DefaultMaxRetryDelay,
+			MaxAttempts:   webhook.DefaultMaxAttempts,
+		},
+		FeeAutopilot: &lncfg.FeeAutopilot{
+			Strategy:           lncfg.FeeAutopilotProportional,
+			Interval:           feeautopilot.DefaultInterval,
+			MinFeeRate:         feeautopilot.DefaultMinFeeRate,
+			MaxFeeRate:         feeautopilot.DefaultMaxFeeRate,
+			ForwardingWindow:   feeautopilot.DefaultForwardingWindow,
+			TargetForwardRatio: feeautopilot.DefaultTargetForwardRatio,
+			FeeRateStep:        feeautopilot.DefaultFeeRateStep,
+			MaxInboundDiscount: feeautopilot.DefaultMaxInboundDiscount,
+			InboundDiscountThreshold: feeautopilot.
+				DefaultInboundDiscountThreshold,
+			MinUpdateInterval: feeautopilot.DefaultMinUpdateInterval,
+			MinFeeRateDelta:   feeautopilot.DefaultMinFeeRateDelta,
+		},
 		Gossip: &lncfg.Gossip{
 			MaxChannelUpdateBurst: discovery.DefaultMaxChannelUpdateBurst,
 			ChannelUpdateInterval: discovery.DefaultChannelUpdateInterval,
@@ -978,6 +1044,12 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 	cfg.Tor.WatchtowerKeyPath = CleanAndExpandPath(cfg.Tor.WatchtowerKeyPath)
 	cfg.Watchtower.TowerDir = CleanAndExpandPath(cfg.Watchtower.TowerDir)
 	cfg.BackupFilePath = CleanAndExpandPath(cfg.BackupFilePath)
+	for i, file := range cfg.BackupSinks.Files {
+		cfg.BackupSinks.Files[i] = CleanAndExpandPath(file)
+	}
+	for i, dir := range cfg.BackupSinks.Dirs {
+		cfg.BackupSinks.Dirs[i] = CleanAndExpandPath(dir)
+	}
 	cfg.WalletUnlockPasswordFile = CleanAndExpandPath(
 		cfg.WalletUnlockPasswordFile,
 	)
@@ -1686,6 +1758,15 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 	// that we want to handle as custom messages, set them now.
 	customMsg := cfg.ProtocolOptions.CustomMessageOverrides()
 
+	// Onion messages can either be handled by lnd itself or by an external
+	// application through the custom message APIs, but not both.
+	if cfg.ProtocolOptions.OnionMessages() &&
+		slices.Contains(customMsg, uint16(lnwire.MsgOnionMessage)) {
+
+		return nil, mkErr("onion-messages can't be set together with " +
+			"a custom-message override for onion messages")
+	}
+
 	// We can safely set our custom override values during startup because
 	// startup is blocked on config parsing.
 	if err := lnwire.SetCustomOverrides(customMsg); err != nil {
@@ -1706,6 +1787,12 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		cfg.Htlcswitch,
 		cfg.Invoices,
 		cfg.Routing,
+		cfg.BackupSinks,
+		cfg.PeerStorage,
+		cfg.Trampoline,
+		cfg.Endorsement,
+		cfg.Webhook,
+		cfg.FeeAutopilot,
 	)
 	if err != nil {
 		return nil, err
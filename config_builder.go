@@ -848,6 +848,12 @@ type DatabaseInstances struct {
 	// complete!
 	GraphDB *channeldb.DB
 
+	// GraphStore is the channel graph used for path finding and gossip.
+	// This is the graph of the GraphDB above, unless the use-native-sql
+	// flag is set, in which case the graph is stored in the native SQL
+	// database.
+	GraphStore channeldb.GraphStore
+
 	// ChanStateDB is the database that stores all of our node's channel
 	// state.
 	//
@@ -862,6 +868,10 @@ type DatabaseInstances struct {
 	// InvoiceDB is the database that stores information about invoices.
 	InvoiceDB invoices.InvoiceDB
 
+	// PaymentDB is the database that stores information about outgoing
+	// payments.
+	PaymentDB channeldb.PaymentStore
+
 	// MacaroonDB is the database that stores macaroon root keys.
 	MacaroonDB kvdb.Backend
 
@@ -973,7 +983,6 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 			cfg.DB.BatchCommitInterval,
 		),
 		channeldb.OptionDryRunMigration(cfg.DryRunMigration),
-		channeldb.OptionSetUseGraphCache(!cfg.DB.NoGraphCache),
 		channeldb.OptionKeepFailedPaymentAttempts(
 			cfg.KeepFailedPaymentAttempts,
 		),
@@ -984,12 +993,24 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 		channeldb.OptionNoRevLogAmtData(cfg.DB.NoRevLogAmtData),
 	}
 
+	// If the graph is stored in the native SQL database, the KV graph is
+	// only read once to migrate it, so there's no need to load it into
+	// the graph cache.
+	useGraphCache := !cfg.DB.NoGraphCache
+	dbOptions = append(
+		dbOptions, channeldb.OptionSetUseGraphCache(
+			useGraphCache && !cfg.DB.UseNativeSQL,
+		),
+	)
+
 	// We want to pre-allocate the channel graph cache according to what we
 	// expect for mainnet to speed up memory allocation.
+	var preAllocCacheNumNodes int
 	if cfg.ActiveNetParams.Name == chaincfg.MainNetParams.Name {
+		preAllocCacheNumNodes = channeldb.DefaultPreAllocCacheNumNodes
 		dbOptions = append(
 			dbOptions, channeldb.OptionSetPreAllocCacheNumNodes(
-				channeldb.DefaultPreAllocCacheNumNodes,
+				preAllocCacheNumNodes,
 			),
 		)
 	}
@@ -1030,11 +1051,22 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 
 	// Instantiate a native SQL invoice store if the flag is set.
 	if d.cfg.DB.UseNativeSQL {
+		executor := sqldb.NewTransactionExecutor(
+			dbs.NativeSQLStore,
+			func(tx *sql.Tx) invoices.SQLInvoiceQueries {
+				return dbs.NativeSQLStore.WithTx(tx)
+			},
+		)
+
+		sqlInvoiceDB := invoices.NewSQLStore(
+			executor, clock.NewDefaultClock(),
+		)
+
 		// KV invoice db resides in the same database as the graph and
 		// channel state DB. Let's query the database to see if we have
-		// any invoices there. If we do, we won't allow the user to
-		// start lnd with native SQL enabled, as we don't currently
-		// migrate the invoices to the new database schema.
+		// any invoices there. If we do, we'll migrate them to the new
+		// database schema before starting up. The migration is a noop
+		// if it already completed on a previous startup.
 		invoiceSlice, err := dbs.GraphDB.QueryInvoices(
 			ctx, invoices.InvoiceQuery{
 				NumMaxInvoices: 1,
@@ -1049,27 +1081,84 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 		}
 
 		if len(invoiceSlice.Invoices) > 0 {
+			err := invoices.MigrateInvoicesToSQL(
+				ctx, dbs.GraphDB, sqlInvoiceDB,
+				invoices.DefaultMigrationBatchSize,
+			)
+			if err != nil {
+				cleanUp()
+				d.logger.Errorf("Unable to migrate KV invoices "+
+					"to native SQL: %v", err)
+
+				return nil, nil, err
+			}
+		}
+
+		dbs.InvoiceDB = sqlInvoiceDB
+
+		// The KV graph is migrated to the native SQL graph store
+		// before it's used. The migration is a noop if it already
+		// completed on a previous startup.
+		graphExecutor := sqldb.NewTransactionExecutor(
+			dbs.NativeSQLStore,
+			func(tx *sql.Tx) channeldb.SQLGraphQueries {
+				return dbs.NativeSQLStore.WithTx(tx)
+			},
+		)
+
+		err = channeldb.MigrateGraphToSQL(
+			ctx, dbs.GraphDB.ChannelGraph(), graphExecutor,
+		)
+		if err != nil {
 			cleanUp()
-			err := fmt.Errorf("found invoices in the KV invoice " +
-				"DB, migration to native SQL is not yet " +
-				"supported")
-			d.logger.Error(err)
+			d.logger.Errorf("Unable to migrate KV graph to native "+
+				"SQL: %v", err)
 
 			return nil, nil, err
 		}
 
-		executor := sqldb.NewTransactionExecutor(
+		dbs.GraphStore, err = channeldb.NewSQLGraphStore(
+			graphExecutor, preAllocCacheNumNodes, useGraphCache,
+		)
+		if err != nil {
+			cleanUp()
+			d.logger.Errorf("Unable to create SQL graph store: %v",
+				err)
+
+			return nil, nil, err
+		}
+
+		// The KV payments are migrated to the native SQL payment
+		// store before it's used. The migration resumes where it left
+		// off if it was interrupted, and is a noop if it already
+		// completed on a previous startup.
+		paymentExecutor := sqldb.NewTransactionExecutor(
 			dbs.NativeSQLStore,
-			func(tx *sql.Tx) invoices.SQLInvoiceQueries {
+			func(tx *sql.Tx) channeldb.SQLPaymentQueries {
 				return dbs.NativeSQLStore.WithTx(tx)
 			},
 		)
 
-		dbs.InvoiceDB = invoices.NewSQLStore(
-			executor, clock.NewDefaultClock(),
+		err = channeldb.MigratePaymentsToSQL(
+			ctx, channeldb.NewPaymentControl(dbs.ChanStateDB),
+			paymentExecutor,
+			channeldb.DefaultPaymentMigrationBatchSize,
+		)
+		if err != nil {
+			cleanUp()
+			d.logger.Errorf("Unable to migrate KV payments to "+
+				"native SQL: %v", err)
+
+			return nil, nil, err
+		}
+
+		dbs.PaymentDB = channeldb.NewSQLPaymentStore(
+			paymentExecutor, cfg.KeepFailedPaymentAttempts,
 		)
 	} else {
 		dbs.InvoiceDB = dbs.GraphDB
+		dbs.PaymentDB = channeldb.NewPaymentControl(dbs.ChanStateDB)
+		dbs.GraphStore = dbs.GraphDB.ChannelGraph()
 	}
 
 	// Wrap the watchtower client DB and make sure we clean up.
@@ -173,6 +173,11 @@ type ChainArbitratorConfig struct {
 	// resolved (which includes sweeping any time locked funds).
 	NotifyFullyResolvedChannel func(point wire.OutPoint)
 
+	// NotifySplicedChannel is a function closure that the ChainArbitrator
+	// will use to notify the ChannelNotifier about a channel that was
+	// moved from the first outpoint onto the second one by a splice.
+	NotifySplicedChannel func(prevChanPoint, chanPoint wire.OutPoint)
+
 	// OnionProcessor is used to decode onion payloads for on-chain
 	// resolution.
 	OnionProcessor OnionProcessor
@@ -516,6 +521,88 @@ func (c *ChainArbitrator) ResolveContract(chanPoint wire.OutPoint) error {
 	return nil
 }
 
+// handleSpliceConfirmed moves the channel with the given outpoint onto the new
+// funding output of its splice, once the splice transaction is buried deep
+// enough to not be reorged out. The channel wasn't closed, so unlike
+// ResolveContract, this doesn't mark it as closed. Instead, the arbitrator and
+// chain watcher of the old funding output are replaced with new ones.
+func (c *ChainArbitrator) handleSpliceConfirmed(chanPoint wire.OutPoint,
+	splice *channeldb.ChannelSplice) error {
+
+	log.Infof("Moving ChannelPoint(%v) to spliced ChannelPoint(%v)",
+		chanPoint, splice.FundingOutpoint)
+
+	// The link of the channel keeps updating the commitments of the old
+	// funding output, so it needs to be stopped before the channel state
+	// is moved onto the new one.
+	if err := c.cfg.MarkLinkInactive(chanPoint); err != nil {
+		log.Errorf("Unable to mark link inactive for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+	}
+
+	// We'll apply the splice to a fresh copy of the channel state, so the
+	// state of the old arbitrator and chain watcher isn't modified while
+	// they're shutting down.
+	chanState, err := c.chanSource.ChannelStateDB().FetchChannel(
+		nil, chanPoint,
+	)
+	if err != nil {
+		return err
+	}
+	if err := chanState.ApplySplice(splice.FundingOutpoint); err != nil {
+		return err
+	}
+
+	c.Lock()
+	chainArb := c.activeChannels[chanPoint]
+	delete(c.activeChannels, chanPoint)
+
+	chainWatcher := c.activeWatchers[chanPoint]
+	delete(c.activeWatchers, chanPoint)
+	c.Unlock()
+
+	if err := c.WatchNewChannel(chanState); err != nil {
+		return err
+	}
+
+	// The channel backup still refers to the old funding output, so it
+	// needs to be replaced.
+	if c.cfg.NotifySplicedChannel != nil {
+		c.cfg.NotifySplicedChannel(chanPoint, splice.FundingOutpoint)
+	}
+
+	// The old chain watcher is the caller of this method, so we'll stop it
+	// along with the old arbitrator in a goroutine once it returned.
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		if chainArb != nil {
+			if err := chainArb.Stop(); err != nil {
+				log.Warnf("unable to stop "+
+					"ChannelArbitrator(%v): %v", chanPoint,
+					err)
+			}
+
+			// The old arbitrator never left its default state, so
+			// its log can be wiped.
+			if err := chainArb.log.WipeHistory(); err != nil {
+				log.Warnf("unable to wipe log of "+
+					"ChannelArbitrator(%v): %v", chanPoint,
+					err)
+			}
+		}
+		if chainWatcher != nil {
+			if err := chainWatcher.Stop(); err != nil {
+				log.Warnf("unable to stop ChainWatcher(%v): %v",
+					chanPoint, err)
+			}
+		}
+	}()
+
+	return nil
+}
+
 // Start launches all goroutines that the ChainArbitrator needs to operate.
 func (c *ChainArbitrator) Start() error {
 	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
@@ -557,6 +644,13 @@ func (c *ChainArbitrator) Start() error {
 				isOurAddr:           c.cfg.IsOurAddress,
 				contractBreach:      breachClosure,
 				extractStateNumHint: lnwallet.GetStateNumHint,
+				spliceConfirmed: func(
+					s *channeldb.ChannelSplice) error {
+
+					return c.handleSpliceConfirmed(
+						chanPoint, s,
+					)
+				},
 			},
 		)
 		if err != nil {
@@ -1186,6 +1280,11 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 				)
 			},
 			extractStateNumHint: lnwallet.GetStateNumHint,
+			spliceConfirmed: func(
+				s *channeldb.ChannelSplice) error {
+
+				return c.handleSpliceConfirmed(chanPoint, s)
+			},
 		},
 	)
 	if err != nil {
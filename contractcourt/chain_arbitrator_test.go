@@ -229,3 +229,101 @@ func TestResolveContract(t *testing.T) {
 	err = chainArb.ResolveContract(channel.FundingOutpoint)
 	require.NoError(t, err, "second resolve call shouldn't fail")
 }
+
+// TestChainArbitratorSpliceConfirmed tests that moving a channel onto the new
+// funding output of its splice replaces its arbitrator and notifies about the
+// spliced channel, so its backup can be replaced.
+func TestChainArbitratorSpliceConfirmed(t *testing.T) {
+	t.Parallel()
+
+	db, err := channeldb.Open(t.TempDir())
+	require.NoError(t, err, "unable to open db")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	newChannel, _, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to make new test channel")
+	channel := newChannel.State()
+	channel.Db = db.ChannelStateDB()
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	require.NoError(t, channel.SyncPending(addr, 101))
+
+	// Persist a pending splice that spends the channel's funding output.
+	chanPoint := channel.FundingOutpoint
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&chanPoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(int64(channel.Capacity), nil))
+
+	splice := &channeldb.ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: 0,
+		},
+		FundingTx:        spliceTx,
+		Capacity:         channel.Capacity,
+		LocalCommitment:  channel.LocalCommitment,
+		RemoteCommitment: channel.RemoteCommitment,
+	}
+	require.NoError(t, channel.AddPendingSplice(splice))
+
+	type splicedChannel struct {
+		prevChanPoint wire.OutPoint
+		chanPoint     wire.OutPoint
+	}
+	splicedChans := make(chan splicedChannel, 1)
+
+	chainArbCfg := ChainArbitratorConfig{
+		ChainIO: &mock.ChainIO{},
+		Notifier: &mock.ChainNotifier{
+			SpendChan: make(chan *chainntnfs.SpendDetail),
+			EpochChan: make(chan *chainntnfs.BlockEpoch),
+			ConfChan:  make(chan *chainntnfs.TxConfirmation),
+		},
+		PublishTx: func(tx *wire.MsgTx, _ string) error {
+			return nil
+		},
+		MarkLinkInactive: func(wire.OutPoint) error {
+			return nil
+		},
+		NotifySplicedChannel: func(prevChanPoint,
+			chanPoint wire.OutPoint) {
+
+			splicedChans <- splicedChannel{
+				prevChanPoint: prevChanPoint,
+				chanPoint:     chanPoint,
+			}
+		},
+		Clock:  clock.NewDefaultClock(),
+		Budget: *DefaultBudgetConfig(),
+	}
+	chainArb := NewChainArbitrator(chainArbCfg, db)
+	require.NoError(t, chainArb.Start())
+	t.Cleanup(func() {
+		require.NoError(t, chainArb.Stop())
+	})
+
+	require.NoError(t, chainArb.handleSpliceConfirmed(chanPoint, splice))
+
+	select {
+	case spliced := <-splicedChans:
+		require.Equal(t, chanPoint, spliced.prevChanPoint)
+		require.Equal(t, splice.FundingOutpoint, spliced.chanPoint)
+
+	default:
+		t.Fatalf("spliced channel not notified")
+	}
+
+	// The channel is only watched on its new funding output.
+	chainArb.Lock()
+	defer chainArb.Unlock()
+
+	require.NotContains(t, chainArb.activeChannels, chanPoint)
+	require.Contains(t, chainArb.activeChannels, splice.FundingOutpoint)
+	require.Contains(t, chainArb.activeWatchers, splice.FundingOutpoint)
+}
@@ -2,6 +2,7 @@ package contractcourt
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
@@ -36,6 +37,10 @@ const (
 	maxCommitPointPollTimeout = 10 * time.Minute
 )
 
+// errChainWatcherShuttingDown is returned when the chain watcher is stopped
+// while it waits for a chain event.
+var errChainWatcherShuttingDown = errors.New("chain watcher shutting down")
+
 // LocalUnilateralCloseInfo encapsulates all the information we need to act on
 // a local force close that gets confirmed.
 type LocalUnilateralCloseInfo struct {
@@ -156,6 +161,12 @@ type ChainEventSubscription struct {
 	// material required to bring the cheating channel peer to justice.
 	ContractBreach chan *BreachCloseInfo
 
+	// SpliceConfirmation is a channel that will be sent upon once a splice
+	// transaction of the channel is buried deep enough to not be reorged
+	// out. By the time it's sent, the channel has been moved onto the new
+	// funding output, which is watched by a new chain watcher.
+	SpliceConfirmation chan *channeldb.ChannelSplice
+
 	// Cancel cancels the subscription to the event stream for a particular
 	// channel. This method should be called once the caller no longer needs to
 	// be notified of any on-chain events for a particular channel.
@@ -193,6 +204,13 @@ type chainWatcherConfig struct {
 	// obfuscater. This is used by the chain watcher to identify which
 	// state was broadcast and confirmed on-chain.
 	extractStateNumHint func(*wire.MsgTx, [lnwallet.StateHintSize]byte) uint64
+
+	// spliceConfirmed is called once a pending splice transaction of the
+	// channel is buried at the depth given by lnwallet.SpliceLockDepth.
+	// It must move the channel onto the new funding output before it
+	// returns. The chain watcher exits afterwards, as the channel wasn't
+	// closed.
+	spliceConfirmed func(*channeldb.ChannelSplice) error
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -375,6 +393,7 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *BreachCloseInfo, 1),
+		SpliceConfirmation:      make(chan *channeldb.ChannelSplice, 1),
 		Cancel: func() {
 			c.Lock()
 			delete(c.clientSubscriptions, clientID)
@@ -389,6 +408,111 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 	return sub
 }
 
+// pendingSpliceOf returns the pending splice of the channel whose splice
+// transaction is the passed spending transaction, or nil if it isn't one of
+// the channel's splices.
+func (c *chainWatcher) pendingSpliceOf(
+	spendingTx *wire.MsgTx) (*channeldb.ChannelSplice, error) {
+
+	if c.cfg.spliceConfirmed == nil {
+		return nil, nil
+	}
+
+	splices, err := c.cfg.chanState.PendingSplices()
+	if err != nil {
+		return nil, err
+	}
+
+	spendingTxid := spendingTx.TxHash()
+	for _, splice := range splices {
+		if splice.FundingOutpoint.Hash == spendingTxid {
+			return splice, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// waitForSpliceLock waits for the passed splice transaction, which spent the
+// funding output of the channel, to be buried deep enough to not be reorged
+// out. True is returned once it is. If the splice transaction is reorged out
+// before, false is returned, and the funding output needs to be watched
+// again, as any of its commitments may still confirm.
+func (c *chainWatcher) waitForSpliceLock(splice *channeldb.ChannelSplice,
+	spend *chainntnfs.SpendDetail,
+	spendNtfn *chainntnfs.SpendEvent) (bool, error) {
+
+	outpoint := splice.FundingOutpoint
+	if int(outpoint.Index) >= len(splice.FundingTx.TxOut) {
+		return false, fmt.Errorf("splice tx %v has no output %v",
+			outpoint.Hash, outpoint.Index)
+	}
+	pkScript := splice.FundingTx.TxOut[outpoint.Index].PkScript
+
+	numConfs := lnwallet.SpliceLockDepth(c.cfg.chanState)
+	confNtfn, err := c.cfg.notifier.RegisterConfirmationsNtfn(
+		&outpoint.Hash, pkScript, numConfs,
+		uint32(spend.SpendingHeight),
+	)
+	if err != nil {
+		return false, err
+	}
+	defer confNtfn.Cancel()
+
+	log.Infof("Splice tx %v of ChannelPoint(%v) confirmed, waiting for "+
+		"%v confirmations", outpoint.Hash,
+		c.cfg.chanState.FundingOutpoint, numConfs)
+
+	select {
+	case _, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return false, errChainWatcherShuttingDown
+		}
+
+		return true, nil
+
+	case <-spendNtfn.Reorg:
+	case <-confNtfn.NegativeConf:
+
+	case <-c.quit:
+		return false, errChainWatcherShuttingDown
+	}
+
+	log.Warnf("Splice tx %v of ChannelPoint(%v) was reorged out, "+
+		"watching the funding output again", outpoint.Hash,
+		c.cfg.chanState.FundingOutpoint)
+
+	return false, nil
+}
+
+// handleSpliceLocked moves the channel onto the new funding output of the
+// passed splice, whose splice transaction is buried deep enough, and notifies
+// our subscribers.
+func (c *chainWatcher) handleSpliceLocked(
+	splice *channeldb.ChannelSplice) error {
+
+	log.Infof("Splice of ChannelPoint(%v) locked, new ChannelPoint(%v)",
+		c.cfg.chanState.FundingOutpoint, splice.FundingOutpoint)
+
+	if err := c.cfg.spliceConfirmed(splice); err != nil {
+		return err
+	}
+
+	// As we're being stopped by the ChainArbitrator at the same time, we
+	// won't wait for our subscribers to pick up the event. Each of them
+	// receives it only once, so the send never blocks.
+	c.Lock()
+	for _, sub := range c.clientSubscriptions {
+		select {
+		case sub.SpliceConfirmation <- splice:
+		default:
+		}
+	}
+	c.Unlock()
+
+	return nil
+}
+
 // handleUnknownLocalState checks whether the passed spend _could_ be a local
 // state that for some reason is unknown to us. This could be a state published
 // by us before we lost state, which we will try to sweep. Or it could be one
@@ -440,10 +564,13 @@ func (c *chainWatcher) handleUnknownLocalState(
 	// Next, we'll derive our script that includes the revocation base for
 	// the remote party allowing them to claim this output before the CSV
 	// delay if we breach.
+	csvDelay := c.cfg.chanState.CommitCsvDelay(
+		lntypes.Local, broadcastStateNum,
+	)
 	localScript, err := lnwallet.CommitScriptToSelf(
 		c.cfg.chanState.ChanType, c.cfg.chanState.IsInitiator,
 		commitKeyRing.ToLocalKey, commitKeyRing.RevocationKey,
-		uint32(c.cfg.chanState.LocalChanCfg.CsvDelay), leaseExpiry,
+		uint32(csvDelay), leaseExpiry,
 	)
 	if err != nil {
 		return false, err
@@ -621,135 +748,184 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 		}
 	}
 
-	select {
+	// A splice transaction of the channel spends its funding output as
+	// well, but the channel is only moved onto the new funding output once
+	// the splice transaction is buried deep enough. If it's reorged out
+	// before, we'll wait for the next spend of the funding output.
+	var commitSpend *chainntnfs.SpendDetail
+	for commitSpend == nil {
+		select {
+		case spend, ok := <-spendNtfn.Spend:
+			// If the channel was closed, then this means that the
+			// notifier exited, so we will as well.
+			if !ok {
+				return
+			}
+
+			splice, err := c.pendingSpliceOf(spend.SpendingTx)
+			if err != nil {
+				log.Errorf("Unable to fetch pending splices: "+
+					"%v", err)
+				return
+			}
+
+			if splice == nil {
+				commitSpend = spend
+				break
+			}
+
+			locked, err := c.waitForSpliceLock(
+				splice, spend, spendNtfn,
+			)
+			switch {
+			case errors.Is(err, errChainWatcherShuttingDown):
+				return
+
+			case err != nil:
+				log.Errorf("Unable to wait for splice: %v", err)
+				return
+
+			case !locked:
+				continue
+			}
+
+			if err := c.handleSpliceLocked(splice); err != nil {
+				log.Errorf("Unable to handle splice: %v", err)
+			}
+
+			return
+
+		// The chainWatcher has been signalled to exit, so we'll do so
+		// now.
+		case <-c.quit:
+			return
+		}
+	}
+
 	// We've detected a spend of the channel onchain! Depending on the type
 	// of spend, we'll act accordingly, so we'll examine the spending
 	// transaction to determine what we should do.
 	//
 	// TODO(Roasbeef): need to be able to ensure this only triggers
 	// on confirmation, to ensure if multiple txns are broadcast, we
-	// act on the one that's timestamped
-	case commitSpend, ok := <-spendNtfn.Spend:
-		// If the channel was closed, then this means that the notifier
-		// exited, so we will as well.
-		if !ok {
-			return
-		}
-
-		// Otherwise, the remote party might have broadcast a prior
-		// revoked state...!!!
-		commitTxBroadcast := commitSpend.SpendingTx
-
-		// First, we'll construct the chainset which includes all the
-		// data we need to dispatch an event to our subscribers about
-		// this possible channel close event.
-		chainSet, err := newChainSet(c.cfg.chanState)
-		if err != nil {
-			log.Errorf("unable to create commit set: %v", err)
-			return
-		}
+	// act on the one that's timestamped.
+	//
+	// The remote party might have broadcast a prior revoked state...!!!
+	commitTxBroadcast := commitSpend.SpendingTx
 
-		// Decode the state hint encoded within the commitment
-		// transaction to determine if this is a revoked state or not.
-		obfuscator := c.stateHintObfuscator
-		broadcastStateNum := c.cfg.extractStateNumHint(
-			commitTxBroadcast, obfuscator,
-		)
+	// The channel config may have been changed by a dynamic
+	// commitment since we loaded the channel, so we'll refresh it
+	// before reconstructing any of its scripts.
+	if err := c.cfg.chanState.Refresh(); err != nil {
+		log.Warnf("ChannelPoint(%v): unable to refresh channel "+
+			"state: %v", c.cfg.chanState.FundingOutpoint,
+			err)
+	}
 
-		// We'll go on to check whether it could be our own commitment
-		// that was published and know is confirmed.
-		ok, err = c.handleKnownLocalState(
-			commitSpend, broadcastStateNum, chainSet,
-		)
-		if err != nil {
-			log.Errorf("Unable to handle known local state: %v",
-				err)
-			return
-		}
+	// First, we'll construct the chainset which includes all the
+	// data we need to dispatch an event to our subscribers about
+	// this possible channel close event.
+	chainSet, err := newChainSet(c.cfg.chanState)
+	if err != nil {
+		log.Errorf("unable to create commit set: %v", err)
+		return
+	}
 
-		if ok {
-			return
-		}
+	// Decode the state hint encoded within the commitment
+	// transaction to determine if this is a revoked state or not.
+	obfuscator := c.stateHintObfuscator
+	broadcastStateNum := c.cfg.extractStateNumHint(
+		commitTxBroadcast, obfuscator,
+	)
 
-		// Now that we know it is neither a non-cooperative closure nor
-		// a local close with the latest state, we check if it is the
-		// remote that closed with any prior or current state.
-		ok, err = c.handleKnownRemoteState(
-			commitSpend, broadcastStateNum, chainSet,
-		)
-		if err != nil {
-			log.Errorf("Unable to handle known remote state: %v",
-				err)
-			return
-		}
+	// We'll go on to check whether it could be our own commitment
+	// that was published and know is confirmed.
+	ok, err := c.handleKnownLocalState(
+		commitSpend, broadcastStateNum, chainSet,
+	)
+	if err != nil {
+		log.Errorf("Unable to handle known local state: %v",
+			err)
+		return
+	}
 
-		if ok {
-			return
-		}
+	if ok {
+		return
+	}
 
-		// Next, we'll check to see if this is a cooperative channel
-		// closure or not. This is characterized by having an input
-		// sequence number that's finalized. This won't happen with
-		// regular commitment transactions due to the state hint
-		// encoding scheme.
-		switch commitTxBroadcast.TxIn[0].Sequence {
-		case wire.MaxTxInSequenceNum:
-			fallthrough
-		case mempool.MaxRBFSequence:
-			// TODO(roasbeef): rare but possible, need itest case
-			// for
-			err := c.dispatchCooperativeClose(commitSpend)
-			if err != nil {
-				log.Errorf("unable to handle co op close: %v", err)
-			}
-			return
-		}
+	// Now that we know it is neither a non-cooperative closure nor
+	// a local close with the latest state, we check if it is the
+	// remote that closed with any prior or current state.
+	ok, err = c.handleKnownRemoteState(
+		commitSpend, broadcastStateNum, chainSet,
+	)
+	if err != nil {
+		log.Errorf("Unable to handle known remote state: %v",
+			err)
+		return
+	}
 
-		log.Warnf("Unknown commitment broadcast for "+
-			"ChannelPoint(%v) ", c.cfg.chanState.FundingOutpoint)
+	if ok {
+		return
+	}
 
-		// We'll try to recover as best as possible from losing state.
-		// We first check if this was a local unknown state. This could
-		// happen if we force close, then lose state or attempt
-		// recovery before the commitment confirms.
-		ok, err = c.handleUnknownLocalState(
-			commitSpend, broadcastStateNum, chainSet,
-		)
+	// Next, we'll check to see if this is a cooperative channel
+	// closure or not. This is characterized by having an input
+	// sequence number that's finalized. This won't happen with
+	// regular commitment transactions due to the state hint
+	// encoding scheme.
+	switch commitTxBroadcast.TxIn[0].Sequence {
+	case wire.MaxTxInSequenceNum:
+		fallthrough
+	case mempool.MaxRBFSequence:
+		// TODO(roasbeef): rare but possible, need itest case
+		// for
+		err := c.dispatchCooperativeClose(commitSpend)
 		if err != nil {
-			log.Errorf("Unable to handle known local state: %v",
-				err)
-			return
+			log.Errorf("unable to handle co op close: %v", err)
 		}
+		return
+	}
 
-		if ok {
-			return
-		}
+	log.Warnf("Unknown commitment broadcast for "+
+		"ChannelPoint(%v) ", c.cfg.chanState.FundingOutpoint)
 
-		// Since it was neither a known remote state, nor a local state
-		// that was published, it most likely mean we lost state and
-		// the remote node closed. In this case we must start the DLP
-		// protocol in hope of getting our money back.
-		ok, err = c.handleUnknownRemoteState(
-			commitSpend, broadcastStateNum, chainSet,
-		)
-		if err != nil {
-			log.Errorf("Unable to handle unknown remote state: %v",
-				err)
-			return
-		}
+	// We'll try to recover as best as possible from losing state.
+	// We first check if this was a local unknown state. This could
+	// happen if we force close, then lose state or attempt
+	// recovery before the commitment confirms.
+	ok, err = c.handleUnknownLocalState(
+		commitSpend, broadcastStateNum, chainSet,
+	)
+	if err != nil {
+		log.Errorf("Unable to handle known local state: %v",
+			err)
+		return
+	}
 
-		if ok {
-			return
-		}
+	if ok {
+		return
+	}
 
-		log.Warnf("Unable to handle spending tx %v of channel point %v",
-			commitTxBroadcast.TxHash(), c.cfg.chanState.FundingOutpoint)
+	// Since it was neither a known remote state, nor a local state
+	// that was published, it most likely mean we lost state and
+	// the remote node closed. In this case we must start the DLP
+	// protocol in hope of getting our money back.
+	ok, err = c.handleUnknownRemoteState(
+		commitSpend, broadcastStateNum, chainSet,
+	)
+	if err != nil {
+		log.Errorf("Unable to handle unknown remote state: %v",
+			err)
 		return
+	}
 
-	// The chainWatcher has been signalled to exit, so we'll do so now.
-	case <-c.quit:
+	if ok {
 		return
 	}
+
+	log.Warnf("Unable to handle spending tx %v of channel point %v",
+		commitTxBroadcast.TxHash(), c.cfg.chanState.FundingOutpoint)
 }
 
 // handleKnownLocalState checks whether the passed spend is a local state that
@@ -78,6 +78,193 @@ func TestChainWatcherRemoteUnilateralClose(t *testing.T) {
 	}
 }
 
+// TestChainWatcherSpliceConfirmed tests that the chain watcher doesn't treat
+// the confirmation of a pending splice of the channel as a close, but hands it
+// to its config and notifies its subscribers once it's buried deep enough.
+func TestChainWatcherSpliceConfirmed(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	// Persist a pending splice that spends the channel's funding output.
+	chanState := aliceChannel.State()
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&chanState.FundingOutpoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(int64(chanState.Capacity), nil))
+
+	splice := &channeldb.ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: 0,
+		},
+		FundingTx:        spliceTx,
+		Capacity:         chanState.Capacity,
+		LocalCommitment:  chanState.LocalCommitment,
+		RemoteCommitment: chanState.RemoteCommitment,
+	}
+	require.NoError(t, chanState.AddPendingSplice(splice))
+
+	confirmedSplices := make(chan *channeldb.ChannelSplice, 1)
+	aliceNotifier := &mock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail),
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+		ConfChan:  make(chan *chainntnfs.TxConfirmation),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           chanState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		spliceConfirmed: func(s *channeldb.ChannelSplice) error {
+			confirmedSplices <- s
+			return nil
+		},
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	spliceTxHash := spliceTx.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &spliceTxHash,
+		SpendingTx:    spliceTx,
+	}
+
+	// The splice must not be handled before it's buried deep enough.
+	select {
+	case <-confirmedSplices:
+		t.Fatalf("splice handled before it was buried")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	aliceNotifier.ConfChan <- &chainntnfs.TxConfirmation{
+		Tx: spliceTx,
+	}
+
+	select {
+	case s := <-confirmedSplices:
+		require.Equal(t, splice.FundingOutpoint, s.FundingOutpoint)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("splice confirmation not handled")
+	}
+
+	select {
+	case s := <-chanEvents.SpliceConfirmation:
+		require.Equal(t, splice.FundingOutpoint, s.FundingOutpoint)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("didn't receive splice confirmation event")
+	}
+
+	// The splice must not have been mistaken for a cooperative close.
+	select {
+	case <-chanEvents.CooperativeClosure:
+		t.Fatalf("splice dispatched as cooperative close")
+	default:
+	}
+}
+
+// TestChainWatcherSpliceReorg tests that the chain watcher keeps watching the
+// funding output of the channel if a splice transaction is reorged out before
+// it's buried, so a revoked commitment confirming instead is still caught.
+func TestChainWatcherSpliceReorg(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	// Bob's current commitment will be revoked by the state transition
+	// below, so broadcasting it later on is a breach.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+
+	htlcAmount := lnwire.NewMSatFromSatoshis(20000)
+	addFakeHTLC(t, htlcAmount, 0, aliceChannel, bobChannel)
+	require.NoError(
+		t, lnwallet.ForceStateTransition(aliceChannel, bobChannel),
+	)
+
+	// Persist a pending splice that spends the channel's funding output.
+	chanState := aliceChannel.State()
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&chanState.FundingOutpoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(int64(chanState.Capacity), nil))
+
+	splice := &channeldb.ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: 0,
+		},
+		FundingTx:        spliceTx,
+		Capacity:         chanState.Capacity,
+		LocalCommitment:  chanState.LocalCommitment,
+		RemoteCommitment: chanState.RemoteCommitment,
+	}
+	require.NoError(t, chanState.AddPendingSplice(splice))
+
+	confirmedSplices := make(chan *channeldb.ChannelSplice, 1)
+	aliceNotifier := &mock.ChainNotifier{
+		SpendChan:      make(chan *chainntnfs.SpendDetail),
+		EpochChan:      make(chan *chainntnfs.BlockEpoch),
+		ConfChan:       make(chan *chainntnfs.TxConfirmation),
+		SpendReorgChan: make(chan struct{}),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           chanState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		contractBreach: func(*lnwallet.BreachRetribution) error {
+			return nil
+		},
+		spliceConfirmed: func(s *channeldb.ChannelSplice) error {
+			confirmedSplices <- s
+			return nil
+		},
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	spliceTxHash := spliceTx.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &spliceTxHash,
+		SpendingTx:    spliceTx,
+	}
+
+	// The splice transaction is reorged out before it's buried, which
+	// must not move the channel.
+	aliceNotifier.SpendReorgChan <- struct{}{}
+
+	// Bob's revoked commitment confirms instead, which must be caught as
+	// a breach of the channel.
+	bobTxHash := bobCommit.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+
+	select {
+	case breach := <-chanEvents.ContractBreach:
+		require.Equal(t, bobTxHash, breach.CommitHash)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("didn't receive contract breach event")
+	}
+
+	select {
+	case <-confirmedSplices:
+		t.Fatalf("reorged splice was handled")
+	default:
+	}
+}
+
 func addFakeHTLC(t *testing.T, htlcAmount lnwire.MilliSatoshi, id uint64,
 	aliceChannel, bobChannel *lnwallet.LightningChannel) {
 
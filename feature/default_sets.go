@@ -95,4 +95,36 @@ var defaultSetDesc = setDesc{
 	lnwire.Bolt11BlindedPathsOptional: {
 		SetInvoice: {}, // I
 	},
+	lnwire.RbfCoopCloseStagingOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.DynamicCommitmentsOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.QuiescenceOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.SpliceStagingOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.CoFundOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.OnionMessagesOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.ProvideStorageOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.TrampolineRoutingStagingOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
 }
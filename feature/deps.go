@@ -85,6 +85,12 @@ var deps = depDesc{
 	lnwire.Bolt11BlindedPathsOptional: {
 		lnwire.RouteBlindingOptional: {},
 	},
+	lnwire.SpliceStagingOptional: {
+		lnwire.QuiescenceOptional: {},
+	},
+	lnwire.TrampolineRoutingStagingOptional: {
+		lnwire.PaymentAddrOptional: {},
+	},
 }
 
 // ValidateDeps asserts that a feature vector sets all features and their
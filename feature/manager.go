@@ -63,6 +63,37 @@ type Config struct {
 	// NoRouteBlinding unsets route blinding feature bits.
 	NoRouteBlinding bool
 
+	// NoRbfCoopClose unsets any bits signalling support for the RBF co-op
+	// close flow.
+	NoRbfCoopClose bool
+
+	// NoDynamicCommitments unsets any bits signalling support for dynamic
+	// commitments.
+	NoDynamicCommitments bool
+
+	// NoQuiescence unsets any bits signalling support for the quiescence
+	// protocol.
+	NoQuiescence bool
+
+	// NoSplice unsets any bits signalling support for splicing.
+	NoSplice bool
+
+	// NoCoFund unsets any bits signalling support for co-funded
+	// channel opens.
+	NoCoFund bool
+
+	// NoOnionMessages unsets any bits signalling support for relaying
+	// onion messages.
+	NoOnionMessages bool
+
+	// NoPeerStorage unsets any bits signalling support for storing blobs
+	// on behalf of our peers.
+	NoPeerStorage bool
+
+	// NoTrampolineRouting unsets any bits signalling support for
+	// forwarding trampoline payments.
+	NoTrampolineRouting bool
+
 	// CustomFeatures is a set of custom features to advertise in each
 	// set.
 	CustomFeatures map[Set][]lnwire.FeatureBit
@@ -192,6 +223,38 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.Bolt11BlindedPathsOptional)
 			raw.Unset(lnwire.Bolt11BlindedPathsRequired)
 		}
+		if cfg.NoRbfCoopClose {
+			raw.Unset(lnwire.RbfCoopCloseStagingOptional)
+			raw.Unset(lnwire.RbfCoopCloseStagingRequired)
+		}
+		if cfg.NoDynamicCommitments {
+			raw.Unset(lnwire.DynamicCommitmentsOptional)
+			raw.Unset(lnwire.DynamicCommitmentsRequired)
+		}
+		if cfg.NoQuiescence {
+			raw.Unset(lnwire.QuiescenceOptional)
+			raw.Unset(lnwire.QuiescenceRequired)
+		}
+		if cfg.NoSplice {
+			raw.Unset(lnwire.SpliceStagingOptional)
+			raw.Unset(lnwire.SpliceStagingRequired)
+		}
+		if cfg.NoCoFund {
+			raw.Unset(lnwire.CoFundOptional)
+			raw.Unset(lnwire.CoFundRequired)
+		}
+		if cfg.NoOnionMessages {
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+		}
+		if cfg.NoPeerStorage {
+			raw.Unset(lnwire.ProvideStorageOptional)
+			raw.Unset(lnwire.ProvideStorageRequired)
+		}
+		if cfg.NoTrampolineRouting {
+			raw.Unset(lnwire.TrampolineRoutingStagingOptional)
+			raw.Unset(lnwire.TrampolineRoutingStagingRequired)
+		}
 		for _, custom := range cfg.CustomFeatures[set] {
 			if custom > set.Maximum() {
 				return nil, fmt.Errorf("feature bit: %v "+
@@ -0,0 +1,494 @@
+package feeautopilot
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultInterval is the default interval at which the fee policies
+	// are evaluated.
+	DefaultInterval = time.Hour
+
+	// DefaultMinUpdateInterval is the default minimum time between two
+	// fee policy updates of the same channel.
+	DefaultMinUpdateInterval = 6 * time.Hour
+
+	// DefaultMinFeeRateDelta is the default minimum change of a fee rate
+	// in parts per million that is worth a policy update.
+	DefaultMinFeeRateDelta = 10
+
+	// DefaultMinFeeRate is the default lowest fee rate that the
+	// strategies propose.
+	DefaultMinFeeRate = 1
+
+	// DefaultMaxFeeRate is the default highest fee rate that the
+	// strategies propose.
+	DefaultMaxFeeRate = 1000
+
+	// DefaultForwardingWindow is the default window within which the
+	// forwarding volume of a channel is measured.
+	DefaultForwardingWindow = 24 * time.Hour
+
+	// DefaultTargetForwardRatio is the default targeted outgoing volume
+	// within the forwarding window as a fraction of the channel capacity.
+	DefaultTargetForwardRatio = 0.1
+
+	// DefaultFeeRateStep is the default fraction by which the forwarding
+	// rate strategy changes a fee rate.
+	DefaultFeeRateStep = 0.1
+
+	// DefaultMaxInboundDiscount is the default inbound fee rate discount
+	// in parts per million of a channel without local balance.
+	DefaultMaxInboundDiscount = 200
+
+	// DefaultInboundDiscountThreshold is the default local ratio below
+	// which an inbound discount is offered.
+	DefaultInboundDiscountThreshold = 0.2
+
+	// forwardingQueryBatch is the number of forwarding events that are
+	// queried at once.
+	forwardingQueryBatch = 10000
+)
+
+// Action is the action that the fee autopilot takes for a channel.
+type Action uint8
+
+const (
+	// ActionNone indicates that the planned policy doesn't differ enough
+	// from the current policy to be worth an update.
+	ActionNone Action = iota
+
+	// ActionUpdate indicates that the channel is updated to the planned
+	// policy.
+	ActionUpdate
+
+	// ActionRateLimited indicates that the channel would be updated to
+	// the planned policy, but its policy was updated too recently.
+	ActionRateLimited
+)
+
+// String returns a human readable representation of the action.
+func (a Action) String() string {
+	switch a {
+	case ActionNone:
+		return "none"
+
+	case ActionUpdate:
+		return "update"
+
+	case ActionRateLimited:
+		return "rate_limited"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(a))
+	}
+}
+
+// ChannelPlan is the planned fee policy of a channel.
+type ChannelPlan struct {
+	ChannelState
+
+	// Planned is the fee policy that the strategies proposed.
+	Planned FeePolicy
+
+	// Action is the action that is taken for the channel.
+	Action Action
+
+	// LastUpdate is the time of the last policy update of the channel.
+	LastUpdate time.Time
+
+	// timeLockDelta and maxHTLC are the parts of the current policy that
+	// the fee autopilot doesn't manage, which are kept on update.
+	timeLockDelta uint32
+	maxHTLC       lnwire.MilliSatoshi
+}
+
+// Config holds the configuration of the fee autopilot.
+type Config struct {
+	// Strategies are the strategies that propose the fee policies, in the
+	// order they are applied.
+	Strategies []Strategy
+
+	// DryRun indicates that the planned policies are only logged, but not
+	// applied.
+	DryRun bool
+
+	// MinUpdateInterval is the minimum time between two policy updates of
+	// the same channel, which limits the channel updates that we gossip.
+	MinUpdateInterval time.Duration
+
+	// MinFeeRateDelta is the minimum change of the fee rate or inbound fee
+	// rate of a channel in parts per million that is worth an update.
+	MinFeeRateDelta uint32
+
+	// ForwardingWindow is the window within which the outgoing volume of
+	// a channel is measured.
+	ForwardingWindow time.Duration
+
+	// ForAllOutgoingChannels iterates over all our local channels.
+	ForAllOutgoingChannels func(cb func(kvdb.RTx,
+		*models.ChannelEdgeInfo, *models.ChannelEdgePolicy) error) error
+
+	// FetchChannel fetches the state of a local channel. Optionally an
+	// existing db tx can be supplied.
+	FetchChannel func(tx kvdb.RTx, chanPoint wire.OutPoint) (
+		*channeldb.OpenChannel, error)
+
+	// QueryForwards queries the forwarding log.
+	QueryForwards func(query channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// UpdatePolicy updates the forwarding policy of channels.
+	UpdatePolicy func(policy routing.ChannelPolicy,
+		chanPoints ...wire.OutPoint) ([]*lnrpc.FailedUpdate, error)
+
+	// Ticker triggers the evaluation of the fee policies.
+	Ticker ticker.Ticker
+
+	// Clock is used to measure the forwarding window and the time since
+	// the last policy update of a channel.
+	Clock clock.Clock
+}
+
+// Manager is the fee autopilot. It periodically evaluates the fee policies of
+// our channels with the configured strategies, and updates the channels whose
+// planned policy differs from their current one.
+type Manager struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *Config
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new fee autopilot.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic evaluation of the fee policies.
+func (m *Manager) Start() error {
+	if m.started.Swap(true) {
+		return errors.New("fee autopilot already started")
+	}
+
+	log.Infof("Fee autopilot starting with strategies %v (dry run: %v)",
+		m.StrategyNames(), m.cfg.DryRun)
+
+	m.wg.Add(1)
+	go m.autopilot()
+
+	return nil
+}
+
+// Stop stops the fee autopilot.
+func (m *Manager) Stop() error {
+	if m.stopped.Swap(true) {
+		return nil
+	}
+
+	log.Info("Fee autopilot shutting down...")
+	defer log.Debug("Fee autopilot shutdown complete")
+
+	close(m.quit)
+	m.wg.Wait()
+
+	return nil
+}
+
+// DryRun returns true if the planned policies are only logged, but not
+// applied.
+func (m *Manager) DryRun() bool {
+	return m.cfg.DryRun
+}
+
+// StrategyNames returns the names of the strategies, in the order they are
+// applied.
+func (m *Manager) StrategyNames() []string {
+	names := make([]string, 0, len(m.cfg.Strategies))
+	for _, strategy := range m.cfg.Strategies {
+		names = append(names, strategy.Name())
+	}
+
+	return names
+}
+
+// autopilot evaluates the fee policies on every tick.
+func (m *Manager) autopilot() {
+	defer m.wg.Done()
+
+	m.cfg.Ticker.Resume()
+	defer m.cfg.Ticker.Stop()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+			if err := m.run(); err != nil {
+				log.Errorf("Unable to evaluate fee policies: "+
+					"%v", err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// run plans the fee policies of all channels and applies the planned updates,
+// unless this is a dry run.
+func (m *Manager) run() error {
+	plans, err := m.Plan()
+	if err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		if plan.Action != ActionUpdate {
+			continue
+		}
+
+		if m.cfg.DryRun {
+			log.Infof("Dry run: would update fee policy of "+
+				"channel %v from %v to %v", plan.ChanPoint,
+				plan.Policy, plan.Planned)
+
+			continue
+		}
+
+		log.Infof("Updating fee policy of channel %v from %v to %v",
+			plan.ChanPoint, plan.Policy, plan.Planned)
+
+		if err := m.apply(plan); err != nil {
+			log.Errorf("Unable to update fee policy of channel "+
+				"%v: %v", plan.ChanPoint, err)
+		}
+	}
+
+	return nil
+}
+
+// apply updates the channel of a plan to the planned policy.
+func (m *Manager) apply(plan *ChannelPlan) error {
+	policy := routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee: plan.Planned.BaseFee,
+			FeeRate: plan.Planned.FeeRate,
+		},
+		TimeLockDelta: plan.timeLockDelta,
+		MaxHTLC:       plan.maxHTLC,
+	}
+
+	// The inbound fee is only set if it changed, so that channels without
+	// an inbound fee don't get an empty one.
+	if plan.Planned.InboundFee != plan.Policy.InboundFee {
+		policy.InboundFee = fn.Some(plan.Planned.InboundFee)
+	}
+
+	failedUpdates, err := m.cfg.UpdatePolicy(policy, plan.ChanPoint)
+	if err != nil {
+		return err
+	}
+	if len(failedUpdates) > 0 {
+		return errors.New(failedUpdates[0].UpdateError)
+	}
+
+	return nil
+}
+
+// Plan evaluates the fee policies of all channels with the configured
+// strategies, without applying them. The plans are ordered by short channel
+// ID.
+func (m *Manager) Plan() ([]*ChannelPlan, error) {
+	now := m.cfg.Clock.Now()
+
+	volumes, err := m.outgoingVolumes(now)
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []*ChannelPlan
+	err = m.cfg.ForAllOutgoingChannels(func(tx kvdb.RTx,
+		info *models.ChannelEdgeInfo,
+		edge *models.ChannelEdgePolicy) error {
+
+		// Channels without our policy aren't announced yet.
+		if edge == nil {
+			return nil
+		}
+
+		channel, err := m.cfg.FetchChannel(tx, info.ChannelPoint)
+		switch {
+		// The channel may be closed while it's still in the graph.
+		case errors.Is(err, channeldb.ErrChannelNotFound):
+			return nil
+
+		case err != nil:
+			return err
+
+		case channel.IsPending:
+			return nil
+		}
+
+		plan, err := m.planChannel(info, edge, channel, volumes, now)
+		if err != nil {
+			return err
+		}
+		plans = append(plans, plan)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].ChanID.ToUint64() < plans[j].ChanID.ToUint64()
+	})
+
+	return plans, nil
+}
+
+// planChannel plans the fee policy of a single channel.
+func (m *Manager) planChannel(info *models.ChannelEdgeInfo,
+	edge *models.ChannelEdgePolicy, channel *channeldb.OpenChannel,
+	volumes map[lnwire.ShortChannelID]lnwire.MilliSatoshi,
+	now time.Time) (*ChannelPlan, error) {
+
+	var inboundWireFee lnwire.Fee
+	_, err := edge.ExtraOpaqueData.ExtractRecords(&inboundWireFee)
+	if err != nil {
+		return nil, err
+	}
+
+	chanID := lnwire.NewShortChanIDFromInt(edge.ChannelID)
+	commitment := channel.LocalCommitment
+
+	plan := &ChannelPlan{
+		ChannelState: ChannelState{
+			ChanPoint:      info.ChannelPoint,
+			ChanID:         chanID,
+			Capacity:       info.Capacity,
+			LocalBalance:   commitment.LocalBalance.ToSatoshis(),
+			RemoteBalance:  commitment.RemoteBalance.ToSatoshis(),
+			OutgoingVolume: volumes[chanID],
+			Policy: FeePolicy{
+				BaseFee: edge.FeeBaseMSat,
+				FeeRate: uint32(
+					edge.FeeProportionalMillionths,
+				),
+				InboundFee: models.NewInboundFeeFromWire(
+					inboundWireFee,
+				),
+			},
+		},
+		LastUpdate:    edge.LastUpdate,
+		timeLockDelta: uint32(edge.TimeLockDelta),
+		maxHTLC:       edge.MaxHTLC,
+	}
+
+	plan.Planned = plan.Policy
+	for _, strategy := range m.cfg.Strategies {
+		strategy.Propose(&plan.ChannelState, &plan.Planned)
+	}
+
+	switch {
+	case !m.worthUpdate(plan.Policy, plan.Planned):
+		plan.Action = ActionNone
+
+	case now.Sub(plan.LastUpdate) < m.cfg.MinUpdateInterval:
+		plan.Action = ActionRateLimited
+
+	default:
+		plan.Action = ActionUpdate
+	}
+
+	return plan, nil
+}
+
+// worthUpdate returns true if the planned policy differs enough from the
+// current policy to be worth a channel update.
+func (m *Manager) worthUpdate(current, planned FeePolicy) bool {
+	if current == planned {
+		return false
+	}
+
+	if current.BaseFee != planned.BaseFee ||
+		current.InboundFee.Base != planned.InboundFee.Base {
+
+		return true
+	}
+
+	feeRateDelta := absDiff(
+		int64(current.FeeRate), int64(planned.FeeRate),
+	)
+	inboundRateDelta := absDiff(
+		int64(current.InboundFee.Rate), int64(planned.InboundFee.Rate),
+	)
+
+	minDelta := int64(m.cfg.MinFeeRateDelta)
+
+	return feeRateDelta >= minDelta || inboundRateDelta >= minDelta
+}
+
+// absDiff returns the absolute difference of a and b.
+func absDiff(a, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
+
+// outgoingVolumes returns the amounts that were forwarded out through each
+// channel within the forwarding window.
+func (m *Manager) outgoingVolumes(now time.Time) (
+	map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+	volumes := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-m.cfg.ForwardingWindow),
+		EndTime:      now,
+		NumMaxEvents: forwardingQueryBatch,
+	}
+	for {
+		timeSlice, err := m.cfg.QueryForwards(query)
+		switch {
+		case errors.Is(err, channeldb.ErrNoForwardingEvents):
+			return volumes, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			volumes[event.OutgoingChanID] += event.AmtOut
+		}
+
+		if len(timeSlice.ForwardingEvents) < forwardingQueryBatch {
+			return volumes, nil
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
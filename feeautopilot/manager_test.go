@@ -0,0 +1,433 @@
+package feeautopilot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+var testNow = time.Unix(1_700_000_000, 0)
+
+// testChannel is a local channel of the test harness.
+type testChannel struct {
+	info    *models.ChannelEdgeInfo
+	policy  *models.ChannelEdgePolicy
+	channel *channeldb.OpenChannel
+}
+
+// policyUpdate is a policy update that the test harness received.
+type policyUpdate struct {
+	policy    routing.ChannelPolicy
+	chanPoint wire.OutPoint
+}
+
+type testHarness struct {
+	t *testing.T
+
+	channels []*testChannel
+	forwards []channeldb.ForwardingEvent
+	updates  chan *policyUpdate
+
+	ticker *ticker.Force
+	clock  *clock.TestClock
+	cfg    *Config
+}
+
+func newTestHarness(t *testing.T) *testHarness {
+	h := &testHarness{
+		t:       t,
+		updates: make(chan *policyUpdate, 10),
+		ticker:  ticker.NewForce(time.Hour),
+		clock:   clock.NewTestClock(testNow),
+	}
+
+	h.cfg = &Config{
+		Strategies: []Strategy{
+			&ProportionalStrategy{
+				MinFeeRate: 100,
+				MaxFeeRate: 1100,
+			},
+		},
+		MinUpdateInterval: time.Hour,
+		MinFeeRateDelta:   10,
+		ForwardingWindow:  24 * time.Hour,
+		ForAllOutgoingChannels: func(cb func(kvdb.RTx,
+			*models.ChannelEdgeInfo,
+			*models.ChannelEdgePolicy) error) error {
+
+			for _, c := range h.channels {
+				err := cb(nil, c.info, c.policy)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		FetchChannel: func(_ kvdb.RTx, chanPoint wire.OutPoint) (
+			*channeldb.OpenChannel, error) {
+
+			for _, c := range h.channels {
+				if c.info.ChannelPoint == chanPoint &&
+					c.channel != nil {
+
+					return c.channel, nil
+				}
+			}
+
+			return nil, channeldb.ErrChannelNotFound
+		},
+		QueryForwards: h.queryForwards,
+		UpdatePolicy: func(policy routing.ChannelPolicy,
+			chanPoints ...wire.OutPoint) ([]*lnrpc.FailedUpdate,
+			error) {
+
+			require.Len(t, chanPoints, 1)
+			h.updates <- &policyUpdate{
+				policy:    policy,
+				chanPoint: chanPoints[0],
+			}
+
+			return nil, nil
+		},
+		Ticker: h.ticker,
+		Clock:  h.clock,
+	}
+
+	return h
+}
+
+// queryForwards returns the forwarding events of the harness within the time
+// range of the query, paginated like the forwarding log.
+func (h *testHarness) queryForwards(query channeldb.ForwardingEventQuery) (
+	channeldb.ForwardingLogTimeSlice, error) {
+
+	resp := channeldb.ForwardingLogTimeSlice{
+		ForwardingEventQuery: query,
+	}
+
+	var matched []channeldb.ForwardingEvent
+	for _, event := range h.forwards {
+		if event.Timestamp.Before(query.StartTime) ||
+			event.Timestamp.After(query.EndTime) {
+
+			continue
+		}
+		matched = append(matched, event)
+	}
+
+	if int(query.IndexOffset) >= len(matched) {
+		return resp, channeldb.ErrNoForwardingEvents
+	}
+
+	end := int(query.IndexOffset) + int(query.NumMaxEvents)
+	if end > len(matched) {
+		end = len(matched)
+	}
+	resp.ForwardingEvents = matched[query.IndexOffset:end]
+	resp.LastIndexOffset = uint32(end)
+
+	return resp, nil
+}
+
+// addChannel adds a local channel with the given balances and fee rate, whose
+// policy was last updated at the given time.
+func (h *testHarness) addChannel(id uint64, local, remote btcutil.Amount,
+	feeRate lnwire.MilliSatoshi, lastUpdate time.Time) *testChannel {
+
+	chanPoint := wire.OutPoint{Hash: chainhash.Hash{byte(id)}}
+
+	c := &testChannel{
+		info: &models.ChannelEdgeInfo{
+			ChannelID:    id,
+			ChannelPoint: chanPoint,
+			Capacity:     local + remote,
+		},
+		policy: &models.ChannelEdgePolicy{
+			ChannelID:                 id,
+			LastUpdate:                lastUpdate,
+			TimeLockDelta:             40,
+			FeeBaseMSat:               1000,
+			FeeProportionalMillionths: feeRate,
+			MaxHTLC:                   5_000_000,
+		},
+		channel: &channeldb.OpenChannel{
+			LocalCommitment: channeldb.ChannelCommitment{
+				LocalBalance: lnwire.NewMSatFromSatoshis(
+					local,
+				),
+				RemoteBalance: lnwire.NewMSatFromSatoshis(
+					remote,
+				),
+			},
+		},
+	}
+	h.channels = append(h.channels, c)
+
+	return c
+}
+
+// start creates and starts a manager with the config of the harness.
+func (h *testHarness) start() *Manager {
+	m := NewManager(h.cfg)
+	require.NoError(h.t, m.Start())
+	h.t.Cleanup(func() {
+		require.NoError(h.t, m.Stop())
+	})
+
+	return m
+}
+
+// tick forces a tick of the manager.
+func (h *testHarness) tick() {
+	select {
+	case h.ticker.Force <- h.clock.Now():
+	case <-time.After(testTimeout):
+		h.t.Fatalf("tick not consumed")
+	}
+}
+
+// receiveUpdate waits for the next policy update.
+func (h *testHarness) receiveUpdate() *policyUpdate {
+	h.t.Helper()
+
+	select {
+	case update := <-h.updates:
+		return update
+	case <-time.After(testTimeout):
+		h.t.Fatalf("no policy update received")
+		return nil
+	}
+}
+
+// assertNoUpdate asserts that no policy update is made.
+func (h *testHarness) assertNoUpdate() {
+	h.t.Helper()
+
+	select {
+	case update := <-h.updates:
+		h.t.Fatalf("unexpected policy update of %v", update.chanPoint)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestPlan tests that the plan reports the proposed policies of our channels
+// along with the action that is taken for them.
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	stale := testNow.Add(-2 * time.Hour)
+
+	// A balanced channel whose fee rate is far off the proposed one is
+	// updated, unless it was updated too recently.
+	update := h.addChannel(3, 500_000, 500_000, 200, stale)
+	limited := h.addChannel(2, 500_000, 500_000, 200, testNow)
+
+	// A channel whose fee rate is close to the proposed one isn't worth an
+	// update.
+	h.addChannel(1, 500_000, 500_000, 595, stale)
+
+	// Channels that aren't announced, pending or closed are skipped.
+	h.addChannel(4, 500_000, 500_000, 200, stale).policy = nil
+	h.addChannel(5, 500_000, 500_000, 200, stale).channel.IsPending = true
+	h.addChannel(6, 500_000, 500_000, 200, stale).channel = nil
+
+	h.forwards = []channeldb.ForwardingEvent{
+		{
+			Timestamp:      testNow.Add(-time.Hour),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(3),
+			AmtOut:         1000,
+		},
+		{
+			Timestamp:      testNow.Add(-2 * time.Hour),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(3),
+			AmtOut:         2000,
+		},
+
+		// Forwards outside of the window aren't counted.
+		{
+			Timestamp:      testNow.Add(-48 * time.Hour),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(3),
+			AmtOut:         4000,
+		},
+	}
+
+	m := NewManager(h.cfg)
+	plans, err := m.Plan()
+	require.NoError(t, err)
+	require.Len(t, plans, 3)
+
+	// The plans are ordered by short channel ID.
+	for i, plan := range plans {
+		require.EqualValues(t, i+1, plan.ChanID.ToUint64())
+		require.EqualValues(t, 600, plan.Planned.FeeRate)
+		require.EqualValues(t, 1000, plan.Planned.BaseFee)
+	}
+	require.Equal(t, ActionNone, plans[0].Action)
+	require.Equal(t, ActionRateLimited, plans[1].Action)
+	require.Equal(t, ActionUpdate, plans[2].Action)
+
+	require.Equal(t, limited.info.ChannelPoint, plans[1].ChanPoint)
+	require.Equal(t, testNow, plans[1].LastUpdate)
+
+	require.Equal(t, update.info.ChannelPoint, plans[2].ChanPoint)
+	require.EqualValues(t, 1_000_000, plans[2].Capacity)
+	require.EqualValues(t, 500_000, plans[2].LocalBalance)
+	require.EqualValues(t, 500_000, plans[2].RemoteBalance)
+	require.EqualValues(t, 3000, plans[2].OutgoingVolume)
+	require.EqualValues(t, 200, plans[2].Policy.FeeRate)
+}
+
+// TestPlanInboundFee tests that the current inbound fee of a channel is
+// extracted from its policy, and that an inbound fee change is worth an
+// update.
+func TestPlanInboundFee(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.cfg.Strategies = append(h.cfg.Strategies, &InboundDiscountStrategy{
+		MaxDiscount: 200,
+		Threshold:   0.2,
+	})
+
+	c := h.addChannel(1, 100_000, 900_000, 1000, testNow.Add(-time.Hour))
+	inboundFee := lnwire.Fee{BaseFee: -500, FeeRate: -20}
+	require.NoError(t, c.policy.ExtraOpaqueData.PackRecords(&inboundFee))
+
+	m := NewManager(h.cfg)
+	plans, err := m.Plan()
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+
+	plan := plans[0]
+	require.Equal(t, models.InboundFee{Base: -500, Rate: -20},
+		plan.Policy.InboundFee)
+	require.Equal(t, models.InboundFee{Rate: -100},
+		plan.Planned.InboundFee)
+	require.EqualValues(t, 1000, plan.Planned.FeeRate)
+	require.Equal(t, ActionUpdate, plan.Action)
+}
+
+// TestManagerUpdates tests that the manager applies the planned updates on
+// every tick, keeping the parts of the policy that it doesn't manage.
+func TestManagerUpdates(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	c := h.addChannel(1, 500_000, 500_000, 200, testNow.Add(-time.Hour))
+	h.start()
+
+	h.tick()
+	update := h.receiveUpdate()
+	require.Equal(t, c.info.ChannelPoint, update.chanPoint)
+	require.Equal(t, routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee: 1000,
+			FeeRate: 600,
+		},
+		TimeLockDelta: 40,
+		MaxHTLC:       5_000_000,
+	}, update.policy)
+
+	// Once the update is applied, the channel is rate limited until the
+	// minimum update interval passed, even if its balance changed.
+	c.policy.FeeProportionalMillionths = 600
+	c.policy.LastUpdate = testNow
+	c.channel.LocalCommitment.LocalBalance = 0
+	h.tick()
+	h.assertNoUpdate()
+
+	h.clock.SetTime(testNow.Add(time.Hour))
+	h.tick()
+	update = h.receiveUpdate()
+	require.EqualValues(t, 1100, update.policy.FeeRate)
+	require.True(t, update.policy.InboundFee.IsNone())
+}
+
+// TestManagerInboundFeeUpdate tests that an inbound fee is only set on update
+// if it changed.
+func TestManagerInboundFeeUpdate(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.cfg.Strategies = append(h.cfg.Strategies, &InboundDiscountStrategy{
+		MaxDiscount: 200,
+		Threshold:   0.2,
+	})
+	h.addChannel(1, 100_000, 900_000, 200, testNow.Add(-time.Hour))
+	h.start()
+
+	h.tick()
+	update := h.receiveUpdate()
+	require.EqualValues(t, 1000, update.policy.FeeRate)
+	require.Equal(t, fn.Some(models.InboundFee{Rate: -100}),
+		update.policy.InboundFee)
+}
+
+// TestManagerDryRun tests that no updates are applied in dry-run mode.
+func TestManagerDryRun(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.cfg.DryRun = true
+	h.addChannel(1, 500_000, 500_000, 200, testNow.Add(-time.Hour))
+	m := h.start()
+
+	require.True(t, m.DryRun())
+	require.Equal(t, []string{"proportional"}, m.StrategyNames())
+
+	h.tick()
+	h.assertNoUpdate()
+
+	// The update is still planned.
+	plans, err := m.Plan()
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	require.Equal(t, ActionUpdate, plans[0].Action)
+}
+
+// TestOutgoingVolumes tests that the outgoing volumes are summed across all
+// pages of the forwarding log.
+func TestOutgoingVolumes(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+
+	numEvents := forwardingQueryBatch + 5
+	for i := 0; i < numEvents; i++ {
+		h.forwards = append(h.forwards, channeldb.ForwardingEvent{
+			Timestamp: testNow.Add(-time.Minute),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(
+				uint64(i%2 + 1),
+			),
+			AmtOut: 1,
+		})
+	}
+
+	m := NewManager(h.cfg)
+	volumes, err := m.outgoingVolumes(testNow)
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+	require.EqualValues(
+		t, (numEvents+1)/2, volumes[lnwire.NewShortChanIDFromInt(1)],
+	)
+	require.EqualValues(
+		t, numEvents/2, volumes[lnwire.NewShortChanIDFromInt(2)],
+	)
+}
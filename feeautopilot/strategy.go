@@ -0,0 +1,222 @@
+package feeautopilot
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// FeePolicy is the part of the forwarding policy of a channel that the fee
+// autopilot manages.
+type FeePolicy struct {
+	// BaseFee is the base fee that is charged for every forwarded htlc.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee in parts per million of the
+	// forwarded amount.
+	FeeRate uint32
+
+	// InboundFee is the fee that is charged for htlcs that come in through
+	// the channel. A negative inbound fee is a discount.
+	InboundFee models.InboundFee
+}
+
+// String returns a human readable representation of the fee policy.
+func (p FeePolicy) String() string {
+	return fmt.Sprintf("base_fee=%v, fee_rate=%vppm, inbound_base_fee=%v, "+
+		"inbound_fee_rate=%vppm", p.BaseFee, p.FeeRate,
+		p.InboundFee.Base, p.InboundFee.Rate)
+}
+
+// ChannelState is the state of a channel that the strategies base their
+// proposals on.
+type ChannelState struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance in the channel.
+	LocalBalance btcutil.Amount
+
+	// RemoteBalance is the balance of our peer in the channel.
+	RemoteBalance btcutil.Amount
+
+	// OutgoingVolume is the amount that was forwarded out through the
+	// channel within the forwarding window.
+	OutgoingVolume lnwire.MilliSatoshi
+
+	// Policy is the current fee policy of the channel.
+	Policy FeePolicy
+}
+
+// LocalRatio returns the share of the channel balance that is on our side,
+// between 0 and 1.
+func (c *ChannelState) LocalRatio() float64 {
+	total := c.LocalBalance + c.RemoteBalance
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(c.LocalBalance) / float64(total)
+}
+
+// Strategy proposes fee policies for channels. The strategies of the fee
+// autopilot are applied in order, each adjusting the policy that the previous
+// ones proposed.
+type Strategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// Propose adjusts the proposed fee policy of a channel.
+	Propose(channel *ChannelState, policy *FeePolicy)
+}
+
+// ProportionalStrategy sets the fee rate of a channel in proportion to the
+// share of the balance that is on the remote side. Channels that are drained
+// of local balance get the maximum fee rate, while channels that hold all of
+// the balance on our side get the minimum fee rate.
+type ProportionalStrategy struct {
+	// MinFeeRate is the fee rate of a channel with no remote balance.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the fee rate of a channel with no local balance.
+	MaxFeeRate uint32
+}
+
+// A compile time check to ensure ProportionalStrategy implements the Strategy
+// interface.
+var _ Strategy = (*ProportionalStrategy)(nil)
+
+// Name returns the name of the strategy.
+//
+// NOTE: Part of the Strategy interface.
+func (s *ProportionalStrategy) Name() string {
+	return "proportional"
+}
+
+// Propose sets the fee rate of the channel in proportion to its remote
+// balance.
+//
+// NOTE: Part of the Strategy interface.
+func (s *ProportionalStrategy) Propose(channel *ChannelState,
+	policy *FeePolicy) {
+
+	span := float64(s.MaxFeeRate - s.MinFeeRate)
+	policy.FeeRate = s.MaxFeeRate - uint32(
+		math.Round(span*channel.LocalRatio()),
+	)
+}
+
+// ForwardingRateStrategy adjusts the fee rate of a channel towards a target
+// forwarding volume. The fee rate is lowered by a step if less than the target
+// was forwarded out through the channel within the forwarding window, and
+// raised by a step if more was forwarded.
+type ForwardingRateStrategy struct {
+	// MinFeeRate is the lowest fee rate that is proposed.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest fee rate that is proposed.
+	MaxFeeRate uint32
+
+	// TargetRatio is the targeted outgoing volume within the forwarding
+	// window as a fraction of the channel capacity.
+	TargetRatio float64
+
+	// Step is the fraction by which the fee rate is changed.
+	Step float64
+}
+
+// A compile time check to ensure ForwardingRateStrategy implements the
+// Strategy interface.
+var _ Strategy = (*ForwardingRateStrategy)(nil)
+
+// Name returns the name of the strategy.
+//
+// NOTE: Part of the Strategy interface.
+func (s *ForwardingRateStrategy) Name() string {
+	return "forwarding-rate"
+}
+
+// Propose moves the fee rate of the channel towards the target forwarding
+// volume.
+//
+// NOTE: Part of the Strategy interface.
+func (s *ForwardingRateStrategy) Propose(channel *ChannelState,
+	policy *FeePolicy) {
+
+	capacity := lnwire.NewMSatFromSatoshis(channel.Capacity)
+	target := s.TargetRatio * float64(capacity)
+	volume := float64(channel.OutgoingVolume)
+	local := float64(lnwire.NewMSatFromSatoshis(channel.LocalBalance))
+
+	rate := float64(policy.FeeRate)
+	switch {
+	// Lowering the fee rate doesn't help a channel that doesn't have the
+	// local balance to forward the missing volume.
+	case volume < target && local >= target-volume:
+		rate *= 1 - s.Step
+
+	// A fee rate is raised by at least one, so that a zero fee rate can
+	// be raised as well.
+	case volume > target:
+		rate = math.Max(rate*(1+s.Step), rate+1)
+	}
+
+	rate = math.Max(rate, float64(s.MinFeeRate))
+	rate = math.Min(rate, float64(s.MaxFeeRate))
+	policy.FeeRate = uint32(math.Round(rate))
+}
+
+// InboundDiscountStrategy offers an inbound fee discount on channels with a
+// low local balance, to attract htlcs that come in through them and thereby
+// refill their local balance. The discount grows linearly from zero at the
+// threshold to the maximum discount for a channel without local balance.
+// Channels with a local ratio above the threshold get no inbound fee.
+type InboundDiscountStrategy struct {
+	// MaxDiscount is the inbound fee rate discount in parts per million
+	// of a channel without local balance.
+	MaxDiscount uint32
+
+	// Threshold is the local ratio below which a discount is offered.
+	Threshold float64
+}
+
+// A compile time check to ensure InboundDiscountStrategy implements the
+// Strategy interface.
+var _ Strategy = (*InboundDiscountStrategy)(nil)
+
+// Name returns the name of the strategy.
+//
+// NOTE: Part of the Strategy interface.
+func (s *InboundDiscountStrategy) Name() string {
+	return "inbound-discount"
+}
+
+// Propose sets the inbound fee of the channel to a discount that depends on
+// its local balance.
+//
+// NOTE: Part of the Strategy interface.
+func (s *InboundDiscountStrategy) Propose(channel *ChannelState,
+	policy *FeePolicy) {
+
+	ratio := channel.LocalRatio()
+	if s.Threshold <= 0 || ratio >= s.Threshold {
+		policy.InboundFee = models.InboundFee{}
+		return
+	}
+
+	discount := float64(s.MaxDiscount) * (s.Threshold - ratio) /
+		s.Threshold
+	policy.InboundFee = models.InboundFee{
+		Rate: -int32(math.Round(discount)),
+	}
+}
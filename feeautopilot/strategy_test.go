@@ -0,0 +1,225 @@
+package feeautopilot
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// newChannelState returns the state of a channel with the given balances,
+// whose capacity is their sum.
+func newChannelState(local, remote btcutil.Amount) *ChannelState {
+	return &ChannelState{
+		Capacity:      local + remote,
+		LocalBalance:  local,
+		RemoteBalance: remote,
+	}
+}
+
+// TestProportionalStrategy tests that the fee rate is proportional to the
+// remote balance of a channel.
+func TestProportionalStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &ProportionalStrategy{
+		MinFeeRate: 100,
+		MaxFeeRate: 1100,
+	}
+
+	tests := []struct {
+		name          string
+		local, remote btcutil.Amount
+		feeRate       uint32
+	}{
+		{
+			name:    "all local",
+			local:   1_000_000,
+			feeRate: 100,
+		},
+		{
+			name:    "all remote",
+			remote:  1_000_000,
+			feeRate: 1100,
+		},
+		{
+			name:    "balanced",
+			local:   500_000,
+			remote:  500_000,
+			feeRate: 600,
+		},
+		{
+			name:    "mostly local",
+			local:   750_000,
+			remote:  250_000,
+			feeRate: 350,
+		},
+		{
+			name:    "no balance",
+			feeRate: 1100,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := &FeePolicy{BaseFee: 1000, FeeRate: 1}
+			channel := newChannelState(test.local, test.remote)
+			strategy.Propose(channel, policy)
+
+			require.Equal(t, test.feeRate, policy.FeeRate)
+			require.EqualValues(t, 1000, policy.BaseFee)
+		})
+	}
+}
+
+// TestForwardingRateStrategy tests that the fee rate is moved towards the
+// target forwarding volume.
+func TestForwardingRateStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &ForwardingRateStrategy{
+		MinFeeRate:  10,
+		MaxFeeRate:  1000,
+		TargetRatio: 0.1,
+		Step:        0.1,
+	}
+
+	// The target volume of the channels below is 100k sat.
+	tests := []struct {
+		name          string
+		local, remote btcutil.Amount
+		volume        lnwire.MilliSatoshi
+		feeRate       uint32
+		expected      uint32
+	}{
+		{
+			name:     "below target",
+			local:    500_000,
+			remote:   500_000,
+			volume:   50_000_000,
+			feeRate:  500,
+			expected: 450,
+		},
+		{
+			name:     "above target",
+			local:    500_000,
+			remote:   500_000,
+			volume:   150_000_000,
+			feeRate:  500,
+			expected: 550,
+		},
+		{
+			name:     "on target",
+			local:    500_000,
+			remote:   500_000,
+			volume:   100_000_000,
+			feeRate:  500,
+			expected: 500,
+		},
+		{
+			name:     "below target without local balance",
+			local:    10_000,
+			remote:   990_000,
+			feeRate:  500,
+			expected: 500,
+		},
+		{
+			name:     "clamped to min",
+			local:    500_000,
+			remote:   500_000,
+			feeRate:  10,
+			expected: 10,
+		},
+		{
+			name:     "clamped to max",
+			local:    500_000,
+			remote:   500_000,
+			volume:   150_000_000,
+			feeRate:  990,
+			expected: 1000,
+		},
+		{
+			name:     "raised from below min",
+			local:    500_000,
+			remote:   500_000,
+			volume:   150_000_000,
+			expected: 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			channel := newChannelState(test.local, test.remote)
+			channel.OutgoingVolume = test.volume
+
+			policy := &FeePolicy{FeeRate: test.feeRate}
+			strategy.Propose(channel, policy)
+
+			require.Equal(t, test.expected, policy.FeeRate)
+		})
+	}
+}
+
+// TestInboundDiscountStrategy tests that an inbound discount is offered on
+// channels below the threshold, growing as the local balance shrinks.
+func TestInboundDiscountStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &InboundDiscountStrategy{
+		MaxDiscount: 200,
+		Threshold:   0.2,
+	}
+
+	tests := []struct {
+		name          string
+		local, remote btcutil.Amount
+		inboundFee    models.InboundFee
+	}{
+		{
+			name:   "no local balance",
+			remote: 1_000_000,
+			inboundFee: models.InboundFee{
+				Rate: -200,
+			},
+		},
+		{
+			name:   "half the threshold",
+			local:  100_000,
+			remote: 900_000,
+			inboundFee: models.InboundFee{
+				Rate: -100,
+			},
+		},
+		{
+			name:   "at the threshold",
+			local:  200_000,
+			remote: 800_000,
+		},
+		{
+			name:   "above the threshold",
+			local:  500_000,
+			remote: 500_000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Start with an inbound fee, which is replaced or
+			// removed by the strategy.
+			policy := &FeePolicy{
+				FeeRate: 500,
+				InboundFee: models.InboundFee{
+					Base: -1000,
+					Rate: -50,
+				},
+			}
+			channel := newChannelState(test.local, test.remote)
+			strategy.Propose(channel, policy)
+
+			require.Equal(t, test.inboundFee, policy.InboundFee)
+			require.EqualValues(t, 500, policy.FeeRate)
+		})
+	}
+}
@@ -0,0 +1,527 @@
+package funding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrCoFundNotSupported is returned if a co-funded channel open
+	// is requested, but the remote peer doesn't support it.
+	ErrCoFundNotSupported = errors.New("peer doesn't support " +
+		"co-funded channels")
+
+	// errCoFundNotNegotiated is returned if the initiator of a channel
+	// open asks us to contribute to the channel, but we don't support
+	// co-funded channels.
+	errCoFundNotNegotiated = errors.New("co-funded channels not " +
+		"negotiated")
+
+	// errNoFundingAmount is returned when the funding amount of the
+	// responder of a co-funded channel is not found in the expected TLV.
+	errNoFundingAmount = errors.New("funding amount not found")
+)
+
+// coFundState is the state of the interactive construction of the funding
+// transaction of a co-funded channel, as long as the channel is pending.
+type coFundState struct {
+	// feeRate is the fee rate of the funding transaction, which is set
+	// by the initiator.
+	feeRate chainfee.SatPerKWeight
+
+	// locktime is the locktime of the funding transaction, which is set
+	// by the initiator.
+	locktime uint32
+
+	// intent is the funding intent that backs our contribution to the
+	// channel.
+	intent *chanfunding.InteractiveIntent
+
+	// itx is the state machine of the construction of the funding
+	// transaction. It's set once the contributions of both parties are
+	// known.
+	itx *chanfunding.InteractiveTx
+
+	// done is set once the funding transaction was constructed.
+	done bool
+}
+
+// verifyFundingPoint checks that the given funding point references the
+// funding output of the constructed funding transaction.
+func (s *coFundState) verifyFundingPoint(fundingPoint wire.OutPoint) error {
+	if !s.done {
+		return errors.New("funding tx construction not done")
+	}
+
+	chanPoint, err := s.intent.ChanPoint()
+	if err != nil {
+		return err
+	}
+
+	if *chanPoint != fundingPoint {
+		return fmt.Errorf("funding point %v doesn't match funding "+
+			"output %v of constructed funding tx", fundingPoint,
+			chanPoint)
+	}
+
+	return nil
+}
+
+// CanCoFund returns true if the remote peer of the passed channel open
+// supports co-funded opens, and the open doesn't push funds, use a funding
+// shim or a variable funding amount, so the peer may contribute to it.
+func CanCoFund(msg *InitFundingMsg) bool {
+	peer := msg.Peer
+	if !hasFeatures(peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.CoFundOptional) {
+
+		return false
+	}
+
+	return msg.PushAmt == 0 && msg.ChanFunder == nil &&
+		msg.FundUpToMaxAmt == 0 && !msg.SubtractFees
+}
+
+// newCoFundState validates a local request to open a co-funded channel
+// and returns the state of the interactive construction of its funding
+// transaction.
+func (f *Manager) newCoFundState(msg *InitFundingMsg) (*coFundState,
+	error) {
+
+	peer := msg.Peer
+	if !hasFeatures(peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.CoFundOptional) {
+
+		return nil, ErrCoFundNotSupported
+	}
+
+	switch {
+	case msg.PushAmt != 0:
+		return nil, errors.New("co-funded channels can't push " +
+			"funds to the remote peer")
+
+	case msg.ChanFunder != nil:
+		return nil, errors.New("co-funded channels can't use a " +
+			"funding shim")
+
+	case msg.FundUpToMaxAmt != 0 || msg.SubtractFees:
+		return nil, errors.New("co-funded channels require a fixed " +
+			"local funding amount")
+	}
+
+	// We discourage fee sniping by using the current height as the
+	// locktime of the funding transaction.
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &coFundState{
+		feeRate:  msg.FundingFeePerKw,
+		locktime: uint32(bestHeight),
+	}, nil
+}
+
+// newFundeeCoFundState returns the state of the interactive construction
+// of the funding transaction if the initiator of the given channel open asks
+// us to contribute to the channel. It returns nil for single-funder opens.
+func (f *Manager) newFundeeCoFundState(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel) (*coFundState, error) {
+
+	if msg.FundingFeeRate == nil {
+		return nil, nil
+	}
+
+	if !hasFeatures(peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.CoFundOptional) {
+
+		return nil, errCoFundNotNegotiated
+	}
+
+	if msg.PushAmount != 0 {
+		return nil, errors.New("co-funded channel with push amount")
+	}
+
+	feeRate := chainfee.SatPerKWeight(*msg.FundingFeeRate)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("funding fee rate %v below minimum "+
+			"of %v", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	if msg.FundingLocktime == nil {
+		return nil, errors.New("funding locktime missing")
+	}
+
+	return &coFundState{
+		feeRate:  feeRate,
+		locktime: uint32(*msg.FundingLocktime),
+	}, nil
+}
+
+// startInteractiveTx starts the construction of the funding transaction of a
+// co-funded channel, once the contributions of both parties are known. If
+// we're the initiator, we send the first message of the construction.
+func (f *Manager) startInteractiveTx(resCtx *reservationWithCtx,
+	cid *chanIdentifier, intent *chanfunding.InteractiveIntent) error {
+
+	state := resCtx.coFund
+	itx, err := intent.InteractiveTx(
+		lnwire.ChannelID(cid.tempChanID), state.locktime,
+	)
+	if err != nil {
+		return err
+	}
+
+	state.intent = intent
+	state.itx = itx
+
+	if !intent.Initiator() {
+		return nil
+	}
+
+	msg, err := itx.Start()
+	if err != nil {
+		return err
+	}
+
+	return resCtx.peer.SendMessage(true, msg)
+}
+
+// handleInteractiveTxMsg processes a message of the interactive construction
+// of the funding transaction of a pending co-funded channel. Once the
+// construction is done, the initiator continues the funding flow by signing
+// the commitment of the responder.
+func (f *Manager) handleInteractiveTxMsg(peer lnpeer.Peer,
+	msg lnwire.LinkUpdater) {
+
+	peerKey := peer.IdentityKey()
+	pendingChanID := [32]byte(msg.TargetChanID())
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		log.Warnf("Can't find reservation (peer_id:%x, chan_id:%x) "+
+			"for %v", peerKey.SerializeCompressed(),
+			pendingChanID[:], msg.MsgType())
+		return
+	}
+
+	// Update the timestamp once the message has been handled.
+	defer resCtx.updateTimestamp()
+
+	cid := newChanIdentifier(pendingChanID)
+
+	state := resCtx.coFund
+	if state == nil || state.itx == nil {
+		err := fmt.Errorf("unexpected %v for pending_id(%x)",
+			msg.MsgType(), pendingChanID[:])
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	resp, done, err := state.itx.ProcessMsg(msg)
+	if err != nil {
+		log.Errorf("Unable to construct funding tx for "+
+			"pending_id(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	err = fn.MapOptionZ(resp, func(m lnwire.Message) error {
+		return peer.SendMessage(true, m)
+	})
+	if err != nil {
+		log.Errorf("Unable to send %v: %v", msg.MsgType(), err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	if !done {
+		return
+	}
+
+	log.Infof("Constructed funding tx for pending_id(%x)",
+		pendingChanID[:])
+
+	// The responder only verifies the funding transaction for now. It
+	// learns the channel point with the signature of the initiator for
+	// its commitment.
+	if !state.intent.Initiator() {
+		if _, err := state.intent.CompileFundingTx(); err != nil {
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+		state.done = true
+
+		return
+	}
+
+	// As the initiator, we notify the wallet so it can sign the
+	// commitment of the responder, which spends the funding output of the
+	// constructed transaction.
+	if err := resCtx.reservation.ProcessInteractiveTx(); err != nil {
+		log.Errorf("Unable to continue funding flow for "+
+			"pending_id(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	state.done = true
+
+	f.continueFundingAccept(resCtx, cid)
+}
+
+// handleTxAbort processes the abort of the construction of the funding
+// transaction of a co-funded channel by the remote peer.
+func (f *Manager) handleTxAbort(peer lnpeer.Peer, msg *lnwire.TxAbort) {
+	peerKey := peer.IdentityKey()
+
+	// Once the commitments are signed, the channel can only be canceled
+	// by not signing the funding transaction, after which it's forgotten
+	// once the funding transaction doesn't confirm.
+	if _, err := f.cfg.FindChannel(peerKey, msg.ChanID); err == nil {
+		log.Warnf("Peer %x aborted funding tx of ChannelID(%v) after "+
+			"signing commitments: %s",
+			peerKey.SerializeCompressed(), msg.ChanID, msg.Data)
+		return
+	}
+
+	resCtx, err := f.cancelReservationCtx(peerKey, msg.ChanID, true)
+	if err != nil {
+		log.Warnf("Received tx_abort for non-existent funding "+
+			"flow: %v (%s)", err, msg.Data)
+		return
+	}
+
+	fundingErr := fmt.Errorf("funding tx construction aborted by %x: %s",
+		peerKey.SerializeCompressed(), msg.Data)
+	log.Errorf(fundingErr.Error())
+
+	resCtx.err <- fundingErr
+}
+
+// exchangeTxSigs signs the funding inputs of a co-funded channel, once the
+// given channel was persisted. Our signatures are persisted along with the
+// funding transaction, so they can be retransmitted on reconnection, but only
+// sent right away if we sign first.
+func (f *Manager) exchangeTxSigs(peer lnpeer.Peer, state *coFundState,
+	channel *channeldb.OpenChannel) error {
+
+	fundingTx, err := state.intent.CompileFundingTx()
+	if err != nil {
+		return err
+	}
+
+	witnesses, err := state.intent.SignFundingTx()
+	if err != nil {
+		return err
+	}
+
+	packet, err := state.intent.FundingPsbt(witnesses)
+	if err != nil {
+		return err
+	}
+
+	var fundingPsbt bytes.Buffer
+	if err := packet.Serialize(&fundingPsbt); err != nil {
+		return err
+	}
+
+	coFundTx := &channeldb.CoFundTx{
+		FundingTx:   fundingTx,
+		FundingPsbt: fundingPsbt.Bytes(),
+		LocalTxSigs: &lnwire.TxSignatures{
+			ChanID: lnwire.NewChanIDFromOutPoint(
+				channel.FundingOutpoint,
+			),
+			TxID:      fundingTx.TxHash(),
+			Witnesses: witnesses,
+		},
+		SignsFirst: signsFirst(state.intent, channel),
+	}
+	if err := channel.PutCoFundTx(coFundTx); err != nil {
+		return err
+	}
+
+	if !coFundTx.SignsFirst {
+		return nil
+	}
+
+	return peer.SendMessage(true, coFundTx.LocalTxSigs)
+}
+
+// signsFirst returns true if we need to send the witnesses of our funding
+// inputs first. The party that contributes less to the channel signs first,
+// and if both contribute the same amount, the party with the lower funding
+// key signs first.
+func signsFirst(intent *chanfunding.InteractiveIntent,
+	channel *channeldb.OpenChannel) bool {
+
+	localAmt := intent.LocalFundingAmt()
+	remoteAmt := intent.RemoteFundingAmt()
+	if localAmt != remoteAmt {
+		return localAmt < remoteAmt
+	}
+
+	localKey := channel.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := channel.RemoteChanCfg.MultiSigKey.PubKey
+
+	return bytes.Compare(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+	) < 0
+}
+
+// fetchCoFundTx returns the pending channel with the given channel ID and
+// the state of the exchange of the signatures of its funding transaction.
+func (f *Manager) fetchCoFundTx(peer lnpeer.Peer,
+	chanID lnwire.ChannelID) (*channeldb.OpenChannel,
+	*channeldb.CoFundTx, error) {
+
+	channel, err := f.cfg.FindChannel(peer.IdentityKey(), chanID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coFundTx, err := channel.CoFundTx()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return channel, coFundTx, nil
+}
+
+// handleTxSignatures processes the witnesses of the remote peer's funding
+// inputs of a co-funded channel. If we don't sign first, we respond with
+// our witnesses, after which the fully signed funding transaction is
+// persisted and published. The remote peer retransmits its witnesses on
+// reconnection if we didn't receive them, in which case we retransmit ours
+// as well.
+func (f *Manager) handleTxSignatures(peer lnpeer.Peer,
+	msg *lnwire.TxSignatures) {
+
+	channel, coFundTx, err := f.fetchCoFundTx(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received tx_signatures for unknown "+
+			"ChannelID(%v): %v", msg.ChanID, err)
+		return
+	}
+
+	txid := coFundTx.FundingTx.TxHash()
+	if msg.TxID != txid {
+		log.Errorf("Received tx_signatures for ChannelID(%v) with "+
+			"txid %v, expected %v", msg.ChanID, msg.TxID, txid)
+		return
+	}
+
+	if !coFundTx.SignsFirst {
+		err := peer.SendMessage(true, coFundTx.LocalTxSigs)
+		if err != nil {
+			log.Errorf("Unable to send tx_signatures for "+
+				"ChannelID(%v): %v", msg.ChanID, err)
+			return
+		}
+	}
+
+	// If we completed the funding transaction already, the remote peer
+	// didn't receive our signatures yet.
+	if coFundTx.IsFinal() {
+		return
+	}
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(coFundTx.FundingPsbt), false,
+	)
+	if err != nil {
+		log.Errorf("Unable to parse funding psbt of ChannelID(%v): %v",
+			msg.ChanID, err)
+		return
+	}
+
+	fundingTx, err := chanfunding.FinalizeFundingPsbt(
+		packet, msg.Witnesses,
+	)
+	if err != nil {
+		log.Errorf("Unable to finalize funding tx %v of "+
+			"ChannelID(%v): %v", txid, msg.ChanID, err)
+		return
+	}
+
+	if err := channel.FinalizeCoFundTx(fundingTx); err != nil {
+		log.Errorf("Unable to persist funding tx %v of "+
+			"ChannelID(%v): %v", txid, msg.ChanID, err)
+		return
+	}
+
+	log.Infof("Broadcasting co-funded funding tx %v for "+
+		"ChannelID(%v)", txid, msg.ChanID)
+
+	f.publishCoFundTx(channel, fundingTx)
+}
+
+// handleChanReestablish retransmits our tx_signatures for the funding
+// transaction of a pending co-funded channel if the remote peer didn't
+// receive them before the disconnection, as indicated by the
+// next_funding_txid of its channel_reestablish. They're retransmitted if we
+// completed the funding transaction, or if we're the party that signs first.
+// Otherwise, we wait for the signatures of the remote peer.
+func (f *Manager) handleChanReestablish(peer lnpeer.Peer,
+	msg *lnwire.ChannelReestablish) {
+
+	if msg.NextFundingTxID.IsNone() {
+		return
+	}
+	txid := chainhash.Hash(msg.NextFundingTxID.UnsafeFromSome())
+
+	_, coFundTx, err := f.fetchCoFundTx(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Remote peer awaits tx_signatures for unknown "+
+			"ChannelID(%v): %v", msg.ChanID, err)
+		return
+	}
+
+	if coFundTx.FundingTx.TxHash() != txid {
+		log.Warnf("Remote peer awaits tx_signatures for funding tx "+
+			"%v of ChannelID(%v), expected %v", txid, msg.ChanID,
+			coFundTx.FundingTx.TxHash())
+		return
+	}
+
+	if !coFundTx.IsFinal() && !coFundTx.SignsFirst {
+		return
+	}
+
+	log.Infof("Retransmitting tx_signatures for funding tx %v of "+
+		"ChannelID(%v)", txid, msg.ChanID)
+
+	if err := peer.SendMessage(true, coFundTx.LocalTxSigs); err != nil {
+		log.Errorf("Unable to send tx_signatures for ChannelID(%v): "+
+			"%v", msg.ChanID, err)
+	}
+}
+
+// publishCoFundTx publishes the fully signed funding transaction of a
+// pending co-funded channel.
+func (f *Manager) publishCoFundTx(channel *channeldb.OpenChannel,
+	fundingTx *wire.MsgTx) {
+
+	// Set a nil short channel ID at this stage because we do not know it
+	// until our funding tx confirms.
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+
+	err := f.cfg.PublishTransaction(fundingTx, label)
+	if err != nil {
+		log.Errorf("Unable to broadcast funding tx %v for "+
+			"ChannelPoint(%v): %v", fundingTx.TxHash(),
+			channel.FundingOutpoint, err)
+	}
+}
@@ -0,0 +1,145 @@
+package funding
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewFundeeCoFundState tests that the responder of a channel open only
+// constructs the funding transaction together with the initiator if it asked
+// for a co-funded channel, and that its parameters are validated.
+func TestNewFundeeCoFundState(t *testing.T) {
+	t.Parallel()
+
+	coFundPeer := &testNode{
+		localFeatures: []lnwire.FeatureBit{
+			lnwire.CoFundOptional,
+		},
+		remoteFeatures: []lnwire.FeatureBit{
+			lnwire.CoFundOptional,
+		},
+	}
+
+	feeRate := func(rate uint32) *lnwire.CoFundFeeRate {
+		feeRate := lnwire.CoFundFeeRate(rate)
+		return &feeRate
+	}
+	locktime := new(lnwire.CoFundLocktime)
+	*locktime = 100
+
+	testCases := []struct {
+		name        string
+		peer        *testNode
+		msg         *lnwire.OpenChannel
+		expectState *coFundState
+		expectErr   string
+	}{
+		{
+			name: "single-funder",
+			peer: coFundPeer,
+			msg:  &lnwire.OpenChannel{},
+		},
+		{
+			name: "not negotiated",
+			peer: &testNode{},
+			msg: &lnwire.OpenChannel{
+				FundingFeeRate:  feeRate(1000),
+				FundingLocktime: locktime,
+			},
+			expectErr: errCoFundNotNegotiated.Error(),
+		},
+		{
+			name: "push amount",
+			peer: coFundPeer,
+			msg: &lnwire.OpenChannel{
+				PushAmount:      1000,
+				FundingFeeRate:  feeRate(1000),
+				FundingLocktime: locktime,
+			},
+			expectErr: "push amount",
+		},
+		{
+			name: "fee rate too low",
+			peer: coFundPeer,
+			msg: &lnwire.OpenChannel{
+				FundingFeeRate:  feeRate(1),
+				FundingLocktime: locktime,
+			},
+			expectErr: "below minimum",
+		},
+		{
+			name: "locktime missing",
+			peer: coFundPeer,
+			msg: &lnwire.OpenChannel{
+				FundingFeeRate: feeRate(1000),
+			},
+			expectErr: "locktime missing",
+		},
+		{
+			name: "co-funded",
+			peer: coFundPeer,
+			msg: &lnwire.OpenChannel{
+				FundingFeeRate:  feeRate(1000),
+				FundingLocktime: locktime,
+			},
+			expectState: &coFundState{
+				feeRate:  chainfee.SatPerKWeight(1000),
+				locktime: 100,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := &Manager{}
+			state, err := f.newFundeeCoFundState(tc.peer, tc.msg)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectState, state)
+		})
+	}
+}
+
+// TestNewCoFundStateValidation tests that local requests for co-funded
+// channels are rejected if the remote peer doesn't support them, or if they
+// ask for funding options that can't be used with co-funded channels.
+func TestNewCoFundStateValidation(t *testing.T) {
+	t.Parallel()
+
+	coFundPeer := &testNode{
+		localFeatures: []lnwire.FeatureBit{
+			lnwire.CoFundOptional,
+		},
+		remoteFeatures: []lnwire.FeatureBit{
+			lnwire.CoFundOptional,
+		},
+	}
+
+	f := &Manager{}
+
+	_, err := f.newCoFundState(&InitFundingMsg{Peer: &testNode{}})
+	require.ErrorIs(t, err, ErrCoFundNotSupported)
+
+	_, err = f.newCoFundState(&InitFundingMsg{
+		Peer:    coFundPeer,
+		PushAmt: 1000,
+	})
+	require.ErrorContains(t, err, "push")
+
+	_, err = f.newCoFundState(&InitFundingMsg{
+		Peer:         coFundPeer,
+		SubtractFees: true,
+	})
+	require.ErrorContains(t, err, "fixed local funding amount")
+}
@@ -1,6 +1,7 @@
 package funding
 
 import (
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -18,6 +19,12 @@ type Controller interface {
 	// IsPendingChannel returns whether a particular 32-byte identifier
 	// represents a pending channel in the Controller implementation.
 	IsPendingChannel([32]byte, lnpeer.Peer) bool
+
+	// AddSplicedChannel replaces the graph edge of a channel whose splice
+	// was locked with an edge for the new funding output with the given
+	// short channel id, and announces it to the network.
+	AddSplicedChannel(channel *channeldb.OpenChannel,
+		prevScid, scid lnwire.ShortChannelID) error
 }
 
 // aliasHandler is an interface that abstracts the managing of aliases.
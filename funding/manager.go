@@ -165,6 +165,10 @@ type reservationWithCtx struct {
 	// the channel.
 	channelType *lnwire.ChannelType
 
+	// coFund is the state of the interactive construction of the
+	// funding transaction. It's only set for co-funded channels.
+	coFund *coFundState
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -298,6 +302,11 @@ type InitFundingMsg struct {
 	// channel that will be useful to our future selves.
 	Memo []byte
 
+	// CoFund is true if the remote peer may contribute to the channel,
+	// in which case the funding transaction is constructed together with
+	// it. This requires the peer to support co-funded channel opens.
+	CoFund bool
+
 	// Updates is a channel which updates to the opening status of the
 	// channel are sent on.
 	Updates chan *lnrpc.OpenStatusUpdate
@@ -495,6 +504,10 @@ type Config struct {
 	// WUMBO you would like your channel.
 	MaxChanSize btcutil.Amount
 
+	// CoFundContribution is the amount we contribute from our wallet to
+	// inbound channels whose initiator asks for a co-funded open.
+	CoFundContribution btcutil.Amount
+
 	// MaxPendingChannels is the maximum number of pending channels we
 	// allow for each peer.
 	MaxPendingChannels int
@@ -634,6 +647,11 @@ const (
 	// channelReady message has been sent, but we still haven't announced
 	// the channel to the network.
 	addedToGraph
+
+	// spliceAddedToGraph is the opening state of a channel whose splice
+	// was locked if the edge of its new funding output has been added to
+	// the graph, but we still haven't announced it to the network.
+	spliceAddedToGraph
 )
 
 func (c channelOpeningState) String() string {
@@ -644,6 +662,8 @@ func (c channelOpeningState) String() string {
 		return "channelReadySent"
 	case addedToGraph:
 		return "addedToGraph"
+	case spliceAddedToGraph:
+		return "spliceAddedToGraph"
 	default:
 		return "unknown"
 	}
@@ -728,6 +748,14 @@ func (f *Manager) start() error {
 				channel.IsInitiator {
 
 				f.rebroadcastFundingTx(channel)
+			} else if chanType.IsDualFunder() ||
+				!chanType.HasFundingTx() {
+
+				// The funding transaction of a co-funded
+				// channel is rebroadcast by both parties once
+				// it's signed, as both may have inputs at
+				// stake.
+				f.rebroadcastCoFundTx(channel)
 			}
 		} else if channel.ChanType.IsSingleFunder() &&
 			channel.ChanType.HasFundingTx() &&
@@ -798,6 +826,29 @@ func (f *Manager) rebroadcastFundingTx(c *channeldb.OpenChannel) {
 	}
 }
 
+// rebroadcastCoFundTx publishes the funding tx of a pending co-funded
+// channel on startup if it was signed by both parties.
+func (f *Manager) rebroadcastCoFundTx(c *channeldb.OpenChannel) {
+	coFundTx, err := c.CoFundTx()
+	switch {
+	case errors.Is(err, channeldb.ErrCoFundTxNotFound):
+		return
+
+	case err != nil:
+		log.Errorf("Unable to fetch funding tx of ChannelPoint(%v): %v",
+			c.FundingOutpoint, err)
+		return
+
+	case !coFundTx.IsFinal():
+		return
+	}
+
+	log.Debugf("Rebroadcasting co-funded funding tx for "+
+		"ChannelPoint(%v)", c.FundingOutpoint)
+
+	f.publishCoFundTx(c, coFundTx.FundingTx)
+}
+
 // nextPendingChanID returns the next free pending channel ID to be used to
 // identify a particular future channel funding workflow.
 func (f *Manager) nextPendingChanID() [32]byte {
@@ -1014,6 +1065,23 @@ func (f *Manager) reservationCoordinator() {
 			case *lnwire.FundingSigned:
 				f.funderProcessFundingSigned(fmsg.peer, msg)
 
+			case *lnwire.TxAddInput, *lnwire.TxAddOutput,
+				*lnwire.TxRemoveInput, *lnwire.TxRemoveOutput,
+				*lnwire.TxComplete:
+
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.(lnwire.LinkUpdater),
+				)
+
+			case *lnwire.TxSignatures:
+				f.handleTxSignatures(fmsg.peer, msg)
+
+			case *lnwire.TxAbort:
+				f.handleTxAbort(fmsg.peer, msg)
+
+			case *lnwire.ChannelReestablish:
+				f.handleChanReestablish(fmsg.peer, msg)
+
 			case *lnwire.ChannelReady:
 				f.wg.Add(1)
 				go f.handleChannelReady(fmsg.peer, msg)
@@ -1231,6 +1299,34 @@ func (f *Manager) stateStep(channel *channeldb.OpenChannel,
 			"announced", chanID, shortChanID)
 
 		return nil
+
+	// The edge of the new funding output of a spliced channel was added
+	// to the graph, but it wasn't announced yet. Unlike a new channel,
+	// the channel is already usable and the peer was sent channel_ready
+	// for the original funding output.
+	case spliceAddedToGraph:
+		err := f.annAfterSixConfs(channel, shortChanID)
+		if err != nil {
+			return fmt.Errorf("error sending splice channel "+
+				"announcement: %v", err)
+		}
+
+		err = f.deleteChannelOpeningState(&channel.FundingOutpoint)
+		if err != nil {
+			return fmt.Errorf("error deleting channel state: %w",
+				err)
+		}
+
+		err = f.deleteInitialForwardingPolicy(chanID)
+		if err != nil {
+			log.Infof("Could not delete initial policy for chanId "+
+				"%x", chanID)
+		}
+
+		log.Debugf("Spliced Channel(%v) with ShortChanID %v: "+
+			"successfully announced", chanID, shortChanID)
+
+		return nil
 	}
 
 	return fmt.Errorf("undefined channelState: %v", channelState)
@@ -1506,6 +1602,30 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		msg.CsvDelay, msg.PendingChannelID,
 		peer.IdentityKey().SerializeCompressed())
 
+	// If the initiator asks us to contribute to the channel, we add the
+	// configured amount to it.
+	coFund, err := f.newFundeeCoFundState(peer, msg)
+	if err != nil {
+		log.Errorf("Unable to accept co-funded channel: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	var contribution btcutil.Amount
+	if coFund != nil {
+		contribution = f.cfg.CoFundContribution
+	}
+
+	// Our contribution mustn't make the channel too large either.
+	if amt+contribution > f.cfg.MaxChanSize {
+		f.failFundingFlow(
+			peer, cid, lnwallet.ErrChanTooLarge(
+				amt+contribution, f.cfg.MaxChanSize,
+			),
+		)
+		return
+	}
+
 	// Attempt to initialize a reservation within the wallet. If the wallet
 	// has insufficient resources to create the channel, then the
 	// reservation attempt may be rejected. Note that since we're on the
@@ -1626,6 +1746,14 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		ScidAliasFeature: scidFeatureVal,
 	}
 
+	// The funding transaction of a co-funded channel is constructed
+	// together with the initiator, at its fee rate.
+	if coFund != nil {
+		req.LocalFundingAmt = contribution
+		req.FundingFeePerKw = coFund.feeRate
+		req.CoFund = true
+	}
+
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
 	if err != nil {
 		log.Errorf("Unable to initialize reservation: %v", err)
@@ -1801,6 +1929,7 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		remoteChanReserve: chanReserve,
 		maxLocalCsv:       f.cfg.MaxLocalCSVDelay,
 		channelType:       chanType,
+		coFund:            coFund,
 		err:               make(chan error, 1),
 		peer:              peer,
 	}
@@ -1865,6 +1994,27 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		return
 	}
 
+	// With both contributions known, we're ready for the construction of
+	// the funding transaction of a co-funded channel, which is started
+	// by the initiator once it receives our response.
+	if coFund != nil {
+		intent, ok := reservation.InteractiveIntent()
+		if !ok {
+			err := errors.New("co-funded reservation without " +
+				"interactive funding intent")
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+
+		err := f.startInteractiveTx(resCtx, cid, intent)
+		if err != nil {
+			log.Errorf("Unable to start funding tx construction: "+
+				"%v", err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+	}
+
 	log.Infof("Sending fundingResp for pending_id(%x)",
 		msg.PendingChannelID)
 	log.Debugf("Remote party accepted commitment constraints: %v",
@@ -1898,6 +2048,11 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		)
 	}
 
+	if coFund != nil {
+		fundingAmt := lnwire.CoFundAmount(contribution)
+		fundingAccept.FundingAmount = &fundingAmt
+	}
+
 	if err := peer.SendMessage(true, &fundingAccept); err != nil {
 		log.Errorf("unable to send funding response to peer: %v", err)
 		f.failFundingFlow(peer, cid, err)
@@ -2053,11 +2208,29 @@ func (f *Manager) funderProcessAcceptChannel(peer lnpeer.Peer,
 		return
 	}
 
+	// If we asked the remote node to contribute to the channel, it must
+	// tell us how much it contributes. Otherwise, it can't contribute.
+	var remoteFundingAmt btcutil.Amount
+	switch {
+	case resCtx.coFund != nil:
+		if msg.FundingAmount == nil {
+			f.failFundingFlow(peer, cid, errNoFundingAmount)
+			return
+		}
+		remoteFundingAmt = btcutil.Amount(*msg.FundingAmount)
+
+	case msg.FundingAmount != nil:
+		err := errors.New("funding amount for single-funder channel")
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
 	// The remote node has responded with their portion of the channel
 	// contribution. At this point, we can process their contribution which
 	// allows us to construct and sign both the commitment transaction, and
 	// the funding transaction.
 	remoteContribution := &lnwallet.ChannelContribution{
+		FundingAmount:        remoteFundingAmt,
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
@@ -2104,6 +2277,21 @@ func (f *Manager) funderProcessAcceptChannel(peer lnpeer.Peer,
 
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 
+	// The funding transaction of a co-funded channel is constructed
+	// together with the remote node. Now that we know its contribution, we
+	// start the construction, which continues the funding flow once it's
+	// done.
+	if itxErr, ok := err.(*lnwallet.InteractiveFundingRequired); ok {
+		err := f.startInteractiveTx(resCtx, cid, itxErr.Intent)
+		if err != nil {
+			log.Errorf("Unable to start funding tx construction "+
+				"with %x: %v", peerKeyBytes, err)
+			f.failFundingFlow(peer, cid, err)
+		}
+
+		return
+	}
+
 	// The wallet has detected that a PSBT funding process was requested by
 	// the user and has halted the funding process after negotiating the
 	// multisig keys. We now have everything that is needed for the user to
@@ -2360,6 +2548,18 @@ func (f *Manager) fundeeProcessFundingCreated(peer lnpeer.Peer,
 	// Create the channel identifier without setting the active channel ID.
 	cid := newChanIdentifier(pendingChanID)
 
+	// The funding point of a co-funded channel must reference the
+	// funding transaction we constructed together with the initiator.
+	if resCtx.coFund != nil {
+		err := resCtx.coFund.verifyFundingPoint(fundingOut)
+		if err != nil {
+			log.Errorf("Invalid funding point for pending_id(%x): "+
+				"%v", pendingChanID[:], err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+	}
+
 	// For taproot channels, the commit signature is actually the partial
 	// signature. Otherwise, we can convert the ECDSA commit signature into
 	// our internal input.Signature type.
@@ -2498,6 +2698,16 @@ func (f *Manager) fundeeProcessFundingCreated(peer lnpeer.Peer,
 		return
 	}
 
+	// Now that both commitments are signed, the inputs of the funding
+	// transaction of a co-funded channel can be signed.
+	if resCtx.coFund != nil {
+		err := f.exchangeTxSigs(peer, resCtx.coFund, completeChan)
+		if err != nil {
+			log.Errorf("Unable to sign funding tx of "+
+				"ChannelPoint(%v): %v", fundingOut, err)
+		}
+	}
+
 	// With a permanent channel id established we can save the respective
 	// forwarding policy in the database. In the channel announcement phase
 	// this forwarding policy is retrieved and applied.
@@ -2649,6 +2859,17 @@ func (f *Manager) funderProcessFundingSigned(peer lnpeer.Peer,
 	// delete it from our set of active reservations.
 	f.deleteReservationCtx(peerKey, pendingChanID)
 
+	// The funding transaction of a co-funded channel is only published
+	// once the remote peer signed its inputs.
+	if resCtx.coFund != nil {
+		err := f.exchangeTxSigs(peer, resCtx.coFund, completeChan)
+		if err != nil {
+			log.Errorf("Unable to sign funding tx of "+
+				"ChannelPoint(%v): %v",
+				completeChan.FundingOutpoint, err)
+		}
+	}
+
 	// Broadcast the finalized funding transaction to the network, but only
 	// if we actually have the funding transaction.
 	if completeChan.ChanType.HasFundingTx() {
@@ -2834,8 +3055,10 @@ func (f *Manager) waitForFundingWithTimeout(
 
 	// If we are not the initiator, we have no money at stake and will
 	// timeout waiting for the funding transaction to confirm after a
-	// while.
-	if !ch.IsInitiator && !ch.IsZeroConf() {
+	// while. This doesn't apply if we contributed inputs to a co-funded
+	// channel, as the remote peer may still publish its funding
+	// transaction.
+	if !ch.IsInitiator && !ch.IsZeroConf() && !hasLocalFundingInputs(ch) {
 		f.wg.Add(1)
 		go f.waitForTimeout(ch, cancelChan, timeoutChan)
 	}
@@ -2862,6 +3085,24 @@ func (f *Manager) waitForFundingWithTimeout(
 	}
 }
 
+// hasLocalFundingInputs returns true if we signed inputs of the funding
+// transaction of the given co-funded channel.
+func hasLocalFundingInputs(ch *channeldb.OpenChannel) bool {
+	coFundTx, err := ch.CoFundTx()
+	switch {
+	case errors.Is(err, channeldb.ErrCoFundTxNotFound):
+		return false
+
+	// If we can't tell, we'll assume our inputs are at stake.
+	case err != nil:
+		log.Errorf("Unable to fetch funding tx of ChannelPoint(%v): %v",
+			ch.FundingOutpoint, err)
+		return true
+	}
+
+	return coFundTx.HasLocalInputs()
+}
+
 // makeFundingScript re-creates the funding script for the funding transaction
 // of the target channel.
 func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
@@ -3554,10 +3795,12 @@ func (f *Manager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 		// If this is a non-zero-conf option-scid-alias channel, we'll
 		// delete the mappings the gossiper uses so that ChannelUpdates
 		// with aliases won't be accepted. This is done elsewhere for
-		// zero-conf channels.
+		// zero-conf channels, and by AddSplicedChannel for the new
+		// funding output of spliced channels.
 		isScidFeature := completeChan.NegotiatedAliasFeature()
 		isZeroConf := completeChan.IsZeroConf()
-		if isScidFeature && !isZeroConf {
+		isSplice := *shortChanID != completeChan.ShortChanID()
+		if isScidFeature && !isZeroConf && !isSplice {
 			baseScid := completeChan.ShortChanID()
 			err := f.cfg.AliasManager.DeleteSixConfs(baseScid)
 			if err != nil {
@@ -4488,6 +4731,18 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		"min_confs=%v)", localAmt, msg.SubtractFees, msg.PushAmt,
 		msg.ChainHash, peerKey.SerializeCompressed(), msg.MinConfs)
 
+	// If the remote peer may contribute to the channel, we'll construct
+	// the funding transaction together with it.
+	var coFund *coFundState
+	if msg.CoFund {
+		var err error
+		coFund, err = f.newCoFundState(msg)
+		if err != nil {
+			msg.Err <- err
+			return
+		}
+	}
+
 	// We set the channel flags to indicate whether we want this channel to
 	// be announced to the network.
 	var channelFlags lnwire.FundingFlag
@@ -4634,6 +4889,7 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		OptionScidAlias:  scid,
 		ScidAliasFeature: scidFeatureVal,
 		Memo:             msg.Memo,
+		CoFund:           coFund != nil,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -4738,6 +4994,7 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		remoteChanReserve: chanReserve,
 		maxLocalCsv:       maxCSV,
 		channelType:       chanType,
+		coFund:            coFund,
 		reservation:       reservation,
 		peer:              msg.Peer,
 		updates:           msg.Updates,
@@ -4813,6 +5070,15 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		)
 	}
 
+	// The fee rate and the locktime of the funding transaction ask the
+	// remote peer to contribute to the channel.
+	if coFund != nil {
+		feeRate := lnwire.CoFundFeeRate(coFund.feeRate)
+		locktime := lnwire.CoFundLocktime(coFund.locktime)
+		fundingOpen.FundingFeeRate = &feeRate
+		fundingOpen.FundingLocktime = &locktime
+	}
+
 	if err := msg.Peer.SendMessage(true, &fundingOpen); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %w",
 			err)
@@ -5022,6 +5288,72 @@ func (f *Manager) IsPendingChannel(pendingChanID [32]byte,
 	return ok
 }
 
+// AddSplicedChannel replaces the graph edge of a channel whose splice was
+// locked with an edge for its new funding output, which is announced to the
+// network once it's buried deep enough. Our policy of the old edge is carried
+// over, with its maximum HTLC capped by the new capacity. The new short
+// channel id is persisted and reported to the switch afterwards.
+func (f *Manager) AddSplicedChannel(channel *channeldb.OpenChannel,
+	prevScid, scid lnwire.ShortChannelID) error {
+
+	chanPoint := channel.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	// If the opening state of the new funding output exists, the edge
+	// was already added and its announcement resumed on start up.
+	_, _, err := f.getChannelOpeningState(&chanPoint)
+	switch {
+	case errors.Is(err, channeldb.ErrChannelNotFound):
+		ourPolicy, err := f.cfg.DeleteAliasEdge(prevScid)
+		if err != nil {
+			return fmt.Errorf("unable to delete edge %v of "+
+				"spliced channel: %w", prevScid, err)
+		}
+
+		if ourPolicy != nil {
+			_, fwdMaxHTLC := f.extractAnnounceParams(channel)
+			if ourPolicy.MaxHTLC > fwdMaxHTLC {
+				ourPolicy.MaxHTLC = fwdMaxHTLC
+			}
+		}
+
+		log.Infof("Adding spliced ChannelPoint(%v) with scid=%v to "+
+			"graph", chanPoint, scid)
+
+		err = f.addToGraph(channel, &scid, nil, ourPolicy)
+		if err != nil {
+			return err
+		}
+
+		err = f.saveChannelOpeningState(
+			&chanPoint, spliceAddedToGraph, &scid,
+		)
+		if err != nil {
+			return fmt.Errorf("error setting channel state to "+
+				"spliceAddedToGraph: %w", err)
+		}
+
+		f.wg.Add(1)
+		go f.advanceFundingState(channel, chanID, nil)
+
+	case err != nil:
+		return err
+	}
+
+	if err := channel.MarkSpliceLocked(scid); err != nil {
+		return fmt.Errorf("unable to lock splice: %w", err)
+	}
+
+	// The link of the channel learns the SCID of its new funding output,
+	// so HTLCs can be forwarded over it.
+	err = f.cfg.ReportShortChanID(chanPoint)
+	if err != nil {
+		log.Errorf("Unable to report short chan id: %v", err)
+	}
+
+	return nil
+}
+
 func copyPubKey(pub *btcec.PublicKey) *btcec.PublicKey {
 	var tmp btcec.JacobianPoint
 	pub.AsJacobian(&tmp)
@@ -278,3 +278,7 @@ type DB interface {
 	// live.
 	MarkEdgeLive(chanID uint64) error
 }
+
+// A compile-time check to ensure that *channeldb.SQLGraphStore implements the
+// DB interface.
+var _ DB = (*channeldb.SQLGraphStore)(nil)
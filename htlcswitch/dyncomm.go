@@ -0,0 +1,767 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ErrDynCommitPending is returned when a dynamic commitment
+	// negotiation is requested while another one is still in progress.
+	ErrDynCommitPending = errors.New("dynamic commitment negotiation " +
+		"already in progress")
+
+	// ErrDynCommitConflict is returned when our dynamic commitment
+	// proposal is abandoned in favor of a concurrent proposal of the
+	// remote party.
+	ErrDynCommitConflict = errors.New("dynamic commitment proposal " +
+		"superseded by remote proposal")
+
+	// ErrDynCommitDisabled is returned when a dynamic commitment
+	// negotiation is requested but disabled for the link.
+	ErrDynCommitDisabled = errors.New("dynamic commitments are disabled")
+
+	// ErrDynCommitEmpty is returned when a dynamic commitment negotiation
+	// is requested without changing any of the channel parameters.
+	ErrDynCommitEmpty = errors.New("no channel parameters to update")
+
+	// ErrUpgradeDisabled is returned when an upgrade of the commitment
+	// type is requested or proposed, but not supported by both parties.
+	ErrUpgradeDisabled = errors.New("commitment type upgrades are " +
+		"disabled")
+
+	// ErrUpgradeNotInitiator is returned when an upgrade of the commitment
+	// type is requested by the party that didn't open the channel. The
+	// channel initiator pays for the kickoff transaction, so only it may
+	// propose an upgrade.
+	ErrUpgradeNotInitiator = errors.New("only the channel initiator can " +
+		"upgrade the commitment type")
+
+	// ErrUpgradeCombined is returned when an upgrade of the commitment
+	// type is combined with updates of other channel parameters.
+	ErrUpgradeCombined = errors.New("commitment type upgrades can't be " +
+		"combined with other parameter updates")
+
+	// errNoUpgradeNonce is returned when an upgrade message lacks the
+	// musig2 nonce of the sender.
+	errNoUpgradeNonce = errors.New("upgrade message has no nonce")
+
+	// errNoUpgradeSig is returned when an upgrade message lacks the
+	// partial signature of the sender.
+	errNoUpgradeSig = errors.New("upgrade message has no partial " +
+		"signature")
+)
+
+// DynCommitRejectedError is returned when the remote party rejects our dynamic
+// commitment proposal.
+type DynCommitRejectedError struct {
+	// Rejections is the set of TLV types of the DynPropose message that
+	// the remote party rejected.
+	Rejections *lnwire.RawFeatureVector
+}
+
+// Error returns a human-readable string describing the rejected parameters.
+//
+// NOTE: Part of the error interface.
+func (e *DynCommitRejectedError) Error() string {
+	var params []string
+	for _, typ := range dynProposeTypes {
+		if e.Rejections.IsSet(lnwire.FeatureBit(typ)) {
+			params = append(params, dynParamName(typ))
+		}
+	}
+
+	return fmt.Sprintf("remote party rejected dynamic commitment "+
+		"parameters: %v", strings.Join(params, ", "))
+}
+
+// dynProposeTypes is the list of TLV types that can be present in a
+// DynPropose message.
+var dynProposeTypes = []tlv.Type{
+	lnwire.DPDustLimitSatoshis,
+	lnwire.DPMaxHtlcValueInFlightMsat,
+	lnwire.DPChannelReserveSatoshis,
+	lnwire.DPToSelfDelay,
+	lnwire.DPMaxAcceptedHtlcs,
+	lnwire.DPFundingPubkey,
+	lnwire.DPChannelType,
+	lnwire.DPKickoffFeerate,
+}
+
+// dynParamName returns the name of the channel parameter identified by the
+// given DynPropose TLV type.
+func dynParamName(typ tlv.Type) string {
+	switch typ {
+	case lnwire.DPDustLimitSatoshis:
+		return "dust_limit"
+	case lnwire.DPMaxHtlcValueInFlightMsat:
+		return "max_value_in_flight"
+	case lnwire.DPChannelReserveSatoshis:
+		return "channel_reserve"
+	case lnwire.DPToSelfDelay:
+		return "csv_delay"
+	case lnwire.DPMaxAcceptedHtlcs:
+		return "max_accepted_htlcs"
+	case lnwire.DPFundingPubkey:
+		return "funding_key"
+	case lnwire.DPChannelType:
+		return "channel_type"
+	case lnwire.DPKickoffFeerate:
+		return "kickoff_feerate"
+	default:
+		return fmt.Sprintf("unknown(%d)", typ)
+	}
+}
+
+// DynCommitParams is the set of channel parameters that can be updated
+// through a dynamic commitment negotiation. Any parameter that is None is left
+// unchanged.
+type DynCommitParams struct {
+	// DustLimit is the new dust limit of our commitment transaction.
+	DustLimit fn.Option[btcutil.Amount]
+
+	// MaxValueInFlight is the new maximum value of HTLCs that the remote
+	// party may have pending towards us.
+	MaxValueInFlight fn.Option[lnwire.MilliSatoshi]
+
+	// ChannelReserve is the new reserve that the remote party must
+	// maintain.
+	ChannelReserve fn.Option[btcutil.Amount]
+
+	// MaxAcceptedHTLCs is the new maximum number of HTLCs that the remote
+	// party may have pending towards us.
+	MaxAcceptedHTLCs fn.Option[uint16]
+
+	// CsvDelay is the new delay that the remote party must wait before
+	// sweeping its own funds after a force close.
+	CsvDelay fn.Option[uint16]
+
+	// CommitmentType, if set, upgrades the commitment type of the channel.
+	// Only upgrades of private anchor channels to simple taproot channels
+	// are supported, which can't be combined with other parameters. See
+	// docs/dynamic_commitments.md for details.
+	CommitmentType fn.Option[lnwallet.CommitmentType]
+
+	// KickoffFeeRate is the fee rate of the kickoff transaction of a
+	// commitment type upgrade, which is paid by the channel initiator.
+	KickoffFeeRate fn.Option[chainfee.SatPerKWeight]
+}
+
+// isUpgrade returns true if the parameters upgrade the commitment type.
+func (p *DynCommitParams) isUpgrade() bool {
+	return p.CommitmentType.IsSome() || p.KickoffFeeRate.IsSome()
+}
+
+// dynCommitReq is a request to initiate a dynamic commitment negotiation.
+type dynCommitReq struct {
+	params DynCommitParams
+
+	// errChan is sent the outcome of the negotiation.
+	errChan chan error
+}
+
+// dynCommitNegotiation tracks a locally initiated dynamic commitment
+// negotiation that is awaiting a response from the remote party.
+type dynCommitNegotiation struct {
+	// proposal is the DynPropose message we sent to the remote party.
+	proposal *lnwire.DynPropose
+
+	// errChan, if set, is sent the outcome of the negotiation. This isn't
+	// set for a negotiation that was resumed on re-establish.
+	errChan chan error
+
+	// reenableAdds is true if we disabled outgoing adds for the duration
+	// of the negotiation, and so have to enable them again once it's
+	// over.
+	reenableAdds bool
+
+	// upgrade is set if we proposed an upgrade of the commitment type
+	// that wasn't signed yet. The link is quiescent until the negotiation
+	// is over, and stays so if the upgrade was signed.
+	upgrade *lnwallet.UpgradeCommitments
+}
+
+// newDynPropose creates the DynPropose message that initiates a dynamic
+// commitment negotiation for the passed parameters.
+func newDynPropose(chanID lnwire.ChannelID,
+	params DynCommitParams) *lnwire.DynPropose {
+
+	return &lnwire.DynPropose{
+		ChanID:           chanID,
+		Initiator:        true,
+		DustLimit:        params.DustLimit,
+		MaxValueInFlight: params.MaxValueInFlight,
+		ChannelReserve:   params.ChannelReserve,
+		MaxAcceptedHTLCs: params.MaxAcceptedHTLCs,
+		CsvDelay:         params.CsvDelay,
+	}
+}
+
+// proposedDynFields returns the set of TLV types that are present in the
+// passed DynPropose message.
+func proposedDynFields(dp *lnwire.DynPropose) *lnwire.RawFeatureVector {
+	fields := lnwire.NewRawFeatureVector()
+	setIf := func(present bool, typ tlv.Type) {
+		if present {
+			fields.Set(lnwire.FeatureBit(typ))
+		}
+	}
+
+	setIf(dp.DustLimit.IsSome(), lnwire.DPDustLimitSatoshis)
+	setIf(
+		dp.MaxValueInFlight.IsSome(), lnwire.DPMaxHtlcValueInFlightMsat,
+	)
+	setIf(dp.ChannelReserve.IsSome(), lnwire.DPChannelReserveSatoshis)
+	setIf(dp.CsvDelay.IsSome(), lnwire.DPToSelfDelay)
+	setIf(dp.MaxAcceptedHTLCs.IsSome(), lnwire.DPMaxAcceptedHtlcs)
+	setIf(dp.FundingKey.IsSome(), lnwire.DPFundingPubkey)
+	setIf(dp.ChannelType.IsSome(), lnwire.DPChannelType)
+	setIf(dp.KickoffFeerate.IsSome(), lnwire.DPKickoffFeerate)
+
+	return fields
+}
+
+// dynUpgradeTypes are the DynPropose TLV types that are used to upgrade the
+// commitment type of a channel. Such an upgrade moves the funds to a new
+// funding output via a kickoff transaction, so it's negotiated separately
+// from the other parameters, see upgrade.go.
+var dynUpgradeTypes = []tlv.Type{
+	lnwire.DPFundingPubkey, lnwire.DPChannelType, lnwire.DPKickoffFeerate,
+}
+
+// isUpgradePropose returns true if the passed DynPropose message upgrades the
+// commitment type of the channel.
+func isUpgradePropose(dp *lnwire.DynPropose) bool {
+	proposed := proposedDynFields(dp)
+	for _, typ := range dynUpgradeTypes {
+		if proposed.IsSet(lnwire.FeatureBit(typ)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyDynPropose returns the channel constraints of both parties after
+// applying the passed DynPropose message, which was sent by the given party.
+// The dust limit applies to the commitment of the sender, while all other
+// parameters constrain the recipient.
+func applyDynPropose(local, remote channeldb.ChannelConstraints,
+	dp *lnwire.DynPropose, sender lntypes.ChannelParty) (
+	channeldb.ChannelConstraints, channeldb.ChannelConstraints) {
+
+	senderCons, recipientCons := &local, &remote
+	if sender.IsRemote() {
+		senderCons, recipientCons = &remote, &local
+	}
+
+	dp.DustLimit.WhenSome(func(dustLimit btcutil.Amount) {
+		senderCons.DustLimit = dustLimit
+	})
+	dp.MaxValueInFlight.WhenSome(func(maxValue lnwire.MilliSatoshi) {
+		recipientCons.MaxPendingAmount = maxValue
+	})
+	dp.ChannelReserve.WhenSome(func(reserve btcutil.Amount) {
+		recipientCons.ChanReserve = reserve
+	})
+	dp.MaxAcceptedHTLCs.WhenSome(func(maxHtlcs uint16) {
+		recipientCons.MaxAcceptedHtlcs = maxHtlcs
+	})
+	dp.CsvDelay.WhenSome(func(csvDelay uint16) {
+		recipientCons.CsvDelay = csvDelay
+	})
+
+	return local, remote
+}
+
+// validateDynCommitment checks that the channel constraints resulting from
+// applying the passed dynamic commitment proposal are sane. Each proposed
+// parameter is verified with the same rules we use during channel funding,
+// while parameters that aren't updated are left as negotiated at funding time.
+// The CSV delay imposed on the recipient is limited by maxCSVDelay.
+func validateDynCommitment(dp *lnwire.DynPropose, local,
+	remote channeldb.ChannelConstraints, capacity btcutil.Amount,
+	recipient lntypes.ChannelParty, maxCSVDelay uint16) error {
+
+	recipientCons := local
+	if recipient.IsRemote() {
+		recipientCons = remote
+	}
+
+	// A new dust limit of the sender must be within the same bounds we
+	// enforce during channel funding.
+	maxWitnessLimit := lnwallet.DustLimitForSize(input.UnknownWitnessSize)
+	dustLimit := dp.DustLimit.UnwrapOr(maxWitnessLimit)
+	if dustLimit < maxWitnessLimit || dustLimit > 3*maxWitnessLimit {
+		return lnwallet.ErrInvalidDustLimit(dustLimit)
+	}
+
+	if dp.ChannelReserve.IsSome() {
+		maxChanReserve := capacity / 5
+		if recipientCons.ChanReserve > maxChanReserve {
+			return lnwallet.ErrChanReserveTooLarge(
+				recipientCons.ChanReserve, maxChanReserve,
+			)
+		}
+	}
+
+	if dp.CsvDelay.IsSome() {
+		switch {
+		case recipientCons.CsvDelay == 0:
+			return errors.New("csv delay must be positive")
+
+		case recipientCons.CsvDelay > maxCSVDelay:
+			return lnwallet.ErrCsvDelayTooLarge(
+				recipientCons.CsvDelay, maxCSVDelay,
+			)
+		}
+	}
+
+	const minNumHtlc = 5
+	if dp.MaxAcceptedHTLCs.IsSome() {
+		maxHtlcs := uint16(input.MaxHTLCNumber / 2)
+		switch {
+		case recipientCons.MaxAcceptedHtlcs > maxHtlcs:
+			return lnwallet.ErrMaxHtlcNumTooLarge(
+				recipientCons.MaxAcceptedHtlcs, maxHtlcs,
+			)
+
+		case recipientCons.MaxAcceptedHtlcs < minNumHtlc:
+			return lnwallet.ErrMaxHtlcNumTooSmall(
+				recipientCons.MaxAcceptedHtlcs, minNumHtlc,
+			)
+		}
+	}
+
+	if dp.MaxValueInFlight.IsSome() {
+		maxPending := recipientCons.MaxPendingAmount
+		minPending := minNumHtlc * recipientCons.MinHTLC
+		switch {
+		case maxPending > lnwire.NewMSatFromSatoshis(capacity):
+			return fmt.Errorf("max value in flight %v exceeds "+
+				"channel capacity %v", maxPending, capacity)
+
+		case maxPending < minPending:
+			return lnwallet.ErrMaxValueInFlightTooSmall(
+				maxPending, minPending,
+			)
+		}
+	}
+
+	// Finally, if either a dust limit or a channel reserve is updated, we
+	// require both channel reserves to be above both dust limits to avoid
+	// stuck channels.
+	if dp.DustLimit.IsNone() && dp.ChannelReserve.IsNone() {
+		return nil
+	}
+
+	minChanReserve := min(local.ChanReserve, remote.ChanReserve)
+	maxDustLimit := max(local.DustLimit, remote.DustLimit)
+	if minChanReserve < maxDustLimit {
+		return lnwallet.ErrChanReserveTooSmall(
+			minChanReserve, maxDustLimit,
+		)
+	}
+
+	return nil
+}
+
+// ProposeDynCommitment initiates a dynamic commitment negotiation with the
+// remote party to update the passed channel parameters. The returned channel
+// is sent the outcome of the negotiation.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ProposeDynCommitment(
+	params DynCommitParams) <-chan error {
+
+	req := &dynCommitReq{
+		params:  params,
+		errChan: make(chan error, 1),
+	}
+
+	select {
+	case l.dynCommitReqs <- req:
+	case <-l.quit:
+		req.errChan <- ErrLinkShuttingDown
+	}
+
+	return req.errChan
+}
+
+// handleDynCommitReq starts a dynamic commitment negotiation for the passed
+// request by sending our proposal to the remote party.
+func (l *channelLink) handleDynCommitReq(req *dynCommitReq) {
+	switch {
+	case l.cfg.DisallowDynCommitments:
+		req.errChan <- ErrDynCommitDisabled
+		return
+
+	case l.dynCommit != nil:
+		req.errChan <- ErrDynCommitPending
+		return
+
+	// If outgoing adds are already disabled, we're in the process of
+	// shutting down the channel.
+	case l.IsFlushing(Outgoing):
+		req.errChan <- ErrLinkShuttingDown
+		return
+
+	case !l.channel.IsChannelClean():
+		req.errChan <- lnwallet.ErrChanNotClean
+		return
+
+	case req.params.isUpgrade():
+		l.handleUpgradeReq(req)
+		return
+	}
+
+	proposal := newDynPropose(l.ChanID(), req.params)
+	if proposedDynFields(proposal).IsEmpty() {
+		req.errChan <- ErrDynCommitEmpty
+		return
+	}
+
+	// Before sending the proposal, make sure that the resulting
+	// constraints for the remote party are acceptable.
+	state := l.channel.State()
+	local, remote := applyDynPropose(
+		state.LocalChanCfg.ChannelConstraints,
+		state.RemoteChanCfg.ChannelConstraints, proposal,
+		lntypes.Local,
+	)
+	err := validateDynCommitment(
+		proposal, local, remote, state.Capacity, lntypes.Remote,
+		l.cfg.MaxRemoteCSVDelay,
+	)
+	if err != nil {
+		req.errChan <- fmt.Errorf("invalid channel parameters: %w",
+			err)
+		return
+	}
+
+	// We'll persist the proposal so we can resume the negotiation in case
+	// we're disconnected before receiving a response.
+	if err := state.StoreDynCommitProposal(proposal); err != nil {
+		req.errChan <- err
+		return
+	}
+
+	// If sending fails, we can't tell whether the proposal reached the
+	// remote party, so we'll keep it persisted to be reconciled on
+	// re-establish.
+	if err := l.sendDynPropose(proposal, req.errChan); err != nil {
+		l.log.Errorf("unable to send DynPropose: %v", err)
+		l.finishDynCommit(err)
+	}
+}
+
+// reconcileDynCommit compares the dynamic commitment height that the remote
+// party sent in its ChannelReestablish with our own. The recipient of a
+// proposal applies it before sending its DynAck, so if the DynAck was lost,
+// the remote party is one dynamic commitment ahead of us. In that case, we'll
+// apply our persisted proposal now, so that both parties agree on the channel
+// constraints before any new commitment is signed. If we're the one that's
+// ahead, the remote party will do the same on its end.
+func (l *channelLink) reconcileDynCommit(
+	remoteDynHeight fn.Option[lnwire.DynHeight]) error {
+
+	state := l.channel.State()
+	localHeight, err := state.DynCommitHeight()
+	if err != nil {
+		return err
+	}
+
+	remoteHeight := uint64(remoteDynHeight.UnwrapOr(0))
+	switch {
+	case remoteHeight == localHeight, remoteHeight+1 == localHeight:
+		return nil
+
+	case remoteHeight != localHeight+1:
+		return fmt.Errorf("unable to reconcile dynamic commitment "+
+			"height %v with remote height %v", localHeight,
+			remoteHeight)
+	}
+
+	proposal, err := state.DynCommitProposal()
+	if err != nil {
+		return err
+	}
+	if proposal.IsNone() {
+		return fmt.Errorf("remote dynamic commitment height %v is "+
+			"ahead of ours without a pending proposal",
+			remoteHeight)
+	}
+
+	dp := proposal.UnsafeFromSome()
+	local, remote := applyDynPropose(
+		state.LocalChanCfg.ChannelConstraints,
+		state.RemoteChanCfg.ChannelConstraints, &dp, lntypes.Local,
+	)
+	if err := l.channel.ApplyDynCommitment(local, remote); err != nil {
+		return fmt.Errorf("unable to apply dynamic commitment: %w",
+			err)
+	}
+
+	l.log.Infof("Applied dynamic commitment accepted by remote party "+
+		"before re-establish: local_constraints=%v, "+
+		"remote_constraints=%v", local, remote)
+
+	return nil
+}
+
+// resumeDynCommit re-sends any dynamic commitment proposal that we persisted
+// before the link was last stopped. As the remote party doesn't persist
+// incoming proposals, it expects us to start over on re-establish. Any
+// proposal that the remote party already accepted was applied by
+// reconcileDynCommit at this point, so the remaining one wasn't accepted yet.
+func (l *channelLink) resumeDynCommit() {
+	state := l.channel.State()
+	proposal, err := state.DynCommitProposal()
+	if err != nil {
+		l.log.Errorf("unable to fetch dynamic commitment proposal: %v",
+			err)
+		return
+	}
+
+	proposal.WhenSome(func(dp lnwire.DynPropose) {
+		// If new updates were added in the meantime, we can't resume
+		// the negotiation, so we'll drop the proposal instead.
+		if !l.channel.IsChannelClean() || l.IsFlushing(Outgoing) {
+			l.log.Infof("Dropping dynamic commitment proposal as " +
+				"the channel is no longer clean")
+
+			err := state.DeleteDynCommitProposal()
+			if err != nil {
+				l.log.Errorf("unable to delete dynamic "+
+					"commitment proposal: %v", err)
+			}
+
+			return
+		}
+
+		l.log.Infof("Resuming dynamic commitment negotiation")
+
+		if err := l.sendDynPropose(&dp, nil); err != nil {
+			l.log.Errorf("unable to send DynPropose: %v", err)
+			l.finishDynCommit(err)
+		}
+	})
+}
+
+// sendDynPropose disables outgoing adds and sends the passed proposal to the
+// remote party.
+func (l *channelLink) sendDynPropose(proposal *lnwire.DynPropose,
+	errChan chan error) error {
+
+	l.dynCommit = &dynCommitNegotiation{
+		proposal:     proposal,
+		errChan:      errChan,
+		reenableAdds: l.DisableAdds(Outgoing),
+	}
+
+	return l.cfg.Peer.SendMessage(false, proposal)
+}
+
+// finishDynCommit concludes the current dynamic commitment negotiation with
+// the passed result.
+func (l *channelLink) finishDynCommit(result error) {
+	if l.dynCommit == nil {
+		return
+	}
+
+	if l.dynCommit.reenableAdds {
+		l.EnableAdds(Outgoing)
+	}
+
+	if l.dynCommit.errChan != nil {
+		l.dynCommit.errChan <- result
+	}
+
+	// An upgrade that wasn't signed is abandoned, so the channel can be
+	// updated again.
+	upgrade := l.dynCommit.upgrade
+	l.dynCommit = nil
+
+	if upgrade != nil {
+		l.resumeFromQuiescence()
+	}
+}
+
+// abortDynCommit removes our persisted proposal and concludes the current
+// dynamic commitment negotiation with the passed error.
+func (l *channelLink) abortDynCommit(reason error) {
+	if err := l.channel.State().DeleteDynCommitProposal(); err != nil {
+		l.log.Errorf("unable to delete dynamic commitment proposal: %v",
+			err)
+	}
+
+	l.finishDynCommit(reason)
+}
+
+// handleDynPropose processes a dynamic commitment proposal of the remote
+// party. If the proposal is acceptable, the new constraints are applied and a
+// DynAck is sent back. Otherwise, we'll respond with a DynReject.
+func (l *channelLink) handleDynPropose(msg *lnwire.DynPropose) {
+	// We never send a counter proposal, so we only expect proposals that
+	// start a negotiation.
+	if !msg.Initiator {
+		l.log.Warnf("Ignoring DynPropose from non-initiator")
+		return
+	}
+
+	// If both of us sent a proposal at the same time, the proposal of the
+	// channel initiator wins, and the other party drops its own.
+	if l.dynCommit != nil {
+		if l.channel.IsInitiator() {
+			l.log.Infof("Ignoring concurrent DynPropose from " +
+				"remote party")
+			return
+		}
+
+		l.abortDynCommit(ErrDynCommitConflict)
+	}
+
+	if isUpgradePropose(msg) {
+		l.handleUpgradePropose(msg)
+		return
+	}
+
+	if err := l.checkDynPropose(msg); err != nil {
+		l.log.Infof("Rejecting dynamic commitment proposal: %v", err)
+		l.sendDynReject(msg)
+
+		return
+	}
+
+	// The proposal is acceptable, so we'll apply the new constraints
+	// right away. Since the channel is clean, they'll govern the next
+	// commitment that is signed by either party. Applying them increments
+	// our dynamic commitment height, so if our DynAck doesn't make it to
+	// the remote party, it'll still apply its proposal on re-establish.
+	state := l.channel.State()
+	local, remote := applyDynPropose(
+		state.LocalChanCfg.ChannelConstraints,
+		state.RemoteChanCfg.ChannelConstraints, msg, lntypes.Remote,
+	)
+	if err := l.channel.ApplyDynCommitment(local, remote); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to apply dynamic commitment: %v", err)
+		return
+	}
+
+	l.log.Infof("Applied dynamic commitment proposed by remote party: "+
+		"local_constraints=%v, remote_constraints=%v", local, remote)
+
+	err := l.cfg.Peer.SendMessage(false, &lnwire.DynAck{
+		ChanID: l.ChanID(),
+	})
+	if err != nil {
+		l.log.Errorf("unable to send DynAck: %v", err)
+	}
+}
+
+// sendDynReject rejects all parameters of the passed proposal of the remote
+// party.
+func (l *channelLink) sendDynReject(msg *lnwire.DynPropose) {
+	err := l.cfg.Peer.SendMessage(false, &lnwire.DynReject{
+		ChanID:           l.ChanID(),
+		UpdateRejections: *proposedDynFields(msg),
+	})
+	if err != nil {
+		l.log.Errorf("unable to send DynReject: %v", err)
+	}
+}
+
+// checkDynPropose returns an error if we can't accept the passed proposal of
+// the remote party.
+func (l *channelLink) checkDynPropose(msg *lnwire.DynPropose) error {
+	switch {
+	case l.cfg.DisallowDynCommitments:
+		return ErrDynCommitDisabled
+
+	case l.IsFlushing(Outgoing):
+		return ErrLinkShuttingDown
+
+	// The channel is quiescent while its commitment type is upgraded.
+	case l.quiescent:
+		return ErrQuiescenceInProgress
+
+	case !l.channel.IsChannelClean():
+		return lnwallet.ErrChanNotClean
+	}
+
+	state := l.channel.State()
+	local, remote := applyDynPropose(
+		state.LocalChanCfg.ChannelConstraints,
+		state.RemoteChanCfg.ChannelConstraints, msg, lntypes.Remote,
+	)
+
+	return validateDynCommitment(
+		msg, local, remote, state.Capacity, lntypes.Local,
+		l.cfg.MaxLocalCSVDelay,
+	)
+}
+
+// handleDynAck processes the remote party's acceptance of our outstanding
+// dynamic commitment proposal, and applies the new constraints.
+func (l *channelLink) handleDynAck(msg *lnwire.DynAck) {
+	switch {
+	// The remote party acknowledges the proposal of an upgrade that we
+	// already signed, which we re-sent along with our kickoff_sig.
+	case l.dynCommit == nil && msg.PartialSig.IsSome():
+		l.log.Debugf("Ignoring DynAck for re-sent upgrade proposal")
+		return
+
+	case l.dynCommit == nil:
+		l.log.Warnf("Received DynAck without pending proposal")
+		return
+
+	case l.dynCommit.upgrade != nil:
+		l.handleUpgradeAck(msg)
+		return
+	}
+
+	state := l.channel.State()
+	local, remote := applyDynPropose(
+		state.LocalChanCfg.ChannelConstraints,
+		state.RemoteChanCfg.ChannelConstraints, l.dynCommit.proposal,
+		lntypes.Local,
+	)
+	if err := l.channel.ApplyDynCommitment(local, remote); err != nil {
+		l.finishDynCommit(err)
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to apply dynamic commitment: %v", err)
+		return
+	}
+
+	l.log.Infof("Applied dynamic commitment: local_constraints=%v, "+
+		"remote_constraints=%v", local, remote)
+
+	l.finishDynCommit(nil)
+}
+
+// handleDynReject processes the remote party's rejection of our outstanding
+// dynamic commitment proposal.
+func (l *channelLink) handleDynReject(msg *lnwire.DynReject) {
+	if l.dynCommit == nil {
+		l.log.Warnf("Received DynReject without pending proposal")
+		return
+	}
+
+	rejections := msg.UpdateRejections.Clone()
+
+	l.abortDynCommit(&DynCommitRejectedError{
+		Rejections: rejections,
+	})
+}
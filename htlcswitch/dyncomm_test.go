@@ -0,0 +1,455 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApplyDynPropose tests that the parameters of a DynPropose message are
+// applied to the constraints of the correct party.
+func TestApplyDynPropose(t *testing.T) {
+	t.Parallel()
+
+	local := channeldb.ChannelConstraints{
+		DustLimit:        354,
+		ChanReserve:      1000,
+		MaxPendingAmount: 100_000,
+		MaxAcceptedHtlcs: 483,
+		CsvDelay:         144,
+	}
+	remote := channeldb.ChannelConstraints{
+		DustLimit:        573,
+		ChanReserve:      2000,
+		MaxPendingAmount: 200_000,
+		MaxAcceptedHtlcs: 30,
+		CsvDelay:         288,
+	}
+
+	dp := &lnwire.DynPropose{
+		DustLimit:        fn.Some(btcutil.Amount(600)),
+		MaxValueInFlight: fn.Some(lnwire.MilliSatoshi(50_000)),
+		ChannelReserve:   fn.Some(btcutil.Amount(5000)),
+		MaxAcceptedHTLCs: fn.Some(uint16(50)),
+		CsvDelay:         fn.Some(uint16(400)),
+	}
+
+	// If we sent the proposal, the dust limit applies to our commitment,
+	// while all other parameters constrain the remote party.
+	newLocal, newRemote := applyDynPropose(local, remote, dp, lntypes.Local)
+
+	expectedLocal := local
+	expectedLocal.DustLimit = 600
+	require.Equal(t, expectedLocal, newLocal)
+
+	expectedRemote := remote
+	expectedRemote.MaxPendingAmount = 50_000
+	expectedRemote.ChanReserve = 5000
+	expectedRemote.MaxAcceptedHtlcs = 50
+	expectedRemote.CsvDelay = 400
+	require.Equal(t, expectedRemote, newRemote)
+
+	// If the remote party sent the proposal, it's the other way around.
+	newLocal, newRemote = applyDynPropose(local, remote, dp, lntypes.Remote)
+
+	expectedLocal = local
+	expectedLocal.MaxPendingAmount = 50_000
+	expectedLocal.ChanReserve = 5000
+	expectedLocal.MaxAcceptedHtlcs = 50
+	expectedLocal.CsvDelay = 400
+	require.Equal(t, expectedLocal, newLocal)
+
+	expectedRemote = remote
+	expectedRemote.DustLimit = 600
+	require.Equal(t, expectedRemote, newRemote)
+}
+
+// TestDynCommitNegotiation tests that two links are able to update the
+// parameters of their channel through a dynamic commitment negotiation, and
+// that the channel remains usable afterwards.
+func TestDynCommitNegotiation(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	const (
+		newMaxInFlight = lnwire.MilliSatoshi(100_000_000)
+		newMaxHtlcs    = uint16(100)
+	)
+
+	errChan := n.aliceChannelLink.ProposeDynCommitment(DynCommitParams{
+		MaxValueInFlight: fn.Some(newMaxInFlight),
+		MaxAcceptedHTLCs: fn.Some(newMaxHtlcs),
+	})
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+
+	// Both parties should now agree on the constraints of Bob.
+	aliceState := channels.aliceToBob.State()
+	require.Equal(
+		t, newMaxInFlight, aliceState.RemoteChanCfg.MaxPendingAmount,
+	)
+	require.Equal(
+		t, newMaxHtlcs, aliceState.RemoteChanCfg.MaxAcceptedHtlcs,
+	)
+
+	bobState := channels.bobToAlice.State()
+	require.Equal(
+		t, newMaxInFlight, bobState.LocalChanCfg.MaxPendingAmount,
+	)
+	require.Equal(t, newMaxHtlcs, bobState.LocalChanCfg.MaxAcceptedHtlcs)
+
+	// Alice should have enabled outgoing adds again, and the proposal
+	// should no longer be persisted.
+	require.False(t, n.aliceChannelLink.IsFlushing(Outgoing))
+
+	proposal, err := aliceState.DynCommitProposal()
+	require.NoError(t, err)
+	require.True(t, proposal.IsNone())
+
+	// Finally, the channel should still be usable for payments.
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.firstBobChannelLink,
+	)
+	_, err = makePayment(
+		n.aliceServer, n.bobServer, n.firstBobChannelLink.ShortChanID(),
+		hops, amount, htlcAmt, totalTimelock,
+	).Wait(30 * time.Second)
+	require.NoError(t, err, "unable to make the payment")
+}
+
+// TestDynCommitRejected tests that a dynamic commitment proposal that is
+// rejected by the remote party leaves the channel unchanged.
+func TestDynCommitRejected(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// Bob doesn't support dynamic commitments, so he'll reject any
+	// proposal.
+	n.firstBobChannelLink.cfg.DisallowDynCommitments = true
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	oldConstraints := channels.aliceToBob.State().RemoteChanCfg
+
+	errChan := n.aliceChannelLink.ProposeDynCommitment(DynCommitParams{
+		MaxValueInFlight: fn.Some(lnwire.MilliSatoshi(100_000_000)),
+		MaxAcceptedHTLCs: fn.Some(uint16(100)),
+	})
+
+	var rejectErr *DynCommitRejectedError
+	select {
+	case err := <-errChan:
+		require.ErrorAs(t, err, &rejectErr)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+
+	require.True(t, rejectErr.Rejections.IsSet(
+		lnwire.FeatureBit(lnwire.DPMaxHtlcValueInFlightMsat),
+	))
+	require.True(t, rejectErr.Rejections.IsSet(
+		lnwire.FeatureBit(lnwire.DPMaxAcceptedHtlcs),
+	))
+
+	// The constraints shouldn't have changed, outgoing adds should be
+	// enabled again, and the proposal should no longer be persisted.
+	aliceState := channels.aliceToBob.State()
+	require.Equal(t, oldConstraints, aliceState.RemoteChanCfg)
+	require.False(t, n.aliceChannelLink.IsFlushing(Outgoing))
+
+	proposal, err := aliceState.DynCommitProposal()
+	require.NoError(t, err)
+	require.True(t, proposal.IsNone())
+}
+
+// TestDynCommitRejectCombinedUpgrade tests that we reject a dynamic
+// commitment proposal that combines an upgrade of the commitment type with
+// other parameters.
+func TestDynCommitRejectCombinedUpgrade(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// We'll intercept the response that Alice receives from Bob.
+	rejects := make(chan *lnwire.DynReject, 1)
+	n.aliceServer.intersect(func(m lnwire.Message) (bool, error) {
+		if msg, ok := m.(*lnwire.DynReject); ok {
+			rejects <- msg
+		}
+
+		return false, nil
+	})
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	oldConstraints := channels.bobToAlice.State().LocalChanCfg
+
+	// We'll deliver a proposal that updates the channel type as well as
+	// the number of accepted HTLCs directly to Bob.
+	chanType := lnwire.ChannelType(*lnwire.NewRawFeatureVector(
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+	))
+	n.firstBobChannelLink.HandleChannelUpdate(&lnwire.DynPropose{
+		ChanID:           n.firstBobChannelLink.ChanID(),
+		Initiator:        true,
+		ChannelType:      fn.Some(chanType),
+		MaxAcceptedHTLCs: fn.Some(uint16(100)),
+	})
+
+	select {
+	case msg := <-rejects:
+		require.True(t, msg.UpdateRejections.IsSet(
+			lnwire.FeatureBit(lnwire.DPChannelType),
+		))
+		require.True(t, msg.UpdateRejections.IsSet(
+			lnwire.FeatureBit(lnwire.DPMaxAcceptedHtlcs),
+		))
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("bob didn't reject the proposal")
+	}
+
+	require.Equal(
+		t, oldConstraints, channels.bobToAlice.State().LocalChanCfg,
+	)
+}
+
+// TestValidateDynCommitmentCsvDelay tests that a proposed CSV delay must be
+// positive and within the maximum of the recipient.
+func TestValidateDynCommitmentCsvDelay(t *testing.T) {
+	t.Parallel()
+
+	cons := channeldb.ChannelConstraints{
+		DustLimit:        573,
+		ChanReserve:      10_000,
+		MaxPendingAmount: 100_000_000,
+		MinHTLC:          1,
+		MaxAcceptedHtlcs: 483,
+		CsvDelay:         144,
+	}
+
+	testCases := []struct {
+		name     string
+		csvDelay uint16
+		valid    bool
+	}{
+		{name: "valid", csvDelay: 288, valid: true},
+		{name: "maximum", csvDelay: 2016, valid: true},
+		{name: "zero", csvDelay: 0},
+		{name: "too large", csvDelay: 2017},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dp := &lnwire.DynPropose{
+				CsvDelay: fn.Some(tc.csvDelay),
+			}
+			local, remote := applyDynPropose(
+				cons, cons, dp, lntypes.Remote,
+			)
+			err := validateDynCommitment(
+				dp, local, remote, btcutil.SatoshiPerBitcoin,
+				lntypes.Local, 2016,
+			)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestDynCommitCsvDelay tests that a dynamic commitment can update the CSV
+// delay of a channel, and that the previous delay is kept for the revoked
+// commitments.
+func TestDynCommitCsvDelay(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	bobState := channels.bobToAlice.State()
+	oldCsvDelay := bobState.LocalChanCfg.CsvDelay
+	oldHeight := bobState.LocalCommitment.CommitHeight
+	newCsvDelay := oldCsvDelay + 100
+
+	errChan := n.aliceChannelLink.ProposeDynCommitment(DynCommitParams{
+		CsvDelay: fn.Some(newCsvDelay),
+	})
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+
+	// Both parties should now agree on the CSV delay of Bob.
+	aliceState := channels.aliceToBob.State()
+	require.Equal(t, newCsvDelay, aliceState.RemoteChanCfg.CsvDelay)
+	require.Equal(t, newCsvDelay, bobState.LocalChanCfg.CsvDelay)
+
+	// The channel should still be usable for payments, which moves the
+	// commitments of both parties past the change.
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.firstBobChannelLink,
+	)
+	_, err = makePayment(
+		n.aliceServer, n.bobServer, n.firstBobChannelLink.ShortChanID(),
+		hops, amount, htlcAmt, totalTimelock,
+	).Wait(30 * time.Second)
+	require.NoError(t, err, "unable to make the payment")
+
+	// Both parties should still use the old delay for the commitments of
+	// Bob that were signed before the change, and the new one for all
+	// commitments after it.
+	newHeight := bobState.LocalCommitment.CommitHeight
+	require.Greater(t, newHeight, oldHeight)
+
+	for _, state := range []*channeldb.OpenChannel{aliceState, bobState} {
+		party := lntypes.Local
+		if state == aliceState {
+			party = lntypes.Remote
+		}
+
+		require.Equal(
+			t, oldCsvDelay, state.CommitCsvDelay(party, oldHeight),
+		)
+		require.Equal(
+			t, newCsvDelay, state.CommitCsvDelay(party, newHeight),
+		)
+	}
+}
+
+// TestDynCommitAckLost tests that a dynamic commitment that was accepted by
+// the remote party is applied on re-establish if its DynAck never reached us,
+// so that both parties end up with the same channel constraints.
+func TestDynCommitAckLost(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	const chanReserve = btcutil.SatoshiPerBitcoin * 1
+	harness, err := newSingleLinkTestHarness(t, chanAmt, chanReserve)
+	require.NoError(t, err)
+
+	require.NoError(t, harness.start())
+	defer harness.aliceLink.Stop()
+
+	alice := newPersistentLinkHarness(t, harness.aliceSwitch,
+		harness.aliceLink, harness.aliceBatchTicker,
+		harness.aliceRestore,
+	)
+
+	const newMaxHtlcs = uint16(100)
+	alice.link.ProposeDynCommitment(DynCommitParams{
+		MaxAcceptedHTLCs: fn.Some(newMaxHtlcs),
+	})
+
+	var msg lnwire.Message
+	select {
+	case msg = <-alice.msgs:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("did not receive message")
+	}
+
+	proposal, ok := msg.(*lnwire.DynPropose)
+	require.True(t, ok)
+
+	// Bob accepts the proposal and applies it, but his DynAck never
+	// reaches Alice.
+	bobState := harness.bobChannel.State()
+	bobLocal, bobRemote := applyDynPropose(
+		bobState.LocalChanCfg.ChannelConstraints,
+		bobState.RemoteChanCfg.ChannelConstraints, proposal,
+		lntypes.Remote,
+	)
+	err = harness.bobChannel.ApplyDynCommitment(bobLocal, bobRemote)
+	require.NoError(t, err)
+
+	// Alice still has her proposal pending when she restarts.
+	alice.restart(false, true)
+
+	select {
+	case msg = <-alice.msgs:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("did not receive message")
+	}
+
+	_, ok = msg.(*lnwire.ChannelReestablish)
+	require.True(t, ok)
+
+	// Bob's ChannelReestablish tells Alice that he applied her proposal,
+	// so she should apply it as well instead of re-sending it.
+	bobReest, err := bobState.ChanSyncMsg()
+	require.NoError(t, err)
+	require.Equal(t, fn.Some(lnwire.DynHeight(1)), bobReest.DynHeight)
+	alice.link.HandleChannelUpdate(bobReest)
+
+	aliceState := alice.channel.State()
+	require.Eventually(t, func() bool {
+		proposal, err := aliceState.DynCommitProposal()
+		require.NoError(t, err)
+
+		return proposal.IsNone()
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// The new constraints should have been persisted along with the
+	// incremented dynamic commitment height.
+	channels, err := aliceState.Db.FetchOpenChannels(aliceState.IdentityPub)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+
+	remoteCfg := channels[0].RemoteChanCfg
+	require.Equal(t, newMaxHtlcs, remoteCfg.MaxAcceptedHtlcs)
+	require.Equal(t, bobLocal, remoteCfg.ChannelConstraints)
+
+	dynHeight, err := channels[0].DynCommitHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, dynHeight)
+}
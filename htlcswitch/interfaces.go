@@ -100,7 +100,8 @@ type scidAliasHandler interface {
 	// bit was negotiated.
 	negotiatedAliasFeature() bool
 
-	// confirmedScid returns the confirmed SCID for a zero-conf channel.
+	// confirmedScid returns the confirmed SCID of the channel. This is
+	// the SCID of the latest funding output once a splice is locked.
 	confirmedScid() lnwire.ShortChannelID
 
 	// zeroConfConfirmed returns whether or not the zero-conf channel has
@@ -168,6 +169,17 @@ type ChannelUpdateHandler interface {
 	// will only ever be called once. If no CommitSig is owed in the
 	// argument's LinkDirection, then we will call this hook immediately.
 	OnCommitOnce(LinkDirection, func())
+
+	// ProposeDynCommitment initiates a dynamic commitment negotiation with
+	// the remote peer to update the given channel parameters. The returned
+	// channel is sent the outcome of the negotiation.
+	ProposeDynCommitment(DynCommitParams) <-chan error
+
+	// InitStfu brings the channel to quiescence and then hands control to
+	// the passed protocol. The returned channel is sent nil once the
+	// protocol was started, or an error if quiescence couldn't be
+	// reached.
+	InitStfu(QuiescentProtocol) <-chan error
 }
 
 // CommitHookID is a value that is used to uniquely identify hooks in the
@@ -427,3 +439,13 @@ type htlcNotifier interface {
 	NotifyFinalHtlcEvent(key models.CircuitKey,
 		info channeldb.FinalHtlcInfo)
 }
+
+// ReputationChecker decides whether the endorsed htlcs of an incoming channel
+// may be forwarded as endorsed, which allows them to use the protected
+// resources of the outgoing channel.
+type ReputationChecker interface {
+	// IsReputable returns whether the incoming channel has the reputation
+	// to have its endorsed htlcs forwarded to the outgoing channel as
+	// endorsed.
+	IsReputable(incoming, outgoing lnwire.ShortChannelID) bool
+}
@@ -280,9 +280,59 @@ type ChannelLinkConfig struct {
 	// invalid.
 	DisallowRouteBlinding bool
 
+	// DisallowDynCommitments, if set, causes the link to reject any
+	// dynamic commitment proposal of the remote party and to refuse
+	// initiating a negotiation itself.
+	DisallowDynCommitments bool
+
+	// MaxLocalCSVDelay is the maximum CSV delay that we accept on our own
+	// commitments when the remote party proposes a dynamic commitment.
+	MaxLocalCSVDelay uint16
+
+	// MaxRemoteCSVDelay is the maximum CSV delay that we impose on the
+	// remote party's commitments when proposing a dynamic commitment.
+	MaxRemoteCSVDelay uint16
+
+	// DisallowCommitUpgrades, if set, causes the link to reject any
+	// proposal of the remote party to upgrade the commitment type, and to
+	// refuse proposing one itself.
+	DisallowCommitUpgrades bool
+
+	// PublishKickoff broadcasts the kickoff transaction of an upgrade of
+	// the commitment type.
+	PublishKickoff func(*wire.MsgTx) error
+
+	// NotifyUpgradeSigned is called once an upgrade of the commitment
+	// type was signed and persisted, so the channel can be moved onto the
+	// new funding output once the kickoff transaction confirms.
+	NotifyUpgradeSigned func()
+
+	// DisallowQuiescence, if set, causes the link to refuse bringing the
+	// channel to quiescence, and to treat any stfu message of the remote
+	// party as a protocol violation.
+	DisallowQuiescence bool
+
+	// QuiescentProtocol is the protocol that is run once the channel has
+	// reached quiescence on request of the remote party. If it isn't set,
+	// such a request results in a disconnect.
+	QuiescentProtocol QuiescentProtocol
+
+	// StartQuiescent, if set, causes the link to start out quiescent
+	// without running any quiescent protocol. It's passed the function
+	// that resumes the link, which must be called once the channel can be
+	// updated again. It's used for channels with a pending splice whose
+	// transaction wasn't signed by both parties yet.
+	StartQuiescent func(resume func())
+
 	// MaxFeeExposure is the threshold in milli-satoshis after which we'll
 	// restrict the flow of HTLCs and fee updates.
 	MaxFeeExposure lnwire.MilliSatoshi
+
+	// Endorsement, if set, enables the experimental endorsement signal.
+	// The link then endorses the htlcs that it originates or forwards on
+	// behalf of reputable channels, and restricts unendorsed htlcs to the
+	// general bucket of the channel's slots and liquidity.
+	Endorsement *EndorsementConfig
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -385,6 +435,62 @@ type channelLink struct {
 	// our next CommitSig.
 	incomingCommitHooks hookMap
 
+	// dynCommitReqs is used to request a new dynamic commitment
+	// negotiation from the htlcManager.
+	dynCommitReqs chan *dynCommitReq
+
+	// commitUpgrade holds the new commitments of an upgrade of the
+	// commitment type that we accepted, until the remote party sends its
+	// kickoff_sig.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	commitUpgrade *lnwallet.UpgradeCommitments
+
+	// dynCommit tracks the locally initiated dynamic commitment
+	// negotiation that is currently in progress, if any.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	dynCommit *dynCommitNegotiation
+
+	// quiescer tracks the progress of the quiescence protocol.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	quiescer *quiescer
+
+	// quiescenceReqs is used to request bringing the channel to
+	// quiescence from the htlcManager.
+	quiescenceReqs chan *quiescenceReq
+
+	// quiescenceReq is our outstanding request to bring the channel to
+	// quiescence, if any.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	quiescenceReq *quiescenceReq
+
+	// quiescenceResumes is used by the quiescent protocol to signal that
+	// it's done, and updates may be sent again.
+	quiescenceResumes chan struct{}
+
+	// quiescent is true while the quiescent protocol is running.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	quiescent bool
+
+	// quiescentMsgs holds the upstream messages that were received while
+	// the quiescent protocol was running, to be processed once the channel
+	// resumes. The remote party may resume before we do, so its first
+	// updates can race with the end of our quiescent protocol.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	quiescentMsgs []lnwire.Message
+
+	// deferredAdds holds the remote adds that were locked in while we
+	// weren't allowed to send updates, to be processed once the channel
+	// resumes.
+	//
+	// NOTE: This must only be accessed by the htlcManager goroutine.
+	deferredAdds []deferredAdds
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -457,7 +563,16 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		cfg.MaxFeeExposure = DefaultMaxFeeExposure
 	}
 
-	return &channelLink{
+	// Likewise, use the default bounds of the CSV delays if they aren't
+	// set.
+	if cfg.MaxLocalCSVDelay == 0 {
+		cfg.MaxLocalCSVDelay = DefaultMaxCSVDelay
+	}
+	if cfg.MaxRemoteCSVDelay == 0 {
+		cfg.MaxRemoteCSVDelay = DefaultMaxCSVDelay
+	}
+
+	l := &channelLink{
 		cfg:                 cfg,
 		channel:             channel,
 		hodlMap:             make(map[models.CircuitKey]hodlHtlc),
@@ -466,8 +581,34 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		flushHooks:          newHookMap(),
 		outgoingCommitHooks: newHookMap(),
 		incomingCommitHooks: newHookMap(),
+		dynCommitReqs:       make(chan *dynCommitReq),
+		quiescenceReqs:      make(chan *quiescenceReq),
+		quiescenceResumes:   make(chan struct{}),
 		quit:                make(chan struct{}),
 	}
+
+	channelInitiator := lntypes.Remote
+	if channel.IsInitiator() {
+		channelInitiator = lntypes.Local
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(channel.ChannelPoint())
+	l.quiescer = newQuiescer(quiescerCfg{
+		chanID:            chanID,
+		channelInitiator:  channelInitiator,
+		numPendingUpdates: channel.NumPendingUpdates,
+		sendMsg: func(msg lnwire.Stfu) error {
+			return l.cfg.Peer.SendMessage(false, &msg)
+		},
+	})
+
+	if cfg.StartQuiescent != nil {
+		l.quiescer.startQuiescent()
+		l.quiescent = true
+		cfg.StartQuiescent(l.resumeFunc())
+	}
+
+	return l
 }
 
 // A compile time check to ensure channelLink implements the ChannelLink
@@ -785,7 +926,11 @@ func (l *channelLink) createFailureWithUpdate(incoming bool,
 	if update == nil {
 		// Fallback to the non-alias behavior.
 		var err error
-		update, err = l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+		// The channel is known by the SCID of its latest funding
+		// output once a splice is locked.
+		update, err = l.cfg.FetchLastChannelUpdate(
+			l.channel.State().RealScid(),
+		)
 		if err != nil {
 			return &lnwire.FailTemporaryNodeFailure{}
 		}
@@ -912,6 +1057,14 @@ func (l *channelLink) syncChanStates() error {
 		l.openedCircuits = openedCircuits
 		l.closedCircuits = closedCircuits
 
+		// Make sure we agree with the remote party on the outcome of
+		// our last dynamic commitment negotiation before any new
+		// updates are exchanged.
+		err = l.reconcileDynCommit(remoteChanSyncMsg.DynHeight)
+		if err != nil {
+			return err
+		}
+
 		// Ensure that all packets have been have been removed from the
 		// link's mailbox.
 		if err := l.ackDownStreamPackets(); err != nil {
@@ -1099,6 +1252,9 @@ func (l *channelLink) loadAndRemove() error {
 func (l *channelLink) htlcManager() {
 	defer func() {
 		l.cfg.BatchTicker.Stop()
+		if l.quiescenceReq != nil {
+			l.quiescenceReq.errChan <- ErrLinkShuttingDown
+		}
 		l.wg.Done()
 		l.log.Infof("exited")
 	}()
@@ -1226,6 +1382,13 @@ func (l *channelLink) htlcManager() {
 		}
 	})
 
+	// If we were in the middle of a dynamic commitment negotiation when
+	// the link was last stopped, we'll start over by re-sending our
+	// proposal. The same goes for an upgrade of the commitment type whose
+	// kickoff transaction isn't fully signed yet.
+	l.resumeDynCommit()
+	l.resumeCommitUpgrade()
+
 	// We've successfully reestablished the channel, mark it as such to
 	// allow the switch to forward HTLCs in the outbound direction.
 	l.markReestablished()
@@ -1286,6 +1449,26 @@ func (l *channelLink) htlcManager() {
 			return
 		}
 
+		// Send our stfu message as soon as we owe one, and hand control
+		// to the quiescent protocol once the channel is quiescent.
+		l.progressQuiescence()
+		if l.failed {
+			l.log.Errorf("link failed, exiting htlcManager")
+			return
+		}
+
+		// While the quiescence protocol is in progress, we must not
+		// send any new updates, so we'll stop reading new packets from
+		// the switch and resolutions from the invoice registry.
+		var (
+			downstream = l.downstream
+			hodlQueue  = l.hodlQueue.ChanOut()
+		)
+		if !l.quiescer.canSendUpdates() {
+			downstream = nil
+			hodlQueue = nil
+		}
+
 		// If the previous event resulted in a non-empty batch, resume
 		// the batch ticker so that it can be cleared. Otherwise pause
 		// the ticker to prevent waking up the htlcManager while the
@@ -1340,6 +1523,19 @@ func (l *channelLink) htlcManager() {
 				continue
 			}
 
+			// We can't update the fee while a dynamic commitment
+			// negotiation is in progress, as it requires the
+			// channel to remain clean.
+			if l.dynCommit != nil {
+				continue
+			}
+
+			// We also can't update the fee while the quiescence
+			// protocol is in progress.
+			if !l.quiescer.canSendUpdates() {
+				continue
+			}
+
 			// If we are the initiator, then we'll sample the
 			// current fee rate to get into the chain within 3
 			// blocks.
@@ -1404,6 +1600,19 @@ func (l *channelLink) htlcManager() {
 				return
 			}
 
+		// A new dynamic commitment negotiation was requested.
+		case req := <-l.dynCommitReqs:
+			l.handleDynCommitReq(req)
+
+		// A request to bring the channel to quiescence was received.
+		case req := <-l.quiescenceReqs:
+			l.handleQuiescenceReq(req)
+
+		// The quiescent protocol is done, so we can resume sending
+		// updates.
+		case <-l.quiescenceResumes:
+			l.resumeFromQuiescence()
+
 		case <-l.cfg.PendingCommitTicker.Ticks():
 			l.fail(
 				LinkFailureError{
@@ -1417,7 +1626,7 @@ func (l *channelLink) htlcManager() {
 		// A message from the switch was just received. This indicates
 		// that the link is an intermediate hop in a multi-hop HTLC
 		// circuit.
-		case pkt := <-l.downstream:
+		case pkt := <-downstream:
 			l.handleDownstreamPkt(pkt)
 
 		// A message from the connected peer was just received. This
@@ -1428,7 +1637,7 @@ func (l *channelLink) htlcManager() {
 
 		// A htlc resolution is received. This means that we now have a
 		// resolution for a previously accepted htlc.
-		case hodlItem := <-l.hodlQueue.ChanOut():
+		case hodlItem := <-hodlQueue:
 			htlcResolution := hodlItem.(invoices.HtlcResolution)
 			err := l.processHodlQueue(htlcResolution)
 			switch err {
@@ -1549,6 +1758,14 @@ func (l *channelLink) processHtlcResolution(resolution invoices.HtlcResolution,
 func getResolutionFailure(resolution *invoices.HtlcFailResolution,
 	amount lnwire.MilliSatoshi) *LinkError {
 
+	// If the htlc modifier chose the failure of the htlc, we use it as
+	// is.
+	if resolution.FailureMessage != nil {
+		return NewDetailedLinkError(
+			resolution.FailureMessage, resolution.Outcome,
+		)
+	}
+
 	// If the resolution has been resolved as part of a MPP timeout,
 	// we need to fail the htlc with lnwire.FailMppTimeout.
 	if resolution.Outcome == invoices.ResultMppTimeout {
@@ -1557,6 +1774,23 @@ func getResolutionFailure(resolution *invoices.HtlcFailResolution,
 		)
 	}
 
+	// Trampoline payments that can't be forwarded within their budget are
+	// failed with the trampoline failures, so that the sender can retry
+	// with a larger budget.
+	switch resolution.Outcome {
+	case invoices.ResultTrampolineFeeInsufficient:
+		return NewDetailedLinkError(
+			&lnwire.FailTrampolineFeeInsufficient{},
+			resolution.Outcome,
+		)
+
+	case invoices.ResultTrampolineExpiryTooSoon:
+		return NewDetailedLinkError(
+			&lnwire.FailTrampolineExpiryTooSoon{},
+			resolution.Outcome,
+		)
+	}
+
 	// If the htlc is not a MPP timeout, we fail it with
 	// FailIncorrectDetails. This error is sent for invoice payment
 	// failures such as underpayment/ expiry too soon and hodl invoices
@@ -1619,6 +1853,27 @@ func (l *channelLink) handleDownstreamUpdateAdd(pkt *htlcPacket) error {
 		)
 	}
 
+	// We endorse the htlcs that we originate ourselves, and make sure
+	// that unendorsed htlcs don't use the protected resources of the
+	// channel.
+	if l.cfg.Endorsement != nil {
+		if pkt.incomingChanID == hop.Source {
+			htlc.Endorsed = true
+		}
+
+		if !htlc.Endorsed && l.exceedsGeneralBucket(htlc.Amount) {
+			l.log.Debugf("Unable to handle downstream HTLC - " +
+				"general bucket exhausted")
+
+			l.mailBox.FailAdd(pkt)
+
+			return NewDetailedLinkError(
+				lnwire.NewTemporaryChannelFailure(nil),
+				OutgoingFailureDownstreamHtlcAdd,
+			)
+		}
+	}
+
 	// A new payment has been initiated via the downstream channel,
 	// so we add the new HTLC to our local log, then update the
 	// commitment chains.
@@ -1687,6 +1942,36 @@ func (l *channelLink) handleDownstreamUpdateAdd(pkt *htlcPacket) error {
 	return nil
 }
 
+// exceedsGeneralBucket returns whether adding an unendorsed htlc of the given
+// amount would exceed the slots or the liquidity of the channel that
+// unendorsed htlcs may use. The limits of the bucket are a fraction of the
+// limits that the remote party imposes on the htlcs that we offer.
+func (l *channelLink) exceedsGeneralBucket(amt lnwire.MilliSatoshi) bool {
+	remoteCfg := l.channel.State().RemoteChanCfg
+	maxSlots, maxAmt := l.cfg.Endorsement.generalBucket(
+		remoteCfg.MaxAcceptedHtlcs, remoteCfg.MaxPendingAmount,
+	)
+
+	numHtlcs, pendingAmt := l.channel.UnendorsedOutgoingHtlcs()
+
+	return numHtlcs+1 > maxSlots || pendingAmt+amt > maxAmt
+}
+
+// isEndorsedForward returns whether an incoming htlc is forwarded to the
+// outgoing channel as endorsed, which is the case if the htlc is endorsed and
+// this channel is reputable towards the outgoing channel.
+func (l *channelLink) isEndorsedForward(pd *lnwallet.PaymentDescriptor,
+	outgoing lnwire.ShortChannelID) bool {
+
+	if l.cfg.Endorsement == nil || !pd.Endorsed {
+		return false
+	}
+
+	return l.cfg.Endorsement.Reputation.IsReputable(
+		l.ShortChanID(), outgoing,
+	)
+}
+
 // handleDownstreamPkt processes an HTLC packet sent from the downstream HTLC
 // Switch. Possible messages sent by the switch include requests to forward new
 // HTLCs, timeout previously cleared HTLCs, and finally to settle currently
@@ -1937,6 +2222,41 @@ func (l *channelLink) cleanupSpuriousResponse(pkt *htlcPacket) {
 // updates from the upstream peer. The upstream peer is the peer whom we have a
 // direct channel with, updating our respective commitment chains.
 func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
+	// As the remote party may resume from quiescence before we do, its
+	// first updates can arrive while our quiescent protocol is still
+	// running. They're processed once it's done.
+	switch msg.(type) {
+	case *lnwire.UpdateAddHTLC, *lnwire.UpdateFulfillHTLC,
+		*lnwire.UpdateFailMalformedHTLC, *lnwire.UpdateFailHTLC,
+		*lnwire.UpdateFee, *lnwire.CommitSig, *lnwire.RevokeAndAck:
+
+		if l.quiescent {
+			l.bufferQuiescentMsg(msg)
+			return
+		}
+	}
+
+	// Once the remote party sent stfu, it isn't allowed to send us any
+	// new updates until the quiescent protocol is done.
+	switch msg.(type) {
+	case *lnwire.UpdateAddHTLC, *lnwire.UpdateFulfillHTLC,
+		*lnwire.UpdateFailMalformedHTLC, *lnwire.UpdateFailHTLC,
+		*lnwire.UpdateFee:
+
+		if !l.quiescer.canRecvUpdates() {
+			l.fail(
+				LinkFailureError{
+					code:          ErrInvalidUpdate,
+					FailureAction: LinkFailureDisconnect,
+					Warning:       true,
+				},
+				"received %v after stfu", msg.MsgType(),
+			)
+
+			return
+		}
+	}
+
 	switch msg := msg.(type) {
 
 	case *lnwire.UpdateAddHTLC:
@@ -2206,6 +2526,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			CommitSig:  msg.CommitSig,
 			HtlcSigs:   msg.HtlcSigs,
 			PartialSig: msg.PartialSig,
+			SpliceSigs: msg.SpliceSigs,
 		})
 		if err != nil {
 			// If we were unable to reconstruct their proposed
@@ -2384,7 +2705,19 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		}
 
 		l.processRemoteSettleFails(fwdPkg, settleFails)
-		l.processRemoteAdds(fwdPkg, adds)
+
+		// Processing the adds may result in us settling or failing
+		// HTLCs, which we aren't allowed to do while the quiescence
+		// protocol is in progress, so we'll defer them until the
+		// channel resumes.
+		if l.quiescer.canSendUpdates() {
+			l.processRemoteAdds(fwdPkg, adds)
+		} else {
+			l.deferredAdds = append(l.deferredAdds, deferredAdds{
+				fwdPkg: fwdPkg,
+				adds:   adds,
+			})
+		}
 
 		// If the link failed during processing the adds, we must
 		// return to ensure we won't attempted to update the state
@@ -2450,6 +2783,29 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		// Update the mailbox's feerate as well.
 		l.mailBox.SetFeeRate(fee)
 
+	// The remote party wants to bring the channel to quiescence, or
+	// responds to our request to do so.
+	case *lnwire.Stfu:
+		l.handleStfu(msg)
+
+	// The remote party wants to update the parameters of the channel
+	// through a dynamic commitment negotiation.
+	case *lnwire.DynPropose:
+		l.handleDynPropose(msg)
+
+	// The remote party accepted our dynamic commitment proposal.
+	case *lnwire.DynAck:
+		l.handleDynAck(msg)
+
+	// The remote party rejected our dynamic commitment proposal.
+	case *lnwire.DynReject:
+		l.handleDynReject(msg)
+
+	// The remote party signed the kickoff transaction of an upgrade of
+	// the commitment type.
+	case *lnwire.KickoffSig:
+		l.handleKickoffSig(msg)
+
 	// In the case where we receive a warning message from our peer, just
 	// log it and move on. We choose not to disconnect from our peer,
 	// although we "MAY" do so according to the specification.
@@ -2638,6 +2994,7 @@ func (l *channelLink) updateCommitTx() error {
 		CommitSig:  newCommit.CommitSig,
 		HtlcSigs:   newCommit.HtlcSigs,
 		PartialSig: newCommit.PartialSig,
+		SpliceSigs: newCommit.SpliceSigs,
 	}
 	l.cfg.Peer.SendMessage(false, commitSig)
 
@@ -2924,12 +3281,13 @@ func (l *channelLink) zeroConfConfirmed() bool {
 	return l.channel.State().ZeroConfConfirmed()
 }
 
-// confirmedScid returns the confirmed SCID for a zero-conf channel. This
-// should not be called for non-zero-conf channels.
+// confirmedScid returns the confirmed SCID of the channel, which is the SCID
+// of the latest funding output once a splice is locked. For zero-conf
+// channels, this should only be called once the channel is confirmed.
 //
 // Part of the scidAliasHandler interface.
 func (l *channelLink) confirmedScid() lnwire.ShortChannelID {
-	return l.channel.State().ZeroConfRealScid()
+	return l.channel.State().RealScid()
 }
 
 // isZeroConf returns whether or not the underlying channel is a zero-conf
@@ -3636,6 +3994,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					Amount:        fwdInfo.AmountToForward,
 					PaymentHash:   pd.RHash,
 					BlindingPoint: fwdInfo.NextBlinding,
+					Endorsed: l.isEndorsedForward(
+						pd, fwdInfo.NextHop,
+					),
 				}
 
 				// Finally, we'll encode the onion packet for
@@ -3682,6 +4043,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				Amount:        fwdInfo.AmountToForward,
 				PaymentHash:   pd.RHash,
 				BlindingPoint: fwdInfo.NextBlinding,
+				Endorsed: l.isEndorsedForward(
+					pd, fwdInfo.NextHop,
+				),
 			}
 
 			// Finally, we'll encode the onion packet for the
@@ -2079,10 +2079,12 @@ func (m *mockPeer) SendMessage(sync bool, msgs ...lnwire.Message) error {
 		return fmt.Errorf("disconnected")
 	}
 
-	select {
-	case m.sentMsgs <- msgs[0]:
-	case <-m.quit:
-		return fmt.Errorf("mockPeer shutting down")
+	for _, msg := range msgs {
+		select {
+		case m.sentMsgs <- msg:
+		case <-m.quit:
+			return fmt.Errorf("mockPeer shutting down")
+		}
 	}
 	return nil
 }
@@ -2233,6 +2235,8 @@ func newSingleLinkTestHarness(t *testing.T, chanAmt,
 		NotifyInactiveLinkEvent: func(wire.OutPoint) {},
 		HtlcNotifier:            aliceSwitch.cfg.HtlcNotifier,
 		GetAliases:              getAliases,
+		PublishKickoff:          func(*wire.MsgTx) error { return nil },
+		NotifyUpgradeSigned:     func() {},
 	}
 
 	aliceLink := NewChannelLink(aliceCfg, aliceLc.channel)
@@ -4907,6 +4911,8 @@ func (h *persistentLinkHarness) restartLink(
 		HtlcNotifier:            h.hSwitch.cfg.HtlcNotifier,
 		SyncStates:              syncStates,
 		GetAliases:              getAliases,
+		PublishKickoff:          h.coreLink.cfg.PublishKickoff,
+		NotifyUpgradeSigned:     h.coreLink.cfg.NotifyUpgradeSigned,
 	}
 
 	aliceLink := NewChannelLink(aliceCfg, aliceChannel)
@@ -7499,3 +7505,87 @@ func TestLinkFlushHooksCalled(t *testing.T) {
 	ctx.receiveRevAndAckAliceToBob()
 	assertHookCalled(true)
 }
+
+// TestChannelLinkGeneralBucket tests that unendorsed htlcs are restricted to
+// the general bucket of the channel's slots, while htlcs that we originate are
+// endorsed and may use the protected slots.
+func TestChannelLinkGeneralBucket(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	harness, err := newSingleLinkTestHarness(t, chanAmt, 0)
+	require.NoError(t, err)
+
+	coreLink, ok := harness.aliceLink.(*channelLink)
+	require.True(t, ok)
+
+	peer, ok := coreLink.cfg.Peer.(*mockPeer)
+	require.True(t, ok)
+
+	// Leave two slots to unendorsed htlcs.
+	maxHtlcs := coreLink.channel.State().RemoteChanCfg.MaxAcceptedHtlcs
+	coreLink.cfg.Endorsement = &EndorsementConfig{
+		Reputation:               &mockReputation{},
+		GeneralSlotFraction:      2.5 / float64(maxHtlcs),
+		GeneralLiquidityFraction: 1,
+	}
+
+	require.NoError(t, harness.start())
+
+	var mockBlob [lnwire.OnionPacketSize]byte
+	htlcAmt := lnwire.NewMSatFromSatoshis(10_000)
+
+	// sendHtlc hands a htlc to the link and returns the add that the link
+	// sent to the remote party, if any.
+	sendHtlc := func(incoming lnwire.ShortChannelID, htlcID uint64,
+		endorsed bool) *lnwire.UpdateAddHTLC {
+
+		_, htlc, _, err := generatePayment(
+			htlcAmt, htlcAmt, 5, mockBlob,
+		)
+		require.NoError(t, err)
+		htlc.Endorsed = endorsed
+
+		addPkt := &htlcPacket{
+			incomingChanID: incoming,
+			incomingHTLCID: htlcID,
+			htlc:           htlc,
+			obfuscator:     NewMockObfuscator(),
+		}
+		circuit := makePaymentCircuit(&htlc.PaymentHash, addPkt)
+		_, err = harness.aliceSwitch.commitCircuits(&circuit)
+		require.NoError(t, err)
+
+		_ = harness.aliceLink.handleSwitchPacket(addPkt)
+
+		select {
+		case msg := <-peer.sentMsgs:
+			add, ok := msg.(*lnwire.UpdateAddHTLC)
+			require.True(t, ok)
+
+			return add
+
+		case <-time.After(time.Second):
+			return nil
+		}
+	}
+
+	// The first two unendorsed forwards fit into the general bucket.
+	incoming := lnwire.NewShortChanIDFromInt(1)
+	require.NotNil(t, sendHtlc(incoming, 0, false))
+	require.NotNil(t, sendHtlc(incoming, 1, false))
+
+	// Endorsed forwards may use the protected slots.
+	add := sendHtlc(incoming, 2, true)
+	require.NotNil(t, add)
+	require.True(t, add.Endorsed)
+
+	// Htlcs that we originate are endorsed.
+	add = sendHtlc(hop.Source, 0, false)
+	require.NotNil(t, add)
+	require.True(t, add.Endorsed)
+
+	// Another unendorsed forward exceeds the general bucket and is
+	// rejected.
+	require.Nil(t, sendHtlc(incoming, 3, false))
+}
@@ -626,6 +626,16 @@ func (s *mockServer) readHandler(message lnwire.Message) error {
 		targetChan = msg.ChanID
 	case *lnwire.UpdateFee:
 		targetChan = msg.ChanID
+	case *lnwire.DynPropose:
+		targetChan = msg.ChanID
+	case *lnwire.DynAck:
+		targetChan = msg.ChanID
+	case *lnwire.DynReject:
+		targetChan = msg.ChanID
+	case *lnwire.KickoffSig:
+		targetChan = msg.ChanID
+	case *lnwire.Stfu:
+		targetChan = msg.ChanID
 	default:
 		return fmt.Errorf("unknown message type: %T", msg)
 	}
@@ -840,6 +850,21 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 
 func (f *mockChannelLink) UpdateForwardingPolicy(_ models.ForwardingPolicy) {
 }
+
+func (f *mockChannelLink) ProposeDynCommitment(DynCommitParams) <-chan error {
+	errChan := make(chan error, 1)
+	errChan <- nil
+
+	return errChan
+}
+
+func (f *mockChannelLink) InitStfu(QuiescentProtocol) <-chan error {
+	errChan := make(chan error, 1)
+	errChan <- nil
+
+	return errChan
+}
+
 func (f *mockChannelLink) CheckHtlcForward([32]byte, lnwire.MilliSatoshi,
 	lnwire.MilliSatoshi, uint32, uint32, models.InboundFee, uint32,
 	lnwire.ShortChannelID) *LinkError {
@@ -885,6 +910,10 @@ func (f *mockChannelLink) negotiatedAliasFeature() bool {
 }
 
 func (f *mockChannelLink) confirmedScid() lnwire.ShortChannelID {
+	if f.realScid == hop.Source {
+		return f.shortChanID
+	}
+
 	return f.realScid
 }
 
@@ -1169,3 +1198,17 @@ func (h *mockHTLCNotifier) NotifySettleEvent(key HtlcKey,
 func (h *mockHTLCNotifier) NotifyFinalHtlcEvent(key models.CircuitKey,
 	info channeldb.FinalHtlcInfo) { //nolint:whitespace
 }
+
+var _ ReputationChecker = (*mockReputation)(nil)
+
+// mockReputation is a reputation checker that considers the channels in its
+// set as reputable.
+type mockReputation struct {
+	reputable map[lnwire.ShortChannelID]bool
+}
+
+func (m *mockReputation) IsReputable(incoming,
+	_ lnwire.ShortChannelID) bool {
+
+	return m.reputable[incoming]
+}
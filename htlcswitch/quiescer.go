@@ -0,0 +1,477 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// maxQuiescentMsgs is the maximum number of upstream messages that are
+	// buffered while the quiescent protocol is running. This covers a full
+	// commitment worth of HTLCs along with the commitment update.
+	maxQuiescentMsgs = 2*input.MaxHTLCNumber + 2
+)
+
+var (
+	// ErrQuiescenceDisabled is returned when quiescence is requested for a
+	// link for which the quiescence protocol wasn't negotiated.
+	ErrQuiescenceDisabled = errors.New("quiescence is disabled")
+
+	// ErrQuiescenceInProgress is returned when quiescence is requested
+	// while either party already initiated it.
+	ErrQuiescenceInProgress = errors.New("quiescence already in progress")
+
+	// ErrStfuAlreadyRcvd is returned when the remote party sends us more
+	// than one stfu message.
+	ErrStfuAlreadyRcvd = errors.New("stfu already received")
+
+	// ErrPendingRemoteUpdates is returned when the remote party sends us
+	// stfu while some of its updates are still pending.
+	ErrPendingRemoteUpdates = errors.New("stfu received with pending " +
+		"remote updates")
+
+	// ErrNotQuiescent is returned when the initiator of quiescence is
+	// queried before the channel is quiescent.
+	ErrNotQuiescent = errors.New("channel is not quiescent")
+
+	// ErrNoQuiescenceInitiator is returned when the channel is quiescent
+	// but neither party claims to have initiated it.
+	ErrNoQuiescenceInitiator = errors.New("neither party initiated " +
+		"quiescence")
+)
+
+// QuiescentProtocol is the protocol that is run once a channel has reached
+// quiescence. It's passed the party that initiated quiescence, and a function
+// that must be called once the protocol is done to allow updates to be added
+// to the channel again.
+//
+// NOTE: The protocol is run in its own goroutine, so it's free to block until
+// it's done.
+type QuiescentProtocol func(initiator lntypes.ChannelParty, resume func())
+
+// quiescerCfg houses the dependencies of the quiescer.
+type quiescerCfg struct {
+	// chanID is the id of the channel that is brought to quiescence.
+	chanID lnwire.ChannelID
+
+	// channelInitiator is the party that funded the channel. It wins the
+	// tie-break if both parties initiate quiescence at the same time.
+	channelInitiator lntypes.ChannelParty
+
+	// numPendingUpdates returns the number of updates originated by
+	// whoseUpdates that aren't yet committed to the tip of whoseCommit's
+	// commitment chain.
+	numPendingUpdates func(whoseUpdates,
+		whoseCommit lntypes.ChannelParty) uint64
+
+	// sendMsg sends the passed stfu message to the remote party.
+	sendMsg func(lnwire.Stfu) error
+}
+
+// quiescer is a state machine that tracks the progress of the quiescence
+// protocol of a single channel. A channel is quiescent once both parties have
+// sent stfu, which they may only do once none of their own updates are
+// pending anymore.
+//
+// WARNING: NOT thread-safe, it must only be accessed by the htlcManager
+// goroutine.
+type quiescer struct {
+	cfg quiescerCfg
+
+	// localInit is true if we initiated quiescence.
+	localInit bool
+
+	// remoteInit is true if the remote party initiated quiescence.
+	remoteInit bool
+
+	// sent is true if we sent our stfu message.
+	sent bool
+
+	// received is true if we received the stfu message of the remote
+	// party.
+	received bool
+}
+
+// newQuiescer creates a new quiescer for the given channel.
+func newQuiescer(cfg quiescerCfg) *quiescer {
+	return &quiescer{
+		cfg: cfg,
+	}
+}
+
+// initStfu marks that we want to bring the channel to quiescence. Our stfu
+// message is sent as soon as none of our updates are pending anymore.
+func (q *quiescer) initStfu() error {
+	if q.localInit || q.sent || q.received {
+		return ErrQuiescenceInProgress
+	}
+
+	q.localInit = true
+
+	return nil
+}
+
+// recvStfu processes the stfu message of the remote party. An error is
+// returned if the message violates the protocol, in which case the
+// connection should be dropped.
+func (q *quiescer) recvStfu(msg lnwire.Stfu) error {
+	if q.received {
+		return ErrStfuAlreadyRcvd
+	}
+
+	// The remote party may only send stfu once none of its updates are
+	// pending on either commitment.
+	if q.cfg.numPendingUpdates(lntypes.Remote, lntypes.Local) != 0 ||
+		q.cfg.numPendingUpdates(lntypes.Remote, lntypes.Remote) != 0 {
+
+		return ErrPendingRemoteUpdates
+	}
+
+	q.received = true
+	q.remoteInit = msg.Initiator
+
+	return nil
+}
+
+// oweStfu returns true if we need to send stfu, either because we initiated
+// quiescence or because the remote party did, and none of our updates are
+// pending anymore.
+func (q *quiescer) oweStfu() bool {
+	if q.sent || (!q.localInit && !q.received) {
+		return false
+	}
+
+	return q.cfg.numPendingUpdates(lntypes.Local, lntypes.Local) == 0 &&
+		q.cfg.numPendingUpdates(lntypes.Local, lntypes.Remote) == 0
+}
+
+// sendOwedStfu sends our stfu message if we owe one to the remote party. It's
+// a no-op otherwise.
+func (q *quiescer) sendOwedStfu() error {
+	if !q.oweStfu() {
+		return nil
+	}
+
+	err := q.cfg.sendMsg(lnwire.Stfu{
+		ChanID:    q.cfg.chanID,
+		Initiator: q.localInit,
+	})
+	if err != nil {
+		return err
+	}
+
+	q.sent = true
+
+	return nil
+}
+
+// startQuiescent marks the channel as quiescent without exchanging stfu
+// messages. This is used when both parties already know that the channel
+// can't be updated, such as while a splice is pending.
+func (q *quiescer) startQuiescent() {
+	q.sent = true
+	q.received = true
+}
+
+// canSendUpdates returns true if we're allowed to send new updates to the
+// remote party. We stop sending updates as soon as either party started the
+// quiescence protocol.
+func (q *quiescer) canSendUpdates() bool {
+	return !q.sent && !q.localInit && !q.received
+}
+
+// canRecvUpdates returns true if the remote party is allowed to send us new
+// updates, which is the case until it sent us stfu.
+func (q *quiescer) canRecvUpdates() bool {
+	return !q.received
+}
+
+// isQuiescent returns true once both parties have sent stfu.
+func (q *quiescer) isQuiescent() bool {
+	return q.sent && q.received
+}
+
+// quiescenceInitiator returns the party that initiated quiescence. If both
+// parties initiated it at the same time, the channel initiator wins.
+func (q *quiescer) quiescenceInitiator() (lntypes.ChannelParty, error) {
+	switch {
+	case !q.isQuiescent():
+		return 0, ErrNotQuiescent
+
+	case q.localInit && q.remoteInit:
+		return q.cfg.channelInitiator, nil
+
+	case q.localInit:
+		return lntypes.Local, nil
+
+	case q.remoteInit:
+		return lntypes.Remote, nil
+
+	default:
+		return 0, ErrNoQuiescenceInitiator
+	}
+}
+
+// resume resets the quiescer once the quiescent protocol is done, after which
+// both parties may send updates again.
+func (q *quiescer) resume() {
+	q.localInit = false
+	q.remoteInit = false
+	q.sent = false
+	q.received = false
+}
+
+// String returns a human-readable description of the quiescer state.
+func (q *quiescer) String() string {
+	return fmt.Sprintf("quiescer(local_init=%v, remote_init=%v, sent=%v, "+
+		"received=%v)", q.localInit, q.remoteInit, q.sent, q.received)
+}
+
+// quiescenceReq is a request to bring the channel to quiescence and to run
+// the given protocol once it is.
+type quiescenceReq struct {
+	proto QuiescentProtocol
+
+	// errChan is sent nil once the channel is quiescent and control was
+	// handed to the protocol, or an error if quiescence couldn't be
+	// reached.
+	errChan chan error
+}
+
+// deferredAdds is a set of remote adds that was locked in while we weren't
+// allowed to send updates. As processing them may result in us settling or
+// failing HTLCs, they're only processed once the channel resumes.
+type deferredAdds struct {
+	fwdPkg *channeldb.FwdPkg
+	adds   []*lnwallet.PaymentDescriptor
+}
+
+// InitStfu brings the channel to quiescence and then hands control to the
+// passed protocol. The returned channel is sent nil once the protocol was
+// started, or an error if quiescence couldn't be reached.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) InitStfu(proto QuiescentProtocol) <-chan error {
+	req := &quiescenceReq{
+		proto:   proto,
+		errChan: make(chan error, 1),
+	}
+
+	select {
+	case l.quiescenceReqs <- req:
+	case <-l.quit:
+		req.errChan <- ErrLinkShuttingDown
+	}
+
+	return req.errChan
+}
+
+// handleQuiescenceReq starts the quiescence protocol for the passed request.
+// Our stfu message is sent by the htlcManager as soon as none of our updates
+// are pending anymore.
+func (l *channelLink) handleQuiescenceReq(req *quiescenceReq) {
+	switch {
+	case l.cfg.DisallowQuiescence:
+		req.errChan <- ErrQuiescenceDisabled
+		return
+
+	// If outgoing adds are disabled, we're in the process of shutting
+	// down the channel.
+	case l.IsFlushing(Outgoing):
+		req.errChan <- ErrLinkShuttingDown
+		return
+	}
+
+	if err := l.quiescer.initStfu(); err != nil {
+		req.errChan <- err
+		return
+	}
+
+	l.log.Infof("Initiating quiescence")
+
+	l.quiescenceReq = req
+}
+
+// handleStfu processes the stfu message of the remote party.
+func (l *channelLink) handleStfu(msg *lnwire.Stfu) {
+	if l.cfg.DisallowQuiescence {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"received stfu although quiescence wasn't negotiated",
+		)
+
+		return
+	}
+
+	// Quiescence only ends once the protocol run on top of it is done. If
+	// the remote party initiates quiescence but we have no protocol to
+	// handle its request, we'd never be able to resume, so we'll drop the
+	// connection rather than answering its stfu.
+	if l.quiescenceReq == nil && l.cfg.QuiescentProtocol == nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"received stfu without a quiescent protocol to run",
+		)
+
+		return
+	}
+
+	if err := l.quiescer.recvStfu(*msg); err != nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"unable to handle stfu: %v", err,
+		)
+
+		return
+	}
+
+	l.log.Debugf("Received stfu, initiator=%v", msg.Initiator)
+}
+
+// progressQuiescence sends our stfu message if we owe one, and hands control
+// to the quiescent protocol once the channel has reached quiescence.
+func (l *channelLink) progressQuiescence() {
+	if err := l.quiescer.sendOwedStfu(); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to send stfu: %v", err)
+		return
+	}
+
+	if !l.quiescer.isQuiescent() || l.quiescent {
+		return
+	}
+
+	initiator, err := l.quiescer.quiescenceInitiator()
+	if err != nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"unable to determine quiescence initiator: %v", err,
+		)
+
+		return
+	}
+
+	// If we requested quiescence, our protocol gets control even if the
+	// remote party won the tie-break, so the caller learns about it.
+	// Otherwise we'll run the protocol that handles remote requests.
+	proto := l.cfg.QuiescentProtocol
+	if l.quiescenceReq != nil {
+		proto = l.quiescenceReq.proto
+		l.quiescenceReq.errChan <- nil
+		l.quiescenceReq = nil
+	}
+
+	// Quiescence can only end once the protocol is done, so without one,
+	// the best we can do is to drop the connection.
+	if proto == nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"no quiescent protocol to run",
+		)
+
+		return
+	}
+
+	l.log.Infof("Channel is quiescent, initiator=%v", initiator)
+
+	l.quiescent = true
+
+	go proto(initiator, l.resumeFunc())
+}
+
+// resumeFunc returns the function that's called by the quiescent protocol
+// once it's done. Only the first call has an effect.
+func (l *channelLink) resumeFunc() func() {
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			select {
+			case l.quiescenceResumes <- struct{}{}:
+			case <-l.quit:
+			}
+		})
+	}
+}
+
+// bufferQuiescentMsg holds on to an upstream message that was received while
+// the quiescent protocol is running, so it can be processed once the channel
+// resumes. If the remote party sends too many of them, it's treated as a
+// protocol violation.
+func (l *channelLink) bufferQuiescentMsg(msg lnwire.Message) {
+	if len(l.quiescentMsgs) >= maxQuiescentMsgs {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"received too many updates while quiescent",
+		)
+
+		return
+	}
+
+	l.log.Debugf("Buffering %v received while quiescent", msg.MsgType())
+
+	l.quiescentMsgs = append(l.quiescentMsgs, msg)
+}
+
+// resumeFromQuiescence allows both parties to send updates again once the
+// quiescent protocol is done, and processes any remote adds that were locked
+// in during quiescence.
+func (l *channelLink) resumeFromQuiescence() {
+	l.log.Infof("Resuming from quiescence")
+
+	l.quiescer.resume()
+	l.quiescent = false
+
+	deferred := l.deferredAdds
+	l.deferredAdds = nil
+	for _, d := range deferred {
+		l.processRemoteAdds(d.fwdPkg, d.adds)
+		if l.failed {
+			return
+		}
+	}
+
+	msgs := l.quiescentMsgs
+	l.quiescentMsgs = nil
+	for _, msg := range msgs {
+		l.handleUpstreamMsg(msg)
+		if l.failed {
+			return
+		}
+	}
+
+	if l.channel.OweCommitment() {
+		l.updateCommitTxOrFail()
+	}
+}
@@ -0,0 +1,389 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// quiescerTestHarness wraps a quiescer with a controllable set of pending
+// updates and records the stfu messages it sends.
+type quiescerTestHarness struct {
+	quiescer *quiescer
+
+	// pending maps the party whose updates are pending, and the party
+	// whose commitment they're pending on, to the number of updates.
+	pending map[[2]lntypes.ChannelParty]uint64
+
+	sent []lnwire.Stfu
+}
+
+// newQuiescerTestHarness creates a new quiescer for a channel that was funded
+// by the given party.
+func newQuiescerTestHarness(
+	channelInitiator lntypes.ChannelParty) *quiescerTestHarness {
+
+	h := &quiescerTestHarness{
+		pending: make(map[[2]lntypes.ChannelParty]uint64),
+	}
+	h.quiescer = newQuiescer(quiescerCfg{
+		channelInitiator: channelInitiator,
+		numPendingUpdates: func(whoseUpdates,
+			whoseCommit lntypes.ChannelParty) uint64 {
+
+			return h.pending[[2]lntypes.ChannelParty{
+				whoseUpdates, whoseCommit,
+			}]
+		},
+		sendMsg: func(msg lnwire.Stfu) error {
+			h.sent = append(h.sent, msg)
+			return nil
+		},
+	})
+
+	return h
+}
+
+// TestQuiescerLocalInit tests that we only send stfu once none of our updates
+// are pending, and that the channel is quiescent once the remote party
+// responds.
+func TestQuiescerLocalInit(t *testing.T) {
+	t.Parallel()
+
+	h := newQuiescerTestHarness(lntypes.Remote)
+	q := h.quiescer
+
+	require.True(t, q.canSendUpdates())
+	require.NoError(t, q.initStfu())
+	require.ErrorIs(t, q.initStfu(), ErrQuiescenceInProgress)
+
+	// As soon as we initiated quiescence, we must not send any new
+	// updates, while the remote party still may.
+	require.False(t, q.canSendUpdates())
+	require.True(t, q.canRecvUpdates())
+
+	// While one of our updates is pending on the remote commitment, we
+	// can't send stfu yet.
+	h.pending[[2]lntypes.ChannelParty{lntypes.Local, lntypes.Remote}] = 1
+	require.NoError(t, q.sendOwedStfu())
+	require.Empty(t, h.sent)
+
+	h.pending[[2]lntypes.ChannelParty{lntypes.Local, lntypes.Remote}] = 0
+	require.NoError(t, q.sendOwedStfu())
+	require.Equal(t, []lnwire.Stfu{{Initiator: true}}, h.sent)
+
+	// We shouldn't send stfu twice.
+	require.NoError(t, q.sendOwedStfu())
+	require.Len(t, h.sent, 1)
+
+	_, err := q.quiescenceInitiator()
+	require.ErrorIs(t, err, ErrNotQuiescent)
+
+	require.NoError(t, q.recvStfu(lnwire.Stfu{}))
+	require.False(t, q.canRecvUpdates())
+	require.True(t, q.isQuiescent())
+
+	initiator, err := q.quiescenceInitiator()
+	require.NoError(t, err)
+	require.Equal(t, lntypes.Local, initiator)
+
+	// Once resumed, both parties may send updates again.
+	q.resume()
+	require.False(t, q.isQuiescent())
+	require.True(t, q.canSendUpdates())
+	require.True(t, q.canRecvUpdates())
+}
+
+// TestQuiescerRemoteInit tests that we respond to the stfu message of the
+// remote party once none of our updates are pending.
+func TestQuiescerRemoteInit(t *testing.T) {
+	t.Parallel()
+
+	h := newQuiescerTestHarness(lntypes.Local)
+	q := h.quiescer
+
+	h.pending[[2]lntypes.ChannelParty{lntypes.Local, lntypes.Local}] = 2
+	require.NoError(t, q.recvStfu(lnwire.Stfu{Initiator: true}))
+	require.ErrorIs(t, q.recvStfu(lnwire.Stfu{}), ErrStfuAlreadyRcvd)
+
+	// We can't initiate quiescence ourselves anymore, and must not send
+	// any new updates.
+	require.ErrorIs(t, q.initStfu(), ErrQuiescenceInProgress)
+	require.False(t, q.canSendUpdates())
+	require.False(t, q.canRecvUpdates())
+
+	require.NoError(t, q.sendOwedStfu())
+	require.Empty(t, h.sent)
+
+	h.pending[[2]lntypes.ChannelParty{lntypes.Local, lntypes.Local}] = 0
+	require.NoError(t, q.sendOwedStfu())
+	require.Equal(t, []lnwire.Stfu{{Initiator: false}}, h.sent)
+	require.True(t, q.isQuiescent())
+
+	initiator, err := q.quiescenceInitiator()
+	require.NoError(t, err)
+	require.Equal(t, lntypes.Remote, initiator)
+}
+
+// TestQuiescerPendingRemoteUpdates tests that the remote party isn't allowed
+// to send stfu while some of its updates are pending.
+func TestQuiescerPendingRemoteUpdates(t *testing.T) {
+	t.Parallel()
+
+	h := newQuiescerTestHarness(lntypes.Local)
+	q := h.quiescer
+
+	h.pending[[2]lntypes.ChannelParty{lntypes.Remote, lntypes.Remote}] = 1
+	require.ErrorIs(
+		t, q.recvStfu(lnwire.Stfu{Initiator: true}),
+		ErrPendingRemoteUpdates,
+	)
+	require.True(t, q.canRecvUpdates())
+}
+
+// TestQuiescerTieBreak tests that the channel initiator wins if both parties
+// initiate quiescence at the same time.
+func TestQuiescerTieBreak(t *testing.T) {
+	t.Parallel()
+
+	for _, channelInitiator := range []lntypes.ChannelParty{
+		lntypes.Local, lntypes.Remote,
+	} {
+
+		h := newQuiescerTestHarness(channelInitiator)
+		q := h.quiescer
+
+		require.NoError(t, q.initStfu())
+		require.NoError(t, q.sendOwedStfu())
+		require.NoError(t, q.recvStfu(lnwire.Stfu{Initiator: true}))
+
+		initiator, err := q.quiescenceInitiator()
+		require.NoError(t, err)
+		require.Equal(t, channelInitiator, initiator)
+	}
+}
+
+// TestQuiescerStartQuiescent tests that a quiescer that starts out quiescent
+// doesn't allow any updates, and never sends stfu.
+func TestQuiescerStartQuiescent(t *testing.T) {
+	t.Parallel()
+
+	h := newQuiescerTestHarness(lntypes.Local)
+	q := h.quiescer
+
+	q.startQuiescent()
+	require.True(t, q.isQuiescent())
+	require.False(t, q.canSendUpdates())
+	require.False(t, q.canRecvUpdates())
+
+	require.NoError(t, q.sendOwedStfu())
+	require.Empty(t, h.sent)
+	require.ErrorIs(t, q.initStfu(), ErrQuiescenceInProgress)
+}
+
+// TestLinkQuiescence tests that two links are able to bring their channel to
+// quiescence, run a quiescent protocol, and then resume to forward payments.
+func TestLinkQuiescence(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// Bob runs a protocol that is done right away whenever Alice brings
+	// the channel to quiescence.
+	bobInitiators := make(chan lntypes.ChannelParty, 1)
+	n.firstBobChannelLink.cfg.QuiescentProtocol = func(
+		initiator lntypes.ChannelParty, resume func()) {
+
+		bobInitiators <- initiator
+		resume()
+	}
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	aliceInitiators := make(chan lntypes.ChannelParty, 1)
+	aliceResume := make(chan func(), 1)
+	errChan := n.aliceChannelLink.InitStfu(func(
+		initiator lntypes.ChannelParty, resume func()) {
+
+		aliceInitiators <- initiator
+		aliceResume <- resume
+	})
+
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("channel didn't reach quiescence")
+	}
+
+	// Both parties should agree that Alice initiated quiescence.
+	assertInitiator := func(initiators chan lntypes.ChannelParty,
+		expected lntypes.ChannelParty) {
+
+		select {
+		case initiator := <-initiators:
+			require.Equal(t, expected, initiator)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("quiescent protocol wasn't run")
+		}
+	}
+	assertInitiator(aliceInitiators, lntypes.Local)
+	assertInitiator(bobInitiators, lntypes.Remote)
+
+	// A payment can't be sent while Alice's protocol is still running.
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.firstBobChannelLink,
+	)
+	payment := makePayment(
+		n.aliceServer, n.bobServer, n.firstBobChannelLink.ShortChanID(),
+		hops, amount, htlcAmt, totalTimelock,
+	)
+
+	_, err = payment.Wait(time.Second)
+	require.Error(t, err)
+
+	// Once Alice resumes the channel, the payment should go through.
+	(<-aliceResume)()
+
+	_, err = payment.Wait(30 * time.Second)
+	require.NoError(t, err, "unable to make the payment")
+}
+
+// TestLinkQuiescenceBufferedUpdates tests that updates the remote party sends
+// after it resumed from quiescence are processed once our quiescent protocol
+// is done, rather than being treated as a protocol violation.
+func TestLinkQuiescenceBufferedUpdates(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// Bob resumes as soon as the channel is quiescent.
+	n.firstBobChannelLink.cfg.QuiescentProtocol = func(
+		_ lntypes.ChannelParty, resume func()) {
+
+		resume()
+	}
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	aliceResume := make(chan func(), 1)
+	errChan := n.aliceChannelLink.InitStfu(func(_ lntypes.ChannelParty,
+		resume func()) {
+
+		aliceResume <- resume
+	})
+
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("channel didn't reach quiescence")
+	}
+
+	var resume func()
+	select {
+	case resume = <-aliceResume:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("quiescent protocol wasn't run")
+	}
+
+	// Bob already resumed, so he sends a payment to Alice while her
+	// protocol is still running.
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.aliceChannelLink,
+	)
+	payment := makePayment(
+		n.bobServer, n.aliceServer, n.aliceChannelLink.ShortChanID(),
+		hops, amount, htlcAmt, totalTimelock,
+	)
+
+	_, err = payment.Wait(time.Second)
+	require.Error(t, err)
+
+	// Once Alice resumes, she processes Bob's updates, and the payment
+	// goes through.
+	resume()
+
+	_, err = payment.Wait(30 * time.Second)
+	require.NoError(t, err, "unable to make the payment")
+}
+
+// TestLinkQuiescenceNoProtocol tests that a link without a quiescent protocol,
+// such as the link of a peer that supports quiescence but not splicing,
+// doesn't answer the stfu of the remote party, as it would never be able to
+// resume, and drops the connection with a warning instead.
+func TestLinkQuiescenceNoProtocol(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// Bob has no quiescent protocol to run.
+	require.Nil(t, n.firstBobChannelLink.cfg.QuiescentProtocol)
+
+	bobLinkErrs := make(chan LinkFailureError, 1)
+	n.firstBobChannelLink.cfg.OnChannelFailure = func(_ lnwire.ChannelID,
+		_ lnwire.ShortChannelID, linkErr LinkFailureError) {
+
+		bobLinkErrs <- linkErr
+	}
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	errChan := n.aliceChannelLink.InitStfu(func(lntypes.ChannelParty,
+		func()) {
+
+		t.Errorf("quiescent protocol must not be run")
+	})
+
+	// Bob fails the link with a warning and disconnects, rather than
+	// resuming on his own.
+	select {
+	case linkErr := <-bobLinkErrs:
+		require.Equal(t, ErrInvalidUpdate, linkErr.code)
+		require.Equal(
+			t, LinkFailureDisconnect, linkErr.FailureAction,
+		)
+		require.True(t, linkErr.Warning)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("link wasn't failed")
+	}
+
+	// As Bob never answered, the channel never reached quiescence.
+	select {
+	case err := <-errChan:
+		t.Fatalf("unexpected quiescence result: %v", err)
+
+	case <-time.After(time.Second):
+	}
+}
@@ -0,0 +1,384 @@
+package htlcswitch
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+const (
+	// DefaultResolutionPeriod is the default time within which forwarded
+	// htlcs are expected to resolve.
+	DefaultResolutionPeriod = 90 * time.Second
+
+	// DefaultReputationHalfLife is the default time after which the fees
+	// that an incoming channel earned us count half towards its
+	// reputation.
+	DefaultReputationHalfLife = 12 * 7 * 24 * time.Hour
+
+	// DefaultRevenueHalfLife is the default time after which the fees
+	// that an outgoing channel earned us count half towards its revenue.
+	DefaultRevenueHalfLife = 7 * 24 * time.Hour
+
+	// DefaultGeneralSlotFraction is the default fraction of the htlc slots
+	// of a channel that unendorsed htlcs may use.
+	DefaultGeneralSlotFraction = 0.5
+
+	// DefaultGeneralLiquidityFraction is the default fraction of the max
+	// value in flight of a channel that unendorsed htlcs may use.
+	DefaultGeneralLiquidityFraction = 0.5
+
+	// maxForwardAge is the time after which we stop waiting for the
+	// resolution of a forwarded htlc. Htlcs that are resolved on chain
+	// don't produce a resolution event, so they are treated as failed
+	// once they reach this age.
+	maxForwardAge = 14 * 24 * time.Hour
+
+	// forwardPruneInterval is the interval at which forwarded htlcs that
+	// reached the max age are pruned.
+	forwardPruneInterval = time.Hour
+)
+
+var (
+	// errInvalidFraction is returned when a bucket fraction is not within
+	// [0, 1].
+	errInvalidFraction = errors.New("bucket fraction must be between 0 " +
+		"and 1")
+)
+
+// EndorsementConfig holds the parameters of the experimental endorsement
+// signal and the resource bucketing of a link.
+type EndorsementConfig struct {
+	// Reputation decides whether endorsed htlcs that we receive over the
+	// link are forwarded as endorsed.
+	Reputation ReputationChecker
+
+	// GeneralSlotFraction is the fraction of the htlc slots of the remote
+	// party that unendorsed htlcs may use. The remaining slots are
+	// protected for endorsed htlcs.
+	GeneralSlotFraction float64
+
+	// GeneralLiquidityFraction is the fraction of the max value in flight
+	// of the remote party that unendorsed htlcs may use. The remaining
+	// liquidity is protected for endorsed htlcs.
+	GeneralLiquidityFraction float64
+}
+
+// Validate checks that the bucket fractions are valid.
+func (e *EndorsementConfig) Validate() error {
+	if e.GeneralSlotFraction < 0 || e.GeneralSlotFraction > 1 {
+		return errInvalidFraction
+	}
+
+	if e.GeneralLiquidityFraction < 0 || e.GeneralLiquidityFraction > 1 {
+		return errInvalidFraction
+	}
+
+	return nil
+}
+
+// generalBucket returns the number of slots and the amount that unendorsed
+// htlcs may use given the limits of the channel.
+func (e *EndorsementConfig) generalBucket(maxHtlcs uint16,
+	maxInFlight lnwire.MilliSatoshi) (int, lnwire.MilliSatoshi) {
+
+	slots := int(float64(maxHtlcs) * e.GeneralSlotFraction)
+	liquidity := lnwire.MilliSatoshi(
+		float64(maxInFlight) * e.GeneralLiquidityFraction,
+	)
+
+	return slots, liquidity
+}
+
+// ReputationConfig holds the parameters of the reputation tracker.
+type ReputationConfig struct {
+	// ResolutionPeriod is the time within which forwarded htlcs are
+	// expected to resolve. For every period that a htlc holds on to our
+	// resources, the incoming channel is charged its fee as an
+	// opportunity cost.
+	ResolutionPeriod time.Duration
+
+	// ReputationHalfLife is the time after which the fees of a htlc count
+	// half towards the reputation of its incoming channel.
+	ReputationHalfLife time.Duration
+
+	// RevenueHalfLife is the time after which the fees of a htlc count
+	// half towards the revenue of its outgoing channel.
+	RevenueHalfLife time.Duration
+
+	// SubscribeHtlcEvents returns a subscription to the events of the
+	// htlc notifier.
+	SubscribeHtlcEvents func() (*subscribe.Client, error)
+
+	// Clock is the time source of the tracker.
+	Clock clock.Clock
+}
+
+// decayingValue is a sum of values that decays exponentially over time.
+type decayingValue struct {
+	value      float64
+	lastUpdate time.Time
+}
+
+// valueAt returns the decayed value at the given time.
+func (d *decayingValue) valueAt(t time.Time, halfLife time.Duration) float64 {
+	elapsed := t.Sub(d.lastUpdate)
+	if d.lastUpdate.IsZero() || elapsed <= 0 {
+		return d.value
+	}
+
+	return d.value * math.Exp2(-elapsed.Seconds()/halfLife.Seconds())
+}
+
+// add adds a value at the given time.
+func (d *decayingValue) add(value float64, t time.Time,
+	halfLife time.Duration) {
+
+	d.value = d.valueAt(t, halfLife) + value
+	if t.After(d.lastUpdate) {
+		d.lastUpdate = t
+	}
+}
+
+// forwardedHtlc is a htlc that we forwarded and that hasn't been resolved
+// yet.
+type forwardedHtlc struct {
+	fee         lnwire.MilliSatoshi
+	forwardedAt time.Time
+}
+
+// ReputationTracker tracks the reputation of our incoming channels and the
+// revenue of our outgoing channels, based on the forwards reported by the
+// htlc notifier. The reputation of an incoming channel is the decaying sum of
+// the fees that its htlcs earned us, minus an opportunity cost for htlcs that
+// held on to our resources for longer than the resolution period. The revenue
+// of an outgoing channel is the decaying sum of the fees of the htlcs that it
+// settled. An incoming channel is reputable towards an outgoing channel if
+// its reputation outweighs the revenue that jamming the outgoing channel
+// would cost us.
+//
+// NOTE: The state of the tracker is kept in memory only.
+type ReputationTracker struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *ReputationConfig
+
+	// forwards holds the forwarded htlcs that haven't been resolved yet.
+	forwards map[HtlcKey]*forwardedHtlc
+
+	// reputation holds the reputation of our incoming channels.
+	reputation map[lnwire.ShortChannelID]*decayingValue
+
+	// revenue holds the revenue of our outgoing channels.
+	revenue map[lnwire.ShortChannelID]*decayingValue
+
+	mu sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile time check to ensure ReputationTracker implements the
+// ReputationChecker interface.
+var _ ReputationChecker = (*ReputationTracker)(nil)
+
+// NewReputationTracker returns a new reputation tracker.
+func NewReputationTracker(cfg *ReputationConfig) *ReputationTracker {
+	return &ReputationTracker{
+		cfg:        cfg,
+		forwards:   make(map[HtlcKey]*forwardedHtlc),
+		reputation: make(map[lnwire.ShortChannelID]*decayingValue),
+		revenue:    make(map[lnwire.ShortChannelID]*decayingValue),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start subscribes to htlc events and starts tracking forwards.
+func (r *ReputationTracker) Start() error {
+	if r.started.Swap(true) {
+		return errors.New("reputation tracker already started")
+	}
+
+	log.Info("Reputation tracker starting")
+
+	client, err := r.cfg.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+
+	r.wg.Add(1)
+	go r.consume(client)
+
+	return nil
+}
+
+// Stop stops the reputation tracker.
+func (r *ReputationTracker) Stop() error {
+	if r.stopped.Swap(true) {
+		return errors.New("reputation tracker already stopped")
+	}
+
+	log.Info("Reputation tracker shutting down...")
+	defer log.Debug("Reputation tracker shutdown complete")
+
+	close(r.quit)
+	r.wg.Wait()
+
+	return nil
+}
+
+// consume processes htlc events until the tracker is stopped.
+func (r *ReputationTracker) consume(client *subscribe.Client) {
+	defer r.wg.Done()
+	defer client.Cancel()
+
+	pruneTicker := r.cfg.Clock.TickAfter(forwardPruneInterval)
+
+	for {
+		select {
+		case event := <-client.Updates():
+			r.handleEvent(event)
+
+		case <-pruneTicker:
+			r.pruneForwards(r.cfg.Clock.Now())
+			pruneTicker = r.cfg.Clock.TickAfter(
+				forwardPruneInterval,
+			)
+
+		case <-client.Quit():
+			log.Warn("Htlc event subscription of reputation " +
+				"tracker canceled")
+
+			return
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// handleEvent updates the tracked state with a htlc event. Only forwards are
+// tracked, local sends and receives don't affect reputation.
+func (r *ReputationTracker) handleEvent(event interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch e := event.(type) {
+	case *ForwardingEvent:
+		if e.HtlcEventType != HtlcEventTypeForward ||
+			e.IncomingAmt < e.OutgoingAmt {
+
+			return
+		}
+
+		r.forwards[e.HtlcKey] = &forwardedHtlc{
+			fee:         e.IncomingAmt - e.OutgoingAmt,
+			forwardedAt: e.Timestamp,
+		}
+
+	case *SettleEvent:
+		r.resolveForward(e.HtlcKey, true, e.Timestamp)
+
+	case *ForwardingFailEvent:
+		r.resolveForward(e.HtlcKey, false, e.Timestamp)
+
+	case *LinkFailEvent:
+		r.resolveForward(e.HtlcKey, false, e.Timestamp)
+	}
+}
+
+// resolveForward accounts for the resolution of a forwarded htlc. The
+// incoming channel earns the fee of a settled htlc, and is charged the fee
+// for every resolution period that the htlc was in flight for. The outgoing
+// channel earns the fee of a settled htlc as revenue.
+//
+// NOTE: The caller must hold the mutex.
+func (r *ReputationTracker) resolveForward(key HtlcKey, settled bool,
+	resolvedAt time.Time) {
+
+	fwd, ok := r.forwards[key]
+	if !ok {
+		return
+	}
+	delete(r.forwards, key)
+
+	periods := int64(resolvedAt.Sub(fwd.forwardedAt) /
+		r.cfg.ResolutionPeriod)
+
+	effectiveFee := -float64(fwd.fee) * float64(periods)
+	if settled {
+		effectiveFee += float64(fwd.fee)
+
+		outgoing := key.OutgoingCircuit.ChanID
+		r.value(r.revenue, outgoing).add(
+			float64(fwd.fee), resolvedAt, r.cfg.RevenueHalfLife,
+		)
+	}
+
+	incoming := key.IncomingCircuit.ChanID
+	r.value(r.reputation, incoming).add(
+		effectiveFee, resolvedAt, r.cfg.ReputationHalfLife,
+	)
+
+	log.Tracef("Resolved forward %v (settled=%v) after %v periods, "+
+		"effective fee: %v msat", key, settled, periods, effectiveFee)
+}
+
+// pruneForwards treats forwards that reached the max age as failed.
+func (r *ReputationTracker) pruneForwards(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, fwd := range r.forwards {
+		if now.Sub(fwd.forwardedAt) >= maxForwardAge {
+			r.resolveForward(key, false, now)
+		}
+	}
+}
+
+// value returns the decaying value of the channel in the given set, creating
+// it if it doesn't exist yet.
+func (r *ReputationTracker) value(
+	values map[lnwire.ShortChannelID]*decayingValue,
+	chanID lnwire.ShortChannelID) *decayingValue {
+
+	value, ok := values[chanID]
+	if !ok {
+		value = &decayingValue{}
+		values[chanID] = value
+	}
+
+	return value
+}
+
+// IsReputable returns whether the incoming channel has built up enough
+// reputation to have its endorsed htlcs forwarded to the outgoing channel as
+// endorsed. This is the case if its reputation is positive and at least the
+// revenue of the outgoing channel.
+//
+// NOTE: Part of the ReputationChecker interface.
+func (r *ReputationTracker) IsReputable(incoming,
+	outgoing lnwire.ShortChannelID) bool {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.cfg.Clock.Now()
+
+	var reputation, revenue float64
+	if value, ok := r.reputation[incoming]; ok {
+		reputation = value.valueAt(now, r.cfg.ReputationHalfLife)
+	}
+	if value, ok := r.revenue[outgoing]; ok {
+		revenue = value.valueAt(now, r.cfg.RevenueHalfLife)
+	}
+
+	return reputation > 0 && reputation >= revenue
+}
@@ -0,0 +1,161 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReputationTracker tests that the reputation of incoming channels and
+// the revenue of outgoing channels follow the resolution of forwards.
+func TestReputationTracker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0)
+	testClock := clock.NewTestClock(now)
+	tracker := NewReputationTracker(&ReputationConfig{
+		ResolutionPeriod:   DefaultResolutionPeriod,
+		ReputationHalfLife: DefaultReputationHalfLife,
+		RevenueHalfLife:    DefaultRevenueHalfLife,
+		Clock:              testClock,
+	})
+
+	var (
+		chanA   = lnwire.NewShortChanIDFromInt(1)
+		chanB   = lnwire.NewShortChanIDFromInt(2)
+		chanOut = lnwire.NewShortChanIDFromInt(3)
+	)
+
+	htlcID := uint64(0)
+	forward := func(incoming lnwire.ShortChannelID,
+		fee lnwire.MilliSatoshi) HtlcKey {
+
+		htlcID++
+		key := HtlcKey{
+			IncomingCircuit: models.CircuitKey{
+				ChanID: incoming,
+				HtlcID: htlcID,
+			},
+			OutgoingCircuit: models.CircuitKey{
+				ChanID: chanOut,
+				HtlcID: htlcID,
+			},
+		}
+
+		tracker.handleEvent(&ForwardingEvent{
+			HtlcKey: key,
+			HtlcInfo: HtlcInfo{
+				IncomingAmt: 100_000 + fee,
+				OutgoingAmt: 100_000,
+			},
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     testClock.Now(),
+		})
+
+		return key
+	}
+
+	// Without any history, no channel is reputable.
+	require.False(t, tracker.IsReputable(chanA, chanOut))
+
+	// A forward of channel A that settles quickly gives it reputation,
+	// and gives the outgoing channel the same amount of revenue.
+	key := forward(chanA, 1000)
+	testClock.SetTime(now.Add(time.Second))
+	tracker.handleEvent(&SettleEvent{
+		HtlcKey:       key,
+		HtlcEventType: HtlcEventTypeForward,
+		Timestamp:     testClock.Now(),
+	})
+	require.True(t, tracker.IsReputable(chanA, chanOut))
+
+	// As revenue decays faster than reputation, channel A remains
+	// reputable over time.
+	testClock.SetTime(now.Add(DefaultRevenueHalfLife))
+	require.True(t, tracker.IsReputable(chanA, chanOut))
+
+	// Channel B holds a htlc for several resolution periods before it
+	// fails, so it loses reputation.
+	key = forward(chanB, 1000)
+	testClock.SetTime(testClock.Now().Add(5 * DefaultResolutionPeriod))
+	tracker.handleEvent(&ForwardingFailEvent{
+		HtlcKey:       key,
+		HtlcEventType: HtlcEventTypeForward,
+		Timestamp:     testClock.Now(),
+	})
+	require.False(t, tracker.IsReputable(chanB, chanOut))
+
+	// Channel B builds up reputation with quick settles, but not enough
+	// to outweigh the revenue of the outgoing channel.
+	for i := 0; i < 5; i++ {
+		key = forward(chanB, 1000)
+		tracker.handleEvent(&SettleEvent{
+			HtlcKey:       key,
+			HtlcEventType: HtlcEventTypeForward,
+			Timestamp:     testClock.Now(),
+		})
+	}
+	require.False(t, tracker.IsReputable(chanB, chanOut))
+
+	// Resolutions of htlcs that we didn't forward are ignored.
+	tracker.handleEvent(&SettleEvent{
+		HtlcKey:       key,
+		HtlcEventType: HtlcEventTypeForward,
+		Timestamp:     testClock.Now(),
+	})
+	require.False(t, tracker.IsReputable(chanB, chanOut))
+
+	// Forwards that never resolve are treated as failed once they reach
+	// the max age.
+	forward(chanA, 1000)
+	require.Len(t, tracker.forwards, 1)
+	tracker.pruneForwards(testClock.Now().Add(maxForwardAge))
+	require.Empty(t, tracker.forwards)
+	require.False(t, tracker.IsReputable(chanA, chanOut))
+}
+
+// TestIsEndorsedForward tests that forwards are only endorsed if the incoming
+// htlc is endorsed and the incoming channel is reputable.
+func TestIsEndorsedForward(t *testing.T) {
+	t.Parallel()
+
+	var (
+		incoming = lnwire.NewShortChanIDFromInt(1)
+		outgoing = lnwire.NewShortChanIDFromInt(2)
+	)
+
+	aliceLc, _, err := createTestChannel(
+		t, alicePrivKey, bobPrivKey, 100_000, 100_000, 0, 0, incoming,
+	)
+	require.NoError(t, err)
+
+	reputation := &mockReputation{
+		reputable: make(map[lnwire.ShortChannelID]bool),
+	}
+	link := &channelLink{
+		cfg: ChannelLinkConfig{
+			Endorsement: &EndorsementConfig{
+				Reputation: reputation,
+			},
+		},
+		channel: aliceLc.channel,
+	}
+
+	endorsed := &lnwallet.PaymentDescriptor{Endorsed: true}
+	unendorsed := &lnwallet.PaymentDescriptor{}
+
+	require.False(t, link.isEndorsedForward(endorsed, outgoing))
+
+	reputation.reputable[incoming] = true
+	require.True(t, link.isEndorsedForward(endorsed, outgoing))
+	require.False(t, link.isEndorsedForward(unendorsed, outgoing))
+
+	// Without endorsement config, nothing is endorsed.
+	link.cfg.Endorsement = nil
+	require.False(t, link.isEndorsedForward(endorsed, outgoing))
+}
@@ -85,6 +85,11 @@ var (
 	// fail payments if they increase our fee exposure. This is currently
 	// set to 500m msats.
 	DefaultMaxFeeExposure = lnwire.MilliSatoshi(500_000_000)
+
+	// DefaultMaxCSVDelay is the default maximum CSV delay that a dynamic
+	// commitment may impose on either party, which is the same as the
+	// maximum we accept during channel funding.
+	DefaultMaxCSVDelay = uint16(2016)
 )
 
 // plexPacket encapsulates switch packet and adds error channel to receive
@@ -2383,15 +2388,24 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 		}
 	} else if link.negotiatedAliasFeature() {
 		// The link's SCID is the confirmed SCID for non-zero-conf
-		// option-scid-alias feature bit channels.
+		// option-scid-alias feature bit channels, unless the channel
+		// was spliced.
+		realScid := link.confirmedScid()
 		for _, alias := range aliases {
-			s.aliasToReal[alias] = linkScid
+			s.aliasToReal[alias] = realScid
 			s.baseIndex[alias] = linkScid
 		}
 
 		// Since the link's SCID is confirmed, it was not included in
 		// the baseIndex above as a key. Add it now.
 		s.baseIndex[linkScid] = linkScid
+		s.baseIndex[realScid] = linkScid
+	} else if realScid := link.confirmedScid(); realScid != linkScid {
+		// The channel was spliced, so it's known by the SCID of its
+		// latest funding output, while the link still uses the SCID
+		// of the original one.
+		s.baseIndex[linkScid] = linkScid
+		s.baseIndex[realScid] = linkScid
 	}
 }
 
@@ -2533,8 +2547,11 @@ func (s *Switch) getLinkByMapping(pkt *htlcPacket) (ChannelLink, error) {
 
 	// If the link is unadvertised, we fail since the real SCID was used to
 	// forward over it and this is a channel where the option-scid-alias
-	// feature bit was negotiated.
-	if link.IsUnadvertised() {
+	// feature bit was negotiated or a zero-conf channel. Spliced channels
+	// without either are also found via the baseIndex, and may use their
+	// real SCID.
+	usesAlias := link.negotiatedAliasFeature() || link.isZeroConf()
+	if link.IsUnadvertised() && usesAlias {
 		return nil, ErrChannelLinkNotFound
 	}
 
@@ -2642,8 +2659,8 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 }
 
 // UpdateShortChanID locates the link with the passed-in chanID and updates the
-// underlying channel state. This is only used in zero-conf channels to allow
-// the confirmed SCID to be updated.
+// underlying channel state. This is only used in zero-conf and spliced
+// channels to allow the confirmed SCID to be updated.
 func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	s.indexMtx.Lock()
 	defer s.indexMtx.Unlock()
@@ -2662,8 +2679,8 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 		return err
 	}
 
-	// Since the zero-conf channel is confirmed, we should populate the
-	// aliasToReal map and update the baseIndex.
+	// Since the zero-conf channel is confirmed, or the splice is locked,
+	// we should populate the aliasToReal map and update the baseIndex.
 	aliases := link.getAliases()
 
 	confirmedScid := link.confirmedScid()
@@ -2673,6 +2690,7 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	}
 
 	s.baseIndex[confirmedScid] = link.ShortChanID()
+	s.baseIndex[link.ShortChanID()] = link.ShortChanID()
 
 	return nil
 }
@@ -3046,7 +3064,7 @@ func (s *Switch) AddAliasForLink(chanID lnwire.ChannelID,
 	} else if link.negotiatedAliasFeature() {
 		// The channel is confirmed, so we'll populate the aliasToReal
 		// and baseIndex maps.
-		s.aliasToReal[alias] = linkScid
+		s.aliasToReal[alias] = link.confirmedScid()
 		s.baseIndex[alias] = linkScid
 	}
 
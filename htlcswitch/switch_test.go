@@ -868,6 +868,106 @@ func TestSwitchUpdateScid(t *testing.T) {
 	s.indexMtx.RUnlock()
 }
 
+// TestSwitchSplicedScid verifies that spliced channels can be found via the
+// SCID of their latest funding output, while their links keep using the SCID
+// of the original one.
+func TestSwitchSplicedScid(t *testing.T) {
+	t.Parallel()
+
+	peer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err, "unable to create alice server")
+
+	s, err := initSwitchWithTempDB(t, testStartingHeight)
+	require.NoError(t, err)
+	err = s.Start()
+	require.NoError(t, err)
+	defer func() { _ = s.Stop() }()
+
+	chanID, chanID2, _, _ := genIDs()
+
+	origScid := lnwire.ShortChannelID{
+		BlockHeight: 500000,
+		TxIndex:     0,
+		TxPosition:  0,
+	}
+	spliceScid := lnwire.ShortChannelID{
+		BlockHeight: 500100,
+		TxIndex:     0,
+		TxPosition:  0,
+	}
+
+	// A private channel without the option-scid-alias feature bit doesn't
+	// need any mapping until it's spliced.
+	link := newMockChannelLink(
+		s, chanID, origScid, emptyScid, peer, true, true, false, false,
+	)
+	require.NoError(t, s.AddLink(link))
+
+	s.indexMtx.RLock()
+	_, ok := s.baseIndex[origScid]
+	require.False(t, ok)
+	s.indexMtx.RUnlock()
+
+	// Once the splice is locked, the new SCID maps to the link, which can
+	// be used for forwarding although the channel is private.
+	link.realScid = spliceScid
+	require.NoError(t, s.UpdateShortChanID(chanID))
+
+	s.indexMtx.RLock()
+	baseScid, ok := s.baseIndex[spliceScid]
+	require.True(t, ok)
+	require.Equal(t, origScid, baseScid)
+
+	pkt := &htlcPacket{
+		outgoingChanID: spliceScid,
+	}
+	fwdLink, err := s.getLinkByMapping(pkt)
+	require.NoError(t, err)
+	require.Equal(t, link, fwdLink)
+	require.Equal(t, origScid, pkt.outgoingChanID)
+	s.indexMtx.RUnlock()
+
+	// A spliced option-scid-alias channel maps its aliases to the new
+	// SCID when its link is added.
+	optionOrig := lnwire.ShortChannelID{
+		BlockHeight: 600000,
+		TxIndex:     0,
+		TxPosition:  0,
+	}
+	optionSplice := lnwire.ShortChannelID{
+		BlockHeight: 600100,
+		TxIndex:     0,
+		TxPosition:  0,
+	}
+	optionAlias := lnwire.ShortChannelID{
+		BlockHeight: 16_000_000,
+		TxIndex:     0,
+		TxPosition:  0,
+	}
+	link2 := newMockChannelLink(
+		s, chanID2, optionOrig, optionSplice, peer, true, false, false,
+		true,
+	)
+	link2.addAlias(optionAlias)
+	require.NoError(t, s.AddLink(link2))
+
+	s.indexMtx.RLock()
+	realMapping, ok := s.aliasToReal[optionAlias]
+	require.True(t, ok)
+	require.Equal(t, optionSplice, realMapping)
+
+	for _, scid := range []lnwire.ShortChannelID{
+		optionOrig, optionSplice, optionAlias,
+	} {
+		baseScid, ok = s.baseIndex[scid]
+		require.True(t, ok)
+		require.Equal(t, optionOrig, baseScid)
+	}
+	s.indexMtx.RUnlock()
+}
+
 // TestSwitchForward checks the ability of htlc switch to forward add/settle
 // requests.
 func TestSwitchForward(t *testing.T) {
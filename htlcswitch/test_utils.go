@@ -1168,6 +1168,8 @@ func (h *hopNetwork) createChannelLink(server, peer *mockServer,
 			NotifyInactiveLinkEvent: func(wire.OutPoint) {},
 			HtlcNotifier:            server.htlcSwitch.cfg.HtlcNotifier,
 			GetAliases:              getAliases,
+			PublishKickoff:          func(*wire.MsgTx) error { return nil },
+			NotifyUpgradeSigned:     func() {},
 		},
 		channel,
 	)
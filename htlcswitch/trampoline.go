@@ -0,0 +1,506 @@
+package htlcswitch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/trampoline"
+)
+
+const (
+	// DefaultTrampolineFeeBase is the default base fee that we charge for
+	// forwarding trampoline payments.
+	DefaultTrampolineFeeBase = lnwire.MilliSatoshi(1000)
+
+	// DefaultTrampolineFeeRate is the default proportional fee in parts
+	// per million that we charge for forwarding trampoline payments.
+	DefaultTrampolineFeeRate = 1000
+
+	// DefaultTrampolineCltvDelta is the default number of blocks that we
+	// keep between the incoming and outgoing expiry of trampoline
+	// payments.
+	DefaultTrampolineCltvDelta = 144
+
+	// DefaultTrampolineMppTimeout is the default time that we wait for all
+	// parts of a trampoline payment to arrive.
+	DefaultTrampolineMppTimeout = 60 * time.Second
+)
+
+var (
+	// ErrTrampolineIncorrectDetails is returned by the payment function of
+	// the trampoline forwarder if the recipient rejected the payment
+	// details.
+	ErrTrampolineIncorrectDetails = errors.New("trampoline recipient " +
+		"rejected payment details")
+
+	// ErrTrampolineStopped is returned when the trampoline forwarder is
+	// shutting down.
+	ErrTrampolineStopped = errors.New("trampoline forwarder stopped")
+)
+
+// TrampolinePayment is a payment that we make on behalf of the sender of a
+// trampoline payment.
+type TrampolinePayment struct {
+	// PaymentHash is the payment hash of the incoming htlcs, which is also
+	// used for the outgoing payment.
+	PaymentHash lntypes.Hash
+
+	// Payload is our layer of the trampoline onion. It describes where to
+	// forward the payment to.
+	Payload *trampoline.Payload
+
+	// NextOnion is the trampoline onion to hand to the next trampoline
+	// node. It is nil if the outgoing node is the final recipient.
+	NextOnion []byte
+
+	// FeeLimit is the maximum fee that can be spent on the routes to the
+	// outgoing node.
+	FeeLimit lnwire.MilliSatoshi
+
+	// CltvLimit is the maximum absolute expiry of the outgoing htlcs.
+	CltvLimit uint32
+}
+
+// TrampolineConfig holds the dependencies of the trampoline forwarder.
+type TrampolineConfig struct {
+	// Registry is the invoice registry that htlcs without a trampoline
+	// onion are handed to.
+	Registry InvoiceDatabase
+
+	// NodeKey is used to peel our layer of trampoline onions.
+	NodeKey keychain.SingleKeyECDH
+
+	// FeeBase is the base fee that we charge for forwarding trampoline
+	// payments.
+	FeeBase lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee in parts per million that we charge
+	// for forwarding trampoline payments.
+	FeeRate uint32
+
+	// CltvDelta is the number of blocks that we keep between the incoming
+	// and outgoing expiry.
+	CltvDelta uint32
+
+	// MppTimeout is the time that we wait for all parts of a trampoline
+	// payment to arrive.
+	MppTimeout time.Duration
+
+	// BestHeight returns the current best block height.
+	BestHeight func() (uint32, error)
+
+	// Pay finds routes and pays the outgoing node on behalf of the sender.
+	// It blocks until the payment succeeded or failed.
+	Pay func(context.Context, *TrampolinePayment) (lntypes.Preimage,
+		error)
+
+	// Clock is the time source of the forwarder.
+	Clock clock.Clock
+}
+
+// trampolineSetKey identifies the htlcs that make up a single trampoline
+// payment.
+type trampolineSetKey struct {
+	hash lntypes.Hash
+	addr [32]byte
+}
+
+// trampolineHtlc is an incoming htlc of a trampoline payment.
+type trampolineHtlc struct {
+	circuitKey   models.CircuitKey
+	amt          lnwire.MilliSatoshi
+	expiry       uint32
+	acceptHeight int32
+	subscriber   chan<- interface{}
+}
+
+// trampolineSet collects the htlcs of a trampoline payment until the total
+// amount arrived.
+type trampolineSet struct {
+	onion    []byte
+	total    lnwire.MilliSatoshi
+	received lnwire.MilliSatoshi
+	htlcs    []*trampolineHtlc
+
+	// forwarding is true once all htlcs arrived and the payment is being
+	// forwarded.
+	forwarding bool
+}
+
+// TrampolineForwarder wraps the invoice registry of the links. It holds the
+// htlcs that carry a trampoline onion, pays the next trampoline node or the
+// final recipient once all parts arrived and then settles or fails the htlcs.
+type TrampolineForwarder struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *TrampolineConfig
+
+	mu   sync.Mutex
+	sets map[trampolineSetKey]*trampolineSet
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// A compile-time check to ensure TrampolineForwarder implements the
+// InvoiceDatabase interface.
+var _ InvoiceDatabase = (*TrampolineForwarder)(nil)
+
+// NewTrampolineForwarder creates a new trampoline forwarder.
+func NewTrampolineForwarder(cfg *TrampolineConfig) *TrampolineForwarder {
+	return &TrampolineForwarder{
+		cfg:  cfg,
+		sets: make(map[trampolineSetKey]*trampolineSet),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the trampoline forwarder.
+func (t *TrampolineForwarder) Start() error {
+	t.started.Do(func() {
+		log.Info("TrampolineForwarder starting")
+	})
+
+	return nil
+}
+
+// Stop stops the trampoline forwarder and waits for pending payments to
+// return.
+func (t *TrampolineForwarder) Stop() error {
+	t.stopped.Do(func() {
+		log.Info("TrampolineForwarder shutting down...")
+		defer log.Debug("TrampolineForwarder shutdown complete")
+
+		close(t.quit)
+		t.wg.Wait()
+	})
+
+	return nil
+}
+
+// LookupInvoice attempts to look up an invoice according to its 32 byte
+// payment hash.
+//
+// NOTE: Part of the InvoiceDatabase interface.
+func (t *TrampolineForwarder) LookupInvoice(ctx context.Context,
+	hash lntypes.Hash) (invoices.Invoice, error) {
+
+	return t.cfg.Registry.LookupInvoice(ctx, hash)
+}
+
+// CancelInvoice attempts to cancel the invoice corresponding to the passed
+// payment hash.
+//
+// NOTE: Part of the InvoiceDatabase interface.
+func (t *TrampolineForwarder) CancelInvoice(ctx context.Context,
+	hash lntypes.Hash) error {
+
+	return t.cfg.Registry.CancelInvoice(ctx, hash)
+}
+
+// SettleHodlInvoice settles a hold invoice.
+//
+// NOTE: Part of the InvoiceDatabase interface.
+func (t *TrampolineForwarder) SettleHodlInvoice(ctx context.Context,
+	preimage lntypes.Preimage) error {
+
+	return t.cfg.Registry.SettleHodlInvoice(ctx, preimage)
+}
+
+// NotifyExitHopHtlc hands htlcs without a trampoline onion to the invoice
+// registry. Htlcs with a trampoline onion are held until the trampoline
+// payment completed, after which the resolution is sent on the hodlChan.
+//
+// NOTE: Part of the InvoiceDatabase interface.
+func (t *TrampolineForwarder) NotifyExitHopHtlc(payHash lntypes.Hash,
+	paidAmount lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
+	circuitKey models.CircuitKey, hodlChan chan<- interface{},
+	payload invoices.Payload) (invoices.HtlcResolution, error) {
+
+	onion, ok := payload.CustomRecords()[trampoline.OnionRecordType]
+	if !ok {
+		return t.cfg.Registry.NotifyExitHopHtlc(
+			payHash, paidAmount, expiry, currentHeight,
+			circuitKey, hodlChan, payload,
+		)
+	}
+
+	key := trampolineSetKey{hash: payHash}
+	total := paidAmount
+	if mpp := payload.MultiPath(); mpp != nil {
+		key.addr = mpp.PaymentAddr()
+		total = mpp.TotalMsat()
+	}
+
+	htlc := &trampolineHtlc{
+		circuitKey:   circuitKey,
+		amt:          paidAmount,
+		expiry:       expiry,
+		acceptHeight: currentHeight,
+		subscriber:   hodlChan,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	set, ok := t.sets[key]
+	if !ok {
+		set = &trampolineSet{
+			onion: onion,
+			total: total,
+		}
+		t.sets[key] = set
+
+		t.wg.Add(1)
+		go t.waitForParts(key, set)
+	}
+
+	// A htlc that is replayed after a restart of the link is already
+	// part of the set.
+	for _, held := range set.htlcs {
+		if held.circuitKey == circuitKey {
+			held.subscriber = hodlChan
+
+			return nil, nil
+		}
+	}
+
+	// Parts that don't agree with the rest of the set are rejected right
+	// away, the other parts keep waiting.
+	if total != set.total || set.forwarding {
+		return invoices.NewFailResolution(
+			circuitKey, currentHeight,
+			invoices.ResultHtlcSetTotalMismatch,
+		), nil
+	}
+
+	set.htlcs = append(set.htlcs, htlc)
+	set.received += paidAmount
+
+	log.Debugf("Holding trampoline htlc %v of %v: received %v of %v",
+		circuitKey, payHash, set.received, set.total)
+
+	if set.received < set.total {
+		return nil, nil
+	}
+
+	set.forwarding = true
+
+	t.wg.Add(1)
+	go t.forward(key, set)
+
+	return nil, nil
+}
+
+// HodlUnsubscribeAll unsubscribes from all htlc resolutions.
+//
+// NOTE: Part of the InvoiceDatabase interface.
+func (t *TrampolineForwarder) HodlUnsubscribeAll(
+	subscriber chan<- interface{}) {
+
+	t.cfg.Registry.HodlUnsubscribeAll(subscriber)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, set := range t.sets {
+		for _, htlc := range set.htlcs {
+			if htlc.subscriber == subscriber {
+				htlc.subscriber = nil
+			}
+		}
+	}
+}
+
+// waitForParts fails the htlcs of a trampoline payment if not all parts
+// arrive in time.
+func (t *TrampolineForwarder) waitForParts(key trampolineSetKey,
+	set *trampolineSet) {
+
+	defer t.wg.Done()
+
+	select {
+	case <-t.cfg.Clock.TickAfter(t.cfg.MppTimeout):
+		// Mark the set as forwarding, so that parts arriving late are
+		// rejected rather than added to a set that is being failed.
+		t.mu.Lock()
+		forwarding := set.forwarding
+		set.forwarding = true
+		t.mu.Unlock()
+
+		if forwarding {
+			return
+		}
+
+		log.Debugf("Trampoline payment %v timed out waiting for "+
+			"parts", key.hash)
+
+		t.resolve(key, set, nil, invoices.ResultMppTimeout)
+
+	case <-t.quit:
+	}
+}
+
+// forward pays the outgoing node of a trampoline payment once all parts
+// arrived and resolves the htlcs with the outcome.
+func (t *TrampolineForwarder) forward(key trampolineSetKey,
+	set *trampolineSet) {
+
+	defer t.wg.Done()
+
+	payment, outcome := t.checkPayment(key.hash, set)
+	if payment == nil {
+		t.resolve(key, set, nil, outcome)
+		return
+	}
+
+	log.Infof("Forwarding trampoline payment %v of %v to %v with fee "+
+		"limit %v", key.hash, payment.Payload.AmtToForward,
+		payment.Payload.OutgoingNode, payment.FeeLimit)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-t.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	preimage, err := t.cfg.Pay(ctx, payment)
+	switch {
+	case err == nil:
+		t.resolve(key, set, &preimage, 0)
+
+	// The htlcs are left on hold while we shut down, the links replay
+	// them once we restart.
+	case errors.Is(err, ErrTrampolineStopped):
+		return
+
+	case errors.Is(err, ErrTrampolineIncorrectDetails):
+		outcome := invoices.ResultTrampolineIncorrectDetails
+		t.resolve(key, set, nil, outcome)
+
+	// We can't tell the sender why the payment failed beyond our node
+	// without leaking information, so we ask for a larger fee budget.
+	default:
+		log.Debugf("Trampoline payment %v failed: %v", key.hash, err)
+
+		t.resolve(
+			key, set, nil, invoices.ResultTrampolineFeeInsufficient,
+		)
+	}
+}
+
+// checkPayment peels the trampoline onion of a payment whose parts all
+// arrived and checks that the payment can be forwarded within its fee and
+// CLTV budget.
+func (t *TrampolineForwarder) checkPayment(hash lntypes.Hash,
+	set *trampolineSet) (*TrampolinePayment,
+	invoices.FailResolutionResult) {
+
+	peeled, err := trampoline.PeelOnion(t.cfg.NodeKey, set.onion, hash[:])
+	if err != nil {
+		log.Debugf("Unable to peel trampoline onion of %v: %v", hash,
+			err)
+
+		return nil, invoices.ResultTrampolineInvalidOnion
+	}
+
+	payload, err := trampoline.DecodePayload(peeled.Payload)
+	if err != nil {
+		log.Debugf("Invalid trampoline payload of %v: %v", hash, err)
+
+		return nil, invoices.ResultTrampolineInvalidOnion
+	}
+
+	// The sender must at least pay our own fee on top of the amount to
+	// forward. What remains is the budget for the routes.
+	fee := t.cfg.FeeBase + payload.AmtToForward*
+		lnwire.MilliSatoshi(t.cfg.FeeRate)/1_000_000
+	if set.received < payload.AmtToForward+fee {
+		return nil, invoices.ResultTrampolineFeeInsufficient
+	}
+
+	// The outgoing htlcs must expire early enough that we keep our CLTV
+	// delta to the earliest incoming htlc.
+	expiry := set.htlcs[0].expiry
+	for _, htlc := range set.htlcs[1:] {
+		if htlc.expiry < expiry {
+			expiry = htlc.expiry
+		}
+	}
+	if expiry < t.cfg.CltvDelta {
+		return nil, invoices.ResultTrampolineExpiryTooSoon
+	}
+
+	height, err := t.cfg.BestHeight()
+	if err != nil {
+		log.Errorf("Unable to fetch best height: %v", err)
+
+		return nil, invoices.ResultTrampolineExpiryTooSoon
+	}
+
+	cltvLimit := expiry - t.cfg.CltvDelta
+	if payload.OutgoingCLTV > cltvLimit || cltvLimit <= height {
+
+		return nil, invoices.ResultTrampolineExpiryTooSoon
+	}
+
+	return &TrampolinePayment{
+		PaymentHash: hash,
+		Payload:     payload,
+		NextOnion:   peeled.NextOnion,
+		FeeLimit:    set.received - payload.AmtToForward - fee,
+		CltvLimit:   cltvLimit,
+	}, 0
+}
+
+// resolve settles the htlcs of a trampoline payment with the preimage, or
+// fails them with the outcome if no preimage is given.
+func (t *TrampolineForwarder) resolve(key trampolineSetKey,
+	set *trampolineSet, preimage *lntypes.Preimage,
+	outcome invoices.FailResolutionResult) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.sets, key)
+
+	for _, htlc := range set.htlcs {
+		var resolution invoices.HtlcResolution
+		if preimage != nil {
+			resolution = invoices.NewSettleResolution(
+				*preimage, htlc.circuitKey, htlc.acceptHeight,
+				invoices.ResultSettled,
+			)
+		} else {
+			resolution = invoices.NewFailResolution(
+				htlc.circuitKey, htlc.acceptHeight, outcome,
+			)
+		}
+
+		// Links that went away will replay the htlc once they come
+		// back.
+		if htlc.subscriber == nil {
+			continue
+		}
+
+		select {
+		case htlc.subscriber <- resolution:
+		case <-t.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package htlcswitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/trampoline"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// trampolinePreimage is the preimage of the trampoline payments in
+	// the tests.
+	trampolinePreimage = lntypes.Preimage{9}
+
+	// trampolineHash is the payment hash of the trampoline payments in
+	// the tests.
+	trampolineHash = trampolinePreimage.Hash()
+)
+
+// testExitPayload is a minimal exit hop payload.
+type testExitPayload struct {
+	mpp    *record.MPP
+	custom record.CustomSet
+}
+
+func (p *testExitPayload) MultiPath() *record.MPP            { return p.mpp }
+func (p *testExitPayload) AMPRecord() *record.AMP            { return nil }
+func (p *testExitPayload) CustomRecords() record.CustomSet   { return p.custom }
+func (p *testExitPayload) Metadata() []byte                  { return nil }
+func (p *testExitPayload) PathID() *chainhash.Hash           { return nil }
+func (p *testExitPayload) TotalAmtMsat() lnwire.MilliSatoshi { return 0 }
+
+// testRegistry records the htlcs that the trampoline forwarder hands to the
+// invoice registry.
+type testRegistry struct {
+	InvoiceDatabase
+
+	notified []lntypes.Hash
+}
+
+func (r *testRegistry) NotifyExitHopHtlc(payHash lntypes.Hash,
+	_ lnwire.MilliSatoshi, _ uint32, _ int32, _ models.CircuitKey,
+	_ chan<- interface{}, _ invoices.Payload) (invoices.HtlcResolution,
+	error) {
+
+	r.notified = append(r.notified, payHash)
+
+	return nil, nil
+}
+
+func (r *testRegistry) HodlUnsubscribeAll(chan<- interface{}) {}
+
+// trampolineHarness bundles a trampoline forwarder with its mocked
+// dependencies.
+type trampolineHarness struct {
+	*TrampolineForwarder
+
+	t        *testing.T
+	registry *testRegistry
+	nodeKey  *btcec.PrivateKey
+	clock    *clock.TestClock
+	ticks    chan time.Duration
+	payments chan *TrampolinePayment
+	results  chan error
+}
+
+func newTrampolineHarness(t *testing.T) *trampolineHarness {
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	h := &trampolineHarness{
+		t:        t,
+		registry: &testRegistry{},
+		nodeKey:  nodeKey,
+		ticks:    make(chan time.Duration, 10),
+		payments: make(chan *TrampolinePayment, 1),
+		results:  make(chan error, 1),
+	}
+	h.clock = clock.NewTestClockWithTickSignal(time.Unix(0, 0), h.ticks)
+
+	h.TrampolineForwarder = NewTrampolineForwarder(&TrampolineConfig{
+		Registry:   h.registry,
+		NodeKey:    &keychain.PrivKeyECDH{PrivKey: nodeKey},
+		FeeBase:    1000,
+		CltvDelta:  40,
+		MppTimeout: time.Minute,
+		BestHeight: func() (uint32, error) {
+			return 100, nil
+		},
+		Pay: func(_ context.Context,
+			payment *TrampolinePayment) (lntypes.Preimage, error) {
+
+			h.payments <- payment
+
+			return trampolinePreimage, <-h.results
+		},
+		Clock: h.clock,
+	})
+	require.NoError(t, h.Start())
+	t.Cleanup(func() {
+		require.NoError(t, h.Stop())
+	})
+
+	return h
+}
+
+// onion builds a trampoline onion for the forwarder under test.
+func (h *trampolineHarness) onion(amt lnwire.MilliSatoshi) []byte {
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(h.t, err)
+
+	payload, err := (&trampoline.Payload{
+		AmtToForward: amt,
+		OutgoingCLTV: 500,
+		OutgoingNode: route.Vertex{1},
+		MPP:          record.NewMPP(amt, [32]byte{2}),
+	}).Encode()
+	require.NoError(h.t, err)
+
+	onion, err := trampoline.BuildOnion(sessionKey, []trampoline.Hop{{
+		NodeKey: h.nodeKey.PubKey(),
+		Payload: payload,
+	}}, trampolineHash[:])
+	require.NoError(h.t, err)
+
+	return onion
+}
+
+// sendPart hands a part of a trampoline payment to the forwarder.
+func (h *trampolineHarness) sendPart(htlcID uint64, amt,
+	total lnwire.MilliSatoshi, onion []byte,
+	subscriber chan<- interface{}) invoices.HtlcResolution {
+
+	resolution, err := h.NotifyExitHopHtlc(
+		trampolineHash, amt, 600, 100,
+		models.CircuitKey{HtlcID: htlcID}, subscriber,
+		&testExitPayload{
+			mpp: record.NewMPP(total, [32]byte{3}),
+			custom: record.CustomSet{
+				trampoline.OnionRecordType: onion,
+			},
+		},
+	)
+	require.NoError(h.t, err)
+
+	return resolution
+}
+
+// receiveResolution waits for a resolution on the subscriber.
+func receiveResolution(t *testing.T,
+	subscriber chan interface{}) invoices.HtlcResolution {
+
+	select {
+	case resolution := <-subscriber:
+		return resolution.(invoices.HtlcResolution)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no resolution received")
+		return nil
+	}
+}
+
+// TestTrampolineForward tests that the trampoline forwarder pays the next
+// node once all parts of a trampoline payment arrived, and settles them with
+// the preimage.
+func TestTrampolineForward(t *testing.T) {
+	t.Parallel()
+
+	h := newTrampolineHarness(t)
+
+	// Htlcs without a trampoline onion are handed to the registry.
+	_, err := h.NotifyExitHopHtlc(
+		trampolineHash, 1000, 600, 100, models.CircuitKey{}, nil,
+		&testExitPayload{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []lntypes.Hash{trampolineHash}, h.registry.notified)
+
+	onion := h.onion(10_000)
+	sub1 := make(chan interface{}, 1)
+	sub2 := make(chan interface{}, 1)
+
+	require.Nil(t, h.sendPart(1, 6000, 12_000, onion, sub1))
+	require.Nil(t, h.sendPart(2, 6000, 12_000, onion, sub2))
+
+	// Once the payment is complete, the outgoing node is paid with what
+	// remains after our own fee, and our CLTV delta to the incoming
+	// htlcs.
+	payment := <-h.payments
+	require.EqualValues(t, 10_000, payment.Payload.AmtToForward)
+	require.Equal(t, route.Vertex{1}, payment.Payload.OutgoingNode)
+	require.Equal(t, lnwire.MilliSatoshi(1000), payment.FeeLimit)
+	require.Equal(t, uint32(560), payment.CltvLimit)
+	require.Nil(t, payment.NextOnion)
+	h.results <- nil
+
+	for _, sub := range []chan interface{}{sub1, sub2} {
+		resolution := receiveResolution(t, sub)
+		settle, ok := resolution.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, trampolinePreimage, settle.Preimage)
+	}
+
+	// A payment whose downstream payment fails is failed back.
+	require.Nil(t, h.sendPart(3, 12_000, 12_000, onion, sub1))
+	<-h.payments
+	h.results <- ErrTrampolineIncorrectDetails
+
+	resolution := receiveResolution(t, sub1)
+	fail, ok := resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(
+		t, invoices.ResultTrampolineIncorrectDetails, fail.Outcome,
+	)
+}
+
+// TestTrampolineBudget tests that trampoline payments that don't pay our fee,
+// or whose onion we can't peel, are failed without being forwarded.
+func TestTrampolineBudget(t *testing.T) {
+	t.Parallel()
+
+	h := newTrampolineHarness(t)
+	sub := make(chan interface{}, 1)
+
+	require.Nil(t, h.sendPart(1, 10_500, 10_500, h.onion(10_000), sub))
+
+	resolution := receiveResolution(t, sub)
+	fail, ok := resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(
+		t, invoices.ResultTrampolineFeeInsufficient, fail.Outcome,
+	)
+	require.Empty(t, h.payments)
+
+	// Onions that aren't meant for us are rejected as well.
+	other := newTrampolineHarness(t)
+	onion := other.onion(10_000)
+	require.Nil(t, h.sendPart(2, 20_000, 20_000, onion, sub))
+
+	resolution = receiveResolution(t, sub)
+	fail, ok = resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, invoices.ResultTrampolineInvalidOnion, fail.Outcome)
+}
+
+// TestTrampolineMppTimeout tests that the parts of a trampoline payment are
+// failed if the payment doesn't complete in time.
+func TestTrampolineMppTimeout(t *testing.T) {
+	t.Parallel()
+
+	h := newTrampolineHarness(t)
+	sub := make(chan interface{}, 2)
+
+	require.Nil(t, h.sendPart(1, 6000, 12_000, h.onion(10_000), sub))
+
+	select {
+	case <-h.ticks:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("mpp timeout not started")
+	}
+	h.clock.SetTime(time.Unix(0, 0).Add(time.Minute))
+
+	resolution := receiveResolution(t, sub)
+	fail, ok := resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, invoices.ResultMppTimeout, fail.Outcome)
+	require.Empty(t, h.payments)
+}
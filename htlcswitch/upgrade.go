@@ -0,0 +1,512 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// An upgrade of the commitment type is a dynamic commitment negotiation that
+// moves the channel onto a funding output of the new type via a kickoff
+// transaction. It's proposed by the channel initiator, which pays for the
+// kickoff transaction:
+//
+//  1. The initiator sends dyn_propose with the new channel type, the fee rate
+//     of the kickoff transaction and its musig2 nonce for its new commitment.
+//  2. The recipient replies with dyn_ack, carrying its own nonce and its
+//     partial signature for the initiator's new commitment.
+//  3. The initiator persists the upgrade, and sends kickoff_sig with its
+//     signature for the kickoff transaction and its partial signature for
+//     the recipient's new commitment.
+//  4. The recipient persists the upgrade, replies with its own kickoff_sig,
+//     and both parties broadcast the kickoff transaction.
+//
+// Both parties keep the channel quiescent from the moment they send their
+// dyn_propose or dyn_ack. Once the kickoff transaction is buried deep enough,
+// the upgrade is applied like a splice: the channel moves onto the new funding
+// output, and is announced under its new short channel id after both parties
+// exchanged splice_locked.
+
+// handleUpgradeReq starts an upgrade of the commitment type of the channel by
+// sending our proposal to the remote party.
+func (l *channelLink) handleUpgradeReq(req *dynCommitReq) {
+	params := req.params
+	commitType := params.CommitmentType.UnwrapOr(
+		lnwallet.CommitmentTypeSimpleTaproot,
+	)
+	proposal := newDynPropose(l.ChanID(), params)
+
+	switch {
+	case l.cfg.DisallowCommitUpgrades:
+		req.errChan <- ErrUpgradeDisabled
+		return
+
+	case commitType != lnwallet.CommitmentTypeSimpleTaproot:
+		req.errChan <- fmt.Errorf("%w: unable to upgrade to %v",
+			lnwallet.ErrUpgradeUnsupported, commitType)
+		return
+
+	case params.KickoffFeeRate.IsNone():
+		req.errChan <- errors.New("kickoff fee rate required")
+		return
+
+	case !proposedDynFields(proposal).IsEmpty():
+		req.errChan <- ErrUpgradeCombined
+		return
+
+	case !l.channel.IsInitiator():
+		req.errChan <- ErrUpgradeNotInitiator
+		return
+
+	case !l.quiescer.canSendUpdates():
+		req.errChan <- ErrQuiescenceInProgress
+		return
+	}
+
+	feeRate := params.KickoffFeeRate.UnsafeFromSome()
+	upgrade, err := l.channel.NewUpgradeCommitments(feeRate, nil)
+	if err != nil {
+		req.errChan <- err
+		return
+	}
+
+	chanType := l.channel.State().ChanType
+	proposal.ChannelType = fn.Some(
+		lnwallet.TaprootUpgradeWireType(chanType),
+	)
+	proposal.KickoffFeerate = fn.Some(feeRate)
+	proposal.LocalNonce = fn.Some(upgrade.LocalNonce())
+
+	l.log.Infof("Proposing upgrade to simple taproot channel, "+
+		"kickoff_fee=%v", upgrade.KickoffFee)
+
+	// Unlike other proposals, an upgrade isn't persisted before it's
+	// signed, as the remote party discards it on disconnection anyway.
+	l.freezeForUpgrade()
+	err = l.sendDynPropose(proposal, req.errChan)
+	l.dynCommit.upgrade = upgrade
+	if err != nil {
+		l.log.Errorf("unable to send DynPropose: %v", err)
+		l.finishDynCommit(err)
+	}
+}
+
+// handleUpgradePropose processes the remote party's proposal to upgrade the
+// commitment type of the channel. If we accept it, we sign the new commitment
+// of the remote party and wait for its kickoff_sig. Otherwise, we'll respond
+// with a DynReject.
+func (l *channelLink) handleUpgradePropose(msg *lnwire.DynPropose) {
+	// If we already signed the upgrade, the remote party re-sends its
+	// proposal along with its kickoff_sig, which we answer with ours.
+	upgrade, err := l.channel.PendingUpgrade()
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to fetch pending upgrade: %v", err)
+		return
+	}
+	if upgrade != nil || l.commitUpgrade != nil {
+		l.log.Debugf("Ignoring proposal of pending upgrade")
+		return
+	}
+
+	commits, sig, err := l.acceptUpgrade(msg)
+	if err != nil {
+		l.log.Infof("Rejecting commitment type upgrade: %v", err)
+		l.sendDynReject(msg)
+
+		return
+	}
+
+	l.log.Infof("Accepted upgrade to simple taproot channel, "+
+		"kickoff_txid=%v", commits.KickoffTx.TxHash())
+
+	l.freezeForUpgrade()
+	l.commitUpgrade = commits
+
+	err = l.cfg.Peer.SendMessage(false, &lnwire.DynAck{
+		ChanID:     l.ChanID(),
+		LocalNonce: fn.Some(commits.LocalNonce()),
+		PartialSig: lnwire.MaybePartialSigWithNonce(sig),
+	})
+	if err != nil {
+		l.log.Errorf("unable to send DynAck: %v", err)
+	}
+}
+
+// acceptUpgrade checks the remote party's proposal to upgrade the commitment
+// type of the channel, and creates and signs the new commitments if it's
+// acceptable.
+func (l *channelLink) acceptUpgrade(msg *lnwire.DynPropose) (
+	*lnwallet.UpgradeCommitments, *lnwire.PartialSigWithNonce, error) {
+
+	// Apart from the upgrade types, the proposal may only carry the nonce
+	// of the remote party.
+	otherFields := proposedDynFields(msg)
+	for _, typ := range dynUpgradeTypes {
+		otherFields.Unset(lnwire.FeatureBit(typ))
+	}
+
+	switch {
+	case l.cfg.DisallowDynCommitments:
+		return nil, nil, ErrDynCommitDisabled
+
+	case l.cfg.DisallowCommitUpgrades:
+		return nil, nil, ErrUpgradeDisabled
+
+	case l.channel.IsInitiator():
+		return nil, nil, ErrUpgradeNotInitiator
+
+	case !otherFields.IsEmpty():
+		return nil, nil, ErrUpgradeCombined
+
+	case l.IsFlushing(Outgoing):
+		return nil, nil, ErrLinkShuttingDown
+
+	case !l.quiescer.canSendUpdates():
+		return nil, nil, ErrQuiescenceInProgress
+	}
+
+	chanType, err := msg.ChannelType.UnwrapOrErr(
+		errors.New("channel type required"),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wireType := lnwallet.TaprootUpgradeWireType(l.channel.State().ChanType)
+	if !sameChannelType(chanType, wireType) {
+		return nil, nil, fmt.Errorf("%w: unexpected channel type",
+			lnwallet.ErrUpgradeUnsupported)
+	}
+
+	feeRate, err := msg.KickoffFeerate.UnwrapOrErr(
+		errors.New("kickoff fee rate required"),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, nil, fmt.Errorf("kickoff fee rate %v below "+
+			"floor %v", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	nonce, err := msg.LocalNonce.UnwrapOrErr(errNoUpgradeNonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fundingKey *btcec.PublicKey
+	msg.FundingKey.WhenSome(func(key btcec.PublicKey) {
+		fundingKey = &key
+	})
+
+	commits, err := l.channel.NewUpgradeCommitments(feeRate, fundingKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := l.channel.SignUpgradeCommitment(commits, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commits, sig, nil
+}
+
+// handleUpgradeAck processes the remote party's acceptance of our proposal to
+// upgrade the commitment type. We verify its signature for our new
+// commitment, persist the upgrade, and send our kickoff_sig.
+func (l *channelLink) handleUpgradeAck(msg *lnwire.DynAck) {
+	commits := l.dynCommit.upgrade
+
+	sig, err := msg.PartialSig.UnwrapOrErrV(errNoUpgradeSig)
+	if err == nil {
+		err = l.channel.VerifyUpgradeCommitment(commits, &sig)
+	}
+	if err != nil {
+		l.finishDynCommit(err)
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"invalid DynAck for upgrade: %v", err,
+		)
+
+		return
+	}
+
+	nonce, err := msg.LocalNonce.UnwrapOrErr(errNoUpgradeNonce)
+	if err != nil {
+		l.finishDynCommit(err)
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"invalid DynAck for upgrade: %v", err,
+		)
+
+		return
+	}
+
+	kickoffSig, err := l.signUpgrade(commits, nonce)
+	if err == nil {
+		err = l.channel.AddPendingUpgrade(
+			commits.ChannelSplice(l.dynCommit.proposal, kickoffSig),
+		)
+	}
+	if err != nil {
+		l.finishDynCommit(err)
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to sign upgrade: %v", err)
+
+		return
+	}
+
+	l.log.Infof("Signed upgrade to simple taproot channel, "+
+		"kickoff_txid=%v", commits.KickoffTx.TxHash())
+
+	// The upgrade is persisted, so the channel stays quiescent until the
+	// kickoff transaction confirms.
+	l.dynCommit.upgrade = nil
+	l.finishDynCommit(nil)
+	l.cfg.NotifyUpgradeSigned()
+
+	if err := l.cfg.Peer.SendMessage(false, kickoffSig); err != nil {
+		l.log.Errorf("unable to send KickoffSig: %v", err)
+	}
+}
+
+// signUpgrade signs the new commitment of the remote party with the passed
+// nonce, as well as the kickoff transaction, and returns our kickoff_sig.
+func (l *channelLink) signUpgrade(commits *lnwallet.UpgradeCommitments,
+	remoteNonce lnwire.Musig2Nonce) (*lnwire.KickoffSig, error) {
+
+	commitSig, err := l.channel.SignUpgradeCommitment(commits, remoteNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	kickoffSig, err := l.channel.SignSpliceInput(commits.KickoffTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwire.KickoffSig{
+		ChanID:     l.ChanID(),
+		Signature:  kickoffSig,
+		PartialSig: lnwire.MaybePartialSigWithNonce(commitSig),
+	}, nil
+}
+
+// handleKickoffSig processes the remote party's kickoff_sig for an upgrade of
+// the commitment type.
+func (l *channelLink) handleKickoffSig(msg *lnwire.KickoffSig) {
+	upgrade, err := l.channel.PendingUpgrade()
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to fetch pending upgrade: %v", err)
+		return
+	}
+
+	switch {
+	// We accepted the upgrade, and the remote party signed our new
+	// commitment and the kickoff transaction.
+	case upgrade == nil && l.commitUpgrade != nil:
+		l.completeUpgrade(msg)
+
+	case upgrade == nil:
+		l.log.Warnf("Received KickoffSig without pending upgrade")
+
+	// If we accepted the upgrade, the remote party re-sends its
+	// kickoff_sig until it received ours.
+	case upgrade.IsFinal():
+		if upgrade.Upgrade.LocalProposal != nil {
+			return
+		}
+
+		l.log.Debugf("Re-sending KickoffSig")
+
+		err := l.cfg.Peer.SendMessage(
+			false, upgrade.Upgrade.LocalKickoffSig,
+		)
+		if err != nil {
+			l.log.Errorf("unable to send KickoffSig: %v", err)
+		}
+
+	// We proposed the upgrade, and the remote party signed the kickoff
+	// transaction in return for our signatures.
+	default:
+		l.finalizeUpgrade(upgrade, msg)
+	}
+}
+
+// completeUpgrade verifies the signatures of the initiator of an upgrade that
+// we accepted, persists the upgrade, and replies with our own kickoff_sig.
+func (l *channelLink) completeUpgrade(msg *lnwire.KickoffSig) {
+	commits := l.commitUpgrade
+	l.commitUpgrade = nil
+
+	sig, err := msg.PartialSig.UnwrapOrErrV(errNoUpgradeSig)
+	if err == nil {
+		err = l.channel.VerifyUpgradeCommitment(commits, &sig)
+	}
+
+	var localSig lnwire.Sig
+	if err == nil {
+		localSig, err = l.channel.SignSpliceInput(commits.KickoffTx)
+	}
+	if err == nil {
+		commits.KickoffTx, err = l.channel.FinalizeKickoff(
+			commits.KickoffTx, localSig, msg.Signature,
+		)
+	}
+	if err != nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"invalid KickoffSig: %v", err,
+		)
+
+		return
+	}
+
+	kickoffSig := &lnwire.KickoffSig{
+		ChanID:    l.ChanID(),
+		Signature: localSig,
+	}
+	err = l.channel.AddPendingUpgrade(
+		commits.ChannelSplice(nil, kickoffSig),
+	)
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to persist upgrade: %v", err)
+		return
+	}
+
+	l.log.Infof("Signed upgrade to simple taproot channel, "+
+		"kickoff_txid=%v", commits.KickoffTx.TxHash())
+
+	l.cfg.NotifyUpgradeSigned()
+
+	if err := l.cfg.Peer.SendMessage(false, kickoffSig); err != nil {
+		l.log.Errorf("unable to send KickoffSig: %v", err)
+	}
+
+	l.publishKickoff(commits.KickoffTx)
+}
+
+// finalizeUpgrade completes the kickoff transaction of an upgrade that we
+// proposed with the remote party's signature, and broadcasts it.
+func (l *channelLink) finalizeUpgrade(upgrade *channeldb.ChannelSplice,
+	msg *lnwire.KickoffSig) {
+
+	kickoffTx, err := l.channel.FinalizeKickoff(
+		upgrade.FundingTx, upgrade.Upgrade.LocalKickoffSig.Signature,
+		msg.Signature,
+	)
+	if err != nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"invalid KickoffSig: %v", err,
+		)
+
+		return
+	}
+
+	err = l.channel.State().FinalizeSplice(
+		upgrade.FundingOutpoint, kickoffTx,
+	)
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to persist kickoff tx: %v", err)
+		return
+	}
+
+	l.publishKickoff(kickoffTx)
+}
+
+// resumeCommitUpgrade continues a pending upgrade of the commitment type on
+// re-establish. If the kickoff transaction is fully signed, it's broadcast
+// again. Otherwise we proposed the upgrade, and re-send our proposal along
+// with our kickoff_sig, so the remote party can sign the kickoff transaction
+// even if it discarded the upgrade.
+func (l *channelLink) resumeCommitUpgrade() {
+	upgrade, err := l.channel.PendingUpgrade()
+	if err != nil {
+		l.log.Errorf("unable to fetch pending upgrade: %v", err)
+		return
+	}
+
+	switch {
+	case upgrade == nil:
+
+	case upgrade.IsFinal():
+		l.publishKickoff(upgrade.FundingTx)
+
+	case upgrade.Upgrade.LocalProposal != nil:
+		l.log.Infof("Resuming upgrade to simple taproot channel")
+
+		err := l.cfg.Peer.SendMessage(
+			false, upgrade.Upgrade.LocalProposal,
+			upgrade.Upgrade.LocalKickoffSig,
+		)
+		if err != nil {
+			l.log.Errorf("unable to send upgrade messages: %v",
+				err)
+		}
+	}
+}
+
+// freezeForUpgrade makes the channel quiescent without exchanging stfu
+// messages, as both parties agreed to stop updating it while its commitment
+// type is upgraded.
+func (l *channelLink) freezeForUpgrade() {
+	l.quiescer.startQuiescent()
+	l.quiescent = true
+}
+
+// publishKickoff broadcasts the kickoff transaction of an upgrade.
+func (l *channelLink) publishKickoff(kickoffTx *wire.MsgTx) {
+	l.log.Infof("Broadcasting kickoff tx %v", kickoffTx.TxHash())
+
+	if err := l.cfg.PublishKickoff(kickoffTx); err != nil {
+		l.log.Errorf("unable to publish kickoff tx %v: %v",
+			kickoffTx.TxHash(), err)
+	}
+}
+
+// sameChannelType returns true if both channel types have the same features
+// set.
+func sameChannelType(a, b lnwire.ChannelType) bool {
+	aFeatures := lnwire.RawFeatureVector(a)
+	bFeatures := lnwire.RawFeatureVector(b)
+
+	var aBytes, bBytes bytes.Buffer
+	if err := aFeatures.Encode(&aBytes); err != nil {
+		return false
+	}
+	if err := bFeatures.Encode(&bBytes); err != nil {
+		return false
+	}
+
+	return bytes.Equal(aBytes.Bytes(), bBytes.Bytes())
+}
@@ -0,0 +1,485 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradeParams are the parameters that upgrade a channel to a simple taproot
+// channel.
+var upgradeParams = DynCommitParams{
+	CommitmentType: fn.Some[lnwallet.CommitmentType](
+		lnwallet.CommitmentTypeSimpleTaproot,
+	),
+	KickoffFeeRate: fn.Some(chainfee.SatPerKWeight(1_000)),
+}
+
+// anchorChanType are the channel type bits of the anchor channels that can be
+// upgraded.
+const anchorChanType = channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+
+// TestSameChannelType tests that channel types are compared by their
+// features.
+func TestSameChannelType(t *testing.T) {
+	t.Parallel()
+
+	chanType := func(bits ...lnwire.FeatureBit) lnwire.ChannelType {
+		return lnwire.ChannelType(*lnwire.NewRawFeatureVector(bits...))
+	}
+
+	taproot := chanType(lnwire.SimpleTaprootChannelsRequiredStaging)
+	taprootAlias := chanType(
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+		lnwire.ScidAliasRequired,
+	)
+	aliasTaproot := chanType(
+		lnwire.ScidAliasRequired,
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+	)
+
+	require.True(t, sameChannelType(taproot, taproot))
+	require.True(t, sameChannelType(taprootAlias, aliasTaproot))
+	require.False(t, sameChannelType(taproot, taprootAlias))
+	require.False(t, sameChannelType(taproot, chanType(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	)))
+}
+
+// TestDynCommitUpgradeRejected tests that an upgrade of the commitment type
+// is refused if the channel doesn't support it, or if it isn't proposed by
+// the channel initiator.
+func TestDynCommitUpgradeRejected(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	propose := func(link ChannelLink, params DynCommitParams) error {
+		select {
+		case err := <-link.ProposeDynCommitment(params):
+			return err
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("dynamic commitment negotiation didn't " +
+				"complete")
+
+			return nil
+		}
+	}
+
+	upgrade := upgradeParams
+
+	// The test channels don't use anchors, so they can't be upgraded.
+	err = propose(n.aliceChannelLink, upgrade)
+	require.ErrorIs(t, err, lnwallet.ErrUpgradeUnsupported)
+
+	// Bob isn't the initiator of the channel.
+	err = propose(n.firstBobChannelLink, upgrade)
+	require.ErrorIs(t, err, ErrUpgradeNotInitiator)
+
+	// An upgrade can't be combined with other parameters.
+	combined := upgrade
+	combined.MaxAcceptedHTLCs = fn.Some(uint16(100))
+	err = propose(n.aliceChannelLink, combined)
+	require.ErrorIs(t, err, ErrUpgradeCombined)
+
+	// The channel should still be usable for payments.
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.firstBobChannelLink,
+	)
+	_, err = makePayment(
+		n.aliceServer, n.bobServer, n.firstBobChannelLink.ShortChanID(),
+		hops, amount, htlcAmt, totalTimelock,
+	).Wait(30 * time.Second)
+	require.NoError(t, err, "unable to make the payment")
+}
+
+// TestDynCommitUpgrade tests that two links are able to sign an upgrade of
+// their anchor channel to a simple taproot channel, and that both of them
+// broadcast the same kickoff transaction.
+func TestDynCommitUpgrade(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	// Only anchor channels can be upgraded. As the channels don't have
+	// any HTLCs, their commitments are the same apart from the fee, which
+	// doesn't matter for the upgrade.
+	channels.aliceToBob.State().ChanType |= anchorChanType
+	channels.bobToAlice.State().ChanType |= anchorChanType
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	aliceKickoff := make(chan *wire.MsgTx, 1)
+	n.aliceChannelLink.cfg.PublishKickoff = func(tx *wire.MsgTx) error {
+		aliceKickoff <- tx
+		return nil
+	}
+	bobKickoff := make(chan *wire.MsgTx, 1)
+	n.firstBobChannelLink.cfg.PublishKickoff = func(tx *wire.MsgTx) error {
+		bobKickoff <- tx
+		return nil
+	}
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	select {
+	case err := <-n.aliceChannelLink.ProposeDynCommitment(upgradeParams):
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+
+	recvKickoff := func(kickoffs chan *wire.MsgTx) *wire.MsgTx {
+		select {
+		case tx := <-kickoffs:
+			return tx
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("kickoff tx not published")
+			return nil
+		}
+	}
+	aliceTx := recvKickoff(aliceKickoff)
+	bobTx := recvKickoff(bobKickoff)
+	require.Equal(t, aliceTx.TxHash(), bobTx.TxHash())
+	require.Len(t, aliceTx.TxIn[0].Witness, 4)
+
+	// Both parties persisted the fully signed upgrade.
+	for _, channel := range []*lnwallet.LightningChannel{
+		channels.aliceToBob, channels.bobToAlice,
+	} {
+		upgrade, err := channel.PendingUpgrade()
+		require.NoError(t, err)
+		require.NotNil(t, upgrade)
+		require.True(t, upgrade.IsFinal())
+		require.Equal(t, aliceTx.TxHash(), upgrade.FundingTx.TxHash())
+	}
+
+	// The channel stays quiescent until the kickoff tx confirms, so no
+	// further upgrade can be started.
+	select {
+	case err := <-n.aliceChannelLink.ProposeDynCommitment(upgradeParams):
+		require.ErrorIs(t, err, ErrQuiescenceInProgress)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+}
+
+// TestUpgradeProposalInvalid tests that the recipient of an upgrade proposal
+// rejects it if it lacks any of the parameters of the upgrade, or if they
+// don't match the channel.
+func TestUpgradeProposalInvalid(t *testing.T) {
+	t.Parallel()
+
+	channels, _, err := createClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	channels.aliceToBob.State().ChanType |= anchorChanType
+	channels.bobToAlice.State().ChanType |= anchorChanType
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// We'll intercept the responses that Alice receives from Bob.
+	rejects := make(chan *lnwire.DynReject, 1)
+	n.aliceServer.intersect(func(m lnwire.Message) (bool, error) {
+		if msg, ok := m.(*lnwire.DynReject); ok {
+			rejects <- msg
+		}
+
+		return false, nil
+	})
+
+	require.NoError(t, n.start())
+	t.Cleanup(n.stop)
+
+	// Alice's nonce is valid, so the proposals are only rejected because
+	// of the parameters each test case changes.
+	feeRate := upgradeParams.KickoffFeeRate.UnsafeFromSome()
+	aliceCommits, err := channels.aliceToBob.NewUpgradeCommitments(
+		feeRate, nil,
+	)
+	require.NoError(t, err)
+
+	chanID := n.firstBobChannelLink.ChanID()
+	newProposal := func() *lnwire.DynPropose {
+		return &lnwire.DynPropose{
+			ChanID:    chanID,
+			Initiator: true,
+			ChannelType: fn.Some(lnwallet.TaprootUpgradeWireType(
+				channels.bobToAlice.State().ChanType,
+			)),
+			KickoffFeerate: fn.Some(feeRate),
+			LocalNonce:     fn.Some(aliceCommits.LocalNonce()),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(*lnwire.DynPropose)
+	}{
+		{
+			name: "not taproot",
+			modify: func(dp *lnwire.DynPropose) {
+				dp.ChannelType = fn.Some(lnwire.ChannelType(
+					*lnwire.NewRawFeatureVector(
+						lnwire.AnchorsZeroFeeHtlcTxRequired,
+					),
+				))
+			},
+		},
+		{
+			name: "no fee rate",
+			modify: func(dp *lnwire.DynPropose) {
+				dp.KickoffFeerate = fn.None[chainfee.SatPerKWeight]()
+			},
+		},
+		{
+			name: "fee rate below floor",
+			modify: func(dp *lnwire.DynPropose) {
+				dp.KickoffFeerate = fn.Some(
+					chainfee.FeePerKwFloor - 1,
+				)
+			},
+		},
+		{
+			name: "no nonce",
+			modify: func(dp *lnwire.DynPropose) {
+				dp.LocalNonce = fn.None[lnwire.Musig2Nonce]()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		proposal := newProposal()
+		tc.modify(proposal)
+		n.firstBobChannelLink.HandleChannelUpdate(proposal)
+
+		select {
+		case msg := <-rejects:
+			require.True(t, msg.UpdateRejections.IsSet(
+				lnwire.FeatureBit(lnwire.DPChannelType),
+			), tc.name)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%v: bob didn't reject the proposal", tc.name)
+		}
+
+		upgrade, err := channels.bobToAlice.PendingUpgrade()
+		require.NoError(t, err)
+		require.Nil(t, upgrade, tc.name)
+	}
+}
+
+// newUpgradeTestHarness returns a link harness of Alice, who is the initiator
+// of an anchor channel with Bob, whose channel is returned as well. The
+// kickoff transactions broadcast by Alice's link are sent on the returned
+// channel.
+func newUpgradeTestHarness(t *testing.T) (*persistentLinkHarness,
+	*lnwallet.LightningChannel, chan *wire.MsgTx) {
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	const chanReserve = btcutil.SatoshiPerBitcoin * 1
+	harness, err := newSingleLinkTestHarness(t, chanAmt, chanReserve)
+	require.NoError(t, err)
+
+	aliceLink := harness.aliceLink.(*channelLink)
+	aliceLink.channel.State().ChanType |= anchorChanType
+	harness.bobChannel.State().ChanType |= anchorChanType
+
+	kickoffs := make(chan *wire.MsgTx, 1)
+	aliceLink.cfg.PublishKickoff = func(tx *wire.MsgTx) error {
+		kickoffs <- tx
+		return nil
+	}
+
+	require.NoError(t, harness.start())
+	t.Cleanup(func() {
+		harness.aliceLink.Stop()
+	})
+
+	alice := newPersistentLinkHarness(t, harness.aliceSwitch,
+		harness.aliceLink, harness.aliceBatchTicker,
+		harness.aliceRestore,
+	)
+
+	return alice, harness.bobChannel, kickoffs
+}
+
+// recvMsg returns the next message sent by the link of the harness.
+func recvMsg[T lnwire.Message](t *testing.T, h *persistentLinkHarness) T {
+	t.Helper()
+
+	select {
+	case msg := <-h.msgs:
+		typed, ok := msg.(T)
+		require.Truef(t, ok, "unexpected message %T", msg)
+
+		return typed
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("did not receive message")
+
+		var empty T
+		return empty
+	}
+}
+
+// TestUpgradeInvalidAck tests that the initiator of an upgrade abandons it if
+// the remote party's signature for its new commitment is invalid.
+func TestUpgradeInvalidAck(t *testing.T) {
+	t.Parallel()
+
+	alice, bobChannel, _ := newUpgradeTestHarness(t)
+
+	errChan := alice.link.ProposeDynCommitment(upgradeParams)
+	proposal := recvMsg[*lnwire.DynPropose](t, alice)
+
+	// Bob signs a commitment that spends a kickoff transaction with a
+	// different fee rate, so his signature is invalid for Alice's
+	// commitment.
+	feeRate := upgradeParams.KickoffFeeRate.UnsafeFromSome()
+	bobCommits, err := bobChannel.NewUpgradeCommitments(feeRate*2, nil)
+	require.NoError(t, err)
+	sig, err := bobChannel.SignUpgradeCommitment(
+		bobCommits, proposal.LocalNonce.UnsafeFromSome(),
+	)
+	require.NoError(t, err)
+
+	alice.link.HandleChannelUpdate(&lnwire.DynAck{
+		ChanID:     proposal.ChanID,
+		LocalNonce: fn.Some(bobCommits.LocalNonce()),
+		PartialSig: lnwire.MaybePartialSigWithNonce(sig),
+	})
+
+	select {
+	case err := <-errChan:
+		require.ErrorIs(t, err, lnwallet.ErrInvalidSpliceSig)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+
+	upgrade, err := alice.channel.PendingUpgrade()
+	require.NoError(t, err)
+	require.Nil(t, upgrade)
+}
+
+// TestUpgradeResume tests that the initiator of an upgrade re-sends its
+// proposal along with its kickoff_sig on re-establish until it receives the
+// remote party's kickoff_sig, and broadcasts the kickoff transaction once it
+// does.
+func TestUpgradeResume(t *testing.T) {
+	t.Parallel()
+
+	alice, bobChannel, kickoffs := newUpgradeTestHarness(t)
+
+	errChan := alice.link.ProposeDynCommitment(upgradeParams)
+	proposal := recvMsg[*lnwire.DynPropose](t, alice)
+	require.True(t, proposal.ChannelType.IsSome())
+
+	// Bob accepts the upgrade, and signs Alice's new commitment.
+	feeRate := proposal.KickoffFeerate.UnsafeFromSome()
+	bobCommits, err := bobChannel.NewUpgradeCommitments(feeRate, nil)
+	require.NoError(t, err)
+	sig, err := bobChannel.SignUpgradeCommitment(
+		bobCommits, proposal.LocalNonce.UnsafeFromSome(),
+	)
+	require.NoError(t, err)
+
+	alice.link.HandleChannelUpdate(&lnwire.DynAck{
+		ChanID:     proposal.ChanID,
+		LocalNonce: fn.Some(bobCommits.LocalNonce()),
+		PartialSig: lnwire.MaybePartialSigWithNonce(sig),
+	})
+
+	select {
+	case err := <-errChan:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("dynamic commitment negotiation didn't complete")
+	}
+
+	// Alice signed Bob's new commitment and the kickoff transaction.
+	kickoffSig := recvMsg[*lnwire.KickoffSig](t, alice)
+	aliceSig, err := kickoffSig.PartialSig.UnwrapOrErrV(errNoUpgradeSig)
+	require.NoError(t, err)
+	require.NoError(t, bobChannel.VerifyUpgradeCommitment(
+		bobCommits, &aliceSig,
+	))
+
+	upgrade, err := alice.channel.PendingUpgrade()
+	require.NoError(t, err)
+	require.NotNil(t, upgrade)
+	require.False(t, upgrade.IsFinal())
+	require.Equal(
+		t, bobCommits.KickoffTx.TxHash(), upgrade.FundingTx.TxHash(),
+	)
+
+	// Alice restarts before she receives Bob's kickoff_sig, so she
+	// re-sends both of her messages once the channel is re-established.
+	alice.restart(false, true)
+	recvMsg[*lnwire.ChannelReestablish](t, alice)
+
+	bobReest, err := bobChannel.State().ChanSyncMsg()
+	require.NoError(t, err)
+	alice.link.HandleChannelUpdate(bobReest)
+
+	// As no commitment was signed yet, Alice re-sends channel_ready first.
+	recvMsg[*lnwire.ChannelReady](t, alice)
+
+	resentProposal := recvMsg[*lnwire.DynPropose](t, alice)
+	require.Equal(t, proposal.KickoffFeerate, resentProposal.KickoffFeerate)
+	resentSig := recvMsg[*lnwire.KickoffSig](t, alice)
+	require.Equal(t, kickoffSig.Signature, resentSig.Signature)
+
+	// Once Bob's kickoff_sig arrives, Alice broadcasts the fully signed
+	// kickoff transaction.
+	bobSig, err := bobChannel.SignSpliceInput(bobCommits.KickoffTx)
+	require.NoError(t, err)
+	alice.link.HandleChannelUpdate(&lnwire.KickoffSig{
+		ChanID:    proposal.ChanID,
+		Signature: bobSig,
+	})
+
+	select {
+	case tx := <-kickoffs:
+		require.Equal(t, bobCommits.KickoffTx.TxHash(), tx.TxHash())
+		require.Len(t, tx.TxIn[0].Witness, 4)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("kickoff tx not published")
+	}
+
+	upgrade, err = alice.channel.PendingUpgrade()
+	require.NoError(t, err)
+	require.True(t, upgrade.IsFinal())
+}
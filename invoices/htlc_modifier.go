@@ -0,0 +1,158 @@
+package invoices
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+)
+
+var (
+	// ErrHtlcModifierAlreadyExists is returned when a htlc modifier is
+	// registered while another one is still active.
+	ErrHtlcModifierAlreadyExists = errors.New("htlc modifier already " +
+		"exists")
+)
+
+// HtlcModifyRequest is the request that is passed to the registered htlc
+// modifier for every htlc that pays one of our invoices.
+type HtlcModifyRequest struct {
+	// Invoice is the invoice that the htlc pays to, as it was before the
+	// htlc arrived.
+	Invoice Invoice
+
+	// ExitHtlcCircuitKey is the circuit key that identifies the htlc.
+	ExitHtlcCircuitKey CircuitKey
+
+	// ExitHtlcAmt is the amount of the htlc.
+	ExitHtlcAmt lnwire.MilliSatoshi
+
+	// ExitHtlcExpiry is the absolute expiry height of the htlc.
+	ExitHtlcExpiry uint32
+
+	// CurrentHeight is the current block height.
+	CurrentHeight uint32
+
+	// CustomRecords are the custom records of the htlc payload.
+	CustomRecords record.CustomSet
+}
+
+// HtlcModifyResponse is the decision of the htlc modifier about a htlc.
+type HtlcModifyResponse struct {
+	// Reject indicates that the htlc must be failed back.
+	Reject bool
+
+	// FailureMessage is the failure that a rejected htlc is failed back
+	// with. If it is nil, the htlc is failed with incorrect payment
+	// details.
+	FailureMessage lnwire.FailureMessage
+
+	// AmountPaid, if set, overrides the amount that an accepted htlc is
+	// credited to the invoice with.
+	AmountPaid fn.Option[lnwire.MilliSatoshi]
+}
+
+// HtlcModifyCallback is the function that is called for every htlc that pays
+// one of our invoices. An error leads to the htlc being rejected.
+type HtlcModifyCallback func(HtlcModifyRequest) (*HtlcModifyResponse, error)
+
+// HtlcModifier allows a single external client to inspect and modify the
+// htlcs that pay our invoices.
+type HtlcModifier interface {
+	// RegisterModifier registers the callback that is called for every
+	// htlc that pays one of our invoices. The returned function must be
+	// called to unregister the callback. Only one callback can be
+	// registered at a time.
+	RegisterModifier(callback HtlcModifyCallback) (func(), error)
+}
+
+// HtlcInterceptor is the part of the htlc modifier that the invoice registry
+// consults before it credits a htlc to an invoice.
+type HtlcInterceptor interface {
+	// Intercept passes the htlc to the registered callback without waiting
+	// for its decision, which is delivered to the resolve function once
+	// the callback returned. If there is no callback registered, false is
+	// returned and the htlc is processed as usual.
+	Intercept(req HtlcModifyRequest,
+		resolve func(*HtlcModifyResponse, error)) bool
+}
+
+// HtlcModificationInterceptor is the default implementation of the
+// HtlcModifier and HtlcInterceptor interfaces.
+type HtlcModificationInterceptor struct {
+	// callback is the currently registered callback, nil if there is
+	// none.
+	callback HtlcModifyCallback
+
+	mu sync.Mutex
+}
+
+// A compile time check to ensure HtlcModificationInterceptor implements the
+// HtlcModifier and HtlcInterceptor interfaces.
+var (
+	_ HtlcModifier    = (*HtlcModificationInterceptor)(nil)
+	_ HtlcInterceptor = (*HtlcModificationInterceptor)(nil)
+)
+
+// NewHtlcModificationInterceptor returns a new htlc modification interceptor
+// without a registered callback.
+func NewHtlcModificationInterceptor() *HtlcModificationInterceptor {
+	return &HtlcModificationInterceptor{}
+}
+
+// RegisterModifier registers the callback that is called for every htlc that
+// pays one of our invoices.
+//
+// NOTE: Part of the HtlcModifier interface.
+func (h *HtlcModificationInterceptor) RegisterModifier(
+	callback HtlcModifyCallback) (func(), error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.callback != nil {
+		return nil, ErrHtlcModifierAlreadyExists
+	}
+	h.callback = callback
+
+	log.Info("Invoice htlc modifier registered")
+
+	var once sync.Once
+	unregister := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			h.callback = nil
+
+			log.Info("Invoice htlc modifier unregistered")
+		})
+	}
+
+	return unregister, nil
+}
+
+// Intercept passes the htlc to the registered callback, if any. The callback
+// is called in its own goroutine, so that htlcs can be modified concurrently
+// and the caller isn't blocked until the callback decided.
+//
+// NOTE: Part of the HtlcInterceptor interface.
+func (h *HtlcModificationInterceptor) Intercept(req HtlcModifyRequest,
+	resolve func(*HtlcModifyResponse, error)) bool {
+
+	h.mu.Lock()
+	callback := h.callback
+	h.mu.Unlock()
+
+	if callback == nil {
+		return false
+	}
+
+	go func() {
+		resolve(callback(req))
+	}()
+
+	return true
+}
@@ -74,6 +74,10 @@ type RegistryConfig struct {
 	// KeysendHoldTime indicates for how long we want to accept and hold
 	// spontaneous keysend payments.
 	KeysendHoldTime time.Duration
+
+	// HtlcInterceptor, if set, is consulted for every htlc that pays one
+	// of our invoices before it is credited to the invoice.
+	HtlcInterceptor HtlcInterceptor
 }
 
 // htlcReleaseEvent describes an htlc auto-release event. It is used to release
@@ -149,6 +153,13 @@ type InvoiceRegistry struct {
 
 	expiryWatcher *InvoiceExpiryWatcher
 
+	// pendingModifications holds the htlcs that were passed to the htlc
+	// modifier and that it hasn't decided about yet. The mutex also
+	// serializes subscribing to the resolution of such a htlc with
+	// delivering it.
+	pendingModifications map[CircuitKey]struct{}
+	modificationsMtx     sync.Mutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -173,10 +184,11 @@ func NewRegistry(idb InvoiceDB, expiryWatcher *InvoiceExpiryWatcher,
 		hodlReverseSubscriptions: make(
 			map[chan<- interface{}]map[CircuitKey]struct{},
 		),
-		cfg:                 cfg,
-		htlcAutoReleaseChan: make(chan *htlcReleaseEvent),
-		expiryWatcher:       expiryWatcher,
-		quit:                make(chan struct{}),
+		cfg:                  cfg,
+		htlcAutoReleaseChan:  make(chan *htlcReleaseEvent),
+		expiryWatcher:        expiryWatcher,
+		pendingModifications: make(map[CircuitKey]struct{}),
+		quit:                 make(chan struct{}),
 	}
 }
 
@@ -316,14 +328,7 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 		// A sub-systems has just modified the invoice state, so we'll
 		// dispatch notifications to all registered clients.
 		case event := <-i.invoiceEvents:
-			// For backwards compatibility, do not notify all
-			// invoice subscribers of cancel and accept events.
-			state := event.invoice.State
-			if state != ContractCanceled &&
-				state != ContractAccepted {
-
-				i.dispatchToClients(event)
-			}
+			i.dispatchToClients(event)
 			i.dispatchToSingleClients(event)
 
 		// A new htlc came in for auto-release.
@@ -395,6 +400,13 @@ func (i *InvoiceRegistry) dispatchToClients(event *invoiceEvent) {
 		// TODO(joostjager): Refactor switches.
 		state := event.invoice.State
 		switch {
+		// For backwards compatibility, only clients that subscribed to
+		// all invoice states are notified of accept and cancel events.
+		case (state == ContractCanceled || state == ContractAccepted) &&
+			!client.allStates:
+
+			continue
+
 		// If we've already sent this settle event to
 		// the client, then we can skip this.
 		case state == ContractSettled &&
@@ -962,10 +974,32 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		}
 	}
 
+	// Give the htlc modifier the chance to reject the htlc or to change
+	// the amount that it is credited with. As the modifier may take a
+	// while to decide, the htlc is held and its resolution is delivered
+	// on the hodl channel once the modifier responded.
+	if i.cfg.HtlcInterceptor != nil {
+		intercepted, err := i.interceptHtlc(ctx, hodlChan)
+		if err != nil {
+			return nil, err
+		}
+		if intercepted {
+			return nil, nil
+		}
+	}
+
+	return i.processExitHopHtlc(&ctx, hodlChan)
+}
+
+// processExitHopHtlc updates the invoice that the htlc pays to and returns the
+// resolution of the htlc, or nil if the htlc is held.
+func (i *InvoiceRegistry) processExitHopHtlc(ctx *invoiceUpdateCtx,
+	hodlChan chan<- interface{}) (HtlcResolution, error) {
+
 	// Execute locked notify exit hop logic.
 	i.Lock()
 	resolution, invoiceToExpire, err := i.notifyExitHopHtlcLocked(
-		&ctx, hodlChan,
+		ctx, hodlChan,
 	)
 	i.Unlock()
 	if err != nil {
@@ -990,7 +1024,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 			}
 
 			err := i.startHtlcTimer(
-				invRef, circuitKey, r.acceptTime,
+				invRef, ctx.circuitKey, r.acceptTime,
 			)
 			if err != nil {
 				return nil, err
@@ -1012,6 +1046,154 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	}
 }
 
+// interceptHtlc passes the htlc to the htlc modifier without waiting for its
+// decision, and subscribes the hodl channel to the resolution of the htlc. It
+// returns false if the htlc isn't intercepted, which is the case for htlcs
+// that are replayed or that don't pay an open or accepted invoice. These are
+// left to the regular invoice update logic.
+func (i *InvoiceRegistry) interceptHtlc(ctx invoiceUpdateCtx,
+	hodlChan chan<- interface{}) (bool, error) {
+
+	invoice, err := i.idb.LookupInvoice(
+		context.Background(), ctx.invoiceRef(),
+	)
+	switch {
+	case errors.Is(err, ErrInvoiceNotFound) ||
+		errors.Is(err, ErrNoInvoicesCreated):
+
+		return false, nil
+
+	case err != nil:
+		return false, err
+	}
+
+	if invoice.State != ContractOpen && invoice.State != ContractAccepted {
+		return false, nil
+	}
+
+	// A replayed htlc was already credited to the invoice when it first
+	// arrived, so it isn't passed to the modifier again.
+	if _, ok := invoice.Htlcs[ctx.circuitKey]; ok {
+		return false, nil
+	}
+
+	i.modificationsMtx.Lock()
+	defer i.modificationsMtx.Unlock()
+
+	// If the htlc is replayed while the modifier still decides about it,
+	// the resolution is delivered to both subscribers.
+	if _, ok := i.pendingModifications[ctx.circuitKey]; ok {
+		i.hodlSubscribe(hodlChan, ctx.circuitKey)
+
+		return true, nil
+	}
+
+	req := HtlcModifyRequest{
+		Invoice:            invoice,
+		ExitHtlcCircuitKey: ctx.circuitKey,
+		ExitHtlcAmt:        ctx.amtPaid,
+		ExitHtlcExpiry:     ctx.expiry,
+		CurrentHeight:      uint32(ctx.currentHeight),
+		CustomRecords:      ctx.customRecords,
+	}
+
+	// The resolution is only delivered once we release the mutex, so the
+	// htlc can be marked as pending after it was passed to the modifier.
+	i.wg.Add(1)
+	resolve := func(resp *HtlcModifyResponse, err error) {
+		defer i.wg.Done()
+
+		i.resolveModifiedHtlc(ctx, resp, err, hodlChan)
+	}
+	if !i.cfg.HtlcInterceptor.Intercept(req, resolve) {
+		i.wg.Done()
+
+		return false, nil
+	}
+
+	i.pendingModifications[ctx.circuitKey] = struct{}{}
+	i.hodlSubscribe(hodlChan, ctx.circuitKey)
+
+	return true, nil
+}
+
+// resolveModifiedHtlc processes the decision of the htlc modifier about the
+// htlc, and delivers the resolution of the htlc to its hodl subscribers.
+func (i *InvoiceRegistry) resolveModifiedHtlc(ctx invoiceUpdateCtx,
+	resp *HtlcModifyResponse, modifierErr error,
+	hodlChan chan<- interface{}) {
+
+	resolution, err := i.processModifiedHtlc(
+		&ctx, resp, modifierErr, hodlChan,
+	)
+	if err != nil {
+		ctx.log(fmt.Sprintf("unable to process modified htlc: %v",
+			err))
+
+		resolution = NewFailResolution(
+			ctx.circuitKey, ctx.currentHeight,
+			ResultRejectedByModifier,
+		)
+	}
+
+	i.modificationsMtx.Lock()
+	defer i.modificationsMtx.Unlock()
+
+	// A nil resolution means that the htlc is held, in which case the
+	// subscribers are notified once the invoice is resolved.
+	if resolution != nil {
+		i.notifyHodlSubscribers(resolution)
+	}
+
+	delete(i.pendingModifications, ctx.circuitKey)
+}
+
+// processModifiedHtlc returns the resolution of a htlc that the htlc modifier
+// decided about. It returns a fail resolution if the modifier rejected the
+// htlc, and updates the invoice with the amount that the modifier chose
+// otherwise.
+func (i *InvoiceRegistry) processModifiedHtlc(ctx *invoiceUpdateCtx,
+	resp *HtlcModifyResponse, err error,
+	hodlChan chan<- interface{}) (HtlcResolution, error) {
+
+	// If the modifier fails to decide, we reject the htlc rather than
+	// crediting it without the modifier's consent.
+	if err != nil {
+		ctx.log(fmt.Sprintf("htlc modifier error: %v", err))
+
+		return NewFailResolution(
+			ctx.circuitKey, ctx.currentHeight,
+			ResultRejectedByModifier,
+		), nil
+	}
+
+	switch {
+	// A nil response accepts the htlc as is.
+	case resp == nil:
+
+	case resp.Reject:
+		ctx.log("rejected by htlc modifier")
+
+		resolution := NewFailResolution(
+			ctx.circuitKey, ctx.currentHeight,
+			ResultRejectedByModifier,
+		)
+		resolution.FailureMessage = resp.FailureMessage
+
+		return resolution, nil
+
+	default:
+		resp.AmountPaid.WhenSome(func(amt lnwire.MilliSatoshi) {
+			ctx.log(fmt.Sprintf("htlc modifier changed amount "+
+				"paid from %v to %v", ctx.amtPaid, amt))
+
+			ctx.amtPaid = amt
+		})
+	}
+
+	return i.processExitHopHtlc(ctx, hodlChan)
+}
+
 // notifyExitHopHtlcLocked is the internal implementation of NotifyExitHopHtlc
 // that should be executed inside the registry lock. The returned invoiceExpiry
 // (if not nil) needs to be added to the expiry watcher outside of the lock.
@@ -1462,6 +1644,20 @@ type InvoiceSubscription struct {
 	// StartingInvoiceIndex field.
 	SettledInvoices chan *Invoice
 
+	// AcceptedInvoices is a channel that we'll use to send all invoices
+	// that move to the accepted state. It is only used by subscriptions
+	// to all invoice states.
+	AcceptedInvoices chan *Invoice
+
+	// CanceledInvoices is a channel that we'll use to send all invoices
+	// that are canceled. It is only used by subscriptions to all invoice
+	// states.
+	CanceledInvoices chan *Invoice
+
+	// allStates indicates that the subscriber also wants to be notified
+	// of accepted and canceled invoices.
+	allStates bool
+
 	// addIndex is the highest add index the caller knows of. We'll use
 	// this information to send out an event backlog to the notifications
 	// subscriber. Any new add events with an index greater than this will
@@ -1530,9 +1726,29 @@ func (i *invoiceSubscriptionKit) notify(event *invoiceEvent) error {
 func (i *InvoiceRegistry) SubscribeNotifications(ctx context.Context,
 	addIndex, settleIndex uint64) (*InvoiceSubscription, error) {
 
+	return i.subscribeNotifications(ctx, addIndex, settleIndex, false)
+}
+
+// SubscribeAllStates returns an InvoiceSubscription like
+// SubscribeNotifications does, which additionally delivers the invoices that
+// move to the accepted or canceled state. As accept and cancel events aren't
+// indexed, there is no backlog of them.
+func (i *InvoiceRegistry) SubscribeAllStates(ctx context.Context,
+	addIndex, settleIndex uint64) (*InvoiceSubscription, error) {
+
+	return i.subscribeNotifications(ctx, addIndex, settleIndex, true)
+}
+
+// subscribeNotifications creates a subscription to added and settled invoices,
+// and to accepted and canceled ones if allStates is set.
+func (i *InvoiceRegistry) subscribeNotifications(ctx context.Context,
+	addIndex, settleIndex uint64,
+	allStates bool) (*InvoiceSubscription, error) {
+
 	client := &InvoiceSubscription{
 		NewInvoices:     make(chan *Invoice),
 		SettledInvoices: make(chan *Invoice),
+		allStates:       allStates,
 		addIndex:        addIndex,
 		settleIndex:     settleIndex,
 		invoiceSubscriptionKit: invoiceSubscriptionKit{
@@ -1542,6 +1758,10 @@ func (i *InvoiceRegistry) SubscribeNotifications(ctx context.Context,
 			backlogDelivered: make(chan struct{}),
 		},
 	}
+	if allStates {
+		client.AcceptedInvoices = make(chan *Invoice)
+		client.CanceledInvoices = make(chan *Invoice)
+	}
 	client.ntfnQueue.Start()
 
 	// This notifies other goroutines that the backlog phase is over.
@@ -1585,6 +1805,12 @@ func (i *InvoiceRegistry) SubscribeNotifications(ctx context.Context,
 				case state == ContractOpen:
 					targetChan = client.NewInvoices
 
+				case state == ContractAccepted:
+					targetChan = client.AcceptedInvoices
+
+				case state == ContractCanceled:
+					targetChan = client.CanceledInvoices
+
 				default:
 					log.Errorf("unknown invoice state: %v",
 						state)
@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
 	invpkg "github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -102,6 +103,14 @@ func TestInvoiceRegistry(t *testing.T) {
 			name: "SpontaneousAmpPayment",
 			test: testSpontaneousAmpPayment,
 		},
+		{
+			name: "HtlcModifier",
+			test: testHtlcModifier,
+		},
+		{
+			name: "SubscribeAllStates",
+			test: testSubscribeAllStates,
+		},
 	}
 
 	makeKeyValueDB := func(t *testing.T) (invpkg.InvoiceDB,
@@ -2086,3 +2095,183 @@ func testSpontaneousAmpPaymentImpl(
 		}
 	}
 }
+
+// testHtlcModifier tests that the htlc modifier can reject htlcs with a failure
+// of its choice and change the amount that htlcs pay to an invoice, and that
+// htlcs are held until the modifier decided.
+func testHtlcModifier(t *testing.T,
+	makeDB func(t *testing.T) (invpkg.InvoiceDB, *clock.TestClock)) {
+
+	t.Parallel()
+	defer timeout()()
+
+	modifier := invpkg.NewHtlcModificationInterceptor()
+	cfg := defaultRegistryConfig()
+	cfg.HtlcInterceptor = modifier
+	ctx := newTestContext(t, &cfg, makeDB)
+	ctxb := context.Background()
+
+	testInvoice := newInvoice(t, false)
+	_, err := ctx.registry.AddInvoice(
+		ctxb, testInvoice, testInvoicePaymentHash,
+	)
+	require.NoError(t, err)
+
+	// The modifier rejects the first htlc, and credits the second one with
+	// the full invoice amount.
+	var requests []invpkg.HtlcModifyRequest
+	unregister, err := modifier.RegisterModifier(
+		func(req invpkg.HtlcModifyRequest) (*invpkg.HtlcModifyResponse,
+			error) {
+
+			requests = append(requests, req)
+
+			if req.ExitHtlcCircuitKey.HtlcID == 1 {
+				return &invpkg.HtlcModifyResponse{
+					Reject: true,
+					FailureMessage: &lnwire.
+						FailTemporaryNodeFailure{},
+				}, nil
+			}
+
+			return &invpkg.HtlcModifyResponse{
+				AmountPaid: fn.Some(testInvoiceAmount),
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	// Only one modifier can be registered at a time.
+	_, err = modifier.RegisterModifier(nil)
+	require.ErrorIs(t, err, invpkg.ErrHtlcModifierAlreadyExists)
+
+	payload := &mockPayload{
+		customRecords: record.CustomSet{
+			record.CustomTypeStart: []byte{1, 2, 3},
+		},
+	}
+
+	// The htlc is held until the modifier decided, and its resolution is
+	// delivered on the hodl channel.
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmount/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(1), hodlChan, payload,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	htlcResolution, _ := (<-hodlChan).(invpkg.HtlcResolution)
+	failResolution := checkFailResolution(
+		t, htlcResolution, invpkg.ResultRejectedByModifier,
+	)
+	require.Equal(
+		t, &lnwire.FailTemporaryNodeFailure{},
+		failResolution.FailureMessage,
+	)
+
+	// The modifier is passed the htlc and the invoice that it pays to.
+	require.Len(t, requests, 1)
+	require.Equal(t, testInvoiceAmount/2, requests[0].ExitHtlcAmt)
+	require.Equal(t, payload.customRecords, requests[0].CustomRecords)
+	require.Equal(
+		t, testInvoice.Terms.PaymentPreimage,
+		requests[0].Invoice.Terms.PaymentPreimage,
+	)
+
+	// The second htlc settles the invoice, as the modifier credits it
+	// with the full invoice amount.
+	resolution, err = ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmount/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(2), hodlChan, payload,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+	htlcResolution, _ = (<-hodlChan).(invpkg.HtlcResolution)
+	checkSettleResolution(t, htlcResolution, testInvoicePreimage)
+
+	inv, err := ctx.registry.LookupInvoice(ctxb, testInvoicePaymentHash)
+	require.NoError(t, err)
+	require.Equal(t, invpkg.ContractSettled, inv.State)
+	require.Equal(t, testInvoiceAmount, inv.AmtPaid)
+
+	// Replays of the settled htlc aren't passed to the modifier again.
+	resolution, err = ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmount/2, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(2), nil, payload,
+	)
+	require.NoError(t, err)
+	checkSettleResolution(t, resolution, testInvoicePreimage)
+	require.Len(t, requests, 2)
+
+	// Once the modifier is unregistered, another one can take its place.
+	unregister()
+	_, err = modifier.RegisterModifier(nil)
+	require.NoError(t, err)
+}
+
+// testSubscribeAllStates tests that subscriptions to all invoice states are
+// notified of accepted and canceled invoices, while regular subscriptions
+// aren't.
+func testSubscribeAllStates(t *testing.T,
+	makeDB func(t *testing.T) (invpkg.InvoiceDB, *clock.TestClock)) {
+
+	t.Parallel()
+	defer timeout()()
+
+	ctx := newTestContext(t, nil, makeDB)
+	ctxb := context.Background()
+
+	allStates, err := ctx.registry.SubscribeAllStates(ctxb, 0, 0)
+	require.NoError(t, err)
+	defer allStates.Cancel()
+
+	regular, err := ctx.registry.SubscribeNotifications(ctxb, 0, 0)
+	require.NoError(t, err)
+	defer regular.Cancel()
+
+	require.Nil(t, regular.AcceptedInvoices)
+	require.Nil(t, regular.CanceledInvoices)
+
+	// Add a hold invoice, which both subscriptions are notified of.
+	invoice := newInvoice(t, true)
+	_, err = ctx.registry.AddInvoice(ctxb, invoice, testInvoicePaymentHash)
+	require.NoError(t, err)
+
+	newInvoice := <-allStates.NewInvoices
+	require.Equal(t, invpkg.ContractOpen, newInvoice.State)
+
+	newInvoice = <-regular.NewInvoices
+	require.Equal(t, invpkg.ContractOpen, newInvoice.State)
+
+	// Pay the invoice, which moves it to the accepted state.
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmount, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(0), hodlChan, testPayload,
+	)
+	require.NoError(t, err)
+	require.Nil(t, resolution)
+
+	accepted := <-allStates.AcceptedInvoices
+	require.Equal(t, invpkg.ContractAccepted, accepted.State)
+	require.Equal(t, testInvoiceAmount, accepted.AmtPaid)
+
+	// Cancel the invoice.
+	err = ctx.registry.CancelInvoice(ctxb, testInvoicePaymentHash)
+	require.NoError(t, err)
+
+	canceled := <-allStates.CanceledInvoices
+	require.Equal(t, invpkg.ContractCanceled, canceled.State)
+
+	// The regular subscription isn't notified of either state.
+	select {
+	case invoice := <-regular.NewInvoices:
+		t.Fatalf("unexpected new invoice: %v", invoice.State)
+
+	case invoice := <-regular.SettledInvoices:
+		t.Fatalf("unexpected settled invoice: %v", invoice.State)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
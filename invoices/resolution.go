@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 // HtlcResolution describes how an htlc should be resolved.
@@ -24,6 +25,10 @@ type HtlcFailResolution struct {
 
 	// Outcome indicates the outcome of the invoice registry update.
 	Outcome FailResolutionResult
+
+	// FailureMessage, if set, is the failure that the htlc is failed back
+	// with instead of the one that the outcome maps to.
+	FailureMessage lnwire.FailureMessage
 }
 
 // NewFailResolution returns a htlc failure resolution.
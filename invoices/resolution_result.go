@@ -120,6 +120,26 @@ const (
 	// ResultAmpReconstruction is returned when the derived child
 	// hash/preimage pairs were invalid for at least one HTLC in the set.
 	ResultAmpReconstruction
+
+	// ResultTrampolineInvalidOnion is returned when the trampoline onion
+	// of a htlc can't be processed.
+	ResultTrampolineInvalidOnion
+
+	// ResultTrampolineFeeInsufficient is returned when a trampoline
+	// payment can't be forwarded within its fee budget.
+	ResultTrampolineFeeInsufficient
+
+	// ResultTrampolineExpiryTooSoon is returned when a trampoline payment
+	// can't be forwarded within its CLTV budget.
+	ResultTrampolineExpiryTooSoon
+
+	// ResultTrampolineIncorrectDetails is returned when the recipient of
+	// a forwarded trampoline payment rejected its payment details.
+	ResultTrampolineIncorrectDetails
+
+	// ResultRejectedByModifier is returned when the htlc modifier rejected
+	// a htlc, or failed to decide about it.
+	ResultRejectedByModifier
 )
 
 // String returns a string representation of the result.
@@ -189,6 +209,21 @@ func (f FailResolutionResult) FailureString() string {
 	case ResultAmpReconstruction:
 		return "amp reconstruction failed"
 
+	case ResultTrampolineInvalidOnion:
+		return "invalid trampoline onion"
+
+	case ResultTrampolineFeeInsufficient:
+		return "trampoline fee insufficient"
+
+	case ResultTrampolineExpiryTooSoon:
+		return "trampoline expiry too soon"
+
+	case ResultTrampolineIncorrectDetails:
+		return "trampoline recipient rejected payment details"
+
+	case ResultRejectedByModifier:
+		return "rejected by htlc modifier"
+
 	default:
 		return "unknown failure resolution result"
 	}
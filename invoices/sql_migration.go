@@ -0,0 +1,815 @@
+package invoices
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+const (
+	// DefaultMigrationBatchSize is the default number of invoices that are
+	// migrated from the KV store to the SQL store in a single transaction.
+	DefaultMigrationBatchSize = 1000
+)
+
+// KVInvoiceSource is the subset of the KV invoice database that is needed to
+// migrate its invoices to the native SQL store.
+type KVInvoiceSource interface {
+	// QueryInvoices allows a caller to query the invoice database for
+	// invoices within the specified add index range.
+	QueryInvoices(ctx context.Context, q InvoiceQuery) (InvoiceSlice,
+		error)
+
+	// ForEachInvoiceHash calls the passed callback for the add index and
+	// payment hash of each invoice in the database.
+	ForEachInvoiceHash(ctx context.Context,
+		cb func(addIndex uint64, hash lntypes.Hash) error) error
+}
+
+// MigrateInvoicesToSQL copies all invoices of the KV invoice database to the
+// native SQL invoice store, including their HTLCs, AMP sub invoices and their
+// add and settle indices. Invoices are migrated in batches of batchSize, and
+// the progress is recorded in the SQL database along with each batch. If the
+// migration is interrupted, calling this function again resumes it from the
+// last migrated batch. Once all invoices are copied, the migrated invoices are
+// verified against the KV invoices. Calling this function after the migration
+// completed is a noop.
+func MigrateInvoicesToSQL(ctx context.Context, kvStore KVInvoiceSource,
+	sqlStore *SQLStore, batchSize int) error {
+
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid migration batch size: %d",
+			batchSize)
+	}
+
+	state, err := fetchMigrationState(ctx, sqlStore.db)
+	if err != nil {
+		return err
+	}
+
+	if state.Completed {
+		log.Debugf("Invoice migration to SQL already completed")
+
+		return nil
+	}
+
+	// The add index of each invoice is its primary key in the SQL store,
+	// but the KV invoices only reference their payment hash through the
+	// hash index. So before we can copy the invoices, we'll first copy all
+	// add index to hash mappings to a temporary table.
+	if !state.HashesMigrated {
+		log.Infof("Migrating invoice payment hashes to SQL")
+
+		err := migrateInvoiceHashes(
+			ctx, kvStore, sqlStore.db, batchSize,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to migrate invoice hashes: "+
+				"%w", err)
+		}
+
+		state.HashesMigrated = true
+		err = upsertMigrationState(ctx, sqlStore.db, state)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Migrating invoices to SQL, starting after add index %d",
+		state.LastAddIndex)
+
+	state, err = migrateInvoices(ctx, kvStore, sqlStore.db, state,
+		batchSize)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Verifying migrated invoices")
+
+	if err := verifyMigratedInvoices(
+		ctx, kvStore, sqlStore.db, batchSize,
+	); err != nil {
+		return fmt.Errorf("invoice migration verification failed: %w",
+			err)
+	}
+
+	// Now that all invoices are in place, we'll make sure that new
+	// invoices continue with the indices of the KV store.
+	var writeTxOpts SQLInvoiceQueriesTxOptions
+	err = sqlStore.db.ExecTx(ctx, &writeTxOpts, func(
+		db SQLInvoiceQueries) error {
+
+		err := db.SetInvoiceSettleIndex(
+			ctx, int64(state.MaxSettleIndex),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to set settle index: %w", err)
+		}
+
+		// Sqlite always uses the highest existing ID plus one for new
+		// rows, while postgres relies on a sequence that doesn't know
+		// about the explicitly inserted IDs.
+		if db.Backend() == sqlc.BackendTypePostgres {
+			err := db.ResetInvoiceIDSequence(ctx)
+			if err != nil {
+				return fmt.Errorf("unable to reset invoice ID "+
+					"sequence: %w", err)
+			}
+		}
+
+		err = db.DeleteInvoiceMigrationHashes(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to delete migration "+
+				"hashes: %w", err)
+		}
+
+		state.Completed = true
+
+		return upsertMigrationState(ctx, db, state)
+	}, func() {})
+	if err != nil {
+		return fmt.Errorf("unable to complete invoice migration: %w",
+			err)
+	}
+
+	log.Infof("Invoice migration to SQL completed, last add index %d, "+
+		"last settle index %d", state.LastAddIndex,
+		state.MaxSettleIndex)
+
+	return nil
+}
+
+// migrationState is the progress of the invoice migration.
+type migrationState struct {
+	// HashesMigrated is true if the payment hashes of all KV invoices have
+	// been copied to the SQL store.
+	HashesMigrated bool
+
+	// LastAddIndex is the add index of the last migrated invoice.
+	LastAddIndex uint64
+
+	// MaxSettleIndex is the highest settle index of all migrated invoices
+	// and AMP sub invoices.
+	MaxSettleIndex uint64
+
+	// Completed is true if all invoices were migrated and verified.
+	Completed bool
+}
+
+// fetchMigrationState fetches the current progress of the invoice migration.
+// If the migration hasn't been started yet, we'll make sure that there are no
+// invoices in the SQL store that could conflict with the migrated ones.
+func fetchMigrationState(ctx context.Context,
+	db BatchedSQLInvoiceQueries) (*migrationState, error) {
+
+	var (
+		state    *migrationState
+		readOpts = NewSQLInvoiceQueryReadTx()
+	)
+	err := db.ExecTx(ctx, &readOpts, func(db SQLInvoiceQueries) error {
+		row, err := db.GetInvoiceMigrationState(ctx)
+		switch {
+		case err == nil:
+			state = &migrationState{
+				HashesMigrated: row.HashesMigrated,
+				LastAddIndex:   uint64(row.LastAddIndex),
+				MaxSettleIndex: uint64(row.MaxSettleIndex),
+				Completed:      row.Completed,
+			}
+
+			return nil
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+
+		numInvoices, err := db.CountInvoices(ctx)
+		if err != nil {
+			return err
+		}
+
+		if numInvoices != 0 {
+			return fmt.Errorf("unable to migrate KV invoices, SQL "+
+				"store already contains %d invoices",
+				numInvoices)
+		}
+
+		state = &migrationState{}
+
+		return nil
+	}, func() {
+		state = nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch invoice migration "+
+			"state: %w", err)
+	}
+
+	return state, nil
+}
+
+// upsertMigrationState stores the passed invoice migration progress.
+func upsertMigrationState(ctx context.Context, db SQLInvoiceQueries,
+	state *migrationState) error {
+
+	err := db.UpsertInvoiceMigrationState(
+		ctx, sqlc.UpsertInvoiceMigrationStateParams{
+			HashesMigrated: state.HashesMigrated,
+			LastAddIndex:   int64(state.LastAddIndex),
+			MaxSettleIndex: int64(state.MaxSettleIndex),
+			Completed:      state.Completed,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to store invoice migration state: %w",
+			err)
+	}
+
+	return nil
+}
+
+// migrateInvoiceHashes copies the add index and payment hash of each KV
+// invoice to the SQL store. Hashes that were already copied are skipped, so
+// this can safely be repeated after an interruption.
+func migrateInvoiceHashes(ctx context.Context, kvStore KVInvoiceSource,
+	db BatchedSQLInvoiceQueries, batchSize int) error {
+
+	var (
+		batch       = make(map[uint64]lntypes.Hash, batchSize)
+		writeTxOpts SQLInvoiceQueriesTxOptions
+	)
+	flush := func() error {
+		err := db.ExecTx(ctx, &writeTxOpts, func(
+			db SQLInvoiceQueries) error {
+
+			for addIndex, hash := range batch {
+				params := sqlc.InsertInvoiceMigrationHashParams{
+					AddIndex: int64(addIndex),
+					Hash:     hash[:],
+				}
+
+				err := db.InsertInvoiceMigrationHash(
+					ctx, params,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, func() {})
+		if err != nil {
+			return err
+		}
+
+		batch = make(map[uint64]lntypes.Hash, batchSize)
+
+		return nil
+	}
+
+	err := kvStore.ForEachInvoiceHash(ctx, func(addIndex uint64,
+		hash lntypes.Hash) error {
+
+		batch[addIndex] = hash
+		if len(batch) < batchSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+// migrateInvoices copies the KV invoices with an add index greater than the
+// last migrated one to the SQL store. The progress is stored along with each
+// batch, and the updated state is returned.
+func migrateInvoices(ctx context.Context, kvStore KVInvoiceSource,
+	db BatchedSQLInvoiceQueries, state *migrationState,
+	batchSize int) (*migrationState, error) {
+
+	var (
+		writeTxOpts SQLInvoiceQueriesTxOptions
+		numMigrated int
+	)
+	for {
+		resp, err := kvStore.QueryInvoices(ctx, InvoiceQuery{
+			IndexOffset:    state.LastAddIndex,
+			NumMaxInvoices: uint64(batchSize),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to query KV invoices: "+
+				"%w", err)
+		}
+
+		if len(resp.Invoices) == 0 {
+			break
+		}
+
+		newState := *state
+		err = db.ExecTx(ctx, &writeTxOpts, func(
+			db SQLInvoiceQueries) error {
+
+			for i := range resp.Invoices {
+				invoice := &resp.Invoices[i]
+
+				hash, err := fetchMigrationHash(
+					ctx, db, invoice.AddIndex,
+				)
+				if err != nil {
+					return err
+				}
+
+				settleIndex, err := migrateInvoice(
+					ctx, db, hash, invoice,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to migrate "+
+						"invoice(%v): %w", hash, err)
+				}
+
+				if settleIndex > newState.MaxSettleIndex {
+					newState.MaxSettleIndex = settleIndex
+				}
+			}
+
+			newState.LastAddIndex = resp.LastIndexOffset
+
+			return upsertMigrationState(ctx, db, &newState)
+		}, func() {
+			newState = *state
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		state = &newState
+		numMigrated += len(resp.Invoices)
+
+		log.Infof("Migrated %d invoices to SQL, last add index %d",
+			numMigrated, state.LastAddIndex)
+	}
+
+	return state, nil
+}
+
+// fetchMigrationHash returns the payment hash of the KV invoice with the given
+// add index.
+func fetchMigrationHash(ctx context.Context, db SQLInvoiceQueries,
+	addIndex uint64) (lntypes.Hash, error) {
+
+	hashBytes, err := db.GetInvoiceMigrationHash(ctx, int64(addIndex))
+	if err != nil {
+		return lntypes.Hash{}, fmt.Errorf("unable to fetch payment "+
+			"hash of invoice with add index %d: %w", addIndex, err)
+	}
+
+	return lntypes.MakeHash(hashBytes)
+}
+
+// migrateInvoice inserts the passed KV invoice along with its features, HTLCs
+// and AMP sub invoices into the SQL store, using its add index as the invoice
+// ID. The highest settle index of the invoice and its AMP sub invoices is
+// returned.
+//
+//nolint:funlen
+func migrateInvoice(ctx context.Context, db SQLInvoiceQueries,
+	hash lntypes.Hash, invoice *Invoice) (uint64, error) {
+
+	var paymentRequestHash []byte
+	if len(invoice.PaymentRequest) > 0 {
+		h := sha256.Sum256(invoice.PaymentRequest)
+		paymentRequestHash = h[:]
+	}
+
+	invoiceID := int64(invoice.AddIndex)
+	params := sqlc.InsertMigratedInvoiceParams{
+		ID:         invoiceID,
+		Hash:       hash[:],
+		Memo:       sqldb.SQLStr(string(invoice.Memo)),
+		AmountMsat: int64(invoice.Terms.Value),
+		CltvDelta:  sqldb.SQLInt32(invoice.Terms.FinalCltvDelta),
+		Expiry:     int32(invoice.Terms.Expiry.Seconds()),
+		PaymentRequest: sqldb.SQLStr(
+			string(invoice.PaymentRequest),
+		),
+		PaymentRequestHash: paymentRequestHash,
+		State:              int16(invoice.State),
+		AmountPaidMsat:     int64(invoice.AmtPaid),
+		IsAmp:              invoice.IsAMP(),
+		IsHodl:             invoice.HodlInvoice,
+		IsKeysend:          invoice.IsKeysend(),
+		CreatedAt:          invoice.CreationDate.UTC(),
+	}
+
+	if invoice.SettleIndex != 0 {
+		params.SettleIndex = sqldb.SQLInt64(int64(invoice.SettleIndex))
+	}
+
+	if !invoice.SettleDate.IsZero() {
+		params.SettledAt = sqldb.SQLTime(invoice.SettleDate.UTC())
+	}
+
+	preimage := invoice.Terms.PaymentPreimage
+	if preimage != nil && *preimage != UnknownPreimage {
+		params.Preimage = preimage[:]
+	}
+
+	if invoice.Terms.PaymentAddr != BlankPayAddr {
+		params.PaymentAddr = invoice.Terms.PaymentAddr[:]
+	}
+
+	if err := db.InsertMigratedInvoice(ctx, params); err != nil {
+		return 0, fmt.Errorf("unable to insert invoice: %w", err)
+	}
+
+	if invoice.Terms.Features != nil {
+		for feature := range invoice.Terms.Features.Features() {
+			params := sqlc.InsertInvoiceFeatureParams{
+				InvoiceID: invoiceID,
+				Feature:   int32(feature),
+			}
+
+			err := db.InsertInvoiceFeature(ctx, params)
+			if err != nil {
+				return 0, fmt.Errorf("unable to insert "+
+					"invoice feature(%v): %w", feature, err)
+			}
+		}
+	}
+
+	// The KV store doesn't keep a history of the invoice state changes,
+	// so the creation event is the only one we can reconstruct.
+	err := db.OnInvoiceCreated(ctx, sqlc.OnInvoiceCreatedParams{
+		AddedAt:   invoice.CreationDate.UTC(),
+		InvoiceID: invoiceID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	maxSettleIndex := invoice.SettleIndex
+
+	// The AMP sub invoices need to exist before their HTLCs can be
+	// inserted. As the KV store doesn't track their creation time, we'll
+	// use the accept time of their first HTLC.
+	if invoice.IsAMP() {
+		createdAt := make(map[SetID]time.Time)
+		for _, htlc := range invoice.Htlcs {
+			if htlc.AMP == nil {
+				continue
+			}
+
+			setID := htlc.AMP.Record.SetID()
+			t, ok := createdAt[setID]
+			if !ok || htlc.AcceptTime.Before(t) {
+				createdAt[setID] = htlc.AcceptTime
+			}
+		}
+
+		// Sub invoices without any HTLCs fall back to the creation
+		// time of the invoice itself.
+		for setID := range invoice.AMPState {
+			if _, ok := createdAt[setID]; !ok {
+				createdAt[setID] = invoice.CreationDate
+			}
+		}
+
+		for setID, created := range createdAt {
+			settleIndex, err := migrateAMPSubInvoice(
+				ctx, db, invoiceID, setID, created,
+				invoice.AMPState[setID],
+			)
+			if err != nil {
+				return 0, fmt.Errorf("unable to migrate AMP "+
+					"sub invoice(%x): %w", setID, err)
+			}
+
+			if settleIndex > maxSettleIndex {
+				maxSettleIndex = settleIndex
+			}
+		}
+	}
+
+	for circuitKey, htlc := range invoice.Htlcs {
+		err := migrateInvoiceHTLC(ctx, db, invoiceID, circuitKey, htlc)
+		if err != nil {
+			return 0, fmt.Errorf("unable to migrate HTLC(%v): %w",
+				circuitKey, err)
+		}
+	}
+
+	return maxSettleIndex, nil
+}
+
+// migrateAMPSubInvoice inserts the AMP sub invoice with the given set ID and
+// state. The settle index of the sub invoice is returned.
+func migrateAMPSubInvoice(ctx context.Context, db SQLInvoiceQueries,
+	invoiceID int64, setID SetID, createdAt time.Time,
+	ampState InvoiceStateAMP) (uint64, error) {
+
+	_, err := db.UpsertAMPSubInvoice(ctx, sqlc.UpsertAMPSubInvoiceParams{
+		SetID:     setID[:],
+		State:     int16(ampState.State),
+		CreatedAt: createdAt.UTC(),
+		InvoiceID: invoiceID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = db.OnAMPSubInvoiceCreated(ctx, sqlc.OnAMPSubInvoiceCreatedParams{
+		AddedAt:   createdAt.UTC(),
+		InvoiceID: invoiceID,
+		SetID:     setID[:],
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		settleIndex sql.NullInt64
+		settledAt   sql.NullTime
+	)
+	if ampState.SettleIndex != 0 {
+		settleIndex = sqldb.SQLInt64(int64(ampState.SettleIndex))
+	}
+	if !ampState.SettleDate.IsZero() {
+		settledAt = sqldb.SQLTime(ampState.SettleDate.UTC())
+	}
+
+	err = db.UpdateAMPSubInvoiceState(
+		ctx, sqlc.UpdateAMPSubInvoiceStateParams{
+			SetID:       setID[:],
+			State:       int16(ampState.State),
+			SettleIndex: settleIndex,
+			SettledAt:   settledAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return ampState.SettleIndex, nil
+}
+
+// migrateInvoiceHTLC inserts the passed HTLC of the invoice with the given ID
+// along with its custom records and AMP data.
+func migrateInvoiceHTLC(ctx context.Context, db SQLInvoiceQueries,
+	invoiceID int64, circuitKey CircuitKey, htlc *InvoiceHTLC) error {
+
+	chanID := strconv.FormatUint(circuitKey.ChanID.ToUint64(), 10)
+	htlcPrimaryKeyID, err := db.InsertInvoiceHTLC(
+		ctx, sqlc.InsertInvoiceHTLCParams{
+			HtlcID:     int64(circuitKey.HtlcID),
+			ChanID:     chanID,
+			AmountMsat: int64(htlc.Amt),
+			TotalMppMsat: sql.NullInt64{
+				Int64: int64(htlc.MppTotalAmt),
+				Valid: htlc.MppTotalAmt != 0,
+			},
+			AcceptHeight: int32(htlc.AcceptHeight),
+			AcceptTime:   htlc.AcceptTime.UTC(),
+			ExpiryHeight: int32(htlc.Expiry),
+			State:        int16(htlc.State),
+			InvoiceID:    invoiceID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// The resolve time can only be set by updating the HTLC.
+	if !htlc.ResolveTime.IsZero() {
+		err := db.UpdateInvoiceHTLC(ctx, sqlc.UpdateInvoiceHTLCParams{
+			HtlcID:      int64(circuitKey.HtlcID),
+			ChanID:      chanID,
+			InvoiceID:   invoiceID,
+			State:       int16(htlc.State),
+			ResolveTime: sqldb.SQLTime(htlc.ResolveTime.UTC()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, value := range htlc.CustomRecords {
+		err := db.InsertInvoiceHTLCCustomRecord(
+			ctx, sqlc.InsertInvoiceHTLCCustomRecordParams{
+				Key:    int64(key),
+				Value:  value,
+				HtlcID: htlcPrimaryKeyID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if htlc.AMP == nil {
+		return nil
+	}
+
+	setID := htlc.AMP.Record.SetID()
+	rootShare := htlc.AMP.Record.RootShare()
+	ampHtlcParams := sqlc.InsertAMPSubInvoiceHTLCParams{
+		InvoiceID:  invoiceID,
+		SetID:      setID[:],
+		HtlcID:     htlcPrimaryKeyID,
+		RootShare:  rootShare[:],
+		ChildIndex: int64(htlc.AMP.Record.ChildIndex()),
+		Hash:       htlc.AMP.Hash[:],
+	}
+
+	if htlc.AMP.Preimage != nil {
+		ampHtlcParams.Preimage = htlc.AMP.Preimage[:]
+	}
+
+	return db.InsertAMPSubInvoiceHTLC(ctx, ampHtlcParams)
+}
+
+// verifyMigratedInvoices compares each KV invoice with its migrated
+// counterpart in the SQL store, and makes sure that both stores contain the
+// same number of invoices.
+func verifyMigratedInvoices(ctx context.Context, kvStore KVInvoiceSource,
+	db BatchedSQLInvoiceQueries, batchSize int) error {
+
+	var (
+		readOpts    = NewSQLInvoiceQueryReadTx()
+		indexOffset uint64
+		numInvoices int64
+	)
+	for {
+		resp, err := kvStore.QueryInvoices(ctx, InvoiceQuery{
+			IndexOffset:    indexOffset,
+			NumMaxInvoices: uint64(batchSize),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query KV invoices: %w",
+				err)
+		}
+
+		if len(resp.Invoices) == 0 {
+			break
+		}
+
+		err = db.ExecTx(ctx, &readOpts, func(
+			db SQLInvoiceQueries) error {
+
+			for i := range resp.Invoices {
+				err := verifyMigratedInvoice(
+					ctx, db, &resp.Invoices[i],
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, func() {})
+		if err != nil {
+			return err
+		}
+
+		numInvoices += int64(len(resp.Invoices))
+		indexOffset = resp.LastIndexOffset
+	}
+
+	var numSQLInvoices int64
+	err := db.ExecTx(ctx, &readOpts, func(db SQLInvoiceQueries) error {
+		var err error
+		numSQLInvoices, err = db.CountInvoices(ctx)
+
+		return err
+	}, func() {})
+	if err != nil {
+		return fmt.Errorf("unable to count SQL invoices: %w", err)
+	}
+
+	if numSQLInvoices != numInvoices {
+		return fmt.Errorf("invoice count mismatch: KV store has %d "+
+			"invoices, SQL store has %d", numInvoices,
+			numSQLInvoices)
+	}
+
+	return nil
+}
+
+// verifyMigratedInvoice compares the passed KV invoice with the SQL invoice
+// that is stored under the same payment hash.
+func verifyMigratedInvoice(ctx context.Context, db SQLInvoiceQueries,
+	kvInvoice *Invoice) error {
+
+	hash, err := fetchMigrationHash(ctx, db, kvInvoice.AddIndex)
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.GetInvoice(ctx, sqlc.GetInvoiceParams{
+		Hash: hash[:],
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fetch invoice(%v): %w", hash, err)
+	}
+
+	if len(rows) != 1 {
+		return fmt.Errorf("expected one invoice(%v), got %d", hash,
+			len(rows))
+	}
+
+	sqlHash, sqlInvoice, err := fetchInvoiceData(
+		ctx, db, rows[0], nil, true,
+	)
+	if err != nil {
+		return err
+	}
+
+	mismatch := func(field string, kvValue, sqlValue interface{}) error {
+		return fmt.Errorf("invoice(%v) %s mismatch: KV %v, SQL %v",
+			hash, field, kvValue, sqlValue)
+	}
+
+	kvPreimage := kvInvoice.Terms.PaymentPreimage
+	sqlPreimage := sqlInvoice.Terms.PaymentPreimage
+
+	switch {
+	case *sqlHash != hash:
+		return mismatch("hash", hash, sqlHash)
+
+	case sqlInvoice.AddIndex != kvInvoice.AddIndex:
+		return mismatch("add index", kvInvoice.AddIndex,
+			sqlInvoice.AddIndex)
+
+	case sqlInvoice.SettleIndex != kvInvoice.SettleIndex:
+		return mismatch("settle index", kvInvoice.SettleIndex,
+			sqlInvoice.SettleIndex)
+
+	case sqlInvoice.State != kvInvoice.State:
+		return mismatch("state", kvInvoice.State, sqlInvoice.State)
+
+	case sqlInvoice.Terms.Value != kvInvoice.Terms.Value:
+		return mismatch("value", kvInvoice.Terms.Value,
+			sqlInvoice.Terms.Value)
+
+	case sqlInvoice.AmtPaid != kvInvoice.AmtPaid:
+		return mismatch("amount paid", kvInvoice.AmtPaid,
+			sqlInvoice.AmtPaid)
+
+	case !bytes.Equal(sqlInvoice.PaymentRequest, kvInvoice.PaymentRequest):
+		return mismatch("payment request",
+			string(kvInvoice.PaymentRequest),
+			string(sqlInvoice.PaymentRequest))
+
+	case len(sqlInvoice.Htlcs) != len(kvInvoice.Htlcs):
+		return mismatch("number of HTLCs", len(kvInvoice.Htlcs),
+			len(sqlInvoice.Htlcs))
+
+	case len(sqlInvoice.AMPState) != len(kvInvoice.AMPState):
+		return mismatch("number of AMP sub invoices",
+			len(kvInvoice.AMPState), len(sqlInvoice.AMPState))
+	}
+
+	// An unknown preimage isn't migrated, but all known preimages must
+	// match the payment hash.
+	if kvPreimage != nil && *kvPreimage != UnknownPreimage {
+		if sqlPreimage == nil || *sqlPreimage != *kvPreimage {
+			return mismatch("preimage", kvPreimage, sqlPreimage)
+		}
+
+		if !sqlPreimage.Matches(hash) {
+			return fmt.Errorf("invoice(%v) preimage doesn't match "+
+				"payment hash", hash)
+		}
+	}
+
+	for setID, kvState := range kvInvoice.AMPState {
+		sqlState, ok := sqlInvoice.AMPState[setID]
+		if !ok {
+			return fmt.Errorf("invoice(%v) AMP sub invoice(%x) "+
+				"not migrated", hash, setID)
+		}
+
+		if sqlState.State != kvState.State ||
+			sqlState.SettleIndex != kvState.SettleIndex {
+
+			return mismatch(
+				fmt.Sprintf("AMP sub invoice(%x)", setID),
+				kvState, sqlState,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package invoices_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// failingInvoiceSource wraps a KV invoice source and fails all invoice queries
+// after the given number of successful ones.
+type failingInvoiceSource struct {
+	invpkg.KVInvoiceSource
+
+	numQueries int
+}
+
+// QueryInvoices fails once the allowed number of queries is used up.
+func (f *failingInvoiceSource) QueryInvoices(ctx context.Context,
+	q invpkg.InvoiceQuery) (invpkg.InvoiceSlice, error) {
+
+	if f.numQueries == 0 {
+		return invpkg.InvoiceSlice{}, errors.New("query failed")
+	}
+	f.numQueries--
+
+	return f.KVInvoiceSource.QueryInvoices(ctx, q)
+}
+
+// cancelInvoiceUpdate is an invoice update callback that cancels the invoice.
+func cancelInvoiceUpdate(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+	return &invpkg.InvoiceUpdateDesc{
+		UpdateType: invpkg.CancelInvoiceUpdate,
+		State: &invpkg.InvoiceStateUpdateDesc{
+			NewState: invpkg.ContractCanceled,
+		},
+	}, nil
+}
+
+// newMigrationTestStores creates a KV invoice database and an empty SQL
+// invoice store.
+func newMigrationTestStores(t *testing.T) (*channeldb.DB, *invpkg.SQLStore) {
+	kvDB, err := channeldb.MakeTestDB(
+		t, channeldb.OptionClock(clock.NewTestClock(testNow)),
+	)
+	require.NoError(t, err)
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) invpkg.SQLInvoiceQueries {
+			return db.WithTx(tx)
+		},
+	)
+	sqlStore := invpkg.NewSQLStore(executor, clock.NewTestClock(testNow))
+
+	return kvDB, sqlStore
+}
+
+// addMigrationTestInvoices adds a mix of open, settled, canceled and AMP
+// invoices to the passed invoice database and returns their payment hashes.
+func addMigrationTestInvoices(t *testing.T,
+	db invpkg.InvoiceDB) []lntypes.Hash {
+
+	ctxb := context.Background()
+
+	var hashes []lntypes.Hash
+	for i := 0; i < 6; i++ {
+		invoice, err := randInvoice(lnwire.MilliSatoshi(i + 1))
+		require.NoError(t, err)
+
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		_, err = db.AddInvoice(ctxb, invoice, hash)
+		require.NoError(t, err)
+
+		hashes = append(hashes, hash)
+		ref := invpkg.InvoiceRefByHash(hash)
+
+		switch i % 3 {
+		// Settle every third invoice.
+		case 1:
+			_, err = db.UpdateInvoice(
+				ctxb, ref, nil,
+				getUpdateInvoice(
+					uint64(100+i), invoice.Terms.Value,
+				),
+			)
+			require.NoError(t, err)
+
+		// Cancel every third invoice.
+		case 2:
+			_, err = db.UpdateInvoice(
+				ctxb, ref, nil, cancelInvoiceUpdate,
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	// Finally, add an AMP invoice with two settled and one accepted HTLC
+	// set.
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	ampInvoice, err := randInvoice(amt)
+	require.NoError(t, err)
+	ampInvoice.Terms.Features = ampFeatures
+
+	preimage := *ampInvoice.Terms.PaymentPreimage
+	hash := preimage.Hash()
+	_, err = db.AddInvoice(ctxb, ampInvoice, hash)
+	require.NoError(t, err)
+
+	hashes = append(hashes, hash)
+	ref := invpkg.InvoiceRefByHashAndAddr(
+		hash, ampInvoice.Terms.PaymentAddr,
+	)
+	for i, setID := range []*[32]byte{{1}, {2}, {3}} {
+		htlcID := uint64(i + 1)
+		_, err = db.UpdateInvoice(
+			ctxb, ref, (*invpkg.SetID)(setID),
+			updateAcceptAMPHtlc(htlcID, amt, setID, true),
+		)
+		require.NoError(t, err)
+
+		if i == 2 {
+			continue
+		}
+
+		_, err = db.UpdateInvoice(
+			ctxb, ref, (*invpkg.SetID)(setID),
+			getUpdateInvoiceAMPSettle(
+				setID, preimage,
+				models.CircuitKey{HtlcID: htlcID},
+			),
+		)
+		require.NoError(t, err)
+	}
+
+	return hashes
+}
+
+// assertInvoicesMigrated asserts that the SQL store contains the same invoices
+// as the KV database.
+func assertInvoicesMigrated(t *testing.T, kvDB invpkg.InvoiceDB,
+	sqlStore *invpkg.SQLStore, hashes []lntypes.Hash) {
+
+	ctxb := context.Background()
+	for _, hash := range hashes {
+		ref := invpkg.InvoiceRefByHash(hash)
+
+		kvInvoice, err := kvDB.LookupInvoice(ctxb, ref)
+		require.NoError(t, err)
+
+		sqlInvoice, err := sqlStore.LookupInvoice(ctxb, ref)
+		require.NoError(t, err)
+
+		require.Equal(t, kvInvoice.AddIndex, sqlInvoice.AddIndex)
+		require.Equal(t, kvInvoice.SettleIndex, sqlInvoice.SettleIndex)
+		require.Equal(t, kvInvoice.State, sqlInvoice.State)
+		require.Equal(t, kvInvoice.AmtPaid, sqlInvoice.AmtPaid)
+		require.Equal(t, kvInvoice.Memo, sqlInvoice.Memo)
+		require.Equal(t, kvInvoice.Terms.Value, sqlInvoice.Terms.Value)
+		require.Equal(
+			t, kvInvoice.Terms.PaymentPreimage,
+			sqlInvoice.Terms.PaymentPreimage,
+		)
+		require.Equal(
+			t, kvInvoice.Terms.PaymentAddr,
+			sqlInvoice.Terms.PaymentAddr,
+		)
+		require.Equal(
+			t, kvInvoice.Terms.Features, sqlInvoice.Terms.Features,
+		)
+		require.Equal(
+			t, kvInvoice.SettleDate.Unix(),
+			sqlInvoice.SettleDate.Unix(),
+		)
+
+		require.Len(t, sqlInvoice.Htlcs, len(kvInvoice.Htlcs))
+		for key, kvHtlc := range kvInvoice.Htlcs {
+			sqlHtlc, ok := sqlInvoice.Htlcs[key]
+			require.True(t, ok)
+
+			require.Equal(t, kvHtlc.Amt, sqlHtlc.Amt)
+			require.Equal(t, kvHtlc.State, sqlHtlc.State)
+			require.Equal(
+				t, kvHtlc.ResolveTime.Unix(),
+				sqlHtlc.ResolveTime.Unix(),
+			)
+			require.Equal(t, kvHtlc.AMP, sqlHtlc.AMP)
+		}
+
+		require.Len(t, sqlInvoice.AMPState, len(kvInvoice.AMPState))
+		for setID, kvState := range kvInvoice.AMPState {
+			sqlState, ok := sqlInvoice.AMPState[setID]
+			require.True(t, ok)
+
+			require.Equal(t, kvState.State, sqlState.State)
+			require.Equal(
+				t, kvState.SettleIndex, sqlState.SettleIndex,
+			)
+		}
+	}
+}
+
+// TestMigrateInvoicesToSQL tests that all KV invoices are migrated to the SQL
+// store, and that new invoices continue with the add and settle indices of the
+// KV database.
+func TestMigrateInvoicesToSQL(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	kvDB, sqlStore := newMigrationTestStores(t)
+	hashes := addMigrationTestInvoices(t, kvDB)
+
+	err := invpkg.MigrateInvoicesToSQL(ctxb, kvDB, sqlStore, 2)
+	require.NoError(t, err)
+
+	assertInvoicesMigrated(t, kvDB, sqlStore, hashes)
+
+	// Running the migration again is a noop.
+	err = invpkg.MigrateInvoicesToSQL(ctxb, kvDB, sqlStore, 2)
+	require.NoError(t, err)
+
+	// A new invoice continues after the last KV add index, and settling
+	// it continues after the last KV settle index. Two regular invoices
+	// and two AMP sub invoices were settled in the KV database.
+	invoice, err := randInvoice(1000)
+	require.NoError(t, err)
+
+	hash := invoice.Terms.PaymentPreimage.Hash()
+	addIndex, err := sqlStore.AddInvoice(ctxb, invoice, hash)
+	require.NoError(t, err)
+	require.EqualValues(t, len(hashes)+1, addIndex)
+
+	settled, err := sqlStore.UpdateInvoice(
+		ctxb, invpkg.InvoiceRefByHash(hash), nil,
+		getUpdateInvoice(0, invoice.Terms.Value),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, settled.SettleIndex)
+}
+
+// TestMigrateInvoicesToSQLResume tests that an interrupted migration can be
+// resumed.
+func TestMigrateInvoicesToSQLResume(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	kvDB, sqlStore := newMigrationTestStores(t)
+	hashes := addMigrationTestInvoices(t, kvDB)
+
+	// Interrupt the migration after the first batch of invoices.
+	source := &failingInvoiceSource{
+		KVInvoiceSource: kvDB,
+		numQueries:      1,
+	}
+	err := invpkg.MigrateInvoicesToSQL(ctxb, source, sqlStore, 3)
+	require.ErrorContains(t, err, "query failed")
+
+	// Only the first batch should have been migrated.
+	slice, err := sqlStore.QueryInvoices(ctxb, invpkg.InvoiceQuery{
+		NumMaxInvoices: 100,
+	})
+	require.NoError(t, err)
+	require.Len(t, slice.Invoices, 3)
+
+	// Now resume the migration which should pick up the remaining
+	// invoices.
+	err = invpkg.MigrateInvoicesToSQL(ctxb, kvDB, sqlStore, 3)
+	require.NoError(t, err)
+
+	assertInvoicesMigrated(t, kvDB, sqlStore, hashes)
+}
+
+// TestMigrateInvoicesToSQLNotEmpty tests that KV invoices aren't migrated to
+// an SQL store that already contains invoices.
+func TestMigrateInvoicesToSQLNotEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	kvDB, sqlStore := newMigrationTestStores(t)
+	addMigrationTestInvoices(t, kvDB)
+
+	invoice, err := randInvoice(1000)
+	require.NoError(t, err)
+
+	hash := invoice.Terms.PaymentPreimage.Hash()
+	_, err = sqlStore.AddInvoice(ctxb, invoice, hash)
+	require.NoError(t, err)
+
+	err = invpkg.MigrateInvoicesToSQL(ctxb, kvDB, sqlStore, 2)
+	require.ErrorContains(t, err, "already contains 1 invoices")
+}
@@ -115,6 +115,32 @@ type SQLInvoiceQueries interface { //nolint:interfacebloat
 
 	OnAMPSubInvoiceSettled(ctx context.Context,
 		arg sqlc.OnAMPSubInvoiceSettledParams) error
+
+	// Invoice migration specific methods.
+	Backend() sqlc.BackendType
+
+	CountInvoices(ctx context.Context) (int64, error)
+
+	InsertMigratedInvoice(ctx context.Context,
+		arg sqlc.InsertMigratedInvoiceParams) error
+
+	SetInvoiceSettleIndex(ctx context.Context, currentValue int64) error
+
+	ResetInvoiceIDSequence(ctx context.Context) error
+
+	GetInvoiceMigrationState(ctx context.Context) (
+		sqlc.InvoiceMigrationState, error)
+
+	UpsertInvoiceMigrationState(ctx context.Context,
+		arg sqlc.UpsertInvoiceMigrationStateParams) error
+
+	InsertInvoiceMigrationHash(ctx context.Context,
+		arg sqlc.InsertInvoiceMigrationHashParams) error
+
+	GetInvoiceMigrationHash(ctx context.Context, addIndex int64) ([]byte,
+		error)
+
+	DeleteInvoiceMigrationHashes(ctx context.Context) error
 }
 
 var _ InvoiceDB = (*SQLStore)(nil)
@@ -63,6 +63,9 @@ const (
 	// LabelTypeChannelClose is used to label channel closes.
 	LabelTypeChannelClose LabelType = "closechannel"
 
+	// LabelTypeChannelSplice is used to label channel splices.
+	LabelTypeChannelSplice LabelType = "splicechannel"
+
 	// LabelTypeJusticeTransaction is used to label justice transactions.
 	LabelTypeJusticeTransaction LabelType = "justicetx"
 
@@ -0,0 +1,93 @@
+package lncfg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BackupSinks holds the configuration of the additional destinations that the
+// static channel backup is copied to whenever it is updated.
+//
+//nolint:lll
+type BackupSinks struct {
+	Files []string `long:"file" description:"An additional file the channel backup is written to, for example on another disk. The directory of the file must exist. Can be specified multiple times."`
+
+	Dirs []string `long:"dir" description:"A directory the channel backup file is mirrored to. The directory is created if it doesn't exist. Can be specified multiple times."`
+
+	Exec string `long:"exec" description:"A command that is run whenever the channel backup is updated. The encrypted backup is passed to the command on its standard input, and arguments are separated by spaces. A non-zero exit status is treated as a failure."`
+
+	ExecTimeout time.Duration `long:"exec-timeout" description:"The maximum amount of time the backup command may run before it is killed."`
+
+	S3 *S3BackupSink `group:"s3" namespace:"s3"`
+
+	RetryDelay time.Duration `long:"retry-delay" description:"The delay before a failed write to a backup destination is retried. The delay doubles after each failed attempt."`
+
+	MaxRetryDelay time.Duration `long:"max-retry-delay" description:"The maximum delay between retries of a failed write to a backup destination."`
+}
+
+// S3BackupSink holds the configuration of an S3 compatible endpoint that the
+// channel backup is uploaded to.
+//
+//nolint:lll
+type S3BackupSink struct {
+	Endpoint string `long:"endpoint" description:"The base URL of an S3 compatible object storage service the channel backup is uploaded to, for example https://s3.us-east-1.amazonaws.com. Uploads are disabled if empty."`
+
+	Region string `long:"region" description:"The region used to sign the upload requests."`
+
+	Bucket string `long:"bucket" description:"The bucket the channel backup is uploaded to."`
+
+	Key string `long:"key" description:"The object key the channel backup is stored under."`
+
+	AccessKeyID string `long:"access-key-id" description:"The ID of the access key used to sign the upload requests."`
+
+	SecretAccessKey string `long:"secret-access-key" description:"The secret of the access key used to sign the upload requests."`
+
+	Timeout time.Duration `long:"timeout" description:"The timeout of a single upload."`
+}
+
+// ExecCommand returns the command and arguments of the backup command.
+func (b *BackupSinks) ExecCommand() (string, []string) {
+	fields := strings.Fields(b.Exec)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	return fields[0], fields[1:]
+}
+
+// Validate checks the values configured for the backup sinks.
+func (b *BackupSinks) Validate() error {
+	if b.ExecTimeout <= 0 {
+		return fmt.Errorf("backupsink.exec-timeout must be positive")
+	}
+
+	if b.RetryDelay <= 0 {
+		return fmt.Errorf("backupsink.retry-delay must be positive")
+	}
+
+	if b.MaxRetryDelay < b.RetryDelay {
+		return fmt.Errorf("backupsink.max-retry-delay must not be " +
+			"below backupsink.retry-delay")
+	}
+
+	s3 := b.S3
+	if s3 == nil || s3.Endpoint == "" {
+		return nil
+	}
+
+	switch {
+	case s3.Bucket == "" || s3.Key == "":
+		return fmt.Errorf("backupsink.s3.bucket and " +
+			"backupsink.s3.key must be set")
+
+	case s3.AccessKeyID == "" || s3.SecretAccessKey == "":
+		return fmt.Errorf("backupsink.s3.access-key-id and " +
+			"backupsink.s3.secret-access-key must be set")
+
+	case s3.Timeout <= 0:
+		return fmt.Errorf("backupsink.s3.timeout must be positive")
+	}
+
+	return nil
+}
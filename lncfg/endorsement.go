@@ -0,0 +1,47 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// Endorsement holds the parameters of the experimental endorsement signal and
+// the resource bucketing that protects our channels against jamming.
+//
+//nolint:lll
+type Endorsement struct {
+	GeneralSlotFraction float64 `long:"general-slot-fraction" description:"The fraction of the htlc slots of a channel that unendorsed htlcs may use. The remaining slots are protected for endorsed htlcs."`
+
+	GeneralLiquidityFraction float64 `long:"general-liquidity-fraction" description:"The fraction of the max value in flight of a channel that unendorsed htlcs may use. The remaining liquidity is protected for endorsed htlcs."`
+
+	ResolutionPeriod time.Duration `long:"resolution-period" description:"The time within which forwarded htlcs are expected to resolve. Incoming channels lose reputation for every period that their htlcs are in flight for."`
+
+	ReputationHalfLife time.Duration `long:"reputation-half-life" description:"The time after which the fees that an incoming channel earned us count half towards its reputation."`
+
+	RevenueHalfLife time.Duration `long:"revenue-half-life" description:"The time after which the fees that an outgoing channel earned us count half towards its revenue."`
+}
+
+// Validate checks the values configured for the endorsement signal.
+func (e *Endorsement) Validate() error {
+	if e.GeneralSlotFraction < 0 || e.GeneralSlotFraction > 1 {
+		return fmt.Errorf("endorsement.general-slot-fraction must be " +
+			"between 0 and 1")
+	}
+
+	if e.GeneralLiquidityFraction < 0 || e.GeneralLiquidityFraction > 1 {
+		return fmt.Errorf("endorsement.general-liquidity-fraction " +
+			"must be between 0 and 1")
+	}
+
+	if e.ResolutionPeriod <= 0 {
+		return fmt.Errorf("endorsement.resolution-period must be " +
+			"positive")
+	}
+
+	if e.ReputationHalfLife <= 0 || e.RevenueHalfLife <= 0 {
+		return fmt.Errorf("endorsement.reputation-half-life and " +
+			"endorsement.revenue-half-life must be positive")
+	}
+
+	return nil
+}
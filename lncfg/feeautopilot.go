@@ -0,0 +1,93 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// FeeAutopilotProportional is the fee autopilot strategy that sets the
+	// fee rate of a channel in proportion to its remote balance.
+	FeeAutopilotProportional = "proportional"
+
+	// FeeAutopilotForwardingRate is the fee autopilot strategy that
+	// adjusts the fee rate of a channel towards a target forwarding
+	// volume.
+	FeeAutopilotForwardingRate = "forwarding-rate"
+)
+
+// FeeAutopilot holds the configuration of the fee autopilot, which manages the
+// routing fees of our channels based on their liquidity.
+//
+//nolint:lll
+type FeeAutopilot struct {
+	Active bool `long:"active" description:"If the fee autopilot should periodically update the routing fees of our channels."`
+
+	DryRun bool `long:"dry-run" description:"Only log the fee policy updates that the fee autopilot would make, without applying them."`
+
+	Strategy string `long:"strategy" description:"The strategy that sets the fee rates of our channels. 'proportional' sets the fee rate in proportion to the remote balance of a channel, 'forwarding-rate' lowers or raises the fee rate of a channel towards a target forwarding volume." choice:"proportional" choice:"forwarding-rate"`
+
+	Interval time.Duration `long:"interval" description:"The interval at which the fee policies of our channels are evaluated."`
+
+	MinFeeRate uint32 `long:"min-fee-rate" description:"The lowest fee rate in parts per million that the fee autopilot sets."`
+
+	MaxFeeRate uint32 `long:"max-fee-rate" description:"The highest fee rate in parts per million that the fee autopilot sets."`
+
+	ForwardingWindow time.Duration `long:"forwarding-window" description:"The window within which the forwarding volume of a channel is measured by the forwarding-rate strategy."`
+
+	TargetForwardRatio float64 `long:"target-forward-ratio" description:"The forwarding volume within the forwarding window that the forwarding-rate strategy targets, as a fraction of the channel capacity."`
+
+	FeeRateStep float64 `long:"fee-rate-step" description:"The fraction by which the forwarding-rate strategy lowers or raises a fee rate."`
+
+	InboundDiscount bool `long:"inbound-discount" description:"If an inbound fee discount should be offered on channels with a low local balance, to attract htlcs that refill them."`
+
+	MaxInboundDiscount uint32 `long:"max-inbound-discount" description:"The inbound fee rate discount in parts per million of a channel without local balance. The discount shrinks linearly to zero at the inbound discount threshold."`
+
+	InboundDiscountThreshold float64 `long:"inbound-discount-threshold" description:"The share of the channel balance on our side below which an inbound fee discount is offered."`
+
+	MinUpdateInterval time.Duration `long:"min-update-interval" description:"The minimum time between two fee policy updates of the same channel, which limits the channel updates that we gossip."`
+
+	MinFeeRateDelta uint32 `long:"min-fee-rate-delta" description:"The minimum change of a fee rate in parts per million that is worth a fee policy update."`
+}
+
+// Validate checks the values configured for the fee autopilot.
+func (f *FeeAutopilot) Validate() error {
+	if !f.Active {
+		return nil
+	}
+
+	switch {
+	case f.Interval <= 0:
+		return fmt.Errorf("feeautopilot.interval must be positive")
+
+	case f.MaxFeeRate < f.MinFeeRate:
+		return fmt.Errorf("feeautopilot.max-fee-rate must not be " +
+			"below feeautopilot.min-fee-rate")
+
+	case f.Strategy == FeeAutopilotForwardingRate &&
+		f.ForwardingWindow <= 0:
+
+		return fmt.Errorf("feeautopilot.forwarding-window must be " +
+			"positive")
+
+	case f.Strategy == FeeAutopilotForwardingRate &&
+		f.TargetForwardRatio <= 0:
+
+		return fmt.Errorf("feeautopilot.target-forward-ratio must be " +
+			"positive")
+
+	case f.Strategy == FeeAutopilotForwardingRate &&
+		(f.FeeRateStep <= 0 || f.FeeRateStep >= 1):
+
+		return fmt.Errorf("feeautopilot.fee-rate-step must be " +
+			"between 0 and 1")
+
+	case f.InboundDiscount && (f.InboundDiscountThreshold <= 0 ||
+		f.InboundDiscountThreshold > 1):
+
+		return fmt.Errorf("feeautopilot.inbound-discount-threshold " +
+			"must be between 0 and 1")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package lncfg
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// PeerStorage holds the quotas of the blobs we store on behalf of our peers
+// when peer storage is enabled.
+//
+//nolint:lll
+type PeerStorage struct {
+	MaxPeers int `long:"max-peers" description:"The maximum number of channel peers that we store a blob for."`
+
+	MaxBlobSize int `long:"max-blob-size" description:"The maximum size in bytes of a blob that we store for a peer. Larger blobs are ignored."`
+}
+
+// Validate checks the values configured for peer storage.
+func (p *PeerStorage) Validate() error {
+	if p.MaxPeers < 0 {
+		return fmt.Errorf("peerstorage.max-peers must not be negative")
+	}
+
+	if p.MaxBlobSize <= 0 || p.MaxBlobSize > lnwire.MaxPeerStorageBlobSize {
+		return fmt.Errorf("peerstorage.max-blob-size must be between "+
+			"1 and %d", lnwire.MaxPeerStorageBlobSize)
+	}
+
+	return nil
+}
@@ -7,3 +7,15 @@ package lncfg
 // features that also require a build-tag to activate.
 type ExperimentalProtocol struct {
 }
+
+// CoFund returns true if we should signal support for co-funded channel
+// opens, which are only available in dev builds.
+func (l *ExperimentalProtocol) CoFund() bool {
+	return false
+}
+
+// CoFundContribution returns the amount in satoshis we contribute to co-funded
+// channels opened to us, which is always zero outside of dev builds.
+func (l *ExperimentalProtocol) CoFundContribution() uint64 {
+	return 0
+}
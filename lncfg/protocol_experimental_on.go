@@ -5,5 +5,29 @@ package lncfg
 
 // ExperimentalProtocol is a sub-config that houses any experimental protocol
 // features that also require a build-tag to activate.
+//
+//nolint:lll
 type ExperimentalProtocol struct {
+	// CoFundOption should be set if we want to signal support for
+	// co-funded channel opens, where the remote party may contribute
+	// inputs to the funding transaction. These opens aren't compatible
+	// with the channel establishment v2 protocol of option_dual_fund, see
+	// docs/co_funded_channels.md.
+	CoFundOption bool `long:"co-fund" description:"EXPERIMENTAL: if set, then lnd will signal support for co-funded channel opens and ask supporting peers to co-fund the channels it opens to them where possible"`
+
+	// CoFundContributionSat is the amount we contribute from our wallet
+	// to co-funded channels opened to us.
+	CoFundContributionSat uint64 `long:"co-fund-contribution" description:"EXPERIMENTAL: the amount in satoshis lnd contributes from its wallet to co-funded channels opened by peers"`
+}
+
+// CoFund returns true if we should signal support for co-funded channel
+// opens.
+func (l *ExperimentalProtocol) CoFund() bool {
+	return l.CoFundOption
+}
+
+// CoFundContribution returns the amount in satoshis we contribute to co-funded
+// channels opened to us.
+func (l *ExperimentalProtocol) CoFundContribution() uint64 {
+	return l.CoFundContributionSat
 }
@@ -66,6 +66,46 @@ type ProtocolOptions struct {
 	// NoRouteBlindingOption disables forwarding of payments in blinded routes.
 	NoRouteBlindingOption bool `long:"no-route-blinding" description:"do not forward payments that are a part of a blinded route"`
 
+	// RbfCoopCloseOption should be set if we want to signal the
+	// rbf-coop-close feature bit, which allows either side of a
+	// cooperative close to fee bump the closing transaction via RBF.
+	RbfCoopCloseOption bool `long:"rbf-coop-close" description:"if set, then lnd will signal support for the RBF co-op close flow, where each side pays for and can fee bump its own closing transaction"`
+
+	// DynamicCommitmentsOption should be set if we want to signal the
+	// dynamic-commitments feature bit, which allows updating the
+	// parameters of a live channel without closing it.
+	DynamicCommitmentsOption bool `long:"dynamic-commitments" description:"EXPERIMENTAL: if set, then lnd will signal support for dynamic commitments, which allow updating the parameters of a channel without closing it"`
+
+	// NoQuiescenceOption disables the quiescence protocol, which allows
+	// the channel to be brought to a state where neither side may add new
+	// updates.
+	NoQuiescenceOption bool `long:"no-quiescence" description:"do not signal support for the quiescence protocol (stfu)"`
+
+	// SpliceOption should be set if we want to signal support for
+	// splicing, which allows adding funds to or removing funds from a live
+	// channel.
+	SpliceOption bool `long:"splice" description:"EXPERIMENTAL: if set, then lnd will signal support for splicing, which allows resizing a channel without closing it"`
+
+	// OnionMessagesOption should be set if we want to signal support for
+	// onion messages, which allows sending, receiving and relaying
+	// messages along blinded paths.
+	OnionMessagesOption bool `long:"onion-messages" description:"EXPERIMENTAL: if set, then lnd will signal support for onion messages and will send, receive and relay them"`
+
+	// PeerStorageOption should be set if we want to signal support for
+	// peer storage, which allows storing small blobs for our peers and
+	// handing our channel backup to them.
+	PeerStorageOption bool `long:"peer-storage" description:"EXPERIMENTAL: if set, then lnd will signal support for peer storage, will store the blobs of its channel peers and will hand its encrypted channel backup to them"`
+
+	// TrampolineRoutingOption should be set if we want to signal support
+	// for trampoline routing and forward trampoline payments on behalf
+	// of senders.
+	TrampolineRoutingOption bool `long:"trampoline-routing" description:"EXPERIMENTAL: if set, then lnd will signal support for trampoline routing and will find routes for trampoline payments on behalf of senders"`
+
+	// ExperimentalEndorsementOption should be set if we want to set and
+	// relay the experimental htlc endorsement signal, and protect part of
+	// the resources of our channels for endorsed htlcs.
+	ExperimentalEndorsementOption bool `long:"experimental-endorsement" description:"EXPERIMENTAL: if set, then lnd will endorse the htlcs that it sends, relay the endorsement of htlcs from reputable channels and restrict unendorsed htlcs to a fraction of the slots and liquidity of each channel"`
+
 	// CustomMessage allows the custom message APIs to handle messages with
 	// the provided protocol numbers, which fall outside the custom message
 	// number range.
@@ -123,6 +163,54 @@ func (l *ProtocolOptions) NoRouteBlinding() bool {
 	return l.NoRouteBlindingOption
 }
 
+// RbfCoopClose returns true if we should signal support for the RBF co-op
+// close flow.
+func (l *ProtocolOptions) RbfCoopClose() bool {
+	return l.RbfCoopCloseOption
+}
+
+// DynamicCommitments returns true if we should signal support for dynamic
+// commitments.
+func (l *ProtocolOptions) DynamicCommitments() bool {
+	return l.DynamicCommitmentsOption
+}
+
+// NoQuiescence returns true if the quiescence protocol should be disabled.
+// As a channel only resumes from quiescence once the protocol that's run on
+// top of it is done, quiescence is only signalled if splicing is enabled.
+func (l *ProtocolOptions) NoQuiescence() bool {
+	return l.NoQuiescenceOption || !l.SpliceOption
+}
+
+// Splice returns true if we should signal support for splicing.
+func (l *ProtocolOptions) Splice() bool {
+	return l.SpliceOption
+}
+
+// OnionMessages returns true if we should signal support for onion messages
+// and handle them.
+func (l *ProtocolOptions) OnionMessages() bool {
+	return l.OnionMessagesOption
+}
+
+// PeerStorage returns true if we should signal support for peer storage and
+// exchange blobs with our peers.
+func (l *ProtocolOptions) PeerStorage() bool {
+	return l.PeerStorageOption
+}
+
+// TrampolineRouting returns true if we should signal support for trampoline
+// routing and forward trampoline payments.
+func (l *ProtocolOptions) TrampolineRouting() bool {
+	return l.TrampolineRoutingOption
+}
+
+// ExperimentalEndorsement returns true if we should use the experimental htlc
+// endorsement signal and the resource bucketing of channels.
+func (l *ProtocolOptions) ExperimentalEndorsement() bool {
+	return l.ExperimentalEndorsementOption
+}
+
 // CustomMessageOverrides returns the set of protocol messages that we override
 // to allow custom handling.
 func (l ProtocolOptions) CustomMessageOverrides() []uint16 {
@@ -0,0 +1,33 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// Trampoline holds the fees and CLTV delta that we charge for forwarding
+// trampoline payments when trampoline routing is enabled.
+//
+//nolint:lll
+type Trampoline struct {
+	FeeBaseMsat uint64 `long:"fee-base-msat" description:"The base fee in millisatoshi that we charge for forwarding a trampoline payment."`
+
+	FeeRatePPM uint32 `long:"fee-rate-ppm" description:"The proportional fee in parts per million that we charge for forwarding a trampoline payment."`
+
+	CltvDelta uint32 `long:"cltv-delta" description:"The number of blocks that we keep between the incoming and outgoing htlcs of a trampoline payment."`
+
+	MppTimeout time.Duration `long:"mpp-timeout" description:"The time that we wait for all parts of a trampoline payment to arrive."`
+}
+
+// Validate checks the values configured for trampoline routing.
+func (t *Trampoline) Validate() error {
+	if t.CltvDelta == 0 {
+		return fmt.Errorf("trampoline.cltv-delta must be positive")
+	}
+
+	if t.MppTimeout <= 0 {
+		return fmt.Errorf("trampoline.mpp-timeout must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package lncfg
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Webhook holds the configuration of the URLs that invoice, payment and
+// channel events are posted to.
+//
+//nolint:lll
+type Webhook struct {
+	URLs []string `long:"url" description:"A URL that invoice, payment and channel events are posted to as JSON. Can be specified multiple times. Webhooks are disabled if no URL is set."`
+
+	Secret string `long:"secret" description:"The shared secret that the body of each request is signed with. The hex encoded HMAC-SHA256 of the body is sent in the X-Lnd-Signature header. Required if a URL is set."`
+
+	Timeout time.Duration `long:"timeout" description:"The timeout of a single delivery attempt."`
+
+	RetryDelay time.Duration `long:"retry-delay" description:"The delay before a failed delivery is retried. The delay doubles after each failed attempt."`
+
+	MaxRetryDelay time.Duration `long:"max-retry-delay" description:"The maximum delay between retries of a failed delivery."`
+
+	MaxAttempts uint32 `long:"max-attempts" description:"The number of failed attempts after which a delivery is dropped. Set to 0 to retry forever."`
+}
+
+// Active returns true if events are posted to at least one URL.
+func (w *Webhook) Active() bool {
+	return len(w.URLs) > 0
+}
+
+// Validate checks the values configured for the webhooks.
+func (w *Webhook) Validate() error {
+	if !w.Active() {
+		return nil
+	}
+
+	for _, rawURL := range w.URLs {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid webhook.url %v: %w", rawURL,
+				err)
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("webhook.url %v must be an http or "+
+				"https URL", rawURL)
+		}
+	}
+
+	switch {
+	case w.Secret == "":
+		return fmt.Errorf("webhook.secret must be set if webhook.url " +
+			"is set")
+
+	case w.Timeout <= 0:
+		return fmt.Errorf("webhook.timeout must be positive")
+
+	case w.RetryDelay <= 0:
+		return fmt.Errorf("webhook.retry-delay must be positive")
+
+	case w.MaxRetryDelay < w.RetryDelay:
+		return fmt.Errorf("webhook.max-retry-delay must not be below " +
+			"webhook.retry-delay")
+	}
+
+	return nil
+}
@@ -31,6 +31,28 @@ type WtClient struct {
 	// MaxUpdates is the maximum number of updates to be backed up in a
 	// single tower sessions.
 	MaxUpdates uint16 `long:"max-updates" description:"The maximum number of updates to be backed up in a single session."`
+
+	// RewardSessions determines whether the client negotiates reward
+	// sessions, in which the tower is paid a reward out of the justice
+	// transaction, instead of altruist sessions.
+	RewardSessions bool `long:"reward-sessions" description:"Whether the client should negotiate reward sessions with its towers instead of altruist sessions. Only towers that accept the offered reward terms are used."`
+
+	// RewardBase is the fixed amount in satoshis offered to the tower out
+	// of each justice transaction when using reward sessions.
+	RewardBase uint32 `long:"reward-base" description:"The fixed amount in satoshis offered to the tower out of each justice transaction when using reward sessions."`
+
+	// RewardRate is the proportional reward offered to the tower when
+	// using reward sessions, in millionths of the swept balance.
+	RewardRate uint32 `long:"reward-rate" description:"The proportional reward offered to the tower when using reward sessions, in millionths of the balance swept by the justice transaction."`
+
+	// MaxSessionPayment is the maximum amount in satoshis, including
+	// routing fees, that the client pays a tower for a single session.
+	MaxSessionPayment uint64 `long:"max-session-payment" description:"The maximum amount in satoshis, including routing fees, that the client pays via Lightning to a tower that charges for its sessions. Towers that charge more for a session are not used. Set to 0 to not use towers that charge for sessions."`
+
+	// MaxTowerPayments is the maximum total amount in satoshis, including
+	// routing fees, that the client pays a single tower over all of its
+	// sessions.
+	MaxTowerPayments uint64 `long:"max-tower-payments" description:"The maximum total amount in satoshis, including routing fees, that the client pays via Lightning to a single tower over all of its sessions. Must be set if max-session-payment is set."`
 }
 
 // DefaultWtClientCfg returns the WtClient config struct with some default
@@ -47,6 +69,7 @@ func DefaultWtClientCfg() *WtClient {
 		SessionCloseRange:  wtclient.DefaultSessionCloseRange,
 		MaxTasksInMemQueue: wtclient.DefaultMaxTasksInMemQueue,
 		MaxUpdates:         wtpolicy.DefaultMaxUpdates,
+		RewardRate:         wtpolicy.DefaultRewardRate,
 	}
 }
 
@@ -70,6 +93,16 @@ func (c *WtClient) Validate() error {
 		return fmt.Errorf("session-close-range must be non-zero")
 	}
 
+	if c.RewardRate > wtpolicy.RewardScale {
+		return fmt.Errorf("reward-rate must not exceed %d",
+			wtpolicy.RewardScale)
+	}
+
+	if c.MaxSessionPayment > c.MaxTowerPayments {
+		return fmt.Errorf("max-tower-payments must be at least " +
+			"max-session-payment")
+	}
+
 	return nil
 }
 
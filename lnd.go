@@ -525,6 +525,29 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 		}()
 	}
 
+	// Initialize the MultiplexAcceptor. If lnd was started with the
+	// zero-conf feature bit, then this will be a ZeroConfAcceptor.
+	// Otherwise, this will be a ChainedAcceptor.
+	var multiAcceptor chanacceptor.MultiplexAcceptor
+	if cfg.ProtocolOptions.ZeroConf() {
+		multiAcceptor = chanacceptor.NewZeroConfAcceptor()
+	} else {
+		multiAcceptor = chanacceptor.NewChainedAcceptor()
+	}
+
+	// Set up the core server which will listen for incoming peer
+	// connections.
+	server, err := newServer(
+		cfg, cfg.Listeners, dbs, activeChainControl, &idKeyDesc,
+		activeChainControl.Cfg.WalletUnlockParams.ChansToRestore,
+		multiAcceptor, torController, tlsManager, leaderElector,
+	)
+	if err != nil {
+		return mkErr("unable to create server: %v", err)
+	}
+
+	// The watchtower is created once the server exists, as it uses the
+	// server's invoices to charge for sessions.
 	var tower *watchtower.Standalone
 	if cfg.Watchtower.Active {
 		towerKeyDesc, err := activeChainControl.KeyRing.DeriveKey(
@@ -555,6 +578,12 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 			ChainHash: *cfg.ActiveNetParams.GenesisHash,
 		}
 
+		// If the tower charges for its sessions, clients pay for them
+		// with invoices of our node.
+		wtCfg.NewInvoice = server.addTowerInvoice
+		wtCfg.IsInvoicePaid = server.isTowerInvoicePaid
+		wtCfg.DeleteInvoice = server.deleteTowerInvoice
+
 		// If there is a tor controller (user wants auto hidden
 		// services), then store a pointer in the watchtower config.
 		if torController != nil {
@@ -584,27 +613,6 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 		}
 	}
 
-	// Initialize the MultiplexAcceptor. If lnd was started with the
-	// zero-conf feature bit, then this will be a ZeroConfAcceptor.
-	// Otherwise, this will be a ChainedAcceptor.
-	var multiAcceptor chanacceptor.MultiplexAcceptor
-	if cfg.ProtocolOptions.ZeroConf() {
-		multiAcceptor = chanacceptor.NewZeroConfAcceptor()
-	} else {
-		multiAcceptor = chanacceptor.NewChainedAcceptor()
-	}
-
-	// Set up the core server which will listen for incoming peer
-	// connections.
-	server, err := newServer(
-		cfg, cfg.Listeners, dbs, activeChainControl, &idKeyDesc,
-		activeChainControl.Cfg.WalletUnlockParams.ChansToRestore,
-		multiAcceptor, torController, tlsManager, leaderElector,
-	)
-	if err != nil {
-		return mkErr("unable to create server: %v", err)
-	}
-
 	// Set up an autopilot manager from the current config. This will be
 	// used to manage the underlying autopilot agent, starting and stopping
 	// it at will.
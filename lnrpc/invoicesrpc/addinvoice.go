@@ -19,6 +19,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnutils"
@@ -75,7 +76,7 @@ type AddInvoiceConfig struct {
 	ChanDB *channeldb.ChannelStateDB
 
 	// Graph holds a reference to the ChannelGraph database.
-	Graph *channeldb.ChannelGraph
+	Graph channeldb.GraphStore
 
 	// GenInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated invoices.
@@ -95,7 +96,13 @@ type AddInvoiceConfig struct {
 
 	// QueryBlindedRoutes can be used to generate a few routes to this node
 	// that can then be used in the construction of a blinded payment path.
-	QueryBlindedRoutes func(lnwire.MilliSatoshi) ([]*route.Route, error)
+	QueryBlindedRoutes func(lnwire.MilliSatoshi,
+		*routing.BlindedPathRestrictions) ([]*route.Route, error)
+
+	// BlindedPathRestrictions holds the default restrictions for the
+	// blinded paths of an invoice. They can be overridden per invoice with
+	// a BlindedPathConfig.
+	BlindedPathRestrictions *routing.BlindedPathRestrictions
 
 	// BlindedRoutePolicyIncrMultiplier is the amount by which policy values
 	// for hops in a blinded route will be bumped to avoid easy probing. For
@@ -109,11 +116,6 @@ type AddInvoiceConfig struct {
 	// appropriate values (like maximum HTLC) by 10%.
 	BlindedRoutePolicyDecrMultiplier float64
 
-	// MinNumBlindedPathHops is the minimum number of hops that a blinded
-	// path should be. Dummy hops will be used to pad any route with a
-	// length less than this.
-	MinNumBlindedPathHops uint8
-
 	// DefaultDummyHopPolicy holds the default policy values to use for
 	// dummy hops in a blinded path in the case where they cant be derived
 	// through other means.
@@ -173,11 +175,75 @@ type AddInvoiceData struct {
 	// revealing the destination node's real pub key.
 	Blind bool
 
+	// BlindedPathCfg holds optional overrides of the default blinded path
+	// restrictions. It is only used if Blind is set.
+	BlindedPathCfg *BlindedPathConfig
+
 	// RouteHints are optional route hints that can each be individually
 	// used to assist in reaching the invoice's destination.
 	RouteHints [][]zpay32.HopHint
 }
 
+// BlindedPathConfig holds the values that an invoice may use to override the
+// default restrictions of its blinded paths.
+type BlindedPathConfig struct {
+	// MinNumRealHops is the minimum number of real (non-dummy) hops to
+	// include in a blinded path. This doesn't include our node.
+	MinNumRealHops fn.Option[uint8]
+
+	// NumHops is the number of hops that each blinded path should consist
+	// of. Paths with fewer real hops are padded with dummy hops.
+	NumHops fn.Option[uint8]
+
+	// MaxNumPaths is the maximum number of blinded paths to include in the
+	// invoice.
+	MaxNumPaths fn.Option[uint8]
+
+	// NodeOmissionSet is a set of nodes that must not appear on any of the
+	// blinded paths.
+	NodeOmissionSet fn.Set[route.Vertex]
+
+	// IntroNodeSet restricts the introduction nodes of the blinded paths
+	// to the given nodes, such as a selection of our channel peers.
+	IntroNodeSet fn.Set[route.Vertex]
+}
+
+// blindedPathRestrictions applies the overrides of the config to the given
+// default restrictions.
+func (c *BlindedPathConfig) blindedPathRestrictions(
+	defaults *routing.BlindedPathRestrictions) (
+	*routing.BlindedPathRestrictions, error) {
+
+	restrictions := *defaults
+	if c == nil {
+		return &restrictions, nil
+	}
+
+	restrictions.MinDistanceFromIntroNode = c.MinNumRealHops.UnwrapOr(
+		defaults.MinDistanceFromIntroNode,
+	)
+	restrictions.NumHops = c.NumHops.UnwrapOr(defaults.NumHops)
+	restrictions.MaxNumPaths = c.MaxNumPaths.UnwrapOr(
+		defaults.MaxNumPaths,
+	)
+	restrictions.NodeOmissionSet = c.NodeOmissionSet
+	restrictions.IntroNodeSet = c.IntroNodeSet
+
+	if restrictions.MinDistanceFromIntroNode > restrictions.NumHops {
+		return nil, fmt.Errorf("the minimum number of real hops (%d) "+
+			"must not exceed the number of hops (%d) of a blinded "+
+			"path", restrictions.MinDistanceFromIntroNode,
+			restrictions.NumHops)
+	}
+
+	if restrictions.MaxNumPaths == 0 {
+		return nil, errors.New("the maximum number of blinded paths " +
+			"must be positive")
+	}
+
+	return &restrictions, nil
+}
+
 // paymentHashAndPreimage returns the payment hash and preimage for this invoice
 // depending on the configuration.
 //
@@ -282,6 +348,11 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			"are not yet supported")
 	}
 
+	if invoice.BlindedPathCfg != nil && !invoice.Blind {
+		return nil, nil, errors.New("blinded path config set for an " +
+			"invoice without blinded paths")
+	}
+
 	paymentPreimage, paymentHash, err := invoice.paymentHashAndPreimage()
 	if err != nil {
 		return nil, nil, err
@@ -508,10 +579,22 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		finalCLTVDelta := uint32(cltvExpiryDelta)
 		finalCLTVDelta += uint32(routing.BlockPadding)
 
+		restrictions, err := invoice.BlindedPathCfg.
+			blindedPathRestrictions(cfg.BlindedPathRestrictions)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		//nolint:lll
 		paths, err := blindedpath.BuildBlindedPaymentPaths(
 			&blindedpath.BuildBlindedPathCfg{
-				FindRoutes:              cfg.QueryBlindedRoutes,
+				FindRoutes: func(amt lnwire.MilliSatoshi) (
+					[]*route.Route, error) {
+
+					return cfg.QueryBlindedRoutes(
+						amt, restrictions,
+					)
+				},
 				FetchChannelEdgesByID:   cfg.Graph.FetchChannelEdgesByID,
 				FetchOurOpenChannels:    cfg.ChanDB.FetchAllOpenChannels,
 				PathID:                  paymentAddr[:],
@@ -529,7 +612,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 						cfg.BlindedRoutePolicyDecrMultiplier,
 					)
 				},
-				MinNumHops:            cfg.MinNumBlindedPathHops,
+				MinNumHops:            restrictions.NumHops,
 				DefaultDummyHopPolicy: cfg.DefaultDummyHopPolicy,
 			},
 		)
@@ -9,7 +9,11 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -896,3 +900,72 @@ func TestPopulateHopHints(t *testing.T) {
 		})
 	}
 }
+
+// TestBlindedPathRestrictions tests that the blinded path config of an invoice
+// overrides the default restrictions.
+func TestBlindedPathRestrictions(t *testing.T) {
+	t.Parallel()
+
+	defaults := &routing.BlindedPathRestrictions{
+		MinDistanceFromIntroNode: 1,
+		NumHops:                  2,
+		MaxNumPaths:              3,
+	}
+
+	// Without a config, the defaults are used.
+	var cfg *BlindedPathConfig
+	restrictions, err := cfg.blindedPathRestrictions(defaults)
+	require.NoError(t, err)
+	require.Equal(t, defaults, restrictions)
+
+	intro := route.Vertex{1}
+	cfg = &BlindedPathConfig{
+		NumHops:      fn.Some(uint8(4)),
+		MaxNumPaths:  fn.Some(uint8(1)),
+		IntroNodeSet: fn.NewSet(intro),
+	}
+	restrictions, err = cfg.blindedPathRestrictions(defaults)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, restrictions.MinDistanceFromIntroNode)
+	require.EqualValues(t, 4, restrictions.NumHops)
+	require.EqualValues(t, 1, restrictions.MaxNumPaths)
+	require.True(t, restrictions.IntroNodeSet.Contains(intro))
+
+	// The defaults themselves are left untouched.
+	require.EqualValues(t, 2, defaults.NumHops)
+
+	// More real hops than hops in total are rejected.
+	cfg = &BlindedPathConfig{
+		MinNumRealHops: fn.Some(uint8(3)),
+	}
+	_, err = cfg.blindedPathRestrictions(defaults)
+	require.Error(t, err)
+}
+
+// TestUnmarshalBlindedPathConfig tests that zero counts in an RPC blinded path
+// config fall back to the defaults, and that node IDs are validated.
+func TestUnmarshalBlindedPathConfig(t *testing.T) {
+	t.Parallel()
+
+	node := route.NewVertex(pubkey)
+	cfg, err := UnmarshalBlindedPathConfig(&lnrpc.BlindedPathConfig{
+		NumHops:          3,
+		NodeOmissionList: [][]byte{node[:]},
+	})
+	require.NoError(t, err)
+	require.True(t, cfg.MinNumRealHops.IsNone())
+	require.Equal(t, fn.Some(uint8(3)), cfg.NumHops)
+	require.True(t, cfg.MaxNumPaths.IsNone())
+	require.True(t, cfg.NodeOmissionSet.Contains(node))
+	require.Empty(t, cfg.IntroNodeSet)
+
+	_, err = UnmarshalBlindedPathConfig(&lnrpc.BlindedPathConfig{
+		MaxNumPaths: 256,
+	})
+	require.Error(t, err)
+
+	_, err = UnmarshalBlindedPathConfig(&lnrpc.BlindedPathConfig{
+		IntroNodeList: [][]byte{{1, 2, 3}},
+	})
+	require.Error(t, err)
+}
@@ -30,6 +30,10 @@ type Config struct {
 	// created by the daemon.
 	InvoiceRegistry *invoices.InvoiceRegistry
 
+	// HtlcModifier allows a client to inspect and modify the htlcs that
+	// pay our invoices.
+	HtlcModifier invoices.HtlcModifier
+
 	// IsChannelActive is used to generate valid hop hints.
 	IsChannelActive func(chanID lnwire.ChannelID) bool
 
@@ -47,7 +51,7 @@ type Config struct {
 
 	// GraphDB is a global database instance which is needed to access the
 	// channel graph.
-	GraphDB *channeldb.ChannelGraph
+	GraphDB channeldb.GraphStore
 
 	// ChanStateDB is a possibly replicated db instance which contains the
 	// channel state
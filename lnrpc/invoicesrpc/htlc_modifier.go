@@ -0,0 +1,241 @@
+//go:build invoicesrpc
+// +build invoicesrpc
+
+package invoicesrpc
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// htlcModifierTimeout is the time after which a htlc that the client
+	// didn't respond to is rejected.
+	htlcModifierTimeout = 30 * time.Second
+)
+
+var (
+	// errHtlcModifierTimeout is returned when the client doesn't respond
+	// to a htlc in time.
+	errHtlcModifierTimeout = errors.New("htlc modifier didn't respond " +
+		"in time")
+
+	// errHtlcModifierGone is returned when the client disconnects before
+	// it responded to a htlc.
+	errHtlcModifierGone = errors.New("htlc modifier disconnected")
+
+	// errHtlcAlreadyPending is returned when a htlc is passed to the
+	// client while it is still waiting for a response to the same htlc.
+	errHtlcAlreadyPending = errors.New("htlc already pending at htlc " +
+		"modifier")
+)
+
+// pendingHtlcs maps the htlcs that the client hasn't responded to yet to the
+// channels that their responses are delivered on.
+type pendingHtlcs map[invoices.CircuitKey]chan *invoices.HtlcModifyResponse
+
+// htlcModifier is the server side of a HtlcModifier stream. It sends the htlcs
+// that pay our invoices to the client, and delivers the responses of the
+// client to the invoice registry.
+type htlcModifier struct {
+	stream      Invoices_HtlcModifierServer
+	chainParams *chaincfg.Params
+
+	// pending holds the htlcs that the client hasn't responded to yet.
+	pending pendingHtlcs
+	mu      sync.Mutex
+
+	// sendMu serializes the sends on the stream, as htlcs of different
+	// channels are passed to the client concurrently.
+	sendMu sync.Mutex
+
+	quit chan struct{}
+}
+
+// newHtlcModifier returns a new htlc modifier for the given stream.
+func newHtlcModifier(stream Invoices_HtlcModifierServer,
+	chainParams *chaincfg.Params, quit chan struct{}) *htlcModifier {
+
+	return &htlcModifier{
+		stream:      stream,
+		chainParams: chainParams,
+		pending:     make(pendingHtlcs),
+		quit:        quit,
+	}
+}
+
+// run registers the htlc modifier and processes the responses of the client
+// until the stream is closed.
+func (h *htlcModifier) run(modifier invoices.HtlcModifier) error {
+	unregister, err := modifier.RegisterModifier(h.onHtlc)
+	if err != nil {
+		return err
+	}
+	defer unregister()
+
+	for {
+		resp, err := h.stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if err := h.resolveFromClient(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// onHtlc is called by the invoice registry for every htlc that pays one of our
+// invoices. It sends the htlc to the client and waits for its response.
+func (h *htlcModifier) onHtlc(
+	req invoices.HtlcModifyRequest) (*invoices.HtlcModifyResponse, error) {
+
+	rpcInvoice, err := CreateRPCInvoice(&req.Invoice, h.chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	key := req.ExitHtlcCircuitKey
+	respChan := make(chan *invoices.HtlcModifyResponse, 1)
+
+	h.mu.Lock()
+	if _, ok := h.pending[key]; ok {
+		h.mu.Unlock()
+
+		return nil, errHtlcAlreadyPending
+	}
+	h.pending[key] = respChan
+	h.mu.Unlock()
+
+	defer func() {
+		h.mu.Lock()
+		delete(h.pending, key)
+		h.mu.Unlock()
+	}()
+
+	log.Tracef("Sending htlc %v to htlc modifier", key)
+
+	h.sendMu.Lock()
+	err = h.stream.Send(&HtlcModifyRequest{
+		Invoice: rpcInvoice,
+		ExitHtlcCircuitKey: &CircuitKey{
+			ChanId: key.ChanID.ToUint64(),
+			HtlcId: key.HtlcID,
+		},
+		ExitHtlcAmt:           uint64(req.ExitHtlcAmt),
+		ExitHtlcExpiry:        req.ExitHtlcExpiry,
+		CurrentHeight:         req.CurrentHeight,
+		ExitHtlcCustomRecords: req.CustomRecords,
+	})
+	h.sendMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp, nil
+
+	case <-time.After(htlcModifierTimeout):
+		return nil, errHtlcModifierTimeout
+
+	case <-h.stream.Context().Done():
+		return nil, errHtlcModifierGone
+
+	case <-h.quit:
+		return nil, errHtlcModifierGone
+	}
+}
+
+// resolveFromClient delivers a response of the client to the htlc that it is
+// for. Responses to htlcs that are no longer pending are ignored.
+func (h *htlcModifier) resolveFromClient(in *HtlcModifyResponse) error {
+	if in.CircuitKey == nil {
+		return status.Errorf(codes.InvalidArgument,
+			"CircuitKey missing from HtlcModifyResponse")
+	}
+
+	key := invoices.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(in.CircuitKey.ChanId),
+		HtlcID: in.CircuitKey.HtlcId,
+	}
+
+	resp := &invoices.HtlcModifyResponse{
+		Reject: in.Reject,
+	}
+
+	switch {
+	case in.Reject:
+		failure, err := failureFromCode(in.FailureCode)
+		if err != nil {
+			return err
+		}
+		resp.FailureMessage = failure
+
+	case in.AmtPaid != 0:
+		resp.AmountPaid = fn.Some(lnwire.MilliSatoshi(in.AmtPaid))
+	}
+
+	h.mu.Lock()
+	respChan, ok := h.pending[key]
+	h.mu.Unlock()
+
+	if !ok {
+		log.Warnf("Ignoring htlc modifier response for htlc %v that "+
+			"isn't pending", key)
+
+		return nil
+	}
+
+	log.Tracef("Htlc modifier resolved htlc %v: %v", key, in)
+
+	// The channel holds a single response, so that further responses to
+	// the same htlc are dropped.
+	select {
+	case respChan <- resp:
+	default:
+		log.Warnf("Ignoring duplicate htlc modifier response for "+
+			"htlc %v", key)
+	}
+
+	return nil
+}
+
+// failureFromCode returns the failure that a rejected htlc is failed back with
+// for the given failure code. A nil failure leaves the failure to the invoice
+// registry, which fails the htlc with incorrect payment details.
+func failureFromCode(
+	code lnrpc.Failure_FailureCode) (lnwire.FailureMessage, error) {
+
+	switch code {
+	case 0, lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS:
+		return nil, nil
+
+	case lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE:
+		return lnwire.NewTemporaryChannelFailure(nil), nil
+
+	case lnrpc.Failure_TEMPORARY_NODE_FAILURE:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnrpc.Failure_PERMANENT_NODE_FAILURE:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnrpc.Failure_MPP_TIMEOUT:
+		return &lnwire.FailMPPTimeout{}, nil
+
+	default:
+		return nil, status.Errorf(
+			codes.InvalidArgument, "unsupported failure code: %v",
+			code,
+		)
+	}
+}
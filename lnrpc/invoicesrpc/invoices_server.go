@@ -65,6 +65,10 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/HtlcModifier": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -446,3 +450,12 @@ func (s *Server) LookupInvoiceV2(ctx context.Context,
 
 	return CreateRPCInvoice(&invoice, s.cfg.ChainParams)
 }
+
+// HtlcModifier is a bidirectional streaming RPC that allows a client to inspect
+// every htlc that pays one of our invoices, and to accept it with a possibly
+// modified amount or to reject it. Only one client can be connected at a time.
+func (s *Server) HtlcModifier(stream Invoices_HtlcModifierServer) error {
+	modifier := newHtlcModifier(stream, s.cfg.ChainParams, s.quit)
+
+	return modifier.run(s.cfg.HtlcModifier)
+}
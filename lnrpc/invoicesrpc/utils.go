@@ -3,12 +3,15 @@ package invoicesrpc
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -347,3 +350,74 @@ func CreateZpay32HopHints(routeHints []*lnrpc.RouteHint) ([][]zpay32.HopHint, er
 	}
 	return res, nil
 }
+
+// UnmarshalBlindedPathConfig converts an lnrpc.BlindedPathConfig into the
+// overrides of the blinded path restrictions of an invoice.
+func UnmarshalBlindedPathConfig(rpcCfg *lnrpc.BlindedPathConfig) (
+	*BlindedPathConfig, error) {
+
+	// unmarshalCount maps a count of zero to the configured default.
+	unmarshalCount := func(name string, n uint32) (fn.Option[uint8],
+		error) {
+
+		if n == 0 {
+			return fn.None[uint8](), nil
+		}
+		if n > math.MaxUint8 {
+			return fn.None[uint8](), fmt.Errorf("%s of %d exceeds "+
+				"maximum of %d", name, n, math.MaxUint8)
+		}
+
+		return fn.Some(uint8(n)), nil
+	}
+
+	// unmarshalNodes parses a list of node IDs into a set.
+	unmarshalNodes := func(nodes [][]byte) (fn.Set[route.Vertex], error) {
+		set := fn.NewSet[route.Vertex]()
+		for _, node := range nodes {
+			vertex, err := route.NewVertexFromBytes(node)
+			if err != nil {
+				return nil, err
+			}
+			set.Add(vertex)
+		}
+
+		return set, nil
+	}
+
+	var (
+		cfg BlindedPathConfig
+		err error
+	)
+
+	cfg.MinNumRealHops, err = unmarshalCount(
+		"min_num_real_hops", rpcCfg.MinNumRealHops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.NumHops, err = unmarshalCount("num_hops", rpcCfg.NumHops)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.MaxNumPaths, err = unmarshalCount(
+		"max_num_paths", rpcCfg.MaxNumPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.NodeOmissionSet, err = unmarshalNodes(rpcCfg.NodeOmissionList)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node omission list: %w", err)
+	}
+
+	cfg.IntroNodeSet, err = unmarshalNodes(rpcCfg.IntroNodeList)
+	if err != nil {
+		return nil, fmt.Errorf("invalid intro node list: %w", err)
+	}
+
+	return &cfg, nil
+}
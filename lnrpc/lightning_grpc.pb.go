@@ -317,6 +317,14 @@ type LightningClient interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// lncli: `updatechanparams`
+	// UpdateChannelParams updates the parameters of an active channel without
+	// closing it, by running a dynamic commitment negotiation with the remote
+	// peer. The call returns once the peer has accepted or rejected the new
+	// parameters. Both peers need to signal support for dynamic commitments, and
+	// the channel must not have any HTLCs or pending updates. Private anchor
+	// channels can also be upgraded to simple taproot channels. [EXPERIMENTAL]
+	UpdateChannelParams(ctx context.Context, in *UpdateChannelParamsRequest, opts ...grpc.CallOption) (*UpdateChannelParamsResponse, error)
 	// lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLCs forwarded within the target time range, and integer offset
@@ -363,6 +371,11 @@ type LightningClient interface {
 	// ups, but the updated set of encrypted multi-chan backups with the closed
 	// channel(s) removed.
 	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	// lncli: `backupsinkstatus`
+	// BackupSinkStatus returns the status of the additional destinations the
+	// static channel backup is copied to, such as other files, directories, S3
+	// compatible endpoints or backup commands.
+	BackupSinkStatus(ctx context.Context, in *BackupSinkStatusRequest, opts ...grpc.CallOption) (*BackupSinkStatusResponse, error)
 	// lncli: `bakemacaroon`
 	// BakeMacaroon allows the creation of a new macaroon with custom read and
 	// write permissions. No first-party caveats are added since this can be done
@@ -407,6 +420,25 @@ type LightningClient interface {
 	// needs to be compiled with  the `dev` build tag, and the message type to
 	// override should be specified in lnd's experimental protocol configuration.
 	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
+	// lncli: `sendonionmessage`
+	// SendOnionMessage sends an onion message to a node or along a blinded path.
+	// Onion messages are relayed through the network along a blinded path, so
+	// the destination doesn't need to be a peer. Onion messages are unreliable,
+	// a successful call only means that the message was sent to the first hop.
+	// This requires onion messages to be enabled with `protocol.onion-messages`.
+	SendOnionMessage(ctx context.Context, in *SendOnionMessageRequest, opts ...grpc.CallOption) (*SendOnionMessageResponse, error)
+	// lncli: `subscribeonionmessages`
+	// SubscribeOnionMessages subscribes to a stream of onion messages that are
+	// addressed to this node.
+	SubscribeOnionMessages(ctx context.Context, in *SubscribeOnionMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeOnionMessagesClient, error)
+	// lncli: `addoffer`
+	// AddOffer creates a new BOLT 12 offer. An offer is a static payment code
+	// that can be paid multiple times. Payers request an invoice for the offer
+	// from this node with an onion message, and the invoice is added to the
+	// invoice registry when the request is received. Offers aren't stored, they
+	// can be paid until they expire. This requires onion messages to be enabled
+	// with `protocol.onion-messages`.
+	AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*AddOfferResponse, error)
 	// lncli: `listaliases`
 	// ListAliases returns the set of all aliases that have ever existed with
 	// their confirmed SCID (if it exists) and/or the base SCID (in the case of
@@ -1123,6 +1155,15 @@ func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpd
 	return out, nil
 }
 
+func (c *lightningClient) UpdateChannelParams(ctx context.Context, in *UpdateChannelParamsRequest, opts ...grpc.CallOption) (*UpdateChannelParamsResponse, error) {
+	out := new(UpdateChannelParamsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateChannelParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
 	out := new(ForwardingHistoryResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
@@ -1200,6 +1241,15 @@ func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, er
 	return m, nil
 }
 
+func (c *lightningClient) BackupSinkStatus(ctx context.Context, in *BackupSinkStatusRequest, opts ...grpc.CallOption) (*BackupSinkStatusResponse, error) {
+	out := new(BackupSinkStatusResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BackupSinkStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
 	out := new(BakeMacaroonResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, opts...)
@@ -1317,6 +1367,56 @@ func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error)
 	return m, nil
 }
 
+func (c *lightningClient) SendOnionMessage(ctx context.Context, in *SendOnionMessageRequest, opts ...grpc.CallOption) (*SendOnionMessageResponse, error) {
+	out := new(SendOnionMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendOnionMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeOnionMessages(ctx context.Context, in *SubscribeOnionMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeOnionMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[13], "/lnrpc.Lightning/SubscribeOnionMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeOnionMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeOnionMessagesClient interface {
+	Recv() (*OnionMessage, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeOnionMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeOnionMessagesClient) Recv() (*OnionMessage, error) {
+	m := new(OnionMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*AddOfferResponse, error) {
+	out := new(AddOfferResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error) {
 	out := new(ListAliasesResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListAliases", in, out, opts...)
@@ -1638,6 +1738,14 @@ type LightningServer interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// lncli: `updatechanparams`
+	// UpdateChannelParams updates the parameters of an active channel without
+	// closing it, by running a dynamic commitment negotiation with the remote
+	// peer. The call returns once the peer has accepted or rejected the new
+	// parameters. Both peers need to signal support for dynamic commitments, and
+	// the channel must not have any HTLCs or pending updates. Private anchor
+	// channels can also be upgraded to simple taproot channels. [EXPERIMENTAL]
+	UpdateChannelParams(context.Context, *UpdateChannelParamsRequest) (*UpdateChannelParamsResponse, error)
 	// lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLCs forwarded within the target time range, and integer offset
@@ -1684,6 +1792,11 @@ type LightningServer interface {
 	// ups, but the updated set of encrypted multi-chan backups with the closed
 	// channel(s) removed.
 	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	// lncli: `backupsinkstatus`
+	// BackupSinkStatus returns the status of the additional destinations the
+	// static channel backup is copied to, such as other files, directories, S3
+	// compatible endpoints or backup commands.
+	BackupSinkStatus(context.Context, *BackupSinkStatusRequest) (*BackupSinkStatusResponse, error)
 	// lncli: `bakemacaroon`
 	// BakeMacaroon allows the creation of a new macaroon with custom read and
 	// write permissions. No first-party caveats are added since this can be done
@@ -1728,6 +1841,25 @@ type LightningServer interface {
 	// needs to be compiled with  the `dev` build tag, and the message type to
 	// override should be specified in lnd's experimental protocol configuration.
 	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
+	// lncli: `sendonionmessage`
+	// SendOnionMessage sends an onion message to a node or along a blinded path.
+	// Onion messages are relayed through the network along a blinded path, so
+	// the destination doesn't need to be a peer. Onion messages are unreliable,
+	// a successful call only means that the message was sent to the first hop.
+	// This requires onion messages to be enabled with `protocol.onion-messages`.
+	SendOnionMessage(context.Context, *SendOnionMessageRequest) (*SendOnionMessageResponse, error)
+	// lncli: `subscribeonionmessages`
+	// SubscribeOnionMessages subscribes to a stream of onion messages that are
+	// addressed to this node.
+	SubscribeOnionMessages(*SubscribeOnionMessagesRequest, Lightning_SubscribeOnionMessagesServer) error
+	// lncli: `addoffer`
+	// AddOffer creates a new BOLT 12 offer. An offer is a static payment code
+	// that can be paid multiple times. Payers request an invoice for the offer
+	// from this node with an onion message, and the invoice is added to the
+	// invoice registry when the request is received. Offers aren't stored, they
+	// can be paid until they expire. This requires onion messages to be enabled
+	// with `protocol.onion-messages`.
+	AddOffer(context.Context, *AddOfferRequest) (*AddOfferResponse, error)
 	// lncli: `listaliases`
 	// ListAliases returns the set of all aliases that have ever existed with
 	// their confirmed SCID (if it exists) and/or the base SCID (in the case of
@@ -1900,6 +2032,9 @@ func (UnimplementedLightningServer) FeeReport(context.Context, *FeeReportRequest
 func (UnimplementedLightningServer) UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelPolicy not implemented")
 }
+func (UnimplementedLightningServer) UpdateChannelParams(context.Context, *UpdateChannelParamsRequest) (*UpdateChannelParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelParams not implemented")
+}
 func (UnimplementedLightningServer) ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForwardingHistory not implemented")
 }
@@ -1918,6 +2053,9 @@ func (UnimplementedLightningServer) RestoreChannelBackups(context.Context, *Rest
 func (UnimplementedLightningServer) SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeChannelBackups not implemented")
 }
+func (UnimplementedLightningServer) BackupSinkStatus(context.Context, *BackupSinkStatusRequest) (*BackupSinkStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupSinkStatus not implemented")
+}
 func (UnimplementedLightningServer) BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BakeMacaroon not implemented")
 }
@@ -1942,6 +2080,15 @@ func (UnimplementedLightningServer) SendCustomMessage(context.Context, *SendCust
 func (UnimplementedLightningServer) SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeCustomMessages not implemented")
 }
+func (UnimplementedLightningServer) SendOnionMessage(context.Context, *SendOnionMessageRequest) (*SendOnionMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOnionMessage not implemented")
+}
+func (UnimplementedLightningServer) SubscribeOnionMessages(*SubscribeOnionMessagesRequest, Lightning_SubscribeOnionMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOnionMessages not implemented")
+}
+func (UnimplementedLightningServer) AddOffer(context.Context, *AddOfferRequest) (*AddOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOffer not implemented")
+}
 func (UnimplementedLightningServer) ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAliases not implemented")
 }
@@ -2942,6 +3089,24 @@ func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_UpdateChannelParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateChannelParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateChannelParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateChannelParams(ctx, req.(*UpdateChannelParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -3053,6 +3218,24 @@ func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_BackupSinkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupSinkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BackupSinkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BackupSinkStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BackupSinkStatus(ctx, req.(*BackupSinkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BakeMacaroonRequest)
 	if err := dec(in); err != nil {
@@ -3208,6 +3391,63 @@ func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_SendOnionMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendOnionMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendOnionMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendOnionMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendOnionMessage(ctx, req.(*SendOnionMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeOnionMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOnionMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeOnionMessages(m, &lightningSubscribeOnionMessagesServer{stream})
+}
+
+type Lightning_SubscribeOnionMessagesServer interface {
+	Send(*OnionMessage) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeOnionMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeOnionMessagesServer) Send(m *OnionMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_AddOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AddOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AddOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AddOffer(ctx, req.(*AddOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAliasesRequest)
 	if err := dec(in); err != nil {
@@ -3419,6 +3659,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
 		},
+		{
+			MethodName: "UpdateChannelParams",
+			Handler:    _Lightning_UpdateChannelParams_Handler,
+		},
 		{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
@@ -3439,6 +3683,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreChannelBackups",
 			Handler:    _Lightning_RestoreChannelBackups_Handler,
 		},
+		{
+			MethodName: "BackupSinkStatus",
+			Handler:    _Lightning_BackupSinkStatus_Handler,
+		},
 		{
 			MethodName: "BakeMacaroon",
 			Handler:    _Lightning_BakeMacaroon_Handler,
@@ -3463,6 +3711,14 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendCustomMessage",
 			Handler:    _Lightning_SendCustomMessage_Handler,
 		},
+		{
+			MethodName: "SendOnionMessage",
+			Handler:    _Lightning_SendOnionMessage_Handler,
+		},
+		{
+			MethodName: "AddOffer",
+			Handler:    _Lightning_AddOffer_Handler,
+		},
 		{
 			MethodName: "ListAliases",
 			Handler:    _Lightning_ListAliases_Handler,
@@ -3542,6 +3798,11 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeCustomMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeOnionMessages",
+			Handler:       _Lightning_SubscribeOnionMessages_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lightning.proto",
 }
@@ -0,0 +1,293 @@
+package routerrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// rebalanceMemo is the memo of the invoices that rebalances pay.
+const rebalanceMemo = "rebalance"
+
+// rebalanceChannel is the state of one of our channels that is relevant for a
+// rebalance.
+type rebalanceChannel struct {
+	chanID uint64
+	peer   route.Vertex
+	local  btcutil.Amount
+	remote btcutil.Amount
+}
+
+// localRatio returns the share of the channel balance that is on our side.
+func (c *rebalanceChannel) localRatio() float64 {
+	total := c.local + c.remote
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(c.local) / float64(total)
+}
+
+// surplus returns the local balance of the channel above the target ratio,
+// which is negative if the local balance is below the target ratio.
+func (c *rebalanceChannel) surplus(targetRatio float64) btcutil.Amount {
+	target := targetRatio * float64(c.local+c.remote)
+
+	return c.local - btcutil.Amount(math.Round(target))
+}
+
+// rebalancePlan describes the channels and the amount of a rebalance.
+type rebalancePlan struct {
+	outgoing []uint64
+	incoming *rebalanceChannel
+	amt      btcutil.Amount
+}
+
+// planRebalance selects the channels and the amount of a rebalance. The
+// channels and the amount that the request doesn't set are derived from its
+// target local ratio.
+func planRebalance(req *RebalanceRequest,
+	channels []*rebalanceChannel) (*rebalancePlan, error) {
+
+	targetRatio := req.TargetLocalRatio
+	if targetRatio < 0 || targetRatio >= 1 {
+		return nil, errors.New("target local ratio must be between 0 " +
+			"and 1")
+	}
+
+	if targetRatio == 0 && (len(req.OutgoingChanIds) == 0 ||
+		req.IncomingChanId == 0 || req.AmtSat == 0) {
+
+		return nil, errors.New("target local ratio must be set if " +
+			"the outgoing channels, the incoming channel or the " +
+			"amount aren't set")
+	}
+
+	if req.AmtSat < 0 {
+		return nil, errors.New("amount cannot be negative")
+	}
+
+	byID := make(map[uint64]*rebalanceChannel, len(channels))
+	numPeerChans := make(map[route.Vertex]int)
+	for _, channel := range channels {
+		byID[channel.chanID] = channel
+		numPeerChans[channel.peer]++
+	}
+
+	plan := &rebalancePlan{}
+
+	// Select the incoming channel, which is the channel with the lowest
+	// local ratio below the target if none is given. Only the peer of the
+	// incoming channel can be enforced as the last hop, as it may forward
+	// the payment over any of its channels with us. So the incoming
+	// channel must be the only channel with its peer.
+	if req.IncomingChanId != 0 {
+		incoming, ok := byID[req.IncomingChanId]
+		if !ok {
+			return nil, fmt.Errorf("incoming channel %v not found",
+				req.IncomingChanId)
+		}
+
+		if numPeerChans[incoming.peer] > 1 {
+			return nil, fmt.Errorf("incoming channel %v isn't the "+
+				"only channel with its peer %v",
+				req.IncomingChanId, incoming.peer)
+		}
+		plan.incoming = incoming
+	} else {
+		for _, channel := range channels {
+			ratio := channel.localRatio()
+			if ratio >= targetRatio ||
+				numPeerChans[channel.peer] > 1 {
+
+				continue
+			}
+
+			if plan.incoming == nil ||
+				ratio < plan.incoming.localRatio() {
+
+				plan.incoming = channel
+			}
+		}
+
+		if plan.incoming == nil {
+			return nil, errors.New("no channel below the target " +
+				"local ratio")
+		}
+	}
+
+	// Select the outgoing channels, which are all other channels above
+	// the target if none are given.
+	var available btcutil.Amount
+	if len(req.OutgoingChanIds) > 0 {
+		for _, chanID := range req.OutgoingChanIds {
+			if chanID == plan.incoming.chanID {
+				return nil, fmt.Errorf("channel %v cannot be "+
+					"both outgoing and incoming", chanID)
+			}
+
+			channel, ok := byID[chanID]
+			if !ok {
+				return nil, fmt.Errorf("outgoing channel %v "+
+					"not found", chanID)
+			}
+
+			surplus := channel.surplus(targetRatio)
+			available += max(surplus, 0)
+		}
+		plan.outgoing = req.OutgoingChanIds
+	} else {
+		for _, channel := range channels {
+			if channel.chanID == plan.incoming.chanID ||
+				channel.localRatio() <= targetRatio {
+
+				continue
+			}
+
+			plan.outgoing = append(plan.outgoing, channel.chanID)
+			available += channel.surplus(targetRatio)
+		}
+
+		if len(plan.outgoing) == 0 {
+			return nil, errors.New("no channel above the target " +
+				"local ratio")
+		}
+
+		sort.Slice(plan.outgoing, func(i, j int) bool {
+			return plan.outgoing[i] < plan.outgoing[j]
+		})
+	}
+
+	// Derive the amount that raises the incoming channel to the target,
+	// limited to what the outgoing channels hold above the target.
+	plan.amt = btcutil.Amount(req.AmtSat)
+	if plan.amt == 0 {
+		plan.amt = min(-plan.incoming.surplus(targetRatio), available)
+		if plan.amt <= 0 {
+			return nil, errors.New("no liquidity to move towards " +
+				"the target local ratio")
+		}
+	}
+
+	return plan, nil
+}
+
+// fetchRebalanceChannels returns the state of our open channels.
+func (r *RouterBackend) fetchRebalanceChannels() ([]*rebalanceChannel,
+	error) {
+
+	openChannels, err := r.FetchOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]*rebalanceChannel, 0, len(openChannels))
+	for _, channel := range openChannels {
+		if channel.IsPending {
+			continue
+		}
+
+		commitment := channel.LocalCommitment
+		channels = append(channels, &rebalanceChannel{
+			chanID: channel.ShortChanID().ToUint64(),
+			peer:   route.NewVertex(channel.IdentityPub),
+			local:  commitment.LocalBalance.ToSatoshis(),
+			remote: commitment.RemoteBalance.ToSatoshis(),
+		})
+	}
+
+	return channels, nil
+}
+
+// extractIntentFromRebalanceRequest plans a rebalance and creates the invoice
+// that it pays. The returned payment is a circular payment to ourselves that
+// leaves through the outgoing channels and comes back through the peer of the
+// incoming channel.
+func (r *RouterBackend) extractIntentFromRebalanceRequest(ctx context.Context,
+	req *RebalanceRequest) (*routing.LightningPayment, error) {
+
+	if r.FetchOpenChannels == nil || r.AddInvoice == nil {
+		return nil, errors.New("rebalancing is not supported")
+	}
+
+	if req.TimeoutSeconds == 0 {
+		return nil, errors.New("timeout_seconds must be specified")
+	}
+
+	// A circular route is hardly ever free, so without a fee rate the
+	// rebalance would fail only after the invoice was added.
+	if req.MaxFeeRatePpm == 0 {
+		return nil, errors.New("max_fee_rate_ppm must be specified")
+	}
+
+	channels, err := r.fetchRebalanceChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planRebalance(req, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(plan.amt)
+	if req.MaxFeeRatePpm > math.MaxUint64/uint64(amt) {
+		return nil, fmt.Errorf("max_fee_rate_ppm %v too large for "+
+			"amount %v", req.MaxFeeRatePpm, amt)
+	}
+	feeLimit := lnwire.MilliSatoshi(
+		uint64(amt) * req.MaxFeeRatePpm / 1_000_000,
+	)
+
+	hash, invoice, err := r.AddInvoice(ctx, amt, rebalanceMemo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add rebalance invoice: %w",
+			err)
+	}
+
+	// The incoming channel is the only channel with its peer, so
+	// enforcing the peer as the last hop enforces the channel.
+	lastHop := plan.incoming.peer
+	paymentAddr := invoice.Terms.PaymentAddr
+
+	payIntent := &routing.LightningPayment{
+		Target:             r.SelfNode,
+		Amount:             amt,
+		FinalCLTVDelta:     uint16(invoice.Terms.FinalCltvDelta),
+		FeeLimit:           feeLimit,
+		CltvLimit:          r.MaxTotalTimelock,
+		OutgoingChannelIDs: plan.outgoing,
+		LastHop:            &lastHop,
+		DestFeatures:       invoice.Terms.Features.Clone(),
+		PaymentAddr:        &paymentAddr,
+		PaymentRequest:     invoice.PaymentRequest,
+		PaymentType:        channeldb.PaymentTypeRebalance,
+		PayAttemptTimeout: time.Second *
+			time.Duration(req.TimeoutSeconds),
+	}
+
+	if err := payIntent.SetPaymentHash(*hash); err != nil {
+		return nil, err
+	}
+
+	maxParts := req.MaxParts
+	if maxParts == 0 {
+		maxParts = DefaultMaxParts
+	}
+	payIntent.MaxParts = maxParts
+
+	log.Infof("Rebalancing %v from channels %v to channel %v of peer "+
+		"%v with fee limit %v", plan.amt, plan.outgoing,
+		plan.incoming.chanID, plan.incoming.peer, feeLimit)
+
+	return payIntent, nil
+}
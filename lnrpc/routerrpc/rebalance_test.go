@@ -0,0 +1,333 @@
+package routerrpc
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlanRebalance tests the selection of the channels and the amount of a
+// rebalance.
+func TestPlanRebalance(t *testing.T) {
+	t.Parallel()
+
+	// Channel 1 and 2 are above a target ratio of 0.5, channel 3 and 4
+	// below it, and channel 6 is at the target. Channel 3 and 6 are with
+	// the same peer, so channel 3 can't be the incoming channel.
+	node3 := route.Vertex{12}
+	channels := []*rebalanceChannel{
+		{chanID: 1, peer: node1, local: 800_000, remote: 200_000},
+		{chanID: 2, peer: node1, local: 600_000, remote: 400_000},
+		{chanID: 3, peer: node2, local: 100_000, remote: 900_000},
+		{chanID: 4, peer: node3, local: 300_000, remote: 700_000},
+		{chanID: 6, peer: node2, local: 500_000, remote: 500_000},
+	}
+
+	tests := []struct {
+		name     string
+		req      *RebalanceRequest
+		outgoing []uint64
+		incoming uint64
+		amt      btcutil.Amount
+		err      string
+	}{
+		{
+			name: "explicit",
+			req: &RebalanceRequest{
+				OutgoingChanIds: []uint64{2},
+				IncomingChanId:  4,
+				AmtSat:          50_000,
+			},
+			outgoing: []uint64{2},
+			incoming: 4,
+			amt:      50_000,
+		},
+		{
+			name: "target ratio",
+			req: &RebalanceRequest{
+				TargetLocalRatio: 0.5,
+			},
+			outgoing: []uint64{1, 2},
+			incoming: 4,
+			amt:      200_000,
+		},
+		{
+			name: "target ratio limited by outgoing channels",
+			req: &RebalanceRequest{
+				OutgoingChanIds:  []uint64{2},
+				TargetLocalRatio: 0.5,
+			},
+			outgoing: []uint64{2},
+			incoming: 4,
+			amt:      100_000,
+		},
+		{
+			name: "target ratio with incoming channel",
+			req: &RebalanceRequest{
+				IncomingChanId:   4,
+				TargetLocalRatio: 0.5,
+			},
+			outgoing: []uint64{1, 2},
+			incoming: 4,
+			amt:      200_000,
+		},
+		{
+			name: "target ratio with amount",
+			req: &RebalanceRequest{
+				AmtSat:           10_000,
+				TargetLocalRatio: 0.5,
+			},
+			outgoing: []uint64{1, 2},
+			incoming: 4,
+			amt:      10_000,
+		},
+		{
+			name: "missing target ratio",
+			req: &RebalanceRequest{
+				IncomingChanId: 4,
+				AmtSat:         50_000,
+			},
+			err: "target local ratio must be set",
+		},
+		{
+			name: "invalid target ratio",
+			req: &RebalanceRequest{
+				TargetLocalRatio: 1,
+			},
+			err: "target local ratio must be between 0 and 1",
+		},
+		{
+			name: "negative amount",
+			req: &RebalanceRequest{
+				AmtSat:           -1,
+				TargetLocalRatio: 0.5,
+			},
+			err: "amount cannot be negative",
+		},
+		{
+			name: "incoming channel is outgoing",
+			req: &RebalanceRequest{
+				OutgoingChanIds: []uint64{2, 4},
+				IncomingChanId:  4,
+				AmtSat:          50_000,
+			},
+			err: "cannot be both outgoing and incoming",
+		},
+		{
+			name: "incoming channel shares its peer",
+			req: &RebalanceRequest{
+				IncomingChanId:   3,
+				TargetLocalRatio: 0.5,
+			},
+			err: "isn't the only channel with its peer",
+		},
+		{
+			name: "unknown incoming channel",
+			req: &RebalanceRequest{
+				IncomingChanId:   5,
+				TargetLocalRatio: 0.5,
+			},
+			err: "incoming channel 5 not found",
+		},
+		{
+			name: "unknown outgoing channel",
+			req: &RebalanceRequest{
+				OutgoingChanIds:  []uint64{5},
+				TargetLocalRatio: 0.5,
+			},
+			err: "outgoing channel 5 not found",
+		},
+		{
+			name: "no channel below target",
+			req: &RebalanceRequest{
+				TargetLocalRatio: 0.05,
+			},
+			err: "no channel below the target local ratio",
+		},
+		{
+			name: "no channel above target",
+			req: &RebalanceRequest{
+				TargetLocalRatio: 0.9,
+			},
+			err: "no channel above the target local ratio",
+		},
+		{
+			name: "no liquidity above target",
+			req: &RebalanceRequest{
+				OutgoingChanIds:  []uint64{3},
+				TargetLocalRatio: 0.5,
+			},
+			err: "no liquidity to move",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan, err := planRebalance(test.req, channels)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, test.outgoing, plan.outgoing)
+			require.Equal(t, test.incoming, plan.incoming.chanID)
+			require.Equal(t, test.amt, plan.amt)
+		})
+	}
+}
+
+// newRebalanceOpenChannel returns an open channel with the given peer and
+// balances.
+func newRebalanceOpenChannel(chanID uint64, peer *btcec.PublicKey,
+	local, remote btcutil.Amount) *channeldb.OpenChannel {
+
+	return &channeldb.OpenChannel{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(chanID),
+		IdentityPub:    peer,
+		LocalCommitment: channeldb.ChannelCommitment{
+			LocalBalance:  lnwire.NewMSatFromSatoshis(local),
+			RemoteBalance: lnwire.NewMSatFromSatoshis(remote),
+		},
+	}
+}
+
+// TestExtractIntentFromRebalanceRequest tests that a rebalance is turned into
+// a circular payment to ourselves that pays the rebalance invoice.
+func TestExtractIntentFromRebalanceRequest(t *testing.T) {
+	t.Parallel()
+
+	outgoingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	incomingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	// The pending channel is skipped, so it can't be the incoming
+	// channel.
+	pending := newRebalanceOpenChannel(
+		4, incomingKey.PubKey(), 0, 1_000_000,
+	)
+	pending.IsPending = true
+
+	openChannels := []*channeldb.OpenChannel{
+		newRebalanceOpenChannel(
+			1, outgoingKey.PubKey(), 800_000, 200_000,
+		),
+		newRebalanceOpenChannel(
+			3, incomingKey.PubKey(), 100_000, 900_000,
+		),
+		pending,
+	}
+
+	hash := lntypes.Hash{1, 2, 3}
+	paymentAddr := [32]byte{4, 5, 6}
+	features := lnwire.NewFeatureVector(nil, lnwire.Features)
+
+	var invoiceAmt lnwire.MilliSatoshi
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+	addInvoice := func(_ context.Context, amt lnwire.MilliSatoshi,
+		memo string) (*lntypes.Hash, *invoices.Invoice, error) {
+
+		require.Equal(t, rebalanceMemo, memo)
+		invoiceAmt = amt
+
+		return &hash, &invoices.Invoice{
+			PaymentRequest: []byte("lnbc1"),
+			Terms: invoices.ContractTerm{
+				FinalCltvDelta: 80,
+				PaymentAddr:    paymentAddr,
+				Features:       features,
+			},
+		}, nil
+	}
+
+	req := &RebalanceRequest{
+		TargetLocalRatio: 0.3,
+		MaxFeeRatePpm:    500,
+		TimeoutSeconds:   60,
+	}
+
+	// Without the channels and invoices, rebalancing is not supported.
+	_, err = backend.extractIntentFromRebalanceRequest(
+		context.Background(), req,
+	)
+	require.ErrorContains(t, err, "rebalancing is not supported")
+
+	backend.AddInvoice = addInvoice
+	backend.FetchOpenChannels = func() ([]*channeldb.OpenChannel, error) {
+		return openChannels, nil
+	}
+
+	// A timeout is required.
+	_, err = backend.extractIntentFromRebalanceRequest(
+		context.Background(), &RebalanceRequest{},
+	)
+	require.ErrorContains(t, err, "timeout_seconds must be specified")
+
+	// A fee rate is required, as circular routes are hardly ever free.
+	_, err = backend.extractIntentFromRebalanceRequest(
+		context.Background(), &RebalanceRequest{
+			TargetLocalRatio: 0.3,
+			TimeoutSeconds:   60,
+		},
+	)
+	require.ErrorContains(t, err, "max_fee_rate_ppm must be specified")
+
+	// A fee rate that overflows the fee limit is rejected.
+	_, err = backend.extractIntentFromRebalanceRequest(
+		context.Background(), &RebalanceRequest{
+			TargetLocalRatio: 0.3,
+			MaxFeeRatePpm:    math.MaxUint64 / 1_000,
+			TimeoutSeconds:   60,
+		},
+	)
+	require.ErrorContains(t, err, "too large for amount")
+
+	// The pending channel can't be used.
+	_, err = backend.extractIntentFromRebalanceRequest(
+		context.Background(), &RebalanceRequest{
+			IncomingChanId:   4,
+			TargetLocalRatio: 0.3,
+			MaxFeeRatePpm:    500,
+			TimeoutSeconds:   60,
+		},
+	)
+	require.ErrorContains(t, err, "incoming channel 4 not found")
+
+	// The rebalance raises channel 3 to the target ratio with the
+	// liquidity of channel 1, and returns through the peer of channel 3.
+	payment, err := backend.extractIntentFromRebalanceRequest(
+		context.Background(), req,
+	)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 200_000_000, invoiceAmt)
+	require.Equal(t, sourceKey, payment.Target)
+	require.EqualValues(t, 200_000_000, payment.Amount)
+	require.EqualValues(t, 100_000, payment.FeeLimit)
+	require.EqualValues(t, 80, payment.FinalCLTVDelta)
+	require.EqualValues(t, 1000, payment.CltvLimit)
+	require.Equal(t, []uint64{1}, payment.OutgoingChannelIDs)
+	require.Equal(
+		t, route.NewVertex(incomingKey.PubKey()), *payment.LastHop,
+	)
+	require.Equal(t, paymentAddr, *payment.PaymentAddr)
+	require.Equal(t, []byte("lnbc1"), payment.PaymentRequest)
+	require.EqualValues(t, hash, payment.Identifier())
+	require.EqualValues(t, DefaultMaxParts, payment.MaxParts)
+	require.Equal(t, time.Minute, payment.PayAttemptTimeout)
+	require.Equal(t, channeldb.PaymentTypeRebalance, payment.PaymentType)
+}
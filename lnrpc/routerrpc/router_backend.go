@@ -16,15 +16,20 @@ import (
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/offers"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/zpay32"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -60,8 +65,20 @@ type RouterBackend struct {
 	// routes.
 	FindRoute func(*routing.RouteRequest) (*route.Route, float64, error)
 
+	// MissionControl is the mission control of the default namespace.
 	MissionControl MissionControl
 
+	// FetchMissionControl returns the mission control of the given
+	// namespace. If create is set, the namespace is created if it doesn't
+	// exist yet, otherwise routing.ErrMcNamespaceNotFound is returned. If
+	// nil, only the default namespace is available.
+	FetchMissionControl func(namespace string,
+		create bool) (MissionControl, error)
+
+	// DeleteMissionControl deletes the given mission control namespace
+	// together with its history and config.
+	DeleteMissionControl func(namespace string) error
+
 	// ActiveNetParams are the network parameters of the primary network
 	// that the route is operating on. This is necessary so we can ensure
 	// that we receive payment requests that send to destinations on our
@@ -104,14 +121,51 @@ type RouterBackend struct {
 	// TODO(yy): remove this config after the new status code is fully
 	// deployed to the network(v0.20.0).
 	UseStatusInitiated bool
+
+	// FetchOfferInvoice requests an invoice for a BOLT 12 offer from its
+	// issuer. It is nil if onion messages, and thereby offers, aren't
+	// enabled.
+	FetchOfferInvoice func(ctx context.Context, offer *offers.Offer,
+		amt lnwire.MilliSatoshi, quantity uint64,
+		payerNote string) (*offers.Invoice, error)
+
+	// BestHeight returns the current best block height. It is used to
+	// compute the absolute expiry of trampoline payments.
+	BestHeight func() (uint32, error)
+
+	// FeeAutopilot is the fee autopilot that manages the routing fees of
+	// our channels. It is nil if the fee autopilot isn't active.
+	FeeAutopilot FeeAutopilot
+
+	// AddInvoice adds an invoice over the given amount that is paid to
+	// ourselves. It is used to rebalance our channels.
+	AddInvoice func(ctx context.Context, amt lnwire.MilliSatoshi,
+		memo string) (*lntypes.Hash, *invoices.Invoice, error)
+
+	// FetchOpenChannels returns all of our open channels.
+	FetchOpenChannels func() ([]*channeldb.OpenChannel, error)
+}
+
+// FeeAutopilot defines the fee autopilot dependencies of routerrpc.
+type FeeAutopilot interface {
+	// Plan evaluates the fee policies of all channels without applying
+	// them.
+	Plan() ([]*feeautopilot.ChannelPlan, error)
+
+	// DryRun returns true if the planned policies are only logged, but
+	// not applied.
+	DryRun() bool
+
+	// StrategyNames returns the names of the strategies, in the order
+	// they are applied.
+	StrategyNames() []string
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
 type MissionControl interface {
-	// GetProbability is expected to return the success probability of a
-	// payment from fromNode to toNode.
-	GetProbability(fromNode, toNode route.Vertex,
-		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64
+	// MissionController allows payments to take their probability
+	// estimates from and report their results to the mission control.
+	routing.MissionController
 
 	// ResetHistory resets the history of MissionControl returning it to a
 	// state as if no payment attempts have been made.
@@ -139,6 +193,53 @@ type MissionControl interface {
 	SetConfig(cfg *routing.MissionControlConfig) error
 }
 
+// fetchMissionControl returns the mission control of the given namespace. The
+// empty namespace refers to the default namespace. Only calls that write to
+// mission control set create, so that reading doesn't create namespaces.
+func (r *RouterBackend) fetchMissionControl(namespace string,
+	create bool) (MissionControl, error) {
+
+	if namespace == "" ||
+		namespace == routing.DefaultMissionControlNamespace {
+
+		return r.MissionControl, nil
+	}
+
+	if r.FetchMissionControl == nil {
+		return nil, errors.New("mission control namespaces not " +
+			"supported")
+	}
+
+	mc, err := r.FetchMissionControl(namespace, create)
+	if errors.Is(err, routing.ErrMcNamespaceNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return mc, err
+}
+
+// deleteMissionControl deletes the given mission control namespace. The
+// default namespace can't be deleted.
+func (r *RouterBackend) deleteMissionControl(namespace string) error {
+	if namespace == "" ||
+		namespace == routing.DefaultMissionControlNamespace {
+
+		return routing.ErrDeleteDefaultMcNamespace
+	}
+
+	if r.DeleteMissionControl == nil {
+		return errors.New("mission control namespaces not " +
+			"supported")
+	}
+
+	err := r.DeleteMissionControl(namespace)
+	if errors.Is(err, routing.ErrMcNamespaceNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
+
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
 // route to a target destination capable of carrying a specific amount of
 // satoshis within the route's flow. The returned route contains the full
@@ -787,6 +888,20 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	}
 	payIntent.TimePref = rpcPayReq.TimePref
 
+	// Pass along whether the payment should be split optimally.
+	payIntent.OptimalSplit = rpcPayReq.OptimalSplit
+
+	// Use the mission control of the requested namespace, if any.
+	if rpcPayReq.MissionControlNamespace != "" {
+		mc, err := r.fetchMissionControl(
+			rpcPayReq.MissionControlNamespace, true,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payIntent.MissionControl = mc
+	}
+
 	// Pass along restrictions on the outgoing channels that may be used.
 	payIntent.OutgoingChannelIDs = rpcPayReq.OutgoingChanIds
 
@@ -1142,6 +1257,14 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.DestFeatures = features
 	}
 
+	// If a trampoline node is given, we only route to the trampoline node
+	// and let it find the route to the destination.
+	if len(rpcPayReq.TrampolineNode) > 0 {
+		if err := r.setTrampoline(rpcPayReq, payIntent); err != nil {
+			return nil, err
+		}
+	}
+
 	// Do bounds checking with the block padding so the router isn't
 	// left with a zombie payment in case the user messes up.
 	err = routing.ValidateCLTVLimit(
@@ -1159,11 +1282,156 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// setTrampoline routes the payment through the trampoline node given in the
+// request.
+func (r *RouterBackend) setTrampoline(rpcPayReq *SendPaymentRequest,
+	payIntent *routing.LightningPayment) error {
+
+	trampolineNode, err := route.NewVertexFromBytes(
+		rpcPayReq.TrampolineNode,
+	)
+	if err != nil {
+		return err
+	}
+
+	if rpcPayReq.TrampolineFeeMsat < 0 {
+		return errors.New("trampoline fee cannot be negative")
+	}
+	if rpcPayReq.TrampolineCltvDelta > math.MaxUint16 {
+		return errors.New("trampoline cltv delta too large")
+	}
+
+	height, err := r.BestHeight()
+	if err != nil {
+		return err
+	}
+
+	return payIntent.SetTrampoline(&routing.TrampolineOptions{
+		Node:      trampolineNode,
+		Fee:       lnwire.MilliSatoshi(rpcPayReq.TrampolineFeeMsat),
+		CltvDelta: uint16(rpcPayReq.TrampolineCltvDelta),
+	}, height)
+}
+
+// extractIntentFromOfferRequest requests an invoice for the offer of the
+// given request, and creates a payment along the blinded paths of the invoice.
+func (r *RouterBackend) extractIntentFromOfferRequest(ctx context.Context,
+	rpcPayReq *PayOfferRequest) (*routing.LightningPayment, error) {
+
+	if r.FetchOfferInvoice == nil {
+		return nil, errors.New("offers are disabled, enable them " +
+			"with --protocol.onion-messages")
+	}
+
+	// Validate the request before we send an invoice request to the
+	// issuer of the offer.
+	if rpcPayReq.TimeoutSeconds == 0 {
+		return nil, errors.New("timeout_seconds must be specified")
+	}
+	if rpcPayReq.AmtMsat < 0 {
+		return nil, errors.New("amount cannot be negative")
+	}
+	if rpcPayReq.FeeLimitMsat < 0 {
+		return nil, errors.New("fee limit cannot be negative")
+	}
+
+	cltvLimit, err := ValidateCLTVLimit(
+		uint32(rpcPayReq.CltvLimit), r.MaxTotalTimelock,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := offers.DecodeOffer(rpcPayReq.Offer)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := r.FetchOfferInvoice(
+		ctx, offer, lnwire.MilliSatoshi(rpcPayReq.AmtMsat),
+		rpcPayReq.Quantity, rpcPayReq.PayerNote,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch invoice for offer: %w",
+			err)
+	}
+
+	pathSet, err := routing.NewBlindedPaymentPathSet(invoice.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	payIntent := &routing.LightningPayment{
+		Target:             route.NewVertex(pathSet.TargetPubKey()),
+		Amount:             invoice.Amount,
+		FinalCLTVDelta:     pathSet.FinalCLTVDelta(),
+		FeeLimit:           lnwire.MilliSatoshi(rpcPayReq.FeeLimitMsat),
+		CltvLimit:          cltvLimit,
+		OutgoingChannelIDs: rpcPayReq.OutgoingChanIds,
+		BlindedPathSet:     pathSet,
+		PaymentRequest:     []byte(invoice.String()),
+		PayAttemptTimeout: time.Second *
+			time.Duration(rpcPayReq.TimeoutSeconds),
+	}
+
+	err = payIntent.SetPaymentHash(invoice.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// The features of the invoice apply to the payment, unless the blinded
+	// paths specify their own.
+	features := lnwire.EmptyFeatureVector()
+	if invoice.Features != nil {
+		features = lnwire.NewFeatureVector(
+			invoice.Features.Clone(), lnwire.Features,
+		)
+	}
+	pathFeatures := pathSet.Features()
+	if pathFeatures != nil && !pathFeatures.IsEmpty() {
+		features = pathFeatures.Clone()
+	}
+	payIntent.DestFeatures = features
+
+	maxParts := rpcPayReq.MaxParts
+	if maxParts == 0 {
+		maxParts = DefaultMaxParts
+	}
+	if !features.HasFeature(lnwire.MPPOptional) {
+		maxParts = 1
+	}
+	payIntent.MaxParts = maxParts
+
+	// Do bounds checking with the block padding so the router isn't
+	// left with a zombie payment in case the user messes up.
+	err = routing.ValidateCLTVLimit(
+		payIntent.CltvLimit, payIntent.FinalCLTVDelta, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return payIntent, nil
+}
+
 // BuildBlindedPathSet marshals a set of zpay32.BlindedPaymentPath and uses
 // the result to build a new routing.BlindedPaymentPathSet.
 func BuildBlindedPathSet(paths []*zpay32.BlindedPaymentPath) (
 	*routing.BlindedPaymentPathSet, error) {
 
+	marshalledPaths, err := MarshalBlindedPayments(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return routing.NewBlindedPaymentPathSet(marshalledPaths)
+}
+
+// MarshalBlindedPayments marshals and validates a set of
+// zpay32.BlindedPaymentPath.
+func MarshalBlindedPayments(paths []*zpay32.BlindedPaymentPath) (
+	[]*routing.BlindedPayment, error) {
+
 	marshalledPaths := make([]*routing.BlindedPayment, len(paths))
 	for i, path := range paths {
 		paymentPath := marshalBlindedPayment(path)
@@ -1176,7 +1444,7 @@ func BuildBlindedPathSet(paths []*zpay32.BlindedPaymentPath) (
 		marshalledPaths[i] = paymentPath
 	}
 
-	return routing.NewBlindedPaymentPathSet(marshalledPaths)
+	return marshalledPaths, nil
 }
 
 // marshalBlindedPayment marshals a zpay32.BLindedPaymentPath into a
@@ -1674,6 +1942,11 @@ func (r *RouterBackend) MarshallPayment(payment *channeldb.MPPayment) (
 		return nil, err
 	}
 
+	paymentType, err := marshallPaymentType(payment.Info.Type)
+	if err != nil {
+		return nil, err
+	}
+
 	return &lnrpc.Payment{
 		// TODO: set this to setID for AMP-payments?
 		PaymentHash:     hex.EncodeToString(paymentID[:]),
@@ -1691,6 +1964,7 @@ func (r *RouterBackend) MarshallPayment(payment *channeldb.MPPayment) (
 		Htlcs:           htlcs,
 		PaymentIndex:    payment.SequenceNum,
 		FailureReason:   failureReason,
+		PaymentType:     paymentType,
 	}, nil
 }
 
@@ -1754,3 +2028,19 @@ func marshallPaymentFailureReason(reason *channeldb.FailureReason) (
 
 	return 0, errors.New("unknown failure reason")
 }
+
+// marshallPaymentType marshalls the payment type to the corresponding rpc
+// type.
+func marshallPaymentType(paymentType channeldb.PaymentType) (
+	lnrpc.PaymentType, error) {
+
+	switch paymentType {
+	case channeldb.PaymentTypeRegular:
+		return lnrpc.PaymentType_PAYMENT_TYPE_REGULAR, nil
+
+	case channeldb.PaymentTypeRebalance:
+		return lnrpc.PaymentType_PAYMENT_TYPE_REBALANCE, nil
+	}
+
+	return 0, fmt.Errorf("unknown payment type %v", paymentType)
+}
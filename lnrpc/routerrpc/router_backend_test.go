@@ -6,12 +6,18 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/offers"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -475,3 +481,156 @@ func testUnmarshalAMP(t *testing.T, test unmarshalAMPTest) {
 		t.Fatalf("test case has non-standard outcome")
 	}
 }
+
+// TestExtractIntentFromOfferRequest asserts that the invoice that is fetched
+// for an offer is turned into a payment along its blinded paths.
+func TestExtractIntentFromOfferRequest(t *testing.T) {
+	t.Parallel()
+
+	issuerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	introKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	offer := &offers.Offer{
+		Description: "coffee",
+		IssuerID:    issuerKey.PubKey(),
+	}
+
+	invoice := &offers.Invoice{
+		Request: &offers.InvoiceRequest{
+			Offer:     offer,
+			Metadata:  []byte{1, 2, 3},
+			Amount:    5000,
+			Quantity:  2,
+			PayerID:   introKey.PubKey(),
+			PayerNote: "hi",
+		},
+		Paths: []*routing.BlindedPayment{{
+			BlindedPath: &sphinx.BlindedPath{
+				IntroductionPoint: introKey.PubKey(),
+				BlindingPoint:     introKey.PubKey(),
+				BlindedHops: []*sphinx.BlindedHopInfo{{
+					BlindedNodePub: introKey.PubKey(),
+				}},
+			},
+			CltvExpiryDelta: 40,
+			HtlcMaximum:     100_000,
+		}},
+		PaymentHash: lntypes.Hash{1},
+		Amount:      5000,
+		Features: lnwire.NewRawFeatureVector(
+			lnwire.MPPOptional,
+		),
+		NodeID: issuerKey.PubKey(),
+	}
+
+	backend := &RouterBackend{
+		MaxTotalTimelock: 1000,
+	}
+	req := &PayOfferRequest{
+		Offer:          offer.String(),
+		AmtMsat:        5000,
+		Quantity:       2,
+		PayerNote:      "hi",
+		TimeoutSeconds: 60,
+		FeeLimitMsat:   100,
+	}
+
+	// Offers can't be paid without the offers manager.
+	ctx := context.Background()
+	_, err = backend.extractIntentFromOfferRequest(ctx, req)
+	require.ErrorContains(t, err, "offers are disabled")
+
+	backend.FetchOfferInvoice = func(_ context.Context,
+		fetched *offers.Offer, amt lnwire.MilliSatoshi,
+		quantity uint64, payerNote string) (*offers.Invoice, error) {
+
+		require.Equal(t, offer.Description, fetched.Description)
+		require.True(t, fetched.IssuerID.IsEqual(offer.IssuerID))
+		require.Equal(t, lnwire.MilliSatoshi(5000), amt)
+		require.Equal(t, uint64(2), quantity)
+		require.Equal(t, "hi", payerNote)
+
+		return invoice, nil
+	}
+
+	payment, err := backend.extractIntentFromOfferRequest(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, route.NewVertex(introKey.PubKey()), payment.Target)
+	require.Equal(t, invoice.Amount, payment.Amount)
+	require.EqualValues(t, invoice.PaymentHash, payment.Identifier())
+	require.Equal(t, uint16(40), payment.FinalCLTVDelta)
+	require.Equal(t, lnwire.MilliSatoshi(100), payment.FeeLimit)
+	require.Equal(t, uint32(1000), payment.CltvLimit)
+	require.Equal(t, uint32(DefaultMaxParts), payment.MaxParts)
+	require.NotNil(t, payment.BlindedPathSet)
+	require.Equal(t, []byte(invoice.String()), payment.PaymentRequest)
+
+	// Payments to invoices that don't support MPP can't be split.
+	invoice.Features = nil
+	payment, err = backend.extractIntentFromOfferRequest(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), payment.MaxParts)
+
+	// A payment timeout is required.
+	req.TimeoutSeconds = 0
+	_, err = backend.extractIntentFromOfferRequest(ctx, req)
+	require.ErrorContains(t, err, "timeout_seconds")
+}
+
+// TestFetchMissionControl tests that mission control namespaces are resolved
+// to the right mission control.
+func TestFetchMissionControl(t *testing.T) {
+	t.Parallel()
+
+	defaultMc := &mockMissionControl{}
+	backend := &RouterBackend{
+		MissionControl: defaultMc,
+	}
+
+	// The empty and the default namespace refer to the default mission
+	// control.
+	for _, namespace := range []string{
+		"", routing.DefaultMissionControlNamespace,
+	} {
+		mc, err := backend.fetchMissionControl(namespace, false)
+		require.NoError(t, err)
+		require.Same(t, defaultMc, mc)
+	}
+
+	// Other namespaces need support from the backend.
+	_, err := backend.fetchMissionControl("tenant", true)
+	require.ErrorContains(t, err, "not supported")
+
+	// Namespaces are only created if requested.
+	tenantMc := &mockMissionControl{}
+	var created bool
+	backend.FetchMissionControl = func(namespace string,
+		create bool) (MissionControl, error) {
+
+		require.Equal(t, "tenant", namespace)
+
+		if !created && !create {
+			return nil, routing.ErrMcNamespaceNotFound
+		}
+		created = true
+
+		return tenantMc, nil
+	}
+
+	_, err = backend.fetchMissionControl("tenant", false)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	mc, err := backend.fetchMissionControl("tenant", true)
+	require.NoError(t, err)
+	require.Same(t, tenantMc, mc)
+
+	mc, err = backend.fetchMissionControl("tenant", false)
+	require.NoError(t, err)
+	require.Same(t, tenantMc, mc)
+
+	// The default namespace can't be deleted.
+	err = backend.deleteMissionControl("")
+	require.ErrorIs(t, err, routing.ErrDeleteDefaultMcNamespace)
+}
@@ -26,6 +26,23 @@ type RouterClient interface {
 	// routes without fees will be attempted which often fails with
 	// FAILURE_REASON_NO_ROUTE.
 	SendPaymentV2(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentV2Client, error)
+	// lncli: `payoffer`
+	// PayOffer requests an invoice for a BOLT 12 offer from its issuer and pays
+	// it along the blinded paths of the invoice. The call returns a stream of
+	// payment updates like SendPaymentV2. This requires onion messages to be
+	// enabled with `protocol.onion-messages`.
+	PayOffer(ctx context.Context, in *PayOfferRequest, opts ...grpc.CallOption) (Router_PayOfferClient, error)
+	// lncli: `rebalance`
+	// Rebalance moves liquidity between our channels with a circular payment to
+	// ourselves. The amount is sent out through the outgoing channels and comes
+	// back in from the peer of the incoming channel, paying an invoice that is
+	// created for the rebalance. The incoming channel must be the only channel
+	// with its peer, as the peer may forward the payment through any of its
+	// channels to us. Instead of the channels and the amount, a target local
+	// balance ratio can be given to derive them from. The call returns a
+	// stream of payment updates like SendPaymentV2. The payment is recorded
+	// with the PAYMENT_TYPE_REBALANCE type.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (Router_RebalanceClient, error)
 	// lncli: `trackpayment`
 	// TrackPaymentV2 returns an update stream for the payment identified by the
 	// payment hash.
@@ -54,8 +71,8 @@ type RouterClient interface {
 	// swaps.
 	SendToRouteV2(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*lnrpc.HTLCAttempt, error)
 	// lncli: `resetmc`
-	// ResetMissionControl clears all mission control state and starts with a clean
-	// slate.
+	// ResetMissionControl clears all mission control state of a namespace and
+	// starts with a clean slate.
 	ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error)
 	// lncli: `querymc`
 	// QueryMissionControl exposes the internal mission control state to callers.
@@ -116,6 +133,12 @@ type RouterClient interface {
 	// channel to stay disabled until a subsequent manual request of either
 	// "enable" or "auto".
 	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+	// lncli: `feeautopilotplan`
+	// FeeAutopilotPlan returns the fee policies that the fee autopilot currently
+	// plans for our channels, and whether it would update them. The planned
+	// policies are evaluated on request and not applied. It fails if the fee
+	// autopilot isn't active.
+	FeeAutopilotPlan(ctx context.Context, in *FeeAutopilotPlanRequest, opts ...grpc.CallOption) (*FeeAutopilotPlanResponse, error)
 }
 
 type routerClient struct {
@@ -158,8 +181,72 @@ func (x *routerSendPaymentV2Client) Recv() (*lnrpc.Payment, error) {
 	return m, nil
 }
 
+func (c *routerClient) PayOffer(ctx context.Context, in *PayOfferRequest, opts ...grpc.CallOption) (Router_PayOfferClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[1], "/routerrpc.Router/PayOffer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerPayOfferClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_PayOfferClient interface {
+	Recv() (*lnrpc.Payment, error)
+	grpc.ClientStream
+}
+
+type routerPayOfferClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerPayOfferClient) Recv() (*lnrpc.Payment, error) {
+	m := new(lnrpc.Payment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (Router_RebalanceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[2], "/routerrpc.Router/Rebalance", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerRebalanceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_RebalanceClient interface {
+	Recv() (*lnrpc.Payment, error)
+	grpc.ClientStream
+}
+
+type routerRebalanceClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerRebalanceClient) Recv() (*lnrpc.Payment, error) {
+	m := new(lnrpc.Payment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *routerClient) TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[1], "/routerrpc.Router/TrackPaymentV2", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[3], "/routerrpc.Router/TrackPaymentV2", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +278,7 @@ func (x *routerTrackPaymentV2Client) Recv() (*lnrpc.Payment, error) {
 }
 
 func (c *routerClient) TrackPayments(ctx context.Context, in *TrackPaymentsRequest, opts ...grpc.CallOption) (Router_TrackPaymentsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[2], "/routerrpc.Router/TrackPayments", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[4], "/routerrpc.Router/TrackPayments", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -314,7 +401,7 @@ func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, op
 }
 
 func (c *routerClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[3], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +434,7 @@ func (x *routerSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
 
 // Deprecated: Do not use.
 func (c *routerClient) SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[4], "/routerrpc.Router/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -380,7 +467,7 @@ func (x *routerSendPaymentClient) Recv() (*PaymentStatus, error) {
 
 // Deprecated: Do not use.
 func (c *routerClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/TrackPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[7], "/routerrpc.Router/TrackPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -412,7 +499,7 @@ func (x *routerTrackPaymentClient) Recv() (*PaymentStatus, error) {
 }
 
 func (c *routerClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/HtlcInterceptor", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[8], "/routerrpc.Router/HtlcInterceptor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -451,6 +538,15 @@ func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatu
 	return out, nil
 }
 
+func (c *routerClient) FeeAutopilotPlan(ctx context.Context, in *FeeAutopilotPlanRequest, opts ...grpc.CallOption) (*FeeAutopilotPlanResponse, error) {
+	out := new(FeeAutopilotPlanResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/FeeAutopilotPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -462,6 +558,23 @@ type RouterServer interface {
 	// routes without fees will be attempted which often fails with
 	// FAILURE_REASON_NO_ROUTE.
 	SendPaymentV2(*SendPaymentRequest, Router_SendPaymentV2Server) error
+	// lncli: `payoffer`
+	// PayOffer requests an invoice for a BOLT 12 offer from its issuer and pays
+	// it along the blinded paths of the invoice. The call returns a stream of
+	// payment updates like SendPaymentV2. This requires onion messages to be
+	// enabled with `protocol.onion-messages`.
+	PayOffer(*PayOfferRequest, Router_PayOfferServer) error
+	// lncli: `rebalance`
+	// Rebalance moves liquidity between our channels with a circular payment to
+	// ourselves. The amount is sent out through the outgoing channels and comes
+	// back in from the peer of the incoming channel, paying an invoice that is
+	// created for the rebalance. The incoming channel must be the only channel
+	// with its peer, as the peer may forward the payment through any of its
+	// channels to us. Instead of the channels and the amount, a target local
+	// balance ratio can be given to derive them from. The call returns a
+	// stream of payment updates like SendPaymentV2. The payment is recorded
+	// with the PAYMENT_TYPE_REBALANCE type.
+	Rebalance(*RebalanceRequest, Router_RebalanceServer) error
 	// lncli: `trackpayment`
 	// TrackPaymentV2 returns an update stream for the payment identified by the
 	// payment hash.
@@ -490,8 +603,8 @@ type RouterServer interface {
 	// swaps.
 	SendToRouteV2(context.Context, *SendToRouteRequest) (*lnrpc.HTLCAttempt, error)
 	// lncli: `resetmc`
-	// ResetMissionControl clears all mission control state and starts with a clean
-	// slate.
+	// ResetMissionControl clears all mission control state of a namespace and
+	// starts with a clean slate.
 	ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error)
 	// lncli: `querymc`
 	// QueryMissionControl exposes the internal mission control state to callers.
@@ -552,6 +665,12 @@ type RouterServer interface {
 	// channel to stay disabled until a subsequent manual request of either
 	// "enable" or "auto".
 	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+	// lncli: `feeautopilotplan`
+	// FeeAutopilotPlan returns the fee policies that the fee autopilot currently
+	// plans for our channels, and whether it would update them. The planned
+	// policies are evaluated on request and not applied. It fails if the fee
+	// autopilot isn't active.
+	FeeAutopilotPlan(context.Context, *FeeAutopilotPlanRequest) (*FeeAutopilotPlanResponse, error)
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -562,6 +681,12 @@ type UnimplementedRouterServer struct {
 func (UnimplementedRouterServer) SendPaymentV2(*SendPaymentRequest, Router_SendPaymentV2Server) error {
 	return status.Errorf(codes.Unimplemented, "method SendPaymentV2 not implemented")
 }
+func (UnimplementedRouterServer) PayOffer(*PayOfferRequest, Router_PayOfferServer) error {
+	return status.Errorf(codes.Unimplemented, "method PayOffer not implemented")
+}
+func (UnimplementedRouterServer) Rebalance(*RebalanceRequest, Router_RebalanceServer) error {
+	return status.Errorf(codes.Unimplemented, "method Rebalance not implemented")
+}
 func (UnimplementedRouterServer) TrackPaymentV2(*TrackPaymentRequest, Router_TrackPaymentV2Server) error {
 	return status.Errorf(codes.Unimplemented, "method TrackPaymentV2 not implemented")
 }
@@ -613,6 +738,9 @@ func (UnimplementedRouterServer) HtlcInterceptor(Router_HtlcInterceptorServer) e
 func (UnimplementedRouterServer) UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChanStatus not implemented")
 }
+func (UnimplementedRouterServer) FeeAutopilotPlan(context.Context, *FeeAutopilotPlanRequest) (*FeeAutopilotPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAutopilotPlan not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -647,6 +775,48 @@ func (x *routerSendPaymentV2Server) Send(m *lnrpc.Payment) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Router_PayOffer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PayOfferRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).PayOffer(m, &routerPayOfferServer{stream})
+}
+
+type Router_PayOfferServer interface {
+	Send(*lnrpc.Payment) error
+	grpc.ServerStream
+}
+
+type routerPayOfferServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerPayOfferServer) Send(m *lnrpc.Payment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_Rebalance_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RebalanceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).Rebalance(m, &routerRebalanceServer{stream})
+}
+
+type Router_RebalanceServer interface {
+	Send(*lnrpc.Payment) error
+	grpc.ServerStream
+}
+
+type routerRebalanceServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerRebalanceServer) Send(m *lnrpc.Payment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Router_TrackPaymentV2_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(TrackPaymentRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -976,6 +1146,24 @@ func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_FeeAutopilotPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeAutopilotPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).FeeAutopilotPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/FeeAutopilotPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).FeeAutopilotPlan(ctx, req.(*FeeAutopilotPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1027,6 +1215,10 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChanStatus",
 			Handler:    _Router_UpdateChanStatus_Handler,
 		},
+		{
+			MethodName: "FeeAutopilotPlan",
+			Handler:    _Router_FeeAutopilotPlan_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1034,6 +1226,16 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Router_SendPaymentV2_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PayOffer",
+			Handler:       _Router_PayOffer_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Rebalance",
+			Handler:       _Router_Rebalance_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "TrackPaymentV2",
 			Handler:       _Router_TrackPaymentV2_Handler,
@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -73,6 +74,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/PayOffer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/SendToRouteV2": {{
 			Entity: "offchain",
 			Action: "write",
@@ -141,6 +150,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/FeeAutopilotPlan": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -331,6 +344,53 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 		return err
 	}
 
+	return s.sendPayment(
+		payment, stream, req.Cancelable, req.NoInflightUpdates,
+	)
+}
+
+// PayOffer requests an invoice for a BOLT 12 offer from its issuer and pays it
+// along the blinded paths of the invoice. The call returns a stream of payment
+// updates like SendPaymentV2.
+func (s *Server) PayOffer(req *PayOfferRequest,
+	stream Router_PayOfferServer) error {
+
+	payment, err := s.cfg.RouterBackend.extractIntentFromOfferRequest(
+		stream.Context(), req,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.sendPayment(
+		payment, stream, req.Cancelable, req.NoInflightUpdates,
+	)
+}
+
+// Rebalance moves liquidity between our channels with a circular payment to
+// ourselves. The payment is recorded as a rebalance and the call returns a
+// stream of payment updates like SendPaymentV2.
+func (s *Server) Rebalance(req *RebalanceRequest,
+	stream Router_RebalanceServer) error {
+
+	payment, err := s.cfg.RouterBackend.extractIntentFromRebalanceRequest(
+		stream.Context(), req,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.sendPayment(
+		payment, stream, req.Cancelable, req.NoInflightUpdates,
+	)
+}
+
+// sendPayment initializes the given payment, sends it asynchronously and
+// streams its updates back to the caller.
+func (s *Server) sendPayment(payment *routing.LightningPayment,
+	stream Router_TrackPaymentV2Server, cancelable,
+	noInflightUpdates bool) error {
+
 	// Get the payment hash.
 	payHash := payment.Identifier()
 
@@ -370,7 +430,7 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 	// user provided 'cancelable' and ends the stream before the timeout is
 	// reached the payment will be canceled.
 	ctx := context.Background()
-	if req.Cancelable {
+	if cancelable {
 		ctx = stream.Context()
 	}
 
@@ -378,7 +438,7 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 	s.cfg.Router.SendPaymentAsync(ctx, payment, paySession, shardTracker)
 
 	// Track the payment and return.
-	return s.trackPayment(sub, payHash, stream, req.NoInflightUpdates)
+	return s.trackPayment(sub, payHash, stream, noInflightUpdates)
 }
 
 // EstimateRouteFee allows callers to obtain an expected value w.r.t how much it
@@ -887,15 +947,28 @@ func (s *Server) SendToRouteV2(ctx context.Context,
 }
 
 // ResetMissionControl clears all mission control state and starts with a clean
-// slate.
+// slate. If requested, the namespace is deleted instead.
 func (s *Server) ResetMissionControl(ctx context.Context,
 	req *ResetMissionControlRequest) (*ResetMissionControlResponse, error) {
 
-	err := s.cfg.RouterBackend.MissionControl.ResetHistory()
+	if req.DeleteNamespace {
+		return &ResetMissionControlResponse{},
+			s.cfg.RouterBackend.deleteMissionControl(
+				req.MissionControlNamespace,
+			)
+	}
+
+	mc, err := s.cfg.RouterBackend.fetchMissionControl(
+		req.MissionControlNamespace, false,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := mc.ResetHistory(); err != nil {
+		return nil, err
+	}
+
 	return &ResetMissionControlResponse{}, nil
 }
 
@@ -904,8 +977,15 @@ func (s *Server) GetMissionControlConfig(ctx context.Context,
 	req *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse,
 	error) {
 
+	mc, err := s.cfg.RouterBackend.fetchMissionControl(
+		req.MissionControlNamespace, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Query the current mission control config.
-	cfg := s.cfg.RouterBackend.MissionControl.GetConfig()
+	cfg := mc.GetConfig()
 	resp := &GetMissionControlConfigResponse{
 		Config: &MissionControlConfig{
 			MaximumPaymentResults: uint32(cfg.MaxMcHistory),
@@ -961,6 +1041,13 @@ func (s *Server) SetMissionControlConfig(ctx context.Context,
 	req *SetMissionControlConfigRequest) (*SetMissionControlConfigResponse,
 	error) {
 
+	mc, err := s.cfg.RouterBackend.fetchMissionControl(
+		req.MissionControlNamespace, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	mcCfg := &routing.MissionControlConfig{
 		MaxMcHistory: int(req.Config.MaximumPaymentResults),
 		MinFailureRelaxInterval: time.Duration(
@@ -1037,16 +1124,22 @@ func (s *Server) SetMissionControlConfig(ctx context.Context,
 			req.Config.Model)
 	}
 
-	return &SetMissionControlConfigResponse{},
-		s.cfg.RouterBackend.MissionControl.SetConfig(mcCfg)
+	return &SetMissionControlConfigResponse{}, mc.SetConfig(mcCfg)
 }
 
 // QueryMissionControl exposes the internal mission control state to callers. It
 // is a development feature.
 func (s *Server) QueryMissionControl(_ context.Context,
-	_ *QueryMissionControlRequest) (*QueryMissionControlResponse, error) {
+	req *QueryMissionControlRequest) (*QueryMissionControlResponse, error) {
 
-	snapshot := s.cfg.RouterBackend.MissionControl.GetHistorySnapshot()
+	mc, err := s.cfg.RouterBackend.fetchMissionControl(
+		req.MissionControlNamespace, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := mc.GetHistorySnapshot()
 
 	rpcPairs := make([]*PairHistory, 0, len(snapshot.Pairs))
 	for _, p := range snapshot.Pairs {
@@ -1114,13 +1207,17 @@ func (s *Server) XImportMissionControl(_ context.Context,
 		snapshot.Pairs[i] = *pairSnapshot
 	}
 
-	err := s.cfg.RouterBackend.MissionControl.ImportHistory(
-		snapshot, req.Force,
+	mc, err := s.cfg.RouterBackend.fetchMissionControl(
+		req.MissionControlNamespace, true,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := mc.ImportHistory(snapshot, req.Force); err != nil {
+		return nil, err
+	}
+
 	return &XImportMissionControlResponse{}, nil
 }
 
@@ -1566,3 +1663,77 @@ func (s *Server) UpdateChanStatus(_ context.Context,
 	}
 	return &UpdateChanStatusResponse{}, nil
 }
+
+// FeeAutopilotPlan returns the fee policies that the fee autopilot currently
+// plans for our channels.
+func (s *Server) FeeAutopilotPlan(_ context.Context,
+	_ *FeeAutopilotPlanRequest) (*FeeAutopilotPlanResponse, error) {
+
+	autopilot := s.cfg.RouterBackend.FeeAutopilot
+	if autopilot == nil {
+		return nil, errors.New("fee autopilot is not active, enable " +
+			"it with --feeautopilot.active")
+	}
+
+	plans, err := autopilot.Plan()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &FeeAutopilotPlanResponse{
+		DryRun:     autopilot.DryRun(),
+		Strategies: autopilot.StrategyNames(),
+		Channels:   make([]*ChannelFeePlan, 0, len(plans)),
+	}
+	for _, plan := range plans {
+		action, err := marshallFeePlanAction(plan.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Channels = append(resp.Channels, &ChannelFeePlan{
+			ChanPoint:          plan.ChanPoint.String(),
+			ChanId:             plan.ChanID.ToUint64(),
+			CapacitySat:        int64(plan.Capacity),
+			LocalBalanceSat:    int64(plan.LocalBalance),
+			RemoteBalanceSat:   int64(plan.RemoteBalance),
+			OutgoingVolumeMsat: uint64(plan.OutgoingVolume),
+			CurrentPolicy:      marshallFeePolicy(plan.Policy),
+			PlannedPolicy:      marshallFeePolicy(plan.Planned),
+			Action:             action,
+			LastUpdate:         plan.LastUpdate.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+// marshallFeePolicy converts a fee autopilot policy to its rpc counterpart.
+func marshallFeePolicy(policy feeautopilot.FeePolicy) *ChannelFeePolicy {
+	return &ChannelFeePolicy{
+		BaseFeeMsat:        int64(policy.BaseFee),
+		FeeRatePpm:         policy.FeeRate,
+		InboundBaseFeeMsat: policy.InboundFee.Base,
+		InboundFeeRatePpm:  policy.InboundFee.Rate,
+	}
+}
+
+// marshallFeePlanAction converts a fee autopilot action to its rpc
+// counterpart.
+func marshallFeePlanAction(action feeautopilot.Action) (FeePlanAction,
+	error) {
+
+	switch action {
+	case feeautopilot.ActionNone:
+		return FeePlanAction_FEE_PLAN_ACTION_NONE, nil
+
+	case feeautopilot.ActionUpdate:
+		return FeePlanAction_FEE_PLAN_ACTION_UPDATE, nil
+
+	case feeautopilot.ActionRateLimited:
+		return FeePlanAction_FEE_PLAN_ACTION_RATE_LIMITED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown fee plan action %v", action)
+	}
+}
@@ -138,9 +138,15 @@ func (s *Server) QueryProbability(_ context.Context,
 
 	amt := lnwire.MilliSatoshi(req.AmtMsat)
 
+	mc, err := s.cfg.RouterBackend.fetchMissionControl(
+		req.MissionControlNamespace, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Compute the probability.
 	var prob float64
-	mc := s.cfg.RouterBackend.MissionControl
 	capacity, err := s.cfg.RouterBackend.FetchAmountPairCapacity(
 		fromNode, toNode, amt,
 	)
@@ -4,7 +4,9 @@
 package walletrpc
 
 import (
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/wallet"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -76,4 +78,10 @@ type Config struct {
 	// CoinSelectionStrategy is the strategy that is used for selecting
 	// coins when funding a transaction.
 	CoinSelectionStrategy wallet.CoinSelectionStrategy
+
+	// SpliceChannel splices the channel with the given funding outpoint,
+	// changing our balance by the passed amount. It returns the splice
+	// transaction once it's fully signed and published.
+	SpliceChannel func(chanPoint wire.OutPoint, amt btcutil.Amount,
+		feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error)
 }
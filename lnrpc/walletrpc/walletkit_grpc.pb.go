@@ -274,6 +274,15 @@ type WalletKitClient interface {
 	// caller's responsibility to either publish the transaction on success or
 	// unlock/release any locked UTXOs in case of an error in this method.
 	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	// lncli: `wallet splicechannel`
+	// SpliceChannel resizes an active channel by splicing funds into or out of
+	// it. The channel is brought to quiescence, after which a splice transaction
+	// is negotiated with the remote peer. The transaction spends the current
+	// funding output and creates a new one, while the channel stays usable until
+	// the splice is locked. Funds that are spliced in are taken from the wallet,
+	// funds that are spliced out are paid to a new wallet address. This call
+	// returns once the splice transaction is signed and published.
+	SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error)
 }
 
 type walletKitClient struct {
@@ -527,6 +536,15 @@ func (c *walletKitClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequ
 	return out, nil
 }
 
+func (c *walletKitClient) SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error) {
+	out := new(SpliceChannelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SpliceChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletKitServer is the server API for WalletKit service.
 // All implementations must embed UnimplementedWalletKitServer
 // for forward compatibility
@@ -785,6 +803,15 @@ type WalletKitServer interface {
 	// caller's responsibility to either publish the transaction on success or
 	// unlock/release any locked UTXOs in case of an error in this method.
 	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	// lncli: `wallet splicechannel`
+	// SpliceChannel resizes an active channel by splicing funds into or out of
+	// it. The channel is brought to quiescence, after which a splice transaction
+	// is negotiated with the remote peer. The transaction spends the current
+	// funding output and creates a new one, while the channel stays usable until
+	// the splice is locked. Funds that are spliced in are taken from the wallet,
+	// funds that are spliced out are paid to a new wallet address. This call
+	// returns once the splice transaction is signed and published.
+	SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error)
 	mustEmbedUnimplementedWalletKitServer()
 }
 
@@ -873,6 +900,9 @@ func (UnimplementedWalletKitServer) SignPsbt(context.Context, *SignPsbtRequest)
 func (UnimplementedWalletKitServer) FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePsbt not implemented")
 }
+func (UnimplementedWalletKitServer) SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpliceChannel not implemented")
+}
 func (UnimplementedWalletKitServer) mustEmbedUnimplementedWalletKitServer() {}
 
 // UnsafeWalletKitServer may be embedded to opt out of forward compatibility for this service.
@@ -1372,6 +1402,24 @@ func _WalletKit_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_SpliceChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).SpliceChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/SpliceChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).SpliceChannel(ctx, req.(*SpliceChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletKit_ServiceDesc is the grpc.ServiceDesc for WalletKit service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1487,6 +1535,10 @@ var WalletKit_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePsbt",
 			Handler:    _WalletKit_FinalizePsbt_Handler,
 		},
+		{
+			MethodName: "SpliceChannel",
+			Handler:    _WalletKit_SpliceChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
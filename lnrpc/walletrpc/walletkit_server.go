@@ -154,6 +154,13 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/SpliceChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/walletrpc.WalletKit/ListAccounts": {{
 			Entity: "onchain",
 			Action: "read",
@@ -2163,6 +2170,76 @@ func (w *WalletKit) FinalizePsbt(_ context.Context,
 	}, nil
 }
 
+// SpliceChannel resizes an active channel by splicing funds into or out of
+// it. This call returns once the splice transaction is signed and published.
+func (w *WalletKit) SpliceChannel(_ context.Context,
+	req *SpliceChannelRequest) (*SpliceChannelResponse, error) {
+
+	if req.ChannelPoint == nil {
+		return nil, fmt.Errorf("channel point must be set")
+	}
+	if req.AmountSat == 0 {
+		return nil, fmt.Errorf("splice amount must not be zero")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(req.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: req.ChannelPoint.OutputIndex,
+	}
+
+	// Determine the fee rate of the splice transaction.
+	var feeRate chainfee.SatPerKWeight
+	switch {
+	case req.TargetConf != 0 && req.SatPerVbyte != 0:
+		return nil, fmt.Errorf("either target_conf or sat_per_vbyte " +
+			"should be set, but not both")
+
+	case req.TargetConf != 0:
+		if req.TargetConf < 2 {
+			return nil, fmt.Errorf("confirmation target must be " +
+				"greater than 1")
+		}
+
+		feeRate, err = w.cfg.FeeEstimator.EstimateFeePerKW(
+			req.TargetConf,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not estimate fee: %w",
+				err)
+		}
+
+	case req.SatPerVbyte != 0:
+		feeRate = chainfee.SatPerKVByte(
+			req.SatPerVbyte * 1000,
+		).FeePerKWeight()
+
+	default:
+		return nil, fmt.Errorf("fee definition missing, need to " +
+			"specify either target_conf or sat_per_vbyte")
+	}
+
+	spliceTx, err := w.cfg.SpliceChannel(
+		chanPoint, btcutil.Amount(req.AmountSat), feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to splice channel: %w", err)
+	}
+
+	var txBytes bytes.Buffer
+	if err := spliceTx.Serialize(&txBytes); err != nil {
+		return nil, fmt.Errorf("error serializing splice TX: %w", err)
+	}
+
+	return &SpliceChannelResponse{
+		Txid:  spliceTx.TxHash().String(),
+		RawTx: txBytes.Bytes(),
+	}, nil
+}
+
 // marshalWalletAccount converts the properties of an account into its RPC
 // representation.
 func marshalWalletAccount(internalScope waddrmgr.KeyScope,
@@ -29,6 +29,14 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/watchtowerrpc.Watchtower/ListRewards": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/watchtowerrpc.Watchtower/ListSessions": {{
+			Entity: "info",
+			Action: "read",
+		}},
 	}
 
 	// ErrTowerNotActive signals that RPC calls cannot be processed because
@@ -172,11 +180,90 @@ func (c *Handler) GetInfo(ctx context.Context,
 		uris = append(uris, fmt.Sprintf("%x@%v", pubkey, addr))
 	}
 
-	return &GetInfoResponse{
+	resp := &GetInfoResponse{
 		Pubkey:    pubkey,
 		Listeners: listeners,
 		Uris:      uris,
-	}, nil
+	}
+
+	// If the tower accepts reward sessions, we also report the minimum
+	// reward terms it accepts.
+	if terms := c.cfg.Tower.RewardTerms(); terms != nil {
+		resp.RewardSessions = true
+		resp.RewardBase = terms.RewardBase
+		resp.RewardRate = terms.RewardRate
+	}
+
+	return resp, nil
+}
+
+// ListRewards returns the rewards the tower has earned from the justice
+// transactions it published on behalf of reward sessions.
+func (c *Handler) ListRewards(ctx context.Context,
+	req *ListRewardsRequest) (*ListRewardsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	rewards, err := c.cfg.Tower.ListRewards()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListRewardsResponse{
+		Rewards: make([]*Reward, 0, len(rewards)),
+	}
+	for _, reward := range rewards {
+		resp.Rewards = append(resp.Rewards, &Reward{
+			SessionId:   reward.SessionID[:],
+			JusticeTxid: reward.JusticeTxID.String(),
+			AmountSat:   int64(reward.Amount),
+			Timestamp:   reward.Timestamp.Unix(),
+		})
+		resp.TotalRewardSat += int64(reward.Amount)
+	}
+
+	return resp, nil
+}
+
+// ListSessions returns the sessions stored by the tower along with the storage
+// used by their state updates.
+func (c *Handler) ListSessions(ctx context.Context,
+	req *ListSessionsRequest) (*ListSessionsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	usages, err := c.cfg.Tower.ListSessionUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListSessionsResponse{
+		Sessions: make([]*SessionUsage, 0, len(usages)),
+	}
+	for _, usage := range usages {
+		session := usage.SessionInfo
+
+		var lastActivity int64
+		if !usage.LastActivity.IsZero() {
+			lastActivity = usage.LastActivity.Unix()
+		}
+
+		resp.Sessions = append(resp.Sessions, &SessionUsage{
+			SessionId:    session.ID[:],
+			MaxUpdates:   uint32(session.Policy.MaxUpdates),
+			LastApplied:  uint32(session.LastApplied),
+			NumUpdates:   usage.NumUpdates,
+			UpdateBytes:  usage.UpdateBytes,
+			LastActivity: lastActivity,
+		})
+		resp.TotalUpdateBytes += usage.UpdateBytes
+	}
+
+	return resp, nil
 }
 
 // isActive returns nil if the tower backend is initialized, and the Handler can
@@ -4,6 +4,8 @@ import (
 	"net"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
 )
 
 // WatchtowerBackend abstracts access to the watchtower information that is
@@ -20,4 +22,16 @@ type WatchtowerBackend interface {
 	// ExternalIPs returns the addresses where the watchtower can be reached
 	// by clients externally.
 	ExternalIPs() []net.Addr
+
+	// RewardTerms returns the minimum reward terms the watchtower accepts
+	// for reward sessions, or nil if it doesn't accept reward sessions.
+	RewardTerms() *wtwire.RewardTerms
+
+	// ListRewards returns the rewards the watchtower has earned from the
+	// justice transactions it published.
+	ListRewards() ([]*wtdb.Reward, error)
+
+	// ListSessionUsage returns the storage used by each session of the
+	// watchtower.
+	ListSessionUsage() ([]*wtdb.SessionUsage, error)
 }
@@ -23,6 +23,14 @@ type WatchtowerClient interface {
 	// including its public key and URIs where the server is currently
 	// listening for clients.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// lncli: `tower rewards`
+	// ListRewards returns the rewards the watchtower has earned from the justice
+	// transactions it published on behalf of reward sessions.
+	ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsResponse, error)
+	// lncli: `tower sessions`
+	// ListSessions returns the sessions stored by the watchtower along with the
+	// storage used by their state updates.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 }
 
 type watchtowerClient struct {
@@ -42,6 +50,24 @@ func (c *watchtowerClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts
 	return out, nil
 }
 
+func (c *watchtowerClient) ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsResponse, error) {
+	out := new(ListRewardsResponse)
+	err := c.cc.Invoke(ctx, "/watchtowerrpc.Watchtower/ListRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchtowerClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/watchtowerrpc.Watchtower/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchtowerServer is the server API for Watchtower service.
 // All implementations must embed UnimplementedWatchtowerServer
 // for forward compatibility
@@ -51,6 +77,14 @@ type WatchtowerServer interface {
 	// including its public key and URIs where the server is currently
 	// listening for clients.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// lncli: `tower rewards`
+	// ListRewards returns the rewards the watchtower has earned from the justice
+	// transactions it published on behalf of reward sessions.
+	ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsResponse, error)
+	// lncli: `tower sessions`
+	// ListSessions returns the sessions stored by the watchtower along with the
+	// storage used by their state updates.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	mustEmbedUnimplementedWatchtowerServer()
 }
 
@@ -61,6 +95,12 @@ type UnimplementedWatchtowerServer struct {
 func (UnimplementedWatchtowerServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (UnimplementedWatchtowerServer) ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRewards not implemented")
+}
+func (UnimplementedWatchtowerServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
 func (UnimplementedWatchtowerServer) mustEmbedUnimplementedWatchtowerServer() {}
 
 // UnsafeWatchtowerServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +132,42 @@ func _Watchtower_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Watchtower_ListRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerServer).ListRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchtowerrpc.Watchtower/ListRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerServer).ListRewards(ctx, req.(*ListRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Watchtower_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchtowerrpc.Watchtower/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Watchtower_ServiceDesc is the grpc.ServiceDesc for Watchtower service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +179,14 @@ var Watchtower_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _Watchtower_GetInfo_Handler,
 		},
+		{
+			MethodName: "ListRewards",
+			Handler:    _Watchtower_ListRewards_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Watchtower_ListSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "watchtowerrpc/watchtower.proto",
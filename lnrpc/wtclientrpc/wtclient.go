@@ -515,6 +515,9 @@ func (c *WatchtowerClient) Policy(ctx context.Context,
 
 		// Deprecated field.
 		SweepSatPerByte: uint32(policy.SweepFeeRate.FeePerVByte()),
+
+		RewardBase: policy.RewardBase,
+		RewardRate: policy.RewardRate,
 	}, nil
 }
 
@@ -584,7 +587,9 @@ func marshallTower(tower *wtclient.RegisteredTower, policyType PolicyType,
 }
 
 func blobTypeToPolicyType(t blob.Type) (PolicyType, error) {
-	switch t {
+	// Reward sessions are reported under the policy type of the channels
+	// they back up.
+	switch t &^ blob.Type(blob.FlagReward) {
 	case blob.TypeAltruistTaprootCommit:
 		return PolicyType_TAPROOT, nil
 
@@ -11,6 +11,11 @@ type ChainNotifier struct {
 	SpendChan chan *chainntnfs.SpendDetail
 	EpochChan chan *chainntnfs.BlockEpoch
 	ConfChan  chan *chainntnfs.TxConfirmation
+
+	// SpendReorgChan and NegativeConfChan are optional, and are used to
+	// signal that a spend or a confirmation was reorged out.
+	SpendReorgChan   chan struct{}
+	NegativeConfChan chan int32
 }
 
 // RegisterConfirmationsNtfn returns a ConfirmationEvent that contains a channel
@@ -20,8 +25,9 @@ func (c *ChainNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 	opts ...chainntnfs.NotifierOption) (*chainntnfs.ConfirmationEvent, error) {
 
 	return &chainntnfs.ConfirmationEvent{
-		Confirmed: c.ConfChan,
-		Cancel:    func() {},
+		Confirmed:    c.ConfChan,
+		NegativeConf: c.NegativeConfChan,
+		Cancel:       func() {},
 	}, nil
 }
 
@@ -32,6 +38,7 @@ func (c *ChainNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 
 	return &chainntnfs.SpendEvent{
 		Spend:  c.SpendChan,
+		Reorg:  c.SpendReorgChan,
 		Cancel: func() {},
 	}, nil
 }
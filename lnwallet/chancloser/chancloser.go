@@ -91,6 +91,15 @@ const (
 	// party. This then causes a shift into the closeFinished state.
 	closeFeeNegotiation
 
+	// closeRbfNegotiation is the state that replaces closeFeeNegotiation
+	// when the RBF co-op close flow is in use. In this state, either side
+	// can send a ClosingComplete message proposing a closing transaction
+	// where it pays the full fee, which the other side then counter signs
+	// with a ClosingSig message. As each new offer can replace the prior
+	// closing transaction via RBF, the state machine remains in this state
+	// until the channel is closed on chain.
+	closeRbfNegotiation
+
 	// closeFinished is the final state of the state machine. In this state,
 	// a side has accepted a fee offer and has broadcast the valid closing
 	// transaction to the network. During this phase, the closing
@@ -140,6 +149,11 @@ type ChanCloseCfg struct {
 	// FeeEstimator is used to estimate the absolute starting co-op close
 	// fee.
 	FeeEstimator CoopFeeEstimator
+
+	// RbfCoopClose indicates that the RBF co-op close flow, based on the
+	// ClosingComplete and ClosingSig messages, should be used in place of
+	// the legacy ClosingSigned fee negotiation.
+	RbfCoopClose bool
 }
 
 // ChanCloser is a state machine that handles the cooperative channel closure
@@ -215,6 +229,19 @@ type ChanCloser struct {
 	// we use to handle a specific race condition caused by the independent
 	// message processing queues.
 	cachedClosingSigned fn.Option[lnwire.ClosingSigned]
+
+	// localRbfOffer is our latest ClosingComplete offer in the RBF co-op
+	// close flow that has yet to be counter signed by the remote party.
+	localRbfOffer fn.Option[rbfCloseOffer]
+
+	// cachedClosingComplete is a cached copy of a received ClosingComplete
+	// that arrived before the link finished flushing the channel.
+	cachedClosingComplete fn.Option[lnwire.ClosingComplete]
+
+	// rbfCloseFee is the fee paid by the closing transaction that we
+	// broadcast last in the RBF co-op close flow, if any. A new closing
+	// transaction must pay a higher fee in order to replace it.
+	rbfCloseFee fn.Option[btcutil.Amount]
 }
 
 // calcCoopCloseFee computes an "ideal" absolute co-op close fee given the
@@ -292,6 +319,20 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 // initFeeBaseline computes our ideal fee rate, and also the largest fee we'll
 // accept given information about the delivery script of the remote party.
 func (c *ChanCloser) initFeeBaseline() {
+	c.idealFeeSat, c.maxFee = c.feeBaseline(c.idealFeeRate, c.cfg.MaxFee)
+
+	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) "+
+		"is: %v sat (max_fee=%v sat)", c.cfg.Channel.ChannelPoint(),
+		int64(c.idealFeeSat), int64(c.maxFee))
+}
+
+// feeBaseline returns the ideal total fee of the closing transaction at the
+// given fee rate, along with the highest fee we're willing to pay. The max
+// fee is computed from the given max fee rate if it's set, otherwise it's a
+// multiple of the ideal fee.
+func (c *ChanCloser) feeBaseline(feeRate,
+	maxFeeRate chainfee.SatPerKWeight) (btcutil.Amount, btcutil.Amount) {
+
 	// Depending on if a balance ends up being dust or not, we'll pass a
 	// nil TxOut into the EstimateFee call which can handle it.
 	var localTxOut, remoteTxOut *wire.TxOut
@@ -310,23 +351,21 @@ func (c *ChanCloser) initFeeBaseline() {
 
 	// Given the target fee-per-kw, we'll compute what our ideal _total_
 	// fee will be starting at for this fee negotiation.
-	c.idealFeeSat = c.cfg.FeeEstimator.EstimateFee(
-		0, localTxOut, remoteTxOut, c.idealFeeRate,
+	idealFee := c.cfg.FeeEstimator.EstimateFee(
+		0, localTxOut, remoteTxOut, feeRate,
 	)
 
 	// When we're the initiator, we'll want to also factor in the highest
 	// fee we want to pay. This'll either be 3x the ideal fee, or the
 	// specified explicit max fee.
-	c.maxFee = c.idealFeeSat * defaultMaxFeeMultiplier
-	if c.cfg.MaxFee > 0 {
-		c.maxFee = c.cfg.FeeEstimator.EstimateFee(
-			0, localTxOut, remoteTxOut, c.cfg.MaxFee,
+	maxFee := idealFee * defaultMaxFeeMultiplier
+	if maxFeeRate > 0 {
+		maxFee = c.cfg.FeeEstimator.EstimateFee(
+			0, localTxOut, remoteTxOut, maxFeeRate,
 		)
 	}
 
-	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) "+
-		"is: %v sat (max_fee=%v sat)", c.cfg.Channel.ChannelPoint(),
-		int64(c.idealFeeSat), int64(c.maxFee))
+	return idealFee, maxFee
 }
 
 // initChanShutdown begins the shutdown process by un-registering the channel,
@@ -411,6 +450,12 @@ func (c *ChanCloser) ShutdownChan() (*lnwire.Shutdown, error) {
 // NOTE: This transaction is only available if the state machine is in the
 // closeFinished state.
 func (c *ChanCloser) ClosingTx() (*wire.MsgTx, error) {
+	// In the RBF co-op close flow, the state machine never finishes, but
+	// we'll return the latest closing transaction we broadcast, if any.
+	if c.state == closeRbfNegotiation && c.closingTx != nil {
+		return c.closingTx, nil
+	}
+
 	// If the state machine hasn't finished closing the channel, then we'll
 	// return an error as we haven't yet computed the closing tx.
 	if c.state != closeFinished {
@@ -640,6 +685,12 @@ func (c *ChanCloser) BeginNegotiation() (fn.Option[lnwire.ClosingSigned],
 
 	noClosingSigned := fn.None[lnwire.ClosingSigned]()
 
+	// The RBF co-op close flow is kicked off via BeginRbfNegotiation
+	// instead.
+	if c.cfg.RbfCoopClose {
+		return noClosingSigned, ErrRbfCoopCloseNotActive
+	}
+
 	switch c.state {
 	case closeAwaitingFlush:
 		// Now that we know their desired delivery script, we can
@@ -702,6 +753,11 @@ func (c *ChanCloser) ReceiveClosingSigned( //nolint:funlen
 
 	noClosing := fn.None[lnwire.ClosingSigned]()
 
+	// ClosingSigned messages aren't part of the RBF co-op close flow.
+	if c.cfg.RbfCoopClose {
+		return noClosing, ErrInvalidState
+	}
+
 	switch c.state {
 	case closeAwaitingFlush:
 		// If we hit this case it either means there's a protocol
@@ -210,6 +210,12 @@ func (m *mockChannel) RemoteBalanceDust() bool {
 	return false
 }
 
+func (m *mockChannel) CoopCloseDust(btcutil.Amount,
+	lntypes.ChannelParty) (bool, bool, error) {
+
+	return false, false, nil
+}
+
 func (m *mockChannel) ChanType() channeldb.ChannelType {
 	return m.chanType
 }
@@ -68,6 +68,12 @@ type Channel interface { //nolint:interfacebloat
 	// accounting for any anchor outputs.
 	RemoteBalanceDust() bool
 
+	// CoopCloseDust returns whether the local and the remote output of a
+	// co-op close transaction that pays the passed fee are omitted for
+	// being dust, when the fee is paid by the passed party.
+	CoopCloseDust(proposedFee btcutil.Amount,
+		payer lntypes.ChannelParty) (bool, bool, error)
+
 	// RemoteUpfrontShutdownScript returns the upfront shutdown script of
 	// the remote party. If the remote party didn't specify such a script,
 	// an empty delivery address should be returned.
@@ -0,0 +1,605 @@
+package chancloser
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnutils"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ErrRbfCoopCloseNotActive is returned when an RBF co-op close method
+	// is called on a ChanCloser that uses the legacy negotiation flow, or
+	// vice versa.
+	ErrRbfCoopCloseNotActive = fmt.Errorf("rbf co-op close not active")
+
+	// ErrFeeBumpTooLow is returned when a fee bump for an RBF co-op close
+	// doesn't pay a higher absolute fee than our prior offer.
+	ErrFeeBumpTooLow = fmt.Errorf("fee bump must pay a higher fee than " +
+		"the prior offer")
+
+	// ErrCloseFeeTooLow is returned when a closing transaction in the RBF
+	// co-op close flow doesn't pay a higher absolute fee than the closing
+	// transaction we already broadcast, so it can't replace it.
+	ErrCloseFeeTooLow = fmt.Errorf("closing tx must pay a higher fee " +
+		"than the broadcast closing tx")
+
+	// ErrCloseReqPending is returned when a fee bump is requested while
+	// a prior local close request is still awaiting the signature of the
+	// remote party.
+	ErrCloseReqPending = fmt.Errorf("prior close request still pending")
+
+	// ErrInvalidCloseSequence is returned when the remote party proposes
+	// a closing transaction with a sequence we don't support.
+	ErrInvalidCloseSequence = fmt.Errorf("invalid closing tx sequence")
+
+	// ErrNoRbfOffer is returned when we receive a ClosingSig message, but
+	// have no outstanding ClosingComplete offer.
+	ErrNoRbfOffer = fmt.Errorf("no outstanding closing offer")
+
+	// errNoClosingSig is returned when a ClosingComplete or ClosingSig
+	// message doesn't carry the signature we expect.
+	errNoClosingSig = fmt.Errorf("expected closing sig not present")
+)
+
+// closingSigSlot denotes which of the three possible signatures of a
+// ClosingComplete or ClosingSig message is used. This depends on which
+// outputs are present on the closing transaction.
+type closingSigSlot uint8
+
+const (
+	// closerAndClosee is used when both outputs are present.
+	closerAndClosee closingSigSlot = iota
+
+	// closerNoClosee is used when the output of the closee is dust, and
+	// has been omitted.
+	closerNoClosee
+
+	// noCloserClosee is used when the output of the closer is dust, and
+	// has been omitted.
+	noCloserClosee
+)
+
+// setSig populates the signature of the target slot within the passed set of
+// closing sigs.
+func (s closingSigSlot) setSig(sigs *lnwire.ClosingSigs, sig lnwire.Sig) {
+	switch s {
+	case closerAndClosee:
+		record := sigs.CloserAndClosee.Zero()
+		record.Val = sig
+		sigs.CloserAndClosee = tlv.SomeRecordT(record)
+
+	case closerNoClosee:
+		record := sigs.CloserNoClosee.Zero()
+		record.Val = sig
+		sigs.CloserNoClosee = tlv.SomeRecordT(record)
+
+	case noCloserClosee:
+		record := sigs.NoCloserClosee.Zero()
+		record.Val = sig
+		sigs.NoCloserClosee = tlv.SomeRecordT(record)
+	}
+}
+
+// sig extracts the signature of the target slot from the passed set of
+// closing sigs.
+func (s closingSigSlot) sig(sigs *lnwire.ClosingSigs) (lnwire.Sig, error) {
+	switch s {
+	case closerAndClosee:
+		return sigs.CloserAndClosee.UnwrapOrErrV(errNoClosingSig)
+
+	case closerNoClosee:
+		return sigs.CloserNoClosee.UnwrapOrErrV(errNoClosingSig)
+
+	case noCloserClosee:
+		return sigs.NoCloserClosee.UnwrapOrErrV(errNoClosingSig)
+
+	default:
+		return lnwire.Sig{}, errNoClosingSig
+	}
+}
+
+// rbfCloseOffer tracks a ClosingComplete offer we sent to the remote party,
+// along with the signature slot we used.
+type rbfCloseOffer struct {
+	msg  lnwire.ClosingComplete
+	slot closingSigSlot
+}
+
+// RbfCloseResult houses the outcome of a single step of the RBF co-op close
+// flow.
+type RbfCloseResult struct {
+	// Msgs is the set of messages that should be sent to the remote
+	// party.
+	Msgs []lnwire.Message
+
+	// CloseTx is set if a new closing transaction was broadcast during
+	// this step.
+	CloseTx fn.Option[*wire.MsgTx]
+
+	// CloseReq is the local close request that should be notified of the
+	// newly broadcast closing transaction, if any. A close request is only
+	// handed out once, after which it's the responsibility of the caller
+	// to deliver any further updates.
+	CloseReq *htlcswitch.ChanClose
+}
+
+// takeCloseRequest returns the current close request, and clears it so it
+// won't be returned again.
+func (c *ChanCloser) takeCloseRequest() *htlcswitch.ChanClose {
+	closeReq := c.closeReq
+	c.closeReq = nil
+
+	return closeReq
+}
+
+// IsRbfCoopClose returns true if the state machine uses the RBF co-op close
+// flow.
+func (c *ChanCloser) IsRbfCoopClose() bool {
+	return c.cfg.RbfCoopClose
+}
+
+// rbfCloseOpts returns the set of close options used to sign and complete a
+// closing transaction where the given party pays the fee.
+func rbfCloseOpts(payer lntypes.ChannelParty,
+	sequence uint32) []lnwallet.ChanCloseOpt {
+
+	return []lnwallet.ChanCloseOpt{
+		lnwallet.WithCustomPayer(payer),
+		lnwallet.WithCustomCoopSequence(sequence),
+	}
+}
+
+// BeginRbfNegotiation is the RBF co-op close analogue of BeginNegotiation. It
+// should be called once the channel has been flushed. If we initiated the
+// co-op close, then a ClosingComplete message will be returned that proposes
+// a closing transaction at our ideal fee rate.
+func (c *ChanCloser) BeginRbfNegotiation() (*RbfCloseResult, error) {
+	if !c.cfg.RbfCoopClose {
+		return nil, ErrRbfCoopCloseNotActive
+	}
+
+	if c.state != closeAwaitingFlush {
+		return nil, ErrInvalidState
+	}
+
+	// Now that we know their desired delivery script, we can compute what
+	// our ideal fee will be.
+	c.initFeeBaseline()
+
+	// As with the legacy flow, we'll mark the channel as cooperatively
+	// closed with a nil txn, so the channel is reported as being shutdown.
+	err := c.cfg.Channel.MarkCoopBroadcasted(nil, c.closer)
+	if err != nil {
+		return nil, err
+	}
+
+	c.state = closeRbfNegotiation
+
+	result := &RbfCloseResult{}
+
+	// If we initiated the close, then we'll kick things off with an offer
+	// where we pay our ideal fee. If we resumed a close whose closing
+	// transaction was already broadcast, then that transaction stands
+	// until the fee is bumped explicitly.
+	if c.closer.IsLocal() && c.closingTx == nil {
+		closingComplete, err := c.proposeRbfClose(
+			c.idealFeeSat, c.maxFee,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign new co op "+
+				"close offer: %w", err)
+		}
+
+		result.Msgs = append(result.Msgs, closingComplete)
+	}
+
+	// If the remote party already sent over an offer while we were still
+	// flushing the channel, then we'll process it now.
+	cached := c.cachedClosingComplete
+	c.cachedClosingComplete = fn.None[lnwire.ClosingComplete]()
+
+	var cachedErr error
+	cached.WhenSome(func(msg lnwire.ClosingComplete) {
+		var cachedResult *RbfCloseResult
+		cachedResult, cachedErr = c.ReceiveClosingComplete(msg)
+		if cachedErr != nil {
+			return
+		}
+
+		result.Msgs = append(result.Msgs, cachedResult.Msgs...)
+		result.CloseTx = cachedResult.CloseTx
+		result.CloseReq = cachedResult.CloseReq
+	})
+	if cachedErr != nil {
+		return nil, cachedErr
+	}
+
+	return result, nil
+}
+
+// ResumeRbfClose seeds the state machine with the closing transaction of an
+// RBF co-op close that was broadcast before a restart or reconnection. Any
+// fee bump must pay a higher absolute fee than this transaction in order to
+// replace it.
+func (c *ChanCloser) ResumeRbfClose(closeTx *wire.MsgTx) error {
+	if !c.cfg.RbfCoopClose {
+		return ErrRbfCoopCloseNotActive
+	}
+
+	var outputTotal btcutil.Amount
+	for _, txOut := range closeTx.TxOut {
+		outputTotal += btcutil.Amount(txOut.Value)
+	}
+
+	fundingAmt := btcutil.Amount(c.cfg.Channel.FundingTxOut().Value)
+	if outputTotal > fundingAmt {
+		return fmt.Errorf("closing tx %v spends more than the funding "+
+			"output", closeTx.TxHash())
+	}
+
+	c.closingTx = closeTx
+	c.lastFeeProposal = fundingAmt - outputTotal
+	c.rbfCloseFee = fn.Some(c.lastFeeProposal)
+
+	chancloserLog.Infof("ChannelPoint(%v): resuming RBF co-op close with "+
+		"closing tx %v paying fee of %v sat", c.chanPoint,
+		closeTx.TxHash(), int64(c.lastFeeProposal))
+
+	return nil
+}
+
+// BumpFee creates a new ClosingComplete offer that pays the fee rate of the
+// passed close request. The new offer must pay a higher absolute fee than our
+// prior offer so it's able to replace the prior closing transaction.
+func (c *ChanCloser) BumpFee(req *htlcswitch.ChanClose) (*RbfCloseResult,
+	error) {
+
+	if !c.cfg.RbfCoopClose {
+		return nil, ErrRbfCoopCloseNotActive
+	}
+
+	if c.state != closeRbfNegotiation {
+		return nil, ErrInvalidState
+	}
+
+	// We only track a single local close request at a time, so we'll
+	// refuse to bump the fee until the prior request has been served.
+	if c.closeReq != nil {
+		return nil, ErrCloseReqPending
+	}
+
+	// Re-compute our ideal fee using the new fee rate. The max fee of the
+	// caller only applies to this bump, so it doesn't replace the max fee
+	// of the close.
+	idealFee, maxFee := c.feeBaseline(req.TargetFeePerKw, req.MaxFee)
+	if idealFee <= c.lastFeeProposal {
+		return nil, fmt.Errorf("%w: new fee %v <= prior fee %v",
+			ErrFeeBumpTooLow, idealFee, c.lastFeeProposal)
+	}
+
+	closingComplete, err := c.proposeRbfClose(idealFee, maxFee)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign new co op close "+
+			"offer: %w", err)
+	}
+
+	// The new request will now receive any updates w.r.t the close.
+	c.idealFeeRate = req.TargetFeePerKw
+	c.idealFeeSat = idealFee
+	c.closeReq = req
+
+	return &RbfCloseResult{
+		Msgs: []lnwire.Message{closingComplete},
+	}, nil
+}
+
+// rbfCloseSlot returns the signature slot for the closing transaction that
+// pays the passed fee out of the balance of the passed closer. The slot
+// reflects which outputs are omitted for being dust once the fee has been
+// deducted, so both parties arrive at the same transaction.
+func (c *ChanCloser) rbfCloseSlot(fee btcutil.Amount,
+	closer lntypes.ChannelParty) (closingSigSlot, error) {
+
+	localDust, remoteDust, err := c.cfg.Channel.CoopCloseDust(fee, closer)
+	if err != nil {
+		return 0, err
+	}
+
+	closerDust, closeeDust := localDust, remoteDust
+	if closer.IsRemote() {
+		closerDust, closeeDust = remoteDust, localDust
+	}
+
+	switch {
+	case closerDust && closeeDust:
+		return 0, fmt.Errorf("both outputs of the closing " +
+			"transaction are dust")
+
+	case closeeDust:
+		return closerNoClosee, nil
+
+	case closerDust:
+		return noCloserClosee, nil
+
+	default:
+		return closerAndClosee, nil
+	}
+}
+
+// proposeRbfClose signs a new closing transaction where we pay the passed
+// fee, and returns the ClosingComplete message to send to the remote party.
+// The fee must not exceed the passed max fee.
+func (c *ChanCloser) proposeRbfClose(fee, maxFee btcutil.Amount) (
+	*lnwire.ClosingComplete, error) {
+
+	if fee > maxFee {
+		return nil, fmt.Errorf("%w: %v > %v", ErrProposalExceedsMaxFee,
+			fee, maxFee)
+	}
+
+	// Depending on which outputs end up being dust once we paid the fee,
+	// we'll use a distinct signature slot, so the remote party knows which
+	// transaction we signed.
+	slot, err := c.rbfCloseSlot(fee, lntypes.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll always signal RBF, so we're able to replace this transaction
+	// later on.
+	const sequence = mempool.MaxRBFSequence
+
+	rawSig, _, _, err := c.cfg.Channel.CreateCloseProposal(
+		fee, c.localDeliveryScript, c.remoteDeliveryScript,
+		rbfCloseOpts(lntypes.Local, sequence)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		return nil, err
+	}
+
+	closingComplete := lnwire.ClosingComplete{
+		ChannelID:   c.cid,
+		FeeSatoshis: fee,
+		Sequence:    sequence,
+	}
+	slot.setSig(&closingComplete.ClosingSigs, sig)
+
+	c.lastFeeProposal = fee
+	c.localRbfOffer = fn.Some(rbfCloseOffer{
+		msg:  closingComplete,
+		slot: slot,
+	})
+
+	chancloserLog.Infof("ChannelPoint(%v): offering to pay fee of %v "+
+		"sat to close chan", c.chanPoint, int64(fee))
+
+	return &closingComplete, nil
+}
+
+// ReceiveClosingComplete handles a new closing offer of the remote party. If
+// the offer is valid, we'll counter sign it, broadcast the resulting closing
+// transaction, and return a ClosingSig to send back to the remote party.
+func (c *ChanCloser) ReceiveClosingComplete(
+	msg lnwire.ClosingComplete) (*RbfCloseResult, error) {
+
+	if !c.cfg.RbfCoopClose {
+		return nil, ErrRbfCoopCloseNotActive
+	}
+
+	switch c.state {
+	// The remote party may have sent their offer before our link finished
+	// flushing the channel, so we'll cache it for now.
+	case closeAwaitingFlush:
+		c.cachedClosingComplete = fn.Some(msg)
+
+		return &RbfCloseResult{}, nil
+
+	case closeRbfNegotiation:
+
+	default:
+		return nil, ErrInvalidState
+	}
+
+	// We only accept sequences that our chain watcher is able to detect
+	// as a co-op close.
+	switch msg.Sequence {
+	case mempool.MaxRBFSequence, wire.MaxTxInSequenceNum:
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCloseSequence,
+			msg.Sequence)
+	}
+
+	// An offer that doesn't pay more than our broadcast closing
+	// transaction can't replace it, so we won't sign it.
+	if err := c.checkRbfCloseFee(msg.FeeSatoshis); err != nil {
+		return nil, err
+	}
+
+	// The remote party pays the fee of its offer, so we'll derive the
+	// outputs of the closing transaction it signed the same way it did.
+	slot, err := c.rbfCloseSlot(msg.FeeSatoshis, lntypes.Remote)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteWireSig, err := slot.sig(&msg.ClosingSigs)
+	if err != nil {
+		return nil, err
+	}
+	remoteSig, err := remoteWireSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	closeOpts := rbfCloseOpts(lntypes.Remote, msg.Sequence)
+	rawSig, _, _, err := c.cfg.Channel.CreateCloseProposal(
+		msg.FeeSatoshis, c.localDeliveryScript, c.remoteDeliveryScript,
+		closeOpts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localWireSig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		return nil, err
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): accepting remote offer to "+
+		"pay fee of %v sat", c.chanPoint, int64(msg.FeeSatoshis))
+
+	closeTx, err := c.completeRbfClose(
+		rawSig, remoteSig, msg.FeeSatoshis, closeOpts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	closingSig := &lnwire.ClosingSig{
+		ChannelID: c.cid,
+	}
+	slot.setSig(&closingSig.ClosingSigs, localWireSig)
+
+	return &RbfCloseResult{
+		Msgs:     []lnwire.Message{closingSig},
+		CloseTx:  fn.Some(closeTx),
+		CloseReq: c.takeCloseRequest(),
+	}, nil
+}
+
+// ReceiveClosingSig handles the counter signature of the remote party for
+// our latest closing offer. Once the signature is verified, the final closing
+// transaction is broadcast.
+func (c *ChanCloser) ReceiveClosingSig(msg lnwire.ClosingSig) (
+	*RbfCloseResult, error) {
+
+	if !c.cfg.RbfCoopClose {
+		return nil, ErrRbfCoopCloseNotActive
+	}
+
+	if c.state != closeRbfNegotiation {
+		return nil, ErrInvalidState
+	}
+
+	offer, err := c.localRbfOffer.UnwrapOrErr(ErrNoRbfOffer)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteWireSig, err := offer.slot.sig(&msg.ClosingSigs)
+	if err != nil {
+		return nil, err
+	}
+	remoteSig, err := remoteWireSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	localWireSig, err := offer.slot.sig(&offer.msg.ClosingSigs)
+	if err != nil {
+		return nil, err
+	}
+	localSig, err := localWireSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): fee of %v sat accepted by "+
+		"remote party", c.chanPoint, int64(offer.msg.FeeSatoshis))
+
+	closeTx, err := c.completeRbfClose(
+		localSig, remoteSig, offer.msg.FeeSatoshis,
+		rbfCloseOpts(lntypes.Local, offer.msg.Sequence),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.localRbfOffer = fn.None[rbfCloseOffer]()
+
+	return &RbfCloseResult{
+		CloseTx:  fn.Some(closeTx),
+		CloseReq: c.takeCloseRequest(),
+	}, nil
+}
+
+// checkRbfCloseFee returns ErrCloseFeeTooLow if a closing transaction that
+// pays the passed fee can't replace the closing transaction we already
+// broadcast.
+func (c *ChanCloser) checkRbfCloseFee(fee btcutil.Amount) error {
+	var err error
+	c.rbfCloseFee.WhenSome(func(broadcastFee btcutil.Amount) {
+		if fee <= broadcastFee {
+			err = fmt.Errorf("%w: fee %v <= broadcast fee %v",
+				ErrCloseFeeTooLow, fee, broadcastFee)
+		}
+	})
+
+	return err
+}
+
+// completeRbfClose assembles the final closing transaction from the passed
+// signatures, then persists and broadcasts it. As the persisted closing
+// transaction replaces the prior one, it must pay a higher fee than the
+// closing transaction we broadcast before, so it's able to replace it in the
+// mempool.
+func (c *ChanCloser) completeRbfClose(localSig, remoteSig input.Signature,
+	fee btcutil.Amount, closeOpts []lnwallet.ChanCloseOpt) (*wire.MsgTx,
+	error) {
+
+	if err := c.checkRbfCloseFee(fee); err != nil {
+		return nil, err
+	}
+
+	closeTx, _, err := c.cfg.Channel.CompleteCooperativeClose(
+		localSig, remoteSig, c.localDeliveryScript,
+		c.remoteDeliveryScript, fee, closeOpts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before publishing the closing tx, we persist it to the database,
+	// such that it can be republished if something goes wrong. This
+	// replaces any prior closing transaction.
+	err = c.cfg.Channel.MarkCoopBroadcasted(closeTx, c.closer)
+	if err != nil {
+		return nil, err
+	}
+
+	chancloserLog.Infof("Broadcasting cooperative close tx: %v",
+		lnutils.SpewLogClosure(closeTx))
+
+	chanID := c.cfg.Channel.ShortChanID()
+	closeLabel := labels.MakeLabel(labels.LabelTypeChannelClose, &chanID)
+
+	if err := c.cfg.BroadcastTx(closeTx, closeLabel); err != nil {
+		return nil, err
+	}
+
+	c.closingTx = closeTx
+	c.rbfCloseFee = fn.Some(fee)
+
+	// Any later offer of either party needs to pay a higher fee than this
+	// transaction in order to replace it.
+	if fee > c.lastFeeProposal {
+		c.lastFeeProposal = fee
+	}
+
+	return closeTx, nil
+}
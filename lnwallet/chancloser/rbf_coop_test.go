@@ -0,0 +1,519 @@
+package chancloser
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testDustLimit is the dust limit of both parties of a mockRbfChannel.
+const testDustLimit = btcutil.Amount(354)
+
+// mockRbfChannel is a mock channel that produces valid ECDSA signatures, so
+// they can be carried in the RBF co-op close messages.
+type mockRbfChannel struct {
+	mockChannel
+
+	key *btcec.PrivateKey
+
+	// localBalance and remoteBalance are the balances of both parties
+	// before the fee of the closing transaction is deducted.
+	localBalance  btcutil.Amount
+	remoteBalance btcutil.Amount
+
+	// completedFees tracks the fees of all closing transactions that
+	// were completed.
+	completedFees []btcutil.Amount
+}
+
+func newMockRbfChannel(t *testing.T, initiator bool) *mockRbfChannel {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return &mockRbfChannel{
+		mockChannel: mockChannel{
+			initiator: initiator,
+		},
+		key:           key,
+		localBalance:  btcutil.SatoshiPerBitcoin / 2,
+		remoteBalance: btcutil.SatoshiPerBitcoin / 2,
+	}
+}
+
+func (m *mockRbfChannel) CreateCloseProposal(fee btcutil.Amount,
+	localScript, remoteScript []byte, _ ...lnwallet.ChanCloseOpt,
+) (input.Signature, *chainhash.Hash, btcutil.Amount, error) {
+
+	sigHash := chainhash.HashB([]byte{byte(fee)})
+
+	return ecdsa.Sign(m.key, sigHash), nil, 0, nil
+}
+
+func (m *mockRbfChannel) CompleteCooperativeClose(localSig,
+	remoteSig input.Signature, localScript, remoteScript []byte,
+	proposedFee btcutil.Amount,
+	_ ...lnwallet.ChanCloseOpt) (*wire.MsgTx, btcutil.Amount, error) {
+
+	m.completedFees = append(m.completedFees, proposedFee)
+
+	// We'll use the lock time to make each closing transaction unique.
+	return &wire.MsgTx{LockTime: uint32(proposedFee)}, 0, nil
+}
+
+func (m *mockRbfChannel) FundingTxOut() *wire.TxOut {
+	return &wire.TxOut{Value: int64(btcutil.SatoshiPerBitcoin)}
+}
+
+func (m *mockRbfChannel) CoopCloseDust(fee btcutil.Amount,
+	payer lntypes.ChannelParty) (bool, bool, error) {
+
+	localBalance, remoteBalance := m.localBalance, m.remoteBalance
+	if payer.IsLocal() {
+		localBalance -= fee
+	} else {
+		remoteBalance -= fee
+	}
+
+	return localBalance < testDustLimit, remoteBalance < testDustLimit,
+		nil
+}
+
+// newRbfTestCloser creates a new ChanCloser that uses the RBF co-op close
+// flow for the passed mock channel.
+func newRbfTestCloser(channel *mockRbfChannel, fee btcutil.Amount,
+	closeReq *htlcswitch.ChanClose, closer lntypes.ChannelParty,
+	broadcasts chan *wire.MsgTx) *ChanCloser {
+
+	return NewChanCloser(
+		ChanCloseCfg{
+			Channel: channel,
+			BroadcastTx: func(tx *wire.MsgTx, _ string) error {
+				broadcasts <- tx
+				return nil
+			},
+			FeeEstimator: &mockCoopFeeEstimator{targetFee: fee},
+			DisableChannel: func(wire.OutPoint) error {
+				return nil
+			},
+			RbfCoopClose: true,
+		}, nil, chainfee.SatPerKWeight(253), 0, closeReq, closer,
+	)
+}
+
+// TestRbfCoopClose tests that two parties are able to execute an RBF co-op
+// close, and that the closer is able to fee bump the closing transaction
+// afterwards.
+func TestRbfCoopClose(t *testing.T) {
+	t.Parallel()
+
+	const initialFee = btcutil.Amount(1000)
+
+	aliceChan := newMockRbfChannel(t, true)
+	bobChan := newMockRbfChannel(t, false)
+
+	aliceBroadcasts := make(chan *wire.MsgTx, 2)
+	bobBroadcasts := make(chan *wire.MsgTx, 2)
+
+	// Bob isn't the initiator of the channel, but he'll initiate the
+	// co-op close, so he'll pay the fee.
+	closeReq := &htlcswitch.ChanClose{}
+	bobCloser := newRbfTestCloser(
+		bobChan, initialFee, closeReq, lntypes.Local, bobBroadcasts,
+	)
+	aliceCloser := newRbfTestCloser(
+		aliceChan, initialFee, nil, lntypes.Remote, aliceBroadcasts,
+	)
+
+	// The legacy negotiation methods shouldn't be usable.
+	_, err := bobCloser.BeginNegotiation()
+	require.ErrorIs(t, err, ErrRbfCoopCloseNotActive)
+
+	// First, both sides will exchange their shutdown messages.
+	shutdown, err := bobCloser.ShutdownChan()
+	require.NoError(t, err)
+
+	oShutdown, err := aliceCloser.ReceiveShutdown(*shutdown)
+	require.NoError(t, err)
+
+	_, err = bobCloser.ReceiveShutdown(oShutdown.UnwrapOrFail(t))
+	require.NoError(t, err)
+
+	// Once the channel has been flushed, Bob will send over his offer,
+	// while Alice only waits for one.
+	bobRes, err := bobCloser.BeginRbfNegotiation()
+	require.NoError(t, err)
+	require.Len(t, bobRes.Msgs, 1)
+	require.True(t, bobRes.CloseTx.IsNone())
+
+	aliceRes, err := aliceCloser.BeginRbfNegotiation()
+	require.NoError(t, err)
+	require.Empty(t, aliceRes.Msgs)
+
+	closingComplete, ok := bobRes.Msgs[0].(*lnwire.ClosingComplete)
+	require.True(t, ok)
+	require.Equal(t, initialFee, closingComplete.FeeSatoshis)
+	require.EqualValues(
+		t, mempool.MaxRBFSequence, closingComplete.Sequence,
+	)
+	require.True(t, closingComplete.CloserAndClosee.IsSome())
+	require.True(t, closingComplete.CloserNoClosee.IsNone())
+	require.True(t, closingComplete.NoCloserClosee.IsNone())
+
+	// Alice will accept the offer, broadcast the closing transaction, and
+	// send back her signature.
+	aliceRes, err = aliceCloser.ReceiveClosingComplete(*closingComplete)
+	require.NoError(t, err)
+	require.Len(t, aliceRes.Msgs, 1)
+	require.True(t, aliceRes.CloseTx.IsSome())
+	require.Nil(t, aliceRes.CloseReq)
+	require.Len(t, aliceBroadcasts, 1)
+
+	closingSig, ok := aliceRes.Msgs[0].(*lnwire.ClosingSig)
+	require.True(t, ok)
+	require.True(t, closingSig.CloserAndClosee.IsSome())
+
+	// An offer that doesn't pay more than the broadcast closing
+	// transaction can't replace it, so Alice refuses to sign it.
+	lowerOffer := *closingComplete
+	lowerOffer.FeeSatoshis = initialFee - 1
+	for _, offer := range []lnwire.ClosingComplete{
+		*closingComplete, lowerOffer,
+	} {
+		_, err = aliceCloser.ReceiveClosingComplete(offer)
+		require.ErrorIs(t, err, ErrCloseFeeTooLow)
+	}
+	require.Len(t, aliceBroadcasts, 1)
+	require.Equal(
+		t, []btcutil.Amount{initialFee}, aliceChan.completedFees,
+	)
+
+	// Bob will now complete the closing transaction, and hand the close
+	// request back to the caller.
+	bobRes, err = bobCloser.ReceiveClosingSig(*closingSig)
+	require.NoError(t, err)
+	require.Empty(t, bobRes.Msgs)
+	require.True(t, bobRes.CloseTx.IsSome())
+	require.Equal(t, closeReq, bobRes.CloseReq)
+	require.Len(t, bobBroadcasts, 1)
+
+	closingTx, err := bobCloser.ClosingTx()
+	require.NoError(t, err)
+	require.NotNil(t, closingTx)
+
+	// A second ClosingSig should be rejected, as Bob has no outstanding
+	// offer.
+	_, err = bobCloser.ReceiveClosingSig(*closingSig)
+	require.ErrorIs(t, err, ErrNoRbfOffer)
+
+	// A fee bump that doesn't increase the fee should be rejected.
+	_, err = bobCloser.BumpFee(&htlcswitch.ChanClose{
+		TargetFeePerKw: chainfee.SatPerKWeight(253),
+	})
+	require.ErrorIs(t, err, ErrFeeBumpTooLow)
+
+	// Bob will now bump the fee of the closing transaction.
+	const bumpedFee = initialFee * 2
+	bobCloser.cfg.FeeEstimator = &mockCoopFeeEstimator{
+		targetFee: bumpedFee,
+	}
+	bumpReq := &htlcswitch.ChanClose{
+		TargetFeePerKw: chainfee.SatPerKWeight(506),
+		MaxFee:         chainfee.SatPerKWeight(1000),
+	}
+	maxFeeRate, maxFee := bobCloser.cfg.MaxFee, bobCloser.maxFee
+	bobRes, err = bobCloser.BumpFee(bumpReq)
+	require.NoError(t, err)
+	require.Len(t, bobRes.Msgs, 1)
+
+	// The max fee of the bump only applies to the bump itself.
+	require.Equal(t, maxFeeRate, bobCloser.cfg.MaxFee)
+	require.Equal(t, maxFee, bobCloser.maxFee)
+
+	closingComplete, ok = bobRes.Msgs[0].(*lnwire.ClosingComplete)
+	require.True(t, ok)
+	require.Equal(t, bumpedFee, closingComplete.FeeSatoshis)
+
+	// While the bump is outstanding, another one can't be requested.
+	_, err = bobCloser.BumpFee(bumpReq)
+	require.ErrorIs(t, err, ErrCloseReqPending)
+
+	aliceRes, err = aliceCloser.ReceiveClosingComplete(*closingComplete)
+	require.NoError(t, err)
+	require.Len(t, aliceBroadcasts, 2)
+
+	closingSig, ok = aliceRes.Msgs[0].(*lnwire.ClosingSig)
+	require.True(t, ok)
+
+	bobRes, err = bobCloser.ReceiveClosingSig(*closingSig)
+	require.NoError(t, err)
+	require.Equal(t, bumpReq, bobRes.CloseReq)
+	require.Len(t, bobBroadcasts, 2)
+
+	// The latest closing transaction should have replaced the prior one.
+	bumpedTx, err := bobCloser.ClosingTx()
+	require.NoError(t, err)
+	require.NotEqual(t, closingTx.TxHash(), bumpedTx.TxHash())
+
+	require.Equal(
+		t, []btcutil.Amount{initialFee, bumpedFee},
+		bobChan.completedFees,
+	)
+	require.Equal(
+		t, []btcutil.Amount{initialFee, bumpedFee},
+		aliceChan.completedFees,
+	)
+}
+
+// TestRbfCoopCloseResume tests that an RBF co-op close can be resumed with a
+// previously broadcast closing transaction, which is kept until the fee is
+// bumped above the fee it pays.
+func TestRbfCoopCloseResume(t *testing.T) {
+	t.Parallel()
+
+	const priorFee = btcutil.Amount(1500)
+
+	aliceChan := newMockRbfChannel(t, true)
+	bobChan := newMockRbfChannel(t, false)
+
+	aliceBroadcasts := make(chan *wire.MsgTx, 1)
+	bobBroadcasts := make(chan *wire.MsgTx, 1)
+
+	// Bob restarted after broadcasting a closing transaction, so he'll
+	// resume the close without a close request.
+	bobCloser := newRbfTestCloser(
+		bobChan, 1000, nil, lntypes.Local, bobBroadcasts,
+	)
+	aliceCloser := newRbfTestCloser(
+		aliceChan, 1000, nil, lntypes.Remote, aliceBroadcasts,
+	)
+
+	priorTx := &wire.MsgTx{
+		TxOut: []*wire.TxOut{{
+			Value: int64(btcutil.SatoshiPerBitcoin - priorFee),
+		}},
+	}
+	require.NoError(t, bobCloser.ResumeRbfClose(priorTx))
+
+	// A closer that uses the legacy flow can't be resumed.
+	legacyCloser := NewChanCloser(
+		ChanCloseCfg{Channel: bobChan}, nil, 253, 0, nil,
+		lntypes.Local,
+	)
+	err := legacyCloser.ResumeRbfClose(priorTx)
+	require.ErrorIs(t, err, ErrRbfCoopCloseNotActive)
+
+	shutdown, err := bobCloser.ShutdownChan()
+	require.NoError(t, err)
+
+	oShutdown, err := aliceCloser.ReceiveShutdown(*shutdown)
+	require.NoError(t, err)
+
+	_, err = bobCloser.ReceiveShutdown(oShutdown.UnwrapOrFail(t))
+	require.NoError(t, err)
+
+	// As the prior closing transaction stands, Bob won't send a new offer
+	// once the negotiation begins.
+	bobRes, err := bobCloser.BeginRbfNegotiation()
+	require.NoError(t, err)
+	require.Empty(t, bobRes.Msgs)
+
+	_, err = aliceCloser.BeginRbfNegotiation()
+	require.NoError(t, err)
+
+	closingTx, err := bobCloser.ClosingTx()
+	require.NoError(t, err)
+	require.Equal(t, priorTx.TxHash(), closingTx.TxHash())
+
+	// A bump must pay more than the prior closing transaction.
+	bobCloser.cfg.FeeEstimator = &mockCoopFeeEstimator{
+		targetFee: priorFee,
+	}
+	_, err = bobCloser.BumpFee(&htlcswitch.ChanClose{
+		TargetFeePerKw: chainfee.SatPerKWeight(506),
+	})
+	require.ErrorIs(t, err, ErrFeeBumpTooLow)
+
+	const bumpedFee = priorFee * 2
+	bobCloser.cfg.FeeEstimator = &mockCoopFeeEstimator{
+		targetFee: bumpedFee,
+	}
+	bumpReq := &htlcswitch.ChanClose{
+		TargetFeePerKw: chainfee.SatPerKWeight(1012),
+	}
+	bobRes, err = bobCloser.BumpFee(bumpReq)
+	require.NoError(t, err)
+	require.Len(t, bobRes.Msgs, 1)
+
+	closingComplete, ok := bobRes.Msgs[0].(*lnwire.ClosingComplete)
+	require.True(t, ok)
+	require.Equal(t, bumpedFee, closingComplete.FeeSatoshis)
+
+	aliceRes, err := aliceCloser.ReceiveClosingComplete(*closingComplete)
+	require.NoError(t, err)
+
+	closingSig, ok := aliceRes.Msgs[0].(*lnwire.ClosingSig)
+	require.True(t, ok)
+
+	bobRes, err = bobCloser.ReceiveClosingSig(*closingSig)
+	require.NoError(t, err)
+	require.Equal(t, bumpReq, bobRes.CloseReq)
+	require.Len(t, bobBroadcasts, 1)
+}
+
+// TestRbfCoopCloseDustOutputs tests that the signature slot used in the RBF
+// co-op close messages reflects which outputs are dust once the closer paid
+// the fee.
+func TestRbfCoopCloseDustOutputs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fee     = btcutil.Amount(1000)
+		balance = btcutil.Amount(btcutil.SatoshiPerBitcoin / 2)
+	)
+
+	testCases := []struct {
+		name string
+
+		// closerBalance and closeeBalance are the balances of the
+		// closer and the closee before the fee is deducted.
+		closerBalance btcutil.Amount
+		closeeBalance btcutil.Amount
+
+		expectedSlot closingSigSlot
+	}{
+		{
+			name:          "no dust",
+			closerBalance: balance,
+			closeeBalance: balance,
+			expectedSlot:  closerAndClosee,
+		},
+		{
+			name:          "closee dust",
+			closerBalance: balance,
+			closeeBalance: testDustLimit - 1,
+			expectedSlot:  closerNoClosee,
+		},
+		{
+			name:          "closee at dust limit",
+			closerBalance: balance,
+			closeeBalance: testDustLimit,
+			expectedSlot:  closerAndClosee,
+		},
+		{
+			name:          "closer dust",
+			closerBalance: testDustLimit - 1,
+			closeeBalance: balance,
+			expectedSlot:  noCloserClosee,
+		},
+		{
+			// The closer's balance is above the dust limit, but
+			// paying the fee pushes its output below it.
+			name:          "closer dust after fee",
+			closerBalance: testDustLimit + fee - 1,
+			closeeBalance: balance,
+			expectedSlot:  noCloserClosee,
+		},
+		{
+			name:          "closer at dust limit after fee",
+			closerBalance: testDustLimit + fee,
+			closeeBalance: balance,
+			expectedSlot:  closerAndClosee,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			closerChan := newMockRbfChannel(t, true)
+			closerChan.localBalance = testCase.closerBalance
+			closerChan.remoteBalance = testCase.closeeBalance
+
+			closeeChan := newMockRbfChannel(t, false)
+			closeeChan.localBalance = testCase.closeeBalance
+			closeeChan.remoteBalance = testCase.closerBalance
+
+			broadcasts := make(chan *wire.MsgTx, 2)
+			closer := newRbfTestCloser(
+				closerChan, fee, nil, lntypes.Local,
+				broadcasts,
+			)
+			closee := newRbfTestCloser(
+				closeeChan, fee, nil, lntypes.Remote,
+				broadcasts,
+			)
+			closer.state = closeAwaitingFlush
+			closee.state = closeAwaitingFlush
+
+			// The closee receives the offer before it finished
+			// flushing the channel, so it should be cached.
+			res, err := closer.BeginRbfNegotiation()
+			require.NoError(t, err)
+			closingComplete, ok :=
+				res.Msgs[0].(*lnwire.ClosingComplete)
+			require.True(t, ok)
+
+			_, err = testCase.expectedSlot.sig(
+				&closingComplete.ClosingSigs,
+			)
+			require.NoError(t, err)
+
+			res, err = closee.ReceiveClosingComplete(
+				*closingComplete,
+			)
+			require.NoError(t, err)
+			require.Empty(t, res.Msgs)
+
+			// Once the closee starts the negotiation, the cached
+			// offer is processed.
+			res, err = closee.BeginRbfNegotiation()
+			require.NoError(t, err)
+			require.True(t, res.CloseTx.IsSome())
+
+			closingSig, ok := res.Msgs[0].(*lnwire.ClosingSig)
+			require.True(t, ok)
+
+			_, err = testCase.expectedSlot.sig(
+				&closingSig.ClosingSigs,
+			)
+			require.NoError(t, err)
+
+			res, err = closer.ReceiveClosingSig(*closingSig)
+			require.NoError(t, err)
+			require.True(t, res.CloseTx.IsSome())
+		})
+	}
+}
+
+// TestRbfCoopCloseInvalidSequence tests that we reject a closing offer that
+// uses a sequence our chain watcher wouldn't detect as a co-op close.
+func TestRbfCoopCloseInvalidSequence(t *testing.T) {
+	t.Parallel()
+
+	broadcasts := make(chan *wire.MsgTx, 1)
+	closee := newRbfTestCloser(
+		newMockRbfChannel(t, false), 1000, nil, lntypes.Remote,
+		broadcasts,
+	)
+	closee.state = closeRbfNegotiation
+
+	_, err := closee.ReceiveClosingComplete(lnwire.ClosingComplete{
+		FeeSatoshis: 1000,
+		Sequence:    0,
+	})
+	require.ErrorIs(t, err, ErrInvalidCloseSequence)
+}
@@ -0,0 +1,478 @@
+package chanfunding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// interactiveSequence is the sequence of our inputs of an
+	// interactively constructed funding transaction, which signals
+	// replaceability.
+	interactiveSequence = wire.MaxTxInSequenceNum - 2
+)
+
+var (
+	// ErrInteractiveTxStarted is returned if the construction of the
+	// funding transaction of an InteractiveIntent is started twice.
+	ErrInteractiveTxStarted = errors.New("interactive funding tx " +
+		"construction already started")
+
+	// ErrFundingTxNotCompiled is returned if the funding transaction of
+	// an InteractiveIntent is signed before it was compiled.
+	ErrFundingTxNotCompiled = errors.New("funding tx not compiled")
+)
+
+// InteractiveIntent is an intent for a co-funded channel, whose funding
+// transaction is constructed together with the remote party using the
+// interactive transaction construction protocol. Our own contribution is
+// backed by the internal wallet.
+//
+// Steps to final channel provisioning:
+//  1. Call BindKeys to notify the intent which keys to use when constructing
+//     the multi-sig output. The initiator also needs to call
+//     SetRemoteFundingAmt once the contribution of the remote party is known.
+//  2. Call InteractiveTx to obtain the construction state machine, and run it
+//     with the remote party until it's done.
+//  3. Call CompileFundingTx to verify the constructed transaction and to
+//     obtain the channel point.
+//  4. Once the signatures for the commitment transactions were exchanged,
+//     sign our funding inputs using SignFundingTx and FundingPsbt, and
+//     complete the funding transaction with the witnesses of the remote
+//     party using FinalizeFundingPsbt.
+//
+// If any of these steps fail, then the Cancel method MUST be called.
+type InteractiveIntent struct {
+	FullIntent
+
+	// initiator is true if we initiated the channel open. The initiator
+	// adds the funding output to the transaction and pays for it.
+	initiator bool
+
+	// fetchTx is used to look up the previous transactions of our inputs,
+	// which are sent to the remote party.
+	fetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+
+	// itx is the state machine of the construction of the funding
+	// transaction.
+	itx *InteractiveTx
+
+	// packet is the constructed funding transaction. It's set by
+	// CompileFundingTx.
+	packet *psbt.Packet
+}
+
+// Initiator returns true if we initiated the channel open.
+func (i *InteractiveIntent) Initiator() bool {
+	return i.initiator
+}
+
+// SetRemoteFundingAmt sets the amount the remote party contributes to the
+// channel. It must be called by the initiator before the construction of the
+// funding transaction is started.
+func (i *InteractiveIntent) SetRemoteFundingAmt(amt btcutil.Amount) {
+	i.remoteFundingAmt = amt
+}
+
+// InteractiveTx returns the state machine that constructs the funding
+// transaction with the remote party. All of our contributions are already
+// queued, so the initiator may start the construction right away.
+func (i *InteractiveIntent) InteractiveTx(chanID lnwire.ChannelID,
+	locktime uint32) (*InteractiveTx, error) {
+
+	if i.itx != nil {
+		return nil, ErrInteractiveTxStarted
+	}
+
+	itx := NewInteractiveTx(InteractiveTxConfig{
+		ChanID:    chanID,
+		Initiator: i.initiator,
+		Locktime:  locktime,
+	})
+
+	for _, coin := range i.InputCoins {
+		prevTx, err := i.fetchTx(coin.OutPoint.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch tx of input "+
+				"%v: %w", coin.OutPoint, err)
+		}
+
+		err = itx.AddLocalInput(
+			prevTx, coin.OutPoint.Index, interactiveSequence,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, changeOutput := range i.ChangeOutputs {
+		itx.AddLocalOutput(changeOutput)
+	}
+
+	// Only the initiator adds the funding output.
+	if i.initiator {
+		_, fundingOutput, err := i.FundingOutput()
+		if err != nil {
+			return nil, err
+		}
+		itx.AddLocalOutput(fundingOutput)
+	}
+
+	i.itx = itx
+
+	return itx, nil
+}
+
+// CompileFundingTx verifies the funding transaction once its construction is
+// done, and sets the channel point of the intent. The returned transaction
+// is unsigned.
+func (i *InteractiveIntent) CompileFundingTx() (*wire.MsgTx, error) {
+	if i.itx == nil {
+		return nil, ErrInteractiveTxNotDone
+	}
+
+	packet, err := i.itx.Packet()
+	if err != nil {
+		return nil, err
+	}
+
+	_, fundingOutput, err := i.FundingOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction must pay exactly the capacity of the channel to the
+	// funding output.
+	var (
+		fundingTx     = packet.UnsignedTx
+		fundingIndex  = -1
+		numFundingOut int
+	)
+	for idx, txOut := range fundingTx.TxOut {
+		if !txOutEqual(txOut, fundingOutput) {
+			continue
+		}
+
+		fundingIndex = idx
+		numFundingOut++
+	}
+	if numFundingOut != 1 {
+		return nil, fmt.Errorf("funding tx %v must have exactly one "+
+			"funding output, found %d", fundingTx.TxHash(),
+			numFundingOut)
+	}
+
+	// The remote party must pay its share of the funding output from its
+	// own inputs. The fees for its inputs and outputs are up to the
+	// remote party, as we're only paying for our own.
+	var remoteIn, remoteOut btcutil.Amount
+	for _, in := range i.itx.Inputs() {
+		if !in.Local {
+			remoteIn += btcutil.Amount(in.PrevOut.Value)
+		}
+	}
+	for _, out := range i.itx.Outputs() {
+		if !out.Local && !txOutEqual(out.TxOut, fundingOutput) {
+			remoteOut += btcutil.Amount(out.TxOut.Value)
+		}
+	}
+	if remoteIn-remoteOut < i.remoteFundingAmt {
+		return nil, fmt.Errorf("remote contribution %v below "+
+			"remote funding amount %v", remoteIn-remoteOut,
+			i.remoteFundingAmt)
+	}
+
+	i.packet = packet
+	i.chanPoint = &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: uint32(fundingIndex),
+	}
+
+	return fundingTx.Copy(), nil
+}
+
+// SignFundingTx signs our inputs of the compiled funding transaction and
+// returns their witnesses, in the order of their serial ids.
+func (i *InteractiveIntent) SignFundingTx() ([]wire.TxWitness, error) {
+	if i.packet == nil {
+		return nil, ErrFundingTxNotCompiled
+	}
+
+	fundingTx := i.packet.UnsignedTx
+	prevOutFetcher := i.prevOutFetcher()
+	sigHashes := txscript.NewTxSigHashes(fundingTx, prevOutFetcher)
+
+	var witnesses []wire.TxWitness
+	for idx, in := range i.itx.Inputs() {
+		if !in.Local {
+			continue
+		}
+
+		signDesc := input.SignDescriptor{
+			Output:            in.PrevOut,
+			HashType:          txscript.SigHashAll,
+			SigHashes:         sigHashes,
+			PrevOutputFetcher: prevOutFetcher,
+			InputIndex:        idx,
+		}
+		if txscript.IsPayToTaproot(in.PrevOut.PkScript) {
+			signDesc.HashType = txscript.SigHashDefault
+		}
+
+		inputScript, err := i.signer.ComputeInputScript(
+			fundingTx, &signDesc,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign input %v: %w",
+				in.OutPoint, err)
+		}
+
+		witnesses = append(witnesses, inputScript.Witness)
+	}
+
+	return witnesses, nil
+}
+
+// FundingPsbt returns the compiled funding transaction as a PSBT that holds
+// the previous outputs of all inputs, and the passed witnesses of our inputs
+// in the order of their serial ids. The witnesses of the remote party are
+// added with FinalizeFundingPsbt, which doesn't require the intent anymore,
+// so the PSBT can be persisted to complete the funding transaction later on.
+func (i *InteractiveIntent) FundingPsbt(
+	localWitnesses []wire.TxWitness) (*psbt.Packet, error) {
+
+	if i.packet == nil {
+		return nil, ErrFundingTxNotCompiled
+	}
+
+	packet, err := i.itx.Packet()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, in := range i.itx.Inputs() {
+		if !in.Local {
+			continue
+		}
+
+		if len(localWitnesses) == 0 {
+			return nil, fmt.Errorf("missing witness for input %v",
+				in.OutPoint)
+		}
+
+		var b bytes.Buffer
+		err := psbt.WriteTxWitness(&b, localWitnesses[0])
+		if err != nil {
+			return nil, err
+		}
+		packet.Inputs[idx].FinalScriptWitness = b.Bytes()
+		localWitnesses = localWitnesses[1:]
+	}
+	if len(localWitnesses) != 0 {
+		return nil, errors.New("too many witnesses")
+	}
+
+	return packet, nil
+}
+
+// FinalizeFundingPsbt returns the fully signed funding transaction, given the
+// funding PSBT created by FundingPsbt and the witnesses of the remote party's
+// inputs in the order of their serial ids. The inputs of the remote party are
+// the ones without a witness in the PSBT. All witnesses are verified before
+// the transaction is returned.
+func FinalizeFundingPsbt(packet *psbt.Packet,
+	remoteWitnesses []wire.TxWitness) (*wire.MsgTx, error) {
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for idx, txIn := range packet.UnsignedTx.TxIn {
+		in := &packet.Inputs[idx]
+		if in.WitnessUtxo == nil {
+			return nil, fmt.Errorf("missing previous output of "+
+				"input %v", txIn.PreviousOutPoint)
+		}
+		prevOuts.AddPrevOut(txIn.PreviousOutPoint, in.WitnessUtxo)
+
+		if len(in.FinalScriptWitness) != 0 {
+			continue
+		}
+
+		if len(remoteWitnesses) == 0 {
+			return nil, fmt.Errorf("missing witness for input %v",
+				txIn.PreviousOutPoint)
+		}
+
+		var b bytes.Buffer
+		err := psbt.WriteTxWitness(&b, remoteWitnesses[0])
+		if err != nil {
+			return nil, err
+		}
+		in.FinalScriptWitness = b.Bytes()
+		remoteWitnesses = remoteWitnesses[1:]
+	}
+	if len(remoteWitnesses) != 0 {
+		return nil, errors.New("too many witnesses")
+	}
+
+	fundingTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	sigHashes := txscript.NewTxSigHashes(fundingTx, prevOuts)
+	for idx, txIn := range fundingTx.TxIn {
+		prevOut := packet.Inputs[idx].WitnessUtxo
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, fundingTx, idx,
+			txscript.StandardVerifyFlags, nil, sigHashes,
+			prevOut.Value, prevOuts,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := vm.Execute(); err != nil {
+			return nil, fmt.Errorf("invalid witness for input "+
+				"%v: %w", txIn.PreviousOutPoint, err)
+		}
+	}
+
+	return fundingTx, nil
+}
+
+// prevOutFetcher returns a fetcher for the previous outputs of all inputs of
+// the constructed transaction.
+func (i *InteractiveIntent) prevOutFetcher() *txscript.MultiPrevOutFetcher {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for _, in := range i.itx.Inputs() {
+		prevOuts.AddPrevOut(in.OutPoint, in.PrevOut)
+	}
+
+	return prevOuts
+}
+
+// txOutEqual returns true if both outputs have the same value and script.
+func txOutEqual(a, b *wire.TxOut) bool {
+	return a.Value == b.Value && bytes.Equal(a.PkScript, b.PkScript)
+}
+
+// A compile-time check to ensure InteractiveIntent meets the Intent
+// interface.
+var _ Intent = (*InteractiveIntent)(nil)
+
+// InteractiveConfig is the config of the InteractiveAssembler.
+type InteractiveConfig struct {
+	WalletConfig
+
+	// FetchTx returns the wallet transaction with the given hash. It's
+	// used to look up the previous transactions of our inputs, which need
+	// to be sent to the remote party.
+	FetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+}
+
+// InteractiveAssembler is an instance of the Assembler interface for
+// co-funded channels. Our contribution to the channel is selected from the
+// wallet, the funding transaction itself is constructed together with the
+// remote party.
+type InteractiveAssembler struct {
+	cfg InteractiveConfig
+
+	// initiator is true if we're the initiator of the channel open.
+	initiator bool
+
+	wallet *WalletAssembler
+}
+
+// NewInteractiveAssembler creates a new instance of the InteractiveAssembler
+// for either the initiator or the responder of a co-funded channel open.
+func NewInteractiveAssembler(cfg InteractiveConfig,
+	initiator bool) *InteractiveAssembler {
+
+	// Only native segwit inputs may be added to an interactively
+	// constructed transaction.
+	walletCfg := cfg.WalletConfig
+	walletCfg.CoinSource = &segwitCoinSource{
+		CoinSource: cfg.CoinSource,
+	}
+
+	return &InteractiveAssembler{
+		cfg:       cfg,
+		initiator: initiator,
+		wallet:    NewWalletAssembler(walletCfg),
+	}
+}
+
+// ProvisionChannel selects the coins of our contribution to a co-funded
+// channel and returns an InteractiveIntent that is used to construct the
+// funding transaction with the remote party. Only the initiator pays the fee
+// for the funding output.
+//
+// NOTE: This is a part of the chanfunding.Assembler interface.
+func (a *InteractiveAssembler) ProvisionChannel(r *Request) (Intent, error) {
+	if r.FundUpToMaxAmt != 0 || r.SubtractFees || r.PushAmt != 0 {
+		return nil, errors.New("co-funded channels only support " +
+			"a fixed local amount without push amount")
+	}
+
+	var fundingOutputWeight input.TxWeightEstimator
+	if a.initiator {
+		fundingOutputWeight.AddP2WSHOutput()
+	}
+
+	fullIntent, err := a.wallet.provisionChannel(r, fundingOutputWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InteractiveIntent{
+		FullIntent: *fullIntent,
+		initiator:  a.initiator,
+		fetchTx:    a.cfg.FetchTx,
+	}, nil
+}
+
+// ShouldPublishFundingTx always returns false, as the funding transaction
+// can only be published once the remote party signed its inputs.
+//
+// NOTE: This method is a part of the ConditionalPublishAssembler interface.
+func (a *InteractiveAssembler) ShouldPublishFundingTx() bool {
+	return false
+}
+
+// A compile-time assertion to ensure the InteractiveAssembler meets the
+// ConditionalPublishAssembler interface.
+var _ ConditionalPublishAssembler = (*InteractiveAssembler)(nil)
+
+// segwitCoinSource is a CoinSource that only lists native segwit coins.
+type segwitCoinSource struct {
+	CoinSource
+}
+
+// ListCoins returns all native segwit UTXOs from the source that have
+// between minConfs and maxConfs number of confirmations.
+//
+// NOTE: This is a part of the CoinSource interface.
+func (s *segwitCoinSource) ListCoins(minConfs,
+	maxConfs int32) ([]wallet.Coin, error) {
+
+	coins, err := s.CoinSource.ListCoins(minConfs, maxConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	segwitCoins := make([]wallet.Coin, 0, len(coins))
+	for _, coin := range coins {
+		if txscript.IsWitnessProgram(coin.PkScript) {
+			segwitCoins = append(segwitCoins, coin)
+		}
+	}
+
+	return segwitCoins, nil
+}
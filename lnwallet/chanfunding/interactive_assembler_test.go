@@ -0,0 +1,283 @@
+package chanfunding
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testWallet is a minimal wallet backing an InteractiveAssembler in tests.
+type testWallet struct {
+	key    *btcec.PrivateKey
+	addr   btcutil.Address
+	coins  []wallet.Coin
+	txns   map[chainhash.Hash]*wire.MsgTx
+	leased map[wire.OutPoint]struct{}
+}
+
+// newTestWallet creates a wallet that owns a single P2WKH coin of the given
+// value, as well as a nested P2WKH coin that must never be selected.
+func newTestWallet(t *testing.T, value int64) *testWallet {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pubKeyHash := btcutil.Hash160(key.PubKey().SerializeCompressed())
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, &params)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	w := &testWallet{
+		key:    key,
+		addr:   addr,
+		txns:   make(map[chainhash.Hash]*wire.MsgTx),
+		leased: make(map[wire.OutPoint]struct{}),
+	}
+
+	nestedScript, err := input.GenerateP2SH(pkScript)
+	require.NoError(t, err)
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 3}, nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(value, pkScript))
+	prevTx.AddTxOut(wire.NewTxOut(10*value, nestedScript))
+	w.txns[prevTx.TxHash()] = prevTx
+
+	for i, txOut := range prevTx.TxOut {
+		w.coins = append(w.coins, wallet.Coin{
+			TxOut: *txOut,
+			OutPoint: wire.OutPoint{
+				Hash:  prevTx.TxHash(),
+				Index: uint32(i),
+			},
+		})
+	}
+
+	return w
+}
+
+func (w *testWallet) ListCoins(_, _ int32) ([]wallet.Coin, error) {
+	return w.coins, nil
+}
+
+func (w *testWallet) CoinFromOutPoint(op wire.OutPoint) (*wallet.Coin,
+	error) {
+
+	for _, coin := range w.coins {
+		if coin.OutPoint == op {
+			return &coin, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown coin %v", op)
+}
+
+func (w *testWallet) WithCoinSelectLock(f func() error) error {
+	return f()
+}
+
+func (w *testWallet) LeaseOutput(_ wtxmgr.LockID, op wire.OutPoint,
+	_ time.Duration) (time.Time, []byte, btcutil.Amount, error) {
+
+	w.leased[op] = struct{}{}
+
+	return time.Time{}, nil, 0, nil
+}
+
+func (w *testWallet) ReleaseOutput(_ wtxmgr.LockID, op wire.OutPoint) error {
+	delete(w.leased, op)
+
+	return nil
+}
+
+func (w *testWallet) fetchTx(txid chainhash.Hash) (*wire.MsgTx, error) {
+	tx, ok := w.txns[txid]
+	if !ok {
+		return nil, fmt.Errorf("unknown tx %v", txid)
+	}
+
+	return tx, nil
+}
+
+// provision provisions the contribution of the wallet to a co-funded
+// channel.
+func (w *testWallet) provision(t *testing.T, initiator bool, localAmt,
+	remoteAmt btcutil.Amount) *InteractiveIntent {
+
+	cfg := InteractiveConfig{
+		WalletConfig: WalletConfig{
+			CoinSource:       w,
+			CoinSelectLocker: w,
+			CoinLeaser:       w,
+			Signer: &input.MockSigner{
+				Privkeys:  []*btcec.PrivateKey{w.key},
+				NetParams: &params,
+			},
+			DustLimit:             330,
+			CoinSelectionStrategy: wallet.CoinSelectionLargest,
+		},
+		FetchTx: w.fetchTx,
+	}
+	assembler := NewInteractiveAssembler(cfg, initiator)
+
+	intent, err := assembler.ProvisionChannel(&Request{
+		LocalAmt:  localAmt,
+		RemoteAmt: remoteAmt,
+		FeeRate:   chainfee.FeePerKwFloor,
+		ChangeAddr: func() (btcutil.Address, error) {
+			return w.addr, nil
+		},
+	})
+	require.NoError(t, err)
+	require.IsType(t, &InteractiveIntent{}, intent)
+
+	return intent.(*InteractiveIntent)
+}
+
+// TestInteractiveIntent tests that the initiator and the responder of a
+// co-funded channel end up with the same, fully signed funding transaction.
+func TestInteractiveIntent(t *testing.T) {
+	t.Parallel()
+
+	const (
+		initiatorAmt btcutil.Amount = 500_000
+		responderAmt btcutil.Amount = 300_000
+	)
+
+	initiatorWallet := newTestWallet(t, 1_000_000)
+	responderWallet := newTestWallet(t, 400_000)
+
+	initiator := initiatorWallet.provision(t, true, initiatorAmt, 0)
+	responder := responderWallet.provision(
+		t, false, responderAmt, initiatorAmt,
+	)
+
+	// Only the native segwit coins were selected and leased.
+	require.Len(t, initiator.InputCoins, 1)
+	require.Len(t, responder.InputCoins, 1)
+	require.Len(t, initiatorWallet.leased, 1)
+	require.Len(t, responderWallet.leased, 1)
+
+	_, localKey := btcec.PrivKeyFromBytes(localPrivkey)
+	_, remoteKey := btcec.PrivKeyFromBytes(remotePrivkey)
+	initiator.BindKeys(&keychain.KeyDescriptor{PubKey: localKey}, remoteKey)
+	initiator.SetRemoteFundingAmt(responderAmt)
+	responder.BindKeys(&keychain.KeyDescriptor{PubKey: remoteKey}, localKey)
+
+	// The funding tx can't be compiled before it's constructed.
+	_, err := responder.CompileFundingTx()
+	require.ErrorIs(t, err, ErrInteractiveTxNotDone)
+
+	chanID := lnwire.ChannelID{1}
+	initiatorTx, err := initiator.InteractiveTx(chanID, 100)
+	require.NoError(t, err)
+	responderTx, err := responder.InteractiveTx(chanID, 100)
+	require.NoError(t, err)
+
+	_, err = initiator.InteractiveTx(chanID, 100)
+	require.ErrorIs(t, err, ErrInteractiveTxStarted)
+
+	runInteractiveTx(t, initiatorTx, responderTx)
+
+	initiatorFundingTx, err := initiator.CompileFundingTx()
+	require.NoError(t, err)
+	responderFundingTx, err := responder.CompileFundingTx()
+	require.NoError(t, err)
+
+	require.Equal(
+		t, initiatorFundingTx.TxHash(), responderFundingTx.TxHash(),
+	)
+	require.Len(t, initiatorFundingTx.TxIn, 2)
+	require.Len(t, initiatorFundingTx.TxOut, 3)
+
+	initiatorChanPoint, err := initiator.ChanPoint()
+	require.NoError(t, err)
+	responderChanPoint, err := responder.ChanPoint()
+	require.NoError(t, err)
+	require.Equal(t, initiatorChanPoint, responderChanPoint)
+
+	fundingOut := initiatorFundingTx.TxOut[initiatorChanPoint.Index]
+	require.EqualValues(t, initiatorAmt+responderAmt, fundingOut.Value)
+
+	// Both parties exchange the witnesses of their inputs, after which
+	// they end up with the same signed transaction.
+	initiatorSigs, err := initiator.SignFundingTx()
+	require.NoError(t, err)
+	responderSigs, err := responder.SignFundingTx()
+	require.NoError(t, err)
+
+	initiatorPsbt, err := initiator.FundingPsbt(initiatorSigs)
+	require.NoError(t, err)
+	responderPsbt, err := responder.FundingPsbt(responderSigs)
+	require.NoError(t, err)
+
+	signedTx, err := FinalizeFundingPsbt(initiatorPsbt, responderSigs)
+	require.NoError(t, err)
+	responderSignedTx, err := FinalizeFundingPsbt(
+		responderPsbt, initiatorSigs,
+	)
+	require.NoError(t, err)
+	require.Equal(t, signedTx, responderSignedTx)
+
+	// A witness of the wrong input is rejected.
+	initiatorPsbt, err = initiator.FundingPsbt(initiatorSigs)
+	require.NoError(t, err)
+	_, err = FinalizeFundingPsbt(initiatorPsbt, initiatorSigs)
+	require.ErrorContains(t, err, "invalid witness")
+
+	// So are our own witnesses of the wrong inputs.
+	_, err = initiator.FundingPsbt(responderSigs)
+	require.NoError(t, err)
+	initiatorPsbt, err = initiator.FundingPsbt(responderSigs)
+	require.NoError(t, err)
+	_, err = FinalizeFundingPsbt(initiatorPsbt, responderSigs)
+	require.ErrorContains(t, err, "invalid witness")
+
+	// Canceling the intents releases the selected coins.
+	initiator.Cancel()
+	responder.Cancel()
+	require.Empty(t, initiatorWallet.leased)
+	require.Empty(t, responderWallet.leased)
+}
+
+// TestInteractiveIntentCapacityMismatch tests that a funding transaction that
+// doesn't pay the agreed upon capacity to the funding output is rejected.
+func TestInteractiveIntentCapacityMismatch(t *testing.T) {
+	t.Parallel()
+
+	initiator := newTestWallet(t, 1_000_000).provision(t, true, 500_000, 0)
+	responder := newTestWallet(t, 400_000).provision(
+		t, false, 300_000, 500_000,
+	)
+
+	_, localKey := btcec.PrivKeyFromBytes(localPrivkey)
+	_, remoteKey := btcec.PrivKeyFromBytes(remotePrivkey)
+	initiator.BindKeys(&keychain.KeyDescriptor{PubKey: localKey}, remoteKey)
+	responder.BindKeys(&keychain.KeyDescriptor{PubKey: remoteKey}, localKey)
+
+	// The initiator doesn't account for the contribution of the
+	// responder, so the funding output is too small.
+	chanID := lnwire.ChannelID{1}
+	initiatorTx, err := initiator.InteractiveTx(chanID, 100)
+	require.NoError(t, err)
+	responderTx, err := responder.InteractiveTx(chanID, 100)
+	require.NoError(t, err)
+
+	runInteractiveTx(t, initiatorTx, responderTx)
+
+	_, err = responder.CompileFundingTx()
+	require.ErrorContains(t, err, "exactly one funding output")
+}
@@ -0,0 +1,627 @@
+package chanfunding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// MaxInteractiveTxInputs is the maximum number of inputs a transaction
+	// that is constructed interactively may have.
+	MaxInteractiveTxInputs = 252
+
+	// MaxInteractiveTxOutputs is the maximum number of outputs a
+	// transaction that is constructed interactively may have.
+	MaxInteractiveTxOutputs = 252
+
+	// maxInteractiveTxMsgs is the maximum number of messages we'll accept
+	// from the remote party during a single interactive transaction
+	// construction, to protect against peers that never finish.
+	maxInteractiveTxMsgs = 4096
+)
+
+var (
+	// ErrInteractiveTxDone is returned when a message is processed after
+	// the interactive transaction construction already completed.
+	ErrInteractiveTxDone = errors.New("interactive tx construction " +
+		"already completed")
+
+	// ErrInteractiveTxNotDone is returned when the constructed
+	// transaction is requested before the construction completed.
+	ErrInteractiveTxNotDone = errors.New("interactive tx construction " +
+		"not completed yet")
+
+	// ErrTooManyInteractiveTxMsgs is returned when the remote party sent
+	// more messages than we're willing to process.
+	ErrTooManyInteractiveTxMsgs = errors.New("too many interactive tx " +
+		"messages")
+)
+
+// InteractiveTxInput is an input of a transaction that is constructed
+// interactively.
+type InteractiveTxInput struct {
+	// SerialID is the unique identifier of the input. Inputs are sorted
+	// by their serial id in the final transaction.
+	SerialID uint64
+
+	// OutPoint is the outpoint that is spent by the input.
+	OutPoint wire.OutPoint
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// PrevTx is the transaction that created the spent output. It's nil
+	// for the shared input.
+	PrevTx *wire.MsgTx
+
+	// PrevOut is the output that is spent by the input.
+	PrevOut *wire.TxOut
+
+	// Shared is true if the input spends the current funding output of
+	// the channel.
+	Shared bool
+
+	// Local is true if the input was contributed by us.
+	Local bool
+}
+
+// InteractiveTxOutput is an output of a transaction that is constructed
+// interactively.
+type InteractiveTxOutput struct {
+	// SerialID is the unique identifier of the output. Outputs are sorted
+	// by their serial id in the final transaction.
+	SerialID uint64
+
+	// TxOut is the output itself.
+	TxOut *wire.TxOut
+
+	// Local is true if the output was contributed by us.
+	Local bool
+}
+
+// InteractiveTxConfig houses the parameters of an interactive transaction
+// construction.
+type InteractiveTxConfig struct {
+	// ChanID is the channel the transaction is constructed for.
+	ChanID lnwire.ChannelID
+
+	// Initiator is true if we initiated the construction. The initiator
+	// sends the first message and uses even serial ids, while the other
+	// party uses odd ones.
+	Initiator bool
+
+	// Locktime is the locktime of the constructed transaction.
+	Locktime uint32
+
+	// SharedInput is the current funding output of the channel, if the
+	// constructed transaction is a splice. Only this outpoint may be added
+	// as a shared input.
+	SharedInput fn.Option[wire.OutPoint]
+
+	// SharedPrevOut is the funding output that is spent by the shared
+	// input.
+	SharedPrevOut *wire.TxOut
+}
+
+// InteractiveTx is a state machine that constructs a transaction together
+// with a remote party, following the interactive transaction construction
+// protocol. Both parties take turns to add or remove inputs and outputs, or
+// to send tx_complete if they don't have anything more to add. The
+// construction is done once both parties sent tx_complete in a row.
+type InteractiveTx struct {
+	cfg InteractiveTxConfig
+
+	// inputs and outputs are the current set of inputs and outputs of the
+	// transaction, indexed by serial id.
+	inputs  map[uint64]*InteractiveTxInput
+	outputs map[uint64]*InteractiveTxOutput
+
+	// pending is the queue of our own contributions that we still need to
+	// send to the remote party.
+	pending []lnwire.Message
+
+	// nextSerialID is the next serial id we'll assign to one of our
+	// contributions.
+	nextSerialID uint64
+
+	// sentComplete is true if the last message we sent was tx_complete.
+	sentComplete bool
+
+	// numRemoteMsgs is the number of messages we received so far.
+	numRemoteMsgs int
+
+	// done is true once both parties sent tx_complete in a row.
+	done bool
+}
+
+// NewInteractiveTx creates a new interactive transaction construction with
+// the passed parameters.
+func NewInteractiveTx(cfg InteractiveTxConfig) *InteractiveTx {
+	nextSerialID := uint64(1)
+	if cfg.Initiator {
+		nextSerialID = 0
+	}
+
+	return &InteractiveTx{
+		cfg:          cfg,
+		inputs:       make(map[uint64]*InteractiveTxInput),
+		outputs:      make(map[uint64]*InteractiveTxOutput),
+		nextSerialID: nextSerialID,
+	}
+}
+
+// isLocalSerialID returns true if the passed serial id belongs to one of our
+// own contributions.
+func (t *InteractiveTx) isLocalSerialID(serialID uint64) bool {
+	return (serialID%2 == 0) == t.cfg.Initiator
+}
+
+// serialID returns the next serial id for one of our contributions.
+func (t *InteractiveTx) serialID() uint64 {
+	serialID := t.nextSerialID
+	t.nextSerialID += 2
+
+	return serialID
+}
+
+// AddLocalSharedInput queues the shared funding input to be added to the
+// transaction. Only the initiator of the construction adds the shared input.
+func (t *InteractiveTx) AddLocalSharedInput(sequence uint32) error {
+	sharedInput, err := t.cfg.SharedInput.UnwrapOrErr(
+		errors.New("no shared input configured"),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !t.cfg.Initiator {
+		return errors.New("only the initiator may add the shared input")
+	}
+
+	in := &InteractiveTxInput{
+		SerialID: t.serialID(),
+		OutPoint: sharedInput,
+		Sequence: sequence,
+		PrevOut:  t.cfg.SharedPrevOut,
+		Shared:   true,
+		Local:    true,
+	}
+	t.inputs[in.SerialID] = in
+
+	msg := &lnwire.TxAddInput{
+		ChanID:     t.cfg.ChanID,
+		SerialID:   in.SerialID,
+		PrevTxVout: sharedInput.Index,
+		Sequence:   sequence,
+	}
+	msg.SharedInputTxid = tlv.SomeRecordT(
+		tlv.NewPrimitiveRecord[lnwire.SharedInputTxidType](
+			[32]byte(sharedInput.Hash),
+		),
+	)
+	t.pending = append(t.pending, msg)
+
+	return nil
+}
+
+// AddLocalInput queues an input that spends the given output of prevTx to be
+// added to the transaction.
+func (t *InteractiveTx) AddLocalInput(prevTx *wire.MsgTx, vout,
+	sequence uint32) error {
+
+	if int(vout) >= len(prevTx.TxOut) {
+		return fmt.Errorf("output %d not found in previous tx %v",
+			vout, prevTx.TxHash())
+	}
+
+	var prevTxBytes bytes.Buffer
+	if err := prevTx.Serialize(&prevTxBytes); err != nil {
+		return err
+	}
+
+	in := &InteractiveTxInput{
+		SerialID: t.serialID(),
+		OutPoint: wire.OutPoint{
+			Hash:  prevTx.TxHash(),
+			Index: vout,
+		},
+		Sequence: sequence,
+		PrevTx:   prevTx,
+		PrevOut:  prevTx.TxOut[vout],
+		Local:    true,
+	}
+	t.inputs[in.SerialID] = in
+
+	t.pending = append(t.pending, &lnwire.TxAddInput{
+		ChanID:     t.cfg.ChanID,
+		SerialID:   in.SerialID,
+		PrevTx:     prevTxBytes.Bytes(),
+		PrevTxVout: vout,
+		Sequence:   sequence,
+	})
+
+	return nil
+}
+
+// AddLocalOutput queues an output to be added to the transaction.
+func (t *InteractiveTx) AddLocalOutput(txOut *wire.TxOut) {
+	out := &InteractiveTxOutput{
+		SerialID: t.serialID(),
+		TxOut:    txOut,
+		Local:    true,
+	}
+	t.outputs[out.SerialID] = out
+
+	t.pending = append(t.pending, &lnwire.TxAddOutput{
+		ChanID:   t.cfg.ChanID,
+		SerialID: out.SerialID,
+		Amount:   btcutil.Amount(txOut.Value),
+		PkScript: txOut.PkScript,
+	})
+}
+
+// nextMsg returns the next message we need to send to the remote party,
+// which is either one of our pending contributions or tx_complete.
+func (t *InteractiveTx) nextMsg() lnwire.Message {
+	if len(t.pending) > 0 {
+		msg := t.pending[0]
+		t.pending = t.pending[1:]
+		t.sentComplete = false
+
+		return msg
+	}
+
+	t.sentComplete = true
+
+	return &lnwire.TxComplete{
+		ChanID: t.cfg.ChanID,
+	}
+}
+
+// Start returns the first message of the construction. It must only be
+// called by the initiator, once all of its contributions were queued.
+func (t *InteractiveTx) Start() (lnwire.Message, error) {
+	if !t.cfg.Initiator {
+		return nil, errors.New("only the initiator may start the " +
+			"interactive tx construction")
+	}
+
+	return t.nextMsg(), nil
+}
+
+// ProcessMsg processes a message of the remote party and returns the message
+// we need to send in response, if any. The returned bool is true once the
+// construction is done, after which Packet may be called.
+func (t *InteractiveTx) ProcessMsg(msg lnwire.Message) (
+	fn.Option[lnwire.Message], bool, error) {
+
+	none := fn.None[lnwire.Message]()
+
+	if t.done {
+		return none, true, ErrInteractiveTxDone
+	}
+
+	t.numRemoteMsgs++
+	if t.numRemoteMsgs > maxInteractiveTxMsgs {
+		return none, false, ErrTooManyInteractiveTxMsgs
+	}
+
+	var err error
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		err = t.recvAddInput(msg)
+
+	case *lnwire.TxAddOutput:
+		err = t.recvAddOutput(msg)
+
+	case *lnwire.TxRemoveInput:
+		err = t.recvRemoveInput(msg)
+
+	case *lnwire.TxRemoveOutput:
+		err = t.recvRemoveOutput(msg)
+
+	case *lnwire.TxComplete:
+		// If our last message was tx_complete as well, the
+		// construction is done and we don't need to respond.
+		if t.sentComplete {
+			if err := t.validate(); err != nil {
+				return none, false, err
+			}
+			t.done = true
+
+			return none, true, nil
+		}
+
+	default:
+		return none, false, fmt.Errorf("unexpected interactive tx "+
+			"message: %T", msg)
+	}
+	if err != nil {
+		return none, false, err
+	}
+
+	resp := t.nextMsg()
+
+	// If the remote party sent tx_complete, and we don't have anything
+	// left to add either, our tx_complete concludes the construction.
+	_, remoteComplete := msg.(*lnwire.TxComplete)
+	if remoteComplete && t.sentComplete {
+		if err := t.validate(); err != nil {
+			return none, false, err
+		}
+		t.done = true
+	}
+
+	return fn.Some(resp), t.done, nil
+}
+
+// recvAddInput validates and applies a tx_add_input message of the remote
+// party.
+func (t *InteractiveTx) recvAddInput(msg *lnwire.TxAddInput) error {
+	if t.isLocalSerialID(msg.SerialID) {
+		return fmt.Errorf("input serial id %d has wrong parity",
+			msg.SerialID)
+	}
+	if _, ok := t.inputs[msg.SerialID]; ok {
+		return fmt.Errorf("duplicate input serial id %d", msg.SerialID)
+	}
+	if len(t.inputs) >= MaxInteractiveTxInputs {
+		return fmt.Errorf("too many inputs")
+	}
+
+	in := &InteractiveTxInput{
+		SerialID: msg.SerialID,
+		Sequence: msg.Sequence,
+	}
+
+	if msg.SharedInputTxid.IsSome() {
+		sharedInput, err := t.cfg.SharedInput.UnwrapOrErr(
+			errors.New("unexpected shared input"),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Only the initiator adds the shared input, so the remote
+		// party may only do so if we're the responder.
+		txid, _ := msg.SharedInputTxid.UnwrapOrErrV(nil)
+		if t.cfg.Initiator || sharedInput.Hash != txid ||
+			sharedInput.Index != msg.PrevTxVout {
+
+			return fmt.Errorf("invalid shared input %x:%d", txid,
+				msg.PrevTxVout)
+		}
+
+		in.OutPoint = sharedInput
+		in.PrevOut = t.cfg.SharedPrevOut
+		in.Shared = true
+	} else {
+		var prevTx wire.MsgTx
+		err := prevTx.Deserialize(bytes.NewReader(msg.PrevTx))
+		if err != nil {
+			return fmt.Errorf("invalid previous tx: %w", err)
+		}
+
+		if int(msg.PrevTxVout) >= len(prevTx.TxOut) {
+			return fmt.Errorf("output %d not found in previous "+
+				"tx %v", msg.PrevTxVout, prevTx.TxHash())
+		}
+
+		// To avoid malleability of the constructed transaction, all
+		// inputs must be native segwit spends.
+		prevOut := prevTx.TxOut[msg.PrevTxVout]
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return fmt.Errorf("input %d is not a segwit spend",
+				msg.SerialID)
+		}
+
+		in.OutPoint = wire.OutPoint{
+			Hash:  prevTx.TxHash(),
+			Index: msg.PrevTxVout,
+		}
+		in.PrevTx = &prevTx
+		in.PrevOut = prevOut
+	}
+
+	for _, other := range t.inputs {
+		if other.OutPoint == in.OutPoint {
+			return fmt.Errorf("input %v added twice", in.OutPoint)
+		}
+	}
+
+	t.inputs[in.SerialID] = in
+
+	return nil
+}
+
+// recvAddOutput validates and applies a tx_add_output message of the remote
+// party.
+func (t *InteractiveTx) recvAddOutput(msg *lnwire.TxAddOutput) error {
+	if t.isLocalSerialID(msg.SerialID) {
+		return fmt.Errorf("output serial id %d has wrong parity",
+			msg.SerialID)
+	}
+	if _, ok := t.outputs[msg.SerialID]; ok {
+		return fmt.Errorf("duplicate output serial id %d",
+			msg.SerialID)
+	}
+	if len(t.outputs) >= MaxInteractiveTxOutputs {
+		return fmt.Errorf("too many outputs")
+	}
+	if msg.Amount <= 0 || msg.Amount > btcutil.MaxSatoshi {
+		return fmt.Errorf("invalid output amount %v", msg.Amount)
+	}
+
+	t.outputs[msg.SerialID] = &InteractiveTxOutput{
+		SerialID: msg.SerialID,
+		TxOut:    wire.NewTxOut(int64(msg.Amount), msg.PkScript),
+	}
+
+	return nil
+}
+
+// recvRemoveInput applies a tx_remove_input message of the remote party.
+func (t *InteractiveTx) recvRemoveInput(msg *lnwire.TxRemoveInput) error {
+	in, ok := t.inputs[msg.SerialID]
+	if !ok || in.Local {
+		return fmt.Errorf("unknown input serial id %d", msg.SerialID)
+	}
+
+	delete(t.inputs, msg.SerialID)
+
+	return nil
+}
+
+// recvRemoveOutput applies a tx_remove_output message of the remote party.
+func (t *InteractiveTx) recvRemoveOutput(msg *lnwire.TxRemoveOutput) error {
+	out, ok := t.outputs[msg.SerialID]
+	if !ok || out.Local {
+		return fmt.Errorf("unknown output serial id %d", msg.SerialID)
+	}
+
+	delete(t.outputs, msg.SerialID)
+
+	return nil
+}
+
+// validate checks the final set of inputs and outputs once both parties are
+// done with their contributions.
+func (t *InteractiveTx) validate() error {
+	if len(t.inputs) == 0 {
+		return errors.New("transaction has no inputs")
+	}
+	if len(t.outputs) == 0 {
+		return errors.New("transaction has no outputs")
+	}
+
+	var inputSum, outputSum int64
+	for _, in := range t.inputs {
+		inputSum += in.PrevOut.Value
+	}
+	for _, out := range t.outputs {
+		outputSum += out.TxOut.Value
+	}
+	if inputSum < outputSum {
+		return fmt.Errorf("input sum %v below output sum %v",
+			btcutil.Amount(inputSum), btcutil.Amount(outputSum))
+	}
+
+	// If a shared input is configured, the transaction must spend it.
+	if t.cfg.SharedInput.IsSome() {
+		for _, in := range t.inputs {
+			if in.Shared {
+				return nil
+			}
+		}
+
+		return errors.New("shared input missing")
+	}
+
+	return nil
+}
+
+// Inputs returns all inputs of the transaction, sorted by serial id.
+func (t *InteractiveTx) Inputs() []*InteractiveTxInput {
+	inputs := make([]*InteractiveTxInput, 0, len(t.inputs))
+	for _, in := range t.inputs {
+		inputs = append(inputs, in)
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].SerialID < inputs[j].SerialID
+	})
+
+	return inputs
+}
+
+// Outputs returns all outputs of the transaction, sorted by serial id.
+func (t *InteractiveTx) Outputs() []*InteractiveTxOutput {
+	outputs := make([]*InteractiveTxOutput, 0, len(t.outputs))
+	for _, out := range t.outputs {
+		outputs = append(outputs, out)
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].SerialID < outputs[j].SerialID
+	})
+
+	return outputs
+}
+
+// Packet returns the constructed transaction as an unsigned PSBT, with the
+// inputs and outputs sorted by their serial ids.
+func (t *InteractiveTx) Packet() (*psbt.Packet, error) {
+	if !t.done {
+		return nil, ErrInteractiveTxNotDone
+	}
+
+	inputs := t.Inputs()
+	outputs := t.Outputs()
+
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = t.cfg.Locktime
+	for _, in := range inputs {
+		txIn := wire.NewTxIn(&in.OutPoint, nil, nil)
+		txIn.Sequence = in.Sequence
+		tx.AddTxIn(txIn)
+	}
+	for _, out := range outputs {
+		tx.AddTxOut(out.TxOut)
+	}
+
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, in := range inputs {
+		packet.Inputs[i].WitnessUtxo = in.PrevOut
+		packet.Inputs[i].NonWitnessUtxo = in.PrevTx
+	}
+
+	return packet, nil
+}
+
+// FundingIntent verifies the constructed transaction with a PsbtIntent for
+// a funding output of the given capacity using the passed multisig keys. The
+// returned intent is ready to be finalized with the signed transaction.
+func (t *InteractiveTx) FundingIntent(capacity btcutil.Amount,
+	localKey *keychain.KeyDescriptor, remoteKey *btcec.PublicKey,
+	netParams *chaincfg.Params) (*PsbtIntent, error) {
+
+	packet, err := t.Packet()
+	if err != nil {
+		return nil, err
+	}
+
+	assembler := NewPsbtAssembler(capacity, nil, netParams, false)
+	intent, err := assembler.ProvisionChannel(&Request{
+		LocalAmt: capacity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	psbtIntent, ok := intent.(*PsbtIntent)
+	if !ok {
+		return nil, fmt.Errorf("unexpected intent: %T", intent)
+	}
+
+	psbtIntent.BindKeys(localKey, remoteKey)
+	if err := psbtIntent.Verify(packet, false); err != nil {
+		return nil, err
+	}
+
+	return psbtIntent, nil
+}
@@ -0,0 +1,234 @@
+package chanfunding
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPrevTx creates a transaction with a single output of the given
+// value and script that can be spent by an interactively constructed tx.
+func newTestPrevTx(value int64, pkScript []byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 7}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(value, pkScript))
+
+	return tx
+}
+
+// runInteractiveTx drives the construction between the two parties until
+// both are done.
+func runInteractiveTx(t *testing.T, initiator,
+	responder *InteractiveTx) {
+
+	msg, err := initiator.Start()
+	require.NoError(t, err)
+
+	parties := []*InteractiveTx{responder, initiator}
+	for i := 0; ; i++ {
+		resp, done, err := parties[i%2].ProcessMsg(msg)
+		require.NoError(t, err)
+
+		if resp.IsNone() {
+			require.True(t, done)
+			break
+		}
+
+		msg = resp.UnsafeFromSome()
+	}
+
+	require.True(t, initiator.done)
+	require.True(t, responder.done)
+}
+
+// TestInteractiveTxSplice tests that both parties of a splice end up with the
+// same transaction.
+func TestInteractiveTxSplice(t *testing.T) {
+	t.Parallel()
+
+	chanID := lnwire.ChannelID{1}
+	sharedInput := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+	sharedPrevOut := wire.NewTxOut(int64(chanCapacity), p2wkhScript)
+
+	cfg := InteractiveTxConfig{
+		ChanID:        chanID,
+		Locktime:      100,
+		SharedInput:   fn.Some(sharedInput),
+		SharedPrevOut: sharedPrevOut,
+	}
+	initiatorCfg := cfg
+	initiatorCfg.Initiator = true
+
+	initiator := NewInteractiveTx(initiatorCfg)
+	responder := NewInteractiveTx(cfg)
+
+	// The initiator splices in funds from a wallet input, the responder
+	// adds an input and a change output of its own.
+	err := initiator.AddLocalSharedInput(wire.MaxTxInSequenceNum)
+	require.NoError(t, err)
+	err = initiator.AddLocalInput(
+		newTestPrevTx(100_000, p2wkhScript), 0, wire.MaxTxInSequenceNum,
+	)
+	require.NoError(t, err)
+	initiator.AddLocalOutput(wire.NewTxOut(
+		int64(chanCapacity)+150_000, p2wkhScript,
+	))
+
+	err = responder.AddLocalInput(
+		newTestPrevTx(60_000, p2wkhScript), 0, wire.MaxTxInSequenceNum,
+	)
+	require.NoError(t, err)
+	responder.AddLocalOutput(wire.NewTxOut(9_000, p2wkhScript))
+
+	runInteractiveTx(t, initiator, responder)
+
+	initiatorPacket, err := initiator.Packet()
+	require.NoError(t, err)
+	responderPacket, err := responder.Packet()
+	require.NoError(t, err)
+
+	require.Equal(
+		t, initiatorPacket.UnsignedTx.TxHash(),
+		responderPacket.UnsignedTx.TxHash(),
+	)
+	require.Len(t, initiatorPacket.UnsignedTx.TxIn, 3)
+	require.Len(t, initiatorPacket.UnsignedTx.TxOut, 2)
+	require.EqualValues(t, 100, initiatorPacket.UnsignedTx.LockTime)
+
+	// The shared input was added first by the initiator, so it has the
+	// lowest serial id.
+	require.Equal(
+		t, sharedInput,
+		initiatorPacket.UnsignedTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Equal(t, sharedPrevOut, responderPacket.Inputs[0].WitnessUtxo)
+
+	_, _, err = responder.ProcessMsg(&lnwire.TxComplete{ChanID: chanID})
+	require.ErrorIs(t, err, ErrInteractiveTxDone)
+}
+
+// TestInteractiveTxInvalidMsgs tests that invalid contributions of the remote
+// party are rejected.
+func TestInteractiveTxInvalidMsgs(t *testing.T) {
+	t.Parallel()
+
+	chanID := lnwire.ChannelID{1}
+	sharedInput := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 1}
+
+	newTx := func(initiator bool) *InteractiveTx {
+		return NewInteractiveTx(InteractiveTxConfig{
+			ChanID:      chanID,
+			Initiator:   initiator,
+			SharedInput: fn.Some(sharedInput),
+			SharedPrevOut: wire.NewTxOut(
+				int64(chanCapacity), p2wkhScript,
+			),
+		})
+	}
+
+	prevTx := func(pkScript []byte) []byte {
+		t.Helper()
+
+		var b bytes.Buffer
+		require.NoError(t, newTestPrevTx(1000, pkScript).Serialize(&b))
+
+		return b.Bytes()
+	}
+
+	sharedInputMsg := &lnwire.TxAddInput{
+		ChanID:     chanID,
+		SerialID:   1,
+		PrevTxVout: sharedInput.Index,
+		SharedInputTxid: tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[lnwire.SharedInputTxidType](
+				[32]byte(sharedInput.Hash),
+			),
+		),
+	}
+
+	testCases := []struct {
+		name      string
+		initiator bool
+		msgs      []lnwire.Message
+	}{{
+		name:      "wrong serial id parity",
+		initiator: true,
+		msgs: []lnwire.Message{&lnwire.TxAddOutput{
+			ChanID:   chanID,
+			SerialID: 2,
+			Amount:   1000,
+			PkScript: p2wkhScript,
+		}},
+	}, {
+		name:      "duplicate serial id",
+		initiator: true,
+		msgs: []lnwire.Message{&lnwire.TxAddOutput{
+			ChanID:   chanID,
+			SerialID: 1,
+			Amount:   1000,
+			PkScript: p2wkhScript,
+		}, &lnwire.TxAddOutput{
+			ChanID:   chanID,
+			SerialID: 1,
+			Amount:   1000,
+			PkScript: p2wkhScript,
+		}},
+	}, {
+		name:      "non segwit input",
+		initiator: true,
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChanID:   chanID,
+			SerialID: 1,
+			PrevTx:   prevTx(p2khScript),
+		}},
+	}, {
+		name:      "output index out of range",
+		initiator: true,
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChanID:     chanID,
+			SerialID:   1,
+			PrevTx:     prevTx(p2wkhScript),
+			PrevTxVout: 1,
+		}},
+	}, {
+		name:      "shared input from responder",
+		initiator: true,
+		msgs:      []lnwire.Message{sharedInputMsg},
+	}, {
+		name:      "remove unknown input",
+		initiator: false,
+		msgs: []lnwire.Message{&lnwire.TxRemoveInput{
+			ChanID:   chanID,
+			SerialID: 4,
+		}},
+	}, {
+		name:      "missing shared input",
+		initiator: false,
+		msgs: []lnwire.Message{&lnwire.TxComplete{
+			ChanID: chanID,
+		}, &lnwire.TxComplete{
+			ChanID: chanID,
+		}},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newTx(tc.initiator)
+
+			var err error
+			for _, msg := range tc.msgs {
+				_, _, err = tx.ProcessMsg(msg)
+				if err != nil {
+					break
+				}
+			}
+			require.Error(t, err)
+		})
+	}
+}
@@ -287,7 +287,23 @@ func NewWalletAssembler(cfg WalletConfig) *WalletAssembler {
 //
 // NOTE: This is a part of the chanfunding.Assembler interface.
 func (w *WalletAssembler) ProvisionChannel(r *Request) (Intent, error) {
-	var intent Intent
+	// The coin selection algorithm requires to know what inputs/outputs
+	// are already present in the funding transaction. Since a channel
+	// funding is always either a P2WSH or P2TR output, we can use just
+	// P2WSH here (both of these output types have the same length).
+	var fundingOutputWeight input.TxWeightEstimator
+	fundingOutputWeight.AddP2WSHOutput()
+
+	return w.provisionChannel(r, fundingOutputWeight)
+}
+
+// provisionChannel performs coin selection for the given request, paying the
+// fees for the passed weight of the funding transaction in addition to the
+// fees for the selected inputs and our change output.
+func (w *WalletAssembler) provisionChannel(r *Request,
+	fundingOutputWeight input.TxWeightEstimator) (*FullIntent, error) {
+
+	var intent *FullIntent
 
 	// We hold the coin select mutex while querying for outputs, and
 	// performing coin selection in order to avoid inadvertent double
@@ -340,15 +356,9 @@ func (w *WalletAssembler) ProvisionChannel(r *Request) (Intent, error) {
 			}
 		}
 
-		// The coin selection algorithm requires to know what
-		// inputs/outputs are already present in the funding
-		// transaction and what a change output would look like. Since
-		// a channel funding is always either a P2WSH or P2TR output,
-		// we can use just P2WSH here (both of these output types have
-		// the same length). And we currently don't support specifying a
-		// change output type, so we always use P2TR.
-		var fundingOutputWeight input.TxWeightEstimator
-		fundingOutputWeight.AddP2WSHOutput()
+		// The coin selection algorithm also requires to know what a
+		// change output would look like. We currently don't support
+		// specifying a change output type, so we always use P2TR.
 		changeType := P2TRChangeAddress
 
 		var (
@@ -130,6 +130,12 @@ var (
 	ErrForceCloseLocalDataLoss = errors.New("cannot force close " +
 		"channel with local data loss")
 
+	// ErrChanNotClean is returned when an operation that requires the
+	// channel to have no HTLCs or pending updates is attempted on a
+	// channel that isn't in a clean state.
+	ErrChanNotClean = errors.New("channel has active htlcs or pending " +
+		"updates")
+
 	// errNoNonce is returned when a nonce is required, but none is found.
 	errNoNonce = errors.New("no nonce found")
 
@@ -206,6 +212,7 @@ func PayDescsFromRemoteLogUpdates(chanID lnwire.ShortChannelID, height uint64,
 					Index:  uint16(i),
 				},
 				BlindingPoint: pd.BlindingPoint,
+				Endorsed:      pd.Endorsed,
 			}
 			pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 			copy(pd.OnionBlob[:], wireMsg.OnionBlob[:])
@@ -345,6 +352,10 @@ type commitment struct {
 	// view.
 	outgoingHTLCIndex map[int32]*PaymentDescriptor
 	incomingHTLCIndex map[int32]*PaymentDescriptor
+
+	// spliceCommits are the versions of this commitment that spend the
+	// funding outputs of the pending splices of the channel.
+	spliceCommits []*spliceCommitment
 }
 
 // locateOutputIndex is a small helper function to locate the output index of a
@@ -533,6 +544,7 @@ func (c *commitment) toDiskCommit(
 			LogIndex:      htlc.LogIndex,
 			Incoming:      false,
 			BlindingPoint: htlc.BlindingPoint,
+			Endorsed:      htlc.Endorsed,
 		}
 		copy(h.OnionBlob[:], htlc.OnionBlob)
 
@@ -558,6 +570,7 @@ func (c *commitment) toDiskCommit(
 			LogIndex:      htlc.LogIndex,
 			Incoming:      true,
 			BlindingPoint: htlc.BlindingPoint,
+			Endorsed:      htlc.Endorsed,
 		}
 		copy(h.OnionBlob[:], htlc.OnionBlob)
 		if whoseCommit.IsLocal() && htlc.sig != nil {
@@ -657,6 +670,7 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate chainfee.SatPerKWeight,
 		theirPkScript:      theirP2WSH,
 		theirWitnessScript: theirWitnessScript,
 		BlindingPoint:      htlc.BlindingPoint,
+		Endorsed:           htlc.Endorsed,
 	}, nil
 }
 
@@ -868,6 +882,17 @@ type LightningChannel struct {
 	// fundingOutput is the funding output (script+value).
 	fundingOutput wire.TxOut
 
+	// pendingSplices are the splices of the channel whose splice
+	// transaction hasn't confirmed yet. Every new commitment is also
+	// created and signed for their funding outputs.
+	pendingSplices []*pendingSplice
+
+	// upgradePending is true if the commitment type of the channel is
+	// being upgraded. The channel stays quiescent until the kickoff
+	// transaction of the upgrade confirms, so its commitments aren't
+	// signed for the new funding output like the ones of a splice.
+	upgradePending bool
+
 	// opts is the set of options that channel was initialized with.
 	opts *channelOpts
 
@@ -991,6 +1016,12 @@ func NewLightningChannel(signer input.Signer,
 		}
 	}
 
+	// Restore the pending splices of the channel, so new commitments are
+	// also created for their funding outputs.
+	if err := lc.restorePendingSplices(); err != nil {
+		return nil, err
+	}
+
 	// With the main channel struct reconstructed, we'll now restore the
 	// commitment state in memory and also the update logs themselves.
 	err = lc.restoreCommitState(&localCommit, &remoteCommit)
@@ -1103,6 +1134,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			LogIndex:              logUpdate.LogIndex,
 			addCommitHeightRemote: commitHeight,
 			BlindingPoint:         wireMsg.BlindingPoint,
+			Endorsed:              wireMsg.Endorsed,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 		copy(pd.OnionBlob[:], wireMsg.OnionBlob[:])
@@ -1301,6 +1333,7 @@ func (lc *LightningChannel) remoteLogUpdateToPayDesc(logUpdate *channeldb.LogUpd
 			LogIndex:             logUpdate.LogIndex,
 			addCommitHeightLocal: commitHeight,
 			BlindingPoint:        wireMsg.BlindingPoint,
+			Endorsed:             wireMsg.Endorsed,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 		copy(pd.OnionBlob, wireMsg.OnionBlob[:])
@@ -2002,7 +2035,9 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		return nil, err
 	}
 
-	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
+	// The CSV delay of their output is the one that was in effect when
+	// this state was signed.
+	theirDelay := uint32(chanState.CommitCsvDelay(lntypes.Remote, stateNum))
 	theirScript, err := CommitScriptToSelf(
 		chanState.ChanType, isRemoteInitiator, keyRing.ToLocalKey,
 		keyRing.RevocationKey, theirDelay, leaseExpiry,
@@ -2023,7 +2058,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	if revokedLog != nil {
 		br, ourAmt, theirAmt, err = createBreachRetribution(
 			revokedLog, spendTx, chanState, keyRing,
-			commitmentSecret, leaseExpiry,
+			commitmentSecret, theirDelay, leaseExpiry,
 		)
 		if err != nil {
 			return nil, err
@@ -2038,7 +2073,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		// are confident that no legacy format is in use.
 		br, ourAmt, theirAmt, err = createBreachRetributionLegacy(
 			revokedLogLegacy, chanState, keyRing, commitmentSecret,
-			ourScript, theirScript, leaseExpiry,
+			ourScript, theirScript, theirDelay, leaseExpiry,
 		)
 		if err != nil {
 			return nil, err
@@ -2153,15 +2188,15 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 }
 
 // createHtlcRetribution is a helper function to construct an HtlcRetribution
-// based on the passed params.
+// based on the passed params. The theirDelay parameter is the CSV delay of the
+// revoked commitment.
 func createHtlcRetribution(chanState *channeldb.OpenChannel,
 	keyRing *CommitmentKeyRing, commitHash chainhash.Hash,
-	commitmentSecret *btcec.PrivateKey, leaseExpiry uint32,
+	commitmentSecret *btcec.PrivateKey, theirDelay, leaseExpiry uint32,
 	htlc *channeldb.HTLCEntry) (HtlcRetribution, error) {
 
 	var emptyRetribution HtlcRetribution
 
-	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
 	isRemoteInitiator := !chanState.IsInitiator
 
 	// We'll generate the original second level witness script now, as
@@ -2247,7 +2282,8 @@ func createHtlcRetribution(chanState *channeldb.OpenChannel,
 func createBreachRetribution(revokedLog *channeldb.RevocationLog,
 	spendTx *wire.MsgTx, chanState *channeldb.OpenChannel,
 	keyRing *CommitmentKeyRing, commitmentSecret *btcec.PrivateKey,
-	leaseExpiry uint32) (*BreachRetribution, int64, int64, error) {
+	theirDelay, leaseExpiry uint32) (*BreachRetribution, int64, int64,
+	error) {
 
 	commitHash := revokedLog.CommitTxHash
 
@@ -2256,7 +2292,7 @@ func createBreachRetribution(revokedLog *channeldb.RevocationLog,
 	for i, htlc := range revokedLog.HTLCEntries {
 		hr, err := createHtlcRetribution(
 			chanState, keyRing, commitHash,
-			commitmentSecret, leaseExpiry, htlc,
+			commitmentSecret, theirDelay, leaseExpiry, htlc,
 		)
 		if err != nil {
 			return nil, 0, 0, err
@@ -2356,7 +2392,8 @@ func createBreachRetributionLegacy(revokedLog *channeldb.ChannelCommitment,
 	chanState *channeldb.OpenChannel, keyRing *CommitmentKeyRing,
 	commitmentSecret *btcec.PrivateKey,
 	ourScript, theirScript input.ScriptDescriptor,
-	leaseExpiry uint32) (*BreachRetribution, int64, int64, error) {
+	theirDelay, leaseExpiry uint32) (*BreachRetribution, int64, int64,
+	error) {
 
 	commitHash := revokedLog.CommitTx.TxHash()
 	ourOutpoint := wire.OutPoint{
@@ -2403,7 +2440,7 @@ func createBreachRetributionLegacy(revokedLog *channeldb.ChannelCommitment,
 		}
 		hr, err := createHtlcRetribution(
 			chanState, keyRing, commitHash,
-			commitmentSecret, leaseExpiry, entry,
+			commitmentSecret, theirDelay, leaseExpiry, entry,
 		)
 		if err != nil {
 			return nil, 0, 0, err
@@ -2622,6 +2659,20 @@ func (lc *LightningChannel) fetchCommitmentView(
 		return nil, err
 	}
 
+	// The same commitment is created for the funding output of every
+	// pending splice, with the balances adjusted by the contributions to
+	// the splice.
+	for _, splice := range lc.pendingSplices {
+		spliceCommit, err := lc.fetchSpliceCommitmentView(
+			splice, c, ourBalance, theirBalance, filteredHTLCView,
+			keyRing,
+		)
+		if err != nil {
+			return nil, err
+		}
+		c.spliceCommits = append(c.spliceCommits, spliceCommit)
+	}
+
 	return c, nil
 }
 
@@ -3116,9 +3167,9 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 // validate this new state. This function is called right before sending the
 // new commitment to the remote party. The commit diff returned contains all
 // information necessary for retransmission.
-func (lc *LightningChannel) createCommitDiff(
-	newCommit *commitment, commitSig lnwire.Sig,
-	htlcSigs []lnwire.Sig) (*channeldb.CommitDiff, error) {
+func (lc *LightningChannel) createCommitDiff(newCommit *commitment,
+	commitSig lnwire.Sig, htlcSigs []lnwire.Sig,
+	spliceSigs lnwire.SpliceSigs) (*channeldb.CommitDiff, error) {
 
 	// First, we need to convert the funding outpoint into the ID that's
 	// used on the wire to identify this channel. We'll use this shortly
@@ -3170,6 +3221,7 @@ func (lc *LightningChannel) createCommitDiff(
 				Expiry:        pd.Timeout,
 				PaymentHash:   pd.RHash,
 				BlindingPoint: pd.BlindingPoint,
+				Endorsed:      pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3241,20 +3293,34 @@ func (lc *LightningChannel) createCommitDiff(
 	// disk.
 	diskCommit := newCommit.toDiskCommit(lntypes.Remote)
 
+	var spliceCommits []channeldb.SpliceCommitment
+	for _, spliceCommit := range newCommit.spliceCommits {
+		diskCommit := spliceCommit.commit.toDiskCommit(lntypes.Remote)
+		fundingOutpoint := spliceCommit.splice.fundingOutpoint
+		spliceCommits = append(
+			spliceCommits, channeldb.SpliceCommitment{
+				FundingOutpoint: fundingOutpoint,
+				Commitment:      *diskCommit,
+			},
+		)
+	}
+
 	return &channeldb.CommitDiff{
 		Commitment: *diskCommit,
 		CommitSig: &lnwire.CommitSig{
 			ChanID: lnwire.NewChanIDFromOutPoint(
 				lc.channelState.FundingOutpoint,
 			),
-			CommitSig: commitSig,
-			HtlcSigs:  htlcSigs,
+			CommitSig:  commitSig,
+			HtlcSigs:   htlcSigs,
+			SpliceSigs: spliceSigs,
 		},
 		LogUpdates:        logUpdates,
 		OpenedCircuitKeys: openCircuitKeys,
 		ClosedCircuitKeys: closedCircuitKeys,
 		AddAcks:           ackAddRefs,
 		SettleFailAcks:    settleFailRefs,
+		SpliceCommitments: spliceCommits,
 	}, nil
 }
 
@@ -3308,6 +3374,7 @@ func (lc *LightningChannel) getUnsignedAckedUpdates() []channeldb.LogUpdate {
 				Expiry:        pd.Timeout,
 				PaymentHash:   pd.RHash,
 				BlindingPoint: pd.BlindingPoint,
+				Endorsed:      pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3616,6 +3683,38 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 			ErrBelowChanReserve)
 	}
 
+	// The same holds for the commitments that spend the funding outputs
+	// of the pending splices, whose balances are adjusted by the
+	// contributions to the splice.
+	for _, splice := range lc.pendingSplices {
+		ourSpliceBalance, err := spliceBalance(
+			ourBalance, splice.localContribution,
+		)
+		if err != nil {
+			return err
+		}
+		theirSpliceBalance, err := spliceBalance(
+			theirBalance, splice.remoteContribution,
+		)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case ourBalance < ourInitialBalance &&
+			ourSpliceBalance < ourReserve:
+
+			return fmt.Errorf("%w: our splice balance below chan "+
+				"reserve", ErrBelowChanReserve)
+
+		case theirBalance < theirInitialBalance &&
+			theirSpliceBalance < theirReserve:
+
+			return fmt.Errorf("%w: their splice balance below "+
+				"chan reserve", ErrBelowChanReserve)
+		}
+	}
+
 	// validateUpdates take a set of updates, and validates them against
 	// the passed channel constraints.
 	validateUpdates := func(updates []*PaymentDescriptor,
@@ -3700,6 +3799,10 @@ type CommitSigs struct {
 	// PartialSig is the musig2 partial signature for taproot commitment
 	// transactions.
 	PartialSig lnwire.OptPartialSigWithNonceTLV
+
+	// SpliceSigs are the signatures for the commitments that spend the
+	// funding outputs of the pending splices of the channel.
+	SpliceSigs lnwire.SpliceSigs
 }
 
 // NewCommitState wraps the various signatures needed to properly
@@ -3886,10 +3989,19 @@ func (lc *LightningChannel) SignNextCommitment() (*NewCommitState, error) {
 		htlcSigs = append(htlcSigs, jobResp.Sig)
 	}
 
+	// The commitments that spend the funding outputs of the pending
+	// splices are signed as well.
+	spliceSigs, err := lc.signSpliceCommitments(keyRing, newCommitView)
+	if err != nil {
+		return nil, err
+	}
+
 	// As we're about to proposer a new commitment state for the remote
 	// party, we'll write this pending state to disk before we exit, so we
 	// can retransmit it if necessary.
-	commitDiff, err := lc.createCommitDiff(newCommitView, sig, htlcSigs)
+	commitDiff, err := lc.createCommitDiff(
+		newCommitView, sig, htlcSigs, spliceSigs,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -3911,6 +4023,7 @@ func (lc *LightningChannel) SignNextCommitment() (*NewCommitState, error) {
 			CommitSig:  sig,
 			HtlcSigs:   htlcSigs,
 			PartialSig: lnwire.MaybePartialSigWithNonce(partialSig),
+			SpliceSigs: spliceSigs,
 		},
 		PendingHTLCs: commitDiff.Commitment.Htlcs,
 	}, nil
@@ -4114,6 +4227,7 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 					CommitSig:  newCommit.CommitSig,
 					HtlcSigs:   newCommit.HtlcSigs,
 					PartialSig: newCommit.PartialSig,
+					SpliceSigs: newCommit.SpliceSigs,
 				}
 
 				updates = append(updates, commitSig)
@@ -4933,6 +5047,15 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSigs *CommitSigs) error {
 		}
 	}
 
+	// The remote party must also have signed our commitments that spend
+	// the funding outputs of the pending splices.
+	err = lc.verifySpliceCommitments(
+		keyRing, localCommitmentView, commitSigs.SpliceSigs,
+	)
+	if err != nil {
+		return err
+	}
+
 	// The signature checks out, so we can now add the new commitment to
 	// our local commitment chain. For regular channels, we can just
 	// serialize the ECDSA sig. For taproot channels, we'll serialize the
@@ -5091,6 +5214,29 @@ func (lc *LightningChannel) PendingLocalUpdateCount() uint64 {
 	return lc.localUpdateLog.logIndex - lastRemoteCommit.ourMessageIndex
 }
 
+// NumPendingUpdates returns the number of updates originated by whoseUpdates
+// that have not been committed to the *tip* of whoseCommit's commitment chain.
+func (lc *LightningChannel) NumPendingUpdates(whoseUpdates lntypes.ChannelParty,
+	whoseCommit lntypes.ChannelParty) uint64 {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	var commitChain *commitmentChain
+	if whoseCommit.IsLocal() {
+		commitChain = lc.localCommitChain
+	} else {
+		commitChain = lc.remoteCommitChain
+	}
+	lastCommit := commitChain.tip()
+
+	if whoseUpdates.IsLocal() {
+		return lc.localUpdateLog.logIndex - lastCommit.ourMessageIndex
+	}
+
+	return lc.remoteUpdateLog.logIndex - lastCommit.theirMessageIndex
+}
+
 // RevokeCurrentCommitment revokes the next lowest unrevoked commitment
 // transaction in the local commitment chain. As a result the edge of our
 // revocation window is extended by one, and the tail of our local commitment
@@ -5128,8 +5274,20 @@ func (lc *LightningChannel) RevokeCurrentCommitment() (*lnwire.RevokeAndAck,
 	// is committed locally.
 	unsignedAckedUpdates := lc.getUnsignedAckedUpdates()
 
+	var spliceCommits []channeldb.SpliceCommitment
+	for _, spliceCommit := range chainTail.spliceCommits {
+		diskCommit := spliceCommit.commit.toDiskCommit(lntypes.Local)
+		fundingOutpoint := spliceCommit.splice.fundingOutpoint
+		spliceCommits = append(
+			spliceCommits, channeldb.SpliceCommitment{
+				FundingOutpoint: fundingOutpoint,
+				Commitment:      *diskCommit,
+			},
+		)
+	}
+
 	finalHtlcs, err := lc.channelState.UpdateCommitment(
-		newCommitment, unsignedAckedUpdates,
+		newCommitment, unsignedAckedUpdates, spliceCommits,
 	)
 	if err != nil {
 		return nil, nil, nil, err
@@ -5313,6 +5471,7 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 				Expiry:        pd.Timeout,
 				PaymentHash:   pd.RHash,
 				BlindingPoint: pd.BlindingPoint,
+				Endorsed:      pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -5368,12 +5527,19 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 	// before the change since the indexes are meant for the current,
 	// revoked remote commitment.
 	ourOutputIndex, theirOutputIndex, err := findOutputIndexesFromRemote(
-		revocation, lc.channelState,
+		revocation, lc.channelState, &lc.channelState.RemoteCommitment,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
+	// The same is done for the revoked commitments that spend the funding
+	// outputs of the pending splices.
+	spliceIndexes, err := lc.spliceOutputIndexes(revocation)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	// Now that we have a new verification nonce from them, we can refresh
 	// our remote musig2 session which allows us to create another state.
 	if lc.channelState.ChanType.IsTaproot() {
@@ -5401,7 +5567,7 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 	// commitment chain.
 	err = lc.channelState.AdvanceCommitChainTail(
 		fwdPkg, localPeerUpdates,
-		ourOutputIndex, theirOutputIndex,
+		ourOutputIndex, theirOutputIndex, spliceIndexes,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -5658,6 +5824,7 @@ func (lc *LightningChannel) htlcAddDescriptor(htlc *lnwire.UpdateAddHTLC,
 		OnionBlob:      htlc.OnionBlob[:],
 		OpenCircuitKey: openKey,
 		BlindingPoint:  htlc.BlindingPoint,
+		Endorsed:       htlc.Endorsed,
 	}
 }
 
@@ -5716,6 +5883,7 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 		HtlcIndex:     lc.remoteUpdateLog.htlcCounter,
 		OnionBlob:     htlc.OnionBlob[:],
 		BlindingPoint: htlc.BlindingPoint,
+		Endorsed:      htlc.Endorsed,
 	}
 
 	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
@@ -6239,10 +6407,13 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 		leaseExpiry = chanState.ThawHeight
 	}
 	isRemoteInitiator := !chanState.IsInitiator
+	remoteChanCfg := commitChanCfg(
+		chanState, lntypes.Remote, remoteCommit.CommitHeight,
+	)
 	htlcResolutions, err := extractHtlcResolutions(
 		chainfee.SatPerKWeight(remoteCommit.FeePerKw), commitType,
 		signer, remoteCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
-		&chanState.RemoteChanCfg, commitSpend.SpendingTx,
+		remoteChanCfg, commitSpend.SpendingTx,
 		chanState.ChanType, isRemoteInitiator, leaseExpiry,
 	)
 	if err != nil {
@@ -7005,6 +7176,21 @@ func (r *OutgoingHtlcResolution) HtlcPoint() wire.OutPoint {
 	return r.ClaimOutpoint
 }
 
+// commitChanCfg returns the channel config of the given party with the CSV
+// delay of its commitment at the given height, which differs from the current
+// one if a dynamic commitment changed it after the commitment was signed.
+func commitChanCfg(chanState *channeldb.OpenChannel,
+	party lntypes.ChannelParty, height uint64) *channeldb.ChannelConfig {
+
+	chanCfg := chanState.RemoteChanCfg
+	if party.IsLocal() {
+		chanCfg = chanState.LocalChanCfg
+	}
+	chanCfg.CsvDelay = chanState.CommitCsvDelay(party, height)
+
+	return &chanCfg
+}
+
 // extractHtlcResolutions creates a series of outgoing HTLC resolutions, and
 // the local key used when generating the HTLC scrips. This function is to be
 // used in two cases: force close, or a unilateral close.
@@ -7185,7 +7371,7 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 	// commitment transaction. We'll need this to find the corresponding
 	// output in the commitment transaction and potentially for creating
 	// the sign descriptor.
-	csvTimeout := uint32(chanState.LocalChanCfg.CsvDelay)
+	csvTimeout := uint32(chanState.CommitCsvDelay(lntypes.Local, stateNum))
 
 	// We use the passed state num to derive our scripts, since in case
 	// this is after recovery, our latest channels state might not be up to
@@ -7293,9 +7479,12 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 	// recovery there is not much we can do with HTLCs, so we'll always
 	// use what we have in our latest state when extracting resolutions.
 	localCommit := chanState.LocalCommitment
+	localChanCfg := commitChanCfg(
+		chanState, lntypes.Local, localCommit.CommitHeight,
+	)
 	htlcResolutions, err := extractHtlcResolutions(
 		chainfee.SatPerKWeight(localCommit.FeePerKw), lntypes.Local,
-		signer, localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
+		signer, localCommit.Htlcs, keyRing, localChanCfg,
 		&chanState.RemoteChanCfg, commitTx, chanState.ChanType,
 		chanState.IsInitiator, leaseExpiry,
 	)
@@ -7325,6 +7514,14 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 // close process.
 type chanCloseOpt struct {
 	musigSession *MusigSession
+
+	// customSequence is an optional sequence number to use for the
+	// funding input of the co-op close transaction.
+	customSequence fn.Option[uint32]
+
+	// customPayer is an optional party that should pay the co-op close
+	// fee in place of the channel initiator.
+	customPayer fn.Option[lntypes.ChannelParty]
 }
 
 // ChanCloseOpt is a closure type that cen be used to modify the set of default
@@ -7345,6 +7542,46 @@ func WithCoopCloseMusigSession(session *MusigSession) ChanCloseOpt {
 	}
 }
 
+// WithCustomCoopSequence can be used to set a custom sequence number on the
+// funding input of the co-op close transaction. This is used by the RBF co-op
+// close flow, where the closer picks the sequence of the transaction.
+func WithCustomCoopSequence(sequence uint32) ChanCloseOpt {
+	return func(opts *chanCloseOpt) {
+		opts.customSequence = fn.Some(sequence)
+	}
+}
+
+// WithCustomPayer can be used to specify the party that pays the co-op close
+// fee. By default, the initiator of the channel pays the full fee.
+//
+// NOTE: Setting a custom payer implies the RBF co-op close flow, in which the
+// closing transaction may be signed and completed several times as each side
+// is free to replace it with a higher fee version.
+func WithCustomPayer(payer lntypes.ChannelParty) ChanCloseOpt {
+	return func(opts *chanCloseOpt) {
+		opts.customPayer = fn.Some(payer)
+	}
+}
+
+// closeTxOpts maps the set of co-op close options to the options used to
+// construct the final closing transaction.
+func (c *chanCloseOpt) closeTxOpts(
+	chanType channeldb.ChannelType) []CloseTxOpt {
+
+	var closeTxOpts []CloseTxOpt
+
+	// If this is a taproot channel, then we use an RBF'able funding input.
+	if chanType.IsTaproot() {
+		closeTxOpts = append(closeTxOpts, WithRBFCloseTx())
+	}
+
+	c.customSequence.WhenSome(func(sequence uint32) {
+		closeTxOpts = append(closeTxOpts, WithCustomSequence(sequence))
+	})
+
+	return closeTxOpts
+}
+
 // CreateCloseProposal is used by both parties in a cooperative channel close
 // workflow to generate proposed close transactions and signatures. This method
 // should only be executed once all pending HTLCs (if any) on the channel have
@@ -7363,39 +7600,36 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee btcutil.Amount,
 	lc.Lock()
 	defer lc.Unlock()
 
-	// If we're already closing the channel, then ignore this request.
-	if lc.isClosed {
-		// TODO(roasbeef): check to ensure no pending payments
-		return nil, nil, 0, ErrChanClosing
-	}
-
 	opts := defaultCloseOpts()
 	for _, optFunc := range closeOpts {
 		optFunc(opts)
 	}
 
+	// If we're already closing the channel, then ignore this request,
+	// unless this is an RBF co-op close in which case new offers are
+	// permitted to replace the prior closing transaction.
+	if lc.isClosed && opts.customPayer.IsNone() {
+		// TODO(roasbeef): check to ensure no pending payments
+		return nil, nil, 0, ErrChanClosing
+	}
+
 	// Get the final balances after subtracting the proposed fee, taking
 	// care not to persist the adjusted balance, as the feeRate may change
 	// during the channel closing process.
 	ourBalance, theirBalance, err := CoopCloseBalance(
 		lc.channelState.ChanType, lc.channelState.IsInitiator,
 		proposedFee, lc.channelState.LocalCommitment,
+		opts.customPayer,
 	)
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
-	var closeTxOpts []CloseTxOpt
-
-	// If this is a taproot channel, then we use an RBF'able funding input.
-	if lc.channelState.ChanType.IsTaproot() {
-		closeTxOpts = append(closeTxOpts, WithRBFCloseTx())
-	}
-
 	closeTx := CreateCooperativeCloseTx(
 		fundingTxIn(lc.channelState), lc.channelState.LocalChanCfg.DustLimit,
 		lc.channelState.RemoteChanCfg.DustLimit, ourBalance, theirBalance,
-		localDeliveryScript, remoteDeliveryScript, closeTxOpts...,
+		localDeliveryScript, remoteDeliveryScript,
+		opts.closeTxOpts(lc.channelState.ChanType)...,
 	)
 
 	// Ensure that the transaction doesn't explicitly violate any
@@ -7447,40 +7681,38 @@ func (lc *LightningChannel) CompleteCooperativeClose(
 	lc.Lock()
 	defer lc.Unlock()
 
-	// If the channel is already closing, then ignore this request.
-	if lc.isClosed {
-		// TODO(roasbeef): check to ensure no pending payments
-		return nil, 0, ErrChanClosing
-	}
-
 	opts := defaultCloseOpts()
 	for _, optFunc := range closeOpts {
 		optFunc(opts)
 	}
 
+	// If the channel is already closing, then ignore this request, unless
+	// this is an RBF co-op close which may replace a prior closing
+	// transaction.
+	if lc.isClosed && opts.customPayer.IsNone() {
+		// TODO(roasbeef): check to ensure no pending payments
+		return nil, 0, ErrChanClosing
+	}
+
 	// Get the final balances after subtracting the proposed fee.
 	ourBalance, theirBalance, err := CoopCloseBalance(
 		lc.channelState.ChanType, lc.channelState.IsInitiator,
 		proposedFee, lc.channelState.LocalCommitment,
+		opts.customPayer,
 	)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	var closeTxOpts []CloseTxOpt
-
-	// If this is a taproot channel, then we use an RBF'able funding input.
-	if lc.channelState.ChanType.IsTaproot() {
-		closeTxOpts = append(closeTxOpts, WithRBFCloseTx())
-	}
-
 	// Create the transaction used to return the current settled balance
-	// on this active channel back to both parties. In this current model,
-	// the initiator pays full fees for the cooperative close transaction.
+	// on this active channel back to both parties. Unless a custom payer
+	// was specified, the initiator pays full fees for the cooperative
+	// close transaction.
 	closeTx := CreateCooperativeCloseTx(
 		fundingTxIn(lc.channelState), lc.channelState.LocalChanCfg.DustLimit,
 		lc.channelState.RemoteChanCfg.DustLimit, ourBalance, theirBalance,
-		localDeliveryScript, remoteDeliveryScript, closeTxOpts...,
+		localDeliveryScript, remoteDeliveryScript,
+		opts.closeTxOpts(lc.channelState.ChanType)...,
 	)
 
 	// Ensure that the transaction doesn't explicitly validate any
@@ -7860,6 +8092,23 @@ func (lc *LightningChannel) availableCommitmentBalance(view *htlcView,
 		ourBalance = 0
 	}
 
+	// Funds that are taken out of the channel by a pending splice can't
+	// be spent either.
+	for _, splice := range lc.pendingSplices {
+		if splice.localContribution >= 0 {
+			continue
+		}
+
+		spliceOut := lnwire.NewMSatFromSatoshis(
+			-splice.localContribution,
+		)
+		if spliceOut <= ourBalance {
+			ourBalance -= spliceOut
+		} else {
+			ourBalance = 0
+		}
+	}
+
 	// Calculate the commitment fee in the case where we would add another
 	// HTLC to the commitment, as only the balance remaining after this fee
 	// has been paid is actually available for sending.
@@ -8177,6 +8426,10 @@ type closeTxOpts struct {
 	// enableRBF indicates whether the cooperative close tx should signal
 	// RBF or not.
 	enableRBF bool
+
+	// customSequence is an optional sequence number that overrides the
+	// default sequence of the funding input.
+	customSequence fn.Option[uint32]
 }
 
 // defaultCloseTxOpts returns a closeTxOpts struct with default values.
@@ -8197,6 +8450,14 @@ func WithRBFCloseTx() CloseTxOpt {
 	}
 }
 
+// WithCustomSequence sets a custom sequence number on the funding input of
+// the cooperative close tx. This takes precedence over WithRBFCloseTx.
+func WithCustomSequence(sequence uint32) CloseTxOpt {
+	return func(o *closeTxOpts) {
+		o.customSequence = fn.Some(sequence)
+	}
+}
+
 // CreateCooperativeCloseTx creates a transaction which if signed by both
 // parties, then broadcast cooperatively closes an active channel. The creation
 // of the closure transaction is modified by a boolean indicating if the party
@@ -8218,6 +8479,9 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 	if opts.enableRBF {
 		fundingTxIn.Sequence = mempool.MaxRBFSequence
 	}
+	opts.customSequence.WhenSome(func(sequence uint32) {
+		fundingTxIn.Sequence = sequence
+	})
 
 	// Construct the transaction to perform a cooperative closure of the
 	// channel. In the event that one side doesn't have any settled funds
@@ -8228,13 +8492,13 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 
 	// Create both cooperative closure outputs, properly respecting the
 	// dust limits of both parties.
-	if ourBalance >= localDust {
+	if !coopCloseOutputDust(ourBalance, localDust) {
 		closeTx.AddTxOut(&wire.TxOut{
 			PkScript: ourDeliveryScript,
 			Value:    int64(ourBalance),
 		})
 	}
-	if theirBalance >= remoteDust {
+	if !coopCloseOutputDust(theirBalance, remoteDust) {
 		closeTx.AddTxOut(&wire.TxOut{
 			PkScript: theirDeliveryScript,
 			Value:    int64(theirBalance),
@@ -8246,6 +8510,42 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 	return closeTx
 }
 
+// coopCloseOutputDust returns true if an output of a co-op close transaction
+// with the passed value is omitted for being below the passed dust limit.
+func coopCloseOutputDust(balance, dustLimit btcutil.Amount) bool {
+	return balance < dustLimit
+}
+
+// CoopCloseDust returns whether the local and the remote output of a co-op
+// close transaction that pays the passed fee are omitted for being dust. The
+// fee is deducted from the balance of the passed party, as done in the RBF
+// co-op close flow, so this reflects the outputs of the transaction that
+// CreateCloseProposal creates with the same payer.
+func (lc *LightningChannel) CoopCloseDust(proposedFee btcutil.Amount,
+	payer lntypes.ChannelParty) (bool, bool, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	ourBalance, theirBalance, err := CoopCloseBalance(
+		chanState.ChanType, chanState.IsInitiator, proposedFee,
+		chanState.LocalCommitment, fn.Some(payer),
+	)
+	if err != nil {
+		return false, false, err
+	}
+
+	localDust := coopCloseOutputDust(
+		ourBalance, chanState.LocalChanCfg.DustLimit,
+	)
+	remoteDust := coopCloseOutputDust(
+		theirBalance, chanState.RemoteChanCfg.DustLimit,
+	)
+
+	return localDust, remoteDust, nil
+}
+
 // LocalBalanceDust returns true if when creating a co-op close transaction,
 // the balance of the local party will be dust after accounting for any anchor
 // outputs.
@@ -8522,6 +8822,25 @@ func (lc *LightningChannel) MarkShutdownSent(
 	return lc.channelState.MarkShutdownSent(info)
 }
 
+// ApplyDynCommitment replaces the channel constraints of both parties with the
+// ones that were agreed upon during a dynamic commitment negotiation. As the
+// new constraints only govern future commitment states, they can only be
+// applied while the channel is clean.
+func (lc *LightningChannel) ApplyDynCommitment(localConstraints,
+	remoteConstraints channeldb.ChannelConstraints) error {
+
+	if !lc.IsChannelClean() {
+		return ErrChanNotClean
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	return lc.channelState.ApplyDynCommitment(
+		localConstraints, remoteConstraints,
+	)
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -8541,6 +8860,33 @@ func (lc *LightningChannel) ActiveHtlcs() []channeldb.HTLC {
 	return lc.channelState.ActiveHtlcs()
 }
 
+// UnendorsedOutgoingHtlcs returns the number and the total amount of the
+// htlcs that we offered without the experimental endorsement signal, and that
+// haven't been fully removed from the channel yet. This includes htlcs that
+// aren't committed to either commitment yet.
+func (lc *LightningChannel) UnendorsedOutgoingHtlcs() (int,
+	lnwire.MilliSatoshi) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	var (
+		numHtlcs int
+		amt      lnwire.MilliSatoshi
+	)
+	for _, e := range lc.localUpdateLog.htlcIndex {
+		htlc := e.Value.(*PaymentDescriptor)
+		if htlc.Endorsed {
+			continue
+		}
+
+		numHtlcs++
+		amt += htlc.Amount
+	}
+
+	return numHtlcs, amt
+}
+
 // LocalChanReserve returns our local ChanReserve requirement for the remote party.
 func (lc *LightningChannel) LocalChanReserve() btcutil.Amount {
 	return lc.channelState.LocalChanCfg.ChanReserve
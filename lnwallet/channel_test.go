@@ -16,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
@@ -800,6 +801,90 @@ func testCoopClose(t *testing.T, testCase *coopCloseTestCase) {
 	}
 }
 
+// TestCooperativeCloseCustomPayer tests that the non-initiator of a channel
+// is able to pay the co-op close fee when a custom payer is set, as done in
+// the RBF co-op close flow. It also ensures that the closing transaction can
+// be replaced by a new version once it has been completed.
+func TestCooperativeCloseCustomPayer(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	aliceDeliveryScript := bobsPrivKey[:]
+	bobDeliveryScript := testHdSeed[:]
+
+	const sequence = mempool.MaxRBFSequence
+
+	// closeWithFee has Bob, who isn't the initiator of the channel, sign
+	// and complete a co-op close that pays the passed fee.
+	closeWithFee := func(fee btcutil.Amount) *wire.MsgTx {
+		bobSig, _, _, err := bobChannel.CreateCloseProposal(
+			fee, bobDeliveryScript, aliceDeliveryScript,
+			WithCustomPayer(lntypes.Local),
+			WithCustomCoopSequence(sequence),
+		)
+		require.NoError(t, err)
+
+		aliceSig, _, _, err := aliceChannel.CreateCloseProposal(
+			fee, aliceDeliveryScript, bobDeliveryScript,
+			WithCustomPayer(lntypes.Remote),
+			WithCustomCoopSequence(sequence),
+		)
+		require.NoError(t, err)
+
+		closeTx, bobBalance, err := bobChannel.CompleteCooperativeClose(
+			bobSig, aliceSig, bobDeliveryScript,
+			aliceDeliveryScript, fee,
+			WithCustomPayer(lntypes.Local),
+			WithCustomCoopSequence(sequence),
+		)
+		require.NoError(t, err)
+
+		aliceCloseTx, aliceBalance, err :=
+			aliceChannel.CompleteCooperativeClose(
+				aliceSig, bobSig, aliceDeliveryScript,
+				bobDeliveryScript, fee,
+				WithCustomPayer(lntypes.Remote),
+				WithCustomCoopSequence(sequence),
+			)
+		require.NoError(t, err)
+
+		require.Equal(t, closeTx.TxHash(), aliceCloseTx.TxHash())
+		require.EqualValues(t, sequence, closeTx.TxIn[0].Sequence)
+
+		// Bob pays the full fee, while Alice, the initiator, receives
+		// her balance along with the commitment fee.
+		localCommit := aliceChannel.channelState.LocalCommitment
+		require.Equal(
+			t, localCommit.LocalBalance.ToSatoshis()+
+				localCommit.CommitFee, aliceBalance,
+		)
+		require.Equal(
+			t, localCommit.RemoteBalance.ToSatoshis()-fee,
+			bobBalance,
+		)
+
+		return closeTx
+	}
+
+	closeTx := closeWithFee(1000)
+
+	// As this is an RBF co-op close, we should be able to sign a
+	// replacement transaction that pays a higher fee.
+	replacementTx := closeWithFee(2000)
+	require.NotEqual(t, closeTx.TxHash(), replacementTx.TxHash())
+
+	// A legacy co-op close should be rejected now that the channel is
+	// closing.
+	_, _, _, err = bobChannel.CreateCloseProposal(
+		1000, bobDeliveryScript, aliceDeliveryScript,
+	)
+	require.ErrorIs(t, err, ErrChanClosing)
+}
+
 // TestForceClose checks that the resulting ForceCloseSummary is correct when a
 // peer is ForceClosing the channel. Will check outputs both above and below
 // the dust limit. Additionally, we'll ensure that the node which executed the
@@ -1693,6 +1778,55 @@ func TestChannelBalanceDustLimit(t *testing.T) {
 	}
 }
 
+// TestCoopCloseDust tests that the outputs of a co-op close transaction are
+// reported as dust once the fee deducted from the payer's balance pushes them
+// below the dust limit.
+func TestCoopCloseDust(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	chanState := aliceChannel.channelState
+	aliceBalance, bobBalance, err := CoopCloseBalance(
+		chanState.ChanType, chanState.IsInitiator, 0,
+		chanState.LocalCommitment, fn.Some(lntypes.Local),
+	)
+	require.NoError(t, err)
+
+	// If Alice pays a fee that leaves her output exactly at her dust
+	// limit, then it's kept.
+	dustLimit := chanState.LocalChanCfg.DustLimit
+	fee := aliceBalance - dustLimit
+	localDust, remoteDust, err := aliceChannel.CoopCloseDust(
+		fee, lntypes.Local,
+	)
+	require.NoError(t, err)
+	require.False(t, localDust)
+	require.False(t, remoteDust)
+
+	// A single satoshi more pushes her output below the dust limit, while
+	// Bob's output isn't affected.
+	localDust, remoteDust, err = aliceChannel.CoopCloseDust(
+		fee+1, lntypes.Local,
+	)
+	require.NoError(t, err)
+	require.True(t, localDust)
+	require.False(t, remoteDust)
+
+	// If Bob pays a fee that pushes his output below his dust limit, then
+	// only his output is omitted.
+	bobFee := bobBalance - chanState.RemoteChanCfg.DustLimit + 1
+	localDust, remoteDust, err = aliceChannel.CoopCloseDust(
+		bobFee, lntypes.Remote,
+	)
+	require.NoError(t, err)
+	require.False(t, localDust)
+	require.True(t, remoteDust)
+}
+
 func TestStateUpdatePersistence(t *testing.T) {
 	t.Parallel()
 
@@ -9708,6 +9842,80 @@ func TestIsChannelClean(t *testing.T) {
 	assertCleanOrDirty(true, aliceChannel, bobChannel, t)
 }
 
+// TestNumPendingUpdates tests that the number of pending updates of each party
+// is tracked for both commitments throughout a state transition.
+func TestNumPendingUpdates(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.ZeroHtlcTxFeeBit,
+	)
+	require.NoError(t, err)
+
+	assertPending := func(c *LightningChannel, whoseUpdates,
+		whoseCommit lntypes.ChannelParty, expected uint64) {
+
+		t.Helper()
+
+		require.Equal(
+			t, expected, c.NumPendingUpdates(
+				whoseUpdates, whoseCommit,
+			),
+		)
+	}
+
+	// ---add--->
+	htlc, _ := createHTLC(0, lnwire.MilliSatoshi(5000000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	// The add is pending on both commitments for both parties.
+	assertPending(aliceChannel, lntypes.Local, lntypes.Local, 1)
+	assertPending(aliceChannel, lntypes.Local, lntypes.Remote, 1)
+	assertPending(bobChannel, lntypes.Remote, lntypes.Local, 1)
+	assertPending(bobChannel, lntypes.Remote, lntypes.Remote, 1)
+
+	// ---sig--->
+	aliceNewCommit, err := aliceChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = bobChannel.ReceiveNewCommitment(aliceNewCommit.CommitSigs)
+	require.NoError(t, err)
+
+	// Once signed, the add is no longer pending on Bob's commitment.
+	assertPending(aliceChannel, lntypes.Local, lntypes.Remote, 0)
+	assertPending(aliceChannel, lntypes.Local, lntypes.Local, 1)
+	assertPending(bobChannel, lntypes.Remote, lntypes.Local, 0)
+	assertPending(bobChannel, lntypes.Remote, lntypes.Remote, 1)
+
+	// <---rev---
+	bobRevocation, _, _, err := bobChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = aliceChannel.ReceiveRevocation(bobRevocation)
+	require.NoError(t, err)
+
+	// <---sig---
+	bobNewCommit, err := bobChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveNewCommitment(bobNewCommit.CommitSigs)
+	require.NoError(t, err)
+
+	// Now that Bob signed Alice's commitment, the add is no longer pending
+	// on either commitment.
+	assertPending(aliceChannel, lntypes.Local, lntypes.Local, 0)
+	assertPending(bobChannel, lntypes.Remote, lntypes.Remote, 0)
+
+	// None of Bob's updates were ever pending.
+	for _, whoseCommit := range []lntypes.ChannelParty{
+		lntypes.Local, lntypes.Remote,
+	} {
+
+		assertPending(aliceChannel, lntypes.Remote, whoseCommit, 0)
+		assertPending(bobChannel, lntypes.Local, whoseCommit, 0)
+	}
+}
+
 // assertCleanOrDirty is a helper function that asserts that both channels are
 // clean if clean is true, and dirty if clean is false.
 func assertCleanOrDirty(clean bool, alice, bob *LightningChannel,
@@ -9940,6 +10148,7 @@ func TestCreateHtlcRetribution(t *testing.T) {
 	leaseExpiry, keyRing, commitHash := deriveDummyRetributionParams(
 		aliceChannel.channelState,
 	)
+	theirDelay := uint32(aliceChannel.channelState.RemoteChanCfg.CsvDelay)
 	htlc := &channeldb.HTLCEntry{
 		Amt:         testAmt,
 		Incoming:    true,
@@ -9949,7 +10158,7 @@ func TestCreateHtlcRetribution(t *testing.T) {
 	// Create the htlc retribution.
 	hr, err := createHtlcRetribution(
 		aliceChannel.channelState, keyRing, commitHash,
-		dummyPrivate, leaseExpiry, htlc,
+		dummyPrivate, theirDelay, leaseExpiry, htlc,
 	)
 	// Expect no error.
 	require.NoError(t, err)
@@ -9997,6 +10206,7 @@ func TestCreateBreachRetribution(t *testing.T) {
 	leaseExpiry, keyRing, commitHash := deriveDummyRetributionParams(
 		aliceChannel.channelState,
 	)
+	theirDelay := uint32(aliceChannel.channelState.RemoteChanCfg.CsvDelay)
 	htlc := &channeldb.HTLCEntry{
 		Amt:         btcutil.Amount(testAmt),
 		Incoming:    true,
@@ -10146,7 +10356,7 @@ func TestCreateBreachRetribution(t *testing.T) {
 			br, our, their, err := createBreachRetribution(
 				tc.revocationLog, tx,
 				aliceChannel.channelState, keyRing,
-				dummyPrivate, leaseExpiry,
+				dummyPrivate, theirDelay, leaseExpiry,
 			)
 
 			// Check the error if expected.
@@ -10186,6 +10396,7 @@ func TestCreateBreachRetributionLegacy(t *testing.T) {
 	leaseExpiry, keyRing, _ := deriveDummyRetributionParams(
 		aliceChannel.channelState,
 	)
+	theirDelay := uint32(aliceChannel.channelState.RemoteChanCfg.CsvDelay)
 
 	// Use the remote commitment as our revocation log.
 	revokedLog := aliceChannel.channelState.RemoteCommitment
@@ -10204,7 +10415,8 @@ func TestCreateBreachRetributionLegacy(t *testing.T) {
 	// Create the breach retribution using the legacy format.
 	br, ourAmt, theirAmt, err := createBreachRetributionLegacy(
 		&revokedLog, aliceChannel.channelState, keyRing,
-		dummyPrivate, ourScript, theirScript, leaseExpiry,
+		dummyPrivate, ourScript, theirScript, theirDelay,
+		leaseExpiry,
 	)
 	require.NoError(t, err)
 
@@ -0,0 +1,71 @@
+package chansplicer
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Channel abstracts away from the core channel state machine by exposing an
+// interface that requires only the methods we need to carry out a splice.
+type Channel interface {
+	// State returns the persistent state of the channel.
+	State() *channeldb.OpenChannel
+
+	// SpliceFundingOutput returns the funding output of the channel for
+	// the given capacity.
+	SpliceFundingOutput(capacity btcutil.Amount) (*wire.TxOut, []byte,
+		error)
+
+	// NewSpliceCommitments creates the commitment transactions of both
+	// parties that spend the given new funding outpoint.
+	NewSpliceCommitments(fundingOutpoint wire.OutPoint,
+		localContribution, remoteContribution btcutil.Amount) (
+		*lnwallet.SpliceCommitments, error)
+
+	// SignSpliceCommitment signs the new commitment of the remote party.
+	SignSpliceCommitment(*lnwallet.SpliceCommitments) (lnwire.Sig, error)
+
+	// VerifySpliceCommitment verifies the remote party's signature for
+	// our new commitment.
+	VerifySpliceCommitment(*lnwallet.SpliceCommitments, lnwire.Sig) error
+
+	// SignSpliceInput signs the input of the splice transaction that
+	// spends the current funding output.
+	SignSpliceInput(spliceTx *wire.MsgTx) (lnwire.Sig, error)
+
+	// VerifySpliceInput verifies the remote party's signature for the
+	// input of the splice transaction that spends the current funding
+	// output.
+	VerifySpliceInput(spliceTx *wire.MsgTx, sig lnwire.Sig) error
+
+	// SpliceInputWitness assembles the witness of the input that spends
+	// the current funding output from the signatures of both parties.
+	SpliceInputWitness(localSig, remoteSig lnwire.Sig) (wire.TxWitness,
+		error)
+
+	// AddPendingSplice persists the given pending splice, and tracks the
+	// commitments that spend its funding output from now on.
+	AddPendingSplice(splice *channeldb.ChannelSplice) error
+
+	// DeletePendingSplices forgets all pending splices of the channel.
+	DeletePendingSplices() error
+}
+
+// Wallet abstracts away from the wallet by exposing an interface that
+// requires only the methods we need to fund and sign our contribution to a
+// splice.
+type Wallet interface {
+	// FundSplice selects the wallet inputs and outputs for a splice of
+	// the given amount, which is negative for a splice-out.
+	FundSplice(amt btcutil.Amount,
+		feeRate chainfee.SatPerKWeight) (*lnwallet.SpliceFunding, error)
+
+	// SignSplice signs and finalizes all inputs of the splice transaction
+	// that belong to the wallet.
+	SignSplice(packet *psbt.Packet) error
+}
@@ -0,0 +1,936 @@
+package chansplicer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// spliceSequence is the sequence of all inputs of a splice
+	// transaction. It signals replaceability, so a splice transaction
+	// that's stuck can be fee bumped.
+	spliceSequence = wire.MaxTxInSequenceNum - 2
+)
+
+var (
+	// ErrInvalidState is returned when a message is processed, or a method
+	// is called, in a state of the splice that doesn't allow for it.
+	ErrInvalidState = errors.New("invalid splice state")
+
+	// ErrSpliceAborted is returned when the remote party aborts the
+	// splice.
+	ErrSpliceAborted = errors.New("splice aborted by remote party")
+
+	// ErrFundingKeyChanged is returned when the remote party uses a
+	// different funding key for the new funding output. The multisig keys
+	// of both parties stay the same across splices.
+	ErrFundingKeyChanged = errors.New("funding key must not change")
+
+	// ErrTxIDMismatch is returned when the remote party sends signatures
+	// for a different transaction than the one that was constructed.
+	ErrTxIDMismatch = errors.New("signatures for unknown transaction")
+
+	// errNoSharedInputSig is returned when the remote party doesn't sign
+	// the shared input of the splice transaction.
+	errNoSharedInputSig = errors.New("missing signature for shared input")
+)
+
+// spliceState is the state of a single splice negotiation.
+type spliceState uint8
+
+const (
+	// stateIdle is the initial state, in which the initiator didn't send
+	// splice_init yet, or the responder didn't receive it yet.
+	stateIdle spliceState = iota
+
+	// stateAwaitSpliceAck is the state of the initiator once it sent
+	// splice_init.
+	stateAwaitSpliceAck
+
+	// stateConstructing is the state in which the splice transaction is
+	// constructed interactively.
+	stateConstructing
+
+	// stateAwaitCommitSig is the state once the splice transaction was
+	// constructed, and we sent our signature for the new commitment of
+	// the remote party.
+	stateAwaitCommitSig
+
+	// stateAwaitTxSigs is the state once both parties signed the new
+	// commitments, and we signed the splice transaction. The party that
+	// signs first sent its tx_signatures, and the other party sends its
+	// own once it received them.
+	stateAwaitTxSigs
+
+	// stateDone is the final state, in which the fully signed splice
+	// transaction was broadcast.
+	stateDone
+)
+
+// String returns a human-readable description of the splice state.
+func (s spliceState) String() string {
+	switch s {
+	case stateIdle:
+		return "Idle"
+	case stateAwaitSpliceAck:
+		return "AwaitSpliceAck"
+	case stateConstructing:
+		return "Constructing"
+	case stateAwaitCommitSig:
+		return "AwaitCommitSig"
+	case stateAwaitTxSigs:
+		return "AwaitTxSigs"
+	case stateDone:
+		return "Done"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(s))
+	}
+}
+
+// Config houses the dependencies of a Splicer.
+type Config struct {
+	// Channel is the channel that is spliced. The channel must be
+	// quiescent during the whole splice negotiation.
+	Channel Channel
+
+	// Wallet funds and signs our contribution to the splice.
+	Wallet Wallet
+
+	// NetParams is the network the channel is on.
+	NetParams *chaincfg.Params
+
+	// BestHeight returns the current height of the chain, which is used
+	// as the locktime of the splice transaction.
+	BestHeight func() (uint32, error)
+
+	// Broadcast publishes the fully signed splice transaction.
+	Broadcast func(*wire.MsgTx) error
+}
+
+// Result houses the outcome of a single step of the splice negotiation.
+type Result struct {
+	// Msgs is the set of messages that should be sent to the remote
+	// party, in order.
+	Msgs []lnwire.Message
+
+	// SpliceTx is set once the splice transaction was fully signed and
+	// broadcast.
+	SpliceTx fn.Option[*wire.MsgTx]
+}
+
+// Splicer is a state machine that negotiates a single splice of a channel
+// with the remote party. The initiator of the splice proposes how much its
+// balance changes with splice_init, after which the splice transaction is
+// constructed interactively. It spends the current funding output with a
+// shared input, and creates the new funding output. Both parties then sign
+// new commitments that spend the new funding output, before they exchange
+// the witnesses of the splice transaction.
+//
+// WARNING: NOT thread-safe.
+type Splicer struct {
+	cfg Config
+
+	// initiator is true if we initiated the splice.
+	initiator bool
+
+	state spliceState
+
+	chanID lnwire.ChannelID
+
+	// funding is the contribution of our wallet to the splice.
+	funding *lnwallet.SpliceFunding
+
+	// localContribution and remoteContribution are the amounts the
+	// channel balances of both parties change by.
+	localContribution  btcutil.Amount
+	remoteContribution btcutil.Amount
+
+	// locktime is the locktime of the splice transaction.
+	locktime uint32
+
+	// itx is the interactive construction of the splice transaction.
+	itx *chanfunding.InteractiveTx
+
+	// intent verifies the constructed splice transaction, and holds it
+	// as a PSBT until it's fully signed.
+	intent *chanfunding.PsbtIntent
+
+	// commits are the new commitments of both parties.
+	commits *lnwallet.SpliceCommitments
+
+	// txSigs is our tx_signatures message, set once we signed the splice
+	// transaction.
+	txSigs *lnwire.TxSignatures
+
+	// sentTxSigs is true once we sent our tx_signatures.
+	sentTxSigs bool
+}
+
+// NewSplicer creates a new Splicer for the given channel. It either
+// initiates a splice with Initiate, or responds to the splice_init message
+// of the remote party.
+func NewSplicer(cfg Config) *Splicer {
+	return &Splicer{
+		cfg: cfg,
+		chanID: lnwire.NewChanIDFromOutPoint(
+			cfg.Channel.State().FundingOutpoint,
+		),
+	}
+}
+
+// Initiate starts a splice that changes our channel balance by amt, which is
+// negative for a splice-out. The splice transaction pays the given fee rate.
+func (s *Splicer) Initiate(amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight) (*Result, error) {
+
+	if s.state != stateIdle {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidState, s.state)
+	}
+
+	// Before we select any coins, make sure the splice leaves both
+	// parties above their reserve.
+	_, err := s.cfg.Channel.NewSpliceCommitments(wire.OutPoint{}, amt, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	locktime, err := s.cfg.BestHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	funding, err := s.cfg.Wallet.FundSplice(amt, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund splice: %w", err)
+	}
+
+	s.initiator = true
+	s.funding = funding
+	s.localContribution = funding.Contribution
+	s.locktime = locktime
+	s.state = stateAwaitSpliceAck
+
+	chanState := s.cfg.Channel.State()
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+
+	chansplicerLog.Infof("ChannelPoint(%v): initiating splice, "+
+		"contribution=%v, fee_rate=%v", chanState.FundingOutpoint,
+		funding.Contribution, feeRate)
+
+	return &Result{
+		Msgs: []lnwire.Message{&lnwire.SpliceInit{
+			ChanID:              s.chanID,
+			FundingContribution: funding.Contribution,
+			FundingFeePerKw:     uint32(feeRate),
+			Locktime:            locktime,
+			FundingPubKey:       localKey,
+		}},
+	}, nil
+}
+
+// ProcessMsg advances the splice negotiation with the given message of the
+// remote party. If an error is returned, the splice should be aborted.
+func (s *Splicer) ProcessMsg(msg lnwire.Message) (*Result, error) {
+	switch msg := msg.(type) {
+	case *lnwire.TxAbort:
+		return nil, fmt.Errorf("%w: %v", ErrSpliceAborted,
+			string(msg.Data))
+
+	case *lnwire.SpliceInit:
+		if s.state != stateIdle || s.initiator {
+			break
+		}
+
+		return s.handleSpliceInit(msg)
+
+	case *lnwire.SpliceAck:
+		if s.state != stateAwaitSpliceAck {
+			break
+		}
+
+		return s.handleSpliceAck(msg)
+
+	case *lnwire.TxAddInput, *lnwire.TxAddOutput, *lnwire.TxRemoveInput,
+		*lnwire.TxRemoveOutput, *lnwire.TxComplete:
+
+		if s.state != stateConstructing {
+			break
+		}
+
+		return s.handleConstructionMsg(msg)
+
+	case *lnwire.CommitSig:
+		if s.state != stateAwaitCommitSig {
+			break
+		}
+
+		return s.handleCommitSig(msg)
+
+	case *lnwire.TxSignatures:
+		if s.state != stateAwaitTxSigs {
+			break
+		}
+
+		return s.handleTxSignatures(msg)
+	}
+
+	return nil, fmt.Errorf("%w: unexpected %v in state %v",
+		ErrInvalidState, msg.MsgType(), s.state)
+}
+
+// handleSpliceInit processes the splice_init message of the initiator.
+func (s *Splicer) handleSpliceInit(msg *lnwire.SpliceInit) (*Result,
+	error) {
+
+	chanState := s.cfg.Channel.State()
+
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+	if !msg.FundingPubKey.IsEqual(remoteKey) {
+		return nil, ErrFundingKeyChanged
+	}
+
+	// Make sure the splice leaves both parties above their reserve. We
+	// don't contribute to splices initiated by the remote party.
+	_, err := s.cfg.Channel.NewSpliceCommitments(
+		wire.OutPoint{}, 0, msg.FundingContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.remoteContribution = msg.FundingContribution
+	s.locktime = msg.Locktime
+
+	if err := s.newInteractiveTx(); err != nil {
+		return nil, err
+	}
+
+	s.state = stateConstructing
+
+	chansplicerLog.Infof("ChannelPoint(%v): accepting splice, "+
+		"remote_contribution=%v, fee_rate=%v",
+		chanState.FundingOutpoint, msg.FundingContribution,
+		chainfee.SatPerKWeight(msg.FundingFeePerKw))
+
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+
+	return &Result{
+		Msgs: []lnwire.Message{&lnwire.SpliceAck{
+			ChanID:        s.chanID,
+			FundingPubKey: localKey,
+		}},
+	}, nil
+}
+
+// handleSpliceAck processes the splice_ack message of the responder, and
+// starts the interactive construction of the splice transaction.
+func (s *Splicer) handleSpliceAck(msg *lnwire.SpliceAck) (*Result, error) {
+	chanState := s.cfg.Channel.State()
+
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+	if !msg.FundingPubKey.IsEqual(remoteKey) {
+		return nil, ErrFundingKeyChanged
+	}
+
+	s.remoteContribution = msg.FundingContribution
+
+	if err := s.newInteractiveTx(); err != nil {
+		return nil, err
+	}
+
+	// As the initiator, we add the shared input and the new funding
+	// output, along with the inputs and outputs of our wallet.
+	if err := s.itx.AddLocalSharedInput(spliceSequence); err != nil {
+		return nil, err
+	}
+	for _, in := range s.funding.Inputs {
+		err := s.itx.AddLocalInput(in.PrevTx, in.Vout, spliceSequence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fundingOutput, _, err := s.cfg.Channel.SpliceFundingOutput(
+		s.newCapacity(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.itx.AddLocalOutput(fundingOutput)
+	for _, out := range s.funding.Outputs {
+		s.itx.AddLocalOutput(out)
+	}
+
+	firstMsg, err := s.itx.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	s.state = stateConstructing
+
+	return &Result{
+		Msgs: []lnwire.Message{firstMsg},
+	}, nil
+}
+
+// newCapacity returns the capacity of the channel after the splice.
+func (s *Splicer) newCapacity() btcutil.Amount {
+	return s.cfg.Channel.State().Capacity + s.localContribution +
+		s.remoteContribution
+}
+
+// newInteractiveTx prepares the interactive construction of the splice
+// transaction, which must spend the current funding output.
+func (s *Splicer) newInteractiveTx() error {
+	chanState := s.cfg.Channel.State()
+
+	fundingOutput, _, err := s.cfg.Channel.SpliceFundingOutput(
+		chanState.Capacity,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.itx = chanfunding.NewInteractiveTx(chanfunding.InteractiveTxConfig{
+		ChanID:        s.chanID,
+		Initiator:     s.initiator,
+		Locktime:      s.locktime,
+		SharedInput:   fn.Some(chanState.FundingOutpoint),
+		SharedPrevOut: fundingOutput,
+	})
+
+	return nil
+}
+
+// handleConstructionMsg processes a message of the interactive construction
+// of the splice transaction. Once the construction is done, we sign the new
+// commitment of the remote party.
+func (s *Splicer) handleConstructionMsg(msg lnwire.Message) (*Result,
+	error) {
+
+	resp, done, err := s.itx.ProcessMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{}
+	resp.WhenSome(func(m lnwire.Message) {
+		res.Msgs = append(res.Msgs, m)
+	})
+
+	if !done {
+		return res, nil
+	}
+
+	commitSig, err := s.signCommitment()
+	if err != nil {
+		return nil, err
+	}
+	res.Msgs = append(res.Msgs, commitSig)
+
+	s.state = stateAwaitCommitSig
+
+	return res, nil
+}
+
+// signCommitment verifies the constructed splice transaction, and signs the
+// new commitment of the remote party that spends its funding output.
+func (s *Splicer) signCommitment() (*lnwire.CommitSig, error) {
+	chanState := s.cfg.Channel.State()
+
+	intent, err := s.itx.FundingIntent(
+		s.newCapacity(), &chanState.LocalChanCfg.MultiSigKey,
+		chanState.RemoteChanCfg.MultiSigKey.PubKey, s.cfg.NetParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid splice tx: %w", err)
+	}
+
+	_, fundingOutput, err := intent.FundingOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	spliceTx := intent.PendingPsbt.UnsignedTx
+	found, index := input.FindScriptOutputIndex(
+		spliceTx, fundingOutput.PkScript,
+	)
+	if !found {
+		return nil, fmt.Errorf("splice tx %v has no funding output",
+			spliceTx.TxHash())
+	}
+
+	commits, err := s.cfg.Channel.NewSpliceCommitments(
+		wire.OutPoint{Hash: spliceTx.TxHash(), Index: index},
+		s.localContribution, s.remoteContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := s.cfg.Channel.SignSpliceCommitment(commits)
+	if err != nil {
+		return nil, err
+	}
+
+	s.intent = intent
+	s.commits = commits
+
+	return &lnwire.CommitSig{
+		ChanID:    s.chanID,
+		CommitSig: sig,
+	}, nil
+}
+
+// handleCommitSig processes the remote party's signature for our new
+// commitment. Once it's persisted along with the pending splice, it's safe
+// to sign the splice transaction. Our signatures are persisted as well, so
+// they can be retransmitted on reconnection, but only sent right away if we
+// sign first.
+func (s *Splicer) handleCommitSig(msg *lnwire.CommitSig) (*Result, error) {
+	if len(msg.HtlcSigs) != 0 {
+		return nil, fmt.Errorf("splice commitment can't have htlcs")
+	}
+
+	err := s.cfg.Channel.VerifySpliceCommitment(s.commits, msg.CommitSig)
+	if err != nil {
+		return nil, err
+	}
+
+	spliceTx := s.intent.PendingPsbt.UnsignedTx
+	err = s.cfg.Channel.AddPendingSplice(
+		s.commits.ChannelSplice(spliceTx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigs, err := s.signSpliceTx()
+	if err != nil {
+		return nil, err
+	}
+
+	var fundingPsbt bytes.Buffer
+	if err := s.intent.PendingPsbt.Serialize(&fundingPsbt); err != nil {
+		return nil, err
+	}
+	err = s.cfg.Channel.State().MarkSpliceSigned(
+		s.commits.FundingOutpoint, fundingPsbt.Bytes(), txSigs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.txSigs = txSigs
+	s.state = stateAwaitTxSigs
+
+	res := &Result{}
+	if !s.signsFirst() {
+		return res, nil
+	}
+
+	res.Msgs = append(res.Msgs, txSigs)
+	s.sentTxSigs = true
+
+	return res, nil
+}
+
+// signsFirst returns true if we need to send our tx_signatures first.
+func (s *Splicer) signsFirst() bool {
+	return SignsFirst(
+		s.cfg.Channel.State(), s.localContribution,
+		s.remoteContribution,
+	)
+}
+
+// SignsFirst returns true if we need to send our tx_signatures for a splice
+// of the given channel first. This is the party that contributes less to the
+// splice, so it can't be tricked into signing away more funds than the other
+// party. If both contribute the same, the party with the lower funding key
+// signs first.
+func SignsFirst(chanState *channeldb.OpenChannel, localContribution,
+	remoteContribution btcutil.Amount) bool {
+
+	if localContribution != remoteContribution {
+		return localContribution < remoteContribution
+	}
+
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+
+	return bytes.Compare(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+	) < 0
+}
+
+// signSpliceTx signs the shared input and our wallet inputs of the splice
+// transaction.
+func (s *Splicer) signSpliceTx() (*lnwire.TxSignatures, error) {
+	packet := s.intent.PendingPsbt
+	spliceTx := packet.UnsignedTx
+
+	sharedSig, err := s.cfg.Channel.SignSpliceInput(spliceTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Our wallet inputs are signed within the packet, from where we
+	// collect their witnesses in the order of their serial ids.
+	var (
+		inputs    = s.itx.Inputs()
+		witnesses []wire.TxWitness
+	)
+	for _, in := range inputs {
+		if !in.Local || in.Shared {
+			continue
+		}
+
+		if len(witnesses) == 0 {
+			err := s.cfg.Wallet.SignSplice(packet)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		witness, err := finalWitness(packet, in.OutPoint)
+		if err != nil {
+			return nil, err
+		}
+		witnesses = append(witnesses, witness)
+	}
+
+	return &lnwire.TxSignatures{
+		ChanID:    s.chanID,
+		TxID:      spliceTx.TxHash(),
+		Witnesses: witnesses,
+		SharedInputSig: tlv.SomeRecordT(
+			tlv.NewRecordT[lnwire.SharedInputSigType](sharedSig),
+		),
+	}, nil
+}
+
+// handleTxSignatures processes the tx_signatures message of the remote party.
+// The fully signed splice transaction is persisted and broadcast, and if we
+// didn't send our signatures yet, we do so now.
+func (s *Splicer) handleTxSignatures(msg *lnwire.TxSignatures) (*Result,
+	error) {
+
+	packet := s.intent.PendingPsbt
+	spliceTx := packet.UnsignedTx
+
+	if msg.TxID != spliceTx.TxHash() {
+		return nil, fmt.Errorf("%w: %v", ErrTxIDMismatch, msg.TxID)
+	}
+
+	finalTx, err := completeSpliceTx(
+		s.cfg.Channel, packet, s.txSigs, msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.intent.FinalizeRawTX(finalTx); err != nil {
+		return nil, err
+	}
+
+	// Persist the signed splice transaction before it's broadcast, and
+	// before we send our signatures, so we can rebroadcast it if needed.
+	err = s.cfg.Channel.State().FinalizeSplice(
+		s.commits.FundingOutpoint, finalTx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cfg.Broadcast(finalTx); err != nil {
+		return nil, err
+	}
+
+	res := &Result{
+		SpliceTx: fn.Some(finalTx),
+	}
+	if !s.sentTxSigs {
+		res.Msgs = append(res.Msgs, s.txSigs)
+		s.sentTxSigs = true
+	}
+
+	s.state = stateDone
+
+	chansplicerLog.Infof("ChannelPoint(%v): broadcast splice tx %v, "+
+		"new capacity=%v", s.cfg.Channel.State().FundingOutpoint,
+		finalTx.TxHash(), s.commits.Capacity)
+
+	return res, nil
+}
+
+// RecoverTxSignatures processes the tx_signatures message of the remote party
+// for a pending splice of the channel whose negotiation didn't complete
+// before a disconnection. This is the case if the remote party retransmits
+// its signatures on reconnection. If they complete the splice transaction,
+// it's persisted and broadcast, and our signatures are returned if we sign
+// second.
+func RecoverTxSignatures(cfg Config, msg *lnwire.TxSignatures) (*Result,
+	error) {
+
+	chanState := cfg.Channel.State()
+	splices, err := chanState.PendingSplices()
+	if err != nil {
+		return nil, err
+	}
+
+	var splice *channeldb.ChannelSplice
+	for _, pending := range splices {
+		if pending.FundingTx.TxHash() == msg.TxID {
+			splice = pending
+		}
+	}
+	if splice == nil {
+		return nil, fmt.Errorf("%w: %v", ErrTxIDMismatch, msg.TxID)
+	}
+	if !splice.IsSigned() {
+		return nil, fmt.Errorf("splice tx %v wasn't signed", msg.TxID)
+	}
+
+	res := &Result{}
+	signsFirst := SignsFirst(
+		chanState, splice.LocalContribution, splice.RemoteContribution,
+	)
+	if !signsFirst {
+		res.Msgs = append(res.Msgs, splice.LocalTxSigs)
+	}
+
+	// If we completed the splice transaction already, the remote party
+	// didn't receive our signatures yet.
+	if splice.IsFinal() {
+		res.SpliceTx = fn.Some(splice.FundingTx)
+		return res, nil
+	}
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(splice.FundingPsbt), false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	finalTx, err := completeSpliceTx(
+		cfg.Channel, packet, splice.LocalTxSigs, msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = chanState.FinalizeSplice(splice.FundingOutpoint, finalTx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Broadcast(finalTx); err != nil {
+		return nil, err
+	}
+	res.SpliceTx = fn.Some(finalTx)
+
+	chansplicerLog.Infof("ChannelPoint(%v): broadcast recovered splice "+
+		"tx %v", chanState.FundingOutpoint, finalTx.TxHash())
+
+	return res, nil
+}
+
+// CanForget returns true if the passed pending splice can be forgotten when
+// it's aborted, as we never sent our signatures for its transaction.
+func CanForget(chanState *channeldb.OpenChannel,
+	splice *channeldb.ChannelSplice) bool {
+
+	switch {
+	case splice.IsFinal():
+		return false
+
+	case !splice.IsSigned():
+		return true
+	}
+
+	return !SignsFirst(
+		chanState, splice.LocalContribution, splice.RemoteContribution,
+	)
+}
+
+// completeSpliceTx verifies the signatures of the remote party for the splice
+// transaction, and adds them to the passed packet, which already holds the
+// witnesses of our inputs. The inputs of the remote party are the ones
+// without a witness, and their witnesses are sent in the order of the inputs.
+// The fully signed splice transaction is returned.
+func completeSpliceTx(channel Channel, packet *psbt.Packet,
+	localTxSigs, msg *lnwire.TxSignatures) (*wire.MsgTx, error) {
+
+	spliceTx := packet.UnsignedTx
+
+	remoteSharedSig, err := msg.SharedInputSig.UnwrapOrErrV(
+		errNoSharedInputSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localSharedSig, err := localTxSigs.SharedInputSig.UnwrapOrErrV(
+		errNoSharedInputSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = channel.VerifySpliceInput(spliceTx, remoteSharedSig)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedWitness, err := channel.SpliceInputWitness(
+		localSharedSig, remoteSharedSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill in the witnesses of the shared input and of the inputs of the
+	// remote party.
+	fundingOutpoint := channel.State().FundingOutpoint
+	remoteWitnesses := msg.Witnesses
+	for i, txIn := range spliceTx.TxIn {
+		in := &packet.Inputs[i]
+
+		switch {
+		case txIn.PreviousOutPoint == fundingOutpoint:
+			err = setFinalWitness(in, sharedWitness)
+
+		case len(in.FinalScriptWitness) == 0:
+			if len(remoteWitnesses) == 0 {
+				return nil, fmt.Errorf("missing witness for "+
+					"input %v", txIn.PreviousOutPoint)
+			}
+
+			err = setFinalWitness(in, remoteWitnesses[0])
+			remoteWitnesses = remoteWitnesses[1:]
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(remoteWitnesses) != 0 {
+		return nil, fmt.Errorf("received %d extra witnesses",
+			len(remoteWitnesses))
+	}
+
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyScripts(finalTx, packet); err != nil {
+		return nil, err
+	}
+
+	return finalTx, nil
+}
+
+// Abort cancels the splice, and returns the tx_abort message that should be
+// sent to the remote party. The returned bool is false if we already sent
+// our signatures for the splice transaction. As the remote party may still
+// broadcast it in that case, its pending splice must be kept.
+func (s *Splicer) Abort(reason error) (*lnwire.TxAbort, bool) {
+	canForget := !s.sentTxSigs
+	if canForget && s.funding != nil {
+		s.funding.Cancel()
+	}
+
+	return &lnwire.TxAbort{
+		ChanID: s.chanID,
+		Data:   lnwire.ErrorData(reason.Error()),
+	}, canForget
+}
+
+// finalWitness returns the final witness of the input of the packet that
+// spends the given outpoint.
+func finalWitness(packet *psbt.Packet,
+	outPoint wire.OutPoint) (wire.TxWitness, error) {
+
+	for i, txIn := range packet.UnsignedTx.TxIn {
+		if txIn.PreviousOutPoint != outPoint {
+			continue
+		}
+
+		witnessBytes := packet.Inputs[i].FinalScriptWitness
+		if len(witnessBytes) == 0 {
+			return nil, fmt.Errorf("input %v not signed", outPoint)
+		}
+
+		r := bytes.NewReader(witnessBytes)
+		numItems, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		witness := make(wire.TxWitness, numItems)
+		for j := range witness {
+			witness[j], err = wire.ReadVarBytes(
+				r, 0, txscript.MaxScriptSize, "witness",
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return witness, nil
+	}
+
+	return nil, fmt.Errorf("unknown input %v", outPoint)
+}
+
+// setFinalWitness sets the final witness of the given packet input.
+func setFinalWitness(in *psbt.PInput, witness wire.TxWitness) error {
+	var b bytes.Buffer
+	if err := psbt.WriteTxWitness(&b, witness); err != nil {
+		return err
+	}
+	in.FinalScriptWitness = b.Bytes()
+
+	return nil
+}
+
+// verifyScripts makes sure all inputs of the fully signed splice transaction
+// are valid spends of the outputs in the packet.
+func verifyScripts(tx *wire.MsgTx, packet *psbt.Packet) error {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range tx.TxIn {
+		prevOuts.AddPrevOut(
+			txIn.PreviousOutPoint, packet.Inputs[i].WitnessUtxo,
+		)
+	}
+	hashCache := txscript.NewTxSigHashes(tx, prevOuts)
+
+	for i := range tx.TxIn {
+		prevOut := packet.Inputs[i].WitnessUtxo
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, tx, i, txscript.StandardVerifyFlags,
+			nil, hashCache, prevOut.Value, prevOuts,
+		)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("invalid witness for input %d: %w",
+				i, err)
+		}
+	}
+
+	return nil
+}
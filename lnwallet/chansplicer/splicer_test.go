@@ -0,0 +1,319 @@
+package chansplicer
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWallet funds a splice-in with a single p2wkh input, and pays the
+// remainder back to its change output.
+type mockWallet struct {
+	privKey  *btcec.PrivateKey
+	pkScript []byte
+	prevTx   *wire.MsgTx
+	change   btcutil.Amount
+}
+
+// newMockWallet creates a mock wallet with a single coin of the given value.
+func newMockWallet(t *testing.T, value, change btcutil.Amount) *mockWallet {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pkHash := btcutil.Hash160(privKey.PubKey().SerializeCompressed())
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pkHash).Script()
+	require.NoError(t, err)
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 3}, nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(int64(value), pkScript))
+
+	return &mockWallet{
+		privKey:  privKey,
+		pkScript: pkScript,
+		prevTx:   prevTx,
+		change:   change,
+	}
+}
+
+// FundSplice returns the single coin of the wallet along with its change
+// output.
+func (m *mockWallet) FundSplice(amt btcutil.Amount,
+	_ chainfee.SatPerKWeight) (*lnwallet.SpliceFunding, error) {
+
+	return &lnwallet.SpliceFunding{
+		Contribution: amt,
+		Inputs: []*lnwallet.SpliceInput{{
+			PrevTx: m.prevTx,
+			Vout:   0,
+		}},
+		Outputs: []*wire.TxOut{
+			wire.NewTxOut(int64(m.change), m.pkScript),
+		},
+	}, nil
+}
+
+// SignSplice signs the input spending the coin of the wallet.
+func (m *mockWallet) SignSplice(packet *psbt.Packet) error {
+	tx := packet.UnsignedTx
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txIn := range tx.TxIn {
+		prevOuts.AddPrevOut(
+			txIn.PreviousOutPoint, packet.Inputs[i].WitnessUtxo,
+		)
+	}
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+
+	for i, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint.Hash != m.prevTx.TxHash() {
+			continue
+		}
+
+		witness, err := txscript.WitnessSignature(
+			tx, sigHashes, i, m.prevTx.TxOut[0].Value, m.pkScript,
+			txscript.SigHashAll, m.privKey, true,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = setFinalWitness(&packet.Inputs[i], witness)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newTestSplicers creates the splicers of both parties of a test channel.
+// The splice transaction broadcast by either party is sent on the returned
+// channel.
+func newTestSplicers(t *testing.T, wallet *mockWallet) (*Splicer, *Splicer,
+	chan *wire.MsgTx) {
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	broadcasts := make(chan *wire.MsgTx, 2)
+	newCfg := func(channel *lnwallet.LightningChannel) Config {
+		return Config{
+			Channel:   channel,
+			Wallet:    wallet,
+			NetParams: &chaincfg.RegressionNetParams,
+			BestHeight: func() (uint32, error) {
+				return 100, nil
+			},
+			Broadcast: func(tx *wire.MsgTx) error {
+				broadcasts <- tx
+				return nil
+			},
+		}
+	}
+
+	return NewSplicer(newCfg(aliceChannel)), NewSplicer(newCfg(bobChannel)),
+		broadcasts
+}
+
+// TestSpliceIn tests that both parties end up with the same fully signed
+// splice transaction, and a pending splice for its new funding output.
+func TestSpliceIn(t *testing.T) {
+	t.Parallel()
+
+	const spliceIn = btcutil.Amount(500_000)
+
+	wallet := newMockWallet(t, 1_000_000, 490_000)
+	alice, bob, broadcasts := newTestSplicers(t, wallet)
+
+	res, err := alice.Initiate(spliceIn, chainfee.FeePerKwFloor)
+	require.NoError(t, err)
+
+	// Deliver the messages between both parties until neither has
+	// anything more to send.
+	parties := []*Splicer{bob, alice}
+	var spliceTxs []*wire.MsgTx
+	for i := 0; len(res.Msgs) > 0; i++ {
+		var nextMsgs []lnwire.Message
+		for _, msg := range res.Msgs {
+			res, err = parties[i%2].ProcessMsg(msg)
+			require.NoError(t, err)
+
+			nextMsgs = append(nextMsgs, res.Msgs...)
+			res.SpliceTx.WhenSome(func(tx *wire.MsgTx) {
+				spliceTxs = append(spliceTxs, tx)
+			})
+		}
+		res.Msgs = nextMsgs
+	}
+
+	require.Equal(t, stateDone, alice.state)
+	require.Equal(t, stateDone, bob.state)
+	require.Len(t, spliceTxs, 2)
+	require.Equal(t, spliceTxs[0].TxHash(), spliceTxs[1].TxHash())
+	require.Equal(t, spliceTxs[0].TxHash(), (<-broadcasts).TxHash())
+	require.Equal(t, spliceTxs[0].TxHash(), (<-broadcasts).TxHash())
+
+	// The splice tx spends the current funding output, and pays the
+	// increased capacity to the new one.
+	aliceState := alice.cfg.Channel.State()
+	spliceTx := spliceTxs[0]
+	require.Len(t, spliceTx.TxIn, 2)
+	require.Len(t, spliceTx.TxOut, 2)
+	require.Equal(
+		t, aliceState.FundingOutpoint,
+		spliceTx.TxIn[0].PreviousOutPoint,
+	)
+	require.EqualValues(t, 100, spliceTx.LockTime)
+
+	// Both parties persisted the pending splice with the signed tx.
+	for _, party := range parties {
+		splices, err := party.cfg.Channel.State().PendingSplices()
+		require.NoError(t, err)
+		require.Len(t, splices, 1)
+
+		splice := splices[0]
+		require.Equal(t, spliceTx.TxHash(), splice.FundingTx.TxHash())
+		require.NotEmpty(t, splice.FundingTx.TxIn[0].Witness)
+		require.Equal(t, aliceState.Capacity+spliceIn, splice.Capacity)
+		require.Equal(
+			t, spliceTx.TxHash(), splice.FundingOutpoint.Hash,
+		)
+	}
+}
+
+// TestSpliceAbort tests that a splice can be aborted by the remote party, and
+// that unexpected messages are rejected.
+func TestSpliceAbort(t *testing.T) {
+	t.Parallel()
+
+	wallet := newMockWallet(t, 1_000_000, 490_000)
+	alice, bob, _ := newTestSplicers(t, wallet)
+
+	// Bob didn't receive splice_init yet, so he can't process a
+	// splice_ack.
+	_, err := bob.ProcessMsg(&lnwire.SpliceAck{
+		ChanID: bob.chanID,
+	})
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	res, err := alice.Initiate(500_000, chainfee.FeePerKwFloor)
+	require.NoError(t, err)
+
+	// A splice_init with a different funding key is rejected.
+	spliceInit := *res.Msgs[0].(*lnwire.SpliceInit)
+	spliceInit.FundingPubKey = wallet.privKey.PubKey()
+	_, err = bob.ProcessMsg(&spliceInit)
+	require.ErrorIs(t, err, ErrFundingKeyChanged)
+
+	_, err = alice.ProcessMsg(&lnwire.TxAbort{ChanID: alice.chanID})
+	require.ErrorIs(t, err, ErrSpliceAborted)
+
+	// As Alice didn't sign the splice tx yet, its pending splice can be
+	// forgotten.
+	txAbort, canForget := alice.Abort(err)
+	require.True(t, canForget)
+	require.Equal(t, alice.chanID, txAbort.ChanID)
+}
+
+// TestSpliceRecoverTxSignatures tests that a splice whose tx_signatures got
+// lost on disconnection is completed by both parties once the signatures are
+// retransmitted from the persisted pending splice.
+func TestSpliceRecoverTxSignatures(t *testing.T) {
+	t.Parallel()
+
+	wallet := newMockWallet(t, 1_000_000, 490_000)
+	alice, bob, broadcasts := newTestSplicers(t, wallet)
+
+	res, err := alice.Initiate(500_000, chainfee.FeePerKwFloor)
+	require.NoError(t, err)
+
+	// Deliver the messages between both parties, but drop the
+	// tx_signatures of the party that signs first, as if the connection
+	// was lost.
+	parties := []*Splicer{bob, alice}
+	var sender *Splicer
+	for i := 0; len(res.Msgs) > 0; i++ {
+		var nextMsgs []lnwire.Message
+		for _, msg := range res.Msgs {
+			res, err = parties[i%2].ProcessMsg(msg)
+			require.NoError(t, err)
+
+			for _, msg := range res.Msgs {
+				if _, ok := msg.(*lnwire.TxSignatures); ok {
+					sender = parties[i%2]
+					continue
+				}
+				nextMsgs = append(nextMsgs, msg)
+			}
+		}
+		res.Msgs = nextMsgs
+	}
+	require.NotNil(t, sender)
+
+	receiver := alice
+	if sender == alice {
+		receiver = bob
+	}
+
+	// Both parties signed the splice tx, but only the party that signs
+	// first may have sent its signatures, so only its pending splice must
+	// be kept if the splice is aborted.
+	pendingSplice := func(s *Splicer) *channeldb.ChannelSplice {
+		splices, err := s.cfg.Channel.State().PendingSplices()
+		require.NoError(t, err)
+		require.Len(t, splices, 1)
+
+		return splices[0]
+	}
+	senderSplice := pendingSplice(sender)
+	require.True(t, senderSplice.IsSigned())
+	require.False(t, senderSplice.IsFinal())
+	require.False(t, CanForget(sender.cfg.Channel.State(), senderSplice))
+
+	receiverSplice := pendingSplice(receiver)
+	require.True(t, receiverSplice.IsSigned())
+	require.True(
+		t, CanForget(receiver.cfg.Channel.State(), receiverSplice),
+	)
+
+	// Signatures for an unknown splice tx are rejected.
+	unknownSigs := *senderSplice.LocalTxSigs
+	unknownSigs.TxID[0] ^= 1
+	_, err = RecoverTxSignatures(receiver.cfg, &unknownSigs)
+	require.ErrorIs(t, err, ErrTxIDMismatch)
+
+	// On reconnection, the sender retransmits its signatures, and the
+	// receiver completes the splice tx and replies with its own.
+	res, err = RecoverTxSignatures(receiver.cfg, senderSplice.LocalTxSigs)
+	require.NoError(t, err)
+	require.Len(t, res.Msgs, 1)
+	spliceTx := res.SpliceTx.UnwrapOrFail(t)
+	require.Equal(t, spliceTx.TxHash(), (<-broadcasts).TxHash())
+
+	receiverSigs, ok := res.Msgs[0].(*lnwire.TxSignatures)
+	require.True(t, ok)
+	res, err = RecoverTxSignatures(sender.cfg, receiverSigs)
+	require.NoError(t, err)
+	require.Empty(t, res.Msgs)
+	require.Equal(t, spliceTx.TxHash(), (<-broadcasts).TxHash())
+
+	for _, party := range parties {
+		splice := pendingSplice(party)
+		require.True(t, splice.IsFinal())
+		require.Equal(t, spliceTx.TxHash(), splice.FundingTx.TxHash())
+		require.False(t, CanForget(party.cfg.Channel.State(), splice))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -623,6 +624,10 @@ type CommitmentBuilder struct {
 	// obfuscator is a 48-bit state hint that's used to obfuscate the
 	// current state number on the commitment transactions.
 	obfuscator [StateHintSize]byte
+
+	// splice is set if the commitment transactions spend the funding
+	// output of a pending splice rather than the one of the channel.
+	splice *pendingSplice
 }
 
 // NewCommitmentBuilder creates a new CommitmentBuilder from chanState.
@@ -638,6 +643,26 @@ func NewCommitmentBuilder(chanState *channeldb.OpenChannel) *CommitmentBuilder {
 	}
 }
 
+// fundingTxIn returns the input of the commitment transactions that spends
+// the funding output.
+func (cb *CommitmentBuilder) fundingTxIn() wire.TxIn {
+	if cb.splice != nil {
+		return *wire.NewTxIn(&cb.splice.fundingOutpoint, nil, nil)
+	}
+
+	return fundingTxIn(cb.chanState)
+}
+
+// capacity returns the value of the funding output the commitment
+// transactions spend.
+func (cb *CommitmentBuilder) capacity() btcutil.Amount {
+	if cb.splice != nil {
+		return cb.splice.capacity
+	}
+
+	return cb.chanState.Capacity
+}
+
 // createStateHintObfuscator derives and assigns the state hint obfuscator for
 // the channel, which is used to encode the commitment height in the sequence
 // number of commitment transaction inputs.
@@ -765,14 +790,14 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	}
 	if whoseCommit.IsLocal() {
 		commitTx, err = CreateCommitTx(
-			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
+			cb.chanState.ChanType, cb.fundingTxIn(), keyRing,
 			&cb.chanState.LocalChanCfg, &cb.chanState.RemoteChanCfg,
 			ourBalance.ToSatoshis(), theirBalance.ToSatoshis(),
 			numHTLCs, cb.chanState.IsInitiator, leaseExpiry,
 		)
 	} else {
 		commitTx, err = CreateCommitTx(
-			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
+			cb.chanState.ChanType, cb.fundingTxIn(), keyRing,
 			&cb.chanState.RemoteChanCfg, &cb.chanState.LocalChanCfg,
 			theirBalance.ToSatoshis(), ourBalance.ToSatoshis(),
 			numHTLCs, !cb.chanState.IsInitiator, leaseExpiry,
@@ -855,11 +880,11 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	for _, txOut := range commitTx.TxOut {
 		totalOut += btcutil.Amount(txOut.Value)
 	}
-	if totalOut+commitFee > cb.chanState.Capacity {
+	if totalOut+commitFee > cb.capacity() {
 		return nil, fmt.Errorf("height=%v, for ChannelPoint(%v) "+
 			"attempts to consume %v while channel capacity is %v",
 			height, cb.chanState.FundingOutpoint,
-			totalOut+commitFee, cb.chanState.Capacity)
+			totalOut+commitFee, cb.capacity())
 	}
 
 	return &unsignedCommitmentTx{
@@ -960,10 +985,15 @@ func CreateCommitTx(chanType channeldb.ChannelType,
 }
 
 // CoopCloseBalance returns the final balances that should be used to create
-// the cooperative close tx, given the channel type and transaction fee.
+// the cooperative close tx, given the channel type and transaction fee. By
+// default the initiator of the channel pays the full co-op close fee. If
+// feePayer is set, then the fee is instead deducted from the balance of the
+// specified party, as done in the RBF co-op close flow where each side pays
+// for its own close offer.
 func CoopCloseBalance(chanType channeldb.ChannelType, isInitiator bool,
-	coopCloseFee btcutil.Amount, localCommit channeldb.ChannelCommitment) (
-	btcutil.Amount, btcutil.Amount, error) {
+	coopCloseFee btcutil.Amount, localCommit channeldb.ChannelCommitment,
+	feePayer fn.Option[lntypes.ChannelParty]) (btcutil.Amount,
+	btcutil.Amount, error) {
 
 	// Get both parties' balances from the latest commitment.
 	ourBalance := localCommit.LocalBalance.ToSatoshis()
@@ -979,21 +1009,29 @@ func CoopCloseBalance(chanType channeldb.ChannelType, isInitiator bool,
 		initiatorDelta += 2 * anchorSize
 	}
 
-	// The initiator will pay the full coop close fee, subtract that value
-	// from their balance.
-	initiatorDelta -= coopCloseFee
-
 	if isInitiator {
 		ourBalance += initiatorDelta
 	} else {
 		theirBalance += initiatorDelta
 	}
 
+	// Unless a custom fee payer was specified, the initiator will pay the
+	// full coop close fee, so we subtract that value from their balance.
+	defaultPayer := lntypes.Remote
+	if isInitiator {
+		defaultPayer = lntypes.Local
+	}
+	if feePayer.UnwrapOr(defaultPayer).IsLocal() {
+		ourBalance -= coopCloseFee
+	} else {
+		theirBalance -= coopCloseFee
+	}
+
 	// During fee negotiation it should always be verified that the
-	// initiator can pay the proposed fee, but we do a sanity check just to
+	// fee payer can pay the proposed fee, but we do a sanity check just to
 	// be sure here.
 	if ourBalance < 0 || theirBalance < 0 {
-		return 0, 0, fmt.Errorf("initiator cannot afford proposed " +
+		return 0, 0, fmt.Errorf("fee payer cannot afford proposed " +
 			"coop close fee")
 	}
 
@@ -1197,17 +1235,16 @@ func addHTLC(commitTx *wire.MsgTx, whoseCommit lntypes.ChannelParty,
 }
 
 // findOutputIndexesFromRemote finds the index of our and their outputs from
-// the remote commitment transaction. It derives the key ring to compute the
-// output scripts and compares them against the outputs inside the commitment
-// to find the match.
+// the passed remote commitment transaction. It derives the key ring to compute
+// the output scripts and compares them against the outputs inside the
+// commitment to find the match.
 func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
-	chanState *channeldb.OpenChannel) (uint32, uint32, error) {
+	chanState *channeldb.OpenChannel,
+	chanCommit *channeldb.ChannelCommitment) (uint32, uint32, error) {
 
 	// Init the output indexes as empty.
 	ourIndex := uint32(channeldb.OutputIndexEmpty)
 	theirIndex := uint32(channeldb.OutputIndexEmpty)
-
-	chanCommit := chanState.RemoteCommitment
 	_, commitmentPoint := btcec.PrivKeyFromBytes(revocationPreimage[:])
 
 	// With the commitment point generated, we can now derive the king ring
@@ -1219,8 +1256,11 @@ func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
 
 	// Since it's remote commitment chain, we'd used the mirrored values.
 	//
-	// We use the remote's channel config for the csv delay.
-	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
+	// We use the remote's channel config for the csv delay that was in
+	// effect when the commitment was signed.
+	theirDelay := uint32(chanState.CommitCsvDelay(
+		lntypes.Remote, chanCommit.CommitHeight,
+	))
 
 	// If we are the initiator of this channel, then it's be false from the
 	// remote's PoV.
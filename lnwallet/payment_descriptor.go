@@ -221,4 +221,8 @@ type PaymentDescriptor struct {
 	// blinded route (ie, not the introduction node) from update_add_htlc's
 	// TLVs.
 	BlindingPoint lnwire.BlindingPointRecord
+
+	// Endorsed indicates that the HTLC carries the experimental
+	// endorsement signal.
+	Endorsed bool
 }
@@ -267,10 +267,11 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	// Used to cut down on verbosity.
 	defaultDust := DustLimitUnknownWitness()
 
+	switch {
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
 	// some funds to us within the first commitment state.
-	if localFundingAmt == 0 {
+	case localFundingAmt == 0:
 		ourBalance = req.PushMSat
 		theirBalance = capacityMSat - feeMSat - req.PushMSat
 		initiator = false
@@ -283,7 +284,23 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 				int64(2*defaultDust),
 			)
 		}
-	} else {
+
+	// If we're contributing to a co-funded channel as the responder, our
+	// initial balance is our contribution, as the initiator still pays
+	// all the initial fees within the commitment transaction.
+	case req.CoFund && capacity != localFundingAmt:
+		ourBalance = localFundingMSat
+		theirBalance = capacityMSat - localFundingMSat - feeMSat
+		initiator = false
+
+		if int64(theirBalance) < 0 {
+			return nil, ErrFunderBalanceDust(
+				int64(commitFee), int64(theirBalance.ToSatoshis()),
+				int64(2*defaultDust),
+			)
+		}
+
+	default:
 		// TODO(roasbeef): need to rework fee structure in general and
 		// also when we "unlock" dual funder within the daemon
 
@@ -344,7 +361,17 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	// If either of the balances are zero at this point, or we have a
 	// non-zero push amt (there's no pushing for dual funder), then this is
 	// a single-funder channel.
-	if ourBalance == 0 || theirBalance == 0 || req.PushMSat != 0 {
+	singleFunder := ourBalance == 0 || theirBalance == 0 ||
+		req.PushMSat != 0
+
+	if singleFunder || req.CoFund {
+		// A channel whose funding transaction is constructed together
+		// with the remote party is co-funded if both contribute to
+		// it, but still has an initiator that pays the fees.
+		if !singleFunder {
+			chanType |= channeldb.DualFunderBit
+		}
+
 		// Both the tweakless type and the anchor type is tweakless,
 		// hence set the bit.
 		if req.CommitType.HasStaticRemoteKey() {
@@ -374,8 +401,8 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 			chanType |= channeldb.NoFundingTxBit
 		}
 	} else {
-		// Otherwise, this is a dual funder channel, and no side is
-		// technically the "initiator"
+		// Otherwise, this is a legacy dual funder channel, and no side
+		// is technically the "initiator"
 		initiator = false
 		chanType |= channeldb.DualFunderBit
 	}
@@ -613,6 +640,57 @@ func (r *ChannelReservation) ProcessPsbt() error {
 	return <-errChan
 }
 
+// InteractiveIntent returns the funding intent of a co-funded channel,
+// whose funding transaction is constructed together with the remote party.
+// The returned bool is false if the channel isn't co-funded.
+func (r *ChannelReservation) InteractiveIntent() (
+	*chanfunding.InteractiveIntent, bool) {
+
+	intent, ok := r.fundingIntent.(*chanfunding.InteractiveIntent)
+	return intent, ok
+}
+
+// ProcessInteractiveTx continues a previously paused funding flow of a
+// co-funded channel. This method can be called once the funding
+// transaction was constructed together with the remote party.
+func (r *ChannelReservation) ProcessInteractiveTx() error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &continueContributionMsg{
+		pendingFundingID: r.reservationID,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// addRemoteFunding adds the contribution of the remote party to the capacity
+// of a co-funded channel we initiated. The initial balance of the remote
+// party is its contribution, as we pay all the fees of the commitment
+// transactions.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (r *ChannelReservation) addRemoteFunding(amt btcutil.Amount) error {
+	chanState := r.partialState
+	if !chanState.IsInitiator {
+		return errors.New("only the initiator adds remote funding")
+	}
+
+	if amt == 0 {
+		return nil
+	}
+
+	remoteBalance := lnwire.NewMSatFromSatoshis(amt)
+
+	chanState.ChanType |= channeldb.DualFunderBit
+	chanState.Capacity += amt
+	chanState.LocalCommitment.RemoteBalance = remoteBalance
+	chanState.RemoteCommitment.RemoteBalance = remoteBalance
+	chanState.InitialRemoteBalance = remoteBalance
+
+	return nil
+}
+
 // RemoteCanceled informs the PSBT funding state machine that the remote peer
 // has canceled the pending reservation, likely due to a timeout.
 func (r *ChannelReservation) RemoteCanceled() {
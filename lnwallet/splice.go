@@ -0,0 +1,967 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/btcutil/txsort"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrSpliceUnsupported is returned when a splice is attempted for a
+	// channel whose type can't be spliced yet.
+	ErrSpliceUnsupported = errors.New("channel type can't be spliced")
+
+	// ErrSpliceBelowReserve is returned when a splice-out would leave
+	// either party with a balance below its channel reserve.
+	ErrSpliceBelowReserve = errors.New("splice leaves balance below " +
+		"channel reserve")
+
+	// ErrInvalidSpliceSig is returned when the remote party's signature
+	// for a splice commitment or for the shared input of a splice
+	// transaction is invalid.
+	ErrInvalidSpliceSig = errors.New("invalid splice signature")
+
+	// ErrSplicePending is returned when a splice is attempted for a
+	// channel that already has a pending splice.
+	ErrSplicePending = errors.New("channel already has a pending splice")
+)
+
+// MinSpliceLockDepth is the minimum number of confirmations of a splice
+// transaction before the channel is moved onto its new funding output and
+// splice_locked is sent. Until then, the old funding output is still watched,
+// so any of its revoked commitments can be punished if the splice transaction
+// is reorged out of the chain.
+const MinSpliceLockDepth = 6
+
+// SpliceLockDepth returns the number of confirmations the splice transaction
+// of the given channel needs before the channel is moved onto its new funding
+// output and splice_locked is sent.
+func SpliceLockDepth(chanState *channeldb.OpenChannel) uint32 {
+	numConfs := uint32(chanState.NumConfsRequired)
+	if numConfs < MinSpliceLockDepth {
+		numConfs = MinSpliceLockDepth
+	}
+
+	return numConfs
+}
+
+// SpliceCommitments holds the commitment transactions of both parties that
+// spend the new funding output created by a splice. They carry over the
+// balances of the current commitments, adjusted by each party's
+// contribution to the splice.
+type SpliceCommitments struct {
+	// FundingOutpoint is the new funding outpoint of the channel.
+	FundingOutpoint wire.OutPoint
+
+	// FundingOutput is the new funding output of the channel.
+	FundingOutput *wire.TxOut
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// LocalContribution and RemoteContribution are the amounts the
+	// channel balances of both parties change by.
+	LocalContribution  btcutil.Amount
+	RemoteContribution btcutil.Amount
+
+	// LocalCommitment is our new commitment. Its signature is set by
+	// VerifySpliceCommitment.
+	LocalCommitment channeldb.ChannelCommitment
+
+	// RemoteCommitment is the new commitment of the remote party.
+	RemoteCommitment channeldb.ChannelCommitment
+
+	// witnessScript is the multisig script of the new funding output.
+	witnessScript []byte
+}
+
+// ChannelSplice returns the splice that is persisted to the database once
+// both parties signed the new commitments.
+func (s *SpliceCommitments) ChannelSplice(
+	spliceTx *wire.MsgTx) *channeldb.ChannelSplice {
+
+	return &channeldb.ChannelSplice{
+		FundingOutpoint:    s.FundingOutpoint,
+		FundingTx:          spliceTx,
+		Capacity:           s.Capacity,
+		LocalContribution:  s.LocalContribution,
+		RemoteContribution: s.RemoteContribution,
+		LocalCommitment:    s.LocalCommitment,
+		RemoteCommitment:   s.RemoteCommitment,
+	}
+}
+
+// pendingSplice is a splice of the channel whose splice transaction hasn't
+// confirmed yet. Until it does, every new commitment of the channel is also
+// created and signed for its funding output.
+type pendingSplice struct {
+	// fundingOutpoint is the funding outpoint created by the splice.
+	fundingOutpoint wire.OutPoint
+
+	// capacity is the capacity of the channel after the splice.
+	capacity btcutil.Amount
+
+	// localContribution and remoteContribution are the amounts the
+	// channel balances of both parties change by.
+	localContribution  btcutil.Amount
+	remoteContribution btcutil.Amount
+
+	// signDesc signs the commitments that spend the funding output.
+	signDesc *input.SignDescriptor
+
+	// commitBuilder creates the commitments that spend the funding
+	// output.
+	commitBuilder *CommitmentBuilder
+}
+
+// newPendingSplice creates the in-memory state of a persisted splice of the
+// given channel.
+func newPendingSplice(chanState *channeldb.OpenChannel,
+	splice *channeldb.ChannelSplice) (*pendingSplice, error) {
+
+	fundingOutput, witnessScript, err := spliceFundingOutput(
+		chanState, splice.Capacity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pendingSplice{
+		fundingOutpoint:    splice.FundingOutpoint,
+		capacity:           splice.Capacity,
+		localContribution:  splice.LocalContribution,
+		remoteContribution: splice.RemoteContribution,
+		signDesc: &input.SignDescriptor{
+			KeyDesc:       chanState.LocalChanCfg.MultiSigKey,
+			WitnessScript: witnessScript,
+			Output:        fundingOutput,
+			HashType:      txscript.SigHashAll,
+			InputIndex:    0,
+		},
+	}
+	p.commitBuilder = NewCommitmentBuilder(chanState)
+	p.commitBuilder.splice = p
+
+	return p, nil
+}
+
+// spliceCommitment is a version of a commitment that spends the funding
+// output of a pending splice.
+type spliceCommitment struct {
+	// splice is the pending splice whose funding output is spent.
+	splice *pendingSplice
+
+	// commit is the commitment that spends the funding output.
+	commit *commitment
+}
+
+// restorePendingSplices loads the pending splices of the channel.
+func (lc *LightningChannel) restorePendingSplices() error {
+	splices, err := lc.channelState.PendingSplices()
+	if err != nil {
+		return err
+	}
+
+	for _, splice := range splices {
+		if splice.IsUpgrade() {
+			lc.upgradePending = true
+			continue
+		}
+
+		p, err := newPendingSplice(lc.channelState, splice)
+		if err != nil {
+			return err
+		}
+		lc.pendingSplices = append(lc.pendingSplices, p)
+	}
+
+	return nil
+}
+
+// AddPendingSplice persists a splice of the channel once both parties signed
+// the commitments that spend its funding output. From then on, every new
+// commitment is also created and signed for the funding output of the splice,
+// until it confirms.
+func (lc *LightningChannel) AddPendingSplice(
+	splice *channeldb.ChannelSplice) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	p, err := newPendingSplice(lc.channelState, splice)
+	if err != nil {
+		return err
+	}
+
+	if err := lc.channelState.AddPendingSplice(splice); err != nil {
+		return err
+	}
+	lc.pendingSplices = append(lc.pendingSplices, p)
+
+	return nil
+}
+
+// DeletePendingSplices removes all pending splices of the channel, which is
+// done once a splice is aborted before we signed its transaction.
+func (lc *LightningChannel) DeletePendingSplices() error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if err := lc.channelState.DeletePendingSplices(); err != nil {
+		return err
+	}
+	lc.pendingSplices = nil
+	lc.upgradePending = false
+
+	return nil
+}
+
+// spliceBalance returns the balance of a party on a commitment that spends the
+// funding output of a pending splice, given its balance on the commitment of
+// the channel and its contribution to the splice.
+func spliceBalance(balance lnwire.MilliSatoshi,
+	contribution btcutil.Amount) (lnwire.MilliSatoshi, error) {
+
+	if contribution >= 0 {
+		return balance + lnwire.NewMSatFromSatoshis(contribution), nil
+	}
+
+	spliceOut := lnwire.NewMSatFromSatoshis(-contribution)
+	if spliceOut > balance {
+		return 0, fmt.Errorf("%w: balance %v can't cover splice-out "+
+			"of %v", ErrSpliceBelowReserve, balance, spliceOut)
+	}
+
+	return balance - spliceOut, nil
+}
+
+// fetchSpliceCommitmentView creates the version of the passed commitment view
+// that spends the funding output of the given pending splice. The passed
+// balances are the ones before the commitment fee is applied.
+func (lc *LightningChannel) fetchSpliceCommitmentView(splice *pendingSplice,
+	c *commitment, ourBalance, theirBalance lnwire.MilliSatoshi,
+	filteredHTLCView *htlcView,
+	keyRing *CommitmentKeyRing) (*spliceCommitment, error) {
+
+	ourBalance, err := spliceBalance(ourBalance, splice.localContribution)
+	if err != nil {
+		return nil, err
+	}
+	theirBalance, err = spliceBalance(
+		theirBalance, splice.remoteContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	commitTx, err := splice.commitBuilder.createUnsignedCommitmentTx(
+		ourBalance, theirBalance, c.whoseCommit, c.feePerKw, c.height,
+		filteredHTLCView, keyRing,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	spliceCommit := &commitment{
+		ourBalance:        commitTx.ourBalance,
+		theirBalance:      commitTx.theirBalance,
+		txn:               commitTx.txn,
+		fee:               commitTx.fee,
+		ourMessageIndex:   c.ourMessageIndex,
+		ourHtlcIndex:      c.ourHtlcIndex,
+		theirMessageIndex: c.theirMessageIndex,
+		theirHtlcIndex:    c.theirHtlcIndex,
+		height:            c.height,
+		feePerKw:          c.feePerKw,
+		dustLimit:         c.dustLimit,
+		whoseCommit:       c.whoseCommit,
+	}
+
+	// The HTLCs are copied once more, as their output indexes differ
+	// from the ones on the commitment of the channel.
+	spliceCommit.outgoingHTLCs = make(
+		[]PaymentDescriptor, len(filteredHTLCView.ourUpdates),
+	)
+	for i, htlc := range filteredHTLCView.ourUpdates {
+		spliceCommit.outgoingHTLCs[i] = *htlc
+	}
+	spliceCommit.incomingHTLCs = make(
+		[]PaymentDescriptor, len(filteredHTLCView.theirUpdates),
+	)
+	for i, htlc := range filteredHTLCView.theirUpdates {
+		spliceCommit.incomingHTLCs[i] = *htlc
+	}
+
+	err = spliceCommit.populateHtlcIndexes(
+		lc.channelState.ChanType, commitTx.cltvs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spliceCommitment{
+		splice: splice,
+		commit: spliceCommit,
+	}, nil
+}
+
+// signSpliceCommitments signs the versions of the passed commitment of the
+// remote party that spend the funding outputs of the pending splices.
+func (lc *LightningChannel) signSpliceCommitments(keyRing *CommitmentKeyRing,
+	commitView *commitment) (lnwire.SpliceSigs, error) {
+
+	chanState := lc.channelState
+
+	var spliceSigs lnwire.SpliceSigs
+	for _, spliceCommit := range commitView.spliceCommits {
+		commitTx := spliceCommit.commit.txn
+
+		// Leased channels can't be spliced, so there's no lease
+		// expiry to account for.
+		sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
+			keyRing, chanState.ChanType, !chanState.IsInitiator, 0,
+			&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
+			spliceCommit.commit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		lc.sigPool.SubmitSignBatch(sigBatch)
+
+		signDesc := *spliceCommit.splice.signDesc
+		signDesc.SigHashes = input.NewTxSigHashesV0Only(commitTx)
+		rawSig, err := lc.Signer.SignOutputRaw(commitTx, &signDesc)
+		if err != nil {
+			close(cancelChan)
+			return nil, err
+		}
+		sig, err := lnwire.NewSigFromSignature(rawSig)
+		if err != nil {
+			close(cancelChan)
+			return nil, err
+		}
+
+		sort.Slice(sigBatch, func(i, j int) bool {
+			return sigBatch[i].OutputIndex < sigBatch[j].OutputIndex
+		})
+
+		htlcSigs := make([]lnwire.Sig, 0, len(sigBatch))
+		for _, htlcSigJob := range sigBatch {
+			jobResp := <-htlcSigJob.Resp
+			if jobResp.Err != nil {
+				close(cancelChan)
+				return nil, jobResp.Err
+			}
+
+			htlcSigs = append(htlcSigs, jobResp.Sig)
+		}
+
+		spliceSigs = append(spliceSigs, lnwire.SpliceSig{
+			FundingTxID: spliceCommit.splice.fundingOutpoint.Hash,
+			CommitSig:   sig,
+			HtlcSigs:    htlcSigs,
+		})
+	}
+
+	return spliceSigs, nil
+}
+
+// verifySpliceCommitments verifies the remote party's signatures for the
+// versions of the passed commitment that spend the funding outputs of the
+// pending splices. The signatures are stored with the commitments if they're
+// valid.
+func (lc *LightningChannel) verifySpliceCommitments(
+	keyRing *CommitmentKeyRing, commitView *commitment,
+	spliceSigs lnwire.SpliceSigs) error {
+
+	chanState := lc.channelState
+
+	for _, spliceCommit := range commitView.spliceCommits {
+		splice := spliceCommit.splice
+
+		var spliceSig *lnwire.SpliceSig
+		for i := range spliceSigs {
+			txid := spliceSigs[i].FundingTxID
+			if txid == splice.fundingOutpoint.Hash {
+				spliceSig = &spliceSigs[i]
+			}
+		}
+		if spliceSig == nil {
+			return fmt.Errorf("%w: missing signature for splice %v",
+				ErrInvalidSpliceSig, splice.fundingOutpoint)
+		}
+
+		err := lc.verifyFundingSig(
+			spliceCommit.commit.txn, 0,
+			splice.signDesc.WitnessScript, int64(splice.capacity),
+			spliceSig.CommitSig,
+		)
+		if err != nil {
+			return err
+		}
+
+		verifyJobs, err := genHtlcSigValidationJobs(
+			spliceCommit.commit, keyRing, spliceSig.HtlcSigs,
+			chanState.ChanType, chanState.IsInitiator, 0,
+			&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
+		)
+		if err != nil {
+			return err
+		}
+
+		cancelChan := make(chan struct{})
+		verifyResps := lc.sigPool.SubmitVerifyBatch(
+			verifyJobs, cancelChan,
+		)
+		for i := 0; i < len(verifyJobs); i++ {
+			htlcErr := <-verifyResps
+			if htlcErr != nil {
+				close(cancelChan)
+
+				return fmt.Errorf("%w: invalid htlc signature "+
+					"for splice %v", ErrInvalidSpliceSig,
+					splice.fundingOutpoint)
+			}
+		}
+
+		spliceCommit.commit.sig = spliceSig.CommitSig.ToSignatureBytes()
+	}
+
+	return nil
+}
+
+// spliceOutputIndexes returns the indexes of the outputs of both parties in
+// the current commitments of the remote party that spend the funding outputs
+// of the pending splices, which are revoked with the passed revocation.
+func (lc *LightningChannel) spliceOutputIndexes(
+	revocation *chainhash.Hash) ([]channeldb.SpliceOutputIndexes, error) {
+
+	if len(lc.pendingSplices) == 0 {
+		return nil, nil
+	}
+
+	splices, err := lc.channelState.PendingSplices()
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]channeldb.SpliceOutputIndexes, 0, len(splices))
+	for _, splice := range splices {
+		ourIndex, theirIndex, err := findOutputIndexesFromRemote(
+			revocation, lc.channelState, &splice.RemoteCommitment,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		indexes = append(indexes, channeldb.SpliceOutputIndexes{
+			FundingOutpoint:  splice.FundingOutpoint,
+			OurOutputIndex:   ourIndex,
+			TheirOutputIndex: theirIndex,
+		})
+	}
+
+	return indexes, nil
+}
+
+// canSplice returns an error if the channel can't be spliced in its current
+// state.
+func (lc *LightningChannel) canSplice() error {
+	chanState := lc.channelState
+
+	switch {
+	// Taproot channels need a fresh musig2 session for the new funding
+	// output, which isn't supported yet.
+	case chanState.ChanType.IsTaproot():
+		return ErrSpliceUnsupported
+
+	// Leased and frozen channels have their funding output constrained by
+	// a contract, so they can't be spliced.
+	case chanState.ChanType.HasLeaseExpiration(),
+		chanState.ChanType.IsFrozen():
+
+		return ErrSpliceUnsupported
+
+	// Only a single splice or upgrade can be pending at a time.
+	case len(lc.pendingSplices) != 0, lc.upgradePending:
+		return ErrSplicePending
+
+	// As the initial commitments of the splice can't carry over any HTLCs,
+	// the channel must be clean.
+	case !lc.IsChannelClean():
+		return ErrChanNotClean
+	}
+
+	return nil
+}
+
+// SpliceFundingOutput returns the funding output of the channel for the given
+// capacity. The multisig keys of both parties stay the same across splices.
+func (lc *LightningChannel) SpliceFundingOutput(
+	capacity btcutil.Amount) (*wire.TxOut, []byte, error) {
+
+	return spliceFundingOutput(lc.channelState, capacity)
+}
+
+// spliceFundingOutput returns the funding output of the given channel for the
+// given capacity, along with its multisig script.
+func spliceFundingOutput(chanState *channeldb.OpenChannel,
+	capacity btcutil.Amount) (*wire.TxOut, []byte, error) {
+
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+	witnessScript, txOut, err := input.GenFundingPkScript(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+		int64(capacity),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txOut, witnessScript, nil
+}
+
+// NewSpliceCommitments creates the commitment transactions of both parties
+// that spend the given new funding outpoint. The balance of each party
+// changes by its contribution to the splice, which is negative for a
+// splice-out. The commitment heights stay the same, so the new commitments
+// replace the current ones once the splice transaction confirms.
+func (lc *LightningChannel) NewSpliceCommitments(fundingOutpoint wire.OutPoint,
+	localContribution, remoteContribution btcutil.Amount) (
+	*SpliceCommitments, error) {
+
+	if err := lc.canSplice(); err != nil {
+		return nil, err
+	}
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	localCommit := chanState.LocalCommitment
+	remoteCommit := chanState.RemoteCommitment
+
+	capacity := chanState.Capacity + localContribution + remoteContribution
+	if capacity <= 0 {
+		return nil, fmt.Errorf("invalid capacity after splice: %v",
+			capacity)
+	}
+
+	// Each party must stay above its reserve if it takes funds out of the
+	// channel.
+	localBalance := localCommit.LocalBalance.ToSatoshis() +
+		localContribution
+	remoteBalance := localCommit.RemoteBalance.ToSatoshis() +
+		remoteContribution
+
+	if localBalance < 0 || (localContribution < 0 &&
+		localBalance < chanState.LocalChanCfg.ChanReserve) {
+
+		return nil, ErrSpliceBelowReserve
+	}
+	if remoteBalance < 0 || (remoteContribution < 0 &&
+		remoteBalance < chanState.RemoteChanCfg.ChanReserve) {
+
+		return nil, ErrSpliceBelowReserve
+	}
+
+	fundingOutput, witnessScript, err := lc.SpliceFundingOutput(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new commitments are created for the current commitment points,
+	// so the revocation of the next state works the same way as before.
+	ourRevPreImage, err := chanState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localCommitPoint := input.ComputeCommitmentPoint(ourRevPreImage[:])
+	remoteCommitPoint := chanState.RemoteCurrentRevocation
+
+	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
+		localBalance, remoteBalance, &chanState.LocalChanCfg,
+		&chanState.RemoteChanCfg, localCommitPoint, remoteCommitPoint,
+		*wire.NewTxIn(&fundingOutpoint, nil, nil), chanState.ChanType,
+		chanState.IsInitiator, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	obfuscator := createStateHintObfuscator(chanState)
+	err = SetStateNumHint(ourCommitTx, localCommit.CommitHeight, obfuscator)
+	if err != nil {
+		return nil, err
+	}
+	err = SetStateNumHint(
+		theirCommitTx, remoteCommit.CommitHeight, obfuscator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without any HTLCs, the commitments are sorted according to BIP 69,
+	// just like the initial commitments of a channel.
+	txsort.InPlaceSort(ourCommitTx)
+	txsort.InPlaceSort(theirCommitTx)
+
+	newLocalCommit := localCommit
+	newLocalCommit.LocalBalance = lnwire.NewMSatFromSatoshis(localBalance)
+	newLocalCommit.RemoteBalance = lnwire.NewMSatFromSatoshis(
+		remoteBalance,
+	)
+	newLocalCommit.CommitTx = ourCommitTx
+	newLocalCommit.CommitSig = nil
+	newLocalCommit.Htlcs = nil
+
+	newRemoteCommit := remoteCommit
+	newRemoteCommit.LocalBalance = newLocalCommit.LocalBalance
+	newRemoteCommit.RemoteBalance = newLocalCommit.RemoteBalance
+	newRemoteCommit.CommitTx = theirCommitTx
+	newRemoteCommit.CommitSig = nil
+	newRemoteCommit.Htlcs = nil
+
+	return &SpliceCommitments{
+		FundingOutpoint:    fundingOutpoint,
+		FundingOutput:      fundingOutput,
+		Capacity:           capacity,
+		LocalContribution:  localContribution,
+		RemoteContribution: remoteContribution,
+		LocalCommitment:    newLocalCommit,
+		RemoteCommitment:   newRemoteCommit,
+		witnessScript:      witnessScript,
+	}, nil
+}
+
+// SignSpliceCommitment signs the new commitment of the remote party.
+func (lc *LightningChannel) SignSpliceCommitment(
+	s *SpliceCommitments) (lnwire.Sig, error) {
+
+	commitTx := s.RemoteCommitment.CommitTx
+	signDesc := &input.SignDescriptor{
+		KeyDesc:       lc.channelState.LocalChanCfg.MultiSigKey,
+		WitnessScript: s.witnessScript,
+		Output:        s.FundingOutput,
+		HashType:      txscript.SigHashAll,
+		SigHashes:     input.NewTxSigHashesV0Only(commitTx),
+		InputIndex:    0,
+	}
+
+	sig, err := lc.Signer.SignOutputRaw(commitTx, signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromSignature(sig)
+}
+
+// VerifySpliceCommitment verifies the remote party's signature for our new
+// commitment, and stores it with the commitment if it's valid.
+func (lc *LightningChannel) VerifySpliceCommitment(s *SpliceCommitments,
+	sig lnwire.Sig) error {
+
+	commitTx := s.LocalCommitment.CommitTx
+	err := lc.verifyFundingSig(
+		commitTx, 0, s.witnessScript, s.FundingOutput.Value, sig,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.LocalCommitment.CommitSig = sig.ToSignatureBytes()
+
+	return nil
+}
+
+// spliceInputIndex returns the index of the input of the splice transaction
+// that spends the current funding output.
+func (lc *LightningChannel) spliceInputIndex(spliceTx *wire.MsgTx) (int,
+	error) {
+
+	for i, txIn := range spliceTx.TxIn {
+		if txIn.PreviousOutPoint == lc.channelState.FundingOutpoint {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("splice tx doesn't spend funding outpoint %v",
+		lc.channelState.FundingOutpoint)
+}
+
+// SignSpliceInput signs the input of the splice transaction that spends the
+// current funding output of the channel.
+func (lc *LightningChannel) SignSpliceInput(
+	spliceTx *wire.MsgTx) (lnwire.Sig, error) {
+
+	inputIndex, err := lc.spliceInputIndex(spliceTx)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	lc.RLock()
+	signDesc := *lc.signDesc
+	lc.RUnlock()
+
+	signDesc.SigHashes = input.NewTxSigHashesV0Only(spliceTx)
+	signDesc.InputIndex = inputIndex
+
+	sig, err := lc.Signer.SignOutputRaw(spliceTx, &signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromSignature(sig)
+}
+
+// VerifySpliceInput verifies the remote party's signature for the input of
+// the splice transaction that spends the current funding output.
+func (lc *LightningChannel) VerifySpliceInput(spliceTx *wire.MsgTx,
+	sig lnwire.Sig) error {
+
+	inputIndex, err := lc.spliceInputIndex(spliceTx)
+	if err != nil {
+		return err
+	}
+
+	return lc.verifyFundingSig(
+		spliceTx, inputIndex, lc.signDesc.WitnessScript,
+		int64(lc.channelState.Capacity), sig,
+	)
+}
+
+// SpliceInputWitness assembles the witness of the input of the splice
+// transaction that spends the current funding output from the signatures of
+// both parties.
+func (lc *LightningChannel) SpliceInputWitness(localSig,
+	remoteSig lnwire.Sig) (wire.TxWitness, error) {
+
+	ourSig, err := localSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+	theirSig, err := remoteSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	localKey := lc.channelState.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+
+	return input.SpendMultiSig(
+		lc.signDesc.WitnessScript, localKey.SerializeCompressed(),
+		ourSig, remoteKey.SerializeCompressed(), theirSig,
+	), nil
+}
+
+// verifyFundingSig verifies the remote party's signature for the given input
+// of tx, which spends a funding output with the given multisig script and
+// value.
+func (lc *LightningChannel) verifyFundingSig(tx *wire.MsgTx, inputIndex int,
+	witnessScript []byte, value int64, sig lnwire.Sig) error {
+
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(
+		witnessScript, value,
+	)
+	hashCache := txscript.NewTxSigHashes(tx, prevFetcher)
+
+	sigHash, err := txscript.CalcWitnessSigHash(
+		witnessScript, hashCache, txscript.SigHashAll, tx, inputIndex,
+		value,
+	)
+	if err != nil {
+		return err
+	}
+
+	remoteSig, err := sig.ToSignature()
+	if err != nil {
+		return err
+	}
+
+	verifyKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+	if !remoteSig.Verify(sigHash, verifyKey) {
+		return ErrInvalidSpliceSig
+	}
+
+	return nil
+}
+
+// SpliceInput is a wallet input that's added to a splice transaction.
+type SpliceInput struct {
+	// PrevTx is the transaction that created the spent output.
+	PrevTx *wire.MsgTx
+
+	// Vout is the index of the spent output within PrevTx.
+	Vout uint32
+}
+
+// SpliceFunding is the contribution of our wallet to a splice transaction.
+type SpliceFunding struct {
+	// Contribution is the amount our channel balance changes by. It's
+	// negative for a splice-out, in which case it includes the fee of the
+	// splice transaction.
+	Contribution btcutil.Amount
+
+	// Inputs are the wallet inputs we add to the splice transaction.
+	Inputs []*SpliceInput
+
+	// Outputs are the outputs paying to our wallet that we add to the
+	// splice transaction.
+	Outputs []*wire.TxOut
+
+	// intent is the funding intent that leased the wallet inputs, if
+	// any.
+	intent *chanfunding.FullIntent
+}
+
+// Cancel releases the wallet inputs of the splice, so they can be used
+// elsewhere.
+func (f *SpliceFunding) Cancel() {
+	if f.intent != nil {
+		f.intent.Cancel()
+	}
+}
+
+// FundSplice selects the wallet inputs and outputs for a splice of the given
+// amount. For a splice-in, the selected inputs pay for the amount that's
+// added to the channel, and for the part of the fee of the splice
+// transaction that's caused by the shared input. For a splice-out, the
+// amount is paid to a new wallet address, and the fee is taken from our
+// channel balance.
+func (l *LightningWallet) FundSplice(amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight) (*SpliceFunding, error) {
+
+	// The shared input spends the current funding output, and the new
+	// funding output replaces it.
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddWitnessInput(input.MultiSigWitnessSize)
+	weightEstimate.AddP2WSHOutput()
+
+	switch {
+	case amt == 0:
+		return nil, fmt.Errorf("splice amount must not be zero")
+
+	case amt < 0:
+		pkScript, err := l.newSpliceOutScript()
+		if err != nil {
+			return nil, err
+		}
+
+		weightEstimate.AddP2TROutput()
+		fee := feeRate.FeeForWeight(weightEstimate.Weight())
+
+		return &SpliceFunding{
+			Contribution: amt - fee,
+			Outputs: []*wire.TxOut{
+				wire.NewTxOut(int64(-amt), pkScript),
+			},
+		}, nil
+	}
+
+	// The wallet assembler accounts for the fee of the inputs it selects,
+	// the change output and a funding output. We add the fee of the
+	// shared input on top of the amount to fund.
+	sharedInputFee := feeRate.FeeForWeight(
+		input.InputSize*blockchain.WitnessScaleFactor +
+			input.MultiSigWitnessSize,
+	)
+
+	assembler := chanfunding.NewWalletAssembler(chanfunding.WalletConfig{
+		CoinSource:       NewCoinSource(l, nil),
+		CoinSelectLocker: l,
+		CoinLeaser:       l,
+		Signer:           l.Cfg.Signer,
+		DustLimit: DustLimitForSize(
+			input.P2WSHSize,
+		),
+		CoinSelectionStrategy: l.Cfg.CoinSelectionStrategy,
+	})
+	intent, err := assembler.ProvisionChannel(&chanfunding.Request{
+		LocalAmt: amt + sharedInputFee,
+		MinConfs: 1,
+		FeeRate:  feeRate,
+		ChangeAddr: func() (btcutil.Address, error) {
+			return l.NewAddress(
+				TaprootPubkey, true, DefaultAccountName,
+			)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fullIntent, ok := intent.(*chanfunding.FullIntent)
+	if !ok {
+		intent.Cancel()
+		return nil, fmt.Errorf("unexpected intent: %T", intent)
+	}
+
+	funding := &SpliceFunding{
+		Contribution: amt,
+		Outputs:      fullIntent.ChangeOutputs,
+		intent:       fullIntent,
+	}
+	for _, coin := range fullIntent.InputCoins {
+		prevTx, err := l.FetchTx(coin.OutPoint.Hash)
+		if err != nil {
+			fullIntent.Cancel()
+			return nil, fmt.Errorf("unable to fetch tx of input "+
+				"%v: %w", coin.OutPoint, err)
+		}
+
+		funding.Inputs = append(funding.Inputs, &SpliceInput{
+			PrevTx: prevTx,
+			Vout:   coin.OutPoint.Index,
+		})
+	}
+
+	return funding, nil
+}
+
+// newSpliceOutScript returns the script of a new wallet address that
+// receives the funds of a splice-out.
+func (l *LightningWallet) newSpliceOutScript() ([]byte, error) {
+	addr, err := l.NewAddress(TaprootPubkey, false, DefaultAccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
+// SignSplice signs and finalizes all inputs of the splice transaction that
+// belong to our wallet. Inputs that aren't ours are left untouched.
+func (l *LightningWallet) SignSplice(packet *psbt.Packet) error {
+	if err := l.DecorateInputs(packet, false); err != nil {
+		return err
+	}
+
+	signedInputs, err := l.SignPsbt(packet)
+	if err != nil {
+		return err
+	}
+
+	for _, idx := range signedInputs {
+		if err := psbt.Finalize(packet, int(idx)); err != nil {
+			return fmt.Errorf("unable to finalize input %d: %w",
+				idx, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpliceCommitments tests that both parties of a splice create the same
+// commitments for the new funding output, and can sign the shared input of
+// the splice transaction.
+func TestSpliceCommitments(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	const spliceIn = btcutil.Amount(500_000)
+
+	// Alice splices in funds from a wallet input. Both parties compute
+	// the new funding output and the commitments that spend it.
+	newCapacity := aliceChannel.channelState.Capacity + spliceIn
+	fundingOutput, _, err := aliceChannel.SpliceFundingOutput(newCapacity)
+	require.NoError(t, err)
+
+	fundingOutpoint := aliceChannel.channelState.FundingOutpoint
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&fundingOutpoint, nil, nil))
+	spliceTx.AddTxIn(wire.NewTxIn(
+		&wire.OutPoint{Hash: chainhash.Hash{1}}, nil, nil,
+	))
+	spliceTx.AddTxOut(fundingOutput)
+	newOutpoint := wire.OutPoint{Hash: spliceTx.TxHash(), Index: 0}
+
+	aliceCommits, err := aliceChannel.NewSpliceCommitments(
+		newOutpoint, spliceIn, 0,
+	)
+	require.NoError(t, err)
+	bobCommits, err := bobChannel.NewSpliceCommitments(
+		newOutpoint, 0, spliceIn,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, newCapacity, aliceCommits.Capacity)
+	require.Equal(t, aliceCommits.FundingOutput, bobCommits.FundingOutput)
+	require.Equal(
+		t, aliceCommits.LocalCommitment.CommitTx.TxHash(),
+		bobCommits.RemoteCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceChannel.channelState.LocalCommitment.LocalBalance+
+			lnwire.NewMSatFromSatoshis(spliceIn),
+		aliceCommits.LocalCommitment.LocalBalance,
+	)
+
+	// Exchange signatures for the new commitments.
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceCommits)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobCommits)
+	require.NoError(t, err)
+
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceCommits, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifySpliceCommitment(
+		bobCommits, aliceSig,
+	))
+	require.NotEmpty(t, aliceCommits.LocalCommitment.CommitSig)
+
+	// A signature for the wrong commitment is rejected.
+	err = aliceChannel.VerifySpliceCommitment(aliceCommits, aliceSig)
+	require.ErrorIs(t, err, ErrInvalidSpliceSig)
+
+	// Finally, both parties sign the shared input, which results in a
+	// valid spend of the current funding output.
+	aliceInputSig, err := aliceChannel.SignSpliceInput(spliceTx)
+	require.NoError(t, err)
+	bobInputSig, err := bobChannel.SignSpliceInput(spliceTx)
+	require.NoError(t, err)
+
+	require.NoError(t, aliceChannel.VerifySpliceInput(
+		spliceTx, bobInputSig,
+	))
+
+	witness, err := aliceChannel.SpliceInputWitness(
+		aliceInputSig, bobInputSig,
+	)
+	require.NoError(t, err)
+	spliceTx.TxIn[0].Witness = witness
+
+	prevOut := aliceChannel.fundingOutput
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, spliceTx, 0, txscript.StandardVerifyFlags,
+		nil, nil, prevOut.Value,
+		txscript.NewCannedPrevOutputFetcher(
+			prevOut.PkScript, prevOut.Value,
+		),
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+}
+
+// TestSpliceOutBelowReserve tests that a splice-out can't take a party's
+// balance below its channel reserve.
+func TestSpliceOutBelowReserve(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	localBalance := aliceChannel.channelState.LocalCommitment.LocalBalance
+	_, err = aliceChannel.NewSpliceCommitments(
+		wire.OutPoint{}, -localBalance.ToSatoshis()+1, 0,
+	)
+	require.ErrorIs(t, err, ErrSpliceBelowReserve)
+}
+
+// TestSpliceCommitmentUpdates tests that the channel can still be updated
+// while a splice is pending, with every new commitment being signed for both
+// funding outputs.
+func TestSpliceCommitmentUpdates(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	const spliceIn = btcutil.Amount(500_000)
+
+	newCapacity := aliceChannel.channelState.Capacity + spliceIn
+	fundingOutput, _, err := aliceChannel.SpliceFundingOutput(newCapacity)
+	require.NoError(t, err)
+
+	fundingOutpoint := aliceChannel.channelState.FundingOutpoint
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&fundingOutpoint, nil, nil))
+	spliceTx.AddTxOut(fundingOutput)
+	newOutpoint := wire.OutPoint{Hash: spliceTx.TxHash(), Index: 0}
+
+	aliceCommits, err := aliceChannel.NewSpliceCommitments(
+		newOutpoint, spliceIn, 0,
+	)
+	require.NoError(t, err)
+	bobCommits, err := bobChannel.NewSpliceCommitments(
+		newOutpoint, 0, spliceIn,
+	)
+	require.NoError(t, err)
+
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceCommits)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobCommits)
+	require.NoError(t, err)
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceCommits, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifySpliceCommitment(
+		bobCommits, aliceSig,
+	))
+
+	require.NoError(t, aliceChannel.AddPendingSplice(
+		aliceCommits.ChannelSplice(spliceTx),
+	))
+	require.NoError(t, bobChannel.AddPendingSplice(
+		bobCommits.ChannelSplice(spliceTx),
+	))
+
+	// Alice sends an HTLC to Bob, and signs the commitments of both
+	// funding outputs.
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(10_000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	aliceNewCommit, err := aliceChannel.SignNextCommitment()
+	require.NoError(t, err)
+	require.Len(t, aliceNewCommit.CommitSigs.SpliceSigs, 1)
+
+	spliceSig := aliceNewCommit.CommitSigs.SpliceSigs[0]
+	require.Equal(t, newOutpoint.Hash, spliceSig.FundingTxID)
+	require.Len(t, spliceSig.HtlcSigs, 1)
+
+	require.NoError(t, bobChannel.ReceiveNewCommitment(
+		aliceNewCommit.CommitSigs,
+	))
+
+	// The rest of the state transition includes the HTLC in the
+	// commitments of both funding outputs.
+	bobRevocation, _, _, err := bobChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = aliceChannel.ReceiveRevocation(bobRevocation)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(bobChannel, aliceChannel))
+
+	for _, channel := range []*LightningChannel{aliceChannel, bobChannel} {
+		splices, err := channel.channelState.PendingSplices()
+		require.NoError(t, err)
+		require.Len(t, splices, 1)
+
+		chanState := channel.channelState
+		require.Equal(
+			t, chanState.LocalCommitment.CommitHeight,
+			splices[0].LocalCommitment.CommitHeight,
+		)
+		require.Len(t, splices[0].LocalCommitment.Htlcs, 1)
+		require.Len(t, splices[0].RemoteCommitment.Htlcs, 1)
+	}
+}
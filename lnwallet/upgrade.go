@@ -0,0 +1,425 @@
+package lnwallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/txsort"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrUpgradeUnsupported is returned when the commitment type of a
+	// channel can't be upgraded.
+	ErrUpgradeUnsupported = errors.New("commitment type can't be upgraded")
+
+	// ErrKickoffFeeTooHigh is returned when the fee of a kickoff
+	// transaction would leave the channel initiator with a balance below
+	// its channel reserve.
+	ErrKickoffFeeTooHigh = errors.New("kickoff fee leaves initiator " +
+		"balance below channel reserve")
+)
+
+// TaprootUpgradeType returns the type of the given channel once its
+// commitment type was upgraded to simple taproot channels.
+func TaprootUpgradeType(
+	chanType channeldb.ChannelType) channeldb.ChannelType {
+
+	return chanType | channeldb.SimpleTaprootFeatureBit
+}
+
+// TaprootUpgradeWireType returns the channel_type that's proposed to upgrade
+// a channel of the given type to simple taproot channels.
+func TaprootUpgradeWireType(chanType channeldb.ChannelType) lnwire.ChannelType {
+	features := []lnwire.FeatureBit{
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+	}
+	if chanType.HasZeroConf() {
+		features = append(features, lnwire.ZeroConfRequired)
+	}
+	if chanType.HasScidAliasChan() {
+		features = append(features, lnwire.ScidAliasRequired)
+	}
+
+	return lnwire.ChannelType(*lnwire.NewRawFeatureVector(features...))
+}
+
+// UpgradeCommitments holds the kickoff transaction of an upgrade of the
+// commitment type of a channel, along with the commitment transactions of
+// both parties that spend its output. The kickoff transaction moves the
+// channel onto a funding output of the new type, and its fee is paid by the
+// channel initiator.
+type UpgradeCommitments struct {
+	// ChanType is the type of the channel after the upgrade.
+	ChanType channeldb.ChannelType
+
+	// KickoffTx is the kickoff transaction, which spends the current
+	// funding output of the channel.
+	KickoffTx *wire.MsgTx
+
+	// KickoffFee is the fee of the kickoff transaction.
+	KickoffFee btcutil.Amount
+
+	// FundingOutpoint is the funding outpoint created by the kickoff
+	// transaction.
+	FundingOutpoint wire.OutPoint
+
+	// FundingOutput is the funding output created by the kickoff
+	// transaction.
+	FundingOutput *wire.TxOut
+
+	// Capacity is the capacity of the channel after the upgrade.
+	Capacity btcutil.Amount
+
+	// RemoteFundingKey is the new funding key of the remote party, if it
+	// proposed one.
+	RemoteFundingKey *btcec.PublicKey
+
+	// LocalCommitment is our new commitment. Its signature is set by
+	// VerifyUpgradeCommitment.
+	LocalCommitment channeldb.ChannelCommitment
+
+	// RemoteCommitment is the new commitment of the remote party. Its
+	// signature is set by SignUpgradeCommitment.
+	RemoteCommitment channeldb.ChannelCommitment
+
+	// localKey and remoteKey are the funding keys of both parties.
+	localKey  keychain.KeyDescriptor
+	remoteKey keychain.KeyDescriptor
+
+	// localNonce is our verification nonce for our new commitment.
+	localNonce *musig2.Nonces
+}
+
+// LocalNonce returns our verification nonce for our new commitment, which is
+// sent to the remote party so it can sign the commitment.
+func (u *UpgradeCommitments) LocalNonce() lnwire.Musig2Nonce {
+	return u.localNonce.PubNonce
+}
+
+// ChannelSplice returns the upgrade as the pending splice that's persisted
+// once both parties signed the new commitments. The proposal is only set if
+// we proposed the upgrade.
+func (u *UpgradeCommitments) ChannelSplice(proposal *lnwire.DynPropose,
+	kickoffSig *lnwire.KickoffSig) *channeldb.ChannelSplice {
+
+	return &channeldb.ChannelSplice{
+		FundingOutpoint:  u.FundingOutpoint,
+		FundingTx:        u.KickoffTx,
+		Capacity:         u.Capacity,
+		LocalCommitment:  u.LocalCommitment,
+		RemoteCommitment: u.RemoteCommitment,
+		Upgrade: &channeldb.ChannelUpgrade{
+			ChanType:         u.ChanType,
+			RemoteFundingKey: u.RemoteFundingKey,
+			LocalProposal:    proposal,
+			LocalKickoffSig:  kickoffSig,
+		},
+	}
+}
+
+// canUpgrade returns an error if the commitment type of the channel can't be
+// upgraded to simple taproot channels in its current state.
+func (lc *LightningChannel) canUpgrade() error {
+	chanState := lc.channelState
+	chanType := chanState.ChanType
+
+	switch {
+	// Only anchor channels with zero-fee HTLC transactions are upgraded,
+	// as their commitments only differ from taproot ones in their
+	// scripts.
+	case chanType.IsTaproot(), !chanType.HasAnchors(),
+		!chanType.ZeroHtlcTxFee():
+
+		return fmt.Errorf("%w: %v", ErrUpgradeUnsupported, chanType)
+
+	// Leased and frozen channels have their funding output constrained by
+	// a contract, so it can't be moved.
+	case chanType.HasLeaseExpiration(), chanType.IsFrozen():
+		return fmt.Errorf("%w: %v", ErrUpgradeUnsupported, chanType)
+
+	// Taproot channels can't be announced yet.
+	case chanState.ChannelFlags&lnwire.FFAnnounceChannel != 0:
+		return fmt.Errorf("%w: public channels can't be upgraded",
+			ErrUpgradeUnsupported)
+
+	case len(lc.pendingSplices) != 0, lc.upgradePending:
+		return ErrSplicePending
+
+	// The new commitments can't carry over any HTLCs.
+	case !lc.IsChannelClean():
+		return ErrChanNotClean
+	}
+
+	return nil
+}
+
+// NewUpgradeCommitments creates the kickoff transaction that upgrades the
+// channel to simple taproot channels at the given fee rate, along with the
+// commitment transactions of both parties that spend its output. The
+// commitment heights and points stay the same, so the new commitments
+// replace the current ones once the kickoff transaction confirms. If the
+// remote party proposed a new funding key, it's used for the new funding
+// output.
+func (lc *LightningChannel) NewUpgradeCommitments(
+	feeRate chainfee.SatPerKWeight,
+	remoteFundingKey *btcec.PublicKey) (*UpgradeCommitments, error) {
+
+	if err := lc.canUpgrade(); err != nil {
+		return nil, err
+	}
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	localCommit := chanState.LocalCommitment
+	remoteCommit := chanState.RemoteCommitment
+	chanType := TaprootUpgradeType(chanState.ChanType)
+
+	localKey := chanState.LocalChanCfg.MultiSigKey
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey
+	if remoteFundingKey != nil {
+		remoteKey.PubKey = remoteFundingKey
+	}
+
+	// The kickoff transaction spends the current funding output into a
+	// single musig2 funding output.
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddWitnessInput(input.MultiSigWitnessSize)
+	weightEstimate.AddP2TROutput()
+	kickoffFee := feeRate.FeeForWeight(weightEstimate.Weight())
+
+	capacity := chanState.Capacity - kickoffFee
+
+	// The channel initiator pays for the kickoff transaction, and for the
+	// new commitment fee, which replaces the current one.
+	commitFee := chainfee.SatPerKWeight(localCommit.FeePerKw).FeeForWeight(
+		CommitWeight(chanType),
+	)
+	initiatorDelta := localCommit.CommitFee - commitFee - kickoffFee
+
+	localBalance := localCommit.LocalBalance.ToSatoshis()
+	remoteBalance := localCommit.RemoteBalance.ToSatoshis()
+	if chanState.IsInitiator {
+		localBalance += initiatorDelta
+		if localBalance < chanState.LocalChanCfg.ChanReserve {
+			return nil, ErrKickoffFeeTooHigh
+		}
+	} else {
+		remoteBalance += initiatorDelta
+		if remoteBalance < chanState.RemoteChanCfg.ChanReserve {
+			return nil, ErrKickoffFeeTooHigh
+		}
+	}
+
+	pkScript, fundingOutput, err := input.GenTaprootFundingScript(
+		localKey.PubKey, remoteKey.PubKey, int64(capacity),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	kickoffTx := wire.NewMsgTx(2)
+	kickoffTx.AddTxIn(wire.NewTxIn(&chanState.FundingOutpoint, nil, nil))
+	kickoffTx.AddTxOut(wire.NewTxOut(int64(capacity), pkScript))
+
+	fundingOutpoint := wire.OutPoint{
+		Hash:  kickoffTx.TxHash(),
+		Index: 0,
+	}
+
+	ourRevPreImage, err := chanState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localCommitPoint := input.ComputeCommitmentPoint(ourRevPreImage[:])
+	remoteCommitPoint := chanState.RemoteCurrentRevocation
+
+	localCfg := chanState.LocalChanCfg
+	remoteCfg := chanState.RemoteChanCfg
+	remoteCfg.MultiSigKey = remoteKey
+
+	ourCommitTx, theirCommitTx, err := CreateCommitmentTxns(
+		localBalance, remoteBalance, &localCfg, &remoteCfg,
+		localCommitPoint, remoteCommitPoint,
+		*wire.NewTxIn(&fundingOutpoint, nil, nil), chanType,
+		chanState.IsInitiator, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	obfuscator := createStateHintObfuscator(chanState)
+	err = SetStateNumHint(ourCommitTx, localCommit.CommitHeight, obfuscator)
+	if err != nil {
+		return nil, err
+	}
+	err = SetStateNumHint(
+		theirCommitTx, remoteCommit.CommitHeight, obfuscator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txsort.InPlaceSort(ourCommitTx)
+	txsort.InPlaceSort(theirCommitTx)
+
+	// Our verification nonce is derived from our shachain, just like the
+	// one of a taproot channel, so it can be re-derived to sign our
+	// commitment for broadcast.
+	localNonce, err := channeldb.NewMusigVerificationNonce(
+		localKey.PubKey, localCommit.CommitHeight,
+		lc.taprootNonceProducer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive verification "+
+			"nonce: %w", err)
+	}
+
+	newLocalCommit := localCommit
+	newLocalCommit.LocalBalance = lnwire.NewMSatFromSatoshis(localBalance)
+	newLocalCommit.RemoteBalance = lnwire.NewMSatFromSatoshis(
+		remoteBalance,
+	)
+	newLocalCommit.CommitFee = commitFee
+	newLocalCommit.CommitTx = ourCommitTx
+	newLocalCommit.CommitSig = nil
+	newLocalCommit.Htlcs = nil
+
+	newRemoteCommit := remoteCommit
+	newRemoteCommit.LocalBalance = newLocalCommit.LocalBalance
+	newRemoteCommit.RemoteBalance = newLocalCommit.RemoteBalance
+	newRemoteCommit.CommitFee = commitFee
+	newRemoteCommit.CommitTx = theirCommitTx
+	newRemoteCommit.CommitSig = nil
+	newRemoteCommit.Htlcs = nil
+
+	return &UpgradeCommitments{
+		ChanType:         chanType,
+		KickoffTx:        kickoffTx,
+		KickoffFee:       kickoffFee,
+		FundingOutpoint:  fundingOutpoint,
+		FundingOutput:    fundingOutput,
+		Capacity:         capacity,
+		RemoteFundingKey: remoteFundingKey,
+		LocalCommitment:  newLocalCommit,
+		RemoteCommitment: newRemoteCommit,
+		localKey:         localKey,
+		remoteKey:        remoteKey,
+		localNonce:       localNonce,
+	}, nil
+}
+
+// SignUpgradeCommitment signs the new commitment of the remote party with a
+// musig2 session bound to the verification nonce the remote party sent for
+// it.
+func (lc *LightningChannel) SignUpgradeCommitment(u *UpgradeCommitments,
+	remoteNonce lnwire.Musig2Nonce) (*lnwire.PartialSigWithNonce, error) {
+
+	session := NewPartialMusigSession(
+		musig2.Nonces{PubNonce: remoteNonce}, u.localKey, u.remoteKey,
+		lc.Signer, u.FundingOutput, RemoteMusigCommit,
+	)
+
+	sig, err := session.SignCommit(u.RemoteCommitment.CommitTx)
+	if err != nil {
+		return nil, err
+	}
+	u.RemoteCommitment.CommitSig = sig.Serialize()
+
+	return sig.ToWireSig(), nil
+}
+
+// VerifyUpgradeCommitment verifies the remote party's partial signature for
+// our new commitment, and stores it with the commitment if it's valid.
+func (lc *LightningChannel) VerifyUpgradeCommitment(u *UpgradeCommitments,
+	sig *lnwire.PartialSigWithNonce) error {
+
+	session := NewPartialMusigSession(
+		*u.localNonce, u.localKey, u.remoteKey, lc.Signer,
+		u.FundingOutput, LocalMusigCommit,
+	)
+
+	_, err := session.VerifyCommitSig(u.LocalCommitment.CommitTx, sig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSpliceSig, err)
+	}
+
+	var b bytes.Buffer
+	if err := sig.Encode(&b); err != nil {
+		return err
+	}
+	u.LocalCommitment.CommitSig = b.Bytes()
+
+	return nil
+}
+
+// FinalizeKickoff verifies the remote party's signature for the kickoff
+// transaction of an upgrade, and returns a copy of the transaction with the
+// witness of its input assembled from the signatures of both parties.
+func (lc *LightningChannel) FinalizeKickoff(kickoffTx *wire.MsgTx, localSig,
+	remoteSig lnwire.Sig) (*wire.MsgTx, error) {
+
+	if err := lc.VerifySpliceInput(kickoffTx, remoteSig); err != nil {
+		return nil, err
+	}
+
+	witness, err := lc.SpliceInputWitness(localSig, remoteSig)
+	if err != nil {
+		return nil, err
+	}
+
+	finalTx := kickoffTx.Copy()
+	finalTx.TxIn[0].Witness = witness
+
+	return finalTx, nil
+}
+
+// AddPendingUpgrade persists an upgrade of the commitment type of the
+// channel, which replaces a prior version of it with the same kickoff
+// transaction. No splice can be started until the kickoff transaction
+// confirms.
+func (lc *LightningChannel) AddPendingUpgrade(
+	upgrade *channeldb.ChannelSplice) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	if err := lc.channelState.AddPendingSplice(upgrade); err != nil {
+		return err
+	}
+	lc.upgradePending = true
+
+	return nil
+}
+
+// PendingUpgrade returns the pending upgrade of the commitment type of the
+// channel, or nil if there's none.
+func (lc *LightningChannel) PendingUpgrade() (*channeldb.ChannelSplice,
+	error) {
+
+	splices, err := lc.channelState.PendingSplices()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, splice := range splices {
+		if splice.IsUpgrade() {
+			return splice, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,178 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpgradeCommitments tests that both parties of an upgrade to simple
+// taproot channels create the same kickoff transaction and commitments, and
+// that the channel can broadcast its new commitment once it moved onto the
+// output of the kickoff transaction.
+func TestUpgradeCommitments(t *testing.T) {
+	t.Parallel()
+
+	chanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+	aliceChannel, bobChannel, err := CreateTestChannels(t, chanType)
+	require.NoError(t, err)
+
+	const feeRate = chainfee.SatPerKWeight(2_500)
+
+	aliceCommits, err := aliceChannel.NewUpgradeCommitments(feeRate, nil)
+	require.NoError(t, err)
+	bobCommits, err := bobChannel.NewUpgradeCommitments(feeRate, nil)
+	require.NoError(t, err)
+
+	require.True(t, aliceCommits.ChanType.IsTaproot())
+	require.Equal(
+		t, aliceCommits.KickoffTx.TxHash(),
+		bobCommits.KickoffTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceCommits.LocalCommitment.CommitTx.TxHash(),
+		bobCommits.RemoteCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceCommits.RemoteCommitment.CommitTx.TxHash(),
+		bobCommits.LocalCommitment.CommitTx.TxHash(),
+	)
+
+	// Alice is the initiator, so she pays for the kickoff transaction,
+	// and gets back the difference of the commitment fees.
+	oldCommit := aliceChannel.channelState.LocalCommitment
+	require.Equal(
+		t, oldCommit.CommitFee-aliceCommits.LocalCommitment.CommitFee-
+			aliceCommits.KickoffFee,
+		aliceCommits.LocalCommitment.LocalBalance.ToSatoshis()-
+			oldCommit.LocalBalance.ToSatoshis(),
+	)
+	require.Equal(
+		t, oldCommit.RemoteBalance,
+		aliceCommits.LocalCommitment.RemoteBalance,
+	)
+	require.Equal(
+		t, aliceChannel.channelState.Capacity-aliceCommits.KickoffFee,
+		aliceCommits.Capacity,
+	)
+
+	// Exchange partial signatures for the new commitments.
+	aliceSig, err := aliceChannel.SignUpgradeCommitment(
+		aliceCommits, bobCommits.LocalNonce(),
+	)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignUpgradeCommitment(
+		bobCommits, aliceCommits.LocalNonce(),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, aliceChannel.VerifyUpgradeCommitment(
+		aliceCommits, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifyUpgradeCommitment(
+		bobCommits, aliceSig,
+	))
+
+	// A signature for the wrong commitment is rejected.
+	err = aliceChannel.VerifyUpgradeCommitment(aliceCommits, aliceSig)
+	require.ErrorIs(t, err, ErrInvalidSpliceSig)
+
+	// Both parties sign the kickoff transaction, which results in a valid
+	// spend of the current funding output.
+	kickoffTx := aliceCommits.KickoffTx
+	aliceKickoffSig, err := aliceChannel.SignSpliceInput(kickoffTx)
+	require.NoError(t, err)
+	bobKickoffSig, err := bobChannel.SignSpliceInput(kickoffTx)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.FinalizeKickoff(
+		kickoffTx, aliceKickoffSig, aliceKickoffSig,
+	)
+	require.ErrorIs(t, err, ErrInvalidSpliceSig)
+
+	finalTx, err := aliceChannel.FinalizeKickoff(
+		kickoffTx, aliceKickoffSig, bobKickoffSig,
+	)
+	require.NoError(t, err)
+
+	prevOut := aliceChannel.fundingOutput
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, finalTx, 0, txscript.StandardVerifyFlags,
+		nil, nil, prevOut.Value,
+		txscript.NewCannedPrevOutputFetcher(
+			prevOut.PkScript, prevOut.Value,
+		),
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// Once persisted, no splice or other upgrade can be started until the
+	// kickoff transaction confirms.
+	kickoffSig := &lnwire.KickoffSig{Signature: aliceKickoffSig}
+	require.NoError(t, aliceChannel.AddPendingUpgrade(
+		aliceCommits.ChannelSplice(nil, kickoffSig),
+	))
+
+	_, err = aliceChannel.NewUpgradeCommitments(feeRate, nil)
+	require.ErrorIs(t, err, ErrSplicePending)
+
+	upgrade, err := aliceChannel.PendingUpgrade()
+	require.NoError(t, err)
+	require.NotNil(t, upgrade)
+	require.Equal(t, aliceCommits.FundingOutpoint, upgrade.FundingOutpoint)
+
+	// Once applied, the channel is a taproot channel that can broadcast
+	// its new commitment.
+	chanState := aliceChannel.channelState
+	require.NoError(t, chanState.ApplySplice(upgrade.FundingOutpoint))
+	require.True(t, chanState.ChanType.IsTaproot())
+
+	upgradedChannel, err := NewLightningChannel(
+		aliceChannel.Signer, chanState, aliceChannel.sigPool,
+	)
+	require.NoError(t, err)
+
+	commitTx, err := upgradedChannel.getSignedCommitTx()
+	require.NoError(t, err)
+
+	fundingOut := aliceCommits.FundingOutput
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(
+		fundingOut.PkScript, fundingOut.Value,
+	)
+	vm, err = txscript.NewEngine(
+		fundingOut.PkScript, commitTx, 0,
+		txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(commitTx, prevFetcher),
+		fundingOut.Value, prevFetcher,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+}
+
+// TestUpgradeUnsupported tests that only private anchor channels can be
+// upgraded to simple taproot channels.
+func TestUpgradeUnsupported(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.NewUpgradeCommitments(253, nil)
+	require.ErrorIs(t, err, ErrUpgradeUnsupported)
+
+	chanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+	aliceChannel, _, err = CreateTestChannels(t, chanType)
+	require.NoError(t, err)
+
+	aliceChannel.channelState.ChannelFlags |= lnwire.FFAnnounceChannel
+	_, err = aliceChannel.NewUpgradeCommitments(253, nil)
+	require.ErrorIs(t, err, ErrUpgradeUnsupported)
+}
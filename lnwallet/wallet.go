@@ -58,6 +58,13 @@ var (
 	// the construction of a PSBT outside of lnd's wallet.
 	ErrPsbtFundingRequired = errors.New("PSBT funding required")
 
+	// ErrInteractiveFundingRequired is the error that is returned during
+	// the contribution handling process if the process should be paused
+	// for the interactive construction of the funding transaction with
+	// the remote party.
+	ErrInteractiveFundingRequired = errors.New("interactive funding " +
+		"required")
+
 	// ErrReservedValueInvalidated is returned if we try to publish a
 	// transaction that would take the walletbalance below what we require
 	// to keep around to fee bump our open anchor channels.
@@ -89,6 +96,23 @@ func (p *PsbtFundingRequired) Error() string {
 	return ErrPsbtFundingRequired.Error()
 }
 
+// InteractiveFundingRequired is a type that implements the error interface
+// and is returned once the funding transaction of a co-funded channel needs
+// to be constructed together with the remote party.
+type InteractiveFundingRequired struct {
+	// Intent is the pending interactive funding intent whose funding
+	// transaction needs to be constructed if the wrapping error is
+	// returned.
+	Intent *chanfunding.InteractiveIntent
+}
+
+// Error returns the underlying error.
+//
+// NOTE: This method is part of the error interface.
+func (i *InteractiveFundingRequired) Error() string {
+	return ErrInteractiveFundingRequired.Error()
+}
+
 // InitFundingReserveMsg is the first message sent to initiate the workflow
 // required to open a payment channel with a remote peer. The initial required
 // parameters are configurable across channels. These parameters are to be
@@ -210,6 +234,12 @@ type InitFundingReserveMsg struct {
 	// channel that will be useful to our future selves.
 	Memo []byte
 
+	// CoFund is true if the remote party may contribute to the channel,
+	// in which case the funding transaction is constructed interactively.
+	// If no ChanFunder is specified, a chanfunding.InteractiveAssembler
+	// is used, where we're the initiator if RemoteFundingAmt is zero.
+	CoFund bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -854,7 +884,8 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 
 	// If no chanFunder was provided, then we'll assume the default
 	// assembler, which is backed by the wallet's internal coin selection.
-	if req.ChanFunder == nil {
+	switch {
+	case req.ChanFunder == nil:
 		// We use the P2WSH dust limit since it is larger than the
 		// P2WPKH dust limit and to avoid threading through two
 		// different dust limits.
@@ -870,8 +901,22 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 			),
 			CoinSelectionStrategy: l.Cfg.CoinSelectionStrategy,
 		}
-		req.ChanFunder = chanfunding.NewWalletAssembler(cfg)
-	} else {
+
+		// For co-funded channels, our contribution is still selected
+		// from the wallet, but the funding transaction is constructed
+		// together with the remote party.
+		if req.CoFund {
+			req.ChanFunder = chanfunding.NewInteractiveAssembler(
+				chanfunding.InteractiveConfig{
+					WalletConfig: cfg,
+					FetchTx:      l.FetchTx,
+				}, req.RemoteFundingAmt == 0,
+			)
+		} else {
+			req.ChanFunder = chanfunding.NewWalletAssembler(cfg)
+		}
+
+	default:
 		_, isPsbtFunder := req.ChanFunder.(*chanfunding.PsbtAssembler)
 		enforceNewReservedValue = !isPsbtFunder
 	}
@@ -1610,6 +1655,33 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		}
 		return
 
+	// The funding transaction of a co-funded channel is constructed
+	// together with the remote party. Now that we know its contribution
+	// and its multisig key, we know the funding output and can pause the
+	// process until the funding transaction was constructed.
+	case *chanfunding.InteractiveIntent:
+		err := pendingReservation.addRemoteFunding(
+			theirContribution.FundingAmount,
+		)
+		if err != nil {
+			req.err <- err
+			return
+		}
+
+		fundingIntent.BindKeys(
+			&ourContribution.MultiSigKey,
+			theirContribution.MultiSigKey.PubKey,
+		)
+		fundingIntent.SetRemoteFundingAmt(
+			theirContribution.FundingAmount,
+		)
+
+		// Exit early because we can't continue the funding flow yet.
+		req.err <- &InteractiveFundingRequired{
+			Intent: fundingIntent,
+		}
+		return
+
 	case *chanfunding.FullIntent:
 		// Now that we know their public key, we can bind theirs as
 		// well as ours to the funding intent.
@@ -1819,6 +1891,28 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 		}
 	}
 
+	// If the funding transaction was constructed together with the remote
+	// party, we verify it now to obtain the final channel point. It's
+	// only signed once the commitment signatures were exchanged.
+	interactiveIntent, ok := intent.(*chanfunding.InteractiveIntent)
+	if ok {
+		_, err := interactiveIntent.CompileFundingTx()
+		if err != nil {
+			req.err <- fmt.Errorf("unable to construct funding "+
+				"tx: %v", err)
+			return
+		}
+		chanPointPtr, err := interactiveIntent.ChanPoint()
+		if err != nil {
+			req.err <- fmt.Errorf("unable to obtain chan "+
+				"point: %v", err)
+			return
+		}
+
+		pendingReservation.partialState.FundingOutpoint = *chanPointPtr
+		chanPoint = *chanPointPtr
+	}
+
 	// Initialize an empty sha-chain for them, tracking the current pending
 	// revocation hash (we don't yet know the preimage so we can't add it
 	// to the chain).
@@ -1859,9 +1953,10 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
-	// both commitment transactions.
+	// both commitment transactions. Only legacy dual funder channels don't
+	// have an initiator, in which case the base points are sorted.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType.IsSingleFunder() {
+	if chanState.IsInitiator {
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
@@ -1984,6 +2079,18 @@ func (l *LightningWallet) handleSingleContribution(req *addSingleContributionMsg
 	// process is complete.
 	chanState.RemoteCurrentRevocation = theirContribution.FirstCommitmentPoint
 
+	// If we're contributing to a co-funded channel, we now know the
+	// funding output the initiator is going to add to the funding
+	// transaction.
+	intent := pendingReservation.fundingIntent
+	interactiveIntent, ok := intent.(*chanfunding.InteractiveIntent)
+	if ok {
+		interactiveIntent.BindKeys(
+			&pendingReservation.ourContribution.MultiSigKey,
+			theirContribution.MultiSigKey.PubKey,
+		)
+	}
+
 	req.err <- nil
 }
 
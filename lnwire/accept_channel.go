@@ -112,6 +112,12 @@ type AcceptChannel struct {
 	// negotiated.
 	LocalNonce OptMusig2NonceTLV
 
+	// FundingAmount is the amount in satoshis the responder contributes to
+	// a co-funded channel. It must be set, possibly to zero, if the
+	// initiator asked for a co-funded open, and must not be set
+	// otherwise.
+	FundingAmount *CoFundAmount
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -144,6 +150,9 @@ func (a *AcceptChannel) Encode(w *bytes.Buffer, pver uint32) error {
 	a.LocalNonce.WhenSome(func(localNonce Musig2NonceTLV) {
 		recordProducers = append(recordProducers, &localNonce)
 	})
+	if a.FundingAmount != nil {
+		recordProducers = append(recordProducers, a.FundingAmount)
+	}
 	err := EncodeMessageExtraData(&a.ExtraData, recordProducers...)
 	if err != nil {
 		return err
@@ -249,10 +258,11 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 		chanType    ChannelType
 		leaseExpiry LeaseExpiry
 		localNonce  = a.LocalNonce.Zero()
+		fundingAmt  CoFundAmount
 	)
 	typeMap, err := tlvRecords.ExtractRecords(
 		&a.UpfrontShutdownScript, &chanType, &leaseExpiry,
-		&localNonce,
+		&localNonce, &fundingAmt,
 	)
 	if err != nil {
 		return err
@@ -268,6 +278,9 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 	if val, ok := typeMap[a.LocalNonce.TlvType()]; ok && val == nil {
 		a.LocalNonce = tlv.SomeRecordT(localNonce)
 	}
+	if val, ok := typeMap[CoFundAmountRecordType]; ok && val == nil {
+		a.FundingAmount = &fundingAmt
+	}
 
 	a.ExtraData = tlvRecords
 
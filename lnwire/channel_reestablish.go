@@ -11,6 +11,11 @@ import (
 
 const (
 	CRDynHeight tlv.Type = 20
+
+	// CRNextFundingTxID is the type of the record that carries the txid
+	// of an interactively built funding transaction that the sender
+	// hasn't received the signatures of yet.
+	CRNextFundingTxID tlv.Type = 0
 )
 
 // DynHeight is a newtype wrapper to get the proper RecordProducer instance
@@ -23,6 +28,17 @@ func (d *DynHeight) Record() tlv.Record {
 	return tlv.MakePrimitiveRecord(CRDynHeight, (*uint64)(d))
 }
 
+// NextFundingTxID is the txid of an interactively built funding transaction,
+// such as a splice transaction, for which the sender of a ChannelReestablish
+// message is still waiting for the tx_signatures of the receiver.
+type NextFundingTxID [32]byte
+
+// Record implements the RecordProducer interface, allowing a full tlv.Record
+// object to be constructed from a NextFundingTxID.
+func (n *NextFundingTxID) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(CRNextFundingTxID, (*[32]byte)(n))
+}
+
 // ChannelReestablish is a message sent between peers that have an existing
 // open channel upon connection reestablishment. This message allows both sides
 // to report their local state, and their current knowledge of the state of the
@@ -89,6 +105,11 @@ type ChannelReestablish struct {
 	// a dynamic commitment negotiation
 	DynHeight fn.Option[DynHeight]
 
+	// NextFundingTxID is an optional field that is set if the sender is
+	// waiting for the tx_signatures of the receiver for the given funding
+	// transaction, which the receiver should then retransmit.
+	NextFundingTxID fn.Option[NextFundingTxID]
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -136,13 +157,16 @@ func (a *ChannelReestablish) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
-	recordProducers := make([]tlv.RecordProducer, 0, 1)
+	recordProducers := make([]tlv.RecordProducer, 0, 3)
 	a.LocalNonce.WhenSome(func(localNonce Musig2NonceTLV) {
 		recordProducers = append(recordProducers, &localNonce)
 	})
 	a.DynHeight.WhenSome(func(h DynHeight) {
 		recordProducers = append(recordProducers, &h)
 	})
+	a.NextFundingTxID.WhenSome(func(txid NextFundingTxID) {
+		recordProducers = append(recordProducers, &txid)
+	})
 
 	err := EncodeMessageExtraData(&a.ExtraData, recordProducers...)
 	if err != nil {
@@ -203,11 +227,12 @@ func (a *ChannelReestablish) Decode(r io.Reader, pver uint32) error {
 	}
 
 	var (
-		dynHeight  DynHeight
-		localNonce = a.LocalNonce.Zero()
+		dynHeight       DynHeight
+		localNonce      = a.LocalNonce.Zero()
+		nextFundingTxID NextFundingTxID
 	)
 	typeMap, err := tlvRecords.ExtractRecords(
-		&localNonce, &dynHeight,
+		&localNonce, &dynHeight, &nextFundingTxID,
 	)
 	if err != nil {
 		return err
@@ -219,6 +244,9 @@ func (a *ChannelReestablish) Decode(r io.Reader, pver uint32) error {
 	if val, ok := typeMap[CRDynHeight]; ok && val == nil {
 		a.DynHeight = fn.Some(dynHeight)
 	}
+	if val, ok := typeMap[CRNextFundingTxID]; ok && val == nil {
+		a.NextFundingTxID = fn.Some(nextFundingTxID)
+	}
 
 	if len(tlvRecords) != 0 {
 		a.ExtraData = tlvRecords
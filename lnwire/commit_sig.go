@@ -45,6 +45,10 @@ type CommitSig struct {
 	// being signed for. In this case, the above Sig type MUST be blank.
 	PartialSig OptPartialSigWithNonceTLV
 
+	// SpliceSigs holds the signatures for the commitments that spend the
+	// funding outputs of the pending splices of the channel, if any.
+	SpliceSigs SpliceSigs
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -81,8 +85,11 @@ func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
-	partialSig := c.PartialSig.Zero()
-	typeMap, err := tlvRecords.ExtractRecords(&partialSig)
+	var (
+		partialSig = c.PartialSig.Zero()
+		spliceSigs SpliceSigs
+	)
+	typeMap, err := tlvRecords.ExtractRecords(&partialSig, &spliceSigs)
 	if err != nil {
 		return err
 	}
@@ -91,6 +98,9 @@ func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
 	if val, ok := typeMap[c.PartialSig.TlvType()]; ok && val == nil {
 		c.PartialSig = tlv.SomeRecordT(partialSig)
 	}
+	if val, ok := typeMap[SpliceSigsRecordType]; ok && val == nil {
+		c.SpliceSigs = spliceSigs
+	}
 
 	if len(tlvRecords) != 0 {
 		c.ExtraData = tlvRecords
@@ -104,10 +114,13 @@ func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *CommitSig) Encode(w *bytes.Buffer, pver uint32) error {
-	recordProducers := make([]tlv.RecordProducer, 0, 1)
+	recordProducers := make([]tlv.RecordProducer, 0, 2)
 	c.PartialSig.WhenSome(func(sig PartialSigWithNonceTLV) {
 		recordProducers = append(recordProducers, &sig)
 	})
+	if len(c.SpliceSigs) != 0 {
+		recordProducers = append(recordProducers, &c.SpliceSigs)
+	}
 	err := EncodeMessageExtraData(&c.ExtraData, recordProducers...)
 	if err != nil {
 		return err
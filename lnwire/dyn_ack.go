@@ -31,6 +31,11 @@ type DynAck struct {
 	// taproot channels in the ChannelType field.
 	LocalNonce fn.Option[Musig2Nonce]
 
+	// PartialSig is the sender's musig2 partial signature for the first
+	// commitment of the recipient after an upgrade to Taproot Channels.
+	// It's only populated along with LocalNonce.
+	PartialSig OptPartialSigWithNonceTLV
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -60,6 +65,9 @@ func (da *DynAck) Encode(w *bytes.Buffer, _ uint32) error {
 			),
 		)
 	})
+	da.PartialSig.WhenSome(func(sig PartialSigWithNonceTLV) {
+		tlvRecords = append(tlvRecords, sig.Record())
+	})
 	tlv.SortRecords(tlvRecords)
 
 	tlvStream, err := tlv.NewStream(tlvRecords...)
@@ -101,8 +109,10 @@ func (da *DynAck) Decode(r io.Reader, _ uint32) error {
 		nonceTypeEncoder, nonceTypeDecoder,
 	)
 
+	partialSig := da.PartialSig.Zero()
+
 	// Create set of Records to read TLV bytestream into.
-	records := []tlv.Record{localNonce}
+	records := []tlv.Record{localNonce, partialSig.Record()}
 	tlv.SortRecords(records)
 
 	// Read TLV stream into record set.
@@ -121,6 +131,9 @@ func (da *DynAck) Decode(r io.Reader, _ uint32) error {
 	if val, ok := typeMap[DALocalMusig2Pubnonce]; ok && val == nil {
 		da.LocalNonce = fn.Some(localNonceScratch)
 	}
+	if val, ok := typeMap[da.PartialSig.TlvType()]; ok && val == nil {
+		da.PartialSig = tlv.SomeRecordT(partialSig)
+	}
 
 	if len(tlvRecords) != 0 {
 		da.ExtraData = tlvRecords
@@ -136,3 +149,11 @@ func (da *DynAck) Decode(r io.Reader, _ uint32) error {
 func (da *DynAck) MsgType() MessageType {
 	return MsgDynAck
 }
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (da *DynAck) TargetChanID() ChannelID {
+	return da.ChanID
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -43,6 +44,10 @@ const (
 	// DPKickoffFeerate is the TLV type number that identifies the record
 	// for DynPropose.KickoffFeerate.
 	DPKickoffFeerate tlv.Type = 7
+
+	// DPLocalMusig2Pubnonce is the TLV type number that identifies the
+	// record for DynPropose.LocalNonce.
+	DPLocalMusig2Pubnonce tlv.Type = 8
 )
 
 // DynPropose is a message that is sent during a dynamic commitments negotiation
@@ -91,6 +96,12 @@ type DynPropose struct {
 	// transaction.
 	KickoffFeerate fn.Option[chainfee.SatPerKWeight]
 
+	// LocalNonce is the musig2 public nonce that the sender uses to
+	// verify the signature for its first commitment of an upgrade to
+	// Taproot Channels. It's only set if ChannelType specifies taproot
+	// channels.
+	LocalNonce fn.Option[Musig2Nonce]
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -174,6 +185,15 @@ func (dp *DynPropose) Encode(w *bytes.Buffer, _ uint32) error {
 			),
 		)
 	})
+	dp.LocalNonce.WhenSome(func(nonce Musig2Nonce) {
+		tlvRecords = append(
+			tlvRecords, tlv.MakeStaticRecord(
+				DPLocalMusig2Pubnonce, &nonce,
+				musig2.PubNonceSize, nonceTypeEncoder,
+				nonceTypeDecoder,
+			),
+		)
+	})
 	tlv.SortRecords(tlvRecords)
 
 	tlvStream, err := tlv.NewStream(tlvRecords...)
@@ -255,10 +275,16 @@ func (dp *DynPropose) Decode(r io.Reader, _ uint32) error {
 		DPKickoffFeerate, &kickoffFeerateScratch,
 	)
 
+	var localNonceScratch Musig2Nonce
+	localNonce := tlv.MakeStaticRecord(
+		DPLocalMusig2Pubnonce, &localNonceScratch, musig2.PubNonceSize,
+		nonceTypeEncoder, nonceTypeDecoder,
+	)
+
 	// Create set of Records to read TLV bytestream into.
 	records := []tlv.Record{
 		dustLimit, maxValue, reserve, csvDelay, maxHtlcs, fundingKey,
-		chanType, kickoffFeerate,
+		chanType, kickoffFeerate, localNonce,
 	}
 	tlv.SortRecords(records)
 
@@ -302,6 +328,9 @@ func (dp *DynPropose) Decode(r io.Reader, _ uint32) error {
 			chainfee.SatPerKWeight(kickoffFeerateScratch),
 		)
 	}
+	if val, ok := typeMap[DPLocalMusig2Pubnonce]; ok && val == nil {
+		dp.LocalNonce = fn.Some(localNonceScratch)
+	}
 
 	if len(tlvRecords) != 0 {
 		dp.ExtraData = tlvRecords
@@ -317,3 +346,11 @@ func (dp *DynPropose) Decode(r io.Reader, _ uint32) error {
 func (dp *DynPropose) MsgType() MessageType {
 	return MsgDynPropose
 }
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (dp *DynPropose) TargetChanID() ChannelID {
+	return dp.ChanID
+}
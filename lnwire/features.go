@@ -171,6 +171,35 @@ const (
 	// sender-generated preimages according to BOLT XX.
 	AMPOptional FeatureBit = 31
 
+	// QuiescenceRequired is a required feature bit that denotes that a
+	// connection established with this node must support the quiescence
+	// protocol if it wants to have a channel relationship.
+	QuiescenceRequired FeatureBit = 34
+
+	// QuiescenceOptional is an optional feature bit that denotes that a
+	// connection established with this node is permitted to use the
+	// quiescence protocol.
+	QuiescenceOptional FeatureBit = 35
+
+	// OnionMessagesRequired is a required feature bit that signals that
+	// the node requires support for relaying onion messages as defined
+	// in BOLT 4.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is an optional feature bit that signals that
+	// the node supports relaying onion messages as defined in BOLT 4.
+	OnionMessagesOptional FeatureBit = 39
+
+	// ProvideStorageRequired is a required feature bit that signals that
+	// the node stores a small blob of data on behalf of its peers, and
+	// hands it back to them when they reconnect.
+	ProvideStorageRequired FeatureBit = 42
+
+	// ProvideStorageOptional is an optional feature bit that signals that
+	// the node stores a small blob of data on behalf of its peers, and
+	// hands it back to them when they reconnect.
+	ProvideStorageOptional FeatureBit = 43
+
 	// ExplicitChannelTypeRequired is a required bit that denotes that a
 	// connection established with this node is to use explicit channel
 	// commitment types for negotiation instead of the existing implicit
@@ -241,6 +270,92 @@ const (
 	// TODO: Decide on actual feature bit value.
 	ScriptEnforcedLeaseOptional FeatureBit = 2023
 
+	// DynamicCommitmentsRequired is a required feature bit that signals
+	// that the node requires support for dynamic commitments, which allow
+	// updating the parameters of a live channel through the DynPropose,
+	// DynAck and DynReject messages.
+	//
+	// TODO: Decide on actual feature bit value.
+	DynamicCommitmentsRequired FeatureBit = 2024
+
+	// DynamicCommitmentsOptional is an optional feature bit that signals
+	// that the node supports dynamic commitments, which allow updating the
+	// parameters of a live channel through the DynPropose, DynAck and
+	// DynReject messages.
+	//
+	// TODO: Decide on actual feature bit value.
+	DynamicCommitmentsOptional FeatureBit = 2025
+
+	// CoFundRequired is a required feature bit that signals that
+	// the node supports channel opens where both parties contribute inputs
+	// to the funding transaction, which is built interactively. Since the
+	// open is negotiated through open_channel and accept_channel rather
+	// than the v2 messages of option_dual_fund, this uses an experimental
+	// bit so peers implementing the spec are never matched. It's only
+	// signalled by dev builds.
+	CoFundRequired FeatureBit = 2026
+
+	// CoFundOptional is an optional feature bit that signals that
+	// the node supports channel opens where both parties contribute inputs
+	// to the funding transaction, which is built interactively. Since the
+	// open is negotiated through open_channel and accept_channel rather
+	// than the v2 messages of option_dual_fund, this uses an experimental
+	// bit so peers implementing the spec are never matched. It's only
+	// signalled by dev builds.
+	CoFundOptional FeatureBit = 2027
+
+	// SpliceStagingRequired is a required feature bit that signals that
+	// the node requires support for splicing, which allows resizing a live
+	// channel by spending its funding output into a new one. Since the
+	// splice signatures are carried in a custom commit_sig record and the
+	// channel moves to a new channel ID once the splice confirms, which
+	// differs from the spec proposal, this uses an experimental bit so
+	// peers implementing the spec are never matched.
+	SpliceStagingRequired FeatureBit = 2028
+
+	// SpliceStagingOptional is an optional feature bit that signals that
+	// the node supports splicing, which allows resizing a live channel by
+	// spending its funding output into a new one. Since the splice
+	// signatures are carried in a custom commit_sig record and the channel
+	// moves to a new channel ID once the splice confirms, which differs
+	// from the spec proposal, this uses an experimental bit so peers
+	// implementing the spec are never matched.
+	SpliceStagingOptional FeatureBit = 2029
+
+	// TrampolineRoutingStagingRequired is a required feature bit that
+	// signals that the node requires the ability to forward payments on
+	// behalf of senders that only know the route to a trampoline node.
+	// Since the trampoline onion uses the legacy payload layout and onion
+	// size rather than the ones of the spec proposal, this uses an
+	// experimental bit so peers implementing the spec are never matched.
+	TrampolineRoutingStagingRequired FeatureBit = 2030
+
+	// TrampolineRoutingStagingOptional is an optional feature bit that
+	// signals that the node is able to forward payments on behalf of
+	// senders that only know the route to a trampoline node. Since the
+	// trampoline onion uses the legacy payload layout and onion size
+	// rather than the ones of the spec proposal, this uses an experimental
+	// bit so peers implementing the spec are never matched.
+	TrampolineRoutingStagingOptional FeatureBit = 2031
+
+	// RbfCoopCloseStagingRequired is a required feature bit that signals
+	// that the node requires the RBF-able co-op close flow, where each
+	// side pays the fee of its own closing transaction using the
+	// ClosingComplete and ClosingSig messages. Since these messages use a
+	// draft layout without the closer and closee scripts and the locktime
+	// of the final option_simple_close, this uses an experimental bit so
+	// peers implementing the spec are never matched.
+	RbfCoopCloseStagingRequired FeatureBit = 2032
+
+	// RbfCoopCloseStagingOptional is an optional feature bit that signals
+	// that the node supports the RBF-able co-op close flow, where each
+	// side pays the fee of its own closing transaction using the
+	// ClosingComplete and ClosingSig messages. Since these messages use a
+	// draft layout without the closer and closee scripts and the locktime
+	// of the final option_simple_close, this uses an experimental bit so
+	// peers implementing the spec are never matched.
+	RbfCoopCloseStagingOptional FeatureBit = 2033
+
 	// SimpleTaprootChannelsRequiredFinal is a required bit that indicates
 	// the node is able to create taproot-native channels. This is the
 	// final feature bit to be used once the channel type is finalized.
@@ -317,20 +432,36 @@ var Features = map[FeatureBit]string{
 	AnchorsZeroFeeHtlcTxOptional:         "anchors-zero-fee-htlc-tx",
 	WumboChannelsRequired:                "wumbo-channels",
 	WumboChannelsOptional:                "wumbo-channels",
+	CoFundRequired:                       "co-fund",
+	CoFundOptional:                       "co-fund",
 	AMPRequired:                          "amp",
 	AMPOptional:                          "amp",
+	QuiescenceRequired:                   "quiescence",
+	OnionMessagesRequired:                "onion-messages",
+	OnionMessagesOptional:                "onion-messages",
+	ProvideStorageRequired:               "provide-storage",
+	ProvideStorageOptional:               "provide-storage",
+	QuiescenceOptional:                   "quiescence",
 	PaymentMetadataOptional:              "payment-metadata",
 	PaymentMetadataRequired:              "payment-metadata",
 	ExplicitChannelTypeOptional:          "explicit-commitment-type",
 	ExplicitChannelTypeRequired:          "explicit-commitment-type",
 	KeysendOptional:                      "keysend",
 	KeysendRequired:                      "keysend",
+	TrampolineRoutingStagingRequired:     "trampoline-routing-x",
+	TrampolineRoutingStagingOptional:     "trampoline-routing-x",
 	ScriptEnforcedLeaseRequired:          "script-enforced-lease",
 	ScriptEnforcedLeaseOptional:          "script-enforced-lease",
+	DynamicCommitmentsRequired:           "dynamic-commitments",
+	DynamicCommitmentsOptional:           "dynamic-commitments",
 	ScidAliasRequired:                    "scid-alias",
 	ScidAliasOptional:                    "scid-alias",
 	ZeroConfRequired:                     "zero-conf",
 	ZeroConfOptional:                     "zero-conf",
+	RbfCoopCloseStagingRequired:          "rbf-coop-close-x",
+	RbfCoopCloseStagingOptional:          "rbf-coop-close-x",
+	SpliceStagingRequired:                "splice-x",
+	SpliceStagingOptional:                "splice-x",
 	RouteBlindingRequired:                "route-blinding",
 	RouteBlindingOptional:                "route-blinding",
 	ShutdownAnySegwitRequired:            "shutdown-any-segwit",
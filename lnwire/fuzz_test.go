@@ -900,3 +900,157 @@ func FuzzClosingComplete(f *testing.F) {
 		harness(t, data)
 	})
 }
+
+func FuzzStfu(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with Stfu.
+		data = prefixWithMsgType(data, MsgStfu)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxAddInput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxAddInput.
+		data = prefixWithMsgType(data, MsgTxAddInput)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxAddOutput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxAddOutput.
+		data = prefixWithMsgType(data, MsgTxAddOutput)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxRemoveInput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxRemoveInput.
+		data = prefixWithMsgType(data, MsgTxRemoveInput)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxRemoveOutput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxRemoveOutput.
+		data = prefixWithMsgType(data, MsgTxRemoveOutput)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxComplete(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxComplete.
+		data = prefixWithMsgType(data, MsgTxComplete)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxSignatures(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxSignatures.
+		data = prefixWithMsgType(data, MsgTxSignatures)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzTxAbort(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with TxAbort.
+		data = prefixWithMsgType(data, MsgTxAbort)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzSpliceInit(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with SpliceInit.
+		data = prefixWithMsgType(data, MsgSpliceInit)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzSpliceAck(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with SpliceAck.
+		data = prefixWithMsgType(data, MsgSpliceAck)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzSpliceLocked(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with SpliceLocked.
+		data = prefixWithMsgType(data, MsgSpliceLocked)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzOnionMessage(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with OnionMessage.
+		data = prefixWithMsgType(data, MsgOnionMessage)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzPeerStorage(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with PeerStorage.
+		data = prefixWithMsgType(data, MsgPeerStorage)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
+
+func FuzzPeerStorageRetrieval(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Prefix with PeerStorageRetrieval.
+		data = prefixWithMsgType(data, MsgPeerStorageRetrieval)
+
+		// Pass the message into our general fuzz harness for wire
+		// messages!
+		harness(t, data)
+	})
+}
@@ -3,6 +3,8 @@ package lnwire
 import (
 	"bytes"
 	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // KickoffSig is the message used to transmit the signature for a kickoff
@@ -17,6 +19,13 @@ type KickoffSig struct {
 	// transaction.
 	Signature Sig
 
+	// PartialSig is the sender's musig2 partial signature for the first
+	// commitment of the recipient after an upgrade to Taproot Channels.
+	// It's sent by the party that proposed the upgrade, as the kickoff
+	// transaction may only be signed once the first commitments of both
+	// parties are.
+	PartialSig OptPartialSigWithNonceTLV
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -32,6 +41,15 @@ var _ Message = (*KickoffSig)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (ks *KickoffSig) Encode(w *bytes.Buffer, _ uint32) error {
+	var recordProducers []tlv.RecordProducer
+	ks.PartialSig.WhenSome(func(sig PartialSigWithNonceTLV) {
+		recordProducers = append(recordProducers, &sig)
+	})
+	err := EncodeMessageExtraData(&ks.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
 	if err := WriteChannelID(w, ks.ChanID); err != nil {
 		return err
 	}
@@ -47,10 +65,42 @@ func (ks *KickoffSig) Encode(w *bytes.Buffer, _ uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (ks *KickoffSig) Decode(r io.Reader, _ uint32) error {
-	return ReadElements(r, &ks.ChanID, &ks.Signature, &ks.ExtraData)
+	err := ReadElements(r, &ks.ChanID, &ks.Signature)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	partialSig := ks.PartialSig.Zero()
+	typeMap, err := tlvRecords.ExtractRecords(&partialSig)
+	if err != nil {
+		return err
+	}
+
+	if val, ok := typeMap[ks.PartialSig.TlvType()]; ok && val == nil {
+		ks.PartialSig = tlv.SomeRecordT(partialSig)
+	}
+
+	if len(tlvRecords) != 0 {
+		ks.ExtraData = tlvRecords
+	}
+
+	return nil
 }
 
 // MsgType returns the integer uniquely identifying KickoffSig on the wire.
 //
 // This is part of the lnwire.Message interface.
 func (ks *KickoffSig) MsgType() MessageType { return MsgKickoffSig }
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (ks *KickoffSig) TargetChanID() ChannelID {
+	return ks.ChanID
+}
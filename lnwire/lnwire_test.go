@@ -518,6 +518,12 @@ func TestLightningWireProtocol(t *testing.T) {
 
 				//nolint:lll
 				req.LocalNonce = someLocalNonce[NonceRecordTypeT](r)
+
+				req.FundingFeeRate = new(CoFundFeeRate)
+				*req.FundingFeeRate = CoFundFeeRate(r.Int31())
+
+				req.FundingLocktime = new(CoFundLocktime)
+				*req.FundingLocktime = CoFundLocktime(r.Int31())
 			} else {
 				req.UpfrontShutdownScript = []byte{}
 			}
@@ -593,6 +599,9 @@ func TestLightningWireProtocol(t *testing.T) {
 
 				//nolint:lll
 				req.LocalNonce = someLocalNonce[NonceRecordTypeT](r)
+
+				req.FundingAmount = new(CoFundAmount)
+				*req.FundingAmount = CoFundAmount(r.Int63())
 			} else {
 				req.UpfrontShutdownScript = []byte{}
 			}
@@ -779,8 +788,26 @@ func TestLightningWireProtocol(t *testing.T) {
 				dp.KickoffFeerate = fn.Some(v)
 			}
 
+			if rand.Uint32()%2 == 0 {
+				var nonce Musig2Nonce
+				rand.Read(nonce[:])
+				dp.LocalNonce = fn.Some(nonce)
+			}
+
 			v[0] = reflect.ValueOf(dp)
 		},
+		MsgStfu: func(v []reflect.Value, r *rand.Rand) {
+			var s Stfu
+			if _, err := r.Read(s.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			s.Initiator = r.Intn(2) == 0
+			s.ExtraData = make([]byte, 0)
+
+			v[0] = reflect.ValueOf(s)
+		},
 		MsgDynReject: func(v []reflect.Value, r *rand.Rand) {
 			var dr DynReject
 			rand.Read(dr.ChanID[:])
@@ -833,18 +860,22 @@ func TestLightningWireProtocol(t *testing.T) {
 				var nonce Musig2Nonce
 				rand.Read(nonce[:])
 				da.LocalNonce = fn.Some(nonce)
+				da.PartialSig = somePartialSigWithNonce(t, r)
 			}
 
 			v[0] = reflect.ValueOf(da)
 		},
 		MsgKickoffSig: func(v []reflect.Value, r *rand.Rand) {
-			ks := KickoffSig{
-				ExtraData: make([]byte, 0),
-			}
+			var ks KickoffSig
 
 			rand.Read(ks.ChanID[:])
 			rand.Read(ks.Signature.bytes[:])
 
+			// 50/50 chance to attach a partial sig.
+			if r.Int31()%2 == 0 {
+				ks.PartialSig = somePartialSigWithNonce(t, r)
+			}
+
 			v[0] = reflect.ValueOf(ks)
 		},
 		MsgCommitSig: func(v []reflect.Value, r *rand.Rand) {
@@ -881,6 +912,27 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.PartialSig = somePartialSigWithNonce(t, r)
 			}
 
+			// 50/50 chance to attach the signature of a splice
+			// commitment. It doesn't carry any HTLC signatures, to
+			// stay below the maximum message size.
+			if r.Int31()%2 == 0 {
+				spliceSig := SpliceSig{}
+				_, err = r.Read(spliceSig.FundingTxID[:])
+				if err != nil {
+					t.Fatalf("unable to generate txid: %v",
+						err)
+					return
+				}
+				spliceSig.CommitSig, err = NewSigFromSignature(
+					testSig,
+				)
+				if err != nil {
+					t.Fatalf("unable to parse sig: %v", err)
+					return
+				}
+				req.SpliceSigs = SpliceSigs{spliceSig}
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgRevokeAndAck: func(v []reflect.Value, r *rand.Rand) {
@@ -1129,6 +1181,17 @@ func TestLightningWireProtocol(t *testing.T) {
 
 				//nolint:lll
 				req.LocalNonce = someLocalNonce[NonceRecordTypeT](r)
+
+				if r.Int()%2 == 0 {
+					var txid NextFundingTxID
+					_, err := r.Read(txid[:])
+					if err != nil {
+						t.Fatalf("unable to read "+
+							"txid: %v", err)
+						return
+					}
+					req.NextFundingTxID = fn.Some(txid)
+				}
 			}
 
 			v[0] = reflect.ValueOf(req)
@@ -1337,6 +1400,210 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddInput{
+				SerialID:   r.Uint64(),
+				PrevTxVout: r.Uint32(),
+				Sequence:   r.Uint32(),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			// The shared input of a splice is only identified by
+			// its txid, all other inputs carry their previous
+			// transaction.
+			if r.Intn(2) == 0 {
+				txid := req.SharedInputTxid.Zero()
+				_, err := r.Read(txid.Val[:])
+				require.NoError(t, err)
+
+				req.SharedInputTxid = tlv.SomeRecordT(txid)
+			} else {
+				req.PrevTx = make([]byte, r.Intn(500)+1)
+				_, err := r.Read(req.PrevTx)
+				require.NoError(t, err)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddOutput{
+				SerialID:  r.Uint64(),
+				Amount:    btcutil.Amount(r.Int63()),
+				PkScript:  make([]byte, r.Intn(34)+1),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			_, err = r.Read(req.PkScript)
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxRemoveInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxRemoveInput{
+				SerialID:  r.Uint64(),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxRemoveOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxRemoveOutput{
+				SerialID:  r.Uint64(),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxComplete: func(v []reflect.Value, r *rand.Rand) {
+			req := TxComplete{
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			var req TxSignatures
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			_, err = r.Read(req.TxID[:])
+			require.NoError(t, err)
+
+			numWitnesses := r.Intn(4)
+			for i := 0; i < numWitnesses; i++ {
+				witness := make(wire.TxWitness, r.Intn(3)+1)
+				for j := range witness {
+					witness[j] = make([]byte, r.Intn(73)+1)
+					_, err := r.Read(witness[j])
+					require.NoError(t, err)
+				}
+
+				req.Witnesses = append(req.Witnesses, witness)
+			}
+
+			if r.Intn(2) == 0 {
+				sig := req.SharedInputSig.Zero()
+				_, err := r.Read(sig.Val.bytes[:])
+				require.NoError(t, err)
+
+				req.SharedInputSig = tlv.SomeRecordT(sig)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAbort: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAbort{
+				Data:      make([]byte, r.Intn(100)),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			_, err = r.Read(req.Data)
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceInit: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceInit{
+				FundingContribution: btcutil.Amount(
+					r.Int63n(1_000_000) - 500_000,
+				),
+				FundingFeePerKw: r.Uint32(),
+				Locktime:        r.Uint32(),
+				ExtraData:       make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			req.FundingPubKey, err = randPubKey()
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceAck: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceAck{
+				FundingContribution: btcutil.Amount(
+					r.Int63n(1_000_000) - 500_000,
+				),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			req.FundingPubKey, err = randPubKey()
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceLocked: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceLocked{
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.ChanID[:])
+			require.NoError(t, err)
+
+			_, err = r.Read(req.SpliceTxid[:])
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgPeerStorage: func(v []reflect.Value, r *rand.Rand) {
+			req := PeerStorage{
+				Blob:      make([]byte, r.Intn(1000)),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.Blob)
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgPeerStorageRetrieval: func(v []reflect.Value, r *rand.Rand) {
+			req := PeerStorageRetrieval{
+				Blob:      make([]byte, r.Intn(1000)),
+				ExtraData: make([]byte, 0),
+			}
+
+			_, err := r.Read(req.Blob)
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgOnionMessage: func(v []reflect.Value, r *rand.Rand) {
+			req := OnionMessage{
+				OnionBlob: make([]byte, r.Intn(1500)+1),
+				ExtraData: make([]byte, 0),
+			}
+
+			var err error
+			req.PathKey, err = randPubKey()
+			require.NoError(t, err)
+
+			_, err = r.Read(req.OnionBlob)
+			require.NoError(t, err)
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgUpdateAddHTLC: func(v []reflect.Value, r *rand.Rand) {
 			req := &UpdateAddHTLC{
 				ID:     r.Uint64(),
@@ -1371,6 +1638,9 @@ func TestLightningWireProtocol(t *testing.T) {
 				)
 			}
 
+			// Endorse the htlc 50% of the time.
+			req.Endorsed = r.Int31()%2 == 0
+
 			v[0] = reflect.ValueOf(*req)
 		},
 	}
@@ -1595,6 +1865,90 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgStfu,
+			scenario: func(m Stfu) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveInput,
+			scenario: func(m TxRemoveInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveOutput,
+			scenario: func(m TxRemoveOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAbort,
+			scenario: func(m TxAbort) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAck,
+			scenario: func(m SpliceAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceLocked,
+			scenario: func(m SpliceLocked) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgOnionMessage,
+			scenario: func(m OnionMessage) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgPeerStorage,
+			scenario: func(m PeerStorage) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgPeerStorageRetrieval,
+			scenario: func(m PeerStorageRetrieval) bool {
+				return mainScenario(&m)
+			},
+		},
 	}
 	for _, test := range tests {
 		var config *quick.Config
@@ -23,6 +23,9 @@ type MessageType uint16
 // Lightning protocol.
 const (
 	MsgWarning                 MessageType = 1
+	MsgStfu                                = 2
+	MsgPeerStorage                         = 7
+	MsgPeerStorageRetrieval                = 9
 	MsgInit                                = 16
 	MsgError                               = 17
 	MsgPing                                = 18
@@ -36,6 +39,16 @@ const (
 	MsgClosingSigned                       = 39
 	MsgClosingComplete                     = 40
 	MsgClosingSig                          = 41
+	MsgTxAddInput                          = 66
+	MsgTxAddOutput                         = 67
+	MsgTxRemoveInput                       = 68
+	MsgTxRemoveOutput                      = 69
+	MsgTxComplete                          = 70
+	MsgTxSignatures                        = 71
+	MsgTxAbort                             = 74
+	MsgSpliceLocked                        = 77
+	MsgSpliceInit                          = 80
+	MsgSpliceAck                           = 81
 	MsgDynPropose                          = 111
 	MsgDynAck                              = 113
 	MsgDynReject                           = 115
@@ -56,6 +69,7 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+	MsgOnionMessage                        = 513
 	MsgKickoffSig                          = 777
 )
 
@@ -84,6 +98,8 @@ func (t MessageType) String() string {
 	switch t {
 	case MsgWarning:
 		return "Warning"
+	case MsgStfu:
+		return "Stfu"
 	case MsgInit:
 		return "Init"
 	case MsgOpenChannel:
@@ -152,6 +168,32 @@ func (t MessageType) String() string {
 		return "ClosingComplete"
 	case MsgClosingSig:
 		return "ClosingSig"
+	case MsgTxAddInput:
+		return "TxAddInput"
+	case MsgTxAddOutput:
+		return "TxAddOutput"
+	case MsgTxRemoveInput:
+		return "TxRemoveInput"
+	case MsgTxRemoveOutput:
+		return "TxRemoveOutput"
+	case MsgTxComplete:
+		return "TxComplete"
+	case MsgTxSignatures:
+		return "TxSignatures"
+	case MsgTxAbort:
+		return "TxAbort"
+	case MsgSpliceLocked:
+		return "SpliceLocked"
+	case MsgSpliceInit:
+		return "SpliceInit"
+	case MsgSpliceAck:
+		return "SpliceAck"
+	case MsgOnionMessage:
+		return "OnionMessage"
+	case MsgPeerStorage:
+		return "PeerStorage"
+	case MsgPeerStorageRetrieval:
+		return "PeerStorageRetrieval"
 	default:
 		return "<unknown>"
 	}
@@ -211,6 +253,8 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 	switch msgType {
 	case MsgWarning:
 		msg = &Warning{}
+	case MsgStfu:
+		msg = &Stfu{}
 	case MsgInit:
 		msg = &Init{}
 	case MsgOpenChannel:
@@ -279,6 +323,40 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &ClosingComplete{}
 	case MsgClosingSig:
 		msg = &ClosingSig{}
+	case MsgTxAddInput:
+		msg = &TxAddInput{}
+	case MsgTxAddOutput:
+		msg = &TxAddOutput{}
+	case MsgTxRemoveInput:
+		msg = &TxRemoveInput{}
+	case MsgTxRemoveOutput:
+		msg = &TxRemoveOutput{}
+	case MsgTxComplete:
+		msg = &TxComplete{}
+	case MsgTxSignatures:
+		msg = &TxSignatures{}
+	case MsgTxAbort:
+		msg = &TxAbort{}
+	case MsgSpliceLocked:
+		msg = &SpliceLocked{}
+	case MsgSpliceInit:
+		msg = &SpliceInit{}
+	case MsgSpliceAck:
+		msg = &SpliceAck{}
+	case MsgOnionMessage:
+		// Onion messages used to be handled by external applications
+		// through a custom message override, so we keep honoring such
+		// an override if it was configured.
+		if IsCustomOverride(msgType) {
+			msg = &Custom{Type: msgType}
+			break
+		}
+
+		msg = &OnionMessage{}
+	case MsgPeerStorage:
+		msg = &PeerStorage{}
+	case MsgPeerStorageRetrieval:
+		msg = &PeerStorageRetrieval{}
 	default:
 		// If the message is not within our custom range and has not
 		// specifically been overridden, return an unknown message.
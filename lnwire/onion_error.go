@@ -81,6 +81,8 @@ const (
 	CodeInvalidOnionPayload                       = FlagPerm | 22
 	CodeMPPTimeout                       FailCode = 23
 	CodeInvalidBlinding                           = FlagBadOnion | FlagPerm | 24 //nolint:lll
+	CodeTrampolineFeeInsufficient                 = FlagNode | 51
+	CodeTrampolineExpiryTooSoon                   = FlagNode | 52
 )
 
 // String returns the string representation of the failure code.
@@ -161,6 +163,12 @@ func (c FailCode) String() string {
 	case CodeInvalidBlinding:
 		return "InvalidBlinding"
 
+	case CodeTrampolineFeeInsufficient:
+		return "TrampolineFeeInsufficient"
+
+	case CodeTrampolineExpiryTooSoon:
+		return "TrampolineExpiryTooSoon"
+
 	default:
 		return "<unknown>"
 	}
@@ -1236,6 +1244,44 @@ func (f *FailMPPTimeout) Error() string {
 	return f.Code().String()
 }
 
+// FailTrampolineFeeInsufficient is returned by a trampoline node if the fee
+// budget of a trampoline payment doesn't suffice to reach the next trampoline
+// node or the final recipient.
+type FailTrampolineFeeInsufficient struct{}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f *FailTrampolineFeeInsufficient) Code() FailCode {
+	return CodeTrampolineFeeInsufficient
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f *FailTrampolineFeeInsufficient) Error() string {
+	return f.Code().String()
+}
+
+// FailTrampolineExpiryTooSoon is returned by a trampoline node if the CLTV
+// budget of a trampoline payment doesn't suffice to reach the next trampoline
+// node or the final recipient.
+type FailTrampolineExpiryTooSoon struct{}
+
+// Code returns the failure unique code.
+//
+// NOTE: Part of the FailureMessage interface.
+func (f *FailTrampolineExpiryTooSoon) Code() FailCode {
+	return CodeTrampolineExpiryTooSoon
+}
+
+// Returns a human readable string describing the target FailureMessage.
+//
+// NOTE: Implements the error interface.
+func (f *FailTrampolineExpiryTooSoon) Error() string {
+	return f.Code().String()
+}
+
 // FailInvalidBlinding is returned if there has been a route blinding related
 // error.
 type FailInvalidBlinding struct {
@@ -1502,6 +1548,12 @@ func makeEmptyOnionError(code FailCode) (FailureMessage, error) {
 	case CodeInvalidBlinding:
 		return &FailInvalidBlinding{}, nil
 
+	case CodeTrampolineFeeInsufficient:
+		return &FailTrampolineFeeInsufficient{}, nil
+
+	case CodeTrampolineExpiryTooSoon:
+		return &FailTrampolineExpiryTooSoon{}, nil
+
 	default:
 		return nil, errors.Errorf("unknown error code: %v", code)
 	}
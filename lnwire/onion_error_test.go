@@ -41,6 +41,8 @@ var onionFailures = []FailureMessage{
 	&FailIncorrectPaymentAmount{},
 	&FailFinalExpiryTooSoon{},
 	&FailMPPTimeout{},
+	&FailTrampolineFeeInsufficient{},
+	&FailTrampolineExpiryTooSoon{},
 
 	NewFailIncorrectDetails(99, 100),
 	NewInvalidOnionVersion(testOnionHash),
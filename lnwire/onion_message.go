@@ -0,0 +1,85 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// OnionMessage is sent to relay a message through the network without an
+// associated payment. The onion packet is decrypted hop by hop along a
+// (partially) blinded route, and each hop forwards the remaining packet to
+// the next node until it reaches its final recipient.
+type OnionMessage struct {
+	// PathKey is the ephemeral public key (blinding point) that the
+	// receiving node uses to decrypt the route blinding data contained in
+	// its onion payload.
+	PathKey *btcec.PublicKey
+
+	// OnionBlob is the serialized onion message packet. Unlike the onion
+	// of an HTLC it isn't of a fixed size, so it is prefixed with its
+	// length on the wire.
+	OnionBlob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// NewOnionMessage creates a new onion message with the given path key and
+// onion packet.
+func NewOnionMessage(pathKey *btcec.PublicKey, onion []byte) *OnionMessage {
+	return &OnionMessage{
+		PathKey:   pathKey,
+		OnionBlob: onion,
+	}
+}
+
+// A compile time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// Encode serializes the target OnionMessage into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (o *OnionMessage) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WritePublicKey(w, o.PathKey); err != nil {
+		return err
+	}
+
+	if err := writeDataWithLength(w, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, o.ExtraData)
+}
+
+// Decode deserializes the serialized OnionMessage stored in the passed
+// io.Reader into the target OnionMessage using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (o *OnionMessage) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElements(r, &o.PathKey); err != nil {
+		return err
+	}
+
+	onion, err := readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+	o.OnionBlob = onion
+
+	return ReadElements(r, &o.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as an OnionMessage on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
@@ -148,6 +148,16 @@ type OpenChannel struct {
 	// negotiated.
 	LocalNonce OptMusig2NonceTLV
 
+	// FundingFeeRate is the fee rate in sat/kw of the funding transaction.
+	// It's only set if the initiator wants the responder to contribute to
+	// the channel, in which case the funding transaction is built
+	// interactively.
+	FundingFeeRate *CoFundFeeRate
+
+	// FundingLocktime is the locktime of the interactively built funding
+	// transaction. It's only set along with FundingFeeRate.
+	FundingLocktime *CoFundLocktime
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -178,6 +188,12 @@ func (o *OpenChannel) Encode(w *bytes.Buffer, pver uint32) error {
 	o.LocalNonce.WhenSome(func(localNonce Musig2NonceTLV) {
 		recordProducers = append(recordProducers, &localNonce)
 	})
+	if o.FundingFeeRate != nil {
+		recordProducers = append(recordProducers, o.FundingFeeRate)
+	}
+	if o.FundingLocktime != nil {
+		recordProducers = append(recordProducers, o.FundingLocktime)
+	}
 	err := EncodeMessageExtraData(&o.ExtraData, recordProducers...)
 	if err != nil {
 		return err
@@ -303,10 +319,12 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		chanType    ChannelType
 		leaseExpiry LeaseExpiry
 		localNonce  = o.LocalNonce.Zero()
+		feeRate     CoFundFeeRate
+		locktime    CoFundLocktime
 	)
 	typeMap, err := tlvRecords.ExtractRecords(
 		&o.UpfrontShutdownScript, &chanType, &leaseExpiry,
-		&localNonce,
+		&localNonce, &feeRate, &locktime,
 	)
 	if err != nil {
 		return err
@@ -322,6 +340,12 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 	if val, ok := typeMap[o.LocalNonce.TlvType()]; ok && val == nil {
 		o.LocalNonce = tlv.SomeRecordT(localNonce)
 	}
+	if val, ok := typeMap[CoFundFeeRateRecordType]; ok && val == nil {
+		o.FundingFeeRate = &feeRate
+	}
+	if val, ok := typeMap[CoFundLocktimeRecordType]; ok && val == nil {
+		o.FundingLocktime = &locktime
+	}
 
 	o.ExtraData = tlvRecords
 
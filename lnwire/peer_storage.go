@@ -0,0 +1,126 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// MaxPeerStorageBlobSize is the maximum size of a blob that can be stored
+// with a peer, so that a peer storage message fits into a single transport
+// message.
+const MaxPeerStorageBlobSize = 65531
+
+// PeerStorage is sent to a peer that signals option_provide_storage to ask it
+// to store the given blob on our behalf. Each new message replaces the blob
+// that was previously stored by the peer.
+type PeerStorage struct {
+	// Blob is the opaque data the peer should store for us.
+	Blob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure PeerStorage implements the lnwire.Message
+// interface.
+var _ Message = (*PeerStorage)(nil)
+
+// Encode serializes the target PeerStorage into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (p *PeerStorage) Encode(w *bytes.Buffer, _ uint32) error {
+	return encodePeerStorageBlob(w, p.Blob, p.ExtraData)
+}
+
+// Decode deserializes the serialized PeerStorage stored in the passed
+// io.Reader into the target PeerStorage using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (p *PeerStorage) Decode(r io.Reader, _ uint32) error {
+	blob, err := readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+	p.Blob = blob
+
+	return ReadElements(r, &p.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a PeerStorage on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorage) MsgType() MessageType {
+	return MsgPeerStorage
+}
+
+// PeerStorageRetrieval is sent to a peer after reconnecting to hand back the
+// latest blob that the peer asked us to store.
+type PeerStorageRetrieval struct {
+	// Blob is the opaque data that the peer asked us to store.
+	Blob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure PeerStorageRetrieval implements the
+// lnwire.Message interface.
+var _ Message = (*PeerStorageRetrieval)(nil)
+
+// Encode serializes the target PeerStorageRetrieval into the passed
+// io.Writer. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (p *PeerStorageRetrieval) Encode(w *bytes.Buffer, _ uint32) error {
+	return encodePeerStorageBlob(w, p.Blob, p.ExtraData)
+}
+
+// Decode deserializes the serialized PeerStorageRetrieval stored in the
+// passed io.Reader into the target PeerStorageRetrieval using the
+// deserialization rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (p *PeerStorageRetrieval) Decode(r io.Reader, _ uint32) error {
+	blob, err := readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+	p.Blob = blob
+
+	return ReadElements(r, &p.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a PeerStorageRetrieval on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (p *PeerStorageRetrieval) MsgType() MessageType {
+	return MsgPeerStorageRetrieval
+}
+
+// encodePeerStorageBlob writes the length prefixed blob of a peer storage
+// message, followed by its extra data.
+func encodePeerStorageBlob(w *bytes.Buffer, blob []byte,
+	extraData ExtraOpaqueData) error {
+
+	if len(blob) > MaxPeerStorageBlobSize {
+		return fmt.Errorf("peer storage blob of %d bytes exceeds "+
+			"maximum of %d bytes", len(blob),
+			MaxPeerStorageBlobSize)
+	}
+
+	if err := writeDataWithLength(w, blob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, extraData)
+}
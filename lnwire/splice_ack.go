@@ -0,0 +1,84 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// SpliceAck is sent in response to SpliceInit to accept the proposed splice.
+// Once sent, the splice transaction is constructed interactively, driven by
+// the initiator of the splice.
+type SpliceAck struct {
+	// ChanID identifies the channel that is spliced.
+	ChanID ChannelID
+
+	// FundingContribution is the amount the responder adds to the
+	// channel, or takes out of it if negative.
+	FundingContribution btcutil.Amount
+
+	// FundingPubKey is the multisig key of the responder for the new
+	// funding output.
+	FundingPubKey *btcec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceAck implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAck)(nil)
+
+// Encode serializes the target SpliceAck into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (s *SpliceAck) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, s.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, s.FundingPubKey); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes the serialized SpliceAck stored in the passed io.Reader
+// into the target SpliceAck using the deserialization rules defined by the
+// passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (s *SpliceAck) Decode(r io.Reader, _ uint32) error {
+	return ReadElements(r,
+		&s.ChanID,
+		&s.FundingContribution,
+		&s.FundingPubKey,
+		&s.ExtraData,
+	)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a SpliceAck on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) MsgType() MessageType {
+	return MsgSpliceAck
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *SpliceAck) TargetChanID() ChannelID {
+	return s.ChanID
+}
@@ -0,0 +1,99 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// SpliceInit is sent by the initiator of a splice once the channel is
+// quiescent to propose a change of the channel's capacity.
+type SpliceInit struct {
+	// ChanID identifies the channel that is spliced.
+	ChanID ChannelID
+
+	// FundingContribution is the amount the initiator adds to the channel
+	// (splice-in), or takes out of it if negative (splice-out).
+	FundingContribution btcutil.Amount
+
+	// FundingFeePerKw is the fee rate of the splice transaction.
+	FundingFeePerKw uint32
+
+	// Locktime is the locktime of the splice transaction.
+	Locktime uint32
+
+	// FundingPubKey is the multisig key of the initiator for the new
+	// funding output.
+	FundingPubKey *btcec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, s.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.FundingFeePerKw); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.Locktime); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, s.FundingPubKey); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes the serialized SpliceInit stored in the passed
+// io.Reader into the target SpliceInit using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, _ uint32) error {
+	return ReadElements(r,
+		&s.ChanID,
+		&s.FundingContribution,
+		&s.FundingFeePerKw,
+		&s.Locktime,
+		&s.FundingPubKey,
+		&s.ExtraData,
+	)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a SpliceInit on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *SpliceInit) TargetChanID() ChannelID {
+	return s.ChanID
+}
@@ -0,0 +1,126 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// SpliceSigsRecordType is the type of the experimental record used to
+	// transmit the signatures for the commitments that spend the funding
+	// outputs of pending splices in the commit_sig message. It's taken
+	// from the custom range, as it's only sent to peers that signal the
+	// experimental SpliceStagingOptional feature bit.
+	SpliceSigsRecordType tlv.Type = 65543
+)
+
+// SpliceSig holds the signatures for the commitment of the receiver that
+// spends the funding output of a pending splice. The commitment carries the
+// same HTLCs as the one signed by the commit_sig message it's part of.
+type SpliceSig struct {
+	// FundingTxID is the txid of the splice transaction that creates the
+	// funding output spent by the commitment.
+	FundingTxID chainhash.Hash
+
+	// CommitSig is the signature for the commitment transaction.
+	CommitSig Sig
+
+	// HtlcSigs are the signatures for the second-level HTLC transactions
+	// of the commitment, in the order of the HTLC outputs.
+	HtlcSigs []Sig
+}
+
+// SpliceSigs are the signatures for the commitments that spend the funding
+// outputs of all pending splices of a channel.
+type SpliceSigs []SpliceSig
+
+// Record returns a TLV record that can be used to encode/decode the
+// SpliceSigs type from a given TLV stream.
+func (s *SpliceSigs) Record() tlv.Record {
+	return tlv.MakeDynamicRecord(
+		SpliceSigsRecordType, s, s.size, encodeSpliceSigs,
+		decodeSpliceSigs,
+	)
+}
+
+// size returns the number of bytes of the encoded splice signatures.
+func (s *SpliceSigs) size() uint64 {
+	var b bytes.Buffer
+	if err := writeSpliceSigs(&b, *s); err != nil {
+		return 0
+	}
+
+	return uint64(b.Len())
+}
+
+// writeSpliceSigs writes the passed splice signatures to buf.
+func writeSpliceSigs(buf *bytes.Buffer, sigs SpliceSigs) error {
+	if err := WriteUint16(buf, uint16(len(sigs))); err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		if err := WriteBytes(buf, sig.FundingTxID[:]); err != nil {
+			return err
+		}
+		if err := WriteSig(buf, sig.CommitSig); err != nil {
+			return err
+		}
+		if err := WriteSigs(buf, sig.HtlcSigs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeSpliceSigs is a tlv.Encoder for SpliceSigs.
+func encodeSpliceSigs(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*SpliceSigs); ok {
+		var b bytes.Buffer
+		if err := writeSpliceSigs(&b, *v); err != nil {
+			return err
+		}
+
+		_, err := w.Write(b.Bytes())
+
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.SpliceSigs")
+}
+
+// decodeSpliceSigs is a tlv.Decoder for SpliceSigs.
+func decodeSpliceSigs(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	v, ok := val.(*SpliceSigs)
+	if !ok {
+		return tlv.NewTypeForDecodingErr(val, "lnwire.SpliceSigs", l, l)
+	}
+
+	lr := io.LimitReader(r, int64(l))
+
+	var numSigs uint16
+	if err := ReadElement(lr, &numSigs); err != nil {
+		return err
+	}
+
+	sigs := make(SpliceSigs, numSigs)
+	for i := range sigs {
+		err := ReadElements(lr,
+			sigs[i].FundingTxID[:],
+			&sigs[i].CommitSig,
+			&sigs[i].HtlcSigs,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	*v = sigs
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// Stfu is the message used to request that the channel be brought into a
+// quiescent state. Once both sides have sent this message and have no
+// outstanding updates, no further updates may be added to the channel until
+// the protocol that required quiescence completes.
+type Stfu struct {
+	// ChanID identifies which channel needs to be brought to quiescence.
+	ChanID ChannelID
+
+	// Initiator is a byte that identifies whether we are the initiator of
+	// this process.
+	Initiator bool
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure Stfu implements the lnwire.Message interface.
+var _ Message = (*Stfu)(nil)
+
+// Encode serializes the target Stfu into the passed io.Writer. Serialization
+// will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (s *Stfu) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, s.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteBool(w, s.Initiator); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes the serialized Stfu stored in the passed io.Reader into
+// the target Stfu using the deserialization rules defined by the passed
+// protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (s *Stfu) Decode(r io.Reader, _ uint32) error {
+	return ReadElements(r, &s.ChanID, &s.Initiator, &s.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a Stfu on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MsgType() MessageType {
+	return MsgStfu
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *Stfu) TargetChanID() ChannelID {
+	return s.ChanID
+}
@@ -0,0 +1,169 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// SharedInputTxidType is the TLV type of the txid of the shared input that is
+// spent by a splice transaction.
+type SharedInputTxidType = tlv.TlvType0
+
+// TxAddInput is sent during the interactive construction of a transaction to
+// add an input to it. The input is identified by the transaction that
+// created it, unless it is the shared funding input of a splice, in which
+// case only its txid is sent.
+type TxAddInput struct {
+	// ChanID identifies the channel the transaction is constructed for.
+	ChanID ChannelID
+
+	// SerialID is the unique identifier of this input within the
+	// transaction. Inputs are sorted by their serial id in the final
+	// transaction. The initiator of the construction uses even serial
+	// ids, the other party odd ones.
+	SerialID uint64
+
+	// PrevTx is the serialized transaction that created the output that
+	// is spent by this input. It's empty for the shared input of a splice.
+	PrevTx []byte
+
+	// PrevTxVout is the index of the spent output within PrevTx.
+	PrevTxVout uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// SharedInputTxid is set if this input spends the current funding
+	// output of the channel. As both parties know the funding
+	// transaction, only its txid is sent.
+	SharedInputTxid tlv.OptionalRecordT[SharedInputTxidType, [32]byte]
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// readDataWithLength reads a byte slice that is prefixed with its 2-byte
+// length from the passed io.Reader.
+func readDataWithLength(r io.Reader) ([]byte, error) {
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, binary.BigEndian.Uint16(l[:]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Encode serializes the target TxAddInput into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := writeDataWithLength(w, t.PrevTx); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.PrevTxVout); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.Sequence); err != nil {
+		return err
+	}
+
+	var recordProducers []tlv.RecordProducer
+	t.SharedInputTxid.WhenSome(
+		func(txid tlv.RecordT[SharedInputTxidType, [32]byte]) {
+			recordProducers = append(recordProducers, &txid)
+		},
+	)
+
+	err := EncodeMessageExtraData(&t.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxAddInput stored in the passed
+// io.Reader into the target TxAddInput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElements(r, &t.ChanID, &t.SerialID); err != nil {
+		return err
+	}
+
+	prevTx, err := readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+	if len(prevTx) != 0 {
+		t.PrevTx = prevTx
+	}
+
+	err = ReadElements(r, &t.PrevTxVout, &t.Sequence)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	sharedTxid := t.SharedInputTxid.Zero()
+	typeMap, err := tlvRecords.ExtractRecords(&sharedTxid)
+	if err != nil {
+		return err
+	}
+
+	if val, ok := typeMap[t.SharedInputTxid.TlvType()]; ok && val == nil {
+		t.SharedInputTxid = tlv.SomeRecordT(sharedTxid)
+	}
+
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAddInput) TargetChanID() ChannelID {
+	return t.ChanID
+}
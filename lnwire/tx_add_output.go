@@ -0,0 +1,94 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// TxAddOutput is sent during the interactive construction of a transaction to
+// add an output to it.
+type TxAddOutput struct {
+	// ChanID identifies the channel the transaction is constructed for.
+	ChanID ChannelID
+
+	// SerialID is the unique identifier of this output within the
+	// transaction. Outputs are sorted by their serial id in the final
+	// transaction.
+	SerialID uint64
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// PkScript is the script of the output.
+	PkScript []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, t.Amount); err != nil {
+		return err
+	}
+
+	if err := writeDataWithLength(w, t.PkScript); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxAddOutput stored in the passed
+// io.Reader into the target TxAddOutput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChanID, &t.SerialID, &t.Amount)
+	if err != nil {
+		return err
+	}
+
+	t.PkScript, err = readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+
+	return ReadElements(r, &t.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxAddOutput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAddOutput) TargetChanID() ChannelID {
+	return t.ChanID
+}
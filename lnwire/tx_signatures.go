@@ -0,0 +1,193 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// maxWitnessItemSize is the maximum size of a single witness stack item we'll
+// decode. It mirrors the limit that bitcoind applies to standard witness
+// elements of P2WSH spends.
+const maxWitnessItemSize = 10_000
+
+// SharedInputSigType is the TLV type of the signature for the shared input
+// that is spent by a splice transaction.
+type SharedInputSigType = tlv.TlvType0
+
+// TxSignatures is sent once a transaction was constructed interactively, and
+// carries the witnesses of all inputs that were contributed by the sender.
+type TxSignatures struct {
+	// ChanID identifies the channel the transaction is constructed for.
+	ChanID ChannelID
+
+	// TxID is the txid of the transaction the witnesses are for.
+	TxID chainhash.Hash
+
+	// Witnesses are the witnesses of the inputs that were added by the
+	// sender, sorted by the serial id of the inputs.
+	Witnesses []wire.TxWitness
+
+	// SharedInputSig is the signature of the sender for the shared funding
+	// input of a splice transaction.
+	SharedInputSig tlv.OptionalRecordT[SharedInputSigType, Sig]
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.TxID[:]); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, uint16(len(t.Witnesses))); err != nil {
+		return err
+	}
+
+	for _, witness := range t.Witnesses {
+		var b bytes.Buffer
+		err := wire.WriteVarInt(&b, 0, uint64(len(witness)))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range witness {
+			if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+				return err
+			}
+		}
+
+		if err := writeDataWithLength(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	var recordProducers []tlv.RecordProducer
+	t.SharedInputSig.WhenSome(
+		func(sig tlv.RecordT[SharedInputSigType, Sig]) {
+			recordProducers = append(recordProducers, &sig)
+		},
+	)
+
+	err := EncodeMessageExtraData(&t.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// decodeWitness parses a witness stack that was serialized using the bitcoin
+// wire format.
+func decodeWitness(b []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(b)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each item takes at least one byte, which bounds the allocation
+	// below.
+	if numItems > uint64(len(b)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, maxWitnessItemSize, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
+
+// Decode deserializes the serialized TxSignatures stored in the passed
+// io.Reader into the target TxSignatures using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is a part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChanID, t.TxID[:])
+	if err != nil {
+		return err
+	}
+
+	var numWitnesses uint16
+	if err := ReadElement(r, &numWitnesses); err != nil {
+		return err
+	}
+
+	for i := 0; i < int(numWitnesses); i++ {
+		witnessBytes, err := readDataWithLength(r)
+		if err != nil {
+			return err
+		}
+
+		witness, err := decodeWitness(witnessBytes)
+		if err != nil {
+			return err
+		}
+
+		t.Witnesses = append(t.Witnesses, witness)
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	sig := t.SharedInputSig.Zero()
+	typeMap, err := tlvRecords.ExtractRecords(&sig)
+	if err != nil {
+		return err
+	}
+
+	if val, ok := typeMap[t.SharedInputSig.TlvType()]; ok && val == nil {
+		t.SharedInputSig = tlv.SomeRecordT(sig)
+	}
+
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxSignatures) TargetChanID() ChannelID {
+	return t.ChanID
+}
@@ -0,0 +1,57 @@
+package lnwire
+
+import (
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// The records of the experimental co-funded channel opens of lnd, which are
+// negotiated through open_channel and accept_channel instead of the v2
+// messages of option_dual_fund, use odd types from the custom range. They're
+// only sent to peers that signal the experimental CoFundOptional
+// feature bit, so they never reach a peer that implements the spec.
+const (
+	// CoFundFeeRateRecordType is the type of the experimental record
+	// used to communicate the fee rate of an interactively built funding
+	// transaction in the open_channel message.
+	CoFundFeeRateRecordType tlv.Type = 65537
+
+	// CoFundLocktimeRecordType is the type of the experimental record
+	// used to communicate the locktime of an interactively built funding
+	// transaction in the open_channel message.
+	CoFundLocktimeRecordType tlv.Type = 65539
+
+	// CoFundAmountRecordType is the type of the experimental record used
+	// to communicate the amount the responder contributes to a co-funded
+	// channel in the accept_channel message.
+	CoFundAmountRecordType tlv.Type = 65541
+)
+
+// CoFundFeeRate is the fee rate in sat/kw of an interactively built funding
+// transaction.
+type CoFundFeeRate uint32
+
+// Record returns a TLV record that can be used to encode/decode the
+// CoFundFeeRate type from a given TLV stream.
+func (d *CoFundFeeRate) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(CoFundFeeRateRecordType, (*uint32)(d))
+}
+
+// CoFundLocktime is the locktime of an interactively built funding
+// transaction.
+type CoFundLocktime uint32
+
+// Record returns a TLV record that can be used to encode/decode the
+// CoFundLocktime type from a given TLV stream.
+func (d *CoFundLocktime) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(CoFundLocktimeRecordType, (*uint32)(d))
+}
+
+// CoFundAmount is the amount in satoshis the responder contributes to a
+// co-funded channel.
+type CoFundAmount uint64
+
+// Record returns a TLV record that can be used to encode/decode the
+// CoFundAmount type from a given TLV stream.
+func (d *CoFundAmount) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(CoFundAmountRecordType, (*uint64)(d))
+}
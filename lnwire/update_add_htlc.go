@@ -25,6 +25,30 @@ type (
 	BlindingPointRecord = tlv.OptionalRecordT[BlindingPointTlvType, *btcec.PublicKey]
 )
 
+const (
+	// ExperimentalEndorsementType is the TLV type of the experimental
+	// endorsement signal that is used for jamming mitigation.
+	ExperimentalEndorsementType tlv.Type = 106823
+
+	// ExperimentalUnendorsed is the value of the endorsement signal of an
+	// htlc that isn't endorsed.
+	ExperimentalUnendorsed Endorsement = 0
+
+	// ExperimentalEndorsed is the value of the endorsement signal of an
+	// htlc that is endorsed by the sender.
+	ExperimentalEndorsed Endorsement = 1
+)
+
+// Endorsement is a newtype wrapper to get the proper RecordProducer instance
+// for the experimental endorsement signal of an UpdateAddHTLC message.
+type Endorsement uint8
+
+// Record implements the RecordProducer interface, allowing a full tlv.Record
+// object to be constructed from an Endorsement.
+func (e *Endorsement) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(ExperimentalEndorsementType, (*uint8)(e))
+}
+
 // UpdateAddHTLC is the message sent by Alice to Bob when she wishes to add an
 // HTLC to his remote commitment transaction. In addition to information
 // detailing the value, the ID, expiry, and the onion blob is also included
@@ -72,6 +96,12 @@ type UpdateAddHTLC struct {
 	// next hop for this htlc.
 	BlindingPoint BlindingPointRecord
 
+	// Endorsed indicates that the sender endorses the htlc, signaling that
+	// it is expected to resolve quickly and may use the resources of the
+	// channel that are protected against jamming. It is carried in the
+	// experimental endorsement TLV.
+	Endorsed bool
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -104,8 +134,11 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
+	var endorsement Endorsement
 	blindingRecord := c.BlindingPoint.Zero()
-	tlvMap, err := c.ExtraData.ExtractRecords(&blindingRecord)
+	tlvMap, err := c.ExtraData.ExtractRecords(
+		&blindingRecord, &endorsement,
+	)
 	if err != nil {
 		return err
 	}
@@ -114,6 +147,11 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 		c.BlindingPoint = tlv.SomeRecordT(blindingRecord)
 	}
 
+	// Any value other than the endorsed one is treated as unendorsed.
+	if val, ok := tlvMap[ExperimentalEndorsementType]; ok && val == nil {
+		c.Endorsed = endorsement == ExperimentalEndorsed
+	}
+
 	// Set extra data to nil if we didn't parse anything out of it so that
 	// we can use assert.Equal in tests.
 	if len(tlvMap) == 0 {
@@ -152,7 +190,7 @@ func (c *UpdateAddHTLC) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
-	// Only include blinding point in extra data if present.
+	// Only include the blinding point in extra data if present.
 	var records []tlv.RecordProducer
 
 	c.BlindingPoint.WhenSome(func(b tlv.RecordT[BlindingPointTlvType,
@@ -161,6 +199,12 @@ func (c *UpdateAddHTLC) Encode(w *bytes.Buffer, pver uint32) error {
 		records = append(records, &b)
 	})
 
+	// The endorsement signal is only included for endorsed htlcs.
+	if c.Endorsed {
+		endorsement := ExperimentalEndorsed
+		records = append(records, &endorsement)
+	}
+
 	err := EncodeMessageExtraData(&c.ExtraData, records...)
 	if err != nil {
 		return err
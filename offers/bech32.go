@@ -0,0 +1,95 @@
+package offers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+const (
+	// offerHRP is the human readable part of an encoded offer.
+	offerHRP = "lno"
+
+	// invoiceRequestHRP is the human readable part of an encoded invoice
+	// request.
+	invoiceRequestHRP = "lnr"
+
+	// invoiceHRP is the human readable part of an encoded invoice.
+	invoiceHRP = "lni"
+
+	// charset is the bech32 character set.
+	charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// encodeBech32 encodes the given data with the given human readable part.
+// Unlike BOLT 11 invoices, BOLT 12 strings use bech32 without a checksum and
+// without a length limit, so the bech32 package can't be used as is.
+func encodeBech32(hrp string, data []byte) (string, error) {
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(hrp) + 1 + len(converted))
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, c := range converted {
+		b.WriteByte(charset[c])
+	}
+
+	return b.String(), nil
+}
+
+// decodeBech32 decodes a bech32 string without checksum and returns its human
+// readable part and data. The string may be split into several parts that are
+// joined with a '+' followed by optional whitespace, as allowed by BOLT 12.
+func decodeBech32(s string) (string, []byte, error) {
+	// Join the parts of the string, dropping any whitespace after the
+	// '+' separators.
+	parts := strings.Split(s, "+")
+	for i, part := range parts {
+		if i > 0 {
+			part = strings.TrimLeft(part, " \t\r\n")
+		}
+		if part == "" {
+			return "", nil, errors.New("invalid use of '+' in " +
+				"bech32 string")
+		}
+
+		parts[i] = part
+	}
+	s = strings.Join(parts, "")
+
+	lower := strings.ToLower(s)
+	if s != lower && s != strings.ToUpper(s) {
+		return "", nil, errors.New("bech32 string has mixed case")
+	}
+
+	sep := strings.LastIndexByte(lower, '1')
+	if sep < 1 {
+		return "", nil, errors.New("bech32 string has no human " +
+			"readable part")
+	}
+
+	hrp, encoded := lower[:sep], lower[sep+1:]
+	data := make([]byte, len(encoded))
+	for i := 0; i < len(encoded); i++ {
+		idx := strings.IndexByte(charset, encoded[i])
+		if idx < 0 {
+			return "", nil, fmt.Errorf("invalid bech32 character: "+
+				"%q", encoded[i])
+		}
+
+		data[i] = byte(idx)
+	}
+
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hrp, converted, nil
+}
@@ -0,0 +1,409 @@
+package offers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	invoicePathsType          tlv.Type = 160
+	invoiceBlindedPayType     tlv.Type = 162
+	invoiceCreatedAtType      tlv.Type = 164
+	invoiceRelativeExpiryType tlv.Type = 166
+	invoicePaymentHashType    tlv.Type = 168
+	invoiceAmountType         tlv.Type = 170
+	invoiceFallbacksType      tlv.Type = 172
+	invoiceFeaturesType       tlv.Type = 174
+	invoiceNodeIDType         tlv.Type = 176
+
+	// invoiceName is the name of the invoice message that is used in its
+	// signature tag.
+	invoiceName = "invoice"
+
+	// DefaultInvoiceExpiry is the expiry of invoices that don't specify a
+	// relative expiry.
+	DefaultInvoiceExpiry = 2 * time.Hour
+)
+
+// invoiceFeatures is the set of invoice features that we understand.
+var invoiceFeatures = map[lnwire.FeatureBit]string{
+	lnwire.MPPRequired: lnwire.Features[lnwire.MPPRequired],
+	lnwire.MPPOptional: lnwire.Features[lnwire.MPPOptional],
+}
+
+// isInvoiceType returns true if the given TLV type is in one of the ranges
+// that are reserved for invoice fields, excluding the offer and invoice
+// request fields that are copied into invoices.
+func isInvoiceType(typ uint64) bool {
+	return (typ >= 160 && typ <= 239) ||
+		(typ >= 3_000_000_000 && typ <= 3_999_999_999)
+}
+
+// Invoice is a BOLT 12 invoice, which is sent by the issuer of an offer in
+// response to an invoice request.
+type Invoice struct {
+	// Request is the invoice request that the invoice was created for.
+	// All its fields except for the signature are copied into the
+	// invoice.
+	Request *InvoiceRequest
+
+	// Paths is the list of blinded paths that the invoice can be paid
+	// along, together with their aggregated relay parameters.
+	Paths []*routing.BlindedPayment
+
+	// CreatedAt is the time at which the invoice was created.
+	CreatedAt time.Time
+
+	// RelativeExpiry is the time after CreatedAt at which the invoice
+	// expires. If zero, DefaultInvoiceExpiry is used.
+	RelativeExpiry time.Duration
+
+	// PaymentHash is the hash of the preimage that is revealed when the
+	// invoice is paid.
+	PaymentHash lntypes.Hash
+
+	// Amount is the amount to pay.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features of the invoice.
+	Features *lnwire.RawFeatureVector
+
+	// NodeID is the public key that signed the invoice.
+	NodeID *btcec.PublicKey
+
+	// Signature is the signature of the invoice by the node ID.
+	Signature [64]byte
+
+	// ExtraRecords holds the unknown odd records of the invoice.
+	ExtraRecords map[uint64][]byte
+}
+
+// Expiry returns the time at which the invoice expires.
+func (i *Invoice) Expiry() time.Time {
+	expiry := i.RelativeExpiry
+	if expiry == 0 {
+		expiry = DefaultInvoiceExpiry
+	}
+
+	return i.CreatedAt.Add(expiry)
+}
+
+// records returns the TLV records of the invoice, including the invoice
+// request and offer fields but excluding the signature.
+func (i *Invoice) records() ([]tlv.Record, error) {
+	records, err := i.Request.records()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]byte, 0)
+	var payInfo bytes.Buffer
+	for _, path := range i.Paths {
+		p, err := encodePaths([]*sphinx.BlindedPath{path.BlindedPath})
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p...)
+
+		if err := encodePayInfo(&payInfo, path); err != nil {
+			return nil, err
+		}
+	}
+	blindedPay := payInfo.Bytes()
+
+	createdAt := uint64(i.CreatedAt.Unix())
+	paymentHash := [32]byte(i.PaymentHash)
+	amount := uint64(i.Amount)
+
+	records = append(records,
+		tlv.MakePrimitiveRecord(invoicePathsType, &paths),
+		tlv.MakePrimitiveRecord(invoiceBlindedPayType, &blindedPay),
+		tu64Record(invoiceCreatedAtType, &createdAt),
+		tlv.MakePrimitiveRecord(invoicePaymentHashType, &paymentHash),
+		tu64Record(invoiceAmountType, &amount),
+		tlv.MakePrimitiveRecord(invoiceNodeIDType, &i.NodeID),
+	)
+
+	if i.RelativeExpiry != 0 {
+		expiry := uint32(i.RelativeExpiry.Seconds())
+		records = append(
+			records, tu32Record(invoiceRelativeExpiryType, &expiry),
+		)
+	}
+
+	if i.Features != nil && !i.Features.IsEmpty() {
+		records = append(
+			records, i.Features.Record(invoiceFeaturesType),
+		)
+	}
+
+	records = append(records, tlv.MapToRecords(i.ExtraRecords)...)
+
+	return records, nil
+}
+
+// encode serializes the invoice, optionally without its signature.
+func (i *Invoice) encode(w io.Writer, withSig bool) error {
+	records, err := i.records()
+	if err != nil {
+		return err
+	}
+
+	if withSig {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				signatureType, &i.Signature,
+			),
+		)
+	}
+
+	return encodeRecords(w, records)
+}
+
+// Encode serializes the invoice as a TLV stream.
+func (i *Invoice) Encode(w io.Writer) error {
+	return i.encode(w, true)
+}
+
+// String returns the bech32 encoding of the invoice.
+func (i *Invoice) String() string {
+	var b bytes.Buffer
+	if err := i.Encode(&b); err != nil {
+		return fmt.Sprintf("<invalid invoice: %v>", err)
+	}
+
+	s, err := encodeBech32(invoiceHRP, b.Bytes())
+	if err != nil {
+		return fmt.Sprintf("<invalid invoice: %v>", err)
+	}
+
+	return s
+}
+
+// Sign signs the invoice with the given signer, which must sign with the
+// private key of the invoice's node ID.
+func (i *Invoice) Sign(signer MessageSigner) error {
+	var b bytes.Buffer
+	if err := i.encode(&b, false); err != nil {
+		return err
+	}
+
+	sig, err := sign(invoiceName, b.Bytes(), signer)
+	if err != nil {
+		return err
+	}
+	i.Signature = sig
+
+	return nil
+}
+
+// Validate checks that the invoice is well formed and signed by its node ID.
+// The invoice request that is part of the invoice isn't signed, so it's only
+// checked to be well formed.
+func (i *Invoice) Validate() error {
+	if err := i.Request.Offer.Validate(); err != nil {
+		return err
+	}
+
+	switch {
+	case len(i.Paths) == 0:
+		return errors.New("invoice has no paths")
+
+	case i.NodeID == nil:
+		return errors.New("invoice has no node id")
+	}
+
+	for _, path := range i.Paths {
+		if err := path.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if i.Features != nil {
+		err := validateFeatures(i.Features, invoiceFeatures)
+		if err != nil {
+			return err
+		}
+	}
+
+	var b bytes.Buffer
+	if err := i.encode(&b, false); err != nil {
+		return err
+	}
+
+	return verifySignature(invoiceName, b.Bytes(), i.Signature, i.NodeID)
+}
+
+// invoiceDecoder holds the values of the invoice records while a TLV stream
+// is decoded.
+type invoiceDecoder struct {
+	requestFields invoiceRequestDecoder
+
+	paths, blindedPay, fallbacks []byte
+
+	createdAt, amount uint64
+
+	relativeExpiry uint32
+
+	paymentHash [32]byte
+
+	features *lnwire.RawFeatureVector
+
+	nodeID *btcec.PublicKey
+}
+
+// records returns the records to decode the invoice fields into, including
+// the invoice request and offer fields.
+func (d *invoiceDecoder) records() []tlv.Record {
+	d.features = lnwire.NewRawFeatureVector()
+
+	return append(
+		d.requestFields.records(),
+		tlv.MakePrimitiveRecord(invoicePathsType, &d.paths),
+		tlv.MakePrimitiveRecord(invoiceBlindedPayType, &d.blindedPay),
+		tu64Record(invoiceCreatedAtType, &d.createdAt),
+		tu32Record(invoiceRelativeExpiryType, &d.relativeExpiry),
+		tlv.MakePrimitiveRecord(invoicePaymentHashType, &d.paymentHash),
+		tu64Record(invoiceAmountType, &d.amount),
+		tlv.MakePrimitiveRecord(invoiceFallbacksType, &d.fallbacks),
+		d.features.Record(invoiceFeaturesType),
+		tlv.MakePrimitiveRecord(invoiceNodeIDType, &d.nodeID),
+	)
+}
+
+// DecodeInvoice parses a serialized invoice and checks that it's well formed
+// and correctly signed. Fallback addresses aren't supported and are ignored.
+func DecodeInvoice(data []byte) (*Invoice, error) {
+	var d invoiceDecoder
+	parsed, err := decodeStream(data, d.records(), func(typ uint64) bool {
+		return isOfferType(typ) || isInvoiceRequestType(typ) ||
+			isInvoiceType(typ)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typ := range []tlv.Type{
+		invoicePathsType, invoiceBlindedPayType, invoiceCreatedAtType,
+		invoicePaymentHashType, invoiceAmountType, invoiceNodeIDType,
+		signatureType,
+	} {
+		if _, ok := parsed[typ]; !ok {
+			return nil, fmt.Errorf("invoice is missing required "+
+				"record %d", typ)
+		}
+	}
+
+	req, err := d.requestFields.invoiceRequest(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := &Invoice{
+		Request:      req,
+		CreatedAt:    time.Unix(int64(d.createdAt), 0),
+		PaymentHash:  lntypes.Hash(d.paymentHash),
+		Amount:       lnwire.MilliSatoshi(d.amount),
+		NodeID:       d.nodeID,
+		Signature:    d.requestFields.signature,
+		ExtraRecords: extraRecords(parsed, isInvoiceType),
+	}
+
+	if _, ok := parsed[invoiceRelativeExpiryType]; ok {
+		invoice.RelativeExpiry = time.Duration(d.relativeExpiry) *
+			time.Second
+	}
+
+	if _, ok := parsed[invoiceFeaturesType]; ok {
+		invoice.Features = d.features
+	}
+
+	paths, err := decodePaths(d.paths)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(d.blindedPay)
+	for _, path := range paths {
+		payment, err := decodePayInfo(r)
+		if err != nil {
+			return nil, err
+		}
+		payment.BlindedPath = path
+
+		invoice.Paths = append(invoice.Paths, payment)
+	}
+	if r.Len() > 0 {
+		return nil, errors.New("number of blinded payinfos doesn't " +
+			"match number of paths")
+	}
+
+	if err := invoice.Validate(); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// encodePayInfo serializes the aggregated relay parameters of a blinded
+// payment path.
+func encodePayInfo(w io.Writer, path *routing.BlindedPayment) error {
+	features := lnwire.NewRawFeatureVector()
+	if path.Features != nil {
+		features = path.Features.RawFeatureVector
+	}
+
+	var b [28]byte
+	binary.BigEndian.PutUint32(b[0:4], path.BaseFee)
+	binary.BigEndian.PutUint32(b[4:8], path.ProportionalFeeRate)
+	binary.BigEndian.PutUint16(b[8:10], path.CltvExpiryDelta)
+	binary.BigEndian.PutUint64(b[10:18], path.HtlcMinimum)
+	binary.BigEndian.PutUint64(b[18:26], path.HtlcMaximum)
+	binary.BigEndian.PutUint16(b[26:28], uint16(features.SerializeSize()))
+
+	if _, err := w.Write(b[:]); err != nil {
+		return err
+	}
+
+	return features.EncodeBase256(w)
+}
+
+// decodePayInfo parses the aggregated relay parameters of a blinded payment
+// path.
+func decodePayInfo(r io.Reader) (*routing.BlindedPayment, error) {
+	var b [28]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, fmt.Errorf("invalid blinded payinfo: %w", err)
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	err := features.DecodeBase256(
+		r, int(binary.BigEndian.Uint16(b[26:28])),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blinded payinfo features: %w",
+			err)
+	}
+
+	return &routing.BlindedPayment{
+		BaseFee:             binary.BigEndian.Uint32(b[0:4]),
+		ProportionalFeeRate: binary.BigEndian.Uint32(b[4:8]),
+		CltvExpiryDelta:     binary.BigEndian.Uint16(b[8:10]),
+		HtlcMinimum:         binary.BigEndian.Uint64(b[10:18]),
+		HtlcMaximum:         binary.BigEndian.Uint64(b[18:26]),
+		Features: lnwire.NewFeatureVector(
+			features, lnwire.Features,
+		),
+	}, nil
+}
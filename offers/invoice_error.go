@@ -0,0 +1,79 @@
+package offers
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	invoiceErrorFieldType   tlv.Type = 1
+	invoiceErrorMessageType tlv.Type = 5
+)
+
+// InvoiceError is sent in reply to an invoice request or an invoice that
+// can't be handled by its recipient.
+type InvoiceError struct {
+	// ErroneousField is the type of the record that caused the error, if
+	// the error is related to a specific record.
+	ErroneousField fn.Option[uint64]
+
+	// Message is a human readable description of the error.
+	Message string
+}
+
+// Error returns the description of the invoice error.
+//
+// NOTE: This is part of the error interface.
+func (e *InvoiceError) Error() string {
+	return fmt.Sprintf("invoice error: %v", e.Message)
+}
+
+// Encode serializes the invoice error as a TLV stream.
+func (e *InvoiceError) Encode() ([]byte, error) {
+	message := []byte(e.Message)
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(invoiceErrorMessageType, &message),
+	}
+
+	e.ErroneousField.WhenSome(func(field uint64) {
+		records = append(
+			records, tu64Record(invoiceErrorFieldType, &field),
+		)
+	})
+
+	var b bytes.Buffer
+	if err := encodeRecords(&b, records); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeInvoiceError parses a serialized invoice error.
+func DecodeInvoiceError(data []byte) (*InvoiceError, error) {
+	var (
+		message []byte
+		field   uint64
+	)
+	parsed, err := decodeStream(data, []tlv.Record{
+		tu64Record(invoiceErrorFieldType, &field),
+		tlv.MakePrimitiveRecord(invoiceErrorMessageType, &message),
+	}, func(uint64) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceErr := &InvoiceError{
+		Message: string(message),
+	}
+	if _, ok := parsed[invoiceErrorFieldType]; ok {
+		invoiceErr.ErroneousField = fn.Some(field)
+	}
+
+	return invoiceErr, nil
+}
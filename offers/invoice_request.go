@@ -0,0 +1,327 @@
+package offers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	invreqMetadataType  tlv.Type = 0
+	invreqChainType     tlv.Type = 80
+	invreqAmountType    tlv.Type = 82
+	invreqFeaturesType  tlv.Type = 84
+	invreqQuantityType  tlv.Type = 86
+	invreqPayerIDType   tlv.Type = 88
+	invreqPayerNoteType tlv.Type = 89
+
+	// invoiceRequestName is the name of the invoice request message that
+	// is used in its signature tag.
+	invoiceRequestName = "invoice_request"
+)
+
+// isInvoiceRequestType returns true if the given TLV type is in one of the
+// ranges that are reserved for invoice request fields, excluding the offer
+// fields that are copied into invoice requests.
+func isInvoiceRequestType(typ uint64) bool {
+	return typ == 0 || (typ >= 80 && typ <= 159) ||
+		(typ >= 2_000_000_000 && typ <= 2_999_999_999)
+}
+
+// InvoiceRequest is a BOLT 12 invoice request, which is sent by a payer to the
+// issuer of an offer to request an invoice for it.
+type InvoiceRequest struct {
+	// Offer is the offer that an invoice is requested for. All its fields
+	// are copied into the invoice request.
+	Offer *Offer
+
+	// Metadata is opaque data that is set by the payer to make the
+	// invoice request unique.
+	Metadata []byte
+
+	// Chain is the chain that the invoice should be paid on. If nil,
+	// bitcoin mainnet is implied.
+	Chain *chainhash.Hash
+
+	// Amount is the amount that the payer wants to pay in millisatoshis.
+	// If zero, the amount of the offer is used.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features of the invoice request.
+	Features *lnwire.RawFeatureVector
+
+	// Quantity is the number of items to pay for, if the offer has a
+	// maximum quantity. Zero means that the quantity isn't set.
+	Quantity uint64
+
+	// PayerID is a transient public key of the payer, which is used to
+	// sign the invoice request.
+	PayerID *btcec.PublicKey
+
+	// PayerNote is a note from the payer to the issuer of the offer.
+	PayerNote string
+
+	// Signature is the signature of the invoice request by the payer ID.
+	Signature [64]byte
+
+	// ExtraRecords holds the unknown odd records of the invoice request,
+	// which have to be copied into the invoice.
+	ExtraRecords map[uint64][]byte
+}
+
+// ChainHash returns the chain that the invoice should be paid on.
+func (r *InvoiceRequest) ChainHash() chainhash.Hash {
+	if r.Chain != nil {
+		return *r.Chain
+	}
+
+	return *bitcoinChain
+}
+
+// records returns the TLV records of the invoice request, including the offer
+// fields but excluding the signature.
+func (r *InvoiceRequest) records() ([]tlv.Record, error) {
+	records, err := r.Offer.records()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Metadata != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				invreqMetadataType, &r.Metadata,
+			),
+		)
+	}
+
+	if r.Chain != nil {
+		chain := [32]byte(*r.Chain)
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				invreqChainType, &chain,
+			),
+		)
+	}
+
+	if r.Amount != 0 {
+		amount := uint64(r.Amount)
+		records = append(records, tu64Record(invreqAmountType, &amount))
+	}
+
+	if r.Features != nil && !r.Features.IsEmpty() {
+		records = append(
+			records, r.Features.Record(invreqFeaturesType),
+		)
+	}
+
+	if r.Quantity != 0 {
+		records = append(
+			records, tu64Record(invreqQuantityType, &r.Quantity),
+		)
+	}
+
+	if r.PayerID != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				invreqPayerIDType, &r.PayerID,
+			),
+		)
+	}
+
+	if r.PayerNote != "" {
+		note := []byte(r.PayerNote)
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				invreqPayerNoteType, &note,
+			),
+		)
+	}
+
+	records = append(records, tlv.MapToRecords(r.ExtraRecords)...)
+
+	return records, nil
+}
+
+// encode serializes the invoice request, optionally without its signature.
+func (r *InvoiceRequest) encode(w io.Writer, withSig bool) error {
+	records, err := r.records()
+	if err != nil {
+		return err
+	}
+
+	if withSig {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				signatureType, &r.Signature,
+			),
+		)
+	}
+
+	return encodeRecords(w, records)
+}
+
+// Encode serializes the invoice request as a TLV stream.
+func (r *InvoiceRequest) Encode(w io.Writer) error {
+	return r.encode(w, true)
+}
+
+// Sign signs the invoice request with the private key of its payer ID.
+func (r *InvoiceRequest) Sign(key *btcec.PrivateKey) error {
+	var b bytes.Buffer
+	if err := r.encode(&b, false); err != nil {
+		return err
+	}
+
+	sig, err := sign(invoiceRequestName, b.Bytes(), KeySigner(key))
+	if err != nil {
+		return err
+	}
+	r.Signature = sig
+
+	return nil
+}
+
+// Validate checks that the invoice request is well formed and signed by its
+// payer ID.
+func (r *InvoiceRequest) Validate() error {
+	if err := r.Offer.Validate(); err != nil {
+		return err
+	}
+
+	switch {
+	case r.Metadata == nil:
+		return errors.New("invoice request has no metadata")
+
+	case r.PayerID == nil:
+		return errors.New("invoice request has no payer id")
+	}
+
+	if r.Features != nil {
+		if err := validateFeatures(r.Features, nil); err != nil {
+			return err
+		}
+	}
+
+	var b bytes.Buffer
+	if err := r.encode(&b, false); err != nil {
+		return err
+	}
+
+	return verifySignature(
+		invoiceRequestName, b.Bytes(), r.Signature, r.PayerID,
+	)
+}
+
+// invoiceRequestDecoder holds the values of the invoice request records while
+// a TLV stream is decoded.
+type invoiceRequestDecoder struct {
+	offerFields offerDecoder
+
+	metadata, payerNote []byte
+
+	chain [32]byte
+
+	amount, quantity uint64
+
+	features *lnwire.RawFeatureVector
+
+	payerID *btcec.PublicKey
+
+	signature [64]byte
+}
+
+// records returns the records to decode the invoice request fields into,
+// including the offer fields.
+func (d *invoiceRequestDecoder) records() []tlv.Record {
+	d.features = lnwire.NewRawFeatureVector()
+
+	return append(
+		d.offerFields.records(),
+		tlv.MakePrimitiveRecord(invreqMetadataType, &d.metadata),
+		tlv.MakePrimitiveRecord(invreqChainType, &d.chain),
+		tu64Record(invreqAmountType, &d.amount),
+		d.features.Record(invreqFeaturesType),
+		tu64Record(invreqQuantityType, &d.quantity),
+		tlv.MakePrimitiveRecord(invreqPayerIDType, &d.payerID),
+		tlv.MakePrimitiveRecord(invreqPayerNoteType, &d.payerNote),
+		tlv.MakePrimitiveRecord(signatureType, &d.signature),
+	)
+}
+
+// invoiceRequest returns the invoice request that was decoded.
+func (d *invoiceRequestDecoder) invoiceRequest(
+	parsed tlv.TypeMap) (*InvoiceRequest, error) {
+
+	offer, err := d.offerFields.offer(parsed)
+	if err != nil {
+		return nil, err
+	}
+	offer.ExtraRecords = extraRecords(parsed, isOfferType)
+
+	req := &InvoiceRequest{
+		Offer:        offer,
+		Amount:       lnwire.MilliSatoshi(d.amount),
+		PayerID:      d.payerID,
+		PayerNote:    string(d.payerNote),
+		ExtraRecords: extraRecords(parsed, isInvoiceRequestType),
+	}
+
+	if _, ok := parsed[invreqMetadataType]; ok {
+		req.Metadata = d.metadata
+	}
+
+	if _, ok := parsed[invreqChainType]; ok {
+		chain := chainhash.Hash(d.chain)
+		req.Chain = &chain
+	}
+
+	if _, ok := parsed[invreqAmountType]; ok && d.amount == 0 {
+		return nil, errors.New("invoice request amount must not be " +
+			"zero")
+	}
+
+	if _, ok := parsed[invreqFeaturesType]; ok {
+		req.Features = d.features
+	}
+
+	if _, ok := parsed[invreqQuantityType]; ok && d.quantity == 0 {
+		return nil, errors.New("invoice request quantity must not " +
+			"be zero")
+	}
+	req.Quantity = d.quantity
+
+	return req, nil
+}
+
+// DecodeInvoiceRequest parses a serialized invoice request and checks that
+// it's well formed and correctly signed.
+func DecodeInvoiceRequest(data []byte) (*InvoiceRequest, error) {
+	var d invoiceRequestDecoder
+	parsed, err := decodeStream(data, d.records(), func(typ uint64) bool {
+		return isOfferType(typ) || isInvoiceRequestType(typ)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsed[signatureType]; !ok {
+		return nil, errors.New("invoice request has no signature")
+	}
+
+	req, err := d.invoiceRequest(parsed)
+	if err != nil {
+		return nil, err
+	}
+	req.Signature = d.signature
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
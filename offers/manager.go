@@ -0,0 +1,767 @@
+package offers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+const (
+	// InvoiceRequestRecordType is the type of the onion message record
+	// that carries an invoice request.
+	InvoiceRequestRecordType = 64
+
+	// InvoiceRecordType is the type of the onion message record that
+	// carries an invoice.
+	InvoiceRecordType = 66
+
+	// InvoiceErrorRecordType is the type of the onion message record that
+	// carries an invoice error.
+	InvoiceErrorRecordType = 68
+
+	// DefaultRequestTimeout is the default time that we wait for an
+	// invoice after sending an invoice request.
+	DefaultRequestTimeout = time.Minute
+
+	// metadataNonceSize is the size of the random nonce that is part of
+	// the metadata of the offers that we create.
+	metadataNonceSize = 16
+
+	// requestQueueSize is the number of received invoice requests that can
+	// be waiting to be processed. Any requests that are received while the
+	// queue is full are dropped.
+	requestQueueSize = 100
+)
+
+var (
+	// ErrRequestTimeout is returned when no invoice is received in reply
+	// to an invoice request before the timeout expires.
+	ErrRequestTimeout = errors.New("timed out waiting for invoice")
+
+	// ErrOfferExpired is returned when an invoice is requested for an
+	// offer that has expired.
+	ErrOfferExpired = errors.New("offer has expired")
+
+	// ErrUnsupportedChain is returned when an invoice is requested for an
+	// offer that isn't valid for our chain.
+	ErrUnsupportedChain = errors.New("offer is not valid for our chain")
+
+	// ErrUnsupportedCurrency is returned when an invoice is requested for
+	// an offer that is denominated in a currency other than bitcoin.
+	ErrUnsupportedCurrency = errors.New("offers in currencies other " +
+		"than bitcoin are not supported")
+
+	// ErrUnknownOffer is returned when an invoice request is received for
+	// an offer that wasn't created by our node.
+	ErrUnknownOffer = errors.New("offer was not created by us")
+)
+
+// Messenger sends and receives the onion messages that carry invoice
+// requests and invoices.
+type Messenger interface {
+	// SendMessage sends an onion message to a node or blinded path.
+	SendMessage(req *onionmessage.SendRequest) error
+
+	// SubscribeMessages returns a subscribe.Client that receives an
+	// onionmessage.ReceivedMessage for each onion message that is
+	// addressed to our node.
+	SubscribeMessages() (*subscribe.Client, error)
+}
+
+// Config holds the resources that the Manager needs to create offers, answer
+// invoice requests and request invoices for offers.
+type Config struct {
+	// NodeKey is the identity key of our node. It's used as the issuer ID
+	// of the offers that we create, and to derive the key that
+	// authenticates their metadata.
+	NodeKey keychain.SingleKeyECDH
+
+	// SignMessage signs with the private key of our node.
+	SignMessage MessageSigner
+
+	// ChainParams are the parameters of the chain that we're on.
+	ChainParams *chaincfg.Params
+
+	// Messenger sends and receives onion messages.
+	Messenger Messenger
+
+	// AddInvoice adds an invoice with blinded payment paths to our
+	// invoice registry, and returns its payment hash and paths.
+	AddInvoice func(ctx context.Context, amt lnwire.MilliSatoshi,
+		memo string, expiry time.Duration) (lntypes.Hash,
+		[]*routing.BlindedPayment, error)
+
+	// InvoiceFeatures is the set of features of the invoices that we
+	// create.
+	InvoiceFeatures *lnwire.RawFeatureVector
+
+	// InvoiceExpiry is the relative expiry of the invoices that we create.
+	InvoiceExpiry time.Duration
+
+	// RequestTimeout is the time that we wait for an invoice after
+	// sending an invoice request.
+	RequestTimeout time.Duration
+
+	// Clock is used to get the current time.
+	Clock clock.Clock
+}
+
+// OfferParams holds the fields of an offer that are chosen by its issuer.
+type OfferParams struct {
+	// Description describes the purpose of the payment.
+	Description string
+
+	// Amount is the amount that is requested by the offer. If zero, the
+	// payer can choose the amount.
+	Amount lnwire.MilliSatoshi
+
+	// Issuer identifies the issuer of the offer in a human readable way.
+	Issuer string
+
+	// AbsoluteExpiry is the time after which the offer expires. If zero,
+	// the offer doesn't expire.
+	AbsoluteExpiry time.Time
+
+	// QuantityMax is set if the offer can be paid for multiple items. A
+	// value of zero means that there's no limit on the quantity.
+	QuantityMax fn.Option[uint64]
+}
+
+// Manager creates offers and answers the invoice requests that are received
+// for them, and requests invoices for the offers of other nodes.
+//
+// The offers that we create aren't stored. Instead, their metadata holds a
+// nonce and an HMAC over the offer fields, which lets us recognize our own
+// offers when an invoice request is received for them.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// metadataKey is the key of the HMAC in the metadata of our offers.
+	metadataKey [32]byte
+
+	// pending maps the path IDs of the reply paths of our outgoing invoice
+	// requests to the channel that the reply is delivered to.
+	pending   map[string]chan *onionmessage.ReceivedMessage
+	pendingMu sync.Mutex
+
+	requests chan *onionmessage.ReceivedMessage
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new Manager from the given config.
+func New(cfg *Config) *Manager {
+	return &Manager{
+		cfg:     cfg,
+		pending: make(map[string]chan *onionmessage.ReceivedMessage),
+		requests: make(
+			chan *onionmessage.ReceivedMessage, requestQueueSize,
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the Manager.
+func (m *Manager) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Info("Offers manager starting")
+
+		// The HMAC key is derived from a Diffie-Hellman exchange of
+		// our node key with itself, so that it's stable across
+		// restarts without having to be stored.
+		m.metadataKey, err = m.cfg.NodeKey.ECDH(m.cfg.NodeKey.PubKey())
+		if err != nil {
+			err = fmt.Errorf("unable to derive offer metadata "+
+				"key: %w", err)
+			return
+		}
+
+		var client *subscribe.Client
+		client, err = m.cfg.Messenger.SubscribeMessages()
+		if err != nil {
+			return
+		}
+
+		m.wg.Add(2)
+		go m.receiveMessages(client)
+		go m.processRequests()
+	})
+
+	return err
+}
+
+// Stop stops the Manager.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Offers manager shutting down...")
+		defer log.Debug("Offers manager shutdown complete")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+
+	return nil
+}
+
+// chainHash returns the genesis hash of our chain.
+func (m *Manager) chainHash() chainhash.Hash {
+	return *m.cfg.ChainParams.GenesisHash
+}
+
+// CreateOffer creates a new offer for our node. The offer isn't stored, so
+// it can be paid until it expires.
+func (m *Manager) CreateOffer(params *OfferParams) (*Offer, error) {
+	offer := &Offer{
+		Amount:         uint64(params.Amount),
+		Description:    params.Description,
+		Issuer:         params.Issuer,
+		AbsoluteExpiry: params.AbsoluteExpiry,
+		QuantityMax:    params.QuantityMax,
+		IssuerID:       m.cfg.NodeKey.PubKey(),
+	}
+
+	// Offers are for bitcoin mainnet unless they specify otherwise.
+	if chain := m.chainHash(); chain != *bitcoinChain {
+		offer.Chains = []chainhash.Hash{chain}
+	}
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, metadataNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	mac, err := m.metadataMAC(offer, nonce)
+	if err != nil {
+		return nil, err
+	}
+	offer.Metadata = append(nonce, mac...)
+
+	return offer, nil
+}
+
+// metadataMAC returns the HMAC over the given nonce and all fields of the
+// offer except for its metadata.
+func (m *Manager) metadataMAC(offer *Offer, nonce []byte) ([]byte, error) {
+	unauthenticated := *offer
+	unauthenticated.Metadata = nil
+
+	var b bytes.Buffer
+	if err := unauthenticated.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, m.metadataKey[:])
+	mac.Write(nonce)
+	mac.Write(b.Bytes())
+
+	return mac.Sum(nil), nil
+}
+
+// verifyMetadata checks that the given offer was created by our node.
+func (m *Manager) verifyMetadata(offer *Offer) error {
+	if len(offer.Metadata) != metadataNonceSize+sha256.Size {
+		return ErrUnknownOffer
+	}
+
+	nonce := offer.Metadata[:metadataNonceSize]
+	mac, err := m.metadataMAC(offer, nonce)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal(mac, offer.Metadata[metadataNonceSize:]) {
+		return ErrUnknownOffer
+	}
+
+	return nil
+}
+
+// requestAmount returns the amount that has to be paid for the given
+// quantity of an offer. The given amount is the amount chosen by the payer,
+// or zero if the payer pays the amount of the offer.
+func requestAmount(offer *Offer, amt lnwire.MilliSatoshi,
+	quantity uint64) (lnwire.MilliSatoshi, error) {
+
+	if offer.Currency != "" {
+		return 0, ErrUnsupportedCurrency
+	}
+
+	hasQuantity := offer.QuantityMax.IsSome()
+	quantityMax := offer.QuantityMax.UnwrapOr(0)
+	switch {
+	case hasQuantity && quantity == 0:
+		return 0, errors.New("offer requires a quantity")
+
+	case hasQuantity && quantityMax != 0 && quantity > quantityMax:
+		return 0, fmt.Errorf("quantity %d exceeds maximum of %d",
+			quantity, quantityMax)
+
+	case !hasQuantity && quantity != 0:
+		return 0, errors.New("offer doesn't support a quantity")
+	}
+
+	// The offer doesn't specify an amount, so the payer has to choose
+	// one.
+	if offer.Amount == 0 {
+		if amt == 0 {
+			return 0, errors.New("amount must be specified for " +
+				"offers without an amount")
+		}
+
+		return amt, nil
+	}
+
+	minAmt := lnwire.MilliSatoshi(offer.Amount)
+	if quantity > 1 {
+		minAmt *= lnwire.MilliSatoshi(quantity)
+	}
+
+	switch {
+	case amt == 0:
+		return minAmt, nil
+
+	case amt < minAmt:
+		return 0, fmt.Errorf("amount %v is below the offer amount %v",
+			amt, minAmt)
+	}
+
+	return amt, nil
+}
+
+// receiveMessages dispatches the onion messages that are addressed to our
+// node. Invoice requests are queued for processing, and replies to our own
+// invoice requests are delivered to the waiting caller.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) receiveMessages(client *subscribe.Client) {
+	defer m.wg.Done()
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg, ok := update.(*onionmessage.ReceivedMessage)
+			if !ok {
+				continue
+			}
+
+			m.dispatchMessage(msg)
+
+		case <-client.Quit():
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// dispatchMessage queues a received invoice request for processing, or
+// delivers a reply to one of our invoice requests to the waiting caller.
+func (m *Manager) dispatchMessage(msg *onionmessage.ReceivedMessage) {
+	if _, ok := msg.Records[InvoiceRequestRecordType]; ok {
+		select {
+		case m.requests <- msg:
+		default:
+			log.Debugf("Dropping invoice request, queue full")
+		}
+
+		return
+	}
+
+	if msg.PathID == nil {
+		return
+	}
+
+	m.pendingMu.Lock()
+	replyChan, ok := m.pending[string(msg.PathID)]
+	m.pendingMu.Unlock()
+	if !ok {
+		return
+	}
+
+	// Only the first reply is delivered, any further replies to the
+	// same request are dropped.
+	select {
+	case replyChan <- msg:
+	default:
+	}
+}
+
+// processRequests answers the invoice requests that we receive.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) processRequests() {
+	defer m.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		select {
+		case msg := <-m.requests:
+			if msg.ReplyPath == nil {
+				log.Debugf("Ignoring invoice request without " +
+					"reply path")
+
+				continue
+			}
+
+			err := m.handleInvoiceRequest(ctx, msg)
+			if err != nil {
+				log.Debugf("Unable to handle invoice request: "+
+					"%v", err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// handleInvoiceRequest creates an invoice for a received invoice request and
+// sends it back along the reply path of the request. If no invoice can be
+// created, an invoice error is sent back instead.
+func (m *Manager) handleInvoiceRequest(ctx context.Context,
+	msg *onionmessage.ReceivedMessage) error {
+
+	invoice, err := m.createInvoice(
+		ctx, msg.Records[InvoiceRequestRecordType],
+	)
+
+	// Requests for offers that aren't ours are ignored, so that we don't
+	// reveal anything about the offers of other nodes.
+	if errors.Is(err, ErrUnknownOffer) {
+		return err
+	}
+
+	var (
+		recordType uint64
+		record     []byte
+	)
+	if err != nil {
+		invoiceErr := &InvoiceError{
+			Message: err.Error(),
+		}
+
+		recordType = InvoiceErrorRecordType
+		record, err = invoiceErr.Encode()
+		if err != nil {
+			return err
+		}
+	} else {
+		var b bytes.Buffer
+		if err := invoice.Encode(&b); err != nil {
+			return err
+		}
+
+		recordType = InvoiceRecordType
+		record = b.Bytes()
+	}
+
+	return m.cfg.Messenger.SendMessage(&onionmessage.SendRequest{
+		BlindedPath: msg.ReplyPath,
+		Records: map[uint64][]byte{
+			recordType: record,
+		},
+	})
+}
+
+// createInvoice validates a serialized invoice request for one of our offers,
+// and adds and signs an invoice for it.
+func (m *Manager) createInvoice(ctx context.Context,
+	data []byte) (*Invoice, error) {
+
+	req, err := DecodeInvoiceRequest(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ourKey := m.cfg.NodeKey.PubKey()
+	if req.Offer.IssuerID == nil || !req.Offer.IssuerID.IsEqual(ourKey) {
+		return nil, ErrUnknownOffer
+	}
+	if err := m.verifyMetadata(req.Offer); err != nil {
+		return nil, err
+	}
+
+	now := m.cfg.Clock.Now()
+	switch {
+	case req.ChainHash() != m.chainHash():
+		return nil, ErrUnsupportedChain
+
+	case req.Offer.Expired(now):
+		return nil, ErrOfferExpired
+	}
+
+	amt, err := requestAmount(req.Offer, req.Amount, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := m.cfg.InvoiceExpiry
+	if expiry == 0 {
+		expiry = DefaultInvoiceExpiry
+	}
+
+	hash, paths, err := m.cfg.AddInvoice(
+		ctx, amt, req.Offer.Description, expiry,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add invoice: %w", err)
+	}
+
+	invoice := &Invoice{
+		Request:        req,
+		Paths:          paths,
+		CreatedAt:      now,
+		RelativeExpiry: expiry,
+		PaymentHash:    hash,
+		Amount:         amt,
+		Features:       m.cfg.InvoiceFeatures,
+		NodeID:         ourKey,
+	}
+	if err := invoice.Sign(m.cfg.SignMessage); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created invoice %v for offer %x with amount %v", hash,
+		req.Offer.Metadata, amt)
+
+	return invoice, nil
+}
+
+// RequestInvoice requests an invoice for an offer from its issuer, and waits
+// for the invoice to be sent back. The amount is only required if the offer
+// doesn't specify one, and the quantity is only allowed if the offer supports
+// it.
+func (m *Manager) RequestInvoice(ctx context.Context, offer *Offer,
+	amt lnwire.MilliSatoshi, quantity uint64,
+	payerNote string) (*Invoice, error) {
+
+	switch {
+	case !offer.ChainSupported(m.chainHash()):
+		return nil, ErrUnsupportedChain
+
+	case offer.Expired(m.cfg.Clock.Now()):
+		return nil, ErrOfferExpired
+	}
+
+	amt, err := requestAmount(offer, amt, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every invoice request is signed with a new payer key, so that our
+	// payments can't be linked to each other.
+	payerKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]byte, 32)
+	if _, err := rand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	req := &InvoiceRequest{
+		Offer:     offer,
+		Metadata:  metadata,
+		Amount:    amt,
+		Quantity:  quantity,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: payerNote,
+	}
+	if chain := m.chainHash(); chain != *bitcoinChain {
+		req.Chain = &chain
+	}
+	if err := req.Sign(payerKey); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := req.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	// The invoice is sent back along a reply path that is identified by
+	// a random path ID.
+	pathID := make([]byte, 32)
+	if _, err := rand.Read(pathID); err != nil {
+		return nil, err
+	}
+
+	replyChan := make(chan *onionmessage.ReceivedMessage, 1)
+	m.pendingMu.Lock()
+	m.pending[string(pathID)] = replyChan
+	m.pendingMu.Unlock()
+
+	defer func() {
+		m.pendingMu.Lock()
+		delete(m.pending, string(pathID))
+		m.pendingMu.Unlock()
+	}()
+
+	path, err := m.sendInvoiceRequest(offer, b.Bytes(), pathID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := m.cfg.RequestTimeout
+	if timeout == 0 {
+		timeout = DefaultRequestTimeout
+	}
+
+	var reply *onionmessage.ReceivedMessage
+	select {
+	case reply = <-replyChan:
+
+	case <-m.cfg.Clock.TickAfter(timeout):
+		return nil, ErrRequestTimeout
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-m.quit:
+		return nil, errors.New("offers manager shutting down")
+	}
+
+	if data, ok := reply.Records[InvoiceErrorRecordType]; ok {
+		invoiceErr, err := DecodeInvoiceError(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice error: %w", err)
+		}
+
+		return nil, invoiceErr
+	}
+
+	data, ok := reply.Records[InvoiceRecordType]
+	if !ok {
+		return nil, errors.New("reply contains no invoice")
+	}
+
+	invoice, err := DecodeInvoice(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice: %w", err)
+	}
+
+	err = m.validateInvoice(req, invoice, amt, path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice: %w", err)
+	}
+
+	return invoice, nil
+}
+
+// sendInvoiceRequest sends a serialized invoice request to the issuer of an
+// offer. The paths of the offer are tried in order, falling back to the
+// issuer ID. The blinded path that the request was sent along is returned,
+// or nil if it was sent to the issuer ID.
+func (m *Manager) sendInvoiceRequest(offer *Offer, req []byte,
+	pathID []byte) (*sphinx.BlindedPath, error) {
+
+	records := map[uint64][]byte{
+		InvoiceRequestRecordType: req,
+	}
+
+	var err error
+	for _, path := range offer.Paths {
+		err = m.cfg.Messenger.SendMessage(&onionmessage.SendRequest{
+			BlindedPath: path,
+			Records:     records,
+			ReplyPathID: pathID,
+		})
+		if err == nil {
+			return path, nil
+		}
+
+		log.Debugf("Unable to send invoice request along blinded "+
+			"path: %v", err)
+	}
+
+	if offer.IssuerID == nil {
+		return nil, fmt.Errorf("unable to send invoice request: %w",
+			err)
+	}
+
+	err = m.cfg.Messenger.SendMessage(&onionmessage.SendRequest{
+		Destination: route.NewVertex(offer.IssuerID),
+		Records:     records,
+		ReplyPathID: pathID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to send invoice request: %w",
+			err)
+	}
+
+	return nil, nil
+}
+
+// validateInvoice checks that an invoice that we received is a valid reply to
+// our invoice request.
+func (m *Manager) validateInvoice(req *InvoiceRequest, invoice *Invoice,
+	amt lnwire.MilliSatoshi, path *sphinx.BlindedPath) error {
+
+	var ours, theirs bytes.Buffer
+	if err := req.encode(&ours, false); err != nil {
+		return err
+	}
+	if err := invoice.Request.encode(&theirs, false); err != nil {
+		return err
+	}
+	if !bytes.Equal(ours.Bytes(), theirs.Bytes()) {
+		return errors.New("invoice doesn't match invoice request")
+	}
+
+	// The invoice must be signed by the issuer of the offer. If the offer
+	// doesn't have an issuer ID, it must be signed by the final node of
+	// the path that we sent the request along.
+	issuer := req.Offer.IssuerID
+	if issuer == nil {
+		hops := path.BlindedHops
+		issuer = hops[len(hops)-1].BlindedNodePub
+	}
+	if !invoice.NodeID.IsEqual(issuer) {
+		return errors.New("invoice not signed by offer issuer")
+	}
+
+	switch {
+	case invoice.Amount != amt:
+		return fmt.Errorf("invoice amount %v doesn't match requested "+
+			"amount %v", invoice.Amount, amt)
+
+	case !m.cfg.Clock.Now().Before(invoice.Expiry()):
+		return errors.New("invoice has expired")
+	}
+
+	return nil
+}
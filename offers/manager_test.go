@@ -0,0 +1,228 @@
+package offers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// maxTestAmt is the largest amount that the test nodes create invoices for.
+const maxTestAmt = lnwire.MilliSatoshi(1_000_000)
+
+// testGraph is an in-memory onionmessage.Graph where all nodes are connected
+// to each other.
+type testGraph []route.Vertex
+
+func (g testGraph) ForEachNeighbor(node route.Vertex,
+	cb func(route.Vertex) error) error {
+
+	for _, neighbor := range g {
+		if neighbor == node {
+			continue
+		}
+
+		if err := cb(neighbor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g testGraph) SupportsOnionMessages(route.Vertex) (bool, error) {
+	return true, nil
+}
+
+// testNode is a node with an offers manager.
+type testNode struct {
+	key     *btcec.PrivateKey
+	manager *Manager
+
+	// invoices holds the amounts of the invoices that were added by the
+	// manager.
+	invoices chan lnwire.MilliSatoshi
+}
+
+// newTestNodes creates a set of connected nodes with offers managers that
+// send their onion messages to each other directly.
+func newTestNodes(t *testing.T, numNodes int) []*testNode {
+	t.Helper()
+
+	var (
+		graph      testGraph
+		nodes      = make([]*testNode, numNodes)
+		messengers = make(map[route.Vertex]*onionmessage.Messenger)
+	)
+	for i := range nodes {
+		nodes[i] = &testNode{
+			key:      newTestKey(t),
+			invoices: make(chan lnwire.MilliSatoshi, 10),
+		}
+		graph = append(graph, route.NewVertex(nodes[i].key.PubKey()))
+	}
+
+	for i, node := range nodes {
+		self := graph[i]
+		nodeKey := &keychain.PrivKeyECDH{PrivKey: node.key}
+
+		messenger := onionmessage.New(&onionmessage.Config{
+			NodeKey: nodeKey,
+			SendToPeer: func(peer route.Vertex,
+				msg *lnwire.OnionMessage) error {
+
+				target, ok := messengers[peer]
+				if !ok {
+					return fmt.Errorf("unknown peer")
+				}
+
+				target.HandleMessage(self, msg)
+
+				return nil
+			},
+			Peers: func() []route.Vertex {
+				var peers []route.Vertex
+				err := graph.ForEachNeighbor(
+					self, func(peer route.Vertex) error {
+						peers = append(peers, peer)
+						return nil
+					},
+				)
+				require.NoError(t, err)
+
+				return peers
+			},
+			Graph: graph,
+		})
+		require.NoError(t, messenger.Start())
+		t.Cleanup(func() {
+			require.NoError(t, messenger.Stop())
+		})
+		messengers[self] = messenger
+
+		node := node
+		node.manager = New(&Config{
+			NodeKey:     nodeKey,
+			SignMessage: KeySigner(node.key),
+			ChainParams: &chaincfg.RegressionNetParams,
+			Messenger:   messenger,
+			AddInvoice: func(_ context.Context,
+				amt lnwire.MilliSatoshi, _ string,
+				_ time.Duration) (lntypes.Hash,
+				[]*routing.BlindedPayment, error) {
+
+				if amt > maxTestAmt {
+					return lntypes.Hash{}, nil,
+						fmt.Errorf("amount too large")
+				}
+
+				node.invoices <- amt
+
+				path := &routing.BlindedPayment{
+					BlindedPath: newTestPath(
+						t, 2, node.key.PubKey(),
+					),
+					HtlcMaximum: uint64(amt),
+				}
+
+				return lntypes.Hash{1},
+					[]*routing.BlindedPayment{path}, nil
+			},
+			InvoiceFeatures: lnwire.NewRawFeatureVector(
+				lnwire.MPPOptional,
+			),
+			RequestTimeout: 5 * time.Second,
+			Clock:          clock.NewDefaultClock(),
+		})
+		require.NoError(t, node.manager.Start())
+		t.Cleanup(func() {
+			require.NoError(t, node.manager.Stop())
+		})
+	}
+
+	return nodes
+}
+
+// TestRequestInvoice tests that an invoice can be requested for an offer, and
+// that invalid invoice requests are answered with an invoice error.
+func TestRequestInvoice(t *testing.T) {
+	t.Parallel()
+
+	nodes := newTestNodes(t, 3)
+	alice, carol := nodes[0], nodes[2]
+
+	offer, err := carol.manager.CreateOffer(&OfferParams{
+		Description: "coffee",
+		Amount:      1000,
+		QuantityMax: fn.Some(uint64(3)),
+	})
+	require.NoError(t, err)
+	require.True(t, offer.IssuerID.IsEqual(carol.key.PubKey()))
+	require.True(t, offer.ChainSupported(
+		*chaincfg.RegressionNetParams.GenesisHash,
+	))
+
+	// The offer must survive its encoding to be recognized by Carol.
+	offer, err = DecodeOffer(offer.String())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	invoice, err := alice.manager.RequestInvoice(ctx, offer, 0, 2, "hi")
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(2000), invoice.Amount)
+	require.Equal(t, "hi", invoice.Request.PayerNote)
+	require.True(t, invoice.NodeID.IsEqual(carol.key.PubKey()))
+	require.Len(t, invoice.Paths, 1)
+	require.Equal(t, lnwire.MilliSatoshi(2000), <-carol.invoices)
+
+	// Invoices can't be requested for a quantity that isn't allowed by
+	// the offer.
+	_, err = alice.manager.RequestInvoice(ctx, offer, 0, 4, "")
+	require.ErrorContains(t, err, "exceeds maximum")
+
+	// If the issuer can't create an invoice, it replies with an invoice
+	// error.
+	_, err = alice.manager.RequestInvoice(
+		ctx, offer, maxTestAmt+1, 1, "",
+	)
+
+	var invoiceErr *InvoiceError
+	require.ErrorAs(t, err, &invoiceErr)
+	require.Contains(t, invoiceErr.Message, "amount too large")
+}
+
+// TestRequestInvoiceUnknownOffer tests that invoice requests for offers that
+// weren't created by the issuer are ignored.
+func TestRequestInvoiceUnknownOffer(t *testing.T) {
+	t.Parallel()
+
+	nodes := newTestNodes(t, 2)
+	alice, bob := nodes[0], nodes[1]
+
+	offer, err := bob.manager.CreateOffer(&OfferParams{
+		Description: "coffee",
+	})
+	require.NoError(t, err)
+
+	// Tamper with the metadata so that Bob doesn't recognize the offer.
+	offer.Metadata[0] ^= 1
+
+	alice.manager.cfg.RequestTimeout = time.Second
+	_, err = alice.manager.RequestInvoice(
+		context.Background(), offer, 1000, 0, "",
+	)
+	require.ErrorIs(t, err, ErrRequestTimeout)
+	require.Empty(t, bob.invoices)
+}
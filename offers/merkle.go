@@ -0,0 +1,207 @@
+package offers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// leafTag is the tag of the hashes of the TLV records that form the
+	// leaves of the merkle tree.
+	leafTag = []byte("LnLeaf")
+
+	// nonceTag is the prefix of the tag of the nonce leaves of the merkle
+	// tree, which prevent the leaves from being guessed when only some of
+	// the records are revealed.
+	nonceTag = []byte("LnNonce")
+
+	// branchTag is the tag of the inner nodes of the merkle tree.
+	branchTag = []byte("LnBranch")
+
+	// ErrInvalidSignature is returned when the signature of an invoice
+	// request or an invoice doesn't verify.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// signatureTag returns the tag that is used to sign the merkle root of the
+// given message, for example "lightninginvoice_requestsignature".
+func signatureTag(messageName string) []byte {
+	return []byte("lightning" + messageName + "signature")
+}
+
+// isSignatureType returns true if the given TLV type is in the range that is
+// reserved for signatures, which aren't part of the merkle tree.
+func isSignatureType(typ uint64) bool {
+	return typ >= 240 && typ <= 1000
+}
+
+// rawRecord is a single serialized TLV record.
+type rawRecord struct {
+	// typ is the serialized type of the record.
+	typ []byte
+
+	// record is the full serialized record, including type and length.
+	record []byte
+}
+
+// splitRecords splits a serialized TLV stream into its records. All records
+// in the signature range are skipped.
+func splitRecords(stream []byte) ([]rawRecord, error) {
+	var (
+		records []rawRecord
+		buf     [8]byte
+		r       = bytes.NewReader(stream)
+	)
+	for r.Len() > 0 {
+		start := len(stream) - r.Len()
+
+		typ, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		typeEnd := len(stream) - r.Len()
+
+		length, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(r.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		if _, err := r.Seek(int64(length), io.SeekCurrent); err != nil {
+			return nil, err
+		}
+		end := len(stream) - r.Len()
+
+		if isSignatureType(typ) {
+			continue
+		}
+
+		records = append(records, rawRecord{
+			typ:    stream[start:typeEnd],
+			record: stream[start:end],
+		})
+	}
+
+	return records, nil
+}
+
+// branchHash returns the hash of an inner node of the merkle tree. The hashes
+// of the children are sorted, so that a proof doesn't need to reveal which
+// side a hash is on.
+func branchHash(a, b *chainhash.Hash) *chainhash.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+
+	return chainhash.TaggedHash(branchTag, a[:], b[:])
+}
+
+// merkleRoot computes the root of the merkle tree of a serialized TLV stream
+// as defined in BOLT 12. Each record contributes a leaf with its hash and a
+// nonce leaf derived from the first record of the stream. If the number of
+// leaves isn't a power of two, the lower-order leaves are deeper in the tree.
+func merkleRoot(stream []byte) (*chainhash.Hash, error) {
+	records, err := splitRecords(stream)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("unable to compute merkle root of " +
+			"empty tlv stream")
+	}
+
+	tag := make([]byte, 0, len(nonceTag)+len(records[0].record))
+	tag = append(tag, nonceTag...)
+	tag = append(tag, records[0].record...)
+
+	leaves := make([]*chainhash.Hash, 0, 2*len(records))
+	for _, record := range records {
+		leaves = append(
+			leaves, chainhash.TaggedHash(leafTag, record.record),
+			chainhash.TaggedHash(tag, record.typ),
+		)
+	}
+
+	for step := 1; step < len(leaves); step *= 2 {
+		for i := 0; i+step < len(leaves); i += 2 * step {
+			leaves[i] = branchHash(leaves[i], leaves[i+step])
+		}
+	}
+
+	return leaves[0], nil
+}
+
+// signatureDigest returns the digest that is signed by the signature of the
+// given message, which is the tagged hash of the merkle root of its records.
+func signatureDigest(messageName string, stream []byte) (*chainhash.Hash,
+	error) {
+
+	root, err := merkleRoot(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.TaggedHash(signatureTag(messageName), root[:]), nil
+}
+
+// verifySignature verifies the BIP 340 signature of a serialized message
+// against the given public key.
+func verifySignature(messageName string, stream []byte, sig [64]byte,
+	pubKey *btcec.PublicKey) error {
+
+	digest, err := signatureDigest(messageName, stream)
+	if err != nil {
+		return err
+	}
+
+	signature, err := schnorr.ParseSignature(sig[:])
+	if err != nil {
+		return err
+	}
+
+	if !signature.Verify(digest[:], pubKey) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// MessageSigner creates a BIP 340 signature over the tagged hash of the given
+// message and tag.
+type MessageSigner func(msg, tag []byte) (*schnorr.Signature, error)
+
+// KeySigner returns a MessageSigner that signs with the given private key.
+func KeySigner(key *btcec.PrivateKey) MessageSigner {
+	return func(msg, tag []byte) (*schnorr.Signature, error) {
+		digest := chainhash.TaggedHash(tag, msg)
+
+		return schnorr.Sign(key, digest[:])
+	}
+}
+
+// sign signs the merkle root of a serialized message with the given signer.
+func sign(messageName string, stream []byte,
+	signer MessageSigner) ([64]byte, error) {
+
+	var sig [64]byte
+
+	root, err := merkleRoot(stream)
+	if err != nil {
+		return sig, err
+	}
+
+	signature, err := signer(root[:], signatureTag(messageName))
+	if err != nil {
+		return sig, err
+	}
+	copy(sig[:], signature.Serialize())
+
+	return sig, nil
+}
@@ -0,0 +1,409 @@
+package offers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	offerChainsType         tlv.Type = 2
+	offerMetadataType       tlv.Type = 4
+	offerCurrencyType       tlv.Type = 6
+	offerAmountType         tlv.Type = 8
+	offerDescriptionType    tlv.Type = 10
+	offerFeaturesType       tlv.Type = 12
+	offerAbsoluteExpiryType tlv.Type = 14
+	offerPathsType          tlv.Type = 16
+	offerIssuerType         tlv.Type = 18
+	offerQuantityMaxType    tlv.Type = 20
+	offerIssuerIDType       tlv.Type = 22
+)
+
+// isOfferType returns true if the given TLV type is in one of the ranges that
+// are reserved for offer fields.
+func isOfferType(typ uint64) bool {
+	return (typ >= 1 && typ <= 79) ||
+		(typ >= 1_000_000_000 && typ <= 1_999_999_999)
+}
+
+// Offer is a BOLT 12 offer. An offer is a static, reusable payment code that
+// a payer uses to request invoices from the issuer of the offer.
+type Offer struct {
+	// Chains is the set of chains that the offer is valid for. If empty,
+	// the offer is only valid for bitcoin mainnet.
+	Chains []chainhash.Hash
+
+	// Metadata is opaque data that is set by the issuer of the offer, and
+	// copied into the invoice requests for the offer.
+	Metadata []byte
+
+	// Currency is the ISO 4217 code of the currency of Amount, if the
+	// amount isn't denominated in bitcoin.
+	Currency string
+
+	// Amount is the amount that is requested by the offer, in
+	// millisatoshis unless Currency is set. If zero, the payer can choose
+	// the amount.
+	Amount uint64
+
+	// Description describes the purpose of the payment.
+	Description string
+
+	// Features is the set of features required to request an invoice for
+	// the offer.
+	Features *lnwire.RawFeatureVector
+
+	// AbsoluteExpiry is the time after which the offer expires. If zero,
+	// the offer doesn't expire.
+	AbsoluteExpiry time.Time
+
+	// Paths is a list of blinded paths to the issuer of the offer, that
+	// can be used to request an invoice instead of the issuer ID.
+	Paths []*sphinx.BlindedPath
+
+	// Issuer identifies the issuer of the offer in a human readable way.
+	Issuer string
+
+	// QuantityMax is set if the offer can be paid for multiple items. A
+	// value of zero means that there's no limit on the quantity.
+	QuantityMax fn.Option[uint64]
+
+	// IssuerID is the public key of the issuer of the offer, which is used
+	// to request invoices if no paths are set.
+	IssuerID *btcec.PublicKey
+
+	// ExtraRecords holds the unknown odd records of the offer, which have
+	// to be copied into invoice requests.
+	ExtraRecords map[uint64][]byte
+}
+
+// ChainSupported returns true if the offer is valid for the given chain.
+func (o *Offer) ChainSupported(chain chainhash.Hash) bool {
+	if len(o.Chains) == 0 {
+		return chain == *bitcoinChain
+	}
+
+	for _, c := range o.Chains {
+		if c == chain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expired returns true if the offer has an absolute expiry that is before the
+// given time.
+func (o *Offer) Expired(now time.Time) bool {
+	return !o.AbsoluteExpiry.IsZero() && now.After(o.AbsoluteExpiry)
+}
+
+// records returns the TLV records of the offer.
+func (o *Offer) records() ([]tlv.Record, error) {
+	var records []tlv.Record
+
+	if len(o.Chains) > 0 {
+		chains := make([]byte, 0, len(o.Chains)*chainhash.HashSize)
+		for _, chain := range o.Chains {
+			chains = append(chains, chain[:]...)
+		}
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerChainsType, &chains,
+			),
+		)
+	}
+
+	if o.Metadata != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerMetadataType, &o.Metadata,
+			),
+		)
+	}
+
+	if o.Currency != "" {
+		currency := []byte(o.Currency)
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerCurrencyType, &currency,
+			),
+		)
+	}
+
+	if o.Amount != 0 {
+		records = append(
+			records, tu64Record(offerAmountType, &o.Amount),
+		)
+	}
+
+	if o.Description != "" {
+		description := []byte(o.Description)
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerDescriptionType, &description,
+			),
+		)
+	}
+
+	if o.Features != nil && !o.Features.IsEmpty() {
+		records = append(
+			records, o.Features.Record(offerFeaturesType),
+		)
+	}
+
+	if !o.AbsoluteExpiry.IsZero() {
+		expiry := uint64(o.AbsoluteExpiry.Unix())
+		records = append(
+			records, tu64Record(offerAbsoluteExpiryType, &expiry),
+		)
+	}
+
+	if len(o.Paths) > 0 {
+		paths, err := encodePaths(o.Paths)
+		if err != nil {
+			return nil, err
+		}
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerPathsType, &paths,
+			),
+		)
+	}
+
+	if o.Issuer != "" {
+		issuer := []byte(o.Issuer)
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerIssuerType, &issuer,
+			),
+		)
+	}
+
+	o.QuantityMax.WhenSome(func(quantityMax uint64) {
+		records = append(
+			records, tu64Record(offerQuantityMaxType, &quantityMax),
+		)
+	})
+
+	if o.IssuerID != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				offerIssuerIDType, &o.IssuerID,
+			),
+		)
+	}
+
+	records = append(records, tlv.MapToRecords(o.ExtraRecords)...)
+
+	return records, nil
+}
+
+// Validate checks that the offer is well formed.
+func (o *Offer) Validate() error {
+	switch {
+	case o.Amount == 0 && o.Currency != "":
+		return errors.New("offer currency set without amount")
+
+	case o.Amount != 0 && o.Description == "":
+		return errors.New("offer with amount has no description")
+
+	case len(o.Paths) == 0 && o.IssuerID == nil:
+		return errors.New("offer has neither paths nor issuer id")
+	}
+
+	if o.Features != nil {
+		if err := validateFeatures(o.Features, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes the offer as a TLV stream.
+func (o *Offer) Encode(w io.Writer) error {
+	records, err := o.records()
+	if err != nil {
+		return err
+	}
+
+	return encodeRecords(w, records)
+}
+
+// String returns the bech32 encoding of the offer.
+func (o *Offer) String() string {
+	var b bytes.Buffer
+	if err := o.Encode(&b); err != nil {
+		return fmt.Sprintf("<invalid offer: %v>", err)
+	}
+
+	s, err := encodeBech32(offerHRP, b.Bytes())
+	if err != nil {
+		return fmt.Sprintf("<invalid offer: %v>", err)
+	}
+
+	return s
+}
+
+// offerDecoder holds the values of the offer records while a TLV stream is
+// decoded.
+type offerDecoder struct {
+	chains, metadata, currency, description, paths, issuer []byte
+
+	amount, absoluteExpiry, quantityMax uint64
+
+	features *lnwire.RawFeatureVector
+
+	issuerID *btcec.PublicKey
+}
+
+// records returns the records to decode the offer fields into.
+func (d *offerDecoder) records() []tlv.Record {
+	d.features = lnwire.NewRawFeatureVector()
+
+	return []tlv.Record{
+		tlv.MakePrimitiveRecord(offerChainsType, &d.chains),
+		tlv.MakePrimitiveRecord(offerMetadataType, &d.metadata),
+		tlv.MakePrimitiveRecord(offerCurrencyType, &d.currency),
+		tu64Record(offerAmountType, &d.amount),
+		tlv.MakePrimitiveRecord(offerDescriptionType, &d.description),
+		d.features.Record(offerFeaturesType),
+		tu64Record(offerAbsoluteExpiryType, &d.absoluteExpiry),
+		tlv.MakePrimitiveRecord(offerPathsType, &d.paths),
+		tlv.MakePrimitiveRecord(offerIssuerType, &d.issuer),
+		tu64Record(offerQuantityMaxType, &d.quantityMax),
+		tlv.MakePrimitiveRecord(offerIssuerIDType, &d.issuerID),
+	}
+}
+
+// offer returns the offer that was decoded.
+func (d *offerDecoder) offer(parsed tlv.TypeMap) (*Offer, error) {
+	var (
+		offer Offer
+		err   error
+	)
+
+	if _, ok := parsed[offerChainsType]; ok {
+		if len(d.chains)%chainhash.HashSize != 0 {
+			return nil, errors.New("invalid offer chains length")
+		}
+
+		for i := 0; i < len(d.chains); i += chainhash.HashSize {
+			var chain chainhash.Hash
+			copy(chain[:], d.chains[i:])
+			offer.Chains = append(offer.Chains, chain)
+		}
+	}
+
+	if _, ok := parsed[offerMetadataType]; ok {
+		offer.Metadata = d.metadata
+	}
+
+	offer.Currency = string(d.currency)
+
+	if _, ok := parsed[offerAmountType]; ok && d.amount == 0 {
+		return nil, errors.New("offer amount must not be zero")
+	}
+	offer.Amount = d.amount
+
+	offer.Description = string(d.description)
+
+	if _, ok := parsed[offerFeaturesType]; ok {
+		offer.Features = d.features
+	}
+
+	if _, ok := parsed[offerAbsoluteExpiryType]; ok {
+		offer.AbsoluteExpiry = time.Unix(int64(d.absoluteExpiry), 0)
+	}
+
+	if _, ok := parsed[offerPathsType]; ok {
+		offer.Paths, err = decodePaths(d.paths)
+		if err != nil {
+			return nil, err
+		}
+		if len(offer.Paths) == 0 {
+			return nil, errors.New("offer paths are empty")
+		}
+	}
+
+	offer.Issuer = string(d.issuer)
+
+	if _, ok := parsed[offerQuantityMaxType]; ok {
+		offer.QuantityMax = fn.Some(d.quantityMax)
+	}
+
+	offer.IssuerID = d.issuerID
+
+	return &offer, nil
+}
+
+// DecodeOffer parses a bech32 encoded offer.
+func DecodeOffer(s string) (*Offer, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != offerHRP {
+		return nil, fmt.Errorf("unexpected offer prefix: %v", hrp)
+	}
+
+	var d offerDecoder
+	parsed, err := decodeStream(data, d.records(), isOfferType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := d.offer(parsed)
+	if err != nil {
+		return nil, err
+	}
+	offer.ExtraRecords = extraRecords(parsed, isOfferType)
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// encodePaths serializes a list of blinded paths.
+func encodePaths(paths []*sphinx.BlindedPath) ([]byte, error) {
+	var b bytes.Buffer
+	for _, path := range paths {
+		if err := onionmessage.EncodeBlindedPath(&b, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodePaths parses a list of blinded paths.
+func decodePaths(b []byte) ([]*sphinx.BlindedPath, error) {
+	var (
+		paths []*sphinx.BlindedPath
+		r     = bytes.NewReader(b)
+	)
+	for r.Len() > 0 {
+		path, err := onionmessage.DecodeBlindedPath(r)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
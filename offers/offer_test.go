@@ -0,0 +1,388 @@
+package offers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestKey returns a new random private key.
+func newTestKey(t *testing.T) *btcec.PrivateKey {
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return key
+}
+
+// newTestPath returns a blinded path with the given number of hops that ends
+// at the given node.
+func newTestPath(t *testing.T, numHops int,
+	final *btcec.PublicKey) *sphinx.BlindedPath {
+
+	t.Helper()
+
+	hops := make([]*sphinx.HopInfo, numHops)
+	for i := range hops {
+		hops[i] = &sphinx.HopInfo{
+			NodePub:   newTestKey(t).PubKey(),
+			PlainText: []byte{byte(i)},
+		}
+	}
+	hops[numHops-1].NodePub = final
+
+	path, err := sphinx.BuildBlindedPath(newTestKey(t), hops)
+	require.NoError(t, err)
+
+	return path
+}
+
+// newTestOffer returns an offer that sets all fields.
+func newTestOffer(t *testing.T) *Offer {
+	t.Helper()
+
+	features := lnwire.NewRawFeatureVector(lnwire.FeatureBit(101))
+
+	return &Offer{
+		Chains: []chainhash.Hash{
+			*chaincfg.RegressionNetParams.GenesisHash,
+			*chaincfg.TestNet3Params.GenesisHash,
+		},
+		Metadata:       []byte{1, 2, 3},
+		Amount:         5000,
+		Description:    "coffee",
+		Features:       features,
+		AbsoluteExpiry: time.Unix(1_900_000_000, 0),
+		Paths: []*sphinx.BlindedPath{
+			newTestPath(t, 2, newTestKey(t).PubKey()),
+		},
+		Issuer:      "cafe",
+		QuantityMax: fn.Some(uint64(10)),
+		IssuerID:    newTestKey(t).PubKey(),
+		ExtraRecords: map[uint64][]byte{
+			1_000_000_001: {4, 5},
+		},
+	}
+}
+
+// TestOfferEncoding tests that offers survive a round trip through their
+// bech32 encoding.
+func TestOfferEncoding(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestOffer(t)
+
+	encoded := offer.String()
+	require.True(t, strings.HasPrefix(encoded, "lno1"))
+
+	decoded, err := DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, offer.String(), decoded.String())
+	require.Equal(t, offer.Description, decoded.Description)
+	require.Equal(t, offer.Amount, decoded.Amount)
+	require.Equal(t, offer.QuantityMax, decoded.QuantityMax)
+	require.Equal(t, offer.ExtraRecords, decoded.ExtraRecords)
+	require.True(t, offer.IssuerID.IsEqual(decoded.IssuerID))
+	require.True(t, decoded.ChainSupported(
+		*chaincfg.TestNet3Params.GenesisHash,
+	))
+	require.False(t, decoded.ChainSupported(*bitcoinChain))
+
+	// Offers can be split with '+' and whitespace, and can be upper case.
+	split := encoded[:20] + "+\n  " + encoded[20:]
+	decoded, err = DecodeOffer(split)
+	require.NoError(t, err)
+	require.Equal(t, encoded, decoded.String())
+
+	decoded, err = DecodeOffer(strings.ToUpper(encoded))
+	require.NoError(t, err)
+	require.Equal(t, encoded, decoded.String())
+}
+
+// TestDecodeOfferInvalid tests that malformed offers are rejected.
+func TestDecodeOfferInvalid(t *testing.T) {
+	t.Parallel()
+
+	issuerID := newTestKey(t).PubKey()
+
+	tests := []struct {
+		name  string
+		offer *Offer
+	}{
+		{
+			name: "no issuer id or paths",
+			offer: &Offer{
+				Description: "coffee",
+			},
+		},
+		{
+			name: "amount without description",
+			offer: &Offer{
+				Amount:   1000,
+				IssuerID: issuerID,
+			},
+		},
+		{
+			name: "currency without amount",
+			offer: &Offer{
+				Currency: "USD",
+				IssuerID: issuerID,
+			},
+		},
+		{
+			name: "unknown even record",
+			offer: &Offer{
+				IssuerID: issuerID,
+				ExtraRecords: map[uint64][]byte{
+					24: {1},
+				},
+			},
+		},
+		{
+			name: "record out of offer range",
+			offer: &Offer{
+				IssuerID: issuerID,
+				ExtraRecords: map[uint64][]byte{
+					81: {1},
+				},
+			},
+		},
+		{
+			name: "unknown required feature",
+			offer: &Offer{
+				IssuerID: issuerID,
+				Features: lnwire.NewRawFeatureVector(
+					lnwire.FeatureBit(100),
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+			require.NoError(t, test.offer.Encode(&b))
+
+			s, err := encodeBech32(offerHRP, b.Bytes())
+			require.NoError(t, err)
+
+			_, err = DecodeOffer(s)
+			require.Error(t, err)
+		})
+	}
+}
+
+// TestInvoiceRequestEncoding tests that invoice requests survive a round trip
+// through their encoding, and that their signature covers all fields.
+func TestInvoiceRequestEncoding(t *testing.T) {
+	t.Parallel()
+
+	payerKey := newTestKey(t)
+	chain := *chaincfg.RegressionNetParams.GenesisHash
+	req := &InvoiceRequest{
+		Offer:     newTestOffer(t),
+		Metadata:  []byte{9, 9, 9},
+		Chain:     &chain,
+		Amount:    10_000,
+		Quantity:  2,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: "thanks",
+		ExtraRecords: map[uint64][]byte{
+			2_000_000_001: {1},
+		},
+	}
+	require.NoError(t, req.Sign(payerKey))
+	require.NoError(t, req.Validate())
+
+	var b bytes.Buffer
+	require.NoError(t, req.Encode(&b))
+
+	decoded, err := DecodeInvoiceRequest(b.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, req.Metadata, decoded.Metadata)
+	require.Equal(t, chain, decoded.ChainHash())
+	require.Equal(t, req.Amount, decoded.Amount)
+	require.Equal(t, req.Quantity, decoded.Quantity)
+	require.Equal(t, req.PayerNote, decoded.PayerNote)
+	require.Equal(t, req.Signature, decoded.Signature)
+	require.Equal(t, req.ExtraRecords, decoded.ExtraRecords)
+	require.Equal(t, req.Offer.String(), decoded.Offer.String())
+
+	// Changing any field, including the fields of the offer, invalidates
+	// the signature.
+	decoded.PayerNote = "no thanks"
+	require.ErrorIs(t, decoded.Validate(), ErrInvalidSignature)
+
+	decoded.PayerNote = req.PayerNote
+	decoded.Offer.Description = "tea"
+	require.ErrorIs(t, decoded.Validate(), ErrInvalidSignature)
+
+	// Invoice requests without a signature are rejected.
+	unsigned := *req
+	unsigned.Signature = [64]byte{}
+	b.Reset()
+	require.NoError(t, unsigned.encode(&b, false))
+
+	_, err = DecodeInvoiceRequest(b.Bytes())
+	require.Error(t, err)
+}
+
+// TestInvoiceEncoding tests that invoices survive a round trip through their
+// encoding.
+func TestInvoiceEncoding(t *testing.T) {
+	t.Parallel()
+
+	payerKey := newTestKey(t)
+	req := &InvoiceRequest{
+		Offer:    newTestOffer(t),
+		Metadata: []byte{1},
+		Quantity: 1,
+		PayerID:  payerKey.PubKey(),
+	}
+	require.NoError(t, req.Sign(payerKey))
+
+	nodeKey := newTestKey(t)
+	nodeID := nodeKey.PubKey()
+	invoice := &Invoice{
+		Request: req,
+		Paths: []*routing.BlindedPayment{{
+			BlindedPath:         newTestPath(t, 3, nodeID),
+			BaseFee:             1000,
+			ProportionalFeeRate: 10,
+			CltvExpiryDelta:     144,
+			HtlcMinimum:         1,
+			HtlcMaximum:         100_000_000,
+			Features: lnwire.NewFeatureVector(
+				lnwire.NewRawFeatureVector(), lnwire.Features,
+			),
+		}, {
+			BlindedPath:     newTestPath(t, 1, nodeID),
+			CltvExpiryDelta: 80,
+			HtlcMaximum:     50_000,
+		}},
+		CreatedAt:      time.Unix(1_700_000_000, 0),
+		RelativeExpiry: time.Hour,
+		PaymentHash:    lntypes.Hash{1, 2, 3},
+		Amount:         5000,
+		Features: lnwire.NewRawFeatureVector(
+			lnwire.MPPOptional,
+		),
+		NodeID: nodeID,
+	}
+	require.NoError(t, invoice.Sign(KeySigner(nodeKey)))
+
+	encoded := invoice.String()
+	require.True(t, strings.HasPrefix(encoded, "lni1"))
+
+	var b bytes.Buffer
+	require.NoError(t, invoice.Encode(&b))
+
+	decoded, err := DecodeInvoice(b.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, encoded, decoded.String())
+	require.Equal(t, invoice.PaymentHash, decoded.PaymentHash)
+	require.Equal(t, invoice.Amount, decoded.Amount)
+	require.Equal(t, invoice.Expiry(), decoded.Expiry())
+	require.True(t, decoded.Features.IsSet(lnwire.MPPOptional))
+	require.Len(t, decoded.Paths, 2)
+	for i, path := range decoded.Paths {
+		require.Equal(t, invoice.Paths[i].BaseFee, path.BaseFee)
+		require.Equal(
+			t, invoice.Paths[i].CltvExpiryDelta,
+			path.CltvExpiryDelta,
+		)
+		require.Equal(
+			t, invoice.Paths[i].HtlcMaximum, path.HtlcMaximum,
+		)
+		require.Len(
+			t, path.BlindedPath.BlindedHops,
+			len(invoice.Paths[i].BlindedPath.BlindedHops),
+		)
+	}
+
+	// An invoice signed by another key is rejected.
+	require.NoError(t, invoice.Sign(KeySigner(newTestKey(t))))
+	b.Reset()
+	require.NoError(t, invoice.Encode(&b))
+
+	_, err = DecodeInvoice(b.Bytes())
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+// TestMerkleRoot tests the merkle root computation against the test vectors
+// of BOLT 12.
+func TestMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stream string
+		root   string
+	}{
+		{
+			name:   "tlv1 = 1000",
+			stream: "010203e8",
+			root: "b013756c8fee86503a0b4abdab4cddeb1af5d344ca6fc2" +
+				"fa8b6c08938caa6f93",
+		},
+		{
+			name:   "tlv1 = 1000, tlv2 = 1x2x3",
+			stream: "010203e802080000010000020003",
+			root: "c3774abbf4815aa54ccaa026bff6581f01f3be5fe814c6" +
+				"20a252534f434bc0d1",
+		},
+	}
+
+	for _, test := range tests {
+		stream, err := hex.DecodeString(test.stream)
+		require.NoError(t, err, test.name)
+
+		root, err := merkleRoot(stream)
+		require.NoError(t, err, test.name)
+		require.Equal(
+			t, test.root, hex.EncodeToString(root[:]), test.name,
+		)
+	}
+
+	// Signatures are excluded from the merkle tree.
+	stream, err := hex.DecodeString(tests[0].stream + "f00100")
+	require.NoError(t, err)
+
+	root, err := merkleRoot(stream)
+	require.NoError(t, err)
+	require.Equal(t, tests[0].root, hex.EncodeToString(root[:]))
+}
+
+// TestInvoiceError tests that invoice errors survive a round trip through
+// their encoding.
+func TestInvoiceError(t *testing.T) {
+	t.Parallel()
+
+	invoiceErr := &InvoiceError{
+		ErroneousField: fn.Some(uint64(invreqQuantityType)),
+		Message:        "quantity too high",
+	}
+
+	b, err := invoiceErr.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInvoiceError(b)
+	require.NoError(t, err)
+	require.Equal(t, invoiceErr, decoded)
+}
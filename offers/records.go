@@ -0,0 +1,120 @@
+package offers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// signatureType is the type of the record that holds the signature of an
+// invoice request or an invoice.
+const signatureType tlv.Type = 240
+
+// bitcoinChain is the chain hash of bitcoin mainnet, which is implied if an
+// offer or invoice request doesn't specify a chain.
+var bitcoinChain = chaincfg.MainNetParams.GenesisHash
+
+// tu64Record returns a record for a truncated uint64.
+func tu64Record(typ tlv.Type, val *uint64) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		typ, val, func() uint64 {
+			return tlv.SizeTUint64(*val)
+		}, tlv.ETUint64, tlv.DTUint64,
+	)
+}
+
+// tu32Record returns a record for a truncated uint32.
+func tu32Record(typ tlv.Type, val *uint32) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		typ, val, func() uint64 {
+			return tlv.SizeTUint32(*val)
+		}, tlv.ETUint32, tlv.DTUint32,
+	)
+}
+
+// encodeRecords writes the given records as a TLV stream, sorted by type.
+func encodeRecords(w io.Writer, records []tlv.Record) error {
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// decodeStream decodes a TLV stream into the given records. Unknown records
+// must be odd, and must be in one of the ranges accepted by the allowed
+// function.
+func decodeStream(data []byte, records []tlv.Record,
+	allowed func(uint64) bool) (tlv.TypeMap, error) {
+
+	tlv.SortRecords(records)
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Since this data is provided by a potentially malicious peer, pass it
+	// into the P2P decoding variant.
+	parsed, err := stream.DecodeWithParsedTypesP2P(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	for typ, value := range parsed {
+		// Known records have a nil value.
+		if value == nil {
+			continue
+		}
+
+		switch {
+		case !allowed(uint64(typ)):
+			return nil, fmt.Errorf("unexpected record type %d", typ)
+
+		case typ%2 == 0:
+			return nil, fmt.Errorf("unknown required record type "+
+				"%d", typ)
+		}
+	}
+
+	return parsed, nil
+}
+
+// extraRecords returns the unknown records of a decoded TLV stream whose
+// types are accepted by the given function.
+func extraRecords(parsed tlv.TypeMap,
+	inRange func(uint64) bool) map[uint64][]byte {
+
+	var extra map[uint64][]byte
+	for typ, value := range parsed {
+		if value == nil || !inRange(uint64(typ)) {
+			continue
+		}
+
+		if extra == nil {
+			extra = make(map[uint64][]byte)
+		}
+		extra[uint64(typ)] = value
+	}
+
+	return extra
+}
+
+// validateFeatures returns an error if the given feature vector requires any
+// features that aren't part of the given set of known features.
+func validateFeatures(features *lnwire.RawFeatureVector,
+	known map[lnwire.FeatureBit]string) error {
+
+	fv := lnwire.NewFeatureVector(features, known)
+	if unknown := fv.UnknownRequiredFeatures(); len(unknown) > 0 {
+		return fmt.Errorf("unknown required features: %v", unknown)
+	}
+
+	return nil
+}
@@ -0,0 +1,569 @@
+package onionmessage
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/blindedpath"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// incomingQueueSize is the number of received onion messages that can
+	// be waiting to be processed. Any messages that are received while
+	// the queue is full are dropped.
+	incomingQueueSize = 100
+
+	// minReplyPathHops is the minimum number of hops of the blinded reply
+	// paths that we create. Shorter paths are padded with dummy hops so
+	// that the recipient of a message can't tell how far away we are from
+	// the introduction node.
+	minReplyPathHops = 3
+)
+
+var (
+	// ErrNoDestination is returned when an onion message is sent without
+	// a destination.
+	ErrNoDestination = errors.New("onion message requires either a " +
+		"destination node or a blinded path")
+
+	// ErrSendToSelf is returned when an onion message is addressed to our
+	// own node.
+	ErrSendToSelf = errors.New("can't send onion message to self")
+)
+
+// Config holds the resources that the Messenger needs to send, receive and
+// relay onion messages.
+type Config struct {
+	// NodeKey is the identity key of our node, which is used to decrypt
+	// the onion messages that we receive.
+	NodeKey keychain.SingleKeyECDH
+
+	// SendToPeer sends an onion message to one of our connected peers.
+	SendToPeer func(peer route.Vertex, msg *lnwire.OnionMessage) error
+
+	// Peers returns the set of connected peers that support onion
+	// messages.
+	Peers func() []route.Vertex
+
+	// Graph is used to find paths to nodes that aren't our peers.
+	Graph Graph
+
+	// FetchChannelPeer returns the node on the other end of one of our
+	// channels. It's used to forward onion messages whose next hop is
+	// identified by a short channel ID.
+	FetchChannelPeer func(lnwire.ShortChannelID) (route.Vertex, error)
+}
+
+// SendRequest describes an onion message to send.
+type SendRequest struct {
+	// Destination is the node to send the message to. It's only used if
+	// BlindedPath is nil.
+	Destination route.Vertex
+
+	// BlindedPath is a blinded path to the recipient of the message.
+	BlindedPath *sphinx.BlindedPath
+
+	// Records holds the content of the message, keyed by record type.
+	// All types must be at least MinRecordType.
+	Records map[uint64][]byte
+
+	// ReplyPathID is set if a blinded reply path to our node should be
+	// attached to the message. The path ID will be included in the
+	// ReceivedMessage of any reply that is sent back along the path.
+	ReplyPathID []byte
+}
+
+// ReceivedMessage is sent to the subscribers of the Messenger when an onion
+// message addressed to our node is received.
+type ReceivedMessage struct {
+	// PathID is the path ID of the blinded path that we created and that
+	// the message was sent along, or nil if the message wasn't sent along
+	// one of our paths.
+	PathID []byte
+
+	// ReplyPath is the blinded path that the sender attached to the
+	// message to allow us to reply, if any.
+	ReplyPath *sphinx.BlindedPath
+
+	// Records holds the content of the message, keyed by record type.
+	Records map[uint64][]byte
+}
+
+// incomingMessage is an onion message that was received from a peer.
+type incomingMessage struct {
+	peer route.Vertex
+	msg  *lnwire.OnionMessage
+}
+
+// Messenger sends, receives and relays onion messages. Onion messages are
+// sent along (partially) blinded paths and are relayed without the relaying
+// nodes learning about the sender, the recipient or the content of the
+// message.
+type Messenger struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	ourKey route.Vertex
+	router *sphinx.Router
+
+	ntfnServer *subscribe.Server
+
+	incoming chan *incomingMessage
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new Messenger from the given config.
+func New(cfg *Config) *Messenger {
+	return &Messenger{
+		cfg:    cfg,
+		ourKey: route.NewVertex(cfg.NodeKey.PubKey()),
+
+		// Onion messages are not protected against replays, so the
+		// router is only used to decrypt the blinded data and doesn't
+		// need a replay log.
+		router:     sphinx.NewRouter(cfg.NodeKey, nil),
+		ntfnServer: subscribe.NewServer(),
+		incoming:   make(chan *incomingMessage, incomingQueueSize),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start starts the Messenger.
+func (m *Messenger) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Info("Onion messenger starting")
+
+		if err = m.ntfnServer.Start(); err != nil {
+			return
+		}
+
+		m.wg.Add(1)
+		go m.processIncoming()
+	})
+
+	return err
+}
+
+// Stop stops the Messenger.
+func (m *Messenger) Stop() error {
+	var err error
+	m.stopped.Do(func() {
+		log.Info("Onion messenger shutting down...")
+		defer log.Debug("Onion messenger shutdown complete")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		err = m.ntfnServer.Stop()
+	})
+
+	return err
+}
+
+// SubscribeMessages returns a subscribe.Client that receives a
+// ReceivedMessage for each onion message that is addressed to our node.
+func (m *Messenger) SubscribeMessages() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// HandleMessage queues an onion message that was received from a peer for
+// processing. If the queue is full, the message is dropped, as onion messages
+// are unreliable by design.
+func (m *Messenger) HandleMessage(peer [33]byte, msg *lnwire.OnionMessage) {
+	select {
+	case m.incoming <- &incomingMessage{peer: peer, msg: msg}:
+	default:
+		log.Debugf("Dropping onion message from %x, queue full", peer)
+	}
+}
+
+// processIncoming processes the onion messages that are received from our
+// peers.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Messenger) processIncoming() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case in := <-m.incoming:
+			if err := m.processMessage(in.msg); err != nil {
+				log.Debugf("Unable to process onion message "+
+					"from %x: %v", in.peer, err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// processMessage peels a layer off the given onion message, and either
+// forwards the remainder to the next hop or delivers the message to our
+// subscribers if we're the final recipient.
+func (m *Messenger) processMessage(msg *lnwire.OnionMessage) error {
+	peeled, err := peelOnionPacket(
+		m.cfg.NodeKey, msg.OnionBlob, msg.PathKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	payload, err := DecodePayload(bytes.NewReader(peeled.payload.Payload))
+	if err != nil {
+		return err
+	}
+
+	// Every hop of an onion message is blinded, so each of them needs to
+	// receive its encrypted data.
+	if payload.EncryptedData == nil {
+		return errMissingEncryptedData
+	}
+
+	plainText, err := m.router.DecryptBlindedHopData(
+		msg.PathKey, payload.EncryptedData,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt blinded data: %w", err)
+	}
+
+	data, err := record.DecodeBlindedRouteData(bytes.NewReader(plainText))
+	if err != nil {
+		return err
+	}
+
+	if peeled.nextPacket == nil {
+		return m.deliver(payload, data)
+	}
+
+	// Only the final recipient may receive content or a reply path, since
+	// they could otherwise be used to probe the relaying nodes.
+	if payload.ReplyPath != nil || len(payload.Records) != 0 {
+		return errors.New("unexpected content for relaying hop")
+	}
+
+	next, err := m.nextNode(data)
+	if err != nil {
+		return err
+	}
+
+	// The next path key is either set by the creator of the path, for
+	// example when concatenating two blinded paths, or derived from the
+	// current one.
+	var nextPathKey *btcec.PublicKey
+	data.NextBlindingOverride.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType8, *btcec.PublicKey]) {
+			nextPathKey = r.Val
+		},
+	)
+	if nextPathKey == nil {
+		nextPathKey, err = m.router.NextEphemeral(msg.PathKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	nextMsg := lnwire.NewOnionMessage(nextPathKey, peeled.nextPacket)
+
+	// Dummy hops that are added to the end of a blinded path point back
+	// to ourselves, in which case we peel the next layer right away.
+	if next == m.ourKey {
+		return m.processMessage(nextMsg)
+	}
+
+	log.Tracef("Forwarding onion message to %x", next)
+
+	return m.cfg.SendToPeer(next, nextMsg)
+}
+
+// nextNode returns the node that an onion message should be forwarded to
+// according to the given blinded route data.
+func (m *Messenger) nextNode(data *record.BlindedRouteData) (route.Vertex,
+	error) {
+
+	var (
+		next  route.Vertex
+		found bool
+	)
+	data.NextNodeID.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType4, *btcec.PublicKey]) {
+			next = route.NewVertex(r.Val)
+			found = true
+		},
+	)
+	if found {
+		return next, nil
+	}
+
+	var (
+		scid lnwire.ShortChannelID
+		err  error
+	)
+	data.ShortChannelID.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType2, lnwire.ShortChannelID]) {
+			scid = r.Val
+			found = true
+		},
+	)
+	if !found {
+		return next, errors.New("blinded data has no next hop")
+	}
+
+	if m.cfg.FetchChannelPeer == nil {
+		return next, errors.New("unable to forward to short channel id")
+	}
+
+	next, err = m.cfg.FetchChannelPeer(scid)
+	if err != nil {
+		return next, fmt.Errorf("unable to find next hop of channel "+
+			"%v: %w", scid, err)
+	}
+
+	return next, nil
+}
+
+// deliver notifies our subscribers about an onion message that is addressed
+// to our node.
+func (m *Messenger) deliver(payload *Payload,
+	data *record.BlindedRouteData) error {
+
+	received := &ReceivedMessage{
+		ReplyPath: payload.ReplyPath,
+		Records:   payload.Records,
+	}
+	data.PathID.WhenSome(func(r tlv.RecordT[tlv.TlvType6, []byte]) {
+		received.PathID = r.Val
+	})
+
+	log.Debugf("Received onion message with %d records",
+		len(received.Records))
+
+	return m.ntfnServer.SendUpdate(received)
+}
+
+// SendMessage sends an onion message to either a node or a blinded path. The
+// message is sent along a blinded path to its recipient, so that the nodes
+// that relay it can't tell where it's going.
+func (m *Messenger) SendMessage(req *SendRequest) error {
+	var (
+		target    route.Vertex
+		finalData *record.BlindedRouteData
+	)
+	switch {
+	// If the recipient gave us a blinded path, we need to reach its
+	// introduction node and hand over the path key of the recipient's
+	// path to it.
+	case req.BlindedPath != nil:
+		target = route.NewVertex(req.BlindedPath.IntroductionPoint)
+		finalData = record.NewNonFinalMessageRouteData(
+			req.BlindedPath.IntroductionPoint,
+			req.BlindedPath.BlindingPoint,
+		)
+
+	case req.Destination == route.Vertex{}:
+		return ErrNoDestination
+
+	case req.Destination == m.ourKey:
+		return ErrSendToSelf
+
+	default:
+		target = req.Destination
+		finalData = record.NewFinalHopBlindedRouteData(nil, nil)
+	}
+
+	// Find a path of real nodes to the target. We don't need one if we are
+	// the introduction node of the recipient's path ourselves.
+	var path []route.Vertex
+	if target != m.ourKey {
+		var err error
+		path, err = FindPath(
+			m.cfg.Graph, m.ourKey, m.cfg.Peers(), target,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// We blind our own part of the path as well, as every hop expects
+	// to receive a path key. If the recipient gave us a blinded path, our
+	// part ends at the node before its introduction node.
+	ourHops := path
+	if req.BlindedPath != nil && len(path) > 0 {
+		ourHops = path[:len(path)-1]
+	}
+
+	var (
+		pathKey *btcec.PublicKey
+		hops    []*sphinx.BlindedHopInfo
+	)
+	if len(ourHops) > 0 {
+		ourPath, err := buildSenderPath(ourHops, finalData)
+		if err != nil {
+			return err
+		}
+
+		pathKey = ourPath.BlindingPoint
+		hops = ourPath.BlindedHops
+	}
+
+	if req.BlindedPath != nil {
+		if pathKey == nil {
+			pathKey = req.BlindedPath.BlindingPoint
+		}
+		hops = append(hops, req.BlindedPath.BlindedHops...)
+	}
+
+	final := &Payload{
+		Records: req.Records,
+	}
+	if req.ReplyPathID != nil {
+		replyPath, err := m.buildReplyPath(req.ReplyPathID)
+		if err != nil {
+			return fmt.Errorf("unable to build reply path: %w", err)
+		}
+
+		final.ReplyPath = replyPath
+	}
+
+	onion, err := buildOnion(hops, final)
+	if err != nil {
+		return err
+	}
+	msg := lnwire.NewOnionMessage(pathKey, onion)
+
+	// If we are the introduction node of the recipient's path, we process
+	// the message as if we received it to forward it along the path.
+	if len(path) == 0 {
+		return m.processMessage(msg)
+	}
+
+	return m.cfg.SendToPeer(path[0], msg)
+}
+
+// buildReplyPath builds a blinded path to our node that the recipient of a
+// message can use to reply to it. A random peer is used as the introduction
+// node. If we don't have any peers that support onion messages, we use our
+// own node as the introduction node.
+func (m *Messenger) buildReplyPath(pathID []byte) (*sphinx.BlindedPath,
+	error) {
+
+	hops := []route.Vertex{m.ourKey}
+	if peers := m.cfg.Peers(); len(peers) > 0 {
+		idx, err := rand.Int(rand.Reader, big.NewInt(int64(len(peers))))
+		if err != nil {
+			return nil, err
+		}
+
+		hops = append([]route.Vertex{peers[idx.Int64()]}, hops...)
+	}
+
+	return blindedpath.BuildBlindedMessagePath(
+		&blindedpath.BuildMessagePathCfg{
+			Hops:       hops,
+			PathID:     pathID,
+			MinNumHops: minReplyPathHops,
+		},
+	)
+}
+
+// buildSenderPath blinds the part of an onion message path that is chosen by
+// the sender. Each hop is told to forward the message to the next one, and
+// the final hop receives the given route data.
+func buildSenderPath(hops []route.Vertex,
+	finalData *record.BlindedRouteData) (*sphinx.BlindedPath, error) {
+
+	keys := make([]*btcec.PublicKey, len(hops))
+	for i, hop := range hops {
+		key, err := btcec.ParsePubKey(hop[:])
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = key
+	}
+
+	hopInfo := make([]*sphinx.HopInfo, len(keys))
+	for i, key := range keys {
+		data := finalData
+		if i < len(keys)-1 {
+			data = record.NewNonFinalMessageRouteData(
+				keys[i+1], nil,
+			)
+		}
+
+		plainText, err := record.EncodeBlindedRouteData(data)
+		if err != nil {
+			return nil, err
+		}
+
+		hopInfo[i] = &sphinx.HopInfo{
+			NodePub:   key,
+			PlainText: plainText,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sphinx.BuildBlindedPath(sessionKey, hopInfo)
+}
+
+// buildOnion creates a serialized onion message packet for the given blinded
+// hops. The final hop receives the given payload in addition to its encrypted
+// data. A large packet is only used if the payloads don't fit into a packet of
+// the size of an HTLC's onion.
+func buildOnion(hops []*sphinx.BlindedHopInfo, final *Payload) ([]byte,
+	error) {
+
+	onionHops := make([]onionHop, len(hops))
+	for i, hop := range hops {
+		payload := &Payload{
+			EncryptedData: hop.CipherText,
+		}
+		if i == len(hops)-1 {
+			payload.ReplyPath = final.ReplyPath
+			payload.Records = final.Records
+		}
+
+		b, err := payload.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		hopPayload, err := sphinx.NewTLVHopPayload(b)
+		if err != nil {
+			return nil, err
+		}
+
+		onionHops[i] = onionHop{
+			nodeKey: hop.BlindedNodePub,
+			payload: hopPayload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return newOnionPacket(sessionKey, onionHops)
+}
@@ -0,0 +1,241 @@
+package onionmessage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// testGraph is an in-memory Graph where all nodes support onion messages.
+type testGraph map[route.Vertex][]route.Vertex
+
+func (g testGraph) ForEachNeighbor(node route.Vertex,
+	cb func(route.Vertex) error) error {
+
+	for _, neighbor := range g[node] {
+		if err := cb(neighbor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g testGraph) SupportsOnionMessages(route.Vertex) (bool, error) {
+	return true, nil
+}
+
+// testNetwork is a set of messengers that deliver their messages to each
+// other directly.
+type testNetwork struct {
+	graph      testGraph
+	messengers map[route.Vertex]*Messenger
+}
+
+// newTestNetwork creates a network of numNodes messengers that are connected
+// in a line.
+func newTestNetwork(t *testing.T, numNodes int) (*testNetwork,
+	[]route.Vertex) {
+
+	network := &testNetwork{
+		graph:      make(testGraph),
+		messengers: make(map[route.Vertex]*Messenger),
+	}
+
+	nodes := make([]route.Vertex, numNodes)
+	keys := make([]*btcec.PrivateKey, numNodes)
+	for i := range nodes {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		keys[i] = key
+		nodes[i] = route.NewVertex(key.PubKey())
+	}
+
+	for i := 0; i < numNodes-1; i++ {
+		network.graph[nodes[i]] = append(
+			network.graph[nodes[i]], nodes[i+1],
+		)
+		network.graph[nodes[i+1]] = append(
+			network.graph[nodes[i+1]], nodes[i],
+		)
+	}
+
+	for i, node := range nodes {
+		node := node
+		m := New(&Config{
+			NodeKey: &keychain.PrivKeyECDH{PrivKey: keys[i]},
+			SendToPeer: func(peer route.Vertex,
+				msg *lnwire.OnionMessage) error {
+
+				target, ok := network.messengers[peer]
+				if !ok {
+					return fmt.Errorf("unknown peer")
+				}
+
+				target.HandleMessage(node, msg)
+
+				return nil
+			},
+			Peers: func() []route.Vertex {
+				return network.graph[node]
+			},
+			Graph: network.graph,
+		})
+		require.NoError(t, m.Start())
+		t.Cleanup(func() {
+			require.NoError(t, m.Stop())
+		})
+
+		network.messengers[node] = m
+	}
+
+	return network, nodes
+}
+
+// TestSendOnionMessage tests that onion messages are relayed to their
+// recipient, and that the recipient can reply along the attached reply path.
+func TestSendOnionMessage(t *testing.T) {
+	t.Parallel()
+
+	// Alice -> Bob -> Carol -> Dave.
+	network, nodes := newTestNetwork(t, 4)
+	alice, dave := nodes[0], nodes[3]
+
+	records := map[uint64][]byte{
+		64:  []byte("invoice_request"),
+		101: []byte("hello"),
+	}
+
+	// Subscribe before sending, so that we don't miss the message.
+	daveClient, err := network.messengers[dave].SubscribeMessages()
+	require.NoError(t, err)
+	defer daveClient.Cancel()
+
+	err = network.messengers[alice].SendMessage(&SendRequest{
+		Destination: dave,
+		Records:     records,
+		ReplyPathID: []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+
+	var received *ReceivedMessage
+	select {
+	case update := <-daveClient.Updates():
+		received = update.(*ReceivedMessage)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no onion message received")
+	}
+
+	require.Equal(t, records, received.Records)
+	require.Nil(t, received.PathID)
+	require.NotNil(t, received.ReplyPath)
+
+	// Alice used her only peer as the introduction node of the reply path
+	// and padded it with dummy hops.
+	require.Len(t, received.ReplyPath.BlindedHops, minReplyPathHops)
+	require.Equal(
+		t, nodes[1], route.NewVertex(
+			received.ReplyPath.IntroductionPoint,
+		),
+	)
+
+	// Dave replies along the blinded path, and Alice learns which of her
+	// messages the reply belongs to.
+	aliceClient, err := network.messengers[alice].SubscribeMessages()
+	require.NoError(t, err)
+	defer aliceClient.Cancel()
+
+	reply := map[uint64][]byte{66: []byte("invoice")}
+	err = network.messengers[dave].SendMessage(&SendRequest{
+		BlindedPath: received.ReplyPath,
+		Records:     reply,
+	})
+	require.NoError(t, err)
+
+	select {
+	case update := <-aliceClient.Updates():
+		received = update.(*ReceivedMessage)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no reply received")
+	}
+
+	require.Equal(t, reply, received.Records)
+	require.Equal(t, []byte{1, 2, 3}, received.PathID)
+	require.Nil(t, received.ReplyPath)
+}
+
+// TestSendOnionMessageErrors tests that invalid onion messages are rejected
+// before they are sent.
+func TestSendOnionMessageErrors(t *testing.T) {
+	t.Parallel()
+
+	network, nodes := newTestNetwork(t, 2)
+	alice := network.messengers[nodes[0]]
+
+	err := alice.SendMessage(&SendRequest{})
+	require.ErrorIs(t, err, ErrNoDestination)
+
+	err = alice.SendMessage(&SendRequest{Destination: nodes[0]})
+	require.ErrorIs(t, err, ErrSendToSelf)
+
+	err = alice.SendMessage(&SendRequest{
+		Destination: nodes[1],
+		Records:     map[uint64][]byte{4: {1}},
+	})
+	require.ErrorIs(t, err, ErrInvalidRecordType)
+
+	unknown, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	err = alice.SendMessage(&SendRequest{
+		Destination: route.NewVertex(unknown.PubKey()),
+	})
+	require.ErrorIs(t, err, ErrNoPathFound)
+
+	// Messages that don't fit into a large onion are rejected as well.
+	err = alice.SendMessage(&SendRequest{
+		Destination: nodes[1],
+		Records: map[uint64][]byte{
+			64: make([]byte, largeRoutingInfoSize),
+		},
+	})
+	require.ErrorIs(t, err, errPayloadTooLarge)
+}
+
+// TestSendLargeOnionMessage tests that messages that don't fit into an onion
+// of the size of an HTLC's onion are relayed in a large onion.
+func TestSendLargeOnionMessage(t *testing.T) {
+	t.Parallel()
+
+	// Alice -> Bob -> Carol.
+	network, nodes := newTestNetwork(t, 3)
+	alice, carol := nodes[0], nodes[2]
+
+	carolClient, err := network.messengers[carol].SubscribeMessages()
+	require.NoError(t, err)
+	defer carolClient.Cancel()
+
+	records := map[uint64][]byte{66: make([]byte, 5000)}
+	err = network.messengers[alice].SendMessage(&SendRequest{
+		Destination: carol,
+		Records:     records,
+	})
+	require.NoError(t, err)
+
+	select {
+	case update := <-carolClient.Updates():
+		received := update.(*ReceivedMessage)
+		require.Equal(t, records, received.Records)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no onion message received")
+	}
+}
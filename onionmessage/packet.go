@@ -0,0 +1,366 @@
+package onionmessage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// smallRoutingInfoSize is the size of the routing info of an onion
+	// message packet of the same size as the onion of an HTLC.
+	smallRoutingInfoSize = 1300
+
+	// largeRoutingInfoSize is the size of the routing info of the large
+	// onion message packets that BOLT 4 allows for payloads that don't
+	// fit into a small packet.
+	largeRoutingInfoSize = 32768
+
+	// onionVersion is the only supported version of onion message
+	// packets.
+	onionVersion = 0
+
+	// onionOverhead is the number of bytes of an onion message packet
+	// besides its routing info: the version, the ephemeral key and the
+	// HMAC.
+	onionOverhead = 1 + btcec.PubKeyBytesLenCompressed + sphinx.HMACSize
+
+	// blindedNodeIDKey is the key type used to derive the factor that a
+	// node's key is blinded with in a blinded path.
+	blindedNodeIDKey = "blinded_node_id"
+)
+
+var (
+	// errInvalidOnionHMAC is returned when the HMAC of an onion message
+	// packet doesn't match, which means the packet was tampered with or
+	// isn't meant for us.
+	errInvalidOnionHMAC = errors.New("invalid onion message hmac")
+
+	// errPayloadTooLarge is returned when the payloads of all hops don't
+	// fit into a large onion message packet.
+	errPayloadTooLarge = errors.New("onion message payloads too large")
+)
+
+// onionHop is a hop of an onion message packet.
+type onionHop struct {
+	// nodeKey is the blinded public key of the hop.
+	nodeKey *btcec.PublicKey
+
+	// payload is the hop payload that is meant for the hop.
+	payload sphinx.HopPayload
+}
+
+// peeledOnion is the result of processing an onion message packet.
+type peeledOnion struct {
+	// payload is the hop payload that is meant for us.
+	payload sphinx.HopPayload
+
+	// nextPacket is the packet that must be handed to the next hop. It is
+	// nil if we are the final hop.
+	nextPacket []byte
+}
+
+// routingInfoSize returns the size of the routing info of a serialized onion
+// message packet of the given size. Only packets of 1366 and 32834 bytes are
+// valid.
+func routingInfoSize(packetSize int) (int, error) {
+	switch packetSize - onionOverhead {
+	case smallRoutingInfoSize:
+		return smallRoutingInfoSize, nil
+
+	case largeRoutingInfoSize:
+		return largeRoutingInfoSize, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported onion packet size: %d",
+			packetSize)
+	}
+}
+
+// newOnionPacket creates a serialized onion message packet that carries the
+// payloads to the hops, in order. A small packet is used if the payloads fit,
+// otherwise a large one.
+func newOnionPacket(sessionKey *btcec.PrivateKey,
+	hops []onionHop) ([]byte, error) {
+
+	if len(hops) == 0 {
+		return nil, errors.New("onion message needs at least one hop")
+	}
+
+	var total int
+	for _, hop := range hops {
+		total += hop.payload.NumBytes()
+	}
+
+	var size int
+	switch {
+	case total <= smallRoutingInfoSize:
+		size = smallRoutingInfoSize
+
+	case total <= largeRoutingInfoSize:
+		size = largeRoutingInfoSize
+
+	default:
+		return nil, fmt.Errorf("%w: %d bytes exceed %d",
+			errPayloadTooLarge, total, largeRoutingInfoSize)
+	}
+
+	// Derive the ephemeral key and the shared secret for every hop.
+	var (
+		ephemKeys = make([]*btcec.PublicKey, len(hops))
+		secrets   = make([][]byte, len(hops))
+		ephemPriv = sessionKey
+	)
+	for i, hop := range hops {
+		ephemKeys[i] = ephemPriv.PubKey()
+
+		secret, err := (&keychain.PrivKeyECDH{
+			PrivKey: ephemPriv,
+		}).ECDH(hop.nodeKey)
+		if err != nil {
+			return nil, err
+		}
+		secrets[i] = secret[:]
+
+		factor := blindingFactor(ephemKeys[i], secrets[i])
+		var next btcec.ModNScalar
+		next.Mul2(&ephemPriv.Key, &factor)
+		ephemPriv = &btcec.PrivateKey{Key: next}
+	}
+
+	// The routing info starts out as pseudo random bytes, so that the
+	// unused space doesn't leak the length of the path.
+	routingInfo := cipherStream(
+		generateKey("pad", sessionKey.Serialize()), size,
+	)
+	filler := generateFiller(hops, secrets, size)
+
+	// Wrap the payloads starting with the last hop, so that every hop can
+	// only peel off its own layer.
+	var nextMac [sphinx.HMACSize]byte
+	for i := len(hops) - 1; i >= 0; i-- {
+		payload := hops[i].payload
+		payload.HMAC = nextMac
+
+		var b bytes.Buffer
+		if err := payload.Encode(&b); err != nil {
+			return nil, err
+		}
+
+		shift := b.Len()
+		copy(routingInfo[shift:], routingInfo[:size-shift])
+		copy(routingInfo, b.Bytes())
+
+		rho := generateKey("rho", secrets[i])
+		xor(routingInfo, cipherStream(rho, size))
+
+		if i == len(hops)-1 {
+			copy(routingInfo[size-len(filler):], filler)
+		}
+
+		mu := generateKey("mu", secrets[i])
+		copy(nextMac[:], calcMac(mu, routingInfo))
+	}
+
+	return encodeOnionPacket(ephemKeys[0], routingInfo, nextMac[:]), nil
+}
+
+// peelOnionPacket processes a serialized onion message packet with our node
+// key, which the sender blinded using the given path key. Our payload is
+// returned along with the packet for the next hop, if any.
+func peelOnionPacket(nodeKey keychain.SingleKeyECDH, packet []byte,
+	pathKey *btcec.PublicKey) (*peeledOnion, error) {
+
+	size, err := routingInfoSize(len(packet))
+	if err != nil {
+		return nil, err
+	}
+	if packet[0] != onionVersion {
+		return nil, sphinx.ErrInvalidOnionVersion
+	}
+
+	pubEnd := 1 + btcec.PubKeyBytesLenCompressed
+	ephemKey, err := btcec.ParsePubKey(packet[1:pubEnd])
+	if err != nil {
+		return nil, sphinx.ErrInvalidOnionKey
+	}
+	routingInfo := packet[pubEnd : pubEnd+size]
+	mac := packet[pubEnd+size:]
+
+	secret, err := blindedSharedSecret(nodeKey, ephemKey, pathKey)
+	if err != nil {
+		return nil, err
+	}
+
+	mu := generateKey("mu", secret)
+	if !hmac.Equal(mac, calcMac(mu, routingInfo)) {
+		return nil, errInvalidOnionHMAC
+	}
+
+	// Decrypt our layer. The routing info is extended with zeroes, so that
+	// the routing info of the next hop keeps its size.
+	hopInfo := make([]byte, 2*size)
+	copy(hopInfo, routingInfo)
+	rho := generateKey("rho", secret)
+	xor(hopInfo, cipherStream(rho, 2*size))
+
+	peeled := &peeledOnion{}
+	if err := peeled.payload.Decode(bytes.NewReader(hopInfo)); err != nil {
+		return nil, err
+	}
+	if peeled.payload.NumBytes() > size {
+		return nil, fmt.Errorf("hop payload of %d bytes exceeds "+
+			"routing info", peeled.payload.NumBytes())
+	}
+
+	// An empty HMAC signals that we are the final hop.
+	if peeled.payload.HMAC == [sphinx.HMACSize]byte{} {
+		return peeled, nil
+	}
+
+	factor := blindingFactor(ephemKey, secret)
+	var nextEphem, point btcec.JacobianPoint
+	ephemKey.AsJacobian(&point)
+	btcec.ScalarMultNonConst(&factor, &point, &nextEphem)
+	nextEphem.ToAffine()
+
+	nextStart := peeled.payload.NumBytes()
+	peeled.nextPacket = encodeOnionPacket(
+		btcec.NewPublicKey(&nextEphem.X, &nextEphem.Y),
+		hopInfo[nextStart:nextStart+size], peeled.payload.HMAC[:],
+	)
+
+	return peeled, nil
+}
+
+// encodeOnionPacket serializes an onion message packet.
+func encodeOnionPacket(ephemKey *btcec.PublicKey, routingInfo,
+	mac []byte) []byte {
+
+	packet := make([]byte, 0, onionOverhead+len(routingInfo))
+	packet = append(packet, onionVersion)
+	packet = append(packet, ephemKey.SerializeCompressed()...)
+	packet = append(packet, routingInfo...)
+	packet = append(packet, mac...)
+
+	return packet
+}
+
+// blindedSharedSecret computes the shared secret of an onion message packet
+// that was built for our blinded node key. The factor that our key was
+// blinded with is derived from the path key, and applied to the ephemeral key
+// instead, which results in the same shared secret.
+func blindedSharedSecret(nodeKey keychain.SingleKeyECDH, ephemKey,
+	pathKey *btcec.PublicKey) ([]byte, error) {
+
+	pathSecret, err := nodeKey.ECDH(pathKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var factor btcec.ModNScalar
+	factor.SetByteSlice(generateKey(blindedNodeIDKey, pathSecret[:]))
+
+	var blinded, point btcec.JacobianPoint
+	ephemKey.AsJacobian(&point)
+	btcec.ScalarMultNonConst(&factor, &point, &blinded)
+	blinded.ToAffine()
+
+	secret, err := nodeKey.ECDH(btcec.NewPublicKey(&blinded.X, &blinded.Y))
+	if err != nil {
+		return nil, err
+	}
+
+	return secret[:], nil
+}
+
+// blindingFactor returns the factor that the ephemeral key is blinded with
+// before it is handed to the next hop.
+func blindingFactor(ephemKey *btcec.PublicKey,
+	secret []byte) btcec.ModNScalar {
+
+	h := sha256.New()
+	h.Write(ephemKey.SerializeCompressed())
+	h.Write(secret)
+
+	var factor btcec.ModNScalar
+	factor.SetByteSlice(h.Sum(nil))
+
+	return factor
+}
+
+// generateKey derives a key of the given type from a shared secret.
+func generateKey(keyType string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret)
+
+	return mac.Sum(nil)
+}
+
+// calcMac computes the HMAC over the routing info. Onion messages don't
+// commit to any associated data.
+func calcMac(key, routingInfo []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(routingInfo)
+
+	return mac.Sum(nil)
+}
+
+// cipherStream returns a pseudo random stream of the given length generated
+// with the key.
+func cipherStream(key []byte, length int) []byte {
+	var nonce [chacha20.NonceSize]byte
+	cipher, err := chacha20.NewUnauthenticatedCipher(key, nonce[:])
+	if err != nil {
+		// This can only happen if the key or nonce have the wrong size,
+		// which we control.
+		panic(err)
+	}
+
+	stream := make([]byte, length)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// generateFiller computes the bytes that the hops append to the routing info
+// while peeling the onion, so that the HMACs of all hops can be computed
+// upfront.
+func generateFiller(hops []onionHop, secrets [][]byte, size int) []byte {
+	var fillerSize int
+	for _, hop := range hops[:len(hops)-1] {
+		fillerSize += hop.payload.NumBytes()
+	}
+
+	filler := make([]byte, fillerSize)
+	for i, hop := range hops[:len(hops)-1] {
+		fillerStart := size
+		for _, prev := range hops[:i] {
+			fillerStart -= prev.payload.NumBytes()
+		}
+		fillerEnd := size + hop.payload.NumBytes()
+
+		rho := generateKey("rho", secrets[i])
+		stream := cipherStream(rho, 2*size)
+		xor(
+			filler[:fillerEnd-fillerStart],
+			stream[fillerStart:fillerEnd],
+		)
+	}
+
+	return filler
+}
+
+// xor xors the bytes of b into dst.
+func xor(dst, b []byte) {
+	for i := range dst {
+		dst[i] ^= b[i]
+	}
+}
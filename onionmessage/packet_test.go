@@ -0,0 +1,191 @@
+package onionmessage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlindedPath creates numHops node keys and a blinded path along them.
+func testBlindedPath(t *testing.T, numHops int) ([]*btcec.PrivateKey,
+	*sphinx.BlindedPath) {
+
+	keys := make([]*btcec.PrivateKey, numHops)
+	hopInfo := make([]*sphinx.HopInfo, numHops)
+	for i := range keys {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		keys[i] = key
+		hopInfo[i] = &sphinx.HopInfo{
+			NodePub:   key.PubKey(),
+			PlainText: []byte{byte(i)},
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := sphinx.BuildBlindedPath(sessionKey, hopInfo)
+	require.NoError(t, err)
+
+	return keys, path
+}
+
+// testOnionHops returns the hops of an onion along the blinded path, where
+// the final hop receives the given payload.
+func testOnionHops(t *testing.T, path *sphinx.BlindedPath,
+	final []byte) []onionHop {
+
+	hops := make([]onionHop, len(path.BlindedHops))
+	for i, hop := range path.BlindedHops {
+		payload := hop.CipherText
+		if i == len(hops)-1 {
+			payload = final
+		}
+
+		hopPayload, err := sphinx.NewTLVHopPayload(payload)
+		require.NoError(t, err)
+
+		hops[i] = onionHop{
+			nodeKey: hop.BlindedNodePub,
+			payload: hopPayload,
+		}
+	}
+
+	return hops
+}
+
+// peelAll peels the onion along the blinded path and returns the payload of
+// the final hop.
+func peelAll(t *testing.T, keys []*btcec.PrivateKey,
+	path *sphinx.BlindedPath, packet []byte) []byte {
+
+	pathKey := path.BlindingPoint
+	for i, key := range keys {
+		nodeKey := &keychain.PrivKeyECDH{PrivKey: key}
+		peeled, err := peelOnionPacket(nodeKey, packet, pathKey)
+		require.NoError(t, err)
+
+		if i == len(keys)-1 {
+			require.Nil(t, peeled.nextPacket)
+			return peeled.payload.Payload
+		}
+
+		require.NotNil(t, peeled.nextPacket)
+		require.Len(t, peeled.nextPacket, len(packet))
+		packet = peeled.nextPacket
+
+		pathKey, err = sphinx.NextEphemeral(nodeKey, pathKey)
+		require.NoError(t, err)
+	}
+
+	return nil
+}
+
+// TestOnionPacketSphinxCompat tests that small onion message packets are
+// compatible with the ones built and processed by the sphinx package.
+func TestOnionPacketSphinxCompat(t *testing.T) {
+	t.Parallel()
+
+	keys, path := testBlindedPath(t, 3)
+	hops := testOnionHops(t, path, []byte("final"))
+
+	// A packet that we built can be processed by sphinx.
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	packet, err := newOnionPacket(sessionKey, hops)
+	require.NoError(t, err)
+	require.Len(t, packet, onionOverhead+smallRoutingInfoSize)
+
+	pathKey := path.BlindingPoint
+	for i, key := range keys {
+		router := sphinx.NewRouter(
+			&sphinx.PrivKeyECDH{PrivKey: key}, nil,
+		)
+
+		var onionPkt sphinx.OnionPacket
+		err := onionPkt.Decode(bytes.NewReader(packet))
+		require.NoError(t, err)
+
+		processed, err := router.ReconstructOnionPacket(
+			&onionPkt, nil, sphinx.WithBlindingPoint(pathKey),
+		)
+		require.NoError(t, err)
+
+		if i == len(keys)-1 {
+			require.EqualValues(
+				t, sphinx.ExitNode, processed.Action,
+			)
+			require.Equal(
+				t, []byte("final"), processed.Payload.Payload,
+			)
+
+			break
+		}
+
+		var b bytes.Buffer
+		require.NoError(t, processed.NextPacket.Encode(&b))
+		packet = b.Bytes()
+
+		pathKey, err = router.NextEphemeral(pathKey)
+		require.NoError(t, err)
+	}
+
+	// A packet built by sphinx can be processed by us.
+	var sphinxPath sphinx.PaymentPath
+	for i, hop := range hops {
+		sphinxPath[i] = sphinx.OnionHop{
+			NodePub:    *hop.nodeKey,
+			HopPayload: hop.payload,
+		}
+	}
+	onionPkt, err := sphinx.NewOnionPacket(
+		&sphinxPath, sessionKey, nil, sphinx.DeterministicPacketFiller,
+	)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, onionPkt.Encode(&b))
+	require.Equal(t, []byte("final"), peelAll(t, keys, path, b.Bytes()))
+}
+
+// TestLargeOnionPacket tests that payloads that don't fit into a small onion
+// message packet are sent in a large one, and that packets of any other size
+// are rejected.
+func TestLargeOnionPacket(t *testing.T) {
+	t.Parallel()
+
+	keys, path := testBlindedPath(t, 3)
+	final := bytes.Repeat([]byte{0x01}, 5000)
+	hops := testOnionHops(t, path, final)
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	packet, err := newOnionPacket(sessionKey, hops)
+	require.NoError(t, err)
+	require.Len(t, packet, 32834)
+
+	require.Equal(t, final, peelAll(t, keys, path, packet))
+
+	// Payloads that don't even fit into a large packet are rejected.
+	hops = testOnionHops(t, path, make([]byte, largeRoutingInfoSize))
+	_, err = newOnionPacket(sessionKey, hops)
+	require.ErrorIs(t, err, errPayloadTooLarge)
+
+	// So are packets of any other size.
+	nodeKey := &keychain.PrivKeyECDH{PrivKey: keys[0]}
+	_, err = peelOnionPacket(
+		nodeKey, packet[:len(packet)-1], path.BlindingPoint,
+	)
+	require.ErrorContains(t, err, "unsupported onion packet size")
+
+	// A tampered packet fails the HMAC check.
+	packet[100] ^= 0x01
+	_, err = peelOnionPacket(nodeKey, packet, path.BlindingPoint)
+	require.ErrorIs(t, err, errInvalidOnionHMAC)
+}
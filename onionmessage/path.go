@@ -0,0 +1,149 @@
+package onionmessage
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// MaxPathLength is the maximum number of real hops that we'll use to reach
+// the recipient of an onion message, or the introduction node of its blinded
+// path. The blinded hops of the recipient still need to fit into the onion.
+const MaxPathLength = 10
+
+// ErrNoPathFound is returned when no path through nodes that support onion
+// messages could be found to the recipient of an onion message.
+var ErrNoPathFound = errors.New("no onion message path found")
+
+// Graph is the view of the network that is used to find paths for onion
+// messages.
+type Graph interface {
+	// ForEachNeighbor calls the callback for each node that has a channel
+	// with the given node.
+	ForEachNeighbor(node route.Vertex, cb func(route.Vertex) error) error
+
+	// SupportsOnionMessages returns true if the given node advertises
+	// support for onion messages.
+	SupportsOnionMessages(node route.Vertex) (bool, error)
+}
+
+// FindPath finds the shortest path from the source to the target that only
+// consists of nodes that relay onion messages. The returned path doesn't
+// include the source, it starts at one of its peers and ends with the target.
+// Since onion messages aren't bound to channels, the peers don't need to have
+// a channel with the source, but the remainder of the path is found through
+// the channel graph, as that's the only way we know of connections between
+// other nodes.
+func FindPath(graph Graph, source route.Vertex, peers []route.Vertex,
+	target route.Vertex) ([]route.Vertex, error) {
+
+	// prev maps each node that we've visited to the node that we reached
+	// it from. Our peers are reached directly, so they map to themselves.
+	// The source is marked as visited so that paths never loop back
+	// through it.
+	prev := make(map[route.Vertex]route.Vertex, len(peers)+1)
+	prev[source] = source
+	for _, peer := range peers {
+		if peer == target {
+			return []route.Vertex{target}, nil
+		}
+
+		prev[peer] = peer
+	}
+
+	// Walk back from the target to the peer that the path starts at.
+	pathTo := func(node route.Vertex) []route.Vertex {
+		path := []route.Vertex{node}
+		for prev[node] != node {
+			node = prev[node]
+			path = append([]route.Vertex{node}, path...)
+		}
+
+		return path
+	}
+
+	errFound := errors.New("found")
+
+	queue := peers
+	for depth := 1; depth < MaxPathLength && len(queue) > 0; depth++ {
+		var next []route.Vertex
+		for _, node := range queue {
+			err := graph.ForEachNeighbor(node,
+				func(neighbor route.Vertex) error {
+					if _, ok := prev[neighbor]; ok {
+						return nil
+					}
+
+					ok, err := graph.SupportsOnionMessages(
+						neighbor,
+					)
+					if err != nil || !ok {
+						return err
+					}
+
+					prev[neighbor] = node
+					if neighbor == target {
+						return errFound
+					}
+
+					next = append(next, neighbor)
+
+					return nil
+				},
+			)
+			switch {
+			case errors.Is(err, errFound):
+				return pathTo(target), nil
+
+			case err != nil:
+				return nil, err
+			}
+		}
+
+		queue = next
+	}
+
+	return nil, ErrNoPathFound
+}
+
+// channelGraph implements the Graph interface on top of the channel graph
+// database.
+type channelGraph struct {
+	db channeldb.GraphStore
+}
+
+// NewChannelGraph returns a Graph that is backed by the given channel graph.
+func NewChannelGraph(db channeldb.GraphStore) Graph {
+	return &channelGraph{db: db}
+}
+
+// ForEachNeighbor calls the callback for each node that has a channel with
+// the given node.
+//
+// NOTE: This is part of the Graph interface.
+func (c *channelGraph) ForEachNeighbor(node route.Vertex,
+	cb func(route.Vertex) error) error {
+
+	return c.db.ForEachNodeDirectedChannel(nil, node,
+		func(channel *channeldb.DirectedChannel) error {
+			return cb(channel.OtherNode)
+		},
+	)
+}
+
+// SupportsOnionMessages returns true if the given node advertises support for
+// onion messages.
+//
+// NOTE: This is part of the Graph interface.
+func (c *channelGraph) SupportsOnionMessages(node route.Vertex) (bool,
+	error) {
+
+	features, err := c.db.FetchNodeFeatures(node)
+	if err != nil {
+		return false, err
+	}
+
+	return features.HasFeature(lnwire.OnionMessagesOptional), nil
+}
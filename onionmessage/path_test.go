@@ -0,0 +1,67 @@
+package onionmessage
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// featureGraph is a Graph in which only some nodes support onion messages.
+type featureGraph struct {
+	testGraph
+	unsupported map[route.Vertex]struct{}
+}
+
+func (g *featureGraph) SupportsOnionMessages(node route.Vertex) (bool,
+	error) {
+
+	_, ok := g.unsupported[node]
+	return !ok, nil
+}
+
+// TestFindPath tests that the shortest path through nodes supporting onion
+// messages is found.
+func TestFindPath(t *testing.T) {
+	t.Parallel()
+
+	var (
+		self  = route.Vertex{1}
+		alice = route.Vertex{2}
+		bob   = route.Vertex{3}
+		carol = route.Vertex{4}
+		dave  = route.Vertex{5}
+		eve   = route.Vertex{6}
+	)
+
+	// Self is connected to Alice and Bob. Alice has a short path to Eve
+	// through Carol, but Carol doesn't support onion messages, so the
+	// path through Bob and Dave needs to be used.
+	graph := &featureGraph{
+		testGraph: testGraph{
+			alice: {self, carol},
+			bob:   {self, dave},
+			carol: {alice, eve},
+			dave:  {bob, eve},
+			eve:   {carol, dave},
+		},
+		unsupported: map[route.Vertex]struct{}{
+			carol: {},
+		},
+	}
+	peers := []route.Vertex{alice, bob}
+
+	path, err := FindPath(graph, self, peers, bob)
+	require.NoError(t, err)
+	require.Equal(t, []route.Vertex{bob}, path)
+
+	path, err = FindPath(graph, self, peers, eve)
+	require.NoError(t, err)
+	require.Equal(t, []route.Vertex{bob, dave, eve}, path)
+
+	_, err = FindPath(graph, self, peers, carol)
+	require.ErrorIs(t, err, ErrNoPathFound)
+
+	_, err = FindPath(graph, self, nil, eve)
+	require.ErrorIs(t, err, ErrNoPathFound)
+}
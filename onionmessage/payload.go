@@ -0,0 +1,267 @@
+package onionmessage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// replyPathType is the type of the onion message payload record that
+	// carries a blinded path that the recipient can use to reply.
+	replyPathType tlv.Type = 2
+
+	// encryptedDataType is the type of the onion message payload record
+	// that carries the encrypted route blinding data for a hop.
+	encryptedDataType tlv.Type = 4
+
+	// MinRecordType is the lowest type of the records that carry the
+	// content of an onion message for its final recipient. All lower types
+	// are reserved for the onion message protocol itself.
+	MinRecordType uint64 = 64
+)
+
+var (
+	// ErrInvalidRecordType is returned when a record with a type that is
+	// reserved for the onion message protocol is used as message content.
+	ErrInvalidRecordType = fmt.Errorf("onion message records must have a "+
+		"type of at least %d", MinRecordType)
+
+	// errMissingEncryptedData is returned when an onion message payload
+	// doesn't contain the encrypted data required to process it.
+	errMissingEncryptedData = errors.New("onion message payload is " +
+		"missing encrypted data")
+)
+
+// Payload is the onion payload of a single hop of an onion message.
+type Payload struct {
+	// ReplyPath is an optional blinded path that the final recipient can
+	// use to reply to the message.
+	ReplyPath *sphinx.BlindedPath
+
+	// EncryptedData is the route blinding data for the hop, encrypted by
+	// the creator of the (blinded) path.
+	EncryptedData []byte
+
+	// Records holds the content of the message for the final recipient,
+	// keyed by record type.
+	Records map[uint64][]byte
+}
+
+// Encode serializes the payload as a TLV stream.
+func (p *Payload) Encode() ([]byte, error) {
+	var records []tlv.Record
+
+	if p.ReplyPath != nil {
+		var b bytes.Buffer
+		if err := EncodeBlindedPath(&b, p.ReplyPath); err != nil {
+			return nil, err
+		}
+
+		replyPath := b.Bytes()
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				replyPathType, &replyPath,
+			),
+		)
+	}
+
+	if p.EncryptedData != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				encryptedDataType, &p.EncryptedData,
+			),
+		)
+	}
+
+	for recordType := range p.Records {
+		if recordType < MinRecordType {
+			return nil, ErrInvalidRecordType
+		}
+	}
+	records = append(records, tlv.MapToRecords(p.Records)...)
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodePayload parses the onion payload of an onion message. Records with a
+// type of at least MinRecordType are returned as the content of the message,
+// any other unknown records are ignored.
+func DecodePayload(r io.Reader) (*Payload, error) {
+	var (
+		payload       Payload
+		replyPath     []byte
+		encryptedData []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(replyPathType, &replyPath),
+		tlv.MakePrimitiveRecord(encryptedDataType, &encryptedData),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Since this data is provided by a potentially malicious peer, pass it
+	// into the P2P decoding variant.
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[replyPathType]; ok {
+		payload.ReplyPath, err = DecodeBlindedPath(
+			bytes.NewReader(replyPath),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply path: %w", err)
+		}
+	}
+
+	if _, ok := parsedTypes[encryptedDataType]; ok {
+		payload.EncryptedData = encryptedData
+	}
+
+	for recordType, value := range parsedTypes {
+		if value == nil || uint64(recordType) < MinRecordType {
+			continue
+		}
+
+		if payload.Records == nil {
+			payload.Records = make(map[uint64][]byte)
+		}
+		payload.Records[uint64(recordType)] = value
+	}
+
+	return &payload, nil
+}
+
+// EncodeBlindedPath serializes a blinded path in the format that is used to
+// transmit blinded paths within onion messages.
+func EncodeBlindedPath(w io.Writer, path *sphinx.BlindedPath) error {
+	if len(path.BlindedHops) == 0 ||
+		len(path.BlindedHops) > math.MaxUint8 {
+
+		return fmt.Errorf("invalid number of blinded hops: %d",
+			len(path.BlindedHops))
+	}
+
+	_, err := w.Write(path.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(path.BlindingPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write([]byte{uint8(len(path.BlindedHops))}); err != nil {
+		return err
+	}
+
+	for _, hop := range path.BlindedHops {
+		if len(hop.CipherText) > math.MaxUint16 {
+			return fmt.Errorf("encrypted data too large: %d bytes",
+				len(hop.CipherText))
+		}
+
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(len(hop.CipherText)))
+		if _, err := w.Write(l[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeBlindedPath parses a blinded path that was serialized with
+// EncodeBlindedPath. Introduction nodes that are identified by a short
+// channel ID rather than by their public key aren't supported.
+func DecodeBlindedPath(r io.Reader) (*sphinx.BlindedPath, error) {
+	readPubKey := func() (*btcec.PublicKey, error) {
+		var b [btcec.PubKeyBytesLenCompressed]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+
+		return btcec.ParsePubKey(b[:])
+	}
+
+	var (
+		path sphinx.BlindedPath
+		err  error
+	)
+
+	path.IntroductionPoint, err = readPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("invalid introduction node: %w", err)
+	}
+
+	path.BlindingPoint, err = readPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("invalid blinding point: %w", err)
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, errors.New("blinded path has no hops")
+	}
+
+	path.BlindedHops = make([]*sphinx.BlindedHopInfo, numHops[0])
+	for i := range path.BlindedHops {
+		nodePub, err := readPubKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinded node id: %w",
+				err)
+		}
+
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return nil, err
+		}
+
+		cipherText := make([]byte, binary.BigEndian.Uint16(l[:]))
+		if _, err := io.ReadFull(r, cipherText); err != nil {
+			return nil, err
+		}
+
+		path.BlindedHops[i] = &sphinx.BlindedHopInfo{
+			BlindedNodePub: nodePub,
+			CipherText:     cipherText,
+		}
+	}
+
+	return &path, nil
+}
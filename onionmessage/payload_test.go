@@ -0,0 +1,73 @@
+package onionmessage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPayloadEncoding tests that onion message payloads, including the
+// blinded reply path, survive an encoding round trip.
+func TestPayloadEncoding(t *testing.T) {
+	t.Parallel()
+
+	randKey := func() *btcec.PublicKey {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return key.PubKey()
+	}
+
+	payload := &Payload{
+		ReplyPath: &sphinx.BlindedPath{
+			IntroductionPoint: randKey(),
+			BlindingPoint:     randKey(),
+			BlindedHops: []*sphinx.BlindedHopInfo{
+				{
+					BlindedNodePub: randKey(),
+					CipherText:     []byte{1, 2, 3},
+				},
+				{
+					BlindedNodePub: randKey(),
+					CipherText:     []byte{4, 5},
+				},
+			},
+		},
+		EncryptedData: []byte{6, 7, 8},
+		Records: map[uint64][]byte{
+			64:   {9},
+			1000: {10, 11},
+		},
+	}
+
+	b, err := payload.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodePayload(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	// Relaying hops only receive their encrypted data.
+	b, err = (&Payload{EncryptedData: []byte{1}}).Encode()
+	require.NoError(t, err)
+
+	decoded, err = DecodePayload(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, &Payload{EncryptedData: []byte{1}}, decoded)
+
+	// Records of the types that are reserved for the protocol can't be
+	// used as content.
+	_, err = (&Payload{Records: map[uint64][]byte{63: {1}}}).Encode()
+	require.ErrorIs(t, err, ErrInvalidRecordType)
+
+	// A blinded path without hops is invalid.
+	var buf bytes.Buffer
+	err = EncodeBlindedPath(&buf, &sphinx.BlindedPath{
+		IntroductionPoint: randKey(),
+		BlindingPoint:     randKey(),
+	})
+	require.Error(t, err)
+}
@@ -34,7 +34,6 @@ import (
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/input"
-	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnutils"
@@ -49,6 +48,7 @@ import (
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/lightningnetwork/lnd/watchtower/wtclient"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -88,6 +88,16 @@ const (
 	// torTimeoutMultiplier is the scaling factor we use on network timeouts
 	// for Tor peers.
 	torTimeoutMultiplier = 3
+
+	// onionMsgRate is the number of onion messages per second that we
+	// accept from a single peer on average. Onion messages are relayed
+	// without any payment, so this limits the bandwidth a peer can make
+	// us spend on them.
+	onionMsgRate = rate.Limit(10)
+
+	// onionMsgBurst is the number of onion messages that a peer may send
+	// us at once before being rate limited.
+	onionMsgBurst = 50
 )
 
 var (
@@ -219,7 +229,7 @@ type Config struct {
 
 	// ChannelGraph is a pointer to the channel graph which is used to
 	// query information about the set of known active channels.
-	ChannelGraph *channeldb.ChannelGraph
+	ChannelGraph channeldb.GraphStore
 
 	// ChainArb is used to subscribe to channel events, update contract signals,
 	// and force close channels.
@@ -271,8 +281,9 @@ type Config struct {
 	WitnessBeacon contractcourt.WitnessBeacon
 
 	// Invoices is passed to the ChannelLink on creation and handles all
-	// invoice-related logic.
-	Invoices *invoices.InvoiceRegistry
+	// invoice-related logic, as well as trampoline payments if trampoline
+	// routing is enabled.
+	Invoices htlcswitch.InvoiceDatabase
 
 	// ChannelNotifier is used by the link to notify other sub-systems about
 	// channel-related events and by the Brontide to subscribe to
@@ -359,6 +370,14 @@ type Config struct {
 	// from the peer.
 	HandleCustomMessage func(peer [33]byte, msg *lnwire.Custom) error
 
+	// HandleOnionMessage is called whenever an onion message is received
+	// from the peer. If it's nil, onion messages are ignored.
+	HandleOnionMessage func(peer [33]byte, msg *lnwire.OnionMessage)
+
+	// HandlePeerStorage is called whenever a peer storage message is
+	// received from the peer. If it's nil, these messages are ignored.
+	HandlePeerStorage func(peer [33]byte, msg lnwire.Message)
+
 	// GetAliases is passed to created links so the Switch and link can be
 	// aware of the channel's aliases.
 	GetAliases func(base lnwire.ShortChannelID) []lnwire.ShortChannelID
@@ -371,6 +390,16 @@ type Config struct {
 	AddLocalAlias func(alias, base lnwire.ShortChannelID,
 		gossip bool) error
 
+	// GetPeerAlias looks up the alias the peer sent us for the channel
+	// with the given channel ID.
+	GetPeerAlias func(chanID lnwire.ChannelID) (lnwire.ShortChannelID,
+		error)
+
+	// PutPeerAlias persists the alias the peer sent us for the channel
+	// with the given channel ID.
+	PutPeerAlias func(chanID lnwire.ChannelID,
+		alias lnwire.ShortChannelID) error
+
 	// PongBuf is a slice we'll reuse instead of allocating memory on the
 	// heap. Since only reads will occur and no writes, there is no need
 	// for any synchronization primitives. As a result, it's safe to share
@@ -386,6 +415,15 @@ type Config struct {
 	// This value will be passed to created links.
 	MaxFeeExposure lnwire.MilliSatoshi
 
+	// MaxLocalCSVDelay is the maximum CSV delay that we accept on our own
+	// commitments. This value will be passed to created links.
+	MaxLocalCSVDelay uint16
+
+	// Endorsement holds the config of the experimental endorsement signal
+	// that is passed to created links. It is nil if the signal isn't
+	// used.
+	Endorsement *htlcswitch.EndorsementConfig
+
 	// Quit is the server's quit channel. If this is closed, we halt operation.
 	Quit chan struct{}
 }
@@ -503,10 +541,62 @@ type Brontide struct {
 	// well as lnwire.ClosingSigned messages.
 	chanCloseMsgs chan *closeMsg
 
+	// rbfClosesDone receives the IDs of the channels whose RBF co-op close
+	// confirmed, so their state machines can be removed from
+	// activeChanCloses.
+	rbfClosesDone chan lnwire.ChannelID
+
+	// activeSplices is a map that keeps track of the splices that are
+	// currently negotiated. Any splice message of the remote party is
+	// directed to the splicer of its channel.
+	activeSplices *lnutils.SyncMap[lnwire.ChannelID, *activeSplice]
+
+	// earlySpliceInits stores the splice_init messages of the remote party
+	// that arrived before their channel was handed to us by the link.
+	earlySpliceInits map[lnwire.ChannelID]*lnwire.SpliceInit
+
+	// spliceResumes holds the functions that resume the links of channels
+	// with a pending splice whose transaction wasn't signed by both
+	// parties yet.
+	spliceResumes *lnutils.SyncMap[lnwire.ChannelID, func()]
+
+	// spliceLocks tracks the exchange of splice_locked messages for
+	// confirmed splices, keyed by the channel ID after the splice.
+	spliceLocks map[lnwire.ChannelID]*pendingSpliceLock
+
+	// localSpliceReqs is a channel in which any local requests to splice
+	// a particular channel are sent over.
+	localSpliceReqs chan *SpliceRequest
+
+	// spliceStarts is a channel over which the links hand quiescent
+	// channels to the channelManager to negotiate a splice.
+	spliceStarts chan *spliceStart
+
+	// spliceMsgs is a channel that any message related to splices is sent
+	// over.
+	spliceMsgs chan *spliceMsg
+
+	// appliedSplices is a channel over which splices are sent once their
+	// transaction confirmed, and the channel was moved onto the new
+	// funding output.
+	appliedSplices chan *appliedSplice
+
+	// spliceConfs is a channel over which splices are sent once their
+	// transaction is buried deep enough to be locked.
+	spliceConfs chan *spliceConf
+
+	// signedUpgrades is a channel over which the links send the funding
+	// outpoints of channels whose commitment type upgrade was signed.
+	signedUpgrades chan wire.OutPoint
+
 	// remoteFeatures is the feature vector received from the peer during
 	// the connection handshake.
 	remoteFeatures *lnwire.FeatureVector
 
+	// onionMsgLimiter limits the rate at which we process onion messages
+	// from the peer. Any messages above the limit are dropped.
+	onionMsgLimiter *rate.Limiter
+
 	// resentChanSyncMsg is a set that keeps track of which channels we
 	// have re-sent channel reestablishment messages for. This is done to
 	// avoid getting into loop where both peers will respond to the other
@@ -555,10 +645,28 @@ func NewBrontide(cfg Config) *Brontide {
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		linkFailures:       make(chan linkFailureReport),
 		chanCloseMsgs:      make(chan *closeMsg),
-		resentChanSyncMsg:  make(map[lnwire.ChannelID]struct{}),
-		startReady:         make(chan struct{}),
-		quit:               make(chan struct{}),
-		log:                build.NewPrefixLog(logPrefix, peerLog),
+		rbfClosesDone:      make(chan lnwire.ChannelID),
+		activeSplices: &lnutils.SyncMap[
+			lnwire.ChannelID, *activeSplice,
+		]{},
+		earlySpliceInits: make(map[lnwire.ChannelID]*lnwire.SpliceInit),
+		spliceResumes: &lnutils.SyncMap[
+			lnwire.ChannelID, func(),
+		]{},
+		spliceLocks: make(
+			map[lnwire.ChannelID]*pendingSpliceLock,
+		),
+		localSpliceReqs:   make(chan *SpliceRequest),
+		spliceStarts:      make(chan *spliceStart),
+		spliceMsgs:        make(chan *spliceMsg),
+		appliedSplices:    make(chan *appliedSplice),
+		spliceConfs:       make(chan *spliceConf),
+		signedUpgrades:    make(chan wire.OutPoint),
+		resentChanSyncMsg: make(map[lnwire.ChannelID]struct{}),
+		onionMsgLimiter:   rate.NewLimiter(onionMsgRate, onionMsgBurst),
+		startReady:        make(chan struct{}),
+		quit:              make(chan struct{}),
+		log:               build.NewPrefixLog(logPrefix, peerLog),
 	}
 
 	if cfg.Conn != nil && cfg.Conn.RemoteAddr() != nil {
@@ -975,61 +1083,25 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// Before we register this new link with the HTLC Switch, we'll
 		// need to fetch its current link-layer forwarding policy from
 		// the database.
-		graph := p.cfg.ChannelGraph
-		info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(
-			&chanPoint,
-		)
-		if err != nil && !errors.Is(err, channeldb.ErrEdgeNotFound) {
+		forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+		if err != nil {
 			return nil, err
 		}
 
-		// We'll filter out our policy from the directional channel
-		// edges based whom the edge connects to. If it doesn't connect
-		// to us, then we know that we were the one that advertised the
-		// policy.
-		//
-		// TODO(roasbeef): can add helper method to get policy for
-		// particular channel.
-		var selfPolicy *models.ChannelEdgePolicy
-		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.cfg.ServerPubKey[:]) {
-
-			selfPolicy = p1
-		} else {
-			selfPolicy = p2
-		}
-
 		// If we don't yet have an advertised routing policy, then
-		// we'll use the current default, otherwise we'll translate the
-		// routing policy into a forwarding policy.
-		var forwardingPolicy *models.ForwardingPolicy
-		if selfPolicy != nil {
-			var inboundWireFee lnwire.Fee
-			_, err := selfPolicy.ExtraOpaqueData.ExtractRecords(
-				&inboundWireFee,
-			)
+		// we'll use the initial forwarding policy of the channel. It's
+		// set for spliced channels whose new funding output wasn't
+		// added to the graph yet. Otherwise, we'll use the current
+		// default.
+		if forwardingPolicy == nil {
+			forwardingPolicy, err = p.cfg.ChannelDB.
+				GetInitialForwardingPolicy(chanID)
 			if err != nil {
-				return nil, err
+				p.log.Warnf("Unable to find our forwarding "+
+					"policy for channel %v, using default "+
+					"values", chanPoint)
+				forwardingPolicy = &p.cfg.RoutingPolicy
 			}
-
-			inboundFee := models.NewInboundFeeFromWire(
-				inboundWireFee,
-			)
-
-			forwardingPolicy = &models.ForwardingPolicy{
-				MinHTLCOut:    selfPolicy.MinHTLC,
-				MaxHTLC:       selfPolicy.MaxHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
-
-				InboundFee: inboundFee,
-			}
-		} else {
-			p.log.Warnf("Unable to find our forwarding policy "+
-				"for channel %v, using default values",
-				chanPoint)
-			forwardingPolicy = &p.cfg.RoutingPolicy
 		}
 
 		p.log.Tracef("Using link policy of: %v",
@@ -1044,9 +1116,38 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		if lnChan.IsPending() {
 			p.activeChannels.Store(chanID, nil)
 
+			// If the signatures for the funding transaction of a
+			// co-funded channel weren't exchanged before the
+			// disconnection, we'll ask the remote party to
+			// retransmit its tx_signatures.
+			coFundTx, err := dbChan.CoFundTx()
+			if err != nil || coFundTx.IsFinal() {
+				continue
+			}
+
+			chanSync, err := dbChan.ChanSyncMsg()
+			if err != nil {
+				p.log.Errorf("Unable to create channel "+
+					"reestablish message for channel %v: "+
+					"%v", chanPoint, err)
+				continue
+			}
+			msgs = append(msgs, chanSync)
+
 			continue
 		}
 
+		// If the channel was spliced, we'll wait for both parties to
+		// lock the splice.
+		prevChanPoint, err := dbChan.SpliceLockPending()
+		if err != nil {
+			return nil, err
+		}
+		if prevChanPoint != nil {
+			p.wg.Add(1)
+			go p.waitForSpliceLock(*prevChanPoint, dbChan)
+		}
+
 		shutdownInfo, err := lnChan.State().ShutdownInfo()
 		if err != nil && !errors.Is(err, channeldb.ErrNoShutdownInfo) {
 			return nil, err
@@ -1118,11 +1219,69 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		}
 
 		p.activeChannels.Store(chanID, lnChan)
+
+		// A signed splice may still confirm, so we'll wait for it.
+		pendingSplice, err := hasPendingSplice(dbChan)
+		if err != nil {
+			return nil, err
+		}
+		if pendingSplice {
+			p.trackPendingSplices(dbChan)
+
+			p.wg.Add(1)
+			go p.waitForSpliceConf(chanPoint)
+		}
 	}
 
 	return msgs, nil
 }
 
+// fetchForwardingPolicy returns our forwarding policy of the channel with the
+// given funding outpoint as advertised in the graph. It returns nil if we
+// don't have an advertised policy for the channel yet.
+func (p *Brontide) fetchForwardingPolicy(
+	chanPoint wire.OutPoint) (*models.ForwardingPolicy, error) {
+
+	graph := p.cfg.ChannelGraph
+	info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(&chanPoint)
+	if err != nil && !errors.Is(err, channeldb.ErrEdgeNotFound) {
+		return nil, err
+	}
+
+	// We'll filter out our policy from the directional channel edges
+	// based whom the edge connects to. If it doesn't connect to us, then
+	// we know that we were the one that advertised the policy.
+	var selfPolicy *models.ChannelEdgePolicy
+	if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
+		p.cfg.ServerPubKey[:]) {
+
+		selfPolicy = p1
+	} else {
+		selfPolicy = p2
+	}
+
+	if selfPolicy == nil {
+		return nil, nil
+	}
+
+	// Translate the routing policy into a forwarding policy.
+	var inboundWireFee lnwire.Fee
+	_, err = selfPolicy.ExtraOpaqueData.ExtractRecords(&inboundWireFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ForwardingPolicy{
+		MinHTLCOut:    selfPolicy.MinHTLC,
+		MaxHTLC:       selfPolicy.MaxHTLC,
+		BaseFee:       selfPolicy.FeeBaseMSat,
+		FeeRate:       selfPolicy.FeeProportionalMillionths,
+		TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+
+		InboundFee: models.NewInboundFeeFromWire(inboundWireFee),
+	}, nil
+}
+
 // addLink creates and adds a new ChannelLink from the specified channel.
 func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 	lnChan *lnwallet.LightningChannel,
@@ -1199,14 +1358,46 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		GetAliases:              p.cfg.GetAliases,
 		PreviouslySentShutdown:  shutdownMsg,
 		DisallowRouteBlinding:   p.cfg.DisallowRouteBlinding,
+		DisallowDynCommitments:  !p.hasNegotiatedDynCommitments(),
+		DisallowCommitUpgrades:  !p.hasNegotiatedCommitUpgrades(),
+		DisallowQuiescence:      !p.hasNegotiatedQuiescence(),
 		MaxFeeExposure:          p.cfg.MaxFeeExposure,
+		MaxLocalCSVDelay:        p.cfg.MaxLocalCSVDelay,
+		MaxRemoteCSVDelay:       funding.MaxBtcRemoteDelay,
+		Endorsement:             p.cfg.Endorsement,
+		PublishKickoff: func(tx *wire.MsgTx) error {
+			return p.publishKickoff(lnChan, tx)
+		},
+		NotifyUpgradeSigned: func() {
+			p.notifyUpgradeSigned(*chanPoint)
+		},
+	}
+
+	// If splicing was negotiated, the remote party may bring the channel
+	// to quiescence to splice it. Otherwise, there's no protocol to run
+	// once the channel is quiescent, so the link disconnects if the remote
+	// party sends stfu.
+	chanID := lnwire.NewChanIDFromOutPoint(*chanPoint)
+	if p.hasNegotiatedSplice() {
+		linkCfg.QuiescentProtocol = p.spliceProtocol(chanID)
+	}
+
+	// A channel whose splice transaction wasn't signed by both parties
+	// stays quiescent until the signatures are retransmitted.
+	awaitsSigs, err := awaitsSpliceSigs(lnChan.State())
+	if err != nil {
+		return err
+	}
+	if awaitsSigs {
+		linkCfg.StartQuiescent = func(resume func()) {
+			p.spliceResumes.Store(chanID, resume)
+		}
 	}
 
 	// Before adding our new link, purge the switch of any pending or live
 	// links going by the same channel id. If one is found, we'll shut it
 	// down to ensure that the mailboxes are only ever under the control of
 	// one link.
-	chanID := lnwire.NewChanIDFromOutPoint(*chanPoint)
 	p.cfg.Switch.RemoveLink(chanID)
 
 	// With the channel link created, we'll now notify the htlc switch so
@@ -1687,6 +1878,10 @@ func (p *Brontide) readHandler() {
 	discStream := newDiscMsgStream(p)
 	discStream.Start()
 	defer discStream.Stop()
+
+	// spliceSigsRcvd tracks the channels for which we received the
+	// tx_signatures or tx_abort of the splice that's negotiated.
+	spliceSigsRcvd := make(map[lnwire.ChannelID]struct{})
 out:
 	for atomic.LoadInt32(&p.disconnect) == 0 {
 		nextMsg, err := p.readNextMessage()
@@ -1780,6 +1975,83 @@ out:
 			case <-p.quit:
 				break out
 			}
+		case *lnwire.ClosingComplete:
+			select {
+			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.ClosingSig:
+			select {
+			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		case *lnwire.SpliceInit,
+			*lnwire.SpliceAck,
+			*lnwire.SpliceLocked,
+			*lnwire.TxAddInput,
+			*lnwire.TxAddOutput,
+			*lnwire.TxRemoveInput,
+			*lnwire.TxRemoveOutput,
+			*lnwire.TxComplete,
+			*lnwire.TxSignatures,
+			*lnwire.TxAbort:
+
+			// The interactive transaction construction messages
+			// are also used to build the funding transaction of
+			// co-funded channels, which aren't active yet.
+			cid := msg.(lnwire.LinkUpdater).TargetChanID()
+			if isInteractiveTxMsg(msg) && !p.isActiveChannel(cid) {
+				p.cfg.FundingManager.ProcessFundingMsg(msg, p)
+				break
+			}
+
+			// Once the signatures for the splice transaction were
+			// sent, or the splice was aborted, the commit_sig of
+			// the remote party updates the channel again.
+			switch msg.(type) {
+			case *lnwire.TxSignatures, *lnwire.TxAbort:
+				spliceSigsRcvd[cid] = struct{}{}
+
+			case *lnwire.SpliceInit, *lnwire.SpliceAck:
+				delete(spliceSigsRcvd, cid)
+			}
+
+			select {
+			case p.spliceMsgs <- &spliceMsg{cid, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		// While a splice is negotiated, the commit_sig of the remote
+		// party signs the commitment that spends the new funding
+		// output, so it's directed to the splicer instead of the link.
+		// As the splice is only removed from the active splices once
+		// the channelManager processed the tx_signatures, we check
+		// whether we received them first.
+		case *lnwire.CommitSig:
+			_, sigsRcvd := spliceSigsRcvd[msg.ChanID]
+			_, ok := p.activeSplices.Load(msg.ChanID)
+			if ok && !sigsRcvd {
+				splice := &spliceMsg{msg.ChanID, msg}
+				select {
+				case p.spliceMsgs <- splice:
+				case <-p.quit:
+					break out
+				}
+
+				break
+			}
+
+			targetChan = msg.ChanID
+			isLinkUpdate = p.hasChannel(targetChan)
+			if !isLinkUpdate {
+				p.log.Errorf("Unknown channel ID: %v found "+
+					"in received msg=%s", targetChan,
+					nextMsg.MsgType())
+			}
 
 		case *lnwire.Warning:
 			targetChan = msg.ChanID
@@ -1793,6 +2065,23 @@ out:
 			targetChan = msg.ChanID
 			isLinkUpdate = p.hasChannel(targetChan)
 
+			// If the remote party didn't receive our tx_signatures
+			// for a pending splice, it asks us to retransmit them.
+			// The same applies to the funding transaction of a
+			// pending co-funded channel, which is handled by the
+			// funding manager.
+			hasFundingTxID := msg.NextFundingTxID.IsSome()
+			if hasFundingTxID && p.isPendingChannel(targetChan) {
+				p.cfg.FundingManager.ProcessFundingMsg(msg, p)
+			} else if isLinkUpdate && hasFundingTxID {
+				splice := &spliceMsg{targetChan, msg}
+				select {
+				case p.spliceMsgs <- splice:
+				case <-p.quit:
+					break out
+				}
+			}
+
 			// If we failed to find the link in question, and the
 			// message received was a channel sync message, then
 			// this might be a peer trying to resync closed channel.
@@ -1844,6 +2133,14 @@ out:
 				p.log.Errorf("%v", err)
 			}
 
+		case *lnwire.OnionMessage:
+			p.handleOnionMessage(msg)
+
+		case *lnwire.PeerStorage, *lnwire.PeerStorageRetrieval:
+			if p.cfg.HandlePeerStorage != nil {
+				p.cfg.HandlePeerStorage(p.PubKey(), msg)
+			}
+
 		default:
 			// If the message we received is unknown to us, store
 			// the type to track the failure.
@@ -1879,6 +2176,23 @@ func (p *Brontide) handleCustomMessage(msg *lnwire.Custom) error {
 	return p.cfg.HandleCustomMessage(p.PubKey(), msg)
 }
 
+// handleOnionMessage hands the given onion message to the onion message
+// handler, unless the peer exceeds its rate limit. Onion messages are
+// unreliable, so any message that we can't handle is silently dropped.
+func (p *Brontide) handleOnionMessage(msg *lnwire.OnionMessage) {
+	if p.cfg.HandleOnionMessage == nil {
+		p.log.Tracef("Ignoring onion message, onion messages disabled")
+		return
+	}
+
+	if !p.onionMsgLimiter.Allow() {
+		p.log.Debugf("Dropping onion message, rate limit exceeded")
+		return
+	}
+
+	p.cfg.HandleOnionMessage(p.PubKey(), msg)
+}
+
 // isLoadedFromDisk returns true if the provided channel ID is loaded from
 // disk.
 //
@@ -2044,6 +2358,13 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, fee_sat=%v", msg.ChannelID,
 			msg.FeeSatoshis)
 
+	case *lnwire.ClosingComplete:
+		return fmt.Sprintf("chan_id=%v, fee_sat=%v, sequence=%v",
+			msg.ChannelID, msg.FeeSatoshis, msg.Sequence)
+
+	case *lnwire.ClosingSig:
+		return fmt.Sprintf("chan_id=%v", msg.ChannelID)
+
 	case *lnwire.UpdateAddHTLC:
 		var blindingPoint []byte
 		msg.BlindingPoint.WhenSome(
@@ -2146,6 +2467,16 @@ func messageSummary(msg lnwire.Message) string {
 
 	case *lnwire.Custom:
 		return fmt.Sprintf("type=%d", msg.Type)
+
+	case *lnwire.OnionMessage:
+		return fmt.Sprintf("path_key=%x, onion_len=%d",
+			msg.PathKey.SerializeCompressed(), len(msg.OnionBlob))
+
+	case *lnwire.PeerStorage:
+		return fmt.Sprintf("blob_len=%d", len(msg.Blob))
+
+	case *lnwire.PeerStorageRetrieval:
+		return fmt.Sprintf("blob_len=%d", len(msg.Blob))
 	}
 
 	return fmt.Sprintf("unknown msg type=%T", msg)
@@ -2562,6 +2893,41 @@ out:
 		case closeMsg := <-p.chanCloseMsgs:
 			p.handleCloseMsg(closeMsg)
 
+		// The RBF co-op close of a channel confirmed, so its closing
+		// transaction can't be bumped anymore.
+		case cid := <-p.rbfClosesDone:
+			delete(p.activeChanCloses, cid)
+
+		// We've received a local request to splice an active channel,
+		// which first brings the channel to quiescence.
+		case req := <-p.localSpliceReqs:
+			p.handleLocalSpliceReq(req)
+
+		// A link handed us its quiescent channel to negotiate a
+		// splice.
+		case start := <-p.spliceStarts:
+			p.handleSpliceStart(start)
+
+		// We've received a splice related message from the remote
+		// peer, we'll use it to advance the splice negotiation.
+		case msg := <-p.spliceMsgs:
+			p.handleSpliceMsg(msg)
+
+		// A splice transaction confirmed, so we'll move the channel
+		// onto the new funding output.
+		case applied := <-p.appliedSplices:
+			p.handleAppliedSplice(applied)
+
+		// A splice transaction is buried deep enough to lock the
+		// splice.
+		case conf := <-p.spliceConfs:
+			p.handleSpliceConf(conf)
+
+		// The upgrade of the commitment type of a channel was signed,
+		// so we'll wait for its kickoff transaction to confirm.
+		case chanPoint := <-p.signedUpgrades:
+			p.handleSignedUpgrade(chanPoint)
+
 		// The channel reannounce delay has elapsed, broadcast the
 		// reenabled channel updates to the network. This should only
 		// fire once, so we set the reenableTimeout channel to nil to
@@ -2920,13 +3286,18 @@ func (p *Brontide) restartCoopClose(lnChan *lnwallet.LightningChannel) (
 	// provided close script. Instead use the LocalUpfrontShutdownScript
 	// or generate a script.
 	c := lnChan.State()
-	_, err := c.BroadcastedCooperative()
-	if err != nil && err != channeldb.ErrNoCloseTx {
-		// An error other than ErrNoCloseTx was encountered.
+	closeTx, err := c.BroadcastedCooperative()
+	switch {
+	case errors.Is(err, channeldb.ErrNoCloseTx):
+
+	// An error other than ErrNoCloseTx was encountered.
+	case err != nil:
 		return nil, err
-	} else if err == nil {
-		// This channel has already completed the coop close
-		// negotiation.
+
+	// This channel has already completed the coop close negotiation. An
+	// RBF co-op close can still be fee bumped though, so we'll resume it
+	// with its latest closing transaction.
+	case !p.useRbfCoopClose(lnChan):
 		return nil, nil
 	}
 
@@ -2983,6 +3354,17 @@ func (p *Brontide) restartCoopClose(lnChan *lnwallet.LightningChannel) (
 		return nil, fmt.Errorf("unable to create chan closer")
 	}
 
+	if closeTx != nil {
+		if err := chanCloser.ResumeRbfClose(closeTx); err != nil {
+			p.log.Errorf("unable to resume rbf co-op close: %v",
+				err)
+
+			return nil, err
+		}
+
+		go p.waitForRbfClose(chanCloser, nil, make(chan error, 1))
+	}
+
 	// This does not need a mutex even though it is in a different
 	// goroutine since this is done before the channelManager goroutine is
 	// created.
@@ -3034,8 +3416,9 @@ func (p *Brontide) createChanCloser(channel *lnwallet.LightningChannel,
 			Disconnect: func() error {
 				return p.cfg.DisconnectPeer(p.IdentityKey())
 			},
-			ChainParams: &p.cfg.Wallet.Cfg.NetParams,
-			Quit:        p.quit,
+			ChainParams:  &p.cfg.Wallet.Cfg.NetParams,
+			Quit:         p.quit,
+			RbfCoopClose: p.useRbfCoopClose(channel),
 		},
 		deliveryScript,
 		fee,
@@ -3052,6 +3435,16 @@ func (p *Brontide) createChanCloser(channel *lnwallet.LightningChannel,
 func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	chanID := lnwire.NewChanIDFromOutPoint(*req.ChanPoint)
 
+	// If an RBF co-op close is already underway for this channel, then
+	// this request is a fee bump of our closing transaction.
+	chanCloser, ok := p.activeChanCloses[chanID]
+	if ok && chanCloser.IsRbfCoopClose() &&
+		req.CloseType == contractcourt.CloseRegular {
+
+		p.bumpRbfCoopClose(chanCloser, req)
+		return
+	}
+
 	channel, ok := p.activeChannels.Load(chanID)
 
 	// Though this function can't be called for pending channels, we still
@@ -3334,6 +3727,139 @@ func (p *Brontide) finalizeChanClosure(chanCloser *chancloser.ChanCloser) {
 		})
 }
 
+// bumpRbfCoopClose sends a new co-op close offer to the remote party that pays
+// the fee rate of the passed close request.
+func (p *Brontide) bumpRbfCoopClose(chanCloser *chancloser.ChanCloser,
+	req *htlcswitch.ChanClose) {
+
+	p.log.Infof("Bumping co-op close fee of ChannelPoint(%v) to %v",
+		req.ChanPoint, req.TargetFeePerKw)
+
+	res, err := chanCloser.BumpFee(req)
+	if err != nil {
+		p.log.Errorf("Unable to bump co-op close fee: %v", err)
+		req.Err <- err
+		return
+	}
+
+	p.handleRbfCloseResult(chanCloser, res)
+}
+
+// handleRbfCloseResult sends out any messages produced by a step of the RBF
+// co-op close flow, and finalizes the closure if a new closing transaction was
+// broadcast.
+func (p *Brontide) handleRbfCloseResult(chanCloser *chancloser.ChanCloser,
+	res *chancloser.RbfCloseResult) {
+
+	for _, msg := range res.Msgs {
+		p.queueMsg(msg, nil)
+	}
+
+	res.CloseTx.WhenSome(func(closeTx *wire.MsgTx) {
+		p.finalizeRbfChanClosure(chanCloser, closeTx, res.CloseReq)
+	})
+}
+
+// finalizeRbfChanClosure is the RBF co-op close analogue of
+// finalizeChanClosure. As the closing transaction may be replaced by a later
+// offer, the state machine is kept around, and we'll wait for the funding
+// output to be spent rather than for a particular closing transaction to
+// confirm.
+func (p *Brontide) finalizeRbfChanClosure(chanCloser *chancloser.ChanCloser,
+	closeTx *wire.MsgTx, closeReq *htlcswitch.ChanClose) {
+
+	// This may be called from within the flush hook of the link, so we'll
+	// wipe the channel in a goroutine to prevent a deadlock. Wiping the
+	// channel multiple times is harmless.
+	chanPoint := chanCloser.Channel().ChannelPoint()
+	go p.WipeChannel(&chanPoint)
+
+	// If this transaction was broadcast on behalf of a local close
+	// request, then we'll notify it of the pending close, and forward any
+	// error encountered while waiting for the close to it.
+	errChan := make(chan error, 1)
+	if closeReq != nil {
+		closingTxid := closeTx.TxHash()
+		closeReq.Updates <- &PendingUpdate{
+			Txid: closingTxid[:],
+		}
+
+		errChan = closeReq.Err
+	}
+
+	go p.waitForRbfClose(chanCloser, closeReq, errChan)
+}
+
+// waitForRbfClose waits until the funding output of a channel that is closed
+// via the RBF co-op close flow is spent, then notifies the passed close
+// request, if any, and removes the state machine from activeChanCloses. Any
+// error encountered while waiting is sent over errChan.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Brontide) waitForRbfClose(chanCloser *chancloser.ChanCloser,
+	closeReq *htlcswitch.ChanClose, errChan chan error) {
+
+	chanPoint := chanCloser.Channel().ChannelPoint()
+	cid := lnwire.NewChanIDFromOutPoint(chanPoint)
+	fundingTxOut := chanCloser.Channel().FundingTxOut()
+	WaitForRbfChanToClose(
+		chanCloser.NegotiationHeight(), p.cfg.ChainNotifier,
+		errChan, &chanPoint, fundingTxOut.PkScript,
+		func(closingTxid chainhash.Hash) {
+			if closeReq != nil {
+				closeReq.Updates <- &ChannelCloseUpdate{
+					ClosingTxid: closingTxid[:],
+					Success:     true,
+				}
+			}
+
+			// Now that the channel is closed, the state machine
+			// can be removed.
+			select {
+			case p.rbfClosesDone <- cid:
+			case <-p.quit:
+			}
+		},
+	)
+}
+
+// WaitForRbfChanToClose uses the passed notifier to wait until the funding
+// output of the channel has been spent on chain, and then executes the passed
+// callback with the txid of the final closing transaction. As the closing
+// transaction of an RBF co-op close can be replaced, we can't wait for a
+// particular txid to confirm. If any error is encountered within the
+// function, then it will be sent over the errChan.
+func WaitForRbfChanToClose(bestHeight uint32,
+	notifier chainntnfs.ChainNotifier, errChan chan error,
+	chanPoint *wire.OutPoint, fundingPkScript []byte,
+	cb func(chainhash.Hash)) {
+
+	peerLog.Infof("Waiting for RBF co-op close of ChannelPoint(%v) to "+
+		"confirm", chanPoint)
+
+	spendNtfn, err := notifier.RegisterSpendNtfn(
+		chanPoint, fundingPkScript, bestHeight,
+	)
+	if err != nil {
+		if errChan != nil {
+			errChan <- err
+		}
+		return
+	}
+
+	// In the case that the ChainNotifier is shutting down, all subscriber
+	// notification channels will be closed, generating a nil receive.
+	spend, ok := <-spendNtfn.Spend
+	if !ok {
+		return
+	}
+
+	peerLog.Infof("ChannelPoint(%v) is now closed by %v at height %v",
+		chanPoint, spend.SpenderTxHash, spend.SpendingHeight)
+
+	cb(*spend.SpenderTxHash)
+}
+
 // WaitForChanToClose uses the passed notifier to wait until the channel has
 // been detected as closed on chain and then concludes by executing the
 // following actions: the channel point will be sent over the settleChan, and
@@ -3453,6 +3979,51 @@ func (p *Brontide) hasNegotiatedScidAlias() bool {
 	return peerHas && localHas
 }
 
+// useRbfCoopClose returns true if the passed channel is closed via the RBF
+// co-op close flow. The flow is only used if both sides signal support for it.
+// Taproot channels aren't yet supported as their co-op close signatures
+// require a fresh pair of nonces for each offer.
+func (p *Brontide) useRbfCoopClose(channel *lnwallet.LightningChannel) bool {
+	return p.UsesRbfCoopClose(channel.ChanType())
+}
+
+// UsesRbfCoopClose returns true if a channel of the given type with this peer
+// is closed via the RBF co-op close flow, meaning that its closing
+// transaction can be replaced by one paying a higher fee.
+func (p *Brontide) UsesRbfCoopClose(chanType channeldb.ChannelType) bool {
+	return p.hasNegotiatedRbfCoopClose() && !chanType.IsTaproot()
+}
+
+// hasNegotiatedRbfCoopClose returns true if both we and the peer signal
+// support for the RBF co-op close flow.
+func (p *Brontide) hasNegotiatedRbfCoopClose() bool {
+	peerHas := p.remoteFeatures.HasFeature(lnwire.RbfCoopCloseStagingOptional)
+	localHas := p.cfg.Features.HasFeature(lnwire.RbfCoopCloseStagingOptional)
+	return peerHas && localHas
+}
+
+// hasNegotiatedDynCommitments returns true if both we and the remote peer
+// support dynamic commitments.
+func (p *Brontide) hasNegotiatedDynCommitments() bool {
+	peerHas := p.remoteFeatures.HasFeature(
+		lnwire.DynamicCommitmentsOptional,
+	)
+	localHas := p.cfg.Features.HasFeature(
+		lnwire.DynamicCommitmentsOptional,
+	)
+
+	return peerHas && localHas
+}
+
+// hasNegotiatedQuiescence returns true if both we and the remote peer support
+// the quiescence protocol.
+func (p *Brontide) hasNegotiatedQuiescence() bool {
+	peerHas := p.remoteFeatures.HasFeature(lnwire.QuiescenceOptional)
+	localHas := p.cfg.Features.HasFeature(lnwire.QuiescenceOptional)
+
+	return peerHas && localHas
+}
+
 // sendInitMsg sends the Init message to the remote peer. This message contains
 // our currently supported local and global features.
 func (p *Brontide) sendInitMsg(legacyChan bool) error {
@@ -3801,6 +4372,18 @@ func (p *Brontide) handleCloseMsg(msg *closeMsg) {
 		})
 
 		beginNegotiation := func() {
+			if chanCloser.IsRbfCoopClose() {
+				res, err := chanCloser.BeginRbfNegotiation()
+				if err != nil {
+					handleErr(err)
+					return
+				}
+
+				p.handleRbfCloseResult(chanCloser, res)
+
+				return
+			}
+
 			oClosingSigned, err := chanCloser.BeginNegotiation()
 			if err != nil {
 				handleErr(err)
@@ -3836,10 +4419,35 @@ func (p *Brontide) handleCloseMsg(msg *closeMsg) {
 			p.queueMsg(&msg, nil)
 		})
 
+	case *lnwire.ClosingComplete:
+		res, err := chanCloser.ReceiveClosingComplete(*typed)
+		if err != nil {
+			handleErr(err)
+			return
+		}
+
+		p.handleRbfCloseResult(chanCloser, res)
+
+	case *lnwire.ClosingSig:
+		res, err := chanCloser.ReceiveClosingSig(*typed)
+		if err != nil {
+			handleErr(err)
+			return
+		}
+
+		p.handleRbfCloseResult(chanCloser, res)
+
 	default:
 		panic("impossible closeMsg type")
 	}
 
+	// The RBF co-op close flow finalizes each closing transaction as it's
+	// broadcast, and keeps the state machine around for any further fee
+	// bumps.
+	if chanCloser.IsRbfCoopClose() {
+		return
+	}
+
 	// If we haven't finished close negotiations, then we'll continue as we
 	// can't yet finalize the closure.
 	if _, err := chanCloser.ClosingTx(); err != nil {
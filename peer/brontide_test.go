@@ -1412,3 +1412,29 @@ func TestRemovePendingChannel(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+// TestHandleOnionMessageRateLimit tests that onion messages are only handed to
+// the onion message handler as long as the peer stays within its rate limit.
+func TestHandleOnionMessageRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var handled int
+	p := NewBrontide(Config{
+		HandleOnionMessage: func(_ [33]byte, _ *lnwire.OnionMessage) {
+			handled++
+		},
+	})
+
+	// A burst of messages that exceeds the limit is cut off at the burst
+	// size. The limiter may refill a token while the messages are
+	// handled, so we allow for one more message.
+	for i := 0; i < 2*onionMsgBurst; i++ {
+		p.handleOnionMessage(&lnwire.OnionMessage{})
+	}
+	require.GreaterOrEqual(t, handled, onionMsgBurst)
+	require.LessOrEqual(t, handled, onionMsgBurst+1)
+
+	// Without a handler, onion messages are ignored.
+	p.cfg.HandleOnionMessage = nil
+	p.handleOnionMessage(&lnwire.OnionMessage{})
+}
@@ -0,0 +1,1122 @@
+package peer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chansplicer"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrSpliceNotNegotiated is returned when a splice is requested with a
+	// peer that doesn't support splicing.
+	ErrSpliceNotNegotiated = errors.New("splicing not supported by peer")
+
+	// ErrSpliceInProgress is returned when a splice is requested for a
+	// channel that is already being spliced.
+	ErrSpliceInProgress = errors.New("channel is already being spliced")
+
+	// ErrRemoteSpliceInitiator is returned to a local splice request when
+	// the remote party started its own splice at the same time, and won
+	// the tie-break.
+	ErrRemoteSpliceInitiator = errors.New("remote party initiated a " +
+		"splice")
+)
+
+// SpliceRequest is a local request to splice a channel.
+type SpliceRequest struct {
+	// ChanPoint is the funding outpoint of the channel to splice.
+	ChanPoint wire.OutPoint
+
+	// Amt is the amount our channel balance changes by. It's negative for
+	// a splice-out.
+	Amt btcutil.Amount
+
+	// FeeRate is the fee rate of the splice transaction.
+	FeeRate chainfee.SatPerKWeight
+
+	// Updates is sent the splice transaction once it's fully signed and
+	// broadcast.
+	Updates chan *wire.MsgTx
+
+	// Err is sent an error if the splice failed.
+	Err chan error
+}
+
+// NewSpliceRequest creates a new request to splice the given channel.
+func NewSpliceRequest(chanPoint wire.OutPoint, amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight) *SpliceRequest {
+
+	return &SpliceRequest{
+		ChanPoint: chanPoint,
+		Amt:       amt,
+		FeeRate:   feeRate,
+		Updates:   make(chan *wire.MsgTx, 1),
+		Err:       make(chan error, 1),
+	}
+}
+
+// spliceMsg is a splice related message of the remote party, along with the
+// channel it targets.
+type spliceMsg struct {
+	cid lnwire.ChannelID
+	msg lnwire.Message
+}
+
+// spliceStart is sent by the quiescent protocol of a link once its channel
+// is quiescent and a splice can be negotiated.
+type spliceStart struct {
+	cid       lnwire.ChannelID
+	initiator lntypes.ChannelParty
+	resume    func()
+
+	// req is the local request that brought the channel to quiescence.
+	// It's nil if the remote party did.
+	req *SpliceRequest
+}
+
+// activeSplice is a splice that is currently negotiated.
+type activeSplice struct {
+	splicer *chansplicer.Splicer
+
+	// resume ends the quiescence of the channel.
+	resume func()
+
+	// req is the local request of the splice, if we initiated it.
+	req *SpliceRequest
+}
+
+// appliedSplice is sent once a splice transaction confirmed, and the channel
+// was moved onto the new funding output.
+type appliedSplice struct {
+	// prevChanPoint is the funding outpoint of the channel before the
+	// splice.
+	prevChanPoint wire.OutPoint
+
+	// channel is the channel state that spends the new funding output.
+	channel *channeldb.OpenChannel
+}
+
+// spliceConf is sent once a splice transaction is buried deep enough for the
+// splice to be locked.
+type spliceConf struct {
+	// prevChanPoint is the funding outpoint of the channel before the
+	// splice.
+	prevChanPoint wire.OutPoint
+
+	// channel is the channel state that spends the new funding output.
+	channel *channeldb.OpenChannel
+
+	// scid is the short channel id of the new funding output.
+	scid lnwire.ShortChannelID
+}
+
+// pendingSpliceLock tracks the exchange of splice_locked messages for a
+// confirmed splice.
+type pendingSpliceLock struct {
+	// conf is set once we sent our splice_locked.
+	conf *spliceConf
+
+	// remoteTxid is set once we received the splice_locked of the remote
+	// party.
+	remoteTxid *chainhash.Hash
+}
+
+// isInteractiveTxMsg returns true if the message is part of the interactive
+// transaction construction protocol, which isn't specific to splices.
+func isInteractiveTxMsg(msg lnwire.Message) bool {
+	switch msg.(type) {
+	case *lnwire.TxAddInput, *lnwire.TxAddOutput, *lnwire.TxRemoveInput,
+		*lnwire.TxRemoveOutput, *lnwire.TxComplete,
+		*lnwire.TxSignatures, *lnwire.TxAbort:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// hasNegotiatedSplice returns true if both we and the remote peer support
+// splicing.
+func (p *Brontide) hasNegotiatedSplice() bool {
+	peerHas := p.remoteFeatures.HasFeature(lnwire.SpliceStagingOptional)
+	localHas := p.cfg.Features.HasFeature(lnwire.SpliceStagingOptional)
+
+	return peerHas && localHas
+}
+
+// hasNegotiatedCommitUpgrades returns true if both we and the remote peer
+// support upgrading the commitment type of a channel to a simple taproot
+// channel. The upgrade is negotiated via dynamic commitments, and the channel
+// is moved onto the kickoff transaction like a splice.
+func (p *Brontide) hasNegotiatedCommitUpgrades() bool {
+	peerHas := p.remoteFeatures.HasFeature(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	)
+	localHas := p.cfg.Features.HasFeature(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	)
+
+	return peerHas && localHas && p.hasNegotiatedSplice() &&
+		p.hasNegotiatedDynCommitments()
+}
+
+// hasPendingSplice returns true if the channel has a splice whose
+// transaction was signed, but didn't confirm yet.
+func hasPendingSplice(channel *channeldb.OpenChannel) (bool, error) {
+	splices, err := channel.PendingSplices()
+	if err != nil {
+		return false, err
+	}
+
+	return len(splices) != 0, nil
+}
+
+// awaitsSpliceSigs returns true if the channel has a pending splice whose
+// transaction wasn't signed by both parties yet. Its link stays quiescent
+// until the signatures are exchanged, as the remote party may still forget
+// the splice. The same goes for a pending upgrade of the commitment type,
+// whose channel stays quiescent until the kickoff transaction confirms.
+func awaitsSpliceSigs(channel *channeldb.OpenChannel) (bool, error) {
+	splices, err := channel.PendingSplices()
+	if err != nil {
+		return false, err
+	}
+
+	for _, splice := range splices {
+		if !splice.IsFinal() || splice.IsUpgrade() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// HandleLocalSpliceReq hands a local request to splice a channel to the
+// peer. The outcome of the request is sent on its Updates or Err channels.
+func (p *Brontide) HandleLocalSpliceReq(req *SpliceRequest) {
+	select {
+	case p.localSpliceReqs <- req:
+	case <-p.quit:
+		req.Err <- lnpeer.ErrPeerExiting
+	}
+}
+
+// handleLocalSpliceReq brings the channel of a local splice request to
+// quiescence, after which the splice is started by handleSpliceStart.
+func (p *Brontide) handleLocalSpliceReq(req *SpliceRequest) {
+	cid := lnwire.NewChanIDFromOutPoint(req.ChanPoint)
+
+	lnChan, ok := p.activeChannels.Load(cid)
+	switch {
+	case !ok || lnChan == nil:
+		req.Err <- fmt.Errorf("unable to find active channel %v",
+			req.ChanPoint)
+		return
+
+	case !p.hasNegotiatedSplice():
+		req.Err <- ErrSpliceNotNegotiated
+		return
+	}
+
+	if _, ok := p.activeSplices.Load(cid); ok {
+		req.Err <- ErrSpliceInProgress
+		return
+	}
+
+	link := p.fetchLinkFromKeyAndCid(cid)
+	if link == nil {
+		req.Err <- fmt.Errorf("no link found for channel %v",
+			req.ChanPoint)
+		return
+	}
+
+	p.log.Infof("Bringing ChannelPoint(%v) to quiescence for splice",
+		req.ChanPoint)
+
+	errChan := link.InitStfu(func(initiator lntypes.ChannelParty,
+		resume func()) {
+
+		p.startSplice(&spliceStart{
+			cid:       cid,
+			initiator: initiator,
+			resume:    resume,
+			req:       req,
+		})
+	})
+
+	// The link only reports back once the channel is quiescent, so we
+	// won't block the channelManager while waiting for it.
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		select {
+		case err := <-errChan:
+			if err != nil {
+				req.Err <- err
+			}
+
+		case <-p.quit:
+		}
+	}()
+}
+
+// spliceProtocol returns the quiescent protocol of the link of the given
+// channel, which negotiates a splice that was initiated by the remote party.
+func (p *Brontide) spliceProtocol(
+	cid lnwire.ChannelID) htlcswitch.QuiescentProtocol {
+
+	return func(initiator lntypes.ChannelParty, resume func()) {
+		p.startSplice(&spliceStart{
+			cid:       cid,
+			initiator: initiator,
+			resume:    resume,
+		})
+	}
+}
+
+// startSplice hands a quiescent channel to the channelManager to negotiate a
+// splice.
+func (p *Brontide) startSplice(start *spliceStart) {
+	select {
+	case p.spliceStarts <- start:
+	case <-p.quit:
+	}
+}
+
+// spliceConfig returns the config used to splice the given channel.
+func (p *Brontide) spliceConfig(
+	lnChan *lnwallet.LightningChannel) chansplicer.Config {
+
+	return chansplicer.Config{
+		Channel:    lnChan,
+		Wallet:     p.cfg.Wallet,
+		NetParams:  &p.cfg.Wallet.Cfg.NetParams,
+		BestHeight: p.cfg.BestBlockView.BestHeight,
+		Broadcast: func(tx *wire.MsgTx) error {
+			scid := lnChan.ShortChanID()
+			label := labels.MakeLabel(
+				labels.LabelTypeChannelSplice, &scid,
+			)
+
+			return p.cfg.Wallet.PublishTransaction(tx, label)
+		},
+	}
+}
+
+// publishKickoff broadcasts the kickoff transaction of an upgrade of the
+// commitment type of the given channel.
+func (p *Brontide) publishKickoff(lnChan *lnwallet.LightningChannel,
+	kickoffTx *wire.MsgTx) error {
+
+	scid := lnChan.ShortChanID()
+	label := labels.MakeLabel(labels.LabelTypeChannelSplice, &scid)
+
+	return p.cfg.Wallet.PublishTransaction(kickoffTx, label)
+}
+
+// notifyUpgradeSigned hands the channel with the given funding outpoint to
+// the channelManager once an upgrade of its commitment type was signed, so
+// that it waits for the kickoff transaction to confirm.
+func (p *Brontide) notifyUpgradeSigned(chanPoint wire.OutPoint) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		select {
+		case p.signedUpgrades <- chanPoint:
+		case <-p.quit:
+		}
+	}()
+}
+
+// handleSignedUpgrade tracks the pending upgrade of the channel with the given
+// funding outpoint like a splice, and waits for its kickoff transaction to
+// confirm.
+func (p *Brontide) handleSignedUpgrade(chanPoint wire.OutPoint) {
+	cid := lnwire.NewChanIDFromOutPoint(chanPoint)
+	lnChan, ok := p.activeChannels.Load(cid)
+	if !ok || lnChan == nil {
+		return
+	}
+
+	p.log.Infof("Upgrade of ChannelPoint(%v) signed, waiting for "+
+		"kickoff tx to confirm", chanPoint)
+
+	p.trackPendingSplices(lnChan.State())
+
+	p.wg.Add(1)
+	go p.waitForSpliceConf(chanPoint)
+}
+
+// newSplicer creates a new Splicer for the given channel.
+func (p *Brontide) newSplicer(
+	lnChan *lnwallet.LightningChannel) *chansplicer.Splicer {
+
+	return chansplicer.NewSplicer(p.spliceConfig(lnChan))
+}
+
+// saveSplicePolicy persists our forwarding policy of the channel with the
+// given funding outpoint as the initial forwarding policy of the channel with
+// the given ID, which spends the funding output of its splice. It's used by
+// the link of the spliced channel until its new funding output is added to
+// the graph.
+func (p *Brontide) saveSplicePolicy(prevChanPoint wire.OutPoint,
+	cid lnwire.ChannelID) error {
+
+	policy, err := p.fetchForwardingPolicy(prevChanPoint)
+	if err != nil {
+		return err
+	}
+
+	// If the channel wasn't added to the graph yet, it may still have its
+	// own initial forwarding policy.
+	if policy == nil {
+		prevCid := lnwire.NewChanIDFromOutPoint(prevChanPoint)
+		policy, err = p.cfg.ChannelDB.GetInitialForwardingPolicy(
+			prevCid,
+		)
+		switch {
+		case errors.Is(err, channeldb.ErrChannelNotFound):
+			policy = &p.cfg.RoutingPolicy
+
+		case err != nil:
+			return err
+		}
+	}
+
+	return p.cfg.ChannelDB.SaveInitialForwardingPolicy(cid, policy)
+}
+
+// trackPendingSplices registers the IDs of the channels that spend the funding
+// outputs of the pending splices of the given channel. Any message of the
+// remote party for them is held back until we moved onto the new funding
+// output, as the remote party may see the splice transaction confirm first.
+func (p *Brontide) trackPendingSplices(channel *channeldb.OpenChannel) {
+	splices, err := channel.PendingSplices()
+	if err != nil {
+		p.log.Errorf("Unable to fetch pending splices of "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+		return
+	}
+
+	for _, splice := range splices {
+		cid := lnwire.NewChanIDFromOutPoint(splice.FundingOutpoint)
+		if !p.hasChannel(cid) {
+			p.activeChannels.Store(cid, nil)
+		}
+
+		err := p.saveSplicePolicy(channel.FundingOutpoint, cid)
+		if err != nil {
+			p.log.Errorf("Unable to save forwarding policy of "+
+				"spliced ChannelPoint(%v): %v",
+				splice.FundingOutpoint, err)
+		}
+	}
+}
+
+// forgetSplice removes the pending splice of the given channel, which can't
+// be completed anymore. The link of the channel resumes if it was kept
+// quiescent for the splice.
+func (p *Brontide) forgetSplice(cid lnwire.ChannelID,
+	lnChan *lnwallet.LightningChannel) {
+
+	splices, err := lnChan.State().PendingSplices()
+	if err != nil {
+		p.log.Errorf("Unable to fetch pending splices of channel "+
+			"%v: %v", cid, err)
+		return
+	}
+
+	if err := lnChan.DeletePendingSplices(); err != nil {
+		p.log.Errorf("Unable to delete pending splices of channel "+
+			"%v: %v", cid, err)
+		return
+	}
+
+	for _, splice := range splices {
+		spliceCid := lnwire.NewChanIDFromOutPoint(
+			splice.FundingOutpoint,
+		)
+		if p.isPendingChannel(spliceCid) {
+			p.activeChannels.Delete(spliceCid)
+		}
+
+		err := p.cfg.ChannelDB.DeleteInitialForwardingPolicy(spliceCid)
+		if err != nil {
+			p.log.Warnf("Unable to delete forwarding policy of "+
+				"spliced ChannelPoint(%v): %v",
+				splice.FundingOutpoint, err)
+		}
+	}
+
+	if resume, ok := p.spliceResumes.LoadAndDelete(cid); ok {
+		resume()
+	}
+}
+
+// handleSpliceStart starts the negotiation of a splice once its channel is
+// quiescent.
+func (p *Brontide) handleSpliceStart(start *spliceStart) {
+	lnChan, ok := p.activeChannels.Load(start.cid)
+	if !ok || lnChan == nil {
+		p.log.Errorf("Unable to find channel %v to splice", start.cid)
+
+		if start.req != nil {
+			start.req.Err <- fmt.Errorf("unable to find channel %v",
+				start.req.ChanPoint)
+		}
+		start.resume()
+
+		return
+	}
+
+	splice := &activeSplice{
+		splicer: p.newSplicer(lnChan),
+		resume:  start.resume,
+	}
+
+	// If the remote party won the tie-break, we'll respond to its splice
+	// instead, and let our request fail.
+	if start.initiator == lntypes.Remote {
+		if start.req != nil {
+			start.req.Err <- ErrRemoteSpliceInitiator
+		}
+
+		p.activeSplices.Store(start.cid, splice)
+
+		// The splice_init of the remote party may have arrived
+		// before the channel was handed to us.
+		if msg, ok := p.earlySpliceInits[start.cid]; ok {
+			delete(p.earlySpliceInits, start.cid)
+			p.handleSpliceMsg(&spliceMsg{cid: start.cid, msg: msg})
+		}
+
+		return
+	}
+
+	// We initiated quiescence without a splice request, which can't
+	// happen, so there's nothing to do but to resume.
+	if start.req == nil {
+		start.resume()
+		return
+	}
+
+	req := start.req
+	splice.req = req
+
+	res, err := splice.splicer.Initiate(req.Amt, req.FeeRate)
+	if err != nil {
+		p.log.Errorf("Unable to initiate splice of ChannelPoint(%v): "+
+			"%v", req.ChanPoint, err)
+
+		req.Err <- err
+		start.resume()
+
+		return
+	}
+
+	p.activeSplices.Store(start.cid, splice)
+	for _, msg := range res.Msgs {
+		p.queueMsg(msg, nil)
+	}
+}
+
+// handleSpliceMsg advances the negotiation of a splice with the given message
+// of the remote party.
+func (p *Brontide) handleSpliceMsg(msg *spliceMsg) {
+	switch m := msg.msg.(type) {
+	case *lnwire.SpliceLocked:
+		p.handleSpliceLocked(msg.cid, m)
+		return
+
+	case *lnwire.ChannelReestablish:
+		p.handleSpliceReestablish(msg.cid, m)
+		return
+	}
+
+	splice, ok := p.activeSplices.Load(msg.cid)
+	if !ok {
+		switch m := msg.msg.(type) {
+		// The splice_init may be processed before the link handed us
+		// the quiescent channel.
+		case *lnwire.SpliceInit:
+			p.earlySpliceInits[msg.cid] = m
+
+		// The tx_signatures of a splice whose negotiation didn't
+		// complete are retransmitted on reconnection.
+		case *lnwire.TxSignatures:
+			p.recoverSplice(msg.cid, m)
+
+		// A tx_abort is echoed back by the remote party once we
+		// aborted a splice, or sent on reconnection if the remote
+		// party can't complete a pending splice.
+		case *lnwire.TxAbort:
+			p.handleRecoveryAbort(msg.cid)
+
+		default:
+			p.log.Warnf("Received %v for channel %v without "+
+				"active splice", msg.msg.MsgType(), msg.cid)
+		}
+
+		return
+	}
+
+	res, err := splice.splicer.ProcessMsg(msg.msg)
+	if err != nil {
+		p.failSplice(msg.cid, splice, err)
+		return
+	}
+
+	for _, m := range res.Msgs {
+		p.queueMsg(m, nil)
+	}
+
+	res.SpliceTx.WhenSome(func(tx *wire.MsgTx) {
+		p.activeSplices.Delete(msg.cid)
+
+		if splice.req != nil {
+			splice.req.Updates <- tx
+		}
+
+		lnChan, ok := p.activeChannels.Load(msg.cid)
+		if !ok || lnChan == nil {
+			splice.resume()
+			return
+		}
+		p.trackPendingSplices(lnChan.State())
+
+		// The channel resumes, and every commitment is signed for
+		// both funding outputs until the splice transaction confirms.
+		splice.resume()
+
+		p.wg.Add(1)
+		go p.waitForSpliceConf(lnChan.ChannelPoint())
+	})
+}
+
+// failSplice aborts the given splice. The channel resumes, unless we already
+// sent our signatures for the splice transaction, in which case it may still
+// confirm. The channel then stays quiescent until the signatures of the
+// remote party are received, as the remote party may have forgotten the
+// splice.
+func (p *Brontide) failSplice(cid lnwire.ChannelID, splice *activeSplice,
+	err error) {
+
+	p.log.Errorf("Splice of channel %v failed: %v", cid, err)
+
+	p.activeSplices.Delete(cid)
+
+	txAbort, canForget := splice.splicer.Abort(err)
+	p.queueMsg(txAbort, nil)
+
+	if splice.req != nil {
+		splice.req.Err <- err
+	}
+
+	lnChan, ok := p.activeChannels.Load(cid)
+	if !ok || lnChan == nil {
+		return
+	}
+
+	if !canForget {
+		p.spliceResumes.Store(cid, splice.resume)
+		p.trackPendingSplices(lnChan.State())
+
+		p.wg.Add(1)
+		go p.waitForSpliceConf(lnChan.ChannelPoint())
+
+		return
+	}
+
+	if err := lnChan.DeletePendingSplices(); err != nil {
+		p.log.Errorf("Unable to delete pending splices of channel "+
+			"%v: %v", cid, err)
+
+		return
+	}
+
+	splice.resume()
+}
+
+// resendCoFundTxSigs retransmits our tx_signatures for the funding
+// transaction of the co-funded channel.
+func (p *Brontide) resendCoFundTxSigs(cid lnwire.ChannelID,
+	chanState *channeldb.OpenChannel) {
+
+	coFundTx, err := chanState.CoFundTx()
+	if err != nil {
+		p.log.Errorf("Unable to fetch funding tx of channel %v: %v",
+			cid, err)
+		return
+	}
+
+	p.log.Infof("Retransmitting tx_signatures for funding tx %v of "+
+		"channel %v", chanState.FundingOutpoint.Hash, cid)
+
+	p.queueMsg(coFundTx.LocalTxSigs, nil)
+}
+
+// handleSpliceReestablish retransmits our tx_signatures for a pending splice
+// of the channel if the remote party didn't receive them before the
+// disconnection, as indicated by the next_funding_txid of its
+// channel_reestablish. If we can't complete the splice, it's aborted.
+func (p *Brontide) handleSpliceReestablish(cid lnwire.ChannelID,
+	msg *lnwire.ChannelReestablish) {
+
+	if msg.NextFundingTxID.IsNone() {
+		return
+	}
+	txid := chainhash.Hash(msg.NextFundingTxID.UnsafeFromSome())
+
+	lnChan, ok := p.activeChannels.Load(cid)
+	if !ok || lnChan == nil {
+		return
+	}
+
+	// The remote party may still await our tx_signatures for the funding
+	// transaction of a co-funded channel that confirmed before it
+	// received them.
+	chanState := lnChan.State()
+	if txid == chanState.FundingOutpoint.Hash {
+		p.resendCoFundTxSigs(cid, chanState)
+		return
+	}
+
+	splices, err := chanState.PendingSplices()
+	if err != nil {
+		p.log.Errorf("Unable to fetch pending splices of channel "+
+			"%v: %v", cid, err)
+		return
+	}
+
+	var splice *channeldb.ChannelSplice
+	for _, pending := range splices {
+		if pending.FundingTx.TxHash() == txid {
+			splice = pending
+		}
+	}
+
+	switch {
+	// We don't know the splice, so the remote party can forget it.
+	case splice == nil:
+		p.log.Infof("Remote party awaits unknown splice tx %v of "+
+			"channel %v, aborting", txid, cid)
+
+		p.queueMsg(&lnwire.TxAbort{
+			ChanID: cid,
+			Data:   lnwire.ErrorData("unknown splice tx"),
+		}, nil)
+
+	// If we didn't sign the splice transaction yet, its negotiation
+	// can't be completed anymore.
+	case !splice.IsSigned():
+		p.log.Infof("Aborting unsigned splice tx %v of channel %v",
+			txid, cid)
+
+		p.queueMsg(&lnwire.TxAbort{
+			ChanID: cid,
+			Data:   lnwire.ErrorData("splice tx wasn't signed"),
+		}, nil)
+		p.forgetSplice(cid, lnChan)
+
+	// Our signatures are retransmitted if we completed the splice
+	// transaction, or if we're the party that signs first. Otherwise, we
+	// wait for the signatures of the remote party.
+	case splice.IsFinal() || chansplicer.SignsFirst(
+		chanState, splice.LocalContribution,
+		splice.RemoteContribution,
+	):
+
+		p.log.Infof("Retransmitting tx_signatures for splice tx %v "+
+			"of channel %v", txid, cid)
+
+		p.queueMsg(splice.LocalTxSigs, nil)
+	}
+}
+
+// recoverSplice completes a pending splice of the channel whose negotiation
+// didn't complete before a disconnection with the retransmitted tx_signatures
+// of the remote party. Once the splice transaction is complete, the link of
+// the channel resumes.
+func (p *Brontide) recoverSplice(cid lnwire.ChannelID,
+	msg *lnwire.TxSignatures) {
+
+	lnChan, ok := p.activeChannels.Load(cid)
+	if !ok || lnChan == nil {
+		p.log.Warnf("Received tx_signatures for unknown channel %v",
+			cid)
+		return
+	}
+
+	// The signatures for the funding transaction of a co-funded
+	// channel aren't needed anymore once it confirmed.
+	if msg.TxID == lnChan.State().FundingOutpoint.Hash {
+		p.log.Debugf("Ignoring tx_signatures for confirmed funding "+
+			"tx %v of channel %v", msg.TxID, cid)
+		return
+	}
+
+	res, err := chansplicer.RecoverTxSignatures(
+		p.spliceConfig(lnChan), msg,
+	)
+	if err != nil {
+		p.log.Errorf("Unable to recover splice of channel %v: %v",
+			cid, err)
+
+		p.queueMsg(&lnwire.TxAbort{
+			ChanID: cid,
+			Data:   lnwire.ErrorData(err.Error()),
+		}, nil)
+		p.handleRecoveryAbort(cid)
+
+		return
+	}
+
+	for _, m := range res.Msgs {
+		p.queueMsg(m, nil)
+	}
+
+	p.log.Infof("Recovered splice tx %v of channel %v", msg.TxID, cid)
+
+	p.trackPendingSplices(lnChan.State())
+
+	if resume, ok := p.spliceResumes.LoadAndDelete(cid); ok {
+		resume()
+	}
+}
+
+// handleRecoveryAbort forgets the pending splice of the channel if it was
+// aborted before its transaction was complete, and we never sent our
+// signatures for it. Otherwise, the remote party may still broadcast the
+// splice transaction, so we keep waiting for it to confirm.
+func (p *Brontide) handleRecoveryAbort(cid lnwire.ChannelID) {
+	lnChan, ok := p.activeChannels.Load(cid)
+	if !ok || lnChan == nil {
+		return
+	}
+
+	chanState := lnChan.State()
+	splices, err := chanState.PendingSplices()
+	if err != nil {
+		p.log.Errorf("Unable to fetch pending splices of channel "+
+			"%v: %v", cid, err)
+		return
+	}
+	if len(splices) == 0 {
+		return
+	}
+
+	// The kickoff transaction of an upgrade may be broadcast by the remote
+	// party as soon as we signed it, so an upgrade is never forgotten.
+	for _, splice := range splices {
+		if splice.IsUpgrade() ||
+			!chansplicer.CanForget(chanState, splice) {
+
+			p.log.Warnf("Splice tx %v of channel %v was aborted, "+
+				"but may still confirm",
+				splice.FundingTx.TxHash(), cid)
+
+			return
+		}
+	}
+
+	p.log.Infof("Forgetting aborted splice of channel %v", cid)
+
+	p.forgetSplice(cid, lnChan)
+}
+
+// waitForSpliceConf waits for one of the pending splices of the channel with
+// the given funding outpoint to be buried deep enough, after which the channel
+// is moved onto the new funding output.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Brontide) waitForSpliceConf(chanPoint wire.OutPoint) {
+	defer p.wg.Done()
+
+	chainEvents, err := p.cfg.ChainArb.SubscribeChannelEvents(chanPoint)
+	if err != nil {
+		p.log.Errorf("Unable to subscribe to chain events of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+	defer chainEvents.Cancel()
+
+	p.log.Infof("Waiting for splice of ChannelPoint(%v) to confirm",
+		chanPoint)
+
+	select {
+	case splice := <-chainEvents.SpliceConfirmation:
+		channel, err := p.cfg.ChannelDB.FetchChannel(
+			nil, splice.FundingOutpoint,
+		)
+		if err != nil {
+			p.log.Errorf("Unable to fetch spliced channel %v: %v",
+				splice.FundingOutpoint, err)
+			return
+		}
+
+		select {
+		case p.appliedSplices <- &appliedSplice{
+			prevChanPoint: chanPoint,
+			channel:       channel,
+		}:
+		case <-p.quit:
+		}
+
+	case <-p.quit:
+	}
+}
+
+// handleAppliedSplice replaces the link of a channel whose splice transaction
+// was buried with a link for the new funding output, which reestablishes the
+// channel with the remote party. The channel stays usable while we wait for
+// the splice to be locked.
+func (p *Brontide) handleAppliedSplice(applied *appliedSplice) {
+	prevCid := lnwire.NewChanIDFromOutPoint(applied.prevChanPoint)
+	channel := applied.channel
+	cid := lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint)
+
+	// The confirmation may be reported by more than one subscription.
+	if !p.hasChannel(prevCid) {
+		return
+	}
+
+	p.log.Infof("Splice of ChannelPoint(%v) confirmed, moving channel "+
+		"to ChannelPoint(%v)", applied.prevChanPoint,
+		channel.FundingOutpoint)
+
+	p.WipeChannel(&applied.prevChanPoint)
+	p.spliceResumes.Delete(prevCid)
+
+	// The alias the remote party sent us is keyed by the channel ID, so
+	// it's carried over to the new one.
+	if channel.NegotiatedAliasFeature() {
+		alias, err := p.cfg.GetPeerAlias(prevCid)
+		if err == nil {
+			err = p.cfg.PutPeerAlias(cid, alias)
+		}
+		if err != nil {
+			p.log.Warnf("Unable to carry over peer alias of "+
+				"ChannelPoint(%v): %v", channel.FundingOutpoint,
+				err)
+		}
+	}
+
+	err := p.saveSplicePolicy(applied.prevChanPoint, cid)
+	if err != nil {
+		p.log.Errorf("Unable to save forwarding policy of spliced "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+	}
+
+	// The link of the new funding output needs to reestablish the
+	// channel, as the link of the old one was stopped without
+	// coordinating with the remote party.
+	p.addedChannels.Delete(cid)
+	p.activeChannels.Store(cid, nil)
+
+	err = p.addActiveChannel(&lnpeer.NewChannel{OpenChannel: channel})
+	if err != nil {
+		p.log.Errorf("Unable to add spliced channel: %v", err)
+		return
+	}
+
+	p.wg.Add(1)
+	go p.waitForSpliceLock(applied.prevChanPoint, channel)
+}
+
+// waitForSpliceLock waits for the funding output of the given spliced
+// channel to be buried deep enough, after which we send splice_locked.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Brontide) waitForSpliceLock(prevChanPoint wire.OutPoint,
+	channel *channeldb.OpenChannel) {
+
+	defer p.wg.Done()
+
+	chanPoint := channel.FundingOutpoint
+	localKey := channel.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := channel.RemoteChanCfg.MultiSigKey.PubKey
+
+	// An upgrade of the commitment type moves the channel onto a taproot
+	// funding output.
+	var (
+		fundingOutput *wire.TxOut
+		err           error
+	)
+	if channel.ChanType.IsTaproot() {
+		_, fundingOutput, err = input.GenTaprootFundingScript(
+			localKey, remoteKey, int64(channel.Capacity),
+		)
+	} else {
+		_, fundingOutput, err = input.GenFundingPkScript(
+			localKey.SerializeCompressed(),
+			remoteKey.SerializeCompressed(),
+			int64(channel.Capacity),
+		)
+	}
+	if err != nil {
+		p.log.Errorf("Unable to get funding output of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+
+	// The chain watcher only moved the channel once the splice
+	// transaction was buried at this depth, so registering for it again
+	// keeps splice_locked in step with it. The splice transaction can't
+	// confirm before the transaction of the previous funding output.
+	numConfs := lnwallet.SpliceLockDepth(channel)
+	confNtfn, err := p.cfg.ChainNotifier.RegisterConfirmationsNtfn(
+		&chanPoint.Hash, fundingOutput.PkScript, numConfs,
+		channel.ShortChannelID.BlockHeight,
+	)
+	if err != nil {
+		p.log.Errorf("Unable to register for confirmation of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+	defer confNtfn.Cancel()
+
+	var conf *spliceConf
+	select {
+	case txConf, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return
+		}
+
+		conf = &spliceConf{
+			prevChanPoint: prevChanPoint,
+			channel:       channel,
+			scid: lnwire.ShortChannelID{
+				BlockHeight: txConf.BlockHeight,
+				TxIndex:     txConf.TxIndex,
+				TxPosition:  uint16(chanPoint.Index),
+			},
+		}
+
+	case <-p.quit:
+		return
+	}
+
+	select {
+	case p.spliceConfs <- conf:
+	case <-p.quit:
+	}
+}
+
+// handleSpliceConf sends our splice_locked once the splice transaction is
+// buried deep enough.
+func (p *Brontide) handleSpliceConf(conf *spliceConf) {
+	cid := lnwire.NewChanIDFromOutPoint(conf.channel.FundingOutpoint)
+
+	lock, ok := p.spliceLocks[cid]
+	if !ok {
+		lock = &pendingSpliceLock{}
+		p.spliceLocks[cid] = lock
+	}
+	lock.conf = conf
+
+	p.log.Infof("Splice of ChannelPoint(%v) confirmed with scid=%v, "+
+		"sending splice_locked", conf.prevChanPoint, conf.scid)
+
+	p.queueMsg(&lnwire.SpliceLocked{
+		ChanID:     cid,
+		SpliceTxid: conf.channel.FundingOutpoint.Hash,
+	}, nil)
+
+	p.maybeFinalizeSplice(cid, lock)
+}
+
+// handleSpliceLocked processes the splice_locked message of the remote
+// party.
+func (p *Brontide) handleSpliceLocked(cid lnwire.ChannelID,
+	msg *lnwire.SpliceLocked) {
+
+	lock, ok := p.spliceLocks[cid]
+	if !ok {
+		// If we already locked the splice, the remote party may have
+		// missed our splice_locked, so we'll send it again.
+		lnChan, _ := p.activeChannels.Load(cid)
+		if lnChan != nil &&
+			lnChan.ChannelPoint().Hash == msg.SpliceTxid {
+
+			prevChanPoint, err := lnChan.State().SpliceLockPending()
+			if err != nil {
+				p.log.Errorf("Unable to check splice lock of "+
+					"channel %v: %v", cid, err)
+				return
+			}
+
+			if prevChanPoint == nil {
+				p.queueMsg(&lnwire.SpliceLocked{
+					ChanID:     cid,
+					SpliceTxid: msg.SpliceTxid,
+				}, nil)
+
+				return
+			}
+		}
+
+		lock = &pendingSpliceLock{}
+		p.spliceLocks[cid] = lock
+	}
+
+	lock.remoteTxid = &msg.SpliceTxid
+
+	p.maybeFinalizeSplice(cid, lock)
+}
+
+// maybeFinalizeSplice locks the splice of the channel once both parties sent
+// splice_locked. The edge of the new funding output replaces the one of the
+// old funding output in the graph, and it's announced once it's buried deep
+// enough.
+func (p *Brontide) maybeFinalizeSplice(cid lnwire.ChannelID,
+	lock *pendingSpliceLock) {
+
+	if lock.conf == nil || lock.remoteTxid == nil {
+		return
+	}
+
+	delete(p.spliceLocks, cid)
+
+	channel := lock.conf.channel
+	chanPoint := channel.FundingOutpoint
+	if *lock.remoteTxid != chanPoint.Hash {
+		p.log.Errorf("Remote party locked splice %v of channel %v, "+
+			"expected %v", lock.remoteTxid, cid, chanPoint.Hash)
+		return
+	}
+
+	p.log.Infof("Splice of ChannelPoint(%v) locked, new "+
+		"ChannelPoint(%v)", lock.conf.prevChanPoint, chanPoint)
+
+	prevScid := channel.RealScid()
+	scid := lock.conf.scid
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		err := p.cfg.FundingManager.AddSplicedChannel(
+			channel, prevScid, scid,
+		)
+		if err != nil {
+			p.log.Errorf("Unable to add spliced ChannelPoint(%v) "+
+				"to graph: %v", chanPoint, err)
+		}
+	}()
+}
@@ -0,0 +1,117 @@
+package peer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocalSpliceReqChecks tests that local splice requests are rejected if
+// the channel can't be spliced.
+func TestLocalSpliceReqChecks(t *testing.T) {
+	t.Parallel()
+
+	// The channel is announced, which doesn't prevent it from being
+	// spliced.
+	harness, err := createTestPeerWithChannel(
+		t, func(a, b *channeldb.OpenChannel) {
+			a.ChannelFlags |= lnwire.FFAnnounceChannel
+			b.ChannelFlags |= lnwire.FFAnnounceChannel
+		},
+	)
+	require.NoError(t, err)
+
+	alicePeer := harness.peer
+	chanPoint := harness.channel.ChannelPoint()
+
+	spliceErr := func(chanPoint wire.OutPoint) error {
+		req := NewSpliceRequest(
+			chanPoint, 100_000, chainfee.FeePerKwFloor,
+		)
+		alicePeer.HandleLocalSpliceReq(req)
+
+		select {
+		case err := <-req.Err:
+			return err
+
+		case <-req.Updates:
+			t.Fatal("expected splice to fail")
+
+		case <-time.After(timeout):
+			t.Fatal("splice request not handled")
+		}
+
+		return nil
+	}
+
+	// A channel we don't know about can't be spliced.
+	require.ErrorContains(
+		t, spliceErr(wire.OutPoint{Index: 42}), "unable to find",
+	)
+
+	// Neither party signaled support for splicing yet.
+	require.ErrorIs(t, spliceErr(chanPoint), ErrSpliceNotNegotiated)
+
+	alicePeer.cfg.Features.Set(lnwire.SpliceStagingOptional)
+	alicePeer.remoteFeatures.Set(lnwire.SpliceStagingOptional)
+	require.True(t, alicePeer.hasNegotiatedSplice())
+
+	// Once splicing is negotiated, the announced channel passes the
+	// checks, and is only rejected as the harness doesn't run its link.
+	require.ErrorContains(t, spliceErr(chanPoint), "no link found")
+}
+
+// TestSpliceReestablishUnknownTx tests that a splice the remote party awaits
+// our tx_signatures for on reconnection is aborted if we don't know it.
+func TestSpliceReestablishUnknownTx(t *testing.T) {
+	t.Parallel()
+
+	harness, err := createTestPeerWithChannel(t, noUpdate)
+	require.NoError(t, err)
+
+	alicePeer := harness.peer
+	chanID := lnwire.NewChanIDFromOutPoint(harness.channel.ChannelPoint())
+
+	alicePeer.spliceMsgs <- &spliceMsg{
+		cid: chanID,
+		msg: &lnwire.ChannelReestablish{
+			ChanID:          chanID,
+			NextFundingTxID: fn.Some(lnwire.NextFundingTxID{1}),
+		},
+	}
+
+	var msg lnwire.Message
+	select {
+	case outMsg := <-alicePeer.outgoingQueue:
+		msg = outMsg.msg
+
+	case <-time.After(timeout):
+		t.Fatalf("did not receive tx_abort")
+	}
+
+	txAbort, ok := msg.(*lnwire.TxAbort)
+	require.True(t, ok, "expected TxAbort, got %T", msg)
+	require.Equal(t, chanID, txAbort.ChanID)
+
+	// The tx_abort of the remote party doesn't affect the channel, as it
+	// has no pending splice.
+	alicePeer.spliceMsgs <- &spliceMsg{
+		cid: chanID,
+		msg: &lnwire.TxAbort{ChanID: chanID},
+	}
+
+	select {
+	case outMsg := <-alicePeer.outgoingQueue:
+		t.Fatalf("unexpected message %T", outMsg.msg)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.True(t, alicePeer.isActiveChannel(chanID))
+}
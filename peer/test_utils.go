@@ -465,6 +465,24 @@ func (m *mockUpdateHandler) OnCommitOnce(
 	hook()
 }
 
+func (m *mockUpdateHandler) ProposeDynCommitment(
+	_ htlcswitch.DynCommitParams) <-chan error {
+
+	errChan := make(chan error, 1)
+	errChan <- nil
+
+	return errChan
+}
+
+func (m *mockUpdateHandler) InitStfu(
+	_ htlcswitch.QuiescentProtocol) <-chan error {
+
+	errChan := make(chan error, 1)
+	errChan <- nil
+
+	return errChan
+}
+
 func newMockConn(t *testing.T, expectedMessages int) *mockMessageConn {
 	return &mockMessageConn{
 		t:               t,
@@ -0,0 +1,331 @@
+package peerstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultMaxPeers is the default maximum number of peers that we store
+	// a blob for.
+	DefaultMaxPeers = 1000
+
+	// storeRate is the number of blob updates per second that we accept
+	// from a single peer on average. Each update is written to disk, so
+	// this limits the disk writes a peer can cause.
+	storeRate = rate.Limit(0.1)
+
+	// storeBurst is the number of blob updates that a peer may send us at
+	// once before being rate limited.
+	storeBurst = 10
+)
+
+// Config holds the dependencies of the peer storage manager.
+type Config struct {
+	// Store persists the blobs we store on behalf of our peers.
+	Store *Store
+
+	// MaxPeers is the maximum number of peers that we store a blob for.
+	MaxPeers int
+
+	// MaxBlobSize is the maximum size of a blob that we store for a peer.
+	MaxBlobSize int
+
+	// KeyRing is used to decrypt the backups our peers hand back to us,
+	// which makes sure they're ours and intact.
+	KeyRing keychain.KeyRing
+
+	// HasChannels returns true if we have at least one open or pending
+	// channel with the given peer.
+	HasChannels func(peer route.Vertex) (bool, error)
+
+	// StoragePeers returns the connected peers that we have channels with
+	// and that signal option_provide_storage.
+	StoragePeers func() []route.Vertex
+
+	// SendToPeer sends a message to a connected peer.
+	SendToPeer func(peer route.Vertex, msg lnwire.Message) error
+}
+
+// Manager implements the peer storage protocol. It stores the blobs our
+// channel peers hand to us and returns them when the peers reconnect. It also
+// hands our own encrypted multi-chan backup to our channel peers, and keeps
+// the backups they return to us so they can be used to restore our channels
+// after a data loss.
+type Manager struct {
+	cfg *Config
+
+	mu sync.Mutex
+
+	// ourBlob is our latest packed multi-chan backup that we hand to our
+	// peers.
+	ourBlob chanbackup.PackedMulti
+
+	// retrieved holds the latest backup each peer handed back to us.
+	retrieved map[route.Vertex]chanbackup.PackedMulti
+
+	// waiters are signaled once a peer hands a backup back to us.
+	waiters map[route.Vertex][]chan struct{}
+
+	// limiters limit the rate of blob updates of each connected peer.
+	// A peer's limiter is removed once it disconnects.
+	limiters map[route.Vertex]*rate.Limiter
+}
+
+// A compile-time check to ensure Manager implements chanbackup.BackupSink.
+var _ chanbackup.BackupSink = (*Manager)(nil)
+
+// NewManager creates a new peer storage manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:       cfg,
+		retrieved: make(map[route.Vertex]chanbackup.PackedMulti),
+		waiters:   make(map[route.Vertex][]chan struct{}),
+		limiters:  make(map[route.Vertex]*rate.Limiter),
+	}
+}
+
+// HandleMessage handles a peer storage message received from the given peer.
+func (m *Manager) HandleMessage(peer [33]byte, msg lnwire.Message) {
+	switch msg := msg.(type) {
+	case *lnwire.PeerStorage:
+		if err := m.storePeerBlob(peer, msg.Blob); err != nil {
+			log.Debugf("Not storing blob of peer %x: %v", peer, err)
+		}
+
+	case *lnwire.PeerStorageRetrieval:
+		if err := m.handleRetrieval(peer, msg.Blob); err != nil {
+			log.Warnf("Invalid backup retrieved from peer %x: %v",
+				peer, err)
+		}
+	}
+}
+
+// storePeerBlob stores the blob of the given peer, as long as the peer stays
+// within its quota.
+func (m *Manager) storePeerBlob(peer route.Vertex, blob []byte) error {
+	if len(blob) > m.cfg.MaxBlobSize {
+		return fmt.Errorf("blob of %d bytes exceeds maximum of %d "+
+			"bytes", len(blob), m.cfg.MaxBlobSize)
+	}
+
+	// We only store blobs for our channel peers, which have a reason to
+	// keep the connection to us.
+	hasChannels, err := m.cfg.HasChannels(peer)
+	if err != nil {
+		return err
+	}
+	if !hasChannels {
+		return errors.New("no channels with peer")
+	}
+
+	m.mu.Lock()
+	limiter, ok := m.limiters[peer]
+	if !ok {
+		limiter = rate.NewLimiter(storeRate, storeBurst)
+		m.limiters[peer] = limiter
+	}
+	m.mu.Unlock()
+
+	if !limiter.Allow() {
+		return errors.New("rate limit exceeded")
+	}
+
+	// A peer that we don't store a blob for yet is only accepted if there
+	// is room left.
+	_, err = m.cfg.Store.Get(peer)
+	switch {
+	case errors.Is(err, ErrNoBlob):
+		numPeers, err := m.cfg.Store.NumPeers()
+		if err != nil {
+			return err
+		}
+		if numPeers >= m.cfg.MaxPeers {
+			return fmt.Errorf("storing blobs for maximum of %d "+
+				"peers already", m.cfg.MaxPeers)
+		}
+
+	case err != nil:
+		return err
+	}
+
+	if err := m.cfg.Store.Put(peer, blob); err != nil {
+		return err
+	}
+
+	log.Debugf("Stored blob of %d bytes for peer %x", len(blob), peer)
+
+	return nil
+}
+
+// handleRetrieval verifies a backup that a peer handed back to us, and keeps
+// it so it can be used to restore our channels.
+func (m *Manager) handleRetrieval(peer route.Vertex, blob []byte) error {
+	backup := chanbackup.PackedMulti(blob)
+	multi, err := backup.Unpack(m.cfg.KeyRing)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Retrieved channel backup with %d channels from peer %x",
+		len(multi.StaticBackups), peer)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.retrieved[peer] = backup
+	for _, waiter := range m.waiters[peer] {
+		close(waiter)
+	}
+	delete(m.waiters, peer)
+
+	return nil
+}
+
+// PeerOnline is called once a peer connected and exchanged init messages with
+// us. If we store a blob for the peer, it's handed back to the peer. If the
+// peer stores blobs and we have channels with it, we hand it our latest
+// backup.
+func (m *Manager) PeerOnline(peer route.Vertex,
+	features *lnwire.FeatureVector) {
+
+	blob, err := m.cfg.Store.Get(peer)
+	switch {
+	// There's nothing to hand back if the peer didn't give us a blob yet.
+	case errors.Is(err, ErrNoBlob):
+
+	case err != nil:
+		log.Errorf("Unable to fetch blob of peer %x: %v", peer, err)
+
+	default:
+		err := m.cfg.SendToPeer(peer, &lnwire.PeerStorageRetrieval{
+			Blob: blob,
+		})
+		if err != nil {
+			log.Warnf("Unable to return blob to peer %x: %v", peer,
+				err)
+		}
+	}
+
+	if !features.HasFeature(lnwire.ProvideStorageOptional) {
+		return
+	}
+
+	m.mu.Lock()
+	ourBlob := m.ourBlob
+	m.mu.Unlock()
+
+	// We only hand our backup to our channel peers. This also makes sure
+	// that a node that lost its channel state doesn't replace the backup
+	// stored by a peer before it was retrieved.
+	hasChannels, err := m.cfg.HasChannels(peer)
+	if err != nil {
+		log.Errorf("Unable to fetch channels with peer %x: %v", peer,
+			err)
+
+		return
+	}
+	if ourBlob == nil || !hasChannels {
+		return
+	}
+
+	err = m.cfg.SendToPeer(peer, &lnwire.PeerStorage{Blob: ourBlob})
+	if err != nil {
+		log.Warnf("Unable to send backup to peer %x: %v", peer, err)
+	}
+}
+
+// PeerOffline is called once a peer disconnected from us. The peer's rate
+// limiter is removed, so that we don't keep one for every peer that ever sent
+// us a blob.
+func (m *Manager) PeerOffline(peer route.Vertex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.limiters, peer)
+}
+
+// RetrievedBackup returns the latest backup that the given peer handed back to
+// us, if any.
+func (m *Manager) RetrievedBackup(
+	peer route.Vertex) (chanbackup.PackedMulti, bool) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	backup, ok := m.retrieved[peer]
+
+	return backup, ok
+}
+
+// WaitForBackup waits until the given peer hands a backup back to us, and
+// returns it.
+func (m *Manager) WaitForBackup(ctx context.Context,
+	peer route.Vertex) (chanbackup.PackedMulti, error) {
+
+	m.mu.Lock()
+	if backup, ok := m.retrieved[peer]; ok {
+		m.mu.Unlock()
+		return backup, nil
+	}
+
+	waiter := make(chan struct{})
+	m.waiters[peer] = append(m.waiters[peer], waiter)
+	m.mu.Unlock()
+
+	select {
+	case <-waiter:
+		backup, _ := m.RetrievedBackup(peer)
+		return backup, nil
+
+	case <-ctx.Done():
+		return nil, fmt.Errorf("no backup retrieved from peer %x: %w",
+			peer, ctx.Err())
+	}
+}
+
+// Name returns a human readable name that identifies the sink.
+//
+// NOTE: This is part of the chanbackup.BackupSink interface.
+func (m *Manager) Name() string {
+	return "peers"
+}
+
+// Write hands the new backup to all connected channel peers that store blobs.
+// The backup is also handed to each channel peer once it reconnects.
+//
+// NOTE: This is part of the chanbackup.BackupSink interface.
+func (m *Manager) Write(_ context.Context,
+	newBackup chanbackup.PackedMulti) error {
+
+	if len(newBackup) > lnwire.MaxPeerStorageBlobSize {
+		return fmt.Errorf("backup of %d bytes exceeds maximum peer "+
+			"storage size of %d bytes", len(newBackup),
+			lnwire.MaxPeerStorageBlobSize)
+	}
+
+	m.mu.Lock()
+	m.ourBlob = newBackup
+	m.mu.Unlock()
+
+	var sendErrs []error
+	for _, peer := range m.cfg.StoragePeers() {
+		err := m.cfg.SendToPeer(peer, &lnwire.PeerStorage{
+			Blob: newBackup,
+		})
+		if err != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("unable to "+
+				"send backup to peer %x: %w", peer, err))
+		}
+	}
+
+	return errors.Join(sendErrs...)
+}
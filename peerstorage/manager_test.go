@@ -0,0 +1,238 @@
+package peerstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnencrypt"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// sentMsg is a message sent by the manager under test.
+type sentMsg struct {
+	peer route.Vertex
+	msg  lnwire.Message
+}
+
+// testHarness bundles a manager with mocked dependencies.
+type testHarness struct {
+	*Manager
+
+	mu           sync.Mutex
+	channelPeers map[route.Vertex]bool
+	sent         []sentMsg
+}
+
+func newTestHarness(t *testing.T, maxPeers int) *testHarness {
+	backend, cleanup, err := kvdb.GetTestBackend(t.TempDir(), "pstr")
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	store, err := NewStore(backend)
+	require.NoError(t, err)
+
+	h := &testHarness{
+		channelPeers: make(map[route.Vertex]bool),
+	}
+	h.Manager = NewManager(&Config{
+		Store:       store,
+		MaxPeers:    maxPeers,
+		MaxBlobSize: 100,
+		KeyRing:     &lnencrypt.MockKeyRing{},
+		HasChannels: func(peer route.Vertex) (bool, error) {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			return h.channelPeers[peer], nil
+		},
+		StoragePeers: func() []route.Vertex {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			var peers []route.Vertex
+			for peer := range h.channelPeers {
+				peers = append(peers, peer)
+			}
+
+			return peers
+		},
+		SendToPeer: func(peer route.Vertex, msg lnwire.Message) error {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			h.sent = append(h.sent, sentMsg{peer: peer, msg: msg})
+
+			return nil
+		},
+	})
+
+	return h
+}
+
+// popSent returns and clears the messages sent so far.
+func (h *testHarness) popSent() []sentMsg {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sent := h.sent
+	h.sent = nil
+
+	return sent
+}
+
+// packTestBackup returns an encrypted, empty multi-chan backup.
+func packTestBackup(t *testing.T) chanbackup.PackedMulti {
+	var b bytes.Buffer
+	multi := chanbackup.Multi{}
+	require.NoError(t, multi.PackToWriter(&b, &lnencrypt.MockKeyRing{}))
+
+	return chanbackup.PackedMulti(b.Bytes())
+}
+
+// TestStorePeerBlobs tests that we store the blobs of our channel peers
+// within their quota, and hand them back once they reconnect.
+func TestStorePeerBlobs(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t, 1)
+	alice, bob := route.Vertex{1}, route.Vertex{2}
+	h.channelPeers[alice] = true
+
+	// Blobs of peers we don't have channels with aren't stored.
+	h.HandleMessage(bob, &lnwire.PeerStorage{Blob: []byte("bob")})
+	_, err := h.cfg.Store.Get(bob)
+	require.ErrorIs(t, err, ErrNoBlob)
+
+	// Neither are blobs that exceed the maximum size.
+	h.HandleMessage(alice, &lnwire.PeerStorage{
+		Blob: make([]byte, 101),
+	})
+	_, err = h.cfg.Store.Get(alice)
+	require.ErrorIs(t, err, ErrNoBlob)
+
+	h.HandleMessage(alice, &lnwire.PeerStorage{Blob: []byte("old")})
+	h.HandleMessage(alice, &lnwire.PeerStorage{Blob: []byte("new")})
+	blob, err := h.cfg.Store.Get(alice)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), blob)
+
+	// We only store blobs for a single peer, so once bob has a channel
+	// with us as well, his blob still isn't stored.
+	h.channelPeers[bob] = true
+	h.HandleMessage(bob, &lnwire.PeerStorage{Blob: []byte("bob")})
+	_, err = h.cfg.Store.Get(bob)
+	require.ErrorIs(t, err, ErrNoBlob)
+
+	// Once alice reconnects, we hand her blob back to her.
+	h.PeerOnline(alice, lnwire.EmptyFeatureVector())
+	sent := h.popSent()
+	require.Len(t, sent, 1)
+	require.Equal(t, alice, sent[0].peer)
+	require.Equal(
+		t, &lnwire.PeerStorageRetrieval{Blob: []byte("new")},
+		sent[0].msg,
+	)
+
+	// Peers that flood us with updates are rate limited.
+	for i := 0; i < storeBurst; i++ {
+		h.HandleMessage(alice, &lnwire.PeerStorage{Blob: []byte("x")})
+	}
+	blob, err = h.cfg.Store.Get(alice)
+	require.NoError(t, err)
+	require.Equal(t, []byte("x"), blob)
+
+	err = h.storePeerBlob(alice, []byte("flood"))
+	require.ErrorContains(t, err, "rate limit")
+
+	// Once the peers disconnect, their rate limiters are removed.
+	h.PeerOffline(alice)
+	require.NotContains(t, h.limiters, alice)
+	require.Contains(t, h.limiters, bob)
+
+	h.PeerOffline(bob)
+	require.Empty(t, h.limiters)
+}
+
+// TestOwnBackup tests that our own backup is handed to our channel peers that
+// provide storage, and that backups retrieved from peers are verified.
+func TestOwnBackup(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t, 10)
+	alice, bob := route.Vertex{1}, route.Vertex{2}
+	h.channelPeers[alice] = true
+
+	storageFeatures := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.ProvideStorageOptional),
+		lnwire.Features,
+	)
+
+	// Without a backup, there is nothing to hand to alice.
+	h.PeerOnline(alice, storageFeatures)
+	require.Empty(t, h.popSent())
+
+	backup := packTestBackup(t)
+	require.NoError(t, h.Write(context.Background(), backup))
+	sent := h.popSent()
+	require.Len(t, sent, 1)
+	require.Equal(t, &lnwire.PeerStorage{Blob: backup}, sent[0].msg)
+
+	// The backup is handed to alice again once she reconnects, but not
+	// to bob who we don't have channels with, or to peers that don't
+	// provide storage.
+	h.PeerOnline(alice, storageFeatures)
+	require.Len(t, h.popSent(), 1)
+	h.PeerOnline(bob, storageFeatures)
+	require.Empty(t, h.popSent())
+	h.PeerOnline(alice, lnwire.EmptyFeatureVector())
+	require.Empty(t, h.popSent())
+
+	// Backups that exceed the maximum peer storage size are rejected.
+	err := h.Write(
+		context.Background(),
+		make([]byte, lnwire.MaxPeerStorageBlobSize+1),
+	)
+	require.Error(t, err)
+
+	// Retrieved backups that we can't decrypt are ignored.
+	h.HandleMessage(bob, &lnwire.PeerStorageRetrieval{
+		Blob: []byte("garbage"),
+	})
+	_, ok := h.RetrievedBackup(bob)
+	require.False(t, ok)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 10*time.Millisecond,
+	)
+	defer cancel()
+	_, err = h.WaitForBackup(ctx, bob)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	// A valid backup is handed to anyone waiting for it.
+	waitErr := make(chan error, 1)
+	go func() {
+		retrieved, err := h.WaitForBackup(context.Background(), bob)
+		if err == nil && !bytes.Equal(retrieved, backup) {
+			err = errors.New("wrong backup retrieved")
+		}
+		waitErr <- err
+	}()
+
+	h.HandleMessage(bob, &lnwire.PeerStorageRetrieval{Blob: backup})
+
+	select {
+	case err := <-waitErr:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("backup not retrieved")
+	}
+}
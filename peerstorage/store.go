@@ -0,0 +1,98 @@
+package peerstorage
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// peerBlobsBucket is the top-level bucket that holds the blobs we store
+	// on behalf of our peers, keyed by the public key of the peer.
+	peerBlobsBucket = []byte("peer-storage-blobs")
+
+	// ErrNoBlob is returned if we don't store a blob for a peer.
+	ErrNoBlob = errors.New("no blob stored for peer")
+)
+
+// Store persists the blobs we store on behalf of our peers.
+type Store struct {
+	db kvdb.Backend
+}
+
+// NewStore creates a new store in the given database, and creates its bucket
+// if it doesn't exist yet.
+func NewStore(db kvdb.Backend) (*Store, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(peerBlobsBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Put stores the blob of the given peer, replacing any previous blob.
+func (s *Store) Put(peer route.Vertex, blob []byte) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(peerBlobsBucket)
+
+		// An empty value can't be distinguished from a missing one in
+		// all backends, so an empty blob removes the stored blob.
+		if len(blob) == 0 {
+			return bucket.Delete(peer[:])
+		}
+
+		return bucket.Put(peer[:], blob)
+	}, func() {})
+}
+
+// Get returns the blob that is stored for the given peer, or ErrNoBlob if
+// there is none.
+func (s *Store) Get(peer route.Vertex) ([]byte, error) {
+	var blob []byte
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(peerBlobsBucket)
+
+		value := bucket.Get(peer[:])
+		if value == nil {
+			return ErrNoBlob
+		}
+
+		blob = make([]byte, len(value))
+		copy(blob, value)
+
+		return nil
+	}, func() {
+		blob = nil
+	})
+
+	return blob, err
+}
+
+// Delete removes the blob of the given peer.
+func (s *Store) Delete(peer route.Vertex) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket(peerBlobsBucket).Delete(peer[:])
+	}, func() {})
+}
+
+// NumPeers returns the number of peers that we store a blob for.
+func (s *Store) NumPeers() (int, error) {
+	var numPeers int
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(peerBlobsBucket)
+
+		return bucket.ForEach(func(_, _ []byte) error {
+			numPeers++
+			return nil
+		})
+	}, func() {
+		numPeers = 0
+	})
+
+	return numPeers, err
+}
@@ -90,6 +90,27 @@ func NewNonFinalBlindedRouteData(chanID lnwire.ShortChannelID,
 	return info
 }
 
+// NewNonFinalMessageRouteData creates the data that's provided for hops within
+// a blinded route that is used to relay onion messages. Since onion messages
+// aren't bound to channels, the next hop is identified by its node ID rather
+// than a channel and no relay parameters are required.
+func NewNonFinalMessageRouteData(nextNodeID,
+	blindingOverride *btcec.PublicKey) *BlindedRouteData {
+
+	info := &BlindedRouteData{
+		NextNodeID: tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType4](nextNodeID),
+		),
+	}
+
+	if blindingOverride != nil {
+		info.NextBlindingOverride = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType8](blindingOverride))
+	}
+
+	return info
+}
+
 // NewFinalHopBlindedRouteData creates the data that's provided for the final
 // hop in a blinded route.
 func NewFinalHopBlindedRouteData(constraints *PaymentConstraints,
@@ -0,0 +1,92 @@
+package blindedpath
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// BuildMessagePathCfg defines the values required to build a blinded path
+// along which onion messages can be sent to this node.
+type BuildMessagePathCfg struct {
+	// Hops is the list of real nodes that make up the path, starting with
+	// the introduction node and ending with this node. Unlike payment
+	// paths, the hops of a message path don't need to be connected by
+	// channels, they only need to be connected as peers.
+	Hops []route.Vertex
+
+	// PathID is the secret data to embed in the blinded path data that we
+	// will receive back as the recipient. It lets the recipient check that
+	// the path is being used in the correct context.
+	PathID []byte
+
+	// MinNumHops is the minimum number of hops that the blinded path
+	// should be. If the number of real hops is less than this number,
+	// then dummy hops will be post-fixed to the path.
+	MinNumHops uint8
+}
+
+// BuildBlindedMessagePath uses the passed config to construct a blinded path
+// that can be used by another node to reach this node with onion messages,
+// for example to reply to a message that we sent.
+func BuildBlindedMessagePath(cfg *BuildMessagePathCfg) (*sphinx.BlindedPath,
+	error) {
+
+	if len(cfg.Hops) == 0 {
+		return nil, fmt.Errorf("at least one hop is required to " +
+			"build a blinded message path")
+	}
+
+	keys := make([]*btcec.PublicKey, len(cfg.Hops))
+	for i, hop := range cfg.Hops {
+		key, err := btcec.ParsePubKey(hop[:])
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = key
+	}
+
+	// Dummy hops are appended by letting this node forward the message to
+	// itself, so each of them points back to our own key.
+	ourKey := keys[len(keys)-1]
+	for len(keys) < int(cfg.MinNumHops) {
+		keys = append(keys, ourKey)
+	}
+
+	hopDataSet := make([]*hopData, 0, len(keys))
+	for i := 0; i < len(keys)-1; i++ {
+		hopDataSet = append(hopDataSet, &hopData{
+			data: record.NewNonFinalMessageRouteData(
+				keys[i+1], nil,
+			),
+			nodeID: keys[i],
+		})
+	}
+
+	// The final hop only receives the path ID which it'll use to identify
+	// the context that the path was created for.
+	hopDataSet = append(hopDataSet, &hopData{
+		data:   record.NewFinalHopBlindedRouteData(nil, cfg.PathID),
+		nodeID: ourKey,
+	})
+
+	// Add padding to each route data instance until the encrypted data
+	// blobs are all the same size so that the position of the final hop
+	// isn't revealed by its size.
+	messagePath, _, err := padHopInfo(hopDataSet, true, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Derive an ephemeral session key.
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sphinx.BuildBlindedPath(sessionKey, messagePath)
+}
@@ -0,0 +1,68 @@
+package blindedpath
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuildBlindedMessagePath tests that a blinded message path can be
+// unwrapped hop by hop by the nodes on it, and that dummy hops are appended
+// when the path is shorter than the minimum number of hops.
+func TestBuildBlindedMessagePath(t *testing.T) {
+	t.Parallel()
+
+	// Alice builds a blinded path to herself with Bob as the introduction
+	// node:
+	//	Bob -> Alice -> Alice (dummy).
+	var (
+		privB, pkB = btcec.PrivKeyFromBytes([]byte{2})
+		privA, pkA = btcec.PrivKeyFromBytes([]byte{3})
+	)
+
+	_, err := BuildBlindedMessagePath(&BuildMessagePathCfg{})
+	require.Error(t, err)
+
+	path, err := BuildBlindedMessagePath(&BuildMessagePathCfg{
+		Hops: []route.Vertex{
+			route.NewVertex(pkB), route.NewVertex(pkA),
+		},
+		PathID:     []byte{1, 2, 3},
+		MinNumHops: 3,
+	})
+	require.NoError(t, err)
+
+	require.True(t, path.IntroductionPoint.IsEqual(pkB))
+	require.Len(t, path.BlindedHops, 3)
+
+	// All the encrypted blobs are padded to the same size.
+	for _, hop := range path.BlindedHops {
+		require.Len(
+			t, hop.CipherText, len(path.BlindedHops[0].CipherText),
+		)
+	}
+
+	// Bob learns that he should forward the message to Alice.
+	data, blindingPoint := decryptAndDecodeHopData(
+		t, privB, path.BlindingPoint, path.BlindedHops[0].CipherText,
+	)
+	require.Equal(t, pkA, data.NextNodeID.UnwrapOrFail(t).Val)
+	require.True(t, data.ShortChannelID.IsNone())
+	require.True(t, data.RelayInfo.IsNone())
+
+	// Alice then finds a dummy hop pointing back to herself.
+	data, blindingPoint = decryptAndDecodeHopData(
+		t, privA, blindingPoint, path.BlindedHops[1].CipherText,
+	)
+	require.Equal(t, pkA, data.NextNodeID.UnwrapOrFail(t).Val)
+	require.True(t, data.PathID.IsNone())
+
+	// Peeling the final layer reveals the path ID.
+	data, _ = decryptAndDecodeHopData(
+		t, privA, blindingPoint, path.BlindedHops[2].CipherText,
+	)
+	require.True(t, data.NextNodeID.IsNone())
+	require.Equal(t, []byte{1, 2, 3}, data.PathID.UnwrapOrFail(t).Val)
+}
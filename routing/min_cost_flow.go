@@ -0,0 +1,569 @@
+package routing
+
+import (
+	"math"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// maxFlowUnits is the maximum number of units that the amount of a
+	// payment is divided into when computing a min-cost flow. Each unit is
+	// routed along the cheapest path of the residual network, so a larger
+	// number gives a finer split at the cost of more path finding rounds.
+	maxFlowUnits = 16
+
+	// maxResidualHops is the maximum number of hops of a path through the
+	// residual network. Paths through the residual network can be longer
+	// than the routes they result in, because they may cancel flow that
+	// was allocated before. The limit also guarantees termination if
+	// non-convex costs introduce negative cycles.
+	maxResidualHops = 2 * sphinx.NumMaxHops
+)
+
+// flowPath is a path of the flow to the destination together with the amount
+// that is allocated to it.
+type flowPath struct {
+	// edges are the edges of the path from the source to the destination.
+	edges []*unifiedEdge
+
+	// amt is the amount that the path delivers to the destination.
+	amt lnwire.MilliSatoshi
+}
+
+// flowFinder defines the interface of a function that splits an amount
+// across several paths to the target at once.
+type flowFinder = func(g *graphParams, r *RestrictParams,
+	cfg *PathFindingConfig, self, target route.Vertex,
+	amt lnwire.MilliSatoshi, numUnits int,
+	timePref float64) ([]*flowPath, error)
+
+// flowPair is a directed pair of nodes that carries flow.
+type flowPair struct {
+	from route.Vertex
+	to   route.Vertex
+}
+
+// residualArc is an arc of the residual network that a node was reached
+// over, seen from its tail.
+type residualArc struct {
+	// to is the head of the arc.
+	to route.Vertex
+
+	// cancel indicates that the arc cancels a unit of flow that was
+	// allocated to the opposite pair before.
+	cancel bool
+}
+
+// flowNetwork holds the state of a min-cost flow computation.
+type flowNetwork struct {
+	g      *graphParams
+	r      *RestrictParams
+	self   route.Vertex
+	target route.Vertex
+
+	// unit is the amount of a single unit of flow.
+	unit lnwire.MilliSatoshi
+
+	// attemptCost is the virtual cost of a failed attempt, used to weigh
+	// success probabilities against fees.
+	attemptCost float64
+
+	// outgoingChanMap holds the outgoing channel restriction, if any.
+	outgoingChanMap map[uint64]struct{}
+
+	// additionalEdges are the hop hints of the payment, indexed by the
+	// node that they lead to.
+	additionalEdges map[route.Vertex][]*edgePolicyWithSource
+
+	// unifiers caches the unified incoming policies per node.
+	unifiers map[route.Vertex]*nodeEdgeUnifier
+
+	// usable caches whether a node has a valid feature vector.
+	usable map[route.Vertex]bool
+
+	// flows holds the number of units allocated to each pair.
+	flows map[flowPair]int
+
+	// outFlows indexes the pairs that carry flow by their tail.
+	outFlows map[route.Vertex]map[route.Vertex]struct{}
+
+	// costs caches the marginal cost of the next unit on each pair. It
+	// is reset for the pairs whose flow changes.
+	costs map[flowPair]float64
+}
+
+// findFlow splits the amount into units and allocates them one by one to the
+// cheapest path of the residual network, using the successive shortest path
+// method. The cost of a unit on a channel combines the fee and time lock
+// weight of the channel with the decrease of its success probability as
+// given by the probability source, so that the resulting paths jointly
+// maximize the success probability for their fees. The flow is decomposed
+// into paths that are returned along with their amounts.
+func findFlow(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+	self, target route.Vertex, amt lnwire.MilliSatoshi, numUnits int,
+	timePref float64) ([]*flowPath, error) {
+
+	if numUnits < 1 || amt == 0 {
+		return nil, errNoPathFound
+	}
+
+	// Ensure that the destination supports the features we need, in
+	// the same way as path finding does.
+	features := r.DestFeatures
+	if features == nil {
+		var err error
+		features, err = g.graph.FetchNodeFeatures(target)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := feature.ValidateRequired(features); err != nil {
+		return nil, errUnknownRequiredFeature
+	}
+	if err := feature.ValidateDeps(features); err != nil {
+		return nil, errMissingDependentFeature
+	}
+	if r.PaymentAddr != nil &&
+		!features.HasFeature(lnwire.PaymentAddrOptional) {
+
+		return nil, errNoPaymentAddr
+	}
+
+	attemptCost, err := getAttemptCost(cfg, amt, timePref)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round the unit up, so that the units cover the full amount. The
+	// excess is taken off again when the flow is decomposed.
+	unit := (amt + lnwire.MilliSatoshi(numUnits) - 1) /
+		lnwire.MilliSatoshi(numUnits)
+
+	n := &flowNetwork{
+		g:               g,
+		r:               r,
+		self:            self,
+		target:          target,
+		unit:            unit,
+		attemptCost:     attemptCost,
+		additionalEdges: make(map[route.Vertex][]*edgePolicyWithSource),
+		unifiers:        make(map[route.Vertex]*nodeEdgeUnifier),
+		usable:          make(map[route.Vertex]bool),
+		flows:           make(map[flowPair]int),
+		costs:           make(map[flowPair]float64),
+		outFlows: make(
+			map[route.Vertex]map[route.Vertex]struct{},
+		),
+	}
+
+	if len(r.OutgoingChannelIDs) > 0 {
+		n.outgoingChanMap = make(map[uint64]struct{})
+		for _, outChan := range r.OutgoingChannelIDs {
+			n.outgoingChanMap[outChan] = struct{}{}
+		}
+	}
+
+	for vertex, additionalEdges := range g.additionalEdges {
+		if vertex == self {
+			continue
+		}
+
+		for _, additionalEdge := range additionalEdges {
+			toVertex := additionalEdge.EdgePolicy().ToNodePubKey()
+			n.additionalEdges[toVertex] = append(
+				n.additionalEdges[toVertex],
+				&edgePolicyWithSource{
+					sourceNode: vertex,
+					edge:       additionalEdge,
+				},
+			)
+		}
+	}
+
+	for i := 0; i < numUnits; i++ {
+		if err := n.augment(); err != nil {
+			return nil, err
+		}
+	}
+
+	return n.decompose(amt, lnwire.MilliSatoshi(numUnits)*unit-amt)
+}
+
+// unifier returns the unified incoming policies of the given node.
+func (n *flowNetwork) unifier(node route.Vertex) (*nodeEdgeUnifier, error) {
+	if u, ok := n.unifiers[node]; ok {
+		return u, nil
+	}
+
+	// Don't use inbound fees for the exit hop.
+	u := newNodeEdgeUnifier(
+		n.self, node, node != n.target, n.outgoingChanMap,
+	)
+	if err := u.addGraphPolicies(n.g.graph); err != nil {
+		return nil, err
+	}
+
+	for _, reverseEdge := range n.additionalEdges[node] {
+		u.addPolicy(
+			reverseEdge.sourceNode, reverseEdge.edge.EdgePolicy(),
+			models.InboundFee{}, fakeHopHintCapacity,
+			reverseEdge.edge.IntermediatePayloadSize,
+			reverseEdge.edge.BlindedPayment(),
+		)
+	}
+
+	n.unifiers[node] = u
+
+	return u, nil
+}
+
+// isUsable returns whether we can route through the given node.
+func (n *flowNetwork) isUsable(node route.Vertex) (bool, error) {
+	if usable, ok := n.usable[node]; ok {
+		return usable, nil
+	}
+
+	features, err := n.g.graph.FetchNodeFeatures(node)
+	if err != nil {
+		return false, err
+	}
+
+	usable := feature.ValidateRequired(features) == nil &&
+		feature.ValidateDeps(features) == nil
+	n.usable[node] = usable
+
+	return usable, nil
+}
+
+// edge returns the edge that the pair uses to carry the given amount, or nil
+// if the pair can't carry it.
+func (n *flowNetwork) edge(pair flowPair,
+	amt lnwire.MilliSatoshi) (*unifiedEdge, error) {
+
+	u, err := n.unifier(pair.to)
+	if err != nil {
+		return nil, err
+	}
+
+	edgeUnifier, ok := u.edgeUnifiers[pair.from]
+	if !ok {
+		return nil, nil
+	}
+
+	return edgeUnifier.getEdge(amt, n.g.bandwidthHints, 0), nil
+}
+
+// probability returns the success probability of the given number of units
+// on the pair.
+func (n *flowNetwork) probability(pair flowPair, edge *unifiedEdge,
+	units int) float64 {
+
+	if units == 0 {
+		return 1
+	}
+
+	return n.r.ProbabilitySource(
+		pair.from, pair.to, lnwire.MilliSatoshi(units)*n.unit,
+		edge.capacity,
+	)
+}
+
+// marginalCost returns the cost of allocating one more unit to the pair. It
+// returns +Inf if the pair can't carry another unit.
+func (n *flowNetwork) marginalCost(pair flowPair) (float64, error) {
+	if cost, ok := n.costs[pair]; ok {
+		return cost, nil
+	}
+
+	units := n.flows[pair]
+	amt := lnwire.MilliSatoshi(units+1) * n.unit
+
+	edge, err := n.edge(pair, amt)
+	if err != nil {
+		return 0, err
+	}
+
+	cost := math.Inf(1)
+	if edge != nil {
+		cost = n.unitCost(pair, edge, units)
+	}
+	n.costs[pair] = cost
+
+	return cost, nil
+}
+
+// unitCost returns the cost of going from the given number of units to one
+// more unit on the pair. Fees are linearized per unit, the probability cost
+// is the decrease of the log probability weighed by the attempt cost.
+func (n *flowNetwork) unitCost(pair flowPair, edge *unifiedEdge,
+	units int) float64 {
+
+	prevProb := n.probability(pair, edge, units)
+	prob := n.probability(pair, edge, units+1)
+	if prob == 0 || prevProb == 0 {
+		return math.Inf(1)
+	}
+
+	// The source doesn't pay a fee nor add a time lock for its own
+	// channels.
+	var weight int64
+	if pair.from != n.self {
+		fee := edge.policy.ComputeFee(n.unit)
+		weight = edgeWeight(n.unit, fee, edge.policy.TimeLockDelta)
+	}
+
+	return float64(weight) +
+		n.attemptCost*(math.Log(prevProb)-math.Log(prob))
+}
+
+// cancelCost returns the cost of removing a unit from the pair, which is the
+// negated cost of the last unit that was allocated to it.
+func (n *flowNetwork) cancelCost(pair flowPair) (float64, error) {
+	units := n.flows[pair]
+	edge, err := n.edge(pair, lnwire.MilliSatoshi(units)*n.unit)
+	if err != nil {
+		return 0, err
+	}
+
+	// Pairs that carry flow had an edge for it when it was allocated. If
+	// that isn't the case anymore, don't cancel through them.
+	if edge == nil {
+		return math.Inf(1), nil
+	}
+
+	return -n.unitCost(pair, edge, units-1), nil
+}
+
+// augment finds the cheapest path from the source to the target in the
+// residual network and allocates a unit of flow to it. The search runs
+// backwards from the target, like path finding does, with a label
+// correcting algorithm because cancelling flow has a negative cost.
+func (n *flowNetwork) augment() error {
+	type label struct {
+		dist float64
+		hops int
+		next residualArc
+	}
+
+	labels := map[route.Vertex]*label{n.target: {}}
+	queue := []route.Vertex{n.target}
+	queued := map[route.Vertex]bool{n.target: true}
+
+	relax := func(from route.Vertex, arc residualArc, cost float64) {
+		// The target is where the search starts, so it never needs a
+		// label of its own.
+		head := labels[arc.to]
+		if from == n.target || math.IsInf(cost, 1) ||
+			head.hops >= maxResidualHops {
+
+			return
+		}
+
+		dist := head.dist + cost
+		if current, ok := labels[from]; ok && dist >= current.dist {
+			return
+		}
+
+		labels[from] = &label{
+			dist: dist,
+			hops: head.hops + 1,
+			next: arc,
+		}
+		if !queued[from] {
+			queue = append(queue, from)
+			queued[from] = true
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		queued[node] = false
+
+		// We don't route through ourselves.
+		if node == n.self {
+			continue
+		}
+
+		u, err := n.unifier(node)
+		if err != nil {
+			return err
+		}
+
+		// Relax the arcs that allocate flow to the incoming pairs.
+		for from := range u.edgeUnifiers {
+			if from == n.target {
+				continue
+			}
+
+			// Apply last hop restriction if set.
+			if n.r.LastHop != nil && node == n.target &&
+				from != *n.r.LastHop {
+
+				continue
+			}
+
+			usable, err := n.isUsable(from)
+			if err != nil {
+				return err
+			}
+			if !usable {
+				continue
+			}
+
+			cost, err := n.marginalCost(flowPair{from, node})
+			if err != nil {
+				return err
+			}
+
+			relax(from, residualArc{to: node}, cost)
+		}
+
+		// Relax the arcs that cancel flow on the outgoing pairs.
+		for to := range n.outFlows[node] {
+			cost, err := n.cancelCost(flowPair{node, to})
+			if err != nil {
+				return err
+			}
+
+			relax(to, residualArc{to: node, cancel: true}, cost)
+		}
+	}
+
+	if _, ok := labels[n.self]; !ok {
+		return errNoPathFound
+	}
+
+	// Walk the path forward from the source and allocate the unit. The
+	// hop limit guards against a cycle in the labels.
+	node := n.self
+	for hops := 0; node != n.target; hops++ {
+		if hops > maxResidualHops {
+			return errNoPathFound
+		}
+
+		arc := labels[node].next
+		if arc.cancel {
+			n.addFlow(flowPair{arc.to, node}, -1)
+		} else {
+			n.addFlow(flowPair{node, arc.to}, 1)
+		}
+		node = arc.to
+	}
+
+	return nil
+}
+
+// addFlow changes the number of units allocated to the pair.
+func (n *flowNetwork) addFlow(pair flowPair, units int) {
+	n.flows[pair] += units
+	delete(n.costs, pair)
+
+	if n.flows[pair] > 0 {
+		if n.outFlows[pair.from] == nil {
+			n.outFlows[pair.from] = make(map[route.Vertex]struct{})
+		}
+		n.outFlows[pair.from][pair.to] = struct{}{}
+
+		return
+	}
+
+	delete(n.flows, pair)
+	delete(n.outFlows[pair.from], pair.to)
+}
+
+// decompose splits the flow into paths from the source to the target. The
+// excess of the rounded up units is taken off the largest path.
+func (n *flowNetwork) decompose(amt,
+	excess lnwire.MilliSatoshi) ([]*flowPath, error) {
+
+	type pathUnits struct {
+		pairs []flowPair
+		units int
+	}
+
+	var paths []*pathUnits
+	for len(n.outFlows[n.self]) > 0 {
+		// Follow the pairs with flow to the target, and take the
+		// smallest flow along the way.
+		var (
+			pairs   []flowPair
+			units   = math.MaxInt
+			node    = n.self
+			visited = map[route.Vertex]bool{n.self: true}
+		)
+		for node != n.target {
+			var next route.Vertex
+			for to := range n.outFlows[node] {
+				next = to
+				break
+			}
+
+			// Flow that circulates or ends before the target
+			// can't be turned into a route.
+			if len(n.outFlows[node]) == 0 || visited[next] {
+				return nil, errNoPathFound
+			}
+			visited[next] = true
+
+			pair := flowPair{node, next}
+			pairs = append(pairs, pair)
+			units = min(units, n.flows[pair])
+			node = next
+		}
+
+		for _, pair := range pairs {
+			n.addFlow(pair, -units)
+		}
+		paths = append(paths, &pathUnits{pairs: pairs, units: units})
+	}
+
+	if len(paths) == 0 {
+		return nil, errNoPathFound
+	}
+
+	largest := 0
+	for i, path := range paths {
+		if path.units > paths[largest].units {
+			largest = i
+		}
+	}
+
+	var (
+		result []*flowPath
+		total  lnwire.MilliSatoshi
+	)
+	for i, path := range paths {
+		pathAmt := lnwire.MilliSatoshi(path.units) * n.unit
+		if i == largest {
+			pathAmt -= excess
+		}
+
+		edges := make([]*unifiedEdge, 0, len(path.pairs))
+		for _, pair := range path.pairs {
+			edge, err := n.edge(pair, pathAmt)
+			if err != nil {
+				return nil, err
+			}
+			if edge == nil {
+				return nil, errNoPathFound
+			}
+			edges = append(edges, edge)
+		}
+
+		result = append(result, &flowPath{edges: edges, amt: pathAmt})
+		total += pathAmt
+	}
+
+	if total != amt {
+		log.Errorf("Flow of %v doesn't match amount %v", total, amt)
+		return nil, errNoPathFound
+	}
+
+	return result, nil
+}
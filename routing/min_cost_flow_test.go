@@ -0,0 +1,115 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// findFlow calls findFlow after getting a graph session from the database
+// graph.
+func (c *pathFindingTestContext) findFlow(target route.Vertex,
+	amt lnwire.MilliSatoshi, numUnits int) ([]*flowPath, error) {
+
+	graphSess, closeGraphSess, err := newMockGraphSessionFactoryFromChanDB(
+		c.graph,
+	).NewGraphSession()
+	require.NoError(c.t, err)
+	defer func() {
+		require.NoError(c.t, closeGraphSess())
+	}()
+
+	return findFlow(
+		&graphParams{
+			bandwidthHints: c.bandwidthHints,
+			graph:          graphSess,
+		},
+		&c.restrictParams, &c.pathFindingConfig, c.source, target, amt,
+		numUnits, c.timePref,
+	)
+}
+
+// assertFlow asserts that the flow consists of paths over the given channels
+// that carry the given amounts.
+func (c *pathFindingTestContext) assertFlow(paths []*flowPath,
+	expected map[uint64]lnwire.MilliSatoshi) {
+
+	require.Len(c.t, paths, len(expected))
+	for _, path := range paths {
+		// The paths in these tests are identified by their first
+		// channel.
+		chanID := path.edges[0].policy.ChannelID
+		require.Contains(c.t, expected, chanID)
+		require.Equal(c.t, expected[chanID], path.amt, "channel %v",
+			chanID)
+	}
+}
+
+// TestFindFlow tests that a min-cost flow splits an amount across parallel
+// paths in a single step.
+func TestFindFlow(t *testing.T) {
+	t.Parallel()
+
+	policy := &testChannelPolicy{
+		Expiry:      144,
+		FeeBaseMsat: 1000,
+		MinHTLC:     1,
+		MaxHTLC:     lnwire.NewMSatFromSatoshis(200_000),
+	}
+	expensivePolicy := *policy
+	expensivePolicy.FeeRate = 1000
+
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 200_000, policy, 1),
+		symmetricTestChannel("a", "target", 100_000, policy, 2),
+		symmetricTestChannel("roasbeef", "b", 200_000, policy, 3),
+		symmetricTestChannel(
+			"b", "target", 100_000, &expensivePolicy, 4,
+		),
+	}
+
+	ctx := newPathFindingTestContext(t, true, testChannels, "roasbeef")
+	target := ctx.keyFromAlias("target")
+
+	// Without any uncertainty, the cheap path via a is filled up to its
+	// capacity before the expensive path via b is used.
+	amt := lnwire.NewMSatFromSatoshis(160_000)
+	paths, err := ctx.findFlow(target, amt, 16)
+	require.NoError(t, err)
+	ctx.assertFlow(paths, map[uint64]lnwire.MilliSatoshi{
+		1: lnwire.NewMSatFromSatoshis(100_000),
+		3: lnwire.NewMSatFromSatoshis(60_000),
+	})
+
+	// With a success probability that drops with the amount, an amount
+	// that fits the cheap path is still spread over both paths, with the
+	// larger share on the cheap one.
+	ctx.restrictParams.ProbabilitySource = func(_, _ route.Vertex,
+		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
+
+		return 1 - float64(amt)/
+			float64(lnwire.NewMSatFromSatoshis(capacity)+1)
+	}
+	ctx.pathFindingConfig.AttemptCost = lnwire.NewMSatFromSatoshis(100)
+
+	amt = lnwire.NewMSatFromSatoshis(80_000)
+	paths, err = ctx.findFlow(target, amt, 16)
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+
+	amts := make(map[uint64]lnwire.MilliSatoshi)
+	for _, path := range paths {
+		amts[path.edges[0].policy.ChannelID] = path.amt
+	}
+	require.Greater(t, amts[1], amts[3])
+	require.Positive(t, amts[3])
+	require.Equal(t, amt, amts[1]+amts[3])
+
+	// An amount that exceeds the capacity of all paths can't be
+	// allocated.
+	_, err = ctx.findFlow(target, lnwire.NewMSatFromSatoshis(250_000), 16)
+	require.ErrorIs(t, err, errNoPathFound)
+}
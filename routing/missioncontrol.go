@@ -63,6 +63,10 @@ const (
 	// amount may be raised.
 	DefaultMinFailureRelaxInterval = time.Minute
 
+	// DefaultMissionControlNamespace is the name of the mission control
+	// namespace that is used by payments that don't select a namespace.
+	DefaultMissionControlNamespace = "default"
+
 	// DefaultFeeEstimationTimeout is the default value for
 	// FeeEstimationTimeout. It defines the maximum duration that the
 	// probing fee estimation is allowed to take.
@@ -104,6 +108,10 @@ type MissionControl struct {
 	// selfNode is our pubkey.
 	selfNode route.Vertex
 
+	// namespace is the name of the namespace whose history this mission
+	// control holds.
+	namespace string
+
 	store *missionControlStore
 
 	// estimator is the probability estimator that is used with the payment
@@ -205,19 +213,42 @@ type paymentResult struct {
 	failure            lnwire.FailureMessage
 }
 
-// NewMissionControl returns a new instance of missionControl.
+// NewMissionControl returns a new instance of missionControl for the default
+// namespace.
 func NewMissionControl(db kvdb.Backend, self route.Vertex,
 	cfg *MissionControlConfig) (*MissionControl, error) {
 
-	log.Debugf("Instantiating mission control with config: %v, %v", cfg,
-		cfg.Estimator)
+	return newMissionControl(db, self, cfg, DefaultMissionControlNamespace)
+}
+
+// newMissionControl returns a new instance of missionControl that holds the
+// history of the given namespace.
+func newMissionControl(db kvdb.Backend, self route.Vertex,
+	cfg *MissionControlConfig, namespace string) (*MissionControl, error) {
+
+	// Namespaces other than the default one keep the config that was set
+	// for them across restarts.
+	if namespace != DefaultMissionControlNamespace {
+		stored, err := fetchNamespaceConfig(db, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		if stored != nil {
+			stored.McFlushInterval = cfg.McFlushInterval
+			cfg = stored
+		}
+	}
+
+	log.Debugf("Instantiating mission control of namespace %v with "+
+		"config: %v, %v", namespace, cfg, cfg.Estimator)
 
 	if err := cfg.validate(); err != nil {
 		return nil, err
 	}
 
 	store, err := newMissionControlStore(
-		db, cfg.MaxMcHistory, cfg.McFlushInterval,
+		db, namespace, cfg.MaxMcHistory, cfg.McFlushInterval,
 	)
 	if err != nil {
 		return nil, err
@@ -227,6 +258,7 @@ func NewMissionControl(db kvdb.Backend, self route.Vertex,
 		state:     newMissionControlState(cfg.MinFailureRelaxInterval),
 		now:       time.Now,
 		selfNode:  self,
+		namespace: namespace,
 		store:     store,
 		estimator: cfg.Estimator,
 	}
@@ -238,6 +270,12 @@ func NewMissionControl(db kvdb.Backend, self route.Vertex,
 	return mc, nil
 }
 
+// Namespace returns the name of the namespace whose history this mission
+// control holds.
+func (m *MissionControl) Namespace() string {
+	return m.namespace
+}
+
 // RunStoreTicker runs the mission control store's ticker.
 func (m *MissionControl) RunStoreTicker() {
 	m.store.run()
@@ -288,7 +326,8 @@ func (m *MissionControl) GetConfig() *MissionControlConfig {
 }
 
 // SetConfig validates the config provided and updates mission control's config
-// if it is valid.
+// if it is valid. The config of namespaces other than the default one is
+// persisted, so that it's restored after a restart.
 func (m *MissionControl) SetConfig(cfg *MissionControlConfig) error {
 	if cfg == nil {
 		return errors.New("nil mission control config")
@@ -301,8 +340,15 @@ func (m *MissionControl) SetConfig(cfg *MissionControlConfig) error {
 	m.Lock()
 	defer m.Unlock()
 
-	log.Infof("Active mission control cfg: %v, estimator: %v", cfg,
-		cfg.Estimator)
+	if m.namespace != DefaultMissionControlNamespace {
+		err := putNamespaceConfig(m.store.db, m.namespace, cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Active mission control cfg of namespace %v: %v, "+
+		"estimator: %v", m.namespace, cfg, cfg.Estimator)
 
 	m.store.maxRecords = cfg.MaxMcHistory
 	m.state.minFailureRelaxInterval = cfg.MinFailureRelaxInterval
@@ -323,7 +369,8 @@ func (m *MissionControl) ResetHistory() error {
 
 	m.state.resetHistory()
 
-	log.Debugf("Mission control history cleared")
+	log.Debugf("Mission control history of namespace %v cleared",
+		m.namespace)
 
 	return nil
 }
@@ -0,0 +1,243 @@
+package routing
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// maxMcNamespaceLength is the maximum length of the name of a mission
+	// control namespace.
+	maxMcNamespaceLength = 64
+
+	// MaxMcNamespaces is the maximum number of mission control namespaces,
+	// including the default one.
+	MaxMcNamespaces = 100
+)
+
+var (
+	// ErrInvalidMcNamespace is returned if a mission control namespace
+	// name is too long.
+	ErrInvalidMcNamespace = errors.New("mission control namespace must " +
+		"not be longer than 64 bytes")
+
+	// ErrMcNamespaceNotFound is returned if a mission control namespace
+	// doesn't exist.
+	ErrMcNamespaceNotFound = errors.New("mission control namespace not " +
+		"found")
+
+	// ErrTooManyMcNamespaces is returned if a mission control namespace
+	// is created while the maximum number of namespaces exists already.
+	ErrTooManyMcNamespaces = fmt.Errorf("at most %d mission control "+
+		"namespaces can exist", MaxMcNamespaces)
+
+	// ErrDeleteDefaultMcNamespace is returned if the default mission
+	// control namespace is deleted.
+	ErrDeleteDefaultMcNamespace = errors.New("the default mission " +
+		"control namespace cannot be deleted")
+)
+
+// MissionControlManager manages the mission controls of a set of named
+// namespaces. Every namespace has its own history, estimator config and
+// results store, so that payments made in one namespace don't affect the
+// probability estimates of another. Namespaces are created when they're first
+// written to, and are restored from the db on startup.
+type MissionControlManager struct {
+	db       kvdb.Backend
+	selfNode route.Vertex
+
+	// cfg is the config that new namespaces start out with.
+	cfg *MissionControlConfig
+
+	// mcs holds the mission control of every known namespace.
+	mcs map[string]*MissionControl
+
+	// tickersRunning indicates that the store tickers were started, so
+	// that the ticker of a namespace that is created later on is started
+	// as well.
+	tickersRunning bool
+
+	mu sync.Mutex
+}
+
+// NewMissionControlManager returns a new mission control manager. It loads the
+// default namespace as well as all namespaces that have results stored in the
+// db. All of them use the given config until it is changed for a namespace.
+func NewMissionControlManager(db kvdb.Backend, self route.Vertex,
+	cfg *MissionControlConfig) (*MissionControlManager, error) {
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	m := &MissionControlManager{
+		db:       db,
+		selfNode: self,
+		cfg:      cfg,
+		mcs:      make(map[string]*MissionControl),
+	}
+
+	namespaces, err := fetchNamespaces(db)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces = append(namespaces, DefaultMissionControlNamespace)
+	for _, namespace := range namespaces {
+		mc, err := newMissionControl(db, self, cfg, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		m.mcs[namespace] = mc
+	}
+
+	return m, nil
+}
+
+// GetDefaultStore returns the mission control of the default namespace.
+func (m *MissionControlManager) GetDefaultStore() *MissionControl {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.mcs[DefaultMissionControlNamespace]
+}
+
+// GetNamespacedStore returns the mission control of the given namespace, or
+// ErrMcNamespaceNotFound if it doesn't exist. The empty namespace refers to the
+// default namespace.
+func (m *MissionControlManager) GetNamespacedStore(
+	namespace string) (*MissionControl, error) {
+
+	if namespace == "" {
+		namespace = DefaultMissionControlNamespace
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mc, ok := m.mcs[namespace]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrMcNamespaceNotFound,
+			namespace)
+	}
+
+	return mc, nil
+}
+
+// FetchOrCreateNamespacedStore returns the mission control of the given
+// namespace, creating the namespace if it doesn't exist yet. The empty
+// namespace refers to the default namespace.
+func (m *MissionControlManager) FetchOrCreateNamespacedStore(
+	namespace string) (*MissionControl, error) {
+
+	if namespace == "" {
+		namespace = DefaultMissionControlNamespace
+	}
+
+	if len(namespace) > maxMcNamespaceLength {
+		return nil, ErrInvalidMcNamespace
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if mc, ok := m.mcs[namespace]; ok {
+		return mc, nil
+	}
+
+	if len(m.mcs) >= MaxMcNamespaces {
+		return nil, ErrTooManyMcNamespaces
+	}
+
+	log.Infof("Creating mission control namespace %v", namespace)
+
+	mc, err := newMissionControl(m.db, m.selfNode, m.cfg, namespace)
+	if err != nil {
+		return nil, err
+	}
+	m.mcs[namespace] = mc
+
+	if m.tickersRunning {
+		mc.RunStoreTicker()
+	}
+
+	return mc, nil
+}
+
+// DeleteNamespace deletes the given namespace together with its history and
+// config. Payments that are still in flight in the namespace keep using its
+// mission control, but their results aren't stored anymore.
+func (m *MissionControlManager) DeleteNamespace(namespace string) error {
+	if namespace == "" || namespace == DefaultMissionControlNamespace {
+		return ErrDeleteDefaultMcNamespace
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mc, ok := m.mcs[namespace]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrMcNamespaceNotFound, namespace)
+	}
+
+	if m.tickersRunning {
+		mc.StopStoreTicker()
+	}
+
+	if err := deleteNamespace(m.db, namespace); err != nil {
+		return err
+	}
+	delete(m.mcs, namespace)
+
+	log.Infof("Deleted mission control namespace %v", namespace)
+
+	return nil
+}
+
+// ListNamespaces returns the names of all known namespaces in alphabetical
+// order.
+func (m *MissionControlManager) ListNamespaces() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	namespaces := make([]string, 0, len(m.mcs))
+	for namespace := range m.mcs {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	return namespaces
+}
+
+// RunStoreTickers runs the store tickers of all namespaces, including the ones
+// that are created later on.
+func (m *MissionControlManager) RunStoreTickers() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, mc := range m.mcs {
+		mc.RunStoreTicker()
+	}
+	m.tickersRunning = true
+}
+
+// StopStoreTickers stops the store tickers of all namespaces.
+func (m *MissionControlManager) StopStoreTickers() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.tickersRunning {
+		return
+	}
+
+	for _, mc := range m.mcs {
+		mc.StopStoreTicker()
+	}
+	m.tickersRunning = false
+}
@@ -0,0 +1,193 @@
+package routing
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMissionControlManager tests that the history of mission control
+// namespaces is kept apart, and that namespaces are restored from the db.
+func TestMissionControlManager(t *testing.T) {
+	t.Parallel()
+
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, filepath.Join(t.TempDir(), "mc.db"),
+		true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	estimator, err := NewAprioriEstimator(AprioriConfig{
+		PenaltyHalfLife:       testPenaltyHalfLife,
+		AprioriHopProbability: testAprioriHopProbability,
+		AprioriWeight:         testAprioriWeight,
+		CapacityFraction:      testCapacityFraction,
+	})
+	require.NoError(t, err)
+	cfg := &MissionControlConfig{Estimator: estimator}
+
+	mgr, err := NewMissionControlManager(db, mcTestSelf, cfg)
+	require.NoError(t, err)
+	require.Equal(
+		t, []string{DefaultMissionControlNamespace},
+		mgr.ListNamespaces(),
+	)
+
+	// The empty namespace refers to the default one.
+	defaultMc := mgr.GetDefaultStore()
+	mc, err := mgr.GetNamespacedStore("")
+	require.NoError(t, err)
+	require.Same(t, defaultMc, mc)
+
+	_, err = mgr.FetchOrCreateNamespacedStore(strings.Repeat("a", 65))
+	require.ErrorIs(t, err, ErrInvalidMcNamespace)
+
+	// Unknown namespaces are only created on request.
+	_, err = mgr.GetNamespacedStore("tenant")
+	require.ErrorIs(t, err, ErrMcNamespaceNotFound)
+
+	// Report a failure to a new namespace.
+	tenantMc, err := mgr.FetchOrCreateNamespacedStore("tenant")
+	require.NoError(t, err)
+	require.Equal(t, "tenant", tenantMc.Namespace())
+
+	errorSourceIdx := 1
+	_, err = tenantMc.ReportPaymentFail(
+		1, mcTestRoute, &errorSourceIdx,
+		lnwire.NewTemporaryChannelFailure(nil),
+	)
+	require.NoError(t, err)
+	require.NoError(t, tenantMc.store.storeResults())
+
+	// Only the namespace that the failure was reported to knows about it.
+	require.Len(t, tenantMc.GetHistorySnapshot().Pairs, 2)
+	require.Empty(t, defaultMc.GetHistorySnapshot().Pairs)
+
+	// Each namespace has its own config.
+	tenantCfg := *cfg
+	tenantCfg.MaxMcHistory = 10
+	require.NoError(t, tenantMc.SetConfig(&tenantCfg))
+	require.Equal(t, 10, tenantMc.GetConfig().MaxMcHistory)
+	require.Zero(t, defaultMc.GetConfig().MaxMcHistory)
+
+	// After a restart, the namespace, its history and its config are
+	// restored.
+	mgr, err = NewMissionControlManager(db, mcTestSelf, cfg)
+	require.NoError(t, err)
+	require.Equal(
+		t, []string{DefaultMissionControlNamespace, "tenant"},
+		mgr.ListNamespaces(),
+	)
+
+	tenantMc, err = mgr.GetNamespacedStore("tenant")
+	require.NoError(t, err)
+	require.Len(t, tenantMc.GetHistorySnapshot().Pairs, 2)
+	require.Equal(t, 10, tenantMc.GetConfig().MaxMcHistory)
+	require.Equal(t, estimator, tenantMc.GetConfig().Estimator)
+	require.Empty(t, mgr.GetDefaultStore().GetHistorySnapshot().Pairs)
+
+	// Resetting a namespace leaves the others alone.
+	_, err = mgr.GetDefaultStore().ReportPaymentFail(
+		2, mcTestRoute, &errorSourceIdx,
+		lnwire.NewTemporaryChannelFailure(nil),
+	)
+	require.NoError(t, err)
+	require.NoError(t, tenantMc.ResetHistory())
+	require.Empty(t, tenantMc.GetHistorySnapshot().Pairs)
+	require.NotEmpty(t, mgr.GetDefaultStore().GetHistorySnapshot().Pairs)
+
+	results, err := tenantMc.store.fetchAll()
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+// TestMissionControlManagerLimits tests that the number of namespaces is
+// capped, and that namespaces can be deleted.
+func TestMissionControlManagerLimits(t *testing.T) {
+	t.Parallel()
+
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, filepath.Join(t.TempDir(), "mc.db"),
+		true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	estimator, err := NewBimodalEstimator(BimodalConfig{
+		BimodalNodeWeight: 0.2,
+		BimodalScaleMsat:  400_000,
+		BimodalDecayTime:  time.Hour,
+	})
+	require.NoError(t, err)
+	cfg := &MissionControlConfig{
+		Estimator:       estimator,
+		McFlushInterval: time.Second,
+	}
+
+	mgr, err := NewMissionControlManager(db, mcTestSelf, cfg)
+	require.NoError(t, err)
+	mgr.RunStoreTickers()
+	t.Cleanup(mgr.StopStoreTickers)
+
+	// Fill up the namespaces next to the default one.
+	for i := 1; i < MaxMcNamespaces; i++ {
+		_, err := mgr.FetchOrCreateNamespacedStore(
+			fmt.Sprintf("tenant-%d", i),
+		)
+		require.NoError(t, err)
+	}
+
+	_, err = mgr.FetchOrCreateNamespacedStore("another")
+	require.ErrorIs(t, err, ErrTooManyMcNamespaces)
+
+	// Existing namespaces can still be fetched.
+	_, err = mgr.FetchOrCreateNamespacedStore("tenant-1")
+	require.NoError(t, err)
+
+	// The default namespace can't be deleted, unknown ones aren't found.
+	err = mgr.DeleteNamespace(DefaultMissionControlNamespace)
+	require.ErrorIs(t, err, ErrDeleteDefaultMcNamespace)
+	err = mgr.DeleteNamespace("another")
+	require.ErrorIs(t, err, ErrMcNamespaceNotFound)
+
+	// Change the config of a namespace before deleting it, so that we
+	// can check that the config is deleted as well.
+	tenantMc, err := mgr.GetNamespacedStore("tenant-1")
+	require.NoError(t, err)
+	tenantCfg := *cfg
+	tenantCfg.MaxMcHistory = 10
+	require.NoError(t, tenantMc.SetConfig(&tenantCfg))
+
+	require.NoError(t, mgr.DeleteNamespace("tenant-1"))
+	_, err = mgr.GetNamespacedStore("tenant-1")
+	require.ErrorIs(t, err, ErrMcNamespaceNotFound)
+
+	// Deleting a namespace makes room for another one.
+	_, err = mgr.FetchOrCreateNamespacedStore("another")
+	require.NoError(t, err)
+
+	// The deleted namespace isn't restored after a restart, and its
+	// config is gone.
+	mgr.StopStoreTickers()
+	mgr, err = NewMissionControlManager(db, mcTestSelf, cfg)
+	require.NoError(t, err)
+	require.Len(t, mgr.ListNamespaces(), MaxMcNamespaces)
+
+	_, err = mgr.GetNamespacedStore("tenant-1")
+	require.ErrorIs(t, err, ErrMcNamespaceNotFound)
+
+	stored, err := fetchNamespaceConfig(db, "tenant-1")
+	require.NoError(t, err)
+	require.Nil(t, stored)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 var (
@@ -20,6 +21,18 @@ var (
 	// stored.
 	resultsKey = []byte("missioncontrol-results")
 
+	// namespacesKey is the fixed key of the bucket that holds a nested
+	// results bucket for every mission control namespace other than the
+	// default one. The results of the default namespace are kept under
+	// resultsKey.
+	namespacesKey = []byte("missioncontrol-namespaces")
+
+	// namespaceConfigsKey is the fixed key of the bucket that holds the
+	// config of every mission control namespace other than the default
+	// one whose config was changed, keyed by the name of the namespace.
+	// The default namespace always starts out with the config of lnd.
+	namespaceConfigsKey = []byte("missioncontrol-namespace-configs")
+
 	// Big endian is the preferred byte order, due to cursor scans over
 	// integer keys iterating in order.
 	byteOrder = binary.BigEndian
@@ -31,6 +44,21 @@ const (
 	unknownFailureSourceIdx = -1
 )
 
+const (
+	// The tlv types of the fields of a stored namespace config. The
+	// flush interval isn't stored, as it can't be changed at runtime.
+	typeMcMaxHistory              tlv.Type = 0
+	typeMcMinFailureRelaxInterval tlv.Type = 1
+	typeMcEstimator               tlv.Type = 2
+	typeAprioriPenaltyHalfLife    tlv.Type = 10
+	typeAprioriHopProbability     tlv.Type = 11
+	typeAprioriWeight             tlv.Type = 12
+	typeAprioriCapacityFraction   tlv.Type = 13
+	typeBimodalNodeWeight         tlv.Type = 20
+	typeBimodalScaleMsat          tlv.Type = 21
+	typeBimodalDecayTime          tlv.Type = 22
+)
+
 // missionControlStore is a bolt db based implementation of a mission control
 // store. It stores the raw payment attempt data from which the internal mission
 // controls state can be rederived on startup. This allows the mission control
@@ -42,6 +70,10 @@ type missionControlStore struct {
 	wg   sync.WaitGroup
 	db   kvdb.Backend
 
+	// namespace is the mission control namespace whose results are
+	// stored.
+	namespace string
+
 	// queueCond is signalled when items are put into the queue.
 	queueCond *sync.Cond
 
@@ -66,8 +98,9 @@ type missionControlStore struct {
 	flushInterval time.Duration
 }
 
-func newMissionControlStore(db kvdb.Backend, maxRecords int,
-	flushInterval time.Duration) (*missionControlStore, error) {
+func newMissionControlStore(db kvdb.Backend, namespace string,
+	maxRecords int, flushInterval time.Duration) (*missionControlStore,
+	error) {
 
 	var (
 		keys    *list.List
@@ -76,7 +109,7 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int,
 
 	// Create buckets if not yet existing.
 	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
-		resultsBucket, err := tx.CreateTopLevelBucket(resultsKey)
+		resultsBucket, err := createResultsBucket(tx, namespace)
 		if err != nil {
 			return fmt.Errorf("cannot create results bucket: %w",
 				err)
@@ -99,11 +132,13 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int,
 		return nil, err
 	}
 
-	log.Infof("Loaded %d mission control entries", len(keysMap))
+	log.Infof("Loaded %d mission control entries of namespace %v",
+		len(keysMap), namespace)
 
 	return &missionControlStore{
 		done:          make(chan struct{}),
 		db:            db,
+		namespace:     namespace,
 		queueCond:     sync.NewCond(&sync.Mutex{}),
 		queue:         list.New(),
 		keys:          keys,
@@ -119,11 +154,11 @@ func (b *missionControlStore) clear() error {
 	defer b.queueCond.L.Unlock()
 
 	err := kvdb.Update(b.db, func(tx kvdb.RwTx) error {
-		if err := tx.DeleteTopLevelBucket(resultsKey); err != nil {
+		if err := deleteResultsBucket(tx, b.namespace); err != nil {
 			return err
 		}
 
-		_, err := tx.CreateTopLevelBucket(resultsKey)
+		_, err := createResultsBucket(tx, b.namespace)
 		return err
 	}, func() {})
 
@@ -140,7 +175,11 @@ func (b *missionControlStore) fetchAll() ([]*paymentResult, error) {
 	var results []*paymentResult
 
 	err := kvdb.View(b.db, func(tx kvdb.RTx) error {
-		resultBucket := tx.ReadBucket(resultsKey)
+		resultBucket := fetchResultsBucket(tx, b.namespace)
+		if resultBucket == nil {
+			return fmt.Errorf("results bucket of namespace %v not "+
+				"found", b.namespace)
+		}
 		results = make([]*paymentResult, 0)
 
 		return resultBucket.ForEach(func(k, v []byte) error {
@@ -282,7 +321,13 @@ func (b *missionControlStore) AddResult(rp *paymentResult) {
 func (b *missionControlStore) stop() {
 	close(b.done)
 
+	// The signal is sent while holding the lock, so that the ticker
+	// goroutine either sees that we're done before it waits, or is woken
+	// up. Namespaces can be deleted at runtime, so the goroutine may
+	// not have started waiting yet.
+	b.queueCond.L.Lock()
 	b.queueCond.Signal()
+	b.queueCond.L.Unlock()
 
 	b.wg.Wait()
 }
@@ -314,8 +359,6 @@ func (b *missionControlStore) run() {
 			// Wait for the queue to not be empty.
 			b.queueCond.L.Lock()
 			for b.queue.Front() == nil {
-				b.queueCond.Wait()
-
 				select {
 				case <-b.done:
 					b.queueCond.L.Unlock()
@@ -323,6 +366,8 @@ func (b *missionControlStore) run() {
 					return
 				default:
 				}
+
+				b.queueCond.Wait()
 			}
 			b.queueCond.L.Unlock()
 
@@ -427,7 +472,11 @@ func (b *missionControlStore) storeResults() error {
 	}
 
 	err := kvdb.Update(b.db, func(tx kvdb.RwTx) error {
-		bucket := tx.ReadWriteBucket(resultsKey)
+		bucket := fetchRwResultsBucket(tx, b.namespace)
+		if bucket == nil {
+			return fmt.Errorf("results bucket of namespace %v not "+
+				"found", b.namespace)
+		}
 
 		for e := l.Front(); e != nil; e = e.Next() {
 			pr, ok := e.Value.(*paymentResult)
@@ -503,3 +552,312 @@ func getResultKey(rp *paymentResult) []byte {
 
 	return keyBytes[:]
 }
+
+// createResultsBucket creates the results bucket of the given namespace if it
+// doesn't exist yet and returns it.
+func createResultsBucket(tx kvdb.RwTx, namespace string) (kvdb.RwBucket,
+	error) {
+
+	if namespace == DefaultMissionControlNamespace {
+		return tx.CreateTopLevelBucket(resultsKey)
+	}
+
+	namespaces, err := tx.CreateTopLevelBucket(namespacesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaces.CreateBucketIfNotExists([]byte(namespace))
+}
+
+// deleteResultsBucket deletes the results bucket of the given namespace.
+func deleteResultsBucket(tx kvdb.RwTx, namespace string) error {
+	if namespace == DefaultMissionControlNamespace {
+		return tx.DeleteTopLevelBucket(resultsKey)
+	}
+
+	namespaces := tx.ReadWriteBucket(namespacesKey)
+	if namespaces == nil {
+		return kvdb.ErrBucketNotFound
+	}
+
+	return namespaces.DeleteNestedBucket([]byte(namespace))
+}
+
+// fetchResultsBucket returns the results bucket of the given namespace, or nil
+// if it doesn't exist.
+func fetchResultsBucket(tx kvdb.RTx, namespace string) kvdb.RBucket {
+	if namespace == DefaultMissionControlNamespace {
+		return tx.ReadBucket(resultsKey)
+	}
+
+	namespaces := tx.ReadBucket(namespacesKey)
+	if namespaces == nil {
+		return nil
+	}
+
+	return namespaces.NestedReadBucket([]byte(namespace))
+}
+
+// fetchRwResultsBucket returns the writable results bucket of the given
+// namespace, or nil if it doesn't exist.
+func fetchRwResultsBucket(tx kvdb.RwTx, namespace string) kvdb.RwBucket {
+	if namespace == DefaultMissionControlNamespace {
+		return tx.ReadWriteBucket(resultsKey)
+	}
+
+	namespaces := tx.ReadWriteBucket(namespacesKey)
+	if namespaces == nil {
+		return nil
+	}
+
+	return namespaces.NestedReadWriteBucket([]byte(namespace))
+}
+
+// fetchNamespaces returns the names of all mission control namespaces other
+// than the default one that have results stored in the db.
+func fetchNamespaces(db kvdb.Backend) ([]string, error) {
+	var namespaces []string
+
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(namespacesKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// Namespaces are stored as nested buckets, which have
+			// no value.
+			if v != nil {
+				return nil
+			}
+
+			namespaces = append(namespaces, string(k))
+
+			return nil
+		})
+	}, func() {
+		namespaces = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaces, nil
+}
+
+// deleteNamespace deletes the results bucket and the stored config of the
+// given namespace.
+func deleteNamespace(db kvdb.Backend, namespace string) error {
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		err := deleteResultsBucket(tx, namespace)
+		if err != nil && err != kvdb.ErrBucketNotFound {
+			return err
+		}
+
+		configs := tx.ReadWriteBucket(namespaceConfigsKey)
+		if configs == nil {
+			return nil
+		}
+
+		return configs.Delete([]byte(namespace))
+	}, func() {})
+}
+
+// putNamespaceConfig stores the config of the given namespace.
+func putNamespaceConfig(db kvdb.Backend, namespace string,
+	cfg *MissionControlConfig) error {
+
+	value, err := serializeMcConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(db, func(tx kvdb.RwTx) error {
+		configs, err := tx.CreateTopLevelBucket(namespaceConfigsKey)
+		if err != nil {
+			return err
+		}
+
+		return configs.Put([]byte(namespace), value)
+	}, func() {})
+}
+
+// fetchNamespaceConfig returns the stored config of the given namespace, or
+// nil if its config was never changed. The flush interval of the returned
+// config isn't set.
+func fetchNamespaceConfig(db kvdb.Backend,
+	namespace string) (*MissionControlConfig, error) {
+
+	var value []byte
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		configs := tx.ReadBucket(namespaceConfigsKey)
+		if configs == nil {
+			return nil
+		}
+
+		if v := configs.Get([]byte(namespace)); v != nil {
+			value = append([]byte(nil), v...)
+		}
+
+		return nil
+	}, func() {
+		value = nil
+	})
+	if err != nil || value == nil {
+		return nil, err
+	}
+
+	return deserializeMcConfig(value)
+}
+
+// serializeMcConfig serializes a mission control config, including the
+// parameters of its estimator.
+func serializeMcConfig(cfg *MissionControlConfig) ([]byte, error) {
+	var (
+		maxHistory   = uint64(cfg.MaxMcHistory)
+		relax        = uint64(cfg.MinFailureRelaxInterval)
+		halfLife     uint64
+		hopProb      uint64
+		weight       uint64
+		capFraction  uint64
+		nodeWeight   uint64
+		scaleMsat    uint64
+		decayTime    uint64
+		estimatorKey []byte
+	)
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(typeMcMaxHistory, &maxHistory),
+		tlv.MakePrimitiveRecord(
+			typeMcMinFailureRelaxInterval, &relax,
+		),
+		tlv.MakePrimitiveRecord(typeMcEstimator, &estimatorKey),
+	}
+
+	switch c := cfg.Estimator.Config().(type) {
+	case AprioriConfig:
+		estimatorKey = []byte(AprioriEstimatorName)
+		halfLife = uint64(c.PenaltyHalfLife)
+		hopProb = math.Float64bits(c.AprioriHopProbability)
+		weight = math.Float64bits(c.AprioriWeight)
+		capFraction = math.Float64bits(c.CapacityFraction)
+
+		records = append(records,
+			tlv.MakePrimitiveRecord(
+				typeAprioriPenaltyHalfLife, &halfLife,
+			),
+			tlv.MakePrimitiveRecord(
+				typeAprioriHopProbability, &hopProb,
+			),
+			tlv.MakePrimitiveRecord(typeAprioriWeight, &weight),
+			tlv.MakePrimitiveRecord(
+				typeAprioriCapacityFraction, &capFraction,
+			),
+		)
+
+	case BimodalConfig:
+		estimatorKey = []byte(BimodalEstimatorName)
+		nodeWeight = math.Float64bits(c.BimodalNodeWeight)
+		scaleMsat = uint64(c.BimodalScaleMsat)
+		decayTime = uint64(c.BimodalDecayTime)
+
+		records = append(records,
+			tlv.MakePrimitiveRecord(
+				typeBimodalNodeWeight, &nodeWeight,
+			),
+			tlv.MakePrimitiveRecord(
+				typeBimodalScaleMsat, &scaleMsat,
+			),
+			tlv.MakePrimitiveRecord(
+				typeBimodalDecayTime, &decayTime,
+			),
+		)
+
+	default:
+		return nil, fmt.Errorf("unknown estimator config type %T", c)
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeMcConfig deserializes a mission control config and creates its
+// estimator.
+func deserializeMcConfig(value []byte) (*MissionControlConfig, error) {
+	var (
+		maxHistory   uint64
+		relax        uint64
+		halfLife     uint64
+		hopProb      uint64
+		weight       uint64
+		capFraction  uint64
+		nodeWeight   uint64
+		scaleMsat    uint64
+		decayTime    uint64
+		estimatorKey []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeMcMaxHistory, &maxHistory),
+		tlv.MakePrimitiveRecord(
+			typeMcMinFailureRelaxInterval, &relax,
+		),
+		tlv.MakePrimitiveRecord(typeMcEstimator, &estimatorKey),
+		tlv.MakePrimitiveRecord(typeAprioriPenaltyHalfLife, &halfLife),
+		tlv.MakePrimitiveRecord(typeAprioriHopProbability, &hopProb),
+		tlv.MakePrimitiveRecord(typeAprioriWeight, &weight),
+		tlv.MakePrimitiveRecord(
+			typeAprioriCapacityFraction, &capFraction,
+		),
+		tlv.MakePrimitiveRecord(typeBimodalNodeWeight, &nodeWeight),
+		tlv.MakePrimitiveRecord(typeBimodalScaleMsat, &scaleMsat),
+		tlv.MakePrimitiveRecord(typeBimodalDecayTime, &decayTime),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Decode(bytes.NewReader(value)); err != nil {
+		return nil, err
+	}
+
+	cfg := &MissionControlConfig{
+		MaxMcHistory:            int(maxHistory),
+		MinFailureRelaxInterval: time.Duration(relax),
+	}
+
+	switch string(estimatorKey) {
+	case AprioriEstimatorName:
+		cfg.Estimator, err = NewAprioriEstimator(AprioriConfig{
+			PenaltyHalfLife:       time.Duration(halfLife),
+			AprioriHopProbability: math.Float64frombits(hopProb),
+			AprioriWeight:         math.Float64frombits(weight),
+			CapacityFraction:      math.Float64frombits(capFraction),
+		})
+
+	case BimodalEstimatorName:
+		cfg.Estimator, err = NewBimodalEstimator(BimodalConfig{
+			BimodalNodeWeight: math.Float64frombits(nodeWeight),
+			BimodalScaleMsat:  lnwire.MilliSatoshi(scaleMsat),
+			BimodalDecayTime:  time.Duration(decayTime),
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown estimator %q", estimatorKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
@@ -697,25 +698,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// if the cltv limit is MaxUint32.
 	absoluteCltvLimit := uint64(r.CltvLimit) + uint64(finalHtlcExpiry)
 
-	// Calculate the default attempt cost as configured globally.
-	defaultAttemptCost := float64(
-		cfg.AttemptCost +
-			amt*lnwire.MilliSatoshi(cfg.AttemptCostPPM)/1000000,
-	)
-
-	// Validate time preference value.
-	if math.Abs(timePref) > 1 {
-		return nil, 0, fmt.Errorf("time preference %v out of range "+
-			"[-1, 1]", timePref)
+	absoluteAttemptCost, err := getAttemptCost(cfg, amt, timePref)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	// Scale to avoid the extremes -1 and 1 which run into infinity issues.
-	timePref *= 0.9
-
-	// Apply time preference. At 0, the default attempt cost will
-	// be used.
-	absoluteAttemptCost := defaultAttemptCost * (1/(0.5-timePref/2) - 1)
-
 	log.Debugf("Pathfinding absolute attempt cost: %v sats",
 		absoluteAttemptCost/1000)
 
@@ -1150,6 +1137,10 @@ type blindedPathRestrictions struct {
 	// path. This doesn't include our node, so if the maximum is 1, then
 	// the path will contain our node along with an introduction node hop.
 	maxNumHops uint8
+
+	// nodeOmissionSet holds a set of nodes that must not appear on any of
+	// the blinded paths.
+	nodeOmissionSet fn.Set[route.Vertex]
 }
 
 // blindedHop holds the information about a hop we have selected for a blinded
@@ -1179,6 +1170,11 @@ func findBlindedPaths(g Graph, target route.Vertex,
 			restrictions.minNumHops)
 	}
 
+	if restrictions.nodeOmissionSet.Contains(target) {
+		return nil, fmt.Errorf("target node %v can't be omitted from "+
+			"its own blinded paths", target)
+	}
+
 	// If the node is not the destination node, then it is required that the
 	// node advertise the route blinding feature-bit in order for it to be
 	// chosen as a node on the blinded path.
@@ -1253,6 +1249,11 @@ func processNodeForBlindedPath(g Graph, node route.Vertex,
 		return nil, false, nil
 	}
 
+	// Nodes that the caller asked us to omit are never part of a path.
+	if restrictions.nodeOmissionSet.Contains(node) {
+		return nil, false, nil
+	}
+
 	supports, err := supportsRouteBlinding(node)
 	if err != nil {
 		return nil, false, err
@@ -1329,6 +1330,31 @@ func processNodeForBlindedPath(g Graph, node route.Vertex,
 	return hopSets, chanCount > 1, nil
 }
 
+// getAttemptCost returns the virtual cost of a failed attempt to send the given
+// amount, adjusted for the time preference of the payment.
+func getAttemptCost(cfg *PathFindingConfig, amt lnwire.MilliSatoshi,
+	timePref float64) (float64, error) {
+
+	// Calculate the default attempt cost as configured globally.
+	defaultAttemptCost := float64(
+		cfg.AttemptCost +
+			amt*lnwire.MilliSatoshi(cfg.AttemptCostPPM)/1000000,
+	)
+
+	// Validate time preference value.
+	if math.Abs(timePref) > 1 {
+		return 0, fmt.Errorf("time preference %v out of range "+
+			"[-1, 1]", timePref)
+	}
+
+	// Scale to avoid the extremes -1 and 1 which run into infinity issues.
+	timePref *= 0.9
+
+	// Apply time preference. At 0, the default attempt cost will
+	// be used.
+	return defaultAttemptCost * (1/(0.5-timePref/2) - 1), nil
+}
+
 // getProbabilityBasedDist converts a weight into a distance that takes into
 // account the success probability and the (virtual) cost of a failed payment
 // attempt.
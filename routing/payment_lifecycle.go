@@ -32,6 +32,10 @@ type paymentLifecycle struct {
 	shardTracker  shards.ShardTracker
 	currentHeight int32
 
+	// missionControl is the mission control that the results of the
+	// payment's attempts are reported to.
+	missionControl MissionController
+
 	// quit is closed to signal the sub goroutines of the payment lifecycle
 	// to stop.
 	quit chan struct{}
@@ -62,6 +66,7 @@ func newPaymentLifecycle(r *ChannelRouter, feeLimit lnwire.MilliSatoshi,
 		paySession:      paySession,
 		shardTracker:    shardTracker,
 		currentHeight:   currentHeight,
+		missionControl:  r.cfg.MissionControl,
 		quit:            make(chan struct{}),
 		resultCollected: make(chan error, 1),
 	}
@@ -548,7 +553,7 @@ func (p *paymentLifecycle) collectResult(attempt *channeldb.HTLCAttempt) (
 		p.identifier, attempt.AttemptID)
 
 	// Report success to mission control.
-	err = p.router.cfg.MissionControl.ReportPaymentSuccess(
+	err = p.missionControl.ReportPaymentSuccess(
 		attempt.AttemptID, &attempt.Route,
 	)
 	if err != nil {
@@ -762,7 +767,7 @@ func (p *paymentLifecycle) handleSwitchErr(attempt *channeldb.HTLCAttempt,
 		msg lnwire.FailureMessage) (*attemptResult, error) {
 
 		// Report outcome to mission control.
-		reason, err := p.router.cfg.MissionControl.ReportPaymentFail(
+		reason, err := p.missionControl.ReportPaymentFail(
 			attemptID, &attempt.Route, srcIdx, msg,
 		)
 		if err != nil {
@@ -2,6 +2,7 @@ package routing
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btclog"
@@ -176,6 +177,20 @@ type paymentSession struct {
 
 	pathFinder pathFinder
 
+	// flowFinder splits the payment across several paths at once if the
+	// payment asks for an optimal split.
+	flowFinder flowFinder
+
+	// pendingRoutes holds the routes of the last computed flow that
+	// haven't been handed out yet. They were built for pendingHeight, and
+	// carry pendingAmt in total.
+	pendingRoutes []*route.Route
+	pendingHeight uint32
+	pendingAmt    lnwire.MilliSatoshi
+
+	// flowMtx guards the pending routes.
+	flowMtx sync.Mutex
+
 	graphSessFactory GraphSessionFactory
 
 	// pathFindingConfig defines global parameters that control the
@@ -225,6 +240,7 @@ func newPaymentSession(p *LightningPayment, selfNode route.Vertex,
 		getBandwidthHints: getBandwidthHints,
 		payment:           p,
 		pathFinder:        findPath,
+		flowFinder:        findFlow,
 		graphSessFactory:  graphSessFactory,
 		pathFindingConfig: pathFindingConfig,
 		missionControl:    missionControl,
@@ -279,6 +295,22 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
 
+	// If the payment asks for an optimal split, we try to allocate the
+	// amount across several paths at once. On failure, we fall back to
+	// the halving splitter below.
+	if p.payment.OptimalSplit {
+		route, err := p.requestFlowRoute(
+			maxAmt, feeLimit, activeShards, height, restrictions,
+			finalCltvDelta,
+		)
+		if err == nil {
+			return route, nil
+		}
+
+		p.log.Debugf("no optimal split for amt=%v, falling back to "+
+			"splitting by halving: %v", maxAmt, err)
+	}
+
 	// Before we enter the loop below, we'll make sure to respect the max
 	// payment shard size (if it's set), which is effectively our
 	// client-side MTU that we'll attempt to respect at all times.
@@ -428,6 +460,159 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 	}
 }
 
+// canSplit returns whether the payment may be split across several htlcs,
+// given the number of htlcs that are already in flight.
+func (p *paymentSession) canSplit(activeShards uint32) bool {
+	// Payments to blinded paths are left to the halving splitter.
+	if p.payment.PaymentAddr == nil || p.payment.BlindedPathSet != nil {
+		return false
+	}
+
+	destFeatures := p.payment.DestFeatures
+	if destFeatures == nil {
+		return false
+	}
+
+	if !destFeatures.HasFeature(lnwire.MPPOptional) &&
+		!destFeatures.HasFeature(lnwire.AMPOptional) {
+
+		return false
+	}
+
+	return activeShards+1 < p.payment.MaxParts
+}
+
+// requestFlowRoute returns the next route of a min-cost flow that carries the
+// given amount to the target. The flow is computed once, and its routes are
+// handed out on subsequent calls. If an htlc of the flow failed in the
+// meantime, the remaining amount no longer matches the pending routes, and a
+// new flow is computed that takes the failure into account.
+func (p *paymentSession) requestFlowRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
+	activeShards, height uint32, restrictions *RestrictParams,
+	finalCltvDelta uint16) (*route.Route, error) {
+
+	p.flowMtx.Lock()
+	defer p.flowMtx.Unlock()
+
+	// Hand out the next route of the last flow if it still fits.
+	if len(p.pendingRoutes) > 0 && p.pendingHeight == height &&
+		p.pendingAmt == maxAmt {
+
+		rt := p.pendingRoutes[0]
+		if rt.TotalFees() <= feeLimit {
+			p.pendingRoutes = p.pendingRoutes[1:]
+			p.pendingAmt -= rt.ReceiverAmt()
+
+			return rt, nil
+		}
+	}
+	p.pendingRoutes = nil
+
+	if !p.canSplit(activeShards) {
+		return nil, errNoPathFound
+	}
+
+	// Each unit must be at least the minimum shard amount. If the amount
+	// doesn't allow for two units, there is nothing to split.
+	numUnits := int(min(maxAmt/p.minShardAmt, maxFlowUnits))
+	if numUnits < 2 {
+		return nil, errNoPathFound
+	}
+
+	graph, closeGraph, err := p.graphSessFactory.NewGraphSession()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := closeGraph(); err != nil {
+			log.Errorf("could not close graph session: %v", err)
+		}
+	}()
+
+	bandwidthHints, err := p.getBandwidthHints(graph)
+	if err != nil {
+		return nil, err
+	}
+
+	p.log.Debugf("computing min-cost flow for amt=%v in %v units", maxAmt,
+		numUnits)
+
+	paths, err := p.flowFinder(
+		&graphParams{
+			additionalEdges: p.additionalEdges,
+			bandwidthHints:  bandwidthHints,
+			graph:           graph,
+		},
+		restrictions, &p.pathFindingConfig, p.selfNode,
+		p.payment.Target, maxAmt, numUnits, p.payment.TimePref,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Turn the paths into routes, splitting paths that carry more than
+	// the max shard size over several routes.
+	var (
+		routes    []*route.Route
+		totalFees lnwire.MilliSatoshi
+	)
+	for _, path := range paths {
+		for remaining := path.amt; remaining > 0; {
+			amt := remaining
+			if p.payment.MaxShardAmt != nil &&
+				amt > *p.payment.MaxShardAmt {
+
+				amt = *p.payment.MaxShardAmt
+			}
+			remaining -= amt
+
+			rt, err := newRoute(
+				p.selfNode, path.edges, height,
+				finalHopParams{
+					amt:         amt,
+					totalAmt:    p.payment.Amount,
+					cltvDelta:   finalCltvDelta,
+					records:     p.payment.DestCustomRecords,
+					paymentAddr: p.payment.PaymentAddr,
+					metadata:    p.payment.Metadata,
+				}, nil,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			// Path finding enforces the cltv limit while searching,
+			// the flow is only checked once its routes are known.
+			if rt.TotalTimeLock > height+p.payment.CltvLimit {
+				return nil, fmt.Errorf("route time lock %v "+
+					"exceeds cltv limit", rt.TotalTimeLock)
+			}
+
+			routes = append(routes, rt)
+			totalFees += rt.TotalFees()
+		}
+	}
+
+	if totalFees > feeLimit {
+		return nil, fmt.Errorf("flow fees %v exceed fee limit %v",
+			totalFees, feeLimit)
+	}
+
+	if uint32(len(routes))+activeShards > p.payment.MaxParts {
+		return nil, fmt.Errorf("flow needs %v parts, exceeding max "+
+			"parts %v", len(routes), p.payment.MaxParts)
+	}
+
+	p.log.Debugf("min-cost flow split amt=%v over %v routes", maxAmt,
+		len(routes))
+
+	p.pendingRoutes = routes[1:]
+	p.pendingHeight = height
+	p.pendingAmt = maxAmt - routes[0].ReceiverAmt()
+
+	return routes[0], nil
+}
+
 // UpdateAdditionalEdge updates the channel edge policy for a private edge. It
 // validates the message signature and checks it's up to date, then applies the
 // updates to the supplied policy. It returns a boolean to indicate whether
@@ -58,9 +58,15 @@ func (m *SessionSource) NewPaymentSession(p *LightningPayment) (
 		)
 	}
 
+	// Use the mission control that the payment asks for, if any.
+	missionControl := m.MissionControl
+	if p.MissionControl != nil {
+		missionControl = p.MissionControl
+	}
+
 	session, err := newPaymentSession(
 		p, m.SourceNode.PubKeyBytes, getBandwidthHints,
-		m.GraphSessionFactory, m.MissionControl, m.PathFindingConfig,
+		m.GraphSessionFactory, missionControl, m.PathFindingConfig,
 	)
 	if err != nil {
 		return nil, err
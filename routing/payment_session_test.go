@@ -255,3 +255,107 @@ type sessionGraph struct {
 func (g *sessionGraph) sourceNode() route.Vertex {
 	return route.Vertex{}
 }
+
+// TestRequestRouteOptimalSplit tests that a payment that asks for an optimal
+// split hands out the routes of a single flow, and falls back to the halving
+// splitter if no flow is found.
+func TestRequestRouteOptimalSplit(t *testing.T) {
+	const height = 10
+
+	payment := &LightningPayment{
+		CltvLimit:      1000,
+		FinalCLTVDelta: 8,
+		Amount:         lnwire.NewMSatFromSatoshis(100_000),
+		FeeLimit:       1000,
+		MaxParts:       16,
+		PaymentAddr:    &[32]byte{1},
+		DestFeatures: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+				lnwire.MPPOptional,
+			), lnwire.Features,
+		),
+		OptimalSplit: true,
+	}
+	require.NoError(t, payment.SetPaymentHash([32]byte{}))
+
+	session, err := newPaymentSession(
+		payment, route.Vertex{},
+		func(Graph) (bandwidthHints, error) {
+			return &mockBandwidthHints{}, nil
+		},
+		newMockGraphSessionFactory(&sessionGraph{}),
+		&MissionControl{},
+		PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	// testPath returns a single hop path over the given channel.
+	testPath := func(chanID uint64) []*unifiedEdge {
+		return []*unifiedEdge{{
+			policy: &models.CachedEdgePolicy{
+				ChannelID: chanID,
+				ToNodePubKey: func() route.Vertex {
+					return route.Vertex{}
+				},
+				ToNodeFeatures: payment.DestFeatures,
+			},
+		}}
+	}
+
+	// The flow finder splits the amount over two channels.
+	var flows int
+	session.flowFinder = func(_ *graphParams, _ *RestrictParams,
+		_ *PathFindingConfig, _, _ route.Vertex,
+		amt lnwire.MilliSatoshi, numUnits int,
+		_ float64) ([]*flowPath, error) {
+
+		flows++
+		require.GreaterOrEqual(t, numUnits, 2)
+
+		return []*flowPath{
+			{edges: testPath(1), amt: amt * 3 / 4},
+			{edges: testPath(2), amt: amt - amt*3/4},
+		}, nil
+	}
+	session.pathFinder = func(_ *graphParams, _ *RestrictParams,
+		_ *PathFindingConfig, _, _, _ route.Vertex,
+		_ lnwire.MilliSatoshi, _ float64, _ int32) ([]*unifiedEdge,
+		float64, error) {
+
+		return testPath(3), 1, nil
+	}
+
+	amt := payment.Amount
+	rt, err := session.RequestRoute(amt, payment.FeeLimit, 0, height)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, rt.Hops[0].ChannelID)
+	require.Equal(t, amt*3/4, rt.ReceiverAmt())
+
+	// The second route is taken from the same flow.
+	amt -= rt.ReceiverAmt()
+	rt, err = session.RequestRoute(amt, payment.FeeLimit, 1, height)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, rt.Hops[0].ChannelID)
+	require.Equal(t, amt, rt.ReceiverAmt())
+	require.Equal(t, 1, flows)
+
+	// If the first shard fails, a new flow is computed for its amount.
+	amt = payment.Amount * 3 / 4
+	_, err = session.RequestRoute(amt, payment.FeeLimit, 1, height)
+	require.NoError(t, err)
+	require.Equal(t, 2, flows)
+
+	// If no flow is found, the path finder is used instead.
+	session.pendingRoutes = nil
+	session.flowFinder = func(*graphParams, *RestrictParams,
+		*PathFindingConfig, route.Vertex, route.Vertex,
+		lnwire.MilliSatoshi, int, float64) ([]*flowPath, error) {
+
+		return nil, errNoPathFound
+	}
+	rt, err = session.RequestRoute(amt, payment.FeeLimit, 0, height)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, rt.Hops[0].ChannelID)
+}
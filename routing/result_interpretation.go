@@ -267,6 +267,16 @@ func (i *interpretedResult) processPaymentOutcomeFinal(
 		// destination correctly. Continue the payment process.
 		i.successPairRange(route, 0, n-1)
 
+	// The trampoline node couldn't reach the destination within the
+	// budget that we gave it. The route to the trampoline node worked,
+	// but retrying with the same budget won't help, so fail the payment.
+	case *lnwire.FailTrampolineFeeInsufficient,
+		*lnwire.FailTrampolineExpiryTooSoon:
+
+		i.successPairRange(route, 0, n-1)
+
+		i.finalFailureReason = &reasonError
+
 	// We do not expect to receive an invalid blinding error from the final
 	// node in the route. This could erroneously happen in the following
 	// cases:
@@ -419,6 +419,23 @@ var resultTestCases = []resultTestCase{
 		},
 	},
 
+	// Tests that a trampoline node that can't forward a payment within its
+	// budget fails the payment without being penalized.
+	{
+		name:          "two hop trampoline fee insufficient",
+		route:         &routeTwoHop,
+		failureSrcIdx: 2,
+		failure:       &lnwire.FailTrampolineFeeInsufficient{},
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
+			},
+			finalFailureReason: &reasonError,
+		},
+	},
+
 	// Test a channel disabled failure from the final hop in two hops. Only the
 	// disabled channel should be penalized for any amount.
 	{
@@ -406,7 +406,7 @@ func (r *ChannelRouter) Start() error {
 			_, _, err := r.sendPayment(
 				context.Background(), 0,
 				payment.Info.PaymentIdentifier, noTimeout,
-				paySession, shardTracker,
+				paySession, shardTracker, r.cfg.MissionControl,
 			)
 			if err != nil {
 				log.Errorf("Resuming payment %v failed: %v.",
@@ -663,6 +663,15 @@ type BlindedPathRestrictions struct {
 
 	// MaxNumPaths is the maximum number of blinded paths to select.
 	MaxNumPaths uint8
+
+	// NodeOmissionSet is a set of nodes that should not be used within any
+	// of the blinded paths.
+	NodeOmissionSet fn.Set[route.Vertex]
+
+	// IntroNodeSet restricts the introduction nodes of the blinded paths
+	// to the given nodes. If it is empty, any node may be used as an
+	// introduction node.
+	IntroNodeSet fn.Set[route.Vertex]
 }
 
 // FindBlindedPaths finds a selection of paths to the destination node that can
@@ -675,8 +684,9 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 	// path length restrictions.
 	paths, err := findBlindedPaths(
 		r.cfg.RoutingGraph, destination, &blindedPathRestrictions{
-			minNumHops: restrictions.MinDistanceFromIntroNode,
-			maxNumHops: restrictions.NumHops,
+			minNumHops:      restrictions.MinDistanceFromIntroNode,
+			maxNumHops:      restrictions.NumHops,
+			nodeOmissionSet: restrictions.NodeOmissionSet,
 		},
 	)
 	if err != nil {
@@ -709,6 +719,14 @@ func (r *ChannelRouter) FindBlindedPaths(destination route.Vertex,
 			totalRouteProbability = float64(1)
 		)
 
+		// Skip the path if its introduction node is not one of the
+		// nodes that the caller allows.
+		if len(restrictions.IntroNodeSet) > 0 &&
+			!restrictions.IntroNodeSet.Contains(introNode) {
+
+			continue
+		}
+
 		// For each set of hops on the path, get the success probability
 		// of a forward between those two vertices and use that to
 		// update the overall route probability.
@@ -940,9 +958,27 @@ type LightningPayment struct {
 	// value in between for a mix.
 	TimePref float64
 
+	// OptimalSplit indicates that a payment that needs to be split is
+	// allocated across several paths at once by computing a min-cost flow,
+	// rather than by repeatedly halving the amount of a single path. The
+	// halving splitter is used as a fallback if no flow is found.
+	OptimalSplit bool
+
+	// MissionControl is the mission control that the payment takes its
+	// probability estimates from and reports its results to. This allows
+	// payments to keep their history separate from other payments by
+	// using the mission control of a different namespace. If nil, the
+	// mission control of the router is used.
+	//
+	// NOTE: This field is _optional_.
+	MissionControl MissionController
+
 	// Metadata is additional data that is sent along with the payment to
 	// the payee.
 	Metadata []byte
+
+	// PaymentType is the type that the payment is recorded with.
+	PaymentType channeldb.PaymentType
 }
 
 // AMPOptions houses information that must be known in order to send an AMP
@@ -1006,6 +1042,7 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	return r.sendPayment(
 		context.Background(), payment.FeeLimit, payment.Identifier(),
 		payment.PayAttemptTimeout, paySession, shardTracker,
+		r.paymentMissionControl(payment),
 	)
 }
 
@@ -1026,6 +1063,7 @@ func (r *ChannelRouter) SendPaymentAsync(ctx context.Context,
 		_, _, err := r.sendPayment(
 			ctx, payment.FeeLimit, payment.Identifier(),
 			payment.PayAttemptTimeout, ps, st,
+			r.paymentMissionControl(payment),
 		)
 		if err != nil {
 			log.Errorf("Payment %x failed: %v",
@@ -1051,10 +1089,27 @@ func spewPayment(payment *LightningPayment) lnutils.LogClosure {
 		}
 		p := *payment
 		p.RouteHints = routeHints
+
+		// Don't spew the state of the mission control that the
+		// payment uses.
+		p.MissionControl = nil
+
 		return spew.Sdump(p)
 	})
 }
 
+// paymentMissionControl returns the mission control that the given payment
+// reports its results to.
+func (r *ChannelRouter) paymentMissionControl(
+	payment *LightningPayment) MissionController {
+
+	if payment.MissionControl != nil {
+		return payment.MissionControl
+	}
+
+	return r.cfg.MissionControl
+}
+
 // PreparePayment creates the payment session and registers the payment with the
 // control tower.
 func (r *ChannelRouter) PreparePayment(payment *LightningPayment) (
@@ -1077,6 +1132,7 @@ func (r *ChannelRouter) PreparePayment(payment *LightningPayment) (
 		Value:             payment.Amount,
 		CreationTime:      r.cfg.Clock.Now(),
 		PaymentRequest:    payment.PaymentRequest,
+		Type:              payment.PaymentType,
 	}
 
 	// Create a new ShardTracker that we'll use during the life cycle of
@@ -1289,10 +1345,14 @@ func (r *ChannelRouter) sendToRoute(htlcHash lntypes.Hash, rt *route.Route,
 // carry out its execution. After restarts, it is safe, and assumed, that the
 // router will call this method for every payment still in-flight according to
 // the ControlTower.
+//
+// The results of the payment's attempts are reported to the given mission
+// control.
 func (r *ChannelRouter) sendPayment(ctx context.Context,
 	feeLimit lnwire.MilliSatoshi, identifier lntypes.Hash,
 	paymentAttemptTimeout time.Duration, paySession PaymentSession,
-	shardTracker shards.ShardTracker) ([32]byte, *route.Route, error) {
+	shardTracker shards.ShardTracker,
+	missionControl MissionController) ([32]byte, *route.Route, error) {
 
 	// If the user provides a timeout, we will additionally wrap the context
 	// in a deadline.
@@ -1319,6 +1379,7 @@ func (r *ChannelRouter) sendPayment(ctx context.Context,
 		r, feeLimit, identifier, paySession, shardTracker,
 		currentHeight,
 	)
+	p.missionControl = missionControl
 
 	return p.resumePayment(ctx)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/graph"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
@@ -2819,4 +2820,45 @@ func TestFindBlindedPathsWithMC(t *testing.T) {
 		"alice,bob,dave",
 		"alice,frank,dave",
 	})
+
+	// Nodes in the omission set are not used on any path.
+	routes, err = ctx.router.FindBlindedPaths(
+		dave, 1000, probabilitySrc, &BlindedPathRestrictions{
+			MinDistanceFromIntroNode: 2,
+			NumHops:                  2,
+			MaxNumPaths:              3,
+			NodeOmissionSet:          fn.NewSet(bob),
+		},
+	)
+	require.NoError(t, err)
+	assertPaths(routes, []string{
+		"alice,frank,dave",
+	})
+
+	// The destination itself can't be omitted.
+	_, err = ctx.router.FindBlindedPaths(
+		dave, 1000, probabilitySrc, &BlindedPathRestrictions{
+			MinDistanceFromIntroNode: 2,
+			NumHops:                  2,
+			MaxNumPaths:              3,
+			NodeOmissionSet:          fn.NewSet(dave),
+		},
+	)
+	require.Error(t, err)
+
+	// Finally, restrict the introduction nodes to our direct peers bob
+	// and frank.
+	routes, err = ctx.router.FindBlindedPaths(
+		dave, 1000, probabilitySrc, &BlindedPathRestrictions{
+			MinDistanceFromIntroNode: 1,
+			NumHops:                  2,
+			MaxNumPaths:              3,
+			IntroNodeSet:             fn.NewSet(bob, frank),
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	for _, r := range routes {
+		require.Contains(t, []route.Vertex{bob, frank}, r.SourcePubKey)
+	}
 }
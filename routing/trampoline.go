@@ -0,0 +1,136 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/trampoline"
+)
+
+// TrampolineOptions describes the trampoline node that a payment is routed
+// through, and the budget that the trampoline node may spend to reach the
+// destination.
+type TrampolineOptions struct {
+	// Node is the trampoline node. We only need to find a route to this
+	// node, the trampoline node finds the route to the destination.
+	Node route.Vertex
+
+	// Fee is the fee that the trampoline node may spend on its own fee
+	// and the routes to the destination.
+	Fee lnwire.MilliSatoshi
+
+	// CltvDelta is the number of blocks that the trampoline node may
+	// spend on its own CLTV delta and the routes to the destination.
+	CltvDelta uint16
+}
+
+// SetTrampoline turns the payment into a trampoline payment. The payment is
+// rewritten to pay the trampoline node, with an inner trampoline onion that
+// tells the trampoline node where to forward the payment to. The trampoline
+// fee is taken from the fee limit of the payment. The payment hash must be
+// set before, as the trampoline onion commits to it.
+func (l *LightningPayment) SetTrampoline(opts *TrampolineOptions,
+	currentHeight uint32) error {
+
+	switch {
+	case l.paymentHash == nil:
+		return errors.New("trampoline payments need a payment hash")
+
+	case l.amp != nil:
+		return errors.New("trampoline payments can't be AMP payments")
+
+	case l.BlindedPathSet != nil:
+		return errors.New("trampoline payments can't use blinded " +
+			"paths")
+
+	case l.LastHop != nil:
+		return errors.New("trampoline payments can't restrict the " +
+			"last hop")
+
+	case len(l.DestCustomRecords) > 0:
+		return errors.New("trampoline payments can't carry custom " +
+			"records")
+
+	case len(l.Metadata) > 0:
+		return errors.New("trampoline payments can't carry payment " +
+			"metadata")
+
+	case l.Target == opts.Node:
+		return errors.New("trampoline node is the destination")
+
+	case opts.CltvDelta > math.MaxUint16-l.FinalCLTVDelta:
+		return errors.New("trampoline cltv delta too large")
+
+	case opts.Fee > l.FeeLimit:
+		return fmt.Errorf("trampoline fee %v exceeds fee limit %v",
+			opts.Fee, l.FeeLimit)
+	}
+
+	payload := &trampoline.Payload{
+		AmtToForward: l.Amount,
+		OutgoingCLTV: currentHeight + uint32(l.FinalCLTVDelta),
+		OutgoingNode: l.Target,
+		RouteHints:   l.RouteHints,
+	}
+	if l.PaymentAddr != nil {
+		payload.MPP = record.NewMPP(l.Amount, *l.PaymentAddr)
+	}
+	if l.DestFeatures != nil {
+		payload.InvoiceFeatures = l.DestFeatures.RawFeatureVector
+	}
+
+	encoded, err := payload.Encode()
+	if err != nil {
+		return err
+	}
+
+	nodeKey, err := btcec.ParsePubKey(opts.Node[:])
+	if err != nil {
+		return err
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	onion, err := trampoline.BuildOnion(sessionKey, []trampoline.Hop{{
+		NodeKey: nodeKey,
+		Payload: encoded,
+	}}, l.paymentHash[:])
+	if err != nil {
+		return fmt.Errorf("unable to build trampoline onion: %w", err)
+	}
+
+	// The trampoline node collects all parts of the payment under a
+	// payment address that is only used for this payment.
+	var addr [32]byte
+	if _, err := rand.Read(addr[:]); err != nil {
+		return err
+	}
+
+	l.Target = opts.Node
+	l.Amount += opts.Fee
+	l.FeeLimit -= opts.Fee
+	l.FinalCLTVDelta += opts.CltvDelta
+	l.PaymentAddr = &addr
+	l.RouteHints = nil
+	l.DestFeatures = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+			lnwire.MPPOptional,
+		), lnwire.Features,
+	)
+	l.DestCustomRecords = record.CustomSet{
+		trampoline.OnionRecordType: onion,
+	}
+
+	return nil
+}
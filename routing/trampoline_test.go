@@ -0,0 +1,77 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/trampoline"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSetTrampoline tests that a payment is rewritten to pay the trampoline
+// node, which can peel the trampoline onion to learn the destination.
+func TestSetTrampoline(t *testing.T) {
+	t.Parallel()
+
+	trampolineKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	trampolineNode := route.NewVertex(trampolineKey.PubKey())
+
+	hash := lntypes.Hash{1}
+	addr := [32]byte{2}
+	payment := &LightningPayment{
+		Target:         route.Vertex{3},
+		Amount:         100_000,
+		FeeLimit:       8000,
+		FinalCLTVDelta: 40,
+		PaymentAddr:    &addr,
+		DestFeatures: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(lnwire.PaymentAddrRequired),
+			lnwire.Features,
+		),
+	}
+
+	opts := &TrampolineOptions{
+		Node:      trampolineNode,
+		Fee:       5000,
+		CltvDelta: 200,
+	}
+
+	// The trampoline onion commits to the payment hash, so it must be
+	// known upfront.
+	require.Error(t, payment.SetTrampoline(opts, 1000))
+
+	require.NoError(t, payment.SetPaymentHash(hash))
+	require.NoError(t, payment.SetTrampoline(opts, 1000))
+
+	require.Equal(t, trampolineNode, payment.Target)
+	require.EqualValues(t, 105_000, payment.Amount)
+	require.EqualValues(t, 3000, payment.FeeLimit)
+	require.EqualValues(t, 240, payment.FinalCLTVDelta)
+	require.NotEqual(t, addr, *payment.PaymentAddr)
+	require.True(t, payment.DestFeatures.HasFeature(lnwire.MPPOptional))
+
+	onion := payment.DestCustomRecords[trampoline.OnionRecordType]
+	peeled, err := trampoline.PeelOnion(
+		&keychain.PrivKeyECDH{PrivKey: trampolineKey}, onion, hash[:],
+	)
+	require.NoError(t, err)
+	require.True(t, peeled.IsFinal)
+
+	payload, err := trampoline.DecodePayload(peeled.Payload)
+	require.NoError(t, err)
+	require.Equal(t, route.Vertex{3}, payload.OutgoingNode)
+	require.EqualValues(t, 100_000, payload.AmtToForward)
+	require.EqualValues(t, 1040, payload.OutgoingCLTV)
+	require.Equal(t, addr, payload.MPP.PaymentAddr())
+	require.True(t, payload.InvoiceFeatures.IsSet(
+		lnwire.PaymentAddrRequired,
+	))
+
+	// A payment can't be routed through the trampoline node twice.
+	require.Error(t, payment.SetTrampoline(opts, 1000))
+}
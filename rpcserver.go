@@ -3,6 +3,7 @@ package lnd
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -71,11 +72,12 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/onionmessage"
 	"github.com/lightningnetwork/lnd/peer"
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing"
-	"github.com/lightningnetwork/lnd/routing/blindedpath"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/rpcperms"
 	"github.com/lightningnetwork/lnd/signal"
@@ -95,6 +97,10 @@ const (
 	// to issuing an estimate for if a fee pre fence doesn't specify an
 	// explicit conf target or fee rate.
 	defaultNumBlocksEstimate = 6
+
+	// peerBackupTimeout is the time we wait for a peer to hand back the
+	// channel backup it stores for us when restoring from a peer.
+	peerBackupTimeout = time.Minute
 )
 
 var (
@@ -494,6 +500,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/UpdateChannelParams": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ForwardingHistory": {{
 			Entity: "offchain",
 			Action: "read",
@@ -518,6 +528,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/BackupSinkStatus": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ChannelAcceptor": {{
 			Entity: "onchain",
 			Action: "write",
@@ -568,6 +582,18 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SendOnionMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeOnionMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/AddOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/LookupHtlcResolution": {{
 			Entity: "offchain",
 			Action: "read",
@@ -731,6 +757,49 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 		},
 		SetChannelAuto:     s.chanStatusMgr.RequestAuto,
 		UseStatusInitiated: subServerCgs.RouterRPC.UseStatusInitiated,
+		FetchMissionControl: func(namespace string, create bool) (
+			routerrpc.MissionControl, error) {
+
+			if create {
+				return s.missionControls.
+					FetchOrCreateNamespacedStore(namespace)
+			}
+
+			return s.missionControls.GetNamespacedStore(namespace)
+		},
+		DeleteMissionControl: s.missionControls.DeleteNamespace,
+		BestHeight: func() (uint32, error) {
+			_, height, err := s.cc.ChainIO.GetBestBlock()
+			if err != nil {
+				return 0, err
+			}
+
+			return uint32(height), nil
+		},
+		AddInvoice: func(ctx context.Context, amt lnwire.MilliSatoshi,
+			memo string) (*lntypes.Hash, *invoices.Invoice, error) {
+
+			return invoicesrpc.AddInvoice(
+				ctx, s.addInvoiceConfig(false),
+				&invoicesrpc.AddInvoiceData{
+					Memo:  memo,
+					Value: amt,
+				},
+			)
+		},
+		FetchOpenChannels: s.chanStateDB.FetchAllOpenChannels,
+	}
+
+	// Invoices for offers are requested with onion messages, so paying
+	// offers is only possible if the offers manager is running.
+	if s.offersManager != nil {
+		routerBackend.FetchOfferInvoice = s.offersManager.RequestInvoice
+	}
+
+	// The fee autopilot is only assigned if it's active, so that the
+	// interface stays nil otherwise.
+	if s.feeAutopilot != nil {
+		routerBackend.FeeAutopilot = s.feeAutopilot
 	}
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
@@ -756,12 +825,13 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 	// TODO(roasbeef): extend sub-sever config to have both (local vs remote) DB
 	err = subServerCgs.PopulateDependencies(
 		r.cfg, s.cc, r.cfg.networkDir, macService, atpl, invoiceRegistry,
-		s.htlcSwitch, r.cfg.ActiveNetParams.Params, s.chanRouter,
+		s.invoiceHtlcModifier, s.htlcSwitch,
+		r.cfg.ActiveNetParams.Params, s.chanRouter,
 		routerBackend, s.nodeSigner, s.graphDB, s.chanStateDB,
 		s.sweeper, tower, s.towerClientMgr, r.cfg.net.ResolveTCPAddr,
 		genInvoiceFeatures, genAmpInvoiceFeatures,
 		s.getNodeAnnouncement, s.updateAndBrodcastSelfNode, parseAddr,
-		rpcsLog, s.aliasMgr.GetPeerAlias,
+		rpcsLog, s.aliasMgr.GetPeerAlias, s.spliceChannel,
 	)
 	if err != nil {
 		return err
@@ -2589,6 +2659,11 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		return fmt.Errorf("force closing a channel uses a pre-defined fee")
 	}
 
+	// Fee bumping only applies to an ongoing cooperative close.
+	if in.Force && in.Bump {
+		return fmt.Errorf("cannot fee bump a force close")
+	}
+
 	force := in.Force
 	index := in.ChannelPoint.OutputIndex
 	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
@@ -2689,6 +2764,59 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 					Success:     true,
 				}
 			})
+	} else if in.Bump {
+		// A fee bump replaces the closing transaction of an ongoing
+		// RBF co-op close, so the co-op close must have already been
+		// kicked off.
+		if !channel.HasChanStatus(channeldb.ChanStatusCoopBroadcasted) {
+			return fmt.Errorf("no co-op close in progress for "+
+				"ChannelPoint(%v)", chanPoint)
+		}
+
+		// As the link has already been torn down at this point, we'll
+		// deliver the request to the peer directly.
+		remotePeer, err := r.server.FindPeer(channel.IdentityPub)
+		if err != nil {
+			return fmt.Errorf("unable to bump co-op close fee "+
+				"while peer is offline: %w", err)
+		}
+
+		// Only the closing transaction of an RBF co-op close can be
+		// replaced. We return a distinct status code otherwise, so
+		// the caller knows to fall back to CPFP.
+		if !remotePeer.UsesRbfCoopClose(channel.ChanType) {
+			return status.Errorf(codes.FailedPrecondition,
+				"ChannelPoint(%v) isn't closed with the RBF "+
+					"co-op close flow", chanPoint)
+		}
+
+		targetConf := maybeUseDefaultConf(
+			in.SatPerByte, in.SatPerVbyte, uint32(in.TargetConf),
+		)
+		feeRate, err := lnrpc.CalculateFeeRate(
+			uint64(in.SatPerByte), in.SatPerVbyte, // nolint:staticcheck
+			targetConf, r.server.cc.FeeEstimator,
+		)
+		if err != nil {
+			return err
+		}
+
+		rpcsLog.Debugf("Bumping closing transaction of "+
+			"ChannelPoint(%v) to %v sat/kw", chanPoint,
+			int64(feeRate))
+
+		updateChan = make(chan interface{}, 2)
+		errChan = make(chan error, 1)
+		remotePeer.HandleLocalCloseChanReqs(&htlcswitch.ChanClose{
+			CloseType:      contractcourt.CloseRegular,
+			ChanPoint:      chanPoint,
+			TargetFeePerKw: feeRate,
+			MaxFee: chainfee.SatPerKVByte(
+				in.MaxFeePerVbyte * 1000,
+			).FeePerKWeight(),
+			Updates: updateChan,
+			Err:     errChan,
+		})
 	} else {
 		// If this is a frozen channel, then we only allow the co-op
 		// close to proceed if we were the responder to this channel if
@@ -2867,7 +2995,7 @@ func createRPCCloseUpdate(update interface{}) (
 // abandonChanFromGraph attempts to remove a channel from the channel graph. If
 // we can't find the chanID in the graph, then we assume it has already been
 // removed, and will return a nop.
-func abandonChanFromGraph(chanGraph *channeldb.ChannelGraph,
+func abandonChanFromGraph(chanGraph channeldb.GraphStore,
 	chanPoint *wire.OutPoint) error {
 
 	// First, we'll obtain the channel ID. If we can't locate this, then
@@ -4910,6 +5038,23 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 					},
 				}
 
+			// A spliced channel is reported as opened on its new
+			// funding output.
+			case channelnotifier.SplicedChannelEvent:
+				channel, err := createRPCOpenChannel(
+					r, event.Channel, true, false,
+				)
+				if err != nil {
+					return err
+				}
+
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_OPEN_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_OpenChannel{
+						OpenChannel: channel,
+					},
+				}
+
 			case channelnotifier.ClosedChannelEvent:
 				closedChannel, err := r.createRPCClosedChannel(
 					event.CloseSummary,
@@ -5760,75 +5905,7 @@ func (r *rpcServer) sendPaymentSync(
 func (r *rpcServer) AddInvoice(ctx context.Context,
 	invoice *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
 
-	defaultDelta := r.cfg.Bitcoin.TimeLockDelta
-
-	blindingRestrictions := &routing.BlindedPathRestrictions{
-		MinDistanceFromIntroNode: r.server.cfg.Routing.BlindedPaths.
-			MinNumRealHops,
-		NumHops:     r.server.cfg.Routing.BlindedPaths.NumHops,
-		MaxNumPaths: r.server.cfg.Routing.BlindedPaths.MaxNumPaths,
-	}
-
-	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
-		AddInvoice:        r.server.invoices.AddInvoice,
-		IsChannelActive:   r.server.htlcSwitch.HasActiveLink,
-		ChainParams:       r.cfg.ActiveNetParams.Params,
-		NodeSigner:        r.server.nodeSigner,
-		DefaultCLTVExpiry: defaultDelta,
-		ChanDB:            r.server.chanStateDB,
-		Graph:             r.server.graphDB,
-		GenInvoiceFeatures: func() *lnwire.FeatureVector {
-			v := r.server.featureMgr.Get(feature.SetInvoice)
-
-			if invoice.Blind {
-				// If an invoice includes blinded paths, then a
-				// payment address is not required since we use
-				// the PathID in the final hop's encrypted data
-				// as equivalent to the payment address
-				v.Unset(lnwire.PaymentAddrRequired)
-				v.Set(lnwire.PaymentAddrOptional)
-
-				// The invoice payer will also need to
-				// understand the new BOLT 11 tagged field
-				// containing the blinded path, so we switch
-				// the bit to required.
-				v.Unset(lnwire.Bolt11BlindedPathsOptional)
-				v.Set(lnwire.Bolt11BlindedPathsRequired)
-			}
-
-			return v
-		},
-		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoiceAmp)
-		},
-		GetAlias:   r.server.aliasMgr.GetPeerAlias,
-		BestHeight: r.server.cc.BestBlockTracker.BestHeight,
-		BlindedRoutePolicyIncrMultiplier: r.server.cfg.Routing.
-			BlindedPaths.PolicyIncreaseMultiplier,
-		BlindedRoutePolicyDecrMultiplier: r.server.cfg.Routing.
-			BlindedPaths.PolicyDecreaseMultiplier,
-		QueryBlindedRoutes: func(amt lnwire.MilliSatoshi) (
-			[]*route.Route, error) {
-
-			return r.server.chanRouter.FindBlindedPaths(
-				r.selfNode, amt,
-				r.server.missionControl.GetProbability,
-				blindingRestrictions,
-			)
-		},
-		MinNumBlindedPathHops: r.server.cfg.Routing.BlindedPaths.
-			NumHops,
-		DefaultDummyHopPolicy: &blindedpath.BlindedHopPolicy{
-			CLTVExpiryDelta: uint16(defaultDelta),
-			FeeRate:         uint32(r.server.cfg.Bitcoin.FeeRate),
-			BaseFee:         r.server.cfg.Bitcoin.BaseFee,
-			MinHTLCMsat:     r.server.cfg.Bitcoin.MinHTLCIn,
-
-			// MaxHTLCMsat will be calculated on the fly by using
-			// the introduction node's channel's capacities.
-			MaxHTLCMsat: 0,
-		},
-	}
+	addInvoiceCfg := r.server.addInvoiceConfig(invoice.Blind)
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
 	if err != nil {
@@ -5853,6 +5930,16 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Blind:           invoice.Blind,
 	}
 
+	if invoice.BlindedPathConfig != nil {
+		pathCfg, err := invoicesrpc.UnmarshalBlindedPathConfig(
+			invoice.BlindedPathConfig,
+		)
+		if err != nil {
+			return nil, err
+		}
+		addInvoiceData.BlindedPathCfg = pathCfg
+	}
+
 	if invoice.RPreimage != nil {
 		preimage, err := lntypes.MakePreimage(invoice.RPreimage)
 		if err != nil {
@@ -6858,6 +6945,19 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		}
 	}
 
+	if req.MinAmountMsat < 0 || req.MaxAmountMsat < 0 {
+		return nil, fmt.Errorf("amounts must not be negative")
+	}
+	if req.MaxAmountMsat != 0 && req.MinAmountMsat > req.MaxAmountMsat {
+		return nil, fmt.Errorf("min amount(%v) must not exceed max "+
+			"amount(%v)", req.MinAmountMsat, req.MaxAmountMsat)
+	}
+
+	status, err := unmarshallPaymentStatus(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
 	query := channeldb.PaymentsQuery{
 		IndexOffset:       req.IndexOffset,
 		MaxPayments:       req.MaxPayments,
@@ -6866,6 +6966,17 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		CountTotal:        req.CountTotalPayments,
 		CreationDateStart: int64(req.CreationDateStart),
 		CreationDateEnd:   int64(req.CreationDateEnd),
+		Status:            status,
+		MinAmount:         lnwire.MilliSatoshi(req.MinAmountMsat),
+		MaxAmount:         lnwire.MilliSatoshi(req.MaxAmountMsat),
+	}
+
+	if len(req.Destination) > 0 {
+		dest, err := route.NewVertexFromBytes(req.Destination)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination: %w", err)
+		}
+		query.Destination = &dest
 	}
 
 	// If the maximum number of payments wasn't specified, then we'll
@@ -6874,7 +6985,7 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		query.MaxPayments = math.MaxUint64
 	}
 
-	paymentsQuerySlice, err := r.server.miscDB.QueryPayments(query)
+	paymentsQuerySlice, err := r.server.paymentsDB.QueryPayments(query)
 	if err != nil {
 		return nil, err
 	}
@@ -6901,6 +7012,34 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 	return paymentsResp, nil
 }
 
+// unmarshallPaymentStatus converts the payment status of a payments query to
+// the status of the payments database. The unknown status maps to the zero
+// status, which doesn't filter by status.
+func unmarshallPaymentStatus(status lnrpc.Payment_PaymentStatus) (
+	channeldb.PaymentStatus, error) {
+
+	switch status {
+	//nolint:staticcheck
+	case lnrpc.Payment_UNKNOWN:
+		return 0, nil
+
+	case lnrpc.Payment_INITIATED:
+		return channeldb.StatusInitiated, nil
+
+	case lnrpc.Payment_IN_FLIGHT:
+		return channeldb.StatusInFlight, nil
+
+	case lnrpc.Payment_SUCCEEDED:
+		return channeldb.StatusSucceeded, nil
+
+	case lnrpc.Payment_FAILED:
+		return channeldb.StatusFailed, nil
+
+	default:
+		return 0, fmt.Errorf("unknown payment status %v", status)
+	}
+}
+
 // DeletePayment deletes a payment from the DB given its payment hash. If
 // failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
 // deleted.
@@ -6916,7 +7055,7 @@ func (r *rpcServer) DeletePayment(ctx context.Context,
 	rpcsLog.Infof("[DeletePayment] payment_identifier=%v, "+
 		"failed_htlcs_only=%v", hash, req.FailedHtlcsOnly)
 
-	err = r.server.miscDB.DeletePayment(hash, req.FailedHtlcsOnly)
+	err = r.server.paymentsDB.DeletePayment(hash, req.FailedHtlcsOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -6954,7 +7093,7 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 		"failed_htlcs_only=%v", req.FailedPaymentsOnly,
 		req.FailedHtlcsOnly)
 
-	err := r.server.miscDB.DeletePayments(
+	err := r.server.paymentsDB.DeletePayments(
 		req.FailedPaymentsOnly, req.FailedHtlcsOnly,
 	)
 	if err != nil {
@@ -7376,6 +7515,144 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	}, nil
 }
 
+// UpdateChannelParams updates the parameters of an active channel without
+// closing it, by running a dynamic commitment negotiation with the remote
+// peer.
+func (r *rpcServer) UpdateChannelParams(ctx context.Context,
+	req *lnrpc.UpdateChannelParamsRequest) (
+	*lnrpc.UpdateChannelParamsResponse, error) {
+
+	if req.ChanPoint == nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"chan_point must be set")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: req.ChanPoint.OutputIndex,
+	}
+
+	if req.MaxAcceptedHtlcs > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"max_accepted_htlcs of %v is too large",
+			req.MaxAcceptedHtlcs)
+	}
+	if req.CsvDelay > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"csv_delay of %v is too large", req.CsvDelay)
+	}
+
+	var params htlcswitch.DynCommitParams
+	if req.DustLimitSat != 0 {
+		params.DustLimit = fn.Some(btcutil.Amount(req.DustLimitSat))
+	}
+	if req.MaxValueInFlightMsat != 0 {
+		params.MaxValueInFlight = fn.Some(
+			lnwire.MilliSatoshi(req.MaxValueInFlightMsat),
+		)
+	}
+	if req.ChanReserveSat != 0 {
+		params.ChannelReserve = fn.Some(
+			btcutil.Amount(req.ChanReserveSat),
+		)
+	}
+	if req.MaxAcceptedHtlcs != 0 {
+		params.MaxAcceptedHTLCs = fn.Some(uint16(req.MaxAcceptedHtlcs))
+	}
+	if req.CsvDelay != 0 {
+		params.CsvDelay = fn.Some(uint16(req.CsvDelay))
+	}
+
+	switch req.CommitmentType {
+	case lnrpc.CommitmentType_UNKNOWN_COMMITMENT_TYPE:
+		if req.KickoffSatPerVbyte != 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"kickoff_sat_per_vbyte requires commitment_type")
+		}
+
+	case lnrpc.CommitmentType_SIMPLE_TAPROOT:
+		// If no fee rate is set, the kickoff transaction targets the
+		// default number of blocks.
+		var targetConf uint32
+		if req.KickoffSatPerVbyte == 0 {
+			targetConf = defaultNumBlocksEstimate
+		}
+
+		feeRate, err := lnrpc.CalculateFeeRate(
+			0, req.KickoffSatPerVbyte, targetConf,
+			r.server.cc.FeeEstimator,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		params.CommitmentType = fn.Some[lnwallet.CommitmentType](
+			lnwallet.CommitmentTypeSimpleTaproot,
+		)
+		params.KickoffFeeRate = fn.Some(feeRate)
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unable to upgrade to commitment type %v",
+			req.CommitmentType)
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+	link, err := r.server.htlcSwitch.GetLink(chanID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find active link for "+
+			"channel %v: %w", chanPoint, err)
+	}
+
+	rpcsLog.Infof("[updatechanparams] proposing new parameters for "+
+		"channel %v", chanPoint)
+
+	select {
+	case err := <-link.ProposeDynCommitment(params):
+		if err != nil {
+			return nil, err
+		}
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+
+	dbChan, err := r.server.chanStateDB.FetchChannel(nil, chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.UpdateChannelParamsResponse{
+		LocalConstraints: createChannelConstraint(
+			&dbChan.LocalChanCfg,
+		),
+		RemoteConstraints: createChannelConstraint(
+			&dbChan.RemoteChanCfg,
+		),
+	}
+
+	// An upgrade of the commitment type is pending until its kickoff
+	// transaction confirms.
+	splices, err := dbChan.PendingSplices()
+	if err != nil {
+		return nil, err
+	}
+	for _, splice := range splices {
+		if splice.IsUpgrade() {
+			resp.KickoffTxid = splice.FundingTx.TxHash().String()
+		}
+	}
+
+	return resp, nil
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -7757,8 +8034,9 @@ func (r *rpcServer) RestoreChannelBackups(ctx context.Context,
 		chainArb:   r.server.chainArb,
 	}
 
-	// We'll accept either a list of Single backups, or a single Multi
-	// backup which contains several single backups.
+	// We'll accept either a list of Single backups, a single Multi backup
+	// which contains several single backups, or the time of a Multi
+	// backup in the backup archive.
 	switch {
 	case in.GetChanBackups() != nil:
 		chanBackupsProtos := in.GetChanBackups()
@@ -7801,11 +8079,118 @@ func (r *rpcServer) RestoreChannelBackups(ctx context.Context,
 			return nil, fmt.Errorf("unable to unpack chan "+
 				"backup: %v", err)
 		}
+
+	case isArchiveRestore(in):
+		if r.server.backupArchive == nil {
+			return nil, fmt.Errorf("channel backup archive is " +
+				"disabled")
+		}
+
+		// We'll restore the newest generation that was archived at
+		// or before the requested time, just like a regular multi
+		// backup.
+		archiveTime := time.Unix(in.GetArchiveTime(), 0)
+		archived, err := r.server.backupArchive.At(archiveTime)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Infof("Restoring channel backup generation %v "+
+			"archived at %v", archived.Generation,
+			archived.Timestamp)
+
+		err = chanbackup.UnpackAndRecoverMulti(
+			archived.Backup, r.server.cc.KeyRing, chanRestorer,
+			r.server,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unpack archived "+
+				"chan backup: %v", err)
+		}
+
+	case isPeerRestore(in):
+		packedMulti, err := r.retrievePeerBackup(
+			ctx, in.GetPeerAddress(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = chanbackup.UnpackAndRecoverMulti(
+			packedMulti, r.server.cc.KeyRing, chanRestorer,
+			r.server,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unpack chan backup "+
+				"retrieved from peer: %v", err)
+		}
 	}
 
 	return &lnrpc.RestoreBackupResponse{}, nil
 }
 
+// isPeerRestore returns true if the restore request asks to restore the
+// backup that a peer stores for us.
+func isPeerRestore(in *lnrpc.RestoreChanBackupRequest) bool {
+	_, ok := in.Backup.(*lnrpc.RestoreChanBackupRequest_PeerAddress)
+	return ok
+}
+
+// retrievePeerBackup connects to the peer with the given address, and waits
+// for it to hand back the latest channel backup it stores for us.
+func (r *rpcServer) retrievePeerBackup(ctx context.Context,
+	peerAddress string) (chanbackup.PackedMulti, error) {
+
+	if r.server.peerStorage == nil {
+		return nil, fmt.Errorf("peer storage is disabled")
+	}
+
+	pubKey, host, err := lncfg.ParseLNAddressPubkey(peerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := parseAddr(host, r.cfg.net)
+	if err != nil {
+		return nil, err
+	}
+
+	peerAddr := &lnwire.NetAddress{
+		IdentityKey: pubKey,
+		Address:     addr,
+		ChainNet:    r.cfg.ActiveNetParams.Net,
+	}
+
+	// The peer hands the backup back to us right after connecting, so
+	// there's nothing to do if we're connected already.
+	err = r.server.ConnectToPeer(peerAddr, false, r.cfg.ConnectionTimeout)
+	var alreadyConnected *errPeerAlreadyConnected
+	if err != nil && !errors.As(err, &alreadyConnected) {
+		return nil, fmt.Errorf("unable to connect to peer: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, peerBackupTimeout)
+	defer cancel()
+
+	peer := route.NewVertex(pubKey)
+	packedMulti, err := r.server.peerStorage.WaitForBackup(ctx, peer)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Restoring channel backup retrieved from peer %v",
+		peerAddr)
+
+	return packedMulti, nil
+}
+
+// isArchiveRestore returns true if the restore request asks to restore a
+// generation from the channel backup archive.
+func isArchiveRestore(in *lnrpc.RestoreChanBackupRequest) bool {
+	_, ok := in.Backup.(*lnrpc.RestoreChanBackupRequest_ArchiveTime)
+	return ok
+}
+
 // SubscribeChannelBackups allows a client to sub-subscribe to the most up to
 // date information concerning the state of all channel back ups. Each time a
 // new channel is added, we return the new set of channels, along with a
@@ -7887,6 +8272,45 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 	}
 }
 
+// BackupSinkStatus returns the status of the additional destinations the
+// static channel backup is copied to.
+func (r *rpcServer) BackupSinkStatus(_ context.Context,
+	_ *lnrpc.BackupSinkStatusRequest) (*lnrpc.BackupSinkStatusResponse,
+	error) {
+
+	// unixTimestamp returns the unix timestamp of the given time, or zero
+	// if the time isn't set.
+	unixTimestamp := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+
+		return t.Unix()
+	}
+
+	statuses := r.server.chanSubSwapper.SinkStatuses()
+	resp := &lnrpc.BackupSinkStatusResponse{
+		Sinks: make([]*lnrpc.BackupSink, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		var lastErr string
+		if status.LastErr != nil {
+			lastErr = status.LastErr.Error()
+		}
+
+		resp.Sinks = append(resp.Sinks, &lnrpc.BackupSink{
+			Name:        status.Name,
+			LastSuccess: unixTimestamp(status.LastSuccess),
+			LastAttempt: unixTimestamp(status.LastAttempt),
+			LastError:   lastErr,
+			NumFailures: status.NumFailures,
+			Pending:     status.Pending,
+		})
+	}
+
+	return resp, nil
+}
+
 // ChannelAcceptor dispatches a bi-directional streaming RPC in which
 // OpenChannel requests are sent to the client and the client responds with
 // a boolean that tells LND whether or not to accept the channel. This allows
@@ -8461,6 +8885,164 @@ func (r *rpcServer) SubscribeCustomMessages(req *lnrpc.SubscribeCustomMessagesRe
 	}
 }
 
+// errOnionMessagesDisabled is returned by the onion message RPCs if onion
+// messages aren't enabled.
+var errOnionMessagesDisabled = errors.New("onion messages are disabled, " +
+	"enable them with --protocol.onion-messages")
+
+// SendOnionMessage sends an onion message to a node or along a blinded path.
+func (r *rpcServer) SendOnionMessage(_ context.Context,
+	req *lnrpc.SendOnionMessageRequest) (*lnrpc.SendOnionMessageResponse,
+	error) {
+
+	if r.server.onionMessenger == nil {
+		return nil, errOnionMessagesDisabled
+	}
+
+	sendReq := &onionmessage.SendRequest{
+		Records: req.Records,
+	}
+
+	switch {
+	case len(req.BlindedPath) > 0:
+		path, err := onionmessage.DecodeBlindedPath(
+			bytes.NewReader(req.BlindedPath),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid blinded path: %v", err)
+		}
+		sendReq.BlindedPath = path
+
+	case len(req.Destination) > 0:
+		dest, err := route.NewVertexFromBytes(req.Destination)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid destination: %v", err)
+		}
+		sendReq.Destination = dest
+
+	default:
+		return nil, status.Error(codes.InvalidArgument,
+			onionmessage.ErrNoDestination.Error())
+	}
+
+	// If the recipient should be able to reply, we generate a random path
+	// ID for the reply path so that the caller can match replies to this
+	// message.
+	resp := &lnrpc.SendOnionMessageResponse{}
+	if req.ReplyPath {
+		sendReq.ReplyPathID = make([]byte, 32)
+		if _, err := rand.Read(sendReq.ReplyPathID); err != nil {
+			return nil, err
+		}
+		resp.ReplyPathId = sendReq.ReplyPathID
+	}
+
+	err := r.server.onionMessenger.SendMessage(sendReq)
+	switch {
+	case errors.Is(err, onionmessage.ErrNoPathFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, onionmessage.ErrSendToSelf),
+		errors.Is(err, onionmessage.ErrInvalidRecordType):
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SubscribeOnionMessages subscribes to a stream of onion messages that are
+// addressed to this node.
+func (r *rpcServer) SubscribeOnionMessages(
+	_ *lnrpc.SubscribeOnionMessagesRequest,
+	server lnrpc.Lightning_SubscribeOnionMessagesServer) error {
+
+	if r.server.onionMessenger == nil {
+		return errOnionMessagesDisabled
+	}
+
+	client, err := r.server.onionMessenger.SubscribeMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case <-client.Quit():
+			return errors.New("shutdown")
+
+		case <-server.Context().Done():
+			return server.Context().Err()
+
+		case update := <-client.Updates():
+			msg := update.(*onionmessage.ReceivedMessage)
+
+			rpcMsg := &lnrpc.OnionMessage{
+				PathId:  msg.PathID,
+				Records: msg.Records,
+			}
+			if msg.ReplyPath != nil {
+				var b bytes.Buffer
+				err := onionmessage.EncodeBlindedPath(
+					&b, msg.ReplyPath,
+				)
+				if err != nil {
+					return err
+				}
+				rpcMsg.ReplyPath = b.Bytes()
+			}
+
+			if err := server.Send(rpcMsg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// AddOffer creates a new BOLT 12 offer that is paid through invoice requests
+// sent to this node with onion messages.
+func (r *rpcServer) AddOffer(_ context.Context,
+	req *lnrpc.AddOfferRequest) (*lnrpc.AddOfferResponse, error) {
+
+	if r.server.offersManager == nil {
+		return nil, errOnionMessagesDisabled
+	}
+
+	params := &offers.OfferParams{
+		Description: req.Description,
+		Amount:      lnwire.MilliSatoshi(req.AmountMsat),
+		Issuer:      req.Issuer,
+	}
+	if req.Expiry != 0 {
+		params.AbsoluteExpiry = time.Now().Add(
+			time.Duration(req.Expiry) * time.Second,
+		)
+	}
+	if req.MultipleItems {
+		params.QuantityMax = fn.Some(req.QuantityMax)
+	} else if req.QuantityMax != 0 {
+		return nil, status.Error(codes.InvalidArgument,
+			"quantity_max requires multiple_items to be set")
+	}
+
+	offer, err := r.server.offersManager.CreateOffer(params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rpcsLog.Debugf("Created offer %v", offer)
+
+	return &lnrpc.AddOfferResponse{
+		Offer: offer.String(),
+	}, nil
+}
+
 // ListAliases returns the set of all aliases we have ever allocated along with
 // their base SCID's and possibly a separate confirmed SCID in the case of
 // zero-conf.
@@ -1,9 +1,16 @@
 package lnd
 
 import (
+	"context"
 	"testing"
 
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetAllPermissions(t *testing.T) {
@@ -12,3 +19,126 @@ func TestGetAllPermissions(t *testing.T) {
 	// Currently there are there are 16 entity:action pairs in use.
 	assert.Equal(t, len(perms), 16)
 }
+
+// mockPaymentStore is a payment store that records the last payments query.
+type mockPaymentStore struct {
+	channeldb.PaymentStore
+
+	query *channeldb.PaymentsQuery
+}
+
+// QueryPayments records the query and returns no payments.
+func (m *mockPaymentStore) QueryPayments(
+	query channeldb.PaymentsQuery) (channeldb.PaymentsResponse, error) {
+
+	m.query = &query
+
+	return channeldb.PaymentsResponse{}, nil
+}
+
+// TestListPaymentsFilters asserts that the filters of a ListPayments request
+// are mapped to the payments query, and that invalid filters are rejected.
+func TestListPaymentsFilters(t *testing.T) {
+	t.Parallel()
+
+	dest := route.Vertex{2, 3}
+
+	testCases := []struct {
+		name        string
+		req         *lnrpc.ListPaymentsRequest
+		expectQuery channeldb.PaymentsQuery
+		expectErr   string
+	}{
+		{
+			name: "no filters",
+			req:  &lnrpc.ListPaymentsRequest{},
+			expectQuery: channeldb.PaymentsQuery{
+				MaxPayments: ^uint64(0),
+			},
+		},
+		{
+			name: "all filters",
+			req: &lnrpc.ListPaymentsRequest{
+				MaxPayments:   10,
+				Status:        lnrpc.Payment_SUCCEEDED,
+				Destination:   dest[:],
+				MinAmountMsat: 1000,
+				MaxAmountMsat: 2000,
+			},
+			expectQuery: channeldb.PaymentsQuery{
+				MaxPayments: 10,
+				Status:      channeldb.StatusSucceeded,
+				Destination: &dest,
+				MinAmount:   lnwire.MilliSatoshi(1000),
+				MaxAmount:   lnwire.MilliSatoshi(2000),
+			},
+		},
+		{
+			name: "initiated status",
+			req: &lnrpc.ListPaymentsRequest{
+				MaxPayments: 10,
+				Status:      lnrpc.Payment_INITIATED,
+			},
+			expectQuery: channeldb.PaymentsQuery{
+				MaxPayments: 10,
+				Status:      channeldb.StatusInitiated,
+			},
+		},
+		{
+			name: "unknown status",
+			req: &lnrpc.ListPaymentsRequest{
+				Status: lnrpc.Payment_PaymentStatus(99),
+			},
+			expectErr: "unknown payment status",
+		},
+		{
+			name: "invalid destination",
+			req: &lnrpc.ListPaymentsRequest{
+				Destination: []byte{1, 2, 3},
+			},
+			expectErr: "invalid destination",
+		},
+		{
+			name: "negative amount",
+			req: &lnrpc.ListPaymentsRequest{
+				MinAmountMsat: -1,
+			},
+			expectErr: "must not be negative",
+		},
+		{
+			name: "min amount above max amount",
+			req: &lnrpc.ListPaymentsRequest{
+				MinAmountMsat: 2000,
+				MaxAmountMsat: 1000,
+			},
+			expectErr: "must not exceed",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &mockPaymentStore{}
+			r := &rpcServer{
+				server: &server{
+					paymentsDB: store,
+				},
+				routerBackend: &routerrpc.RouterBackend{},
+			}
+
+			_, err := r.ListPayments(context.Background(), tc.req)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				require.Nil(t, store.query)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &tc.expectQuery, store.query)
+		})
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	prand "math/rand"
 	"net"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
@@ -40,6 +43,7 @@ import (
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/graph"
@@ -54,7 +58,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnencrypt"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
@@ -62,22 +68,30 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/onionmessage"
 	"github.com/lightningnetwork/lnd/peer"
 	"github.com/lightningnetwork/lnd/peernotifier"
+	"github.com/lightningnetwork/lnd/peerstorage"
 	"github.com/lightningnetwork/lnd/pool"
 	"github.com/lightningnetwork/lnd/queue"
+	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/blindedpath"
 	"github.com/lightningnetwork/lnd/routing/localchans"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/trampoline"
 	"github.com/lightningnetwork/lnd/walletunlocker"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtclient"
 	"github.com/lightningnetwork/lnd/watchtower/wtpolicy"
 	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+	"github.com/lightningnetwork/lnd/webhook"
+	"github.com/lightningnetwork/lnd/zpay32"
 )
 
 const (
@@ -241,7 +255,7 @@ type server struct {
 
 	fundingMgr *funding.Manager
 
-	graphDB *channeldb.ChannelGraph
+	graphDB channeldb.GraphStore
 
 	chanStateDB *channeldb.ChannelStateDB
 
@@ -253,6 +267,9 @@ type server struct {
 
 	invoicesDB invoices.InvoiceDB
 
+	// paymentsDB is the DB that stores our outgoing payments.
+	paymentsDB channeldb.PaymentStore
+
 	aliasMgr *aliasmgr.Manager
 
 	htlcSwitch *htlcswitch.Switch
@@ -261,16 +278,33 @@ type server struct {
 
 	invoices *invoices.InvoiceRegistry
 
+	// invoiceHtlcModifier allows an RPC client to inspect and modify the
+	// htlcs that pay our invoices.
+	invoiceHtlcModifier *invoices.HtlcModificationInterceptor
+
 	channelNotifier *channelnotifier.ChannelNotifier
 
 	peerNotifier *peernotifier.PeerNotifier
 
 	htlcNotifier *htlcswitch.HtlcNotifier
 
+	// reputationTracker tracks the reputation of our channels for the
+	// experimental endorsement signal. It is nil if the signal isn't
+	// used.
+	reputationTracker *htlcswitch.ReputationTracker
+
+	// endorsementCfg is passed to our links if the experimental
+	// endorsement signal is used, nil otherwise.
+	endorsementCfg *htlcswitch.EndorsementConfig
+
 	witnessBeacon contractcourt.WitnessBeacon
 
 	breachArbitrator *contractcourt.BreachArbitrator
 
+	// missionControls manages the mission controls of all namespaces.
+	missionControls *routing.MissionControlManager
+
+	// missionControl is the mission control of the default namespace.
 	missionControl *routing.MissionControl
 
 	graphBuilder *graph.Builder
@@ -321,6 +355,10 @@ type server struct {
 	// channelNotifier to be notified of newly opened and closed channels.
 	chanSubSwapper *chanbackup.SubSwapper
 
+	// backupArchive keeps the previous generations of the channel backup
+	// file. It is nil if the archive is disabled.
+	backupArchive *chanbackup.Archive
+
 	// chanEventStore tracks the behaviour of channels and their remote peers to
 	// provide insights into their health and performance.
 	chanEventStore *chanfitness.ChannelEventStore
@@ -332,6 +370,30 @@ type server struct {
 
 	customMessageServer *subscribe.Server
 
+	// onionMessenger sends, receives and relays onion messages. It's nil
+	// if onion messages are disabled.
+	onionMessenger *onionmessage.Messenger
+
+	// offersManager creates BOLT 12 offers and requests invoices for
+	// them. It's nil if onion messages are disabled.
+	offersManager *offers.Manager
+
+	// peerStorage stores blobs on behalf of our peers, and hands our own
+	// channel backup to them. It's nil if peer storage is disabled.
+	peerStorage *peerstorage.Manager
+
+	// trampolineForwarder forwards trampoline payments on behalf of their
+	// senders. It's nil if trampoline routing is disabled.
+	trampolineForwarder *htlcswitch.TrampolineForwarder
+
+	// webhookNotifier posts invoice, payment and channel events to the
+	// configured webhook URLs. It's nil if no webhook URL is configured.
+	webhookNotifier *webhook.Notifier
+
+	// feeAutopilot manages the routing fees of our channels based on
+	// their liquidity. It's nil if the fee autopilot isn't active.
+	feeAutopilot *feeautopilot.Manager
+
 	// txPublisher is a publisher with fee-bumping capability.
 	txPublisher *sweep.TxPublisher
 
@@ -553,11 +615,20 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		CustomFeatures:           cfg.ProtocolOptions.CustomFeatures(),
 		NoTaprootChans:           !cfg.ProtocolOptions.TaprootChans,
 		NoRouteBlinding:          cfg.ProtocolOptions.NoRouteBlinding(),
+		NoRbfCoopClose:           !cfg.ProtocolOptions.RbfCoopClose(),
+		NoDynamicCommitments:     !cfg.ProtocolOptions.DynamicCommitments(),
+		NoQuiescence:             cfg.ProtocolOptions.NoQuiescence(),
+		NoSplice:                 !cfg.ProtocolOptions.Splice(),
+		NoCoFund:                 !cfg.ProtocolOptions.CoFund(),
+		NoOnionMessages:          !cfg.ProtocolOptions.OnionMessages(),
+		NoPeerStorage:            !cfg.ProtocolOptions.PeerStorage(),
+		NoTrampolineRouting:      !cfg.ProtocolOptions.TrampolineRouting(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	invoiceHtlcModifier := invoices.NewHtlcModificationInterceptor()
 	registryConfig := invoices.RegistryConfig{
 		FinalCltvRejectDelta:        lncfg.DefaultFinalCltvRejectDelta,
 		HtlcHoldDuration:            invoices.DefaultHtlcHoldDuration,
@@ -567,15 +638,19 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		GcCanceledInvoicesOnStartup: cfg.GcCanceledInvoicesOnStartup,
 		GcCanceledInvoicesOnTheFly:  cfg.GcCanceledInvoicesOnTheFly,
 		KeysendHoldTime:             cfg.KeysendHoldTime,
+		HtlcInterceptor:             invoiceHtlcModifier,
 	}
 
 	s := &server{
-		cfg:            cfg,
-		graphDB:        dbs.GraphDB.ChannelGraph(),
-		chanStateDB:    dbs.ChanStateDB.ChannelStateDB(),
-		addrSource:     dbs.ChanStateDB,
+		cfg:         cfg,
+		graphDB:     dbs.GraphStore,
+		chanStateDB: dbs.ChanStateDB.ChannelStateDB(),
+		addrSource: channeldb.NewGraphAddrSource(
+			dbs.ChanStateDB, dbs.GraphStore,
+		),
 		miscDB:         dbs.ChanStateDB,
 		invoicesDB:     dbs.InvoiceDB,
+		paymentsDB:     dbs.PaymentDB,
 		cc:             cc,
 		sigPool:        lnwallet.NewSigPool(cfg.Workers.Sig, cc.Signer),
 		writePool:      writePool,
@@ -592,6 +667,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 		listenAddrs: listenAddrs,
 
+		invoiceHtlcModifier: invoiceHtlcModifier,
+
 		// TODO(roasbeef): derive proper onion key based on rotation
 		// schedule
 		sphinx: hop.NewOnionProcessor(sphinxRouter),
@@ -637,6 +714,32 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now)
 
+	// If we use the experimental endorsement signal, we'll track the
+	// reputation of our channels based on the htlcs that they forward.
+	if cfg.ProtocolOptions.ExperimentalEndorsement() {
+		s.reputationTracker = htlcswitch.NewReputationTracker(
+			&htlcswitch.ReputationConfig{
+				ResolutionPeriod: cfg.Endorsement.
+					ResolutionPeriod,
+				ReputationHalfLife: cfg.Endorsement.
+					ReputationHalfLife,
+				RevenueHalfLife: cfg.Endorsement.
+					RevenueHalfLife,
+				SubscribeHtlcEvents: s.htlcNotifier.
+					SubscribeHtlcEvents,
+				Clock: clock.NewDefaultClock(),
+			},
+		)
+
+		s.endorsementCfg = &htlcswitch.EndorsementConfig{
+			Reputation: s.reputationTracker,
+			GeneralSlotFraction: cfg.Endorsement.
+				GeneralSlotFraction,
+			GeneralLiquidityFraction: cfg.Endorsement.
+				GeneralLiquidityFraction,
+		}
+	}
+
 	thresholdSats := btcutil.Amount(cfg.MaxFeeExposure)
 	thresholdMSats := lnwire.NewMSatFromSatoshis(thresholdSats)
 
@@ -712,7 +815,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		IsChannelActive:          s.htlcSwitch.HasActiveLink,
 		ApplyChannelUpdate:       s.applyChannelUpdate,
 		DB:                       s.chanStateDB,
-		Graph:                    dbs.GraphDB.ChannelGraph(),
+		Graph:                    dbs.GraphStore,
 	}
 
 	chanStatusMgr, err := netann.NewChanStatusManager(chanStatusMgrCfg)
@@ -804,7 +907,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 	// As the graph can be obtained at anytime from the network, we won't
 	// replicate it, and instead it'll only be stored locally.
-	chanGraph := dbs.GraphDB.ChannelGraph()
+	chanGraph := dbs.GraphStore
 
 	// We'll now reconstruct a node announcement based on our current
 	// configuration so we can send it out as a sort of heart beat within
@@ -933,12 +1036,13 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		McFlushInterval:         routingConfig.McFlushInterval,
 		MinFailureRelaxInterval: routing.DefaultMinFailureRelaxInterval,
 	}
-	s.missionControl, err = routing.NewMissionControl(
+	s.missionControls, err = routing.NewMissionControlManager(
 		dbs.ChanStateDB, selfNode.PubKeyBytes, mcCfg,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("can't create mission control: %w", err)
 	}
+	s.missionControl = s.missionControls.GetDefaultStore()
 
 	srvrLog.Debugf("Instantiating payment session source with config: "+
 		"AttemptCost=%v + %v%%, MinRouteProbability=%v",
@@ -968,9 +1072,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PathFindingConfig: pathFindingConfig,
 	}
 
-	paymentControl := channeldb.NewPaymentControl(dbs.ChanStateDB)
-
-	s.controlTower = routing.NewControlTower(paymentControl)
+	s.controlTower = routing.NewControlTower(dbs.PaymentDB)
 
 	strictPruning := cfg.Bitcoin.Node == "neutrino" ||
 		cfg.Routing.StrictZombiePruning
@@ -1067,6 +1169,24 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FetchChannel:              s.chanStateDB.FetchChannel,
 	}
 
+	if cfg.FeeAutopilot.Active {
+		strategies := newFeeAutopilotStrategies(cfg.FeeAutopilot)
+		s.feeAutopilot = feeautopilot.NewManager(&feeautopilot.Config{
+			Strategies:        strategies,
+			DryRun:            cfg.FeeAutopilot.DryRun,
+			MinUpdateInterval: cfg.FeeAutopilot.MinUpdateInterval,
+			MinFeeRateDelta:   cfg.FeeAutopilot.MinFeeRateDelta,
+			ForwardingWindow:  cfg.FeeAutopilot.ForwardingWindow,
+			ForAllOutgoingChannels: s.graphBuilder.
+				ForAllOutgoingChannels,
+			FetchChannel:  s.chanStateDB.FetchChannel,
+			QueryForwards: dbs.ChanStateDB.ForwardingLog().Query,
+			UpdatePolicy:  s.localChanMgr.UpdatePolicy,
+			Ticker:        ticker.New(cfg.FeeAutopilot.Interval),
+			Clock:         clock.NewDefaultClock(),
+		})
+	}
+
 	utxnStore, err := contractcourt.NewNurseryStore(
 		s.cfg.ActiveNetParams.GenesisHash, dbs.ChanStateDB,
 	)
@@ -1240,6 +1360,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		Registry:                      s.invoices,
 		NotifyClosedChannel:           s.channelNotifier.NotifyClosedChannelEvent,
 		NotifyFullyResolvedChannel:    s.channelNotifier.NotifyFullyResolvedChannelEvent,
+		NotifySplicedChannel:          s.channelNotifier.NotifySplicedChannelEvent,
 		OnionProcessor:                s.sphinx,
 		PaymentsExpirationGracePeriod: cfg.PaymentsExpirationGracePeriod,
 		IsForwardedHTLC:               s.htlcSwitch.IsForwardedHTLC,
@@ -1499,6 +1620,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		ReservationTimeout:            reservationTimeout,
 		MinChanSize:                   btcutil.Amount(cfg.MinChanSize),
 		MaxChanSize:                   btcutil.Amount(cfg.MaxChanSize),
+		CoFundContribution:            btcutil.Amount(cfg.ProtocolOptions.CoFundContribution()),
 		MaxPendingChannels:            cfg.MaxPendingChannels,
 		RejectPush:                    cfg.RejectPush,
 		MaxLocalCSVDelay:              chainCfg.MaxLocalDelay,
@@ -1529,8 +1651,106 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	if err != nil {
 		return nil, err
 	}
+	backupSinks, err := newBackupSinks(cfg.BackupSinks)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.ProtocolOptions.PeerStorage() {
+		peerStore, err := peerstorage.NewStore(dbs.ChanStateDB)
+		if err != nil {
+			return nil, err
+		}
+
+		s.peerStorage = peerstorage.NewManager(&peerstorage.Config{
+			Store:        peerStore,
+			MaxPeers:     cfg.PeerStorage.MaxPeers,
+			MaxBlobSize:  cfg.PeerStorage.MaxBlobSize,
+			KeyRing:      s.cc.KeyRing,
+			HasChannels:  s.hasChannelsWith,
+			StoragePeers: s.peerStoragePeers,
+			SendToPeer:   s.sendPeerStorageMessage,
+		})
+
+		// Our channel backup is handed to our peers like to any other
+		// backup sink.
+		backupSinks = append(backupSinks, s.peerStorage)
+	}
+	if cfg.ProtocolOptions.TrampolineRouting() {
+		s.trampolineForwarder = htlcswitch.NewTrampolineForwarder(
+			&htlcswitch.TrampolineConfig{
+				Registry: s.invoices,
+				NodeKey:  nodeKeyECDH,
+				FeeBase: lnwire.MilliSatoshi(
+					cfg.Trampoline.FeeBaseMsat,
+				),
+				FeeRate:    cfg.Trampoline.FeeRatePPM,
+				CltvDelta:  cfg.Trampoline.CltvDelta,
+				MppTimeout: cfg.Trampoline.MppTimeout,
+				BestHeight: s.cc.BestBlockTracker.BestHeight,
+				Pay:        s.payTrampoline,
+				Clock:      clock.NewDefaultClock(),
+			},
+		)
+	}
+	if cfg.Webhook.Active() {
+		s.webhookNotifier, err = webhook.New(&webhook.Config{
+			URLs:          cfg.Webhook.URLs,
+			Secret:        []byte(cfg.Webhook.Secret),
+			Timeout:       cfg.Webhook.Timeout,
+			RetryDelay:    cfg.Webhook.RetryDelay,
+			MaxRetryDelay: cfg.Webhook.MaxRetryDelay,
+			MaxAttempts:   cfg.Webhook.MaxAttempts,
+
+			// The outbox is kept in the KV invoice database, next to
+			// the invoice indices that it tracks.
+			DB:          dbs.GraphDB,
+			ChainParams: s.cfg.ActiveNetParams.Params,
+			SubscribeInvoices: func(addIndex, settleIndex uint64) (
+				*invoices.InvoiceSubscription, error) {
+
+				return s.invoices.SubscribeAllStates(
+					context.Background(), addIndex,
+					settleIndex,
+				)
+			},
+			QueryInvoices:     s.invoicesDB.QueryInvoices,
+			SubscribePayments: s.controlTower.SubscribeAllPayments,
+			QueryPayments:     s.paymentsDB.QueryPayments,
+			SubscribeChannelEvents: s.channelNotifier.
+				SubscribeChannelEvents,
+			FetchOpenChannels: s.chanStateDB.FetchAllOpenChannels,
+			FetchClosedChannels: func() (
+				[]*channeldb.ChannelCloseSummary, error) {
+
+				return s.chanStateDB.FetchClosedChannels(false)
+			},
+			Clock: clock.NewDefaultClock(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	subSwapperOpts := []chanbackup.SubSwapperOption{
+		chanbackup.WithBackupSinks(backupSinks...),
+		chanbackup.WithSinkBackoff(chanbackup.SinkBackoff{
+			InitialDelay: cfg.BackupSinks.RetryDelay,
+			MaxDelay:     cfg.BackupSinks.MaxRetryDelay,
+		}),
+	}
+	if cfg.BackupArchiveSize > 0 {
+		s.backupArchive = chanbackup.NewArchive(
+			filepath.Join(
+				filepath.Dir(cfg.BackupFilePath),
+				chanbackup.DefaultArchiveDirName,
+			), cfg.BackupArchiveSize,
+		)
+		subSwapperOpts = append(
+			subSwapperOpts, chanbackup.WithArchive(s.backupArchive),
+		)
+	}
 	s.chanSubSwapper, err = chanbackup.NewSubSwapper(
 		startingChans, chanNotifier, s.cc.KeyRing, backupFile,
+		subSwapperOpts...,
 	)
 	if err != nil {
 		return nil, err
@@ -1567,6 +1787,15 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 		policy.SweepFeeRate = sweepRateSatPerVByte.FeePerKWeight()
 
+		// If the client negotiates reward sessions, the towers are
+		// offered the configured reward out of each justice
+		// transaction.
+		if cfg.WtClient.RewardSessions {
+			policy.BlobType = blob.TypeRewardCommit
+			policy.RewardBase = cfg.WtClient.RewardBase
+			policy.RewardRate = cfg.WtClient.RewardRate
+		}
+
 		if err := policy.Validate(); err != nil {
 			return nil, err
 		}
@@ -1641,6 +1870,15 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			MinBackoff:         10 * time.Second,
 			MaxBackoff:         5 * time.Minute,
 			MaxTasksInMemQueue: cfg.WtClient.MaxTasksInMemQueue,
+			MaxSessionPayment: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(cfg.WtClient.MaxSessionPayment),
+			),
+			MaxTowerPayments: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(cfg.WtClient.MaxTowerPayments),
+			),
+			PayInvoice:        s.payTowerInvoice,
+			DecodePaymentHash: s.decodeTowerPaymentHash,
+			PaymentStatus:     s.towerPaymentStatus,
 		}, policy, anchorPolicy, taprootPolicy)
 		if err != nil {
 			return nil, err
@@ -1674,6 +1912,35 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		})
 	}
 
+	if cfg.ProtocolOptions.OnionMessages() {
+		s.onionMessenger = onionmessage.New(&onionmessage.Config{
+			NodeKey:          nodeKeyECDH,
+			SendToPeer:       s.sendOnionMessage,
+			Peers:            s.onionMessagePeers,
+			Graph:            onionmessage.NewChannelGraph(s.graphDB),
+			FetchChannelPeer: s.fetchChannelPeer,
+		})
+
+		s.offersManager = offers.New(&offers.Config{
+			NodeKey: nodeKeyECDH,
+			SignMessage: func(msg, tag []byte) (*schnorr.Signature,
+				error) {
+
+				return cc.KeyRing.SignMessageSchnorr(
+					nodeKeyDesc.KeyLocator, msg, false,
+					nil, tag,
+				)
+			},
+			ChainParams: cfg.ActiveNetParams.Params,
+			Messenger:   s.onionMessenger,
+			AddInvoice:  s.addOfferInvoice,
+			InvoiceFeatures: lnwire.NewRawFeatureVector(
+				lnwire.MPPOptional,
+			),
+			Clock: clock.NewDefaultClock(),
+		})
+	}
+
 	// Create liveness monitor.
 	s.createLivenessMonitor(cfg, cc, leaderElector)
 
@@ -1893,6 +2160,83 @@ func (s *server) createLivenessMonitor(cfg *Config, cc *chainreg.ChainControl,
 	)
 }
 
+// newFeeAutopilotStrategies creates the strategies of the fee autopilot from
+// its configuration.
+func newFeeAutopilotStrategies(
+	cfg *lncfg.FeeAutopilot) []feeautopilot.Strategy {
+
+	var strategies []feeautopilot.Strategy
+	switch cfg.Strategy {
+	case lncfg.FeeAutopilotForwardingRate:
+		strategies = append(strategies,
+			&feeautopilot.ForwardingRateStrategy{
+				MinFeeRate:  cfg.MinFeeRate,
+				MaxFeeRate:  cfg.MaxFeeRate,
+				TargetRatio: cfg.TargetForwardRatio,
+				Step:        cfg.FeeRateStep,
+			},
+		)
+
+	default:
+		strategies = append(strategies,
+			&feeautopilot.ProportionalStrategy{
+				MinFeeRate: cfg.MinFeeRate,
+				MaxFeeRate: cfg.MaxFeeRate,
+			},
+		)
+	}
+
+	if cfg.InboundDiscount {
+		strategies = append(strategies,
+			&feeautopilot.InboundDiscountStrategy{
+				MaxDiscount: cfg.MaxInboundDiscount,
+				Threshold:   cfg.InboundDiscountThreshold,
+			},
+		)
+	}
+
+	return strategies
+}
+
+// newBackupSinks creates the additional destinations of the static channel
+// backup that are set in the config.
+func newBackupSinks(cfg *lncfg.BackupSinks) ([]chanbackup.BackupSink, error) {
+	var sinks []chanbackup.BackupSink
+	for _, file := range cfg.Files {
+		sinks = append(sinks, chanbackup.NewFileSink(file))
+	}
+
+	for _, dir := range cfg.Dirs {
+		sinks = append(sinks, chanbackup.NewDirSink(dir))
+	}
+
+	if command, args := cfg.ExecCommand(); command != "" {
+		sinks = append(sinks, chanbackup.NewExecSink(
+			command, args, cfg.ExecTimeout,
+		))
+	}
+
+	if cfg.S3 != nil && cfg.S3.Endpoint != "" {
+		s3Sink, err := chanbackup.NewS3Sink(chanbackup.S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			Key:             cfg.S3.Key,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			Timeout:         cfg.S3.Timeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create S3 backup "+
+				"sink: %w", err)
+		}
+
+		sinks = append(sinks, s3Sink)
+	}
+
+	return sinks, nil
+}
+
 // Started returns true if the server has been started, and false otherwise.
 // NOTE: This function is safe for concurrent access.
 func (s *server) Started() bool {
@@ -2011,6 +2355,14 @@ func (s *server) Start() error {
 			return
 		}
 
+		if s.reputationTracker != nil {
+			cleanup = cleanup.add(s.reputationTracker.Stop)
+			if err := s.reputationTracker.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		if s.towerClientMgr != nil {
 			cleanup = cleanup.add(s.towerClientMgr.Stop)
 			if err := s.towerClientMgr.Start(); err != nil {
@@ -2019,6 +2371,22 @@ func (s *server) Start() error {
 			}
 		}
 
+		if s.onionMessenger != nil {
+			cleanup = cleanup.add(s.onionMessenger.Stop)
+			if err := s.onionMessenger.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if s.offersManager != nil {
+			cleanup = cleanup.add(s.offersManager.Stop)
+			if err := s.offersManager.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		cleanup = cleanup.add(s.txPublisher.Stop)
 		if err := s.txPublisher.Start(); err != nil {
 			startErr = err
@@ -2095,6 +2463,14 @@ func (s *server) Start() error {
 			return
 		}
 
+		if s.trampolineForwarder != nil {
+			cleanup = cleanup.add(s.trampolineForwarder.Stop)
+			if err := s.trampolineForwarder.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		cleanup = cleanup.add(s.sphinx.Stop)
 		if err := s.sphinx.Start(); err != nil {
 			startErr = err
@@ -2114,10 +2490,10 @@ func (s *server) Start() error {
 		}
 
 		cleanup.add(func() error {
-			s.missionControl.StopStoreTicker()
+			s.missionControls.StopStoreTickers()
 			return nil
 		})
-		s.missionControl.RunStoreTicker()
+		s.missionControls.RunStoreTickers()
 
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
@@ -2160,6 +2536,25 @@ func (s *server) Start() error {
 			return
 		}
 
+		// The webhook notifier subscribes to the invoice registry, the
+		// control tower and the channel notifier, so it's started
+		// after them.
+		if s.webhookNotifier != nil {
+			cleanup = cleanup.add(s.webhookNotifier.Stop)
+			if err := s.webhookNotifier.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		if s.feeAutopilot != nil {
+			cleanup = cleanup.add(s.feeAutopilot.Stop)
+			if err := s.feeAutopilot.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		if s.torController != nil {
 			cleanup = cleanup.add(s.torController.Stop)
 			if err := s.createNewHiddenService(); err != nil {
@@ -2334,6 +2729,24 @@ func (s *server) Stop() error {
 		if err := s.chanStatusMgr.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop chanStatusMgr: %v", err)
 		}
+		if s.feeAutopilot != nil {
+			if err := s.feeAutopilot.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop fee autopilot: "+
+					"%v", err)
+			}
+		}
+		if s.webhookNotifier != nil {
+			if err := s.webhookNotifier.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop webhook "+
+					"notifier: %v", err)
+			}
+		}
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop trampoline "+
+					"forwarder: %v", err)
+			}
+		}
 		if err := s.htlcSwitch.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcSwitch: %v", err)
 		}
@@ -2374,6 +2787,12 @@ func (s *server) Stop() error {
 		if err := s.peerNotifier.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop peerNotifier: %v", err)
 		}
+		if s.reputationTracker != nil {
+			if err := s.reputationTracker.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop "+
+					"reputationTracker: %v", err)
+			}
+		}
 		if err := s.htlcNotifier.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcNotifier: %v", err)
 		}
@@ -2391,7 +2810,7 @@ func (s *server) Stop() error {
 			srvrLog.Warnf("Unable to stop ChannelEventStore: %v",
 				err)
 		}
-		s.missionControl.StopStoreTicker()
+		s.missionControls.StopStoreTickers()
 
 		// Disconnect from each active peers to ensure that
 		// peerTerminationWatchers signal completion to each peer.
@@ -2416,6 +2835,20 @@ func (s *server) Stop() error {
 			}
 		}
 
+		if s.offersManager != nil {
+			if err := s.offersManager.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop offers "+
+					"manager: %v", err)
+			}
+		}
+
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop onion "+
+					"messenger: %v", err)
+			}
+		}
+
 		if s.hostAnn != nil {
 			if err := s.hostAnn.Stop(); err != nil {
 				srvrLog.Warnf("unable to shut down host "+
@@ -3508,6 +3941,38 @@ func (s *server) FindPeer(peerKey *btcec.PublicKey) (*peer.Brontide, error) {
 	return s.findPeerByPubStr(pubStr)
 }
 
+// spliceChannel splices the channel with the given funding outpoint, changing
+// our balance by the passed amount. It returns the splice transaction once
+// it's fully signed and published.
+func (s *server) spliceChannel(chanPoint wire.OutPoint, amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	channel, err := s.chanStateDB.FetchChannel(nil, chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find channel %v: %w",
+			chanPoint, err)
+	}
+
+	p, err := s.FindPeer(channel.IdentityPub)
+	if err != nil {
+		return nil, err
+	}
+
+	req := peer.NewSpliceRequest(chanPoint, amt, feeRate)
+	p.HandleLocalSpliceReq(req)
+
+	select {
+	case spliceTx := <-req.Updates:
+		return spliceTx, nil
+
+	case err := <-req.Err:
+		return nil, err
+
+	case <-s.quit:
+		return nil, ErrServerShuttingDown
+	}
+}
+
 // FindPeerByPubStr will return the peer that corresponds to the passed peerID,
 // which should be a string representation of the peer's serialized, compressed
 // public key.
@@ -3862,6 +4327,539 @@ func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
 	return s.customMessageServer.Subscribe()
 }
 
+// sendOnionMessage sends an onion message to one of our peers, provided that
+// it supports onion messages.
+func (s *server) sendOnionMessage(peerPub route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	if !peer.RemoteFeatures().HasFeature(lnwire.OnionMessagesOptional) {
+		return fmt.Errorf("peer %x doesn't support onion messages",
+			peerPub)
+	}
+
+	// Onion messages are unreliable by design, so they are sent with low
+	// priority.
+	return peer.SendMessageLazy(false, msg)
+}
+
+// onionMessagePeers returns the set of connected peers that support onion
+// messages.
+func (s *server) onionMessagePeers() []route.Vertex {
+	var peers []route.Vertex
+	for _, peer := range s.Peers() {
+		features := peer.RemoteFeatures()
+		if !features.HasFeature(lnwire.OnionMessagesOptional) {
+			continue
+		}
+
+		peers = append(peers, peer.PubKey())
+	}
+
+	return peers
+}
+
+// hasChannelsWith returns true if we have at least one open or pending
+// channel with the given peer.
+func (s *server) hasChannelsWith(peerPub route.Vertex) (bool, error) {
+	pubKey, err := btcec.ParsePubKey(peerPub[:])
+	if err != nil {
+		return false, err
+	}
+
+	channels, err := s.chanStateDB.FetchOpenChannels(pubKey)
+	if err != nil {
+		return false, err
+	}
+
+	return len(channels) > 0, nil
+}
+
+// peerStoragePeers returns the set of connected channel peers that store
+// blobs on our behalf.
+func (s *server) peerStoragePeers() []route.Vertex {
+	var peers []route.Vertex
+	for _, peer := range s.Peers() {
+		features := peer.RemoteFeatures()
+		if !features.HasFeature(lnwire.ProvideStorageOptional) {
+			continue
+		}
+
+		hasChannels, err := s.hasChannelsWith(peer.PubKey())
+		if err != nil {
+			srvrLog.Errorf("Unable to fetch channels with peer "+
+				"%v: %v", peer, err)
+
+			continue
+		}
+		if hasChannels {
+			peers = append(peers, peer.PubKey())
+		}
+	}
+
+	return peers
+}
+
+// sendPeerStorageMessage sends a peer storage message to the given peer.
+func (s *server) sendPeerStorageMessage(peerPub route.Vertex,
+	msg lnwire.Message) error {
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	return peer.SendMessageLazy(false, msg)
+}
+
+// fetchChannelPeer returns the node on the other end of one of our channels.
+func (s *server) fetchChannelPeer(
+	scid lnwire.ShortChannelID) (route.Vertex, error) {
+
+	info, _, _, err := s.graphDB.FetchChannelEdgesByID(scid.ToUint64())
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	ourKey := route.NewVertex(s.identityECDH.PubKey())
+	switch ourKey {
+	case info.NodeKey1Bytes:
+		return info.NodeKey2Bytes, nil
+
+	case info.NodeKey2Bytes:
+		return info.NodeKey1Bytes, nil
+	}
+
+	return route.Vertex{}, fmt.Errorf("channel %v is not ours", scid)
+}
+
+// addInvoiceConfig returns the config that is used to add invoices to our
+// invoice registry. If blind is set, the invoice features are adjusted for
+// invoices that include blinded paths.
+func (s *server) addInvoiceConfig(blind bool) *invoicesrpc.AddInvoiceConfig {
+	defaultDelta := s.cfg.Bitcoin.TimeLockDelta
+	selfNode := route.NewVertex(s.identityECDH.PubKey())
+
+	blindingRestrictions := &routing.BlindedPathRestrictions{
+		MinDistanceFromIntroNode: s.cfg.Routing.BlindedPaths.
+			MinNumRealHops,
+		NumHops:     s.cfg.Routing.BlindedPaths.NumHops,
+		MaxNumPaths: s.cfg.Routing.BlindedPaths.MaxNumPaths,
+	}
+
+	return &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.invoices.AddInvoice,
+		IsChannelActive:   s.htlcSwitch.HasActiveLink,
+		ChainParams:       s.cfg.ActiveNetParams.Params,
+		NodeSigner:        s.nodeSigner,
+		DefaultCLTVExpiry: defaultDelta,
+		ChanDB:            s.chanStateDB,
+		Graph:             s.graphDB,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			v := s.featureMgr.Get(feature.SetInvoice)
+
+			if blind {
+				// If an invoice includes blinded paths, then a
+				// payment address is not required since we use
+				// the PathID in the final hop's encrypted data
+				// as equivalent to the payment address
+				v.Unset(lnwire.PaymentAddrRequired)
+				v.Set(lnwire.PaymentAddrOptional)
+
+				// The invoice payer will also need to
+				// understand the new BOLT 11 tagged field
+				// containing the blinded path, so we switch
+				// the bit to required.
+				v.Unset(lnwire.Bolt11BlindedPathsOptional)
+				v.Set(lnwire.Bolt11BlindedPathsRequired)
+			}
+
+			return v
+		},
+		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoiceAmp)
+		},
+		GetAlias:   s.aliasMgr.GetPeerAlias,
+		BestHeight: s.cc.BestBlockTracker.BestHeight,
+		BlindedRoutePolicyIncrMultiplier: s.cfg.Routing.
+			BlindedPaths.PolicyIncreaseMultiplier,
+		BlindedRoutePolicyDecrMultiplier: s.cfg.Routing.
+			BlindedPaths.PolicyDecreaseMultiplier,
+		QueryBlindedRoutes: func(amt lnwire.MilliSatoshi,
+			r *routing.BlindedPathRestrictions) ([]*route.Route,
+			error) {
+
+			return s.chanRouter.FindBlindedPaths(
+				selfNode, amt, s.missionControl.GetProbability,
+				r,
+			)
+		},
+		BlindedPathRestrictions: blindingRestrictions,
+		DefaultDummyHopPolicy: &blindedpath.BlindedHopPolicy{
+			CLTVExpiryDelta: uint16(defaultDelta),
+			FeeRate:         uint32(s.cfg.Bitcoin.FeeRate),
+			BaseFee:         s.cfg.Bitcoin.BaseFee,
+			MinHTLCMsat:     s.cfg.Bitcoin.MinHTLCIn,
+
+			// MaxHTLCMsat will be calculated on the fly by using
+			// the introduction node's channel's capacities.
+			MaxHTLCMsat: 0,
+		},
+	}
+}
+
+// addOfferInvoice adds an invoice with blinded paths for a BOLT 12 invoice
+// request to our invoice registry, and returns its payment hash and paths.
+func (s *server) addOfferInvoice(ctx context.Context, amt lnwire.MilliSatoshi,
+	memo string, expiry time.Duration) (lntypes.Hash,
+	[]*routing.BlindedPayment, error) {
+
+	hash, invoice, err := invoicesrpc.AddInvoice(
+		ctx, s.addInvoiceConfig(true), &invoicesrpc.AddInvoiceData{
+			Memo:   memo,
+			Value:  amt,
+			Expiry: int64(expiry.Seconds()),
+			Blind:  true,
+		},
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	// The blinded paths are taken from the BOLT 11 payment request that
+	// is stored with the invoice.
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), s.cfg.ActiveNetParams.Params,
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	paths, err := routerrpc.MarshalBlindedPayments(
+		payReq.BlindedPaymentPaths,
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	return *hash, paths, nil
+}
+
+// addTowerInvoice adds an invoice that a client of our watchtower pays for a
+// session, and returns its payment request and payment hash.
+func (s *server) addTowerInvoice(amt lnwire.MilliSatoshi, memo string,
+	expiry time.Duration) (string, lntypes.Hash, error) {
+
+	hash, invoice, err := invoicesrpc.AddInvoice(
+		context.Background(), s.addInvoiceConfig(false),
+		&invoicesrpc.AddInvoiceData{
+			Memo:   memo,
+			Value:  amt,
+			Expiry: int64(expiry.Seconds()),
+		},
+	)
+	if err != nil {
+		return "", lntypes.Hash{}, err
+	}
+
+	return string(invoice.PaymentRequest), *hash, nil
+}
+
+// isTowerInvoicePaid returns true if the invoice that a client of our
+// watchtower pays for a session has been settled. An invoice that doesn't
+// exist anymore wasn't paid.
+func (s *server) isTowerInvoicePaid(hash lntypes.Hash) (bool, error) {
+	invoice, err := s.invoices.LookupInvoice(context.Background(), hash)
+	if errors.Is(err, invoices.ErrInvoiceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return invoice.State == invoices.ContractSettled, nil
+}
+
+// deleteTowerInvoice cancels and removes the invoice that a client of our
+// watchtower didn't pay for its session. Invoices that don't exist anymore are
+// ignored, while settled invoices are never removed.
+func (s *server) deleteTowerInvoice(hash lntypes.Hash) error {
+	ctx := context.Background()
+
+	invoice, err := s.invoices.LookupInvoice(ctx, hash)
+	if errors.Is(err, invoices.ErrInvoiceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if invoice.State == invoices.ContractSettled {
+		return fmt.Errorf("tower invoice %v already settled", hash)
+	}
+
+	if err := s.invoices.CancelInvoice(ctx, hash); err != nil {
+		return err
+	}
+
+	ref := invoices.InvoiceDeleteRef{
+		PayHash:     hash,
+		AddIndex:    invoice.AddIndex,
+		SettleIndex: invoice.SettleIndex,
+	}
+	if invoice.Terms.PaymentAddr != invoices.BlankPayAddr {
+		ref.PayAddr = &invoice.Terms.PaymentAddr
+	}
+
+	return s.invoicesDB.DeleteInvoice(
+		ctx, []invoices.InvoiceDeleteRef{ref},
+	)
+}
+
+// payTowerInvoice pays the invoice that the watchtower with the given identity
+// key requested for a session of our tower client, as long as the amount and
+// routing fees don't exceed maxAmt. The amount paid, including routing fees,
+// is returned.
+func (s *server) payTowerInvoice(payReq string, towerKey *btcec.PublicKey,
+	maxAmt lnwire.MilliSatoshi) (lntypes.Hash, lnwire.MilliSatoshi, error) {
+
+	invoice, err := zpay32.Decode(payReq, s.cfg.ActiveNetParams.Params)
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	err = routerrpc.ValidatePayReqExpiry(invoice)
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	if invoice.MilliSat == nil || invoice.PaymentHash == nil {
+		return lntypes.Hash{}, 0, fmt.Errorf("tower invoice has no " +
+			"amount or payment hash")
+	}
+
+	// The invoice must be paid to the tower itself, otherwise the tower
+	// could make us pay anyone.
+	if !invoice.Destination.IsEqual(towerKey) {
+		return lntypes.Hash{}, 0, fmt.Errorf("tower invoice "+
+			"destination %x doesn't match tower %x",
+			invoice.Destination.SerializeCompressed(),
+			towerKey.SerializeCompressed())
+	}
+
+	amt := *invoice.MilliSat
+	if amt > maxAmt {
+		return lntypes.Hash{}, 0, fmt.Errorf("tower invoice amount %v "+
+			"exceeds maximum session payment of %v", amt, maxAmt)
+	}
+
+	payment := &routing.LightningPayment{
+		Target:            route.NewVertex(invoice.Destination),
+		Amount:            amt,
+		FeeLimit:          maxAmt - amt,
+		CltvLimit:         s.cfg.MaxOutgoingCltvExpiry,
+		FinalCLTVDelta:    uint16(invoice.MinFinalCLTVExpiry()),
+		PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
+		RouteHints:        invoice.RouteHints,
+		DestFeatures:      invoice.Features,
+		PaymentAddr:       invoice.PaymentAddr,
+		PaymentRequest:    []byte(payReq),
+		Metadata:          invoice.Metadata,
+		MaxParts:          routerrpc.DefaultMaxParts,
+	}
+	if !invoice.Features.HasFeature(lnwire.MPPOptional) {
+		payment.MaxParts = 1
+	}
+
+	err = payment.SetPaymentHash(*invoice.PaymentHash)
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	_, rt, err := s.chanRouter.SendPayment(payment)
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	return *invoice.PaymentHash, rt.TotalAmount, nil
+}
+
+// decodeTowerPaymentHash returns the payment hash of an invoice that a
+// watchtower requested for a session of our tower client.
+func (s *server) decodeTowerPaymentHash(payReq string) (lntypes.Hash, error) {
+	invoice, err := zpay32.Decode(payReq, s.cfg.ActiveNetParams.Params)
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	if invoice.PaymentHash == nil {
+		return lntypes.Hash{}, fmt.Errorf("tower invoice has no " +
+			"payment hash")
+	}
+
+	return *invoice.PaymentHash, nil
+}
+
+// towerPaymentStatus returns the status of a payment that our tower client
+// made for a session, along with the amount paid including routing fees.
+func (s *server) towerPaymentStatus(
+	hash lntypes.Hash) (channeldb.PaymentStatus, lnwire.MilliSatoshi, error) {
+
+	payment, err := s.controlTower.FetchPayment(hash)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var amtPaid lnwire.MilliSatoshi
+	for _, htlc := range payment.GetHTLCs() {
+		if htlc.Settle != nil {
+			amtPaid += htlc.Route.TotalAmount
+		}
+	}
+
+	return payment.GetStatus(), amtPaid, nil
+}
+
+// payTrampoline pays the outgoing node of a trampoline payment on behalf of
+// its sender, and blocks until the payment succeeded or failed.
+func (s *server) payTrampoline(ctx context.Context,
+	p *htlcswitch.TrampolinePayment) (lntypes.Preimage, error) {
+
+	height, err := s.cc.BestBlockTracker.BestHeight()
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+	if p.Payload.OutgoingCLTV <= height ||
+		p.Payload.OutgoingCLTV-height > math.MaxUint16 {
+
+		return lntypes.Preimage{}, fmt.Errorf("invalid trampoline "+
+			"outgoing cltv %v at height %v", p.Payload.OutgoingCLTV,
+			height)
+	}
+
+	payment := &routing.LightningPayment{
+		Target:            p.Payload.OutgoingNode,
+		Amount:            p.Payload.AmtToForward,
+		FeeLimit:          p.FeeLimit,
+		CltvLimit:         p.CltvLimit - height,
+		FinalCLTVDelta:    uint16(p.Payload.OutgoingCLTV - height),
+		PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
+		RouteHints:        p.Payload.RouteHints,
+		MaxParts:          routerrpc.DefaultMaxParts,
+	}
+
+	// If the outgoing node is the final recipient, we pay it like we'd
+	// pay its invoice. Otherwise we hand it the rest of the trampoline
+	// onion.
+	if p.NextOnion == nil {
+		if p.Payload.MPP != nil {
+			addr := p.Payload.MPP.PaymentAddr()
+			payment.PaymentAddr = &addr
+		}
+		if p.Payload.InvoiceFeatures != nil {
+			payment.DestFeatures = lnwire.NewFeatureVector(
+				p.Payload.InvoiceFeatures, lnwire.Features,
+			)
+		}
+	} else {
+		var addr [32]byte
+		if _, err := rand.Read(addr[:]); err != nil {
+			return lntypes.Preimage{}, err
+		}
+
+		payment.PaymentAddr = &addr
+		payment.DestFeatures = lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+				lnwire.MPPOptional,
+			), lnwire.Features,
+		)
+		payment.DestCustomRecords = record.CustomSet{
+			trampoline.OnionRecordType: p.NextOnion,
+		}
+	}
+	if payment.DestFeatures == nil ||
+		!payment.DestFeatures.HasFeature(lnwire.MPPOptional) {
+
+		payment.MaxParts = 1
+	}
+
+	err = routing.ValidateCLTVLimit(
+		payment.CltvLimit, payment.FinalCLTVDelta, true,
+	)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	err = payment.SetPaymentHash(p.PaymentHash)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	// If the sender retries a payment that we already forwarded, for
+	// example after a restart, we wait for the existing payment.
+	paySession, shardTracker, err := s.chanRouter.PreparePayment(payment)
+	switch {
+	case err == nil:
+		s.chanRouter.SendPaymentAsync(
+			ctx, payment, paySession, shardTracker,
+		)
+
+	case errors.Is(err, channeldb.ErrPaymentInFlight),
+		errors.Is(err, channeldb.ErrAlreadyPaid):
+
+	default:
+		return lntypes.Preimage{}, err
+	}
+
+	sub, err := s.controlTower.SubscribePayment(p.PaymentHash)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return lntypes.Preimage{}, fmt.Errorf("payment "+
+					"subscription of %v closed",
+					p.PaymentHash)
+			}
+
+			mpp, ok := update.(*channeldb.MPPayment)
+			if !ok || !mpp.Terminated() {
+				continue
+			}
+
+			htlc, reason := mpp.TerminalInfo()
+			switch {
+			case htlc != nil && htlc.Settle != nil:
+				return htlc.Settle.Preimage, nil
+
+			case reason != nil && *reason ==
+				channeldb.FailureReasonPaymentDetails:
+
+				return lntypes.Preimage{},
+					htlcswitch.ErrTrampolineIncorrectDetails
+
+			case reason != nil:
+				return lntypes.Preimage{}, fmt.Errorf("payment "+
+					"failed: %v", reason)
+			}
+
+		case <-ctx.Done():
+			return lntypes.Preimage{},
+				htlcswitch.ErrTrampolineStopped
+		}
+	}
+}
+
 // peerConnected is a function that handles initialization a newly connected
 // peer by adding it to the server's global list of all active peers, and
 // starting all the goroutines the peer needs to function properly. The inbound
@@ -3911,6 +4909,14 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		towerClient = s.towerClientMgr
 	}
 
+	// Exit hop htlcs are handed to the trampoline forwarder if trampoline
+	// routing is enabled, which passes everything that isn't a trampoline
+	// payment on to the invoice registry.
+	var invoiceDB htlcswitch.InvoiceDatabase = s.invoices
+	if s.trampolineForwarder != nil {
+		invoiceDB = s.trampolineForwarder
+	}
+
 	thresholdSats := btcutil.Amount(s.cfg.MaxFeeExposure)
 	thresholdMSats := lnwire.NewMSatFromSatoshis(thresholdSats)
 
@@ -3949,7 +4955,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		RoutingPolicy:           s.cc.RoutingPolicy,
 		Sphinx:                  s.sphinx,
 		WitnessBeacon:           s.witnessBeacon,
-		Invoices:                s.invoices,
+		Invoices:                invoiceDB,
 		ChannelNotifier:         s.channelNotifier,
 		HtlcNotifier:            s.htlcNotifier,
 		TowerClient:             towerClient,
@@ -3982,11 +4988,25 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		GetAliases:             s.aliasMgr.GetAliases,
 		RequestAlias:           s.aliasMgr.RequestAlias,
 		AddLocalAlias:          s.aliasMgr.AddLocalAlias,
+		GetPeerAlias:           s.aliasMgr.GetPeerAlias,
+		PutPeerAlias:           s.aliasMgr.PutPeerAlias,
 		DisallowRouteBlinding:  s.cfg.ProtocolOptions.NoRouteBlinding(),
 		MaxFeeExposure:         thresholdMSats,
+		MaxLocalCSVDelay:       s.cfg.Bitcoin.MaxLocalDelay,
+		Endorsement:            s.endorsementCfg,
 		Quit:                   s.quit,
 	}
 
+	// Onion messages of the peer are only handled if we support them.
+	if s.onionMessenger != nil {
+		pCfg.HandleOnionMessage = s.onionMessenger.HandleMessage
+	}
+
+	// The same applies to peer storage messages.
+	if s.peerStorage != nil {
+		pCfg.HandlePeerStorage = s.peerStorage.HandleMessage
+	}
+
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
@@ -4091,6 +5111,12 @@ func (s *server) peerInitializer(p *peer.Brontide) {
 	// was successful, and to begin watching the peer's wait group.
 	close(ready)
 
+	// Now that the init messages were exchanged, we'll exchange the blobs
+	// stored by us and the peer.
+	if s.peerStorage != nil {
+		s.peerStorage.PeerOnline(p.PubKey(), p.RemoteFeatures())
+	}
+
 	pubStr := string(p.IdentityKey().SerializeCompressed())
 
 	s.mu.Lock()
@@ -4450,6 +5476,10 @@ func (s *server) removePeer(p *peer.Brontide) {
 	copy(pubKey[:], pubSer)
 
 	s.peerNotifier.NotifyPeerOffline(pubKey)
+
+	if s.peerStorage != nil {
+		s.peerStorage.PeerOffline(pubKey)
+	}
 }
 
 // ConnectToPeer requests that the server connect to a Lightning Network peer
@@ -4630,6 +5660,13 @@ func (s *server) OpenChannel(
 		return req.Updates, req.Err
 	}
 
+	// Co-funded opens are an experimental dev build feature, so we only
+	// ask the peer to co-fund the channel if it's enabled in our config
+	// and the peer supports it.
+	if s.cfg.ProtocolOptions.CoFund() && funding.CanCoFund(req) {
+		req.CoFund = true
+	}
+
 	// If the fee rate wasn't specified at this point we fail the funding
 	// because of the missing fee rate information. The caller of the
 	// `OpenChannel` method needs to make sure that default values for the
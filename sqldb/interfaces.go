@@ -83,6 +83,9 @@ type BatchedQuerier interface {
 	// create a batched version of the normal methods they need.
 	sqlc.Querier
 
+	// Backend returns the type of the database backend.
+	Backend() sqlc.BackendType
+
 	// BeginTx creates a new database transaction given the set of
 	// transaction options.
 	BeginTx(ctx context.Context, options TxOptions) (*sql.Tx, error)
@@ -363,6 +366,13 @@ type BaseDB struct {
 	*sqlc.Queries
 }
 
+// WithTx returns a new Queries instance that runs all queries within the given
+// transaction. Unlike the generated method, it keeps the backend type of the
+// database.
+func (s *BaseDB) WithTx(tx *sql.Tx) *sqlc.Queries {
+	return sqlc.NewForType(tx, s.Backend())
+}
+
 // BeginTx wraps the normal sql specific BeginTx method with the TxOptions
 // interface. This interface is then mapped to the concrete sql tx options
 // struct.
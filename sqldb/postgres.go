@@ -106,7 +106,7 @@ func NewPostgresStore(cfg *PostgresConfig) (*PostgresStore, error) {
 	rawDB.SetMaxIdleConns(maxConns)
 	rawDB.SetConnMaxLifetime(connIdleLifetime)
 
-	queries := sqlc.New(rawDB)
+	queries := sqlc.NewForType(rawDB, sqlc.BackendTypePostgres)
 
 	s := &PostgresStore{
 		cfg: cfg,
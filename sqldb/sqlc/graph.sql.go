@@ -0,0 +1,971 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: graph.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+)
+
+const countZombieChannels = `-- name: CountZombieChannels :one
+SELECT COUNT(*)
+FROM graph_zombie_channels
+`
+
+func (q *Queries) CountZombieChannels(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countZombieChannels)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteChannel = `-- name: DeleteChannel :exec
+DELETE
+FROM graph_channels
+WHERE id = $1
+`
+
+func (q *Queries) DeleteChannel(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteChannel, id)
+	return err
+}
+
+const deletePruneLogEntriesFrom = `-- name: DeletePruneLogEntriesFrom :exec
+DELETE
+FROM graph_prune_log
+WHERE block_height >= $1
+`
+
+func (q *Queries) DeletePruneLogEntriesFrom(ctx context.Context, blockHeight int64) error {
+	_, err := q.db.ExecContext(ctx, deletePruneLogEntriesFrom, blockHeight)
+	return err
+}
+
+const deleteSourceNodes = `-- name: DeleteSourceNodes :exec
+DELETE
+FROM graph_source_nodes
+`
+
+func (q *Queries) DeleteSourceNodes(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteSourceNodes)
+	return err
+}
+
+const deleteUnconnectedNodes = `-- name: DeleteUnconnectedNodes :many
+DELETE
+FROM graph_nodes
+WHERE pub_key NOT IN (
+    SELECT node_key_1 FROM graph_channels
+    UNION
+    SELECT node_key_2 FROM graph_channels
+) AND pub_key NOT IN (
+    SELECT pub_key FROM graph_source_nodes
+)
+RETURNING pub_key
+`
+
+func (q *Queries) DeleteUnconnectedNodes(ctx context.Context) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, deleteUnconnectedNodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var pub_key []byte
+		if err := rows.Scan(&pub_key); err != nil {
+			return nil, err
+		}
+		items = append(items, pub_key)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteZombieChannel = `-- name: DeleteZombieChannel :execresult
+DELETE
+FROM graph_zombie_channels
+WHERE scid = $1
+`
+
+func (q *Queries) DeleteZombieChannel(ctx context.Context, scid []byte) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteZombieChannel, scid)
+}
+
+const getChannelByOutpoint = `-- name: GetChannelByOutpoint :one
+SELECT id, scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2, features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint, capacity, extra_opaque_data
+FROM graph_channels
+WHERE outpoint = $1
+`
+
+func (q *Queries) GetChannelByOutpoint(ctx context.Context, outpoint string) (GraphChannel, error) {
+	row := q.db.QueryRowContext(ctx, getChannelByOutpoint, outpoint)
+	var i GraphChannel
+	err := row.Scan(
+		&i.ID,
+		&i.Scid,
+		&i.ChainHash,
+		&i.NodeKey1,
+		&i.NodeKey2,
+		&i.BitcoinKey1,
+		&i.BitcoinKey2,
+		&i.Features,
+		&i.NodeSig1,
+		&i.NodeSig2,
+		&i.BitcoinSig1,
+		&i.BitcoinSig2,
+		&i.Outpoint,
+		&i.Capacity,
+		&i.ExtraOpaqueData,
+	)
+	return i, err
+}
+
+const getChannelBySCID = `-- name: GetChannelBySCID :one
+SELECT id, scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2, features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint, capacity, extra_opaque_data
+FROM graph_channels
+WHERE scid = $1
+`
+
+func (q *Queries) GetChannelBySCID(ctx context.Context, scid []byte) (GraphChannel, error) {
+	row := q.db.QueryRowContext(ctx, getChannelBySCID, scid)
+	var i GraphChannel
+	err := row.Scan(
+		&i.ID,
+		&i.Scid,
+		&i.ChainHash,
+		&i.NodeKey1,
+		&i.NodeKey2,
+		&i.BitcoinKey1,
+		&i.BitcoinKey2,
+		&i.Features,
+		&i.NodeSig1,
+		&i.NodeSig2,
+		&i.BitcoinSig1,
+		&i.BitcoinSig2,
+		&i.Outpoint,
+		&i.Capacity,
+		&i.ExtraOpaqueData,
+	)
+	return i, err
+}
+
+const getChannelPolicies = `-- name: GetChannelPolicies :many
+SELECT id, channel_id, direction, last_update, signature, message_flags, channel_flags, timelock, min_htlc_msat, max_htlc_msat, base_fee_msat, fee_ppm, disabled, extra_opaque_data
+FROM graph_channel_policies
+WHERE channel_id = $1
+ORDER BY direction
+`
+
+func (q *Queries) GetChannelPolicies(ctx context.Context, channelID int64) ([]GraphChannelPolicy, error) {
+	rows, err := q.db.QueryContext(ctx, getChannelPolicies, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannelPolicy
+	for rows.Next() {
+		var i GraphChannelPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.Direction,
+			&i.LastUpdate,
+			&i.Signature,
+			&i.MessageFlags,
+			&i.ChannelFlags,
+			&i.Timelock,
+			&i.MinHtlcMsat,
+			&i.MaxHtlcMsat,
+			&i.BaseFeeMsat,
+			&i.FeePpm,
+			&i.Disabled,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getClosedSCID = `-- name: GetClosedSCID :one
+SELECT id, scid
+FROM graph_closed_scids
+WHERE scid = $1
+`
+
+func (q *Queries) GetClosedSCID(ctx context.Context, scid []byte) (GraphClosedScid, error) {
+	row := q.db.QueryRowContext(ctx, getClosedSCID, scid)
+	var i GraphClosedScid
+	err := row.Scan(
+		&i.ID,
+		&i.Scid,
+	)
+	return i, err
+}
+
+const getHighestSCID = `-- name: GetHighestSCID :one
+SELECT scid
+FROM graph_channels
+ORDER BY scid DESC
+LIMIT 1
+`
+
+func (q *Queries) GetHighestSCID(ctx context.Context) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, getHighestSCID)
+	var scid []byte
+	err := row.Scan(&scid)
+	return scid, err
+}
+
+const getNodeByPubKey = `-- name: GetNodeByPubKey :one
+SELECT id, pub_key, have_announcement, last_update, alias, color, features, addresses, auth_sig, extra_opaque_data
+FROM graph_nodes
+WHERE pub_key = $1
+`
+
+func (q *Queries) GetNodeByPubKey(ctx context.Context, pubKey []byte) (GraphNode, error) {
+	row := q.db.QueryRowContext(ctx, getNodeByPubKey, pubKey)
+	var i GraphNode
+	err := row.Scan(
+		&i.ID,
+		&i.PubKey,
+		&i.HaveAnnouncement,
+		&i.LastUpdate,
+		&i.Alias,
+		&i.Color,
+		&i.Features,
+		&i.Addresses,
+		&i.AuthSig,
+		&i.ExtraOpaqueData,
+	)
+	return i, err
+}
+
+const getPruneTip = `-- name: GetPruneTip :one
+SELECT id, block_height, block_hash
+FROM graph_prune_log
+ORDER BY block_height DESC
+LIMIT 1
+`
+
+func (q *Queries) GetPruneTip(ctx context.Context) (GraphPruneLog, error) {
+	row := q.db.QueryRowContext(ctx, getPruneTip)
+	var i GraphPruneLog
+	err := row.Scan(
+		&i.ID,
+		&i.BlockHeight,
+		&i.BlockHash,
+	)
+	return i, err
+}
+
+const getSourceNode = `-- name: GetSourceNode :one
+SELECT n.id, n.pub_key, n.have_announcement, n.last_update, n.alias, n.color, n.features, n.addresses, n.auth_sig, n.extra_opaque_data
+FROM graph_nodes n
+JOIN graph_source_nodes s ON s.pub_key = n.pub_key
+`
+
+func (q *Queries) GetSourceNode(ctx context.Context) (GraphNode, error) {
+	row := q.db.QueryRowContext(ctx, getSourceNode)
+	var i GraphNode
+	err := row.Scan(
+		&i.ID,
+		&i.PubKey,
+		&i.HaveAnnouncement,
+		&i.LastUpdate,
+		&i.Alias,
+		&i.Color,
+		&i.Features,
+		&i.Addresses,
+		&i.AuthSig,
+		&i.ExtraOpaqueData,
+	)
+	return i, err
+}
+
+const getZombieChannel = `-- name: GetZombieChannel :one
+SELECT id, scid, node_key_1, node_key_2
+FROM graph_zombie_channels
+WHERE scid = $1
+`
+
+func (q *Queries) GetZombieChannel(ctx context.Context, scid []byte) (GraphZombieChannel, error) {
+	row := q.db.QueryRowContext(ctx, getZombieChannel, scid)
+	var i GraphZombieChannel
+	err := row.Scan(
+		&i.ID,
+		&i.Scid,
+		&i.NodeKey1,
+		&i.NodeKey2,
+	)
+	return i, err
+}
+
+const insertChannel = `-- name: InsertChannel :exec
+INSERT INTO graph_channels (
+    scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2,
+    features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint,
+    capacity, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+`
+
+type InsertChannelParams struct {
+	Scid            []byte
+	ChainHash       []byte
+	NodeKey1        []byte
+	NodeKey2        []byte
+	BitcoinKey1     []byte
+	BitcoinKey2     []byte
+	Features        []byte
+	NodeSig1        []byte
+	NodeSig2        []byte
+	BitcoinSig1     []byte
+	BitcoinSig2     []byte
+	Outpoint        string
+	Capacity        int64
+	ExtraOpaqueData []byte
+}
+
+func (q *Queries) InsertChannel(ctx context.Context, arg InsertChannelParams) error {
+	_, err := q.db.ExecContext(ctx, insertChannel, arg.Scid, arg.ChainHash, arg.NodeKey1, arg.NodeKey2, arg.BitcoinKey1, arg.BitcoinKey2, arg.Features, arg.NodeSig1, arg.NodeSig2, arg.BitcoinSig1, arg.BitcoinSig2, arg.Outpoint, arg.Capacity, arg.ExtraOpaqueData)
+	return err
+}
+
+const insertClosedSCID = `-- name: InsertClosedSCID :exec
+INSERT INTO graph_closed_scids (
+    scid
+) VALUES (
+    $1
+) ON CONFLICT (scid) DO NOTHING
+`
+
+func (q *Queries) InsertClosedSCID(ctx context.Context, scid []byte) error {
+	_, err := q.db.ExecContext(ctx, insertClosedSCID, scid)
+	return err
+}
+
+const insertShellNode = `-- name: InsertShellNode :exec
+INSERT INTO graph_nodes (
+    pub_key, have_announcement, last_update
+) VALUES (
+    $1, FALSE, 0
+) ON CONFLICT (pub_key) DO NOTHING
+`
+
+func (q *Queries) InsertShellNode(ctx context.Context, pubKey []byte) error {
+	_, err := q.db.ExecContext(ctx, insertShellNode, pubKey)
+	return err
+}
+
+const insertSourceNode = `-- name: InsertSourceNode :exec
+INSERT INTO graph_source_nodes (
+    pub_key
+) VALUES (
+    $1
+)
+`
+
+func (q *Queries) InsertSourceNode(ctx context.Context, pubKey []byte) error {
+	_, err := q.db.ExecContext(ctx, insertSourceNode, pubKey)
+	return err
+}
+
+const listChannelPoliciesInRange = `-- name: ListChannelPoliciesInRange :many
+SELECT id, channel_id, direction, last_update, signature, message_flags, channel_flags, timelock, min_htlc_msat, max_htlc_msat, base_fee_msat, fee_ppm, disabled, extra_opaque_data
+FROM graph_channel_policies
+WHERE channel_id > $1 AND channel_id <= $2
+ORDER BY channel_id, direction
+`
+
+type ListChannelPoliciesInRangeParams struct {
+	StartID int64
+	EndID   int64
+}
+
+func (q *Queries) ListChannelPoliciesInRange(ctx context.Context, arg ListChannelPoliciesInRangeParams) ([]GraphChannelPolicy, error) {
+	rows, err := q.db.QueryContext(ctx, listChannelPoliciesInRange, arg.StartID, arg.EndID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannelPolicy
+	for rows.Next() {
+		var i GraphChannelPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.Direction,
+			&i.LastUpdate,
+			&i.Signature,
+			&i.MessageFlags,
+			&i.ChannelFlags,
+			&i.Timelock,
+			&i.MinHtlcMsat,
+			&i.MaxHtlcMsat,
+			&i.BaseFeeMsat,
+			&i.FeePpm,
+			&i.Disabled,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChannelsInSCIDRange = `-- name: ListChannelsInSCIDRange :many
+SELECT id, scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2, features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint, capacity, extra_opaque_data
+FROM graph_channels
+WHERE scid >= $1 AND scid < $2
+ORDER BY scid
+`
+
+type ListChannelsInSCIDRangeParams struct {
+	StartScid []byte
+	EndScid   []byte
+}
+
+func (q *Queries) ListChannelsInSCIDRange(ctx context.Context, arg ListChannelsInSCIDRangeParams) ([]GraphChannel, error) {
+	rows, err := q.db.QueryContext(ctx, listChannelsInSCIDRange, arg.StartScid, arg.EndScid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannel
+	for rows.Next() {
+		var i GraphChannel
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scid,
+			&i.ChainHash,
+			&i.NodeKey1,
+			&i.NodeKey2,
+			&i.BitcoinKey1,
+			&i.BitcoinKey2,
+			&i.Features,
+			&i.NodeSig1,
+			&i.NodeSig2,
+			&i.BitcoinSig1,
+			&i.BitcoinSig2,
+			&i.Outpoint,
+			&i.Capacity,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChannelsPaginated = `-- name: ListChannelsPaginated :many
+SELECT id, scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2, features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint, capacity, extra_opaque_data
+FROM graph_channels
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListChannelsPaginatedParams struct {
+	ID    int64
+	Limit int32
+}
+
+func (q *Queries) ListChannelsPaginated(ctx context.Context, arg ListChannelsPaginatedParams) ([]GraphChannel, error) {
+	rows, err := q.db.QueryContext(ctx, listChannelsPaginated, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannel
+	for rows.Next() {
+		var i GraphChannel
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scid,
+			&i.ChainHash,
+			&i.NodeKey1,
+			&i.NodeKey2,
+			&i.BitcoinKey1,
+			&i.BitcoinKey2,
+			&i.Features,
+			&i.NodeSig1,
+			&i.NodeSig2,
+			&i.BitcoinSig1,
+			&i.BitcoinSig2,
+			&i.Outpoint,
+			&i.Capacity,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChannelsUpdatedInRange = `-- name: ListChannelsUpdatedInRange :many
+SELECT id, scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2, features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint, capacity, extra_opaque_data
+FROM graph_channels
+WHERE id IN (
+    SELECT channel_id
+    FROM graph_channel_policies
+    WHERE last_update >= $1 AND last_update <= $2
+)
+ORDER BY id
+`
+
+type ListChannelsUpdatedInRangeParams struct {
+	StartTime int64
+	EndTime   int64
+}
+
+func (q *Queries) ListChannelsUpdatedInRange(ctx context.Context, arg ListChannelsUpdatedInRangeParams) ([]GraphChannel, error) {
+	rows, err := q.db.QueryContext(ctx, listChannelsUpdatedInRange, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannel
+	for rows.Next() {
+		var i GraphChannel
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scid,
+			&i.ChainHash,
+			&i.NodeKey1,
+			&i.NodeKey2,
+			&i.BitcoinKey1,
+			&i.BitcoinKey2,
+			&i.Features,
+			&i.NodeSig1,
+			&i.NodeSig2,
+			&i.BitcoinSig1,
+			&i.BitcoinSig2,
+			&i.Outpoint,
+			&i.Capacity,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDisabledChannelSCIDs = `-- name: ListDisabledChannelSCIDs :many
+SELECT c.scid
+FROM graph_channels c
+JOIN graph_channel_policies p ON p.channel_id = c.id
+WHERE p.disabled = TRUE
+GROUP BY c.id, c.scid
+HAVING COUNT(*) = 2
+ORDER BY c.id
+`
+
+func (q *Queries) ListDisabledChannelSCIDs(ctx context.Context) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, listDisabledChannelSCIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var scid []byte
+		if err := rows.Scan(&scid); err != nil {
+			return nil, err
+		}
+		items = append(items, scid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNodeChannelPolicies = `-- name: ListNodeChannelPolicies :many
+SELECT p.id, p.channel_id, p.direction, p.last_update, p.signature, p.message_flags, p.channel_flags, p.timelock, p.min_htlc_msat, p.max_htlc_msat, p.base_fee_msat, p.fee_ppm, p.disabled, p.extra_opaque_data
+FROM graph_channel_policies p
+JOIN graph_channels c ON c.id = p.channel_id
+WHERE c.node_key_1 = $1 OR c.node_key_2 = $1
+ORDER BY p.channel_id, p.direction
+`
+
+func (q *Queries) ListNodeChannelPolicies(ctx context.Context, nodeKey1 []byte) ([]GraphChannelPolicy, error) {
+	rows, err := q.db.QueryContext(ctx, listNodeChannelPolicies, nodeKey1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannelPolicy
+	for rows.Next() {
+		var i GraphChannelPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChannelID,
+			&i.Direction,
+			&i.LastUpdate,
+			&i.Signature,
+			&i.MessageFlags,
+			&i.ChannelFlags,
+			&i.Timelock,
+			&i.MinHtlcMsat,
+			&i.MaxHtlcMsat,
+			&i.BaseFeeMsat,
+			&i.FeePpm,
+			&i.Disabled,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNodeChannels = `-- name: ListNodeChannels :many
+SELECT id, scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2, features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint, capacity, extra_opaque_data
+FROM graph_channels
+WHERE node_key_1 = $1 OR node_key_2 = $1
+ORDER BY id
+`
+
+func (q *Queries) ListNodeChannels(ctx context.Context, nodeKey1 []byte) ([]GraphChannel, error) {
+	rows, err := q.db.QueryContext(ctx, listNodeChannels, nodeKey1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphChannel
+	for rows.Next() {
+		var i GraphChannel
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scid,
+			&i.ChainHash,
+			&i.NodeKey1,
+			&i.NodeKey2,
+			&i.BitcoinKey1,
+			&i.BitcoinKey2,
+			&i.Features,
+			&i.NodeSig1,
+			&i.NodeSig2,
+			&i.BitcoinSig1,
+			&i.BitcoinSig2,
+			&i.Outpoint,
+			&i.Capacity,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNodesPaginated = `-- name: ListNodesPaginated :many
+SELECT id, pub_key, have_announcement, last_update, alias, color, features, addresses, auth_sig, extra_opaque_data
+FROM graph_nodes
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListNodesPaginatedParams struct {
+	ID    int64
+	Limit int32
+}
+
+func (q *Queries) ListNodesPaginated(ctx context.Context, arg ListNodesPaginatedParams) ([]GraphNode, error) {
+	rows, err := q.db.QueryContext(ctx, listNodesPaginated, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphNode
+	for rows.Next() {
+		var i GraphNode
+		if err := rows.Scan(
+			&i.ID,
+			&i.PubKey,
+			&i.HaveAnnouncement,
+			&i.LastUpdate,
+			&i.Alias,
+			&i.Color,
+			&i.Features,
+			&i.Addresses,
+			&i.AuthSig,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNodesUpdatedInRange = `-- name: ListNodesUpdatedInRange :many
+SELECT id, pub_key, have_announcement, last_update, alias, color, features, addresses, auth_sig, extra_opaque_data
+FROM graph_nodes
+WHERE last_update >= $1 AND last_update <= $2
+ORDER BY last_update, pub_key
+`
+
+type ListNodesUpdatedInRangeParams struct {
+	StartTime int64
+	EndTime   int64
+}
+
+func (q *Queries) ListNodesUpdatedInRange(ctx context.Context, arg ListNodesUpdatedInRangeParams) ([]GraphNode, error) {
+	rows, err := q.db.QueryContext(ctx, listNodesUpdatedInRange, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GraphNode
+	for rows.Next() {
+		var i GraphNode
+		if err := rows.Scan(
+			&i.ID,
+			&i.PubKey,
+			&i.HaveAnnouncement,
+			&i.LastUpdate,
+			&i.Alias,
+			&i.Color,
+			&i.Features,
+			&i.Addresses,
+			&i.AuthSig,
+			&i.ExtraOpaqueData,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChannel = `-- name: UpdateChannel :exec
+UPDATE graph_channels
+SET chain_hash = $2,
+    node_key_1 = $3,
+    node_key_2 = $4,
+    bitcoin_key_1 = $5,
+    bitcoin_key_2 = $6,
+    features = $7,
+    node_sig_1 = $8,
+    node_sig_2 = $9,
+    bitcoin_sig_1 = $10,
+    bitcoin_sig_2 = $11,
+    outpoint = $12,
+    capacity = $13,
+    extra_opaque_data = $14
+WHERE scid = $1
+`
+
+type UpdateChannelParams struct {
+	Scid            []byte
+	ChainHash       []byte
+	NodeKey1        []byte
+	NodeKey2        []byte
+	BitcoinKey1     []byte
+	BitcoinKey2     []byte
+	Features        []byte
+	NodeSig1        []byte
+	NodeSig2        []byte
+	BitcoinSig1     []byte
+	BitcoinSig2     []byte
+	Outpoint        string
+	Capacity        int64
+	ExtraOpaqueData []byte
+}
+
+func (q *Queries) UpdateChannel(ctx context.Context, arg UpdateChannelParams) error {
+	_, err := q.db.ExecContext(ctx, updateChannel, arg.Scid, arg.ChainHash, arg.NodeKey1, arg.NodeKey2, arg.BitcoinKey1, arg.BitcoinKey2, arg.Features, arg.NodeSig1, arg.NodeSig2, arg.BitcoinSig1, arg.BitcoinSig2, arg.Outpoint, arg.Capacity, arg.ExtraOpaqueData)
+	return err
+}
+
+const upsertChannelPolicy = `-- name: UpsertChannelPolicy :exec
+INSERT INTO graph_channel_policies (
+    channel_id, direction, last_update, signature, message_flags,
+    channel_flags, timelock, min_htlc_msat, max_htlc_msat, base_fee_msat,
+    fee_ppm, disabled, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) ON CONFLICT (channel_id, direction) DO UPDATE SET
+    last_update = EXCLUDED.last_update,
+    signature = EXCLUDED.signature,
+    message_flags = EXCLUDED.message_flags,
+    channel_flags = EXCLUDED.channel_flags,
+    timelock = EXCLUDED.timelock,
+    min_htlc_msat = EXCLUDED.min_htlc_msat,
+    max_htlc_msat = EXCLUDED.max_htlc_msat,
+    base_fee_msat = EXCLUDED.base_fee_msat,
+    fee_ppm = EXCLUDED.fee_ppm,
+    disabled = EXCLUDED.disabled,
+    extra_opaque_data = EXCLUDED.extra_opaque_data
+`
+
+type UpsertChannelPolicyParams struct {
+	ChannelID       int64
+	Direction       int16
+	LastUpdate      int64
+	Signature       []byte
+	MessageFlags    int16
+	ChannelFlags    int16
+	Timelock        int32
+	MinHtlcMsat     int64
+	MaxHtlcMsat     int64
+	BaseFeeMsat     int64
+	FeePpm          int64
+	Disabled        bool
+	ExtraOpaqueData []byte
+}
+
+func (q *Queries) UpsertChannelPolicy(ctx context.Context, arg UpsertChannelPolicyParams) error {
+	_, err := q.db.ExecContext(ctx, upsertChannelPolicy, arg.ChannelID, arg.Direction, arg.LastUpdate, arg.Signature, arg.MessageFlags, arg.ChannelFlags, arg.Timelock, arg.MinHtlcMsat, arg.MaxHtlcMsat, arg.BaseFeeMsat, arg.FeePpm, arg.Disabled, arg.ExtraOpaqueData)
+	return err
+}
+
+const upsertNode = `-- name: UpsertNode :exec
+INSERT INTO graph_nodes (
+    pub_key, have_announcement, last_update, alias, color, features,
+    addresses, auth_sig, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) ON CONFLICT (pub_key) DO UPDATE SET
+    have_announcement = EXCLUDED.have_announcement,
+    last_update = EXCLUDED.last_update,
+    alias = EXCLUDED.alias,
+    color = EXCLUDED.color,
+    features = EXCLUDED.features,
+    addresses = EXCLUDED.addresses,
+    auth_sig = EXCLUDED.auth_sig,
+    extra_opaque_data = EXCLUDED.extra_opaque_data
+`
+
+type UpsertNodeParams struct {
+	PubKey           []byte
+	HaveAnnouncement bool
+	LastUpdate       int64
+	Alias            sql.NullString
+	Color            []byte
+	Features         []byte
+	Addresses        []byte
+	AuthSig          []byte
+	ExtraOpaqueData  []byte
+}
+
+func (q *Queries) UpsertNode(ctx context.Context, arg UpsertNodeParams) error {
+	_, err := q.db.ExecContext(ctx, upsertNode, arg.PubKey, arg.HaveAnnouncement, arg.LastUpdate, arg.Alias, arg.Color, arg.Features, arg.Addresses, arg.AuthSig, arg.ExtraOpaqueData)
+	return err
+}
+
+const upsertPruneLogEntry = `-- name: UpsertPruneLogEntry :exec
+INSERT INTO graph_prune_log (
+    block_height, block_hash
+) VALUES (
+    $1, $2
+) ON CONFLICT (block_height) DO UPDATE SET
+    block_hash = EXCLUDED.block_hash
+`
+
+type UpsertPruneLogEntryParams struct {
+	BlockHeight int64
+	BlockHash   []byte
+}
+
+func (q *Queries) UpsertPruneLogEntry(ctx context.Context, arg UpsertPruneLogEntryParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPruneLogEntry, arg.BlockHeight, arg.BlockHash)
+	return err
+}
+
+const upsertZombieChannel = `-- name: UpsertZombieChannel :exec
+INSERT INTO graph_zombie_channels (
+    scid, node_key_1, node_key_2
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (scid) DO UPDATE SET
+    node_key_1 = EXCLUDED.node_key_1,
+    node_key_2 = EXCLUDED.node_key_2
+`
+
+type UpsertZombieChannelParams struct {
+	Scid     []byte
+	NodeKey1 []byte
+	NodeKey2 []byte
+}
+
+func (q *Queries) UpsertZombieChannel(ctx context.Context, arg UpsertZombieChannelParams) error {
+	_, err := q.db.ExecContext(ctx, upsertZombieChannel, arg.Scid, arg.NodeKey1, arg.NodeKey2)
+	return err
+}
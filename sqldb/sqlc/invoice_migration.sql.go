@@ -0,0 +1,195 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: invoice_migration.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const countInvoices = `-- name: CountInvoices :one
+SELECT COUNT(*)
+FROM invoices
+`
+
+func (q *Queries) CountInvoices(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countInvoices)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteInvoiceMigrationHashes = `-- name: DeleteInvoiceMigrationHashes :exec
+DELETE
+FROM invoice_migration_hashes
+`
+
+func (q *Queries) DeleteInvoiceMigrationHashes(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteInvoiceMigrationHashes)
+	return err
+}
+
+const getInvoiceMigrationHash = `-- name: GetInvoiceMigrationHash :one
+SELECT hash
+FROM invoice_migration_hashes
+WHERE add_index = $1
+`
+
+func (q *Queries) GetInvoiceMigrationHash(ctx context.Context, addIndex int64) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, getInvoiceMigrationHash, addIndex)
+	var hash []byte
+	err := row.Scan(&hash)
+	return hash, err
+}
+
+const getInvoiceMigrationState = `-- name: GetInvoiceMigrationState :one
+SELECT id, hashes_migrated, last_add_index, max_settle_index, completed
+FROM invoice_migration_state
+WHERE id = 1
+`
+
+func (q *Queries) GetInvoiceMigrationState(ctx context.Context) (InvoiceMigrationState, error) {
+	row := q.db.QueryRowContext(ctx, getInvoiceMigrationState)
+	var i InvoiceMigrationState
+	err := row.Scan(
+		&i.ID,
+		&i.HashesMigrated,
+		&i.LastAddIndex,
+		&i.MaxSettleIndex,
+		&i.Completed,
+	)
+	return i, err
+}
+
+const insertInvoiceMigrationHash = `-- name: InsertInvoiceMigrationHash :exec
+INSERT INTO invoice_migration_hashes (
+    add_index, hash
+) VALUES (
+    $1, $2
+) ON CONFLICT (add_index) DO NOTHING
+`
+
+type InsertInvoiceMigrationHashParams struct {
+	AddIndex int64
+	Hash     []byte
+}
+
+func (q *Queries) InsertInvoiceMigrationHash(ctx context.Context, arg InsertInvoiceMigrationHashParams) error {
+	_, err := q.db.ExecContext(ctx, insertInvoiceMigrationHash, arg.AddIndex, arg.Hash)
+	return err
+}
+
+const insertMigratedInvoice = `-- name: InsertMigratedInvoice :exec
+INSERT INTO invoices (
+    id, hash, preimage, settle_index, settled_at, memo, amount_msat,
+    cltv_delta, expiry, payment_addr, payment_request, payment_request_hash,
+    state, amount_paid_msat, is_amp, is_hodl, is_keysend, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+    $18
+)
+`
+
+type InsertMigratedInvoiceParams struct {
+	ID                 int64
+	Hash               []byte
+	Preimage           []byte
+	SettleIndex        sql.NullInt64
+	SettledAt          sql.NullTime
+	Memo               sql.NullString
+	AmountMsat         int64
+	CltvDelta          sql.NullInt32
+	Expiry             int32
+	PaymentAddr        []byte
+	PaymentRequest     sql.NullString
+	PaymentRequestHash []byte
+	State              int16
+	AmountPaidMsat     int64
+	IsAmp              bool
+	IsHodl             bool
+	IsKeysend          bool
+	CreatedAt          time.Time
+}
+
+func (q *Queries) InsertMigratedInvoice(ctx context.Context, arg InsertMigratedInvoiceParams) error {
+	_, err := q.db.ExecContext(ctx, insertMigratedInvoice,
+		arg.ID,
+		arg.Hash,
+		arg.Preimage,
+		arg.SettleIndex,
+		arg.SettledAt,
+		arg.Memo,
+		arg.AmountMsat,
+		arg.CltvDelta,
+		arg.Expiry,
+		arg.PaymentAddr,
+		arg.PaymentRequest,
+		arg.PaymentRequestHash,
+		arg.State,
+		arg.AmountPaidMsat,
+		arg.IsAmp,
+		arg.IsHodl,
+		arg.IsKeysend,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const resetInvoiceIDSequence = `-- name: ResetInvoiceIDSequence :exec
+
+SELECT setval(
+    pg_get_serial_sequence('invoices', 'id'),
+    (SELECT MAX(id) FROM invoices)
+)
+`
+
+// This query is only supported by postgres. Sqlite always assigns the highest
+// existing ID plus one to new rows, so explicitly inserted IDs don't need any
+// sequence updates.
+func (q *Queries) ResetInvoiceIDSequence(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, resetInvoiceIDSequence)
+	return err
+}
+
+const setInvoiceSettleIndex = `-- name: SetInvoiceSettleIndex :exec
+UPDATE invoice_sequences SET current_value = $1
+WHERE name = 'settle_index'
+`
+
+func (q *Queries) SetInvoiceSettleIndex(ctx context.Context, currentValue int64) error {
+	_, err := q.db.ExecContext(ctx, setInvoiceSettleIndex, currentValue)
+	return err
+}
+
+const upsertInvoiceMigrationState = `-- name: UpsertInvoiceMigrationState :exec
+INSERT INTO invoice_migration_state (
+    id, hashes_migrated, last_add_index, max_settle_index, completed
+) VALUES (
+    1, $1, $2, $3, $4
+) ON CONFLICT (id) DO UPDATE SET
+    hashes_migrated = EXCLUDED.hashes_migrated,
+    last_add_index = EXCLUDED.last_add_index,
+    max_settle_index = EXCLUDED.max_settle_index,
+    completed = EXCLUDED.completed
+`
+
+type UpsertInvoiceMigrationStateParams struct {
+	HashesMigrated bool
+	LastAddIndex   int64
+	MaxSettleIndex int64
+	Completed      bool
+}
+
+func (q *Queries) UpsertInvoiceMigrationState(ctx context.Context, arg UpsertInvoiceMigrationStateParams) error {
+	_, err := q.db.ExecContext(ctx, upsertInvoiceMigrationState,
+		arg.HashesMigrated,
+		arg.LastAddIndex,
+		arg.MaxSettleIndex,
+		arg.Completed,
+	)
+	return err
+}
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS payment_htlc_failures;
+DROP INDEX IF EXISTS payment_htlc_attempts_payment_id_idx;
+DROP TABLE IF EXISTS payment_htlc_attempts;
+DROP INDEX IF EXISTS payments_amount_msat_idx;
+DROP INDEX IF EXISTS payments_destination_idx;
+DROP INDEX IF EXISTS payments_status_idx;
+DROP INDEX IF EXISTS payments_created_at_idx;
+DROP TABLE IF EXISTS payments;
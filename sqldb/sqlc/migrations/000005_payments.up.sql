@@ -0,0 +1,99 @@
+-- payments contains all outgoing payments of the node. Payments are identified
+-- by their payment hash, or by the set ID in the case of AMP payments.
+CREATE TABLE IF NOT EXISTS payments (
+    -- The id of the payment. Translates to the sequence number that is used
+    -- to paginate payments.
+    id BIGINT PRIMARY KEY,
+
+    -- The hash of the payment, or the set ID for AMP payments.
+    hash BLOB NOT NULL UNIQUE,
+
+    -- The amount of the payment in millisatoshis.
+    amount_msat BIGINT NOT NULL,
+
+    -- Timestamp of when this payment was initiated.
+    created_at TIMESTAMP NOT NULL,
+
+    -- The encoded payment request, if the payment was made to one.
+    payment_request BLOB,
+
+    -- The public key of the final hop of the first HTLC attempt of the
+    -- payment. It is NULL until the first attempt is registered.
+    destination BLOB,
+
+    -- The status of the payment. It is derived from the HTLC attempts and the
+    -- failure reason, and is stored to be able to query payments by status.
+    status SMALLINT NOT NULL,
+
+    -- The reason the payment failed. It is NULL until the payment is marked
+    -- as failed.
+    fail_reason SMALLINT
+);
+
+CREATE INDEX IF NOT EXISTS payments_created_at_idx ON payments(created_at);
+CREATE INDEX IF NOT EXISTS payments_status_idx ON payments(status);
+CREATE INDEX IF NOT EXISTS payments_destination_idx ON payments(destination);
+CREATE INDEX IF NOT EXISTS payments_amount_msat_idx ON payments(amount_msat);
+
+-- payment_htlc_attempts contains the HTLC attempts that were made for the
+-- payments in the payments table.
+CREATE TABLE IF NOT EXISTS payment_htlc_attempts (
+    id BIGINT PRIMARY KEY,
+
+    -- The id of the attempt that was assigned by the router.
+    attempt_id BIGINT NOT NULL,
+
+    -- The payment this attempt was made for.
+    payment_id BIGINT NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+
+    -- The ephemeral session key of the onion of the attempt.
+    session_key BLOB NOT NULL,
+
+    -- Timestamp of when the attempt was made.
+    attempt_time TIMESTAMP NOT NULL,
+
+    -- The hash that is used for this attempt. It differs across the attempts
+    -- of AMP payments.
+    hash BLOB,
+
+    -- The serialized route of the attempt.
+    route BLOB NOT NULL,
+
+    -- The amount that is received by the final hop of the route.
+    amount_msat BIGINT NOT NULL,
+
+    -- The total fees of the route.
+    fee_msat BIGINT NOT NULL,
+
+    -- The preimage that settled the attempt. It is NULL until the attempt is
+    -- settled.
+    settle_preimage BLOB,
+
+    -- Timestamp of when the attempt was settled.
+    settled_at TIMESTAMP,
+
+    UNIQUE (payment_id, attempt_id)
+);
+
+CREATE INDEX IF NOT EXISTS payment_htlc_attempts_payment_id_idx ON payment_htlc_attempts(payment_id);
+
+-- payment_htlc_failures contains the failures of the HTLC attempts in the
+-- payment_htlc_attempts table.
+CREATE TABLE IF NOT EXISTS payment_htlc_failures (
+    id BIGINT PRIMARY KEY,
+
+    -- The attempt that failed.
+    htlc_attempt_id BIGINT NOT NULL UNIQUE REFERENCES payment_htlc_attempts(id) ON DELETE CASCADE,
+
+    -- Timestamp of when the attempt failed.
+    failed_at TIMESTAMP NOT NULL,
+
+    -- The reason the attempt failed.
+    reason SMALLINT NOT NULL,
+
+    -- The encoded wire failure message, if any.
+    failure_msg BLOB,
+
+    -- The position in the route of the node that generated the failure.
+    failure_source_index BIGINT NOT NULL
+);
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS graph_prune_log;
+DROP TABLE IF EXISTS graph_closed_scids;
+DROP TABLE IF EXISTS graph_zombie_channels;
+DROP INDEX IF EXISTS graph_channel_policies_last_update_idx;
+DROP TABLE IF EXISTS graph_channel_policies;
+DROP INDEX IF EXISTS graph_channels_outpoint_idx;
+DROP INDEX IF EXISTS graph_channels_node_key_2_idx;
+DROP INDEX IF EXISTS graph_channels_node_key_1_idx;
+DROP TABLE IF EXISTS graph_channels;
+DROP TABLE IF EXISTS graph_source_nodes;
+DROP INDEX IF EXISTS graph_nodes_last_update_idx;
+DROP TABLE IF EXISTS graph_nodes;
\ No newline at end of file
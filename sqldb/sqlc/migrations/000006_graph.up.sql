@@ -0,0 +1,169 @@
+-- graph_nodes contains the nodes of the channel graph. Nodes we only know
+-- from a channel announcement are stored as shell nodes that only have their
+-- public key set.
+CREATE TABLE IF NOT EXISTS graph_nodes (
+    id BIGINT PRIMARY KEY,
+
+    -- The identity public key of the node.
+    pub_key BLOB NOT NULL UNIQUE,
+
+    -- Whether we received a node announcement for this node. If false, only
+    -- the public key of the node is known.
+    have_announcement BOOLEAN NOT NULL,
+
+    -- The unix timestamp of the last node announcement of the node.
+    last_update BIGINT NOT NULL,
+
+    -- The alias of the node.
+    alias TEXT,
+
+    -- The RGB color of the node.
+    color BLOB,
+
+    -- The encoded feature vector of the node.
+    features BLOB,
+
+    -- The serialized addresses the node is reachable at.
+    addresses BLOB,
+
+    -- The signature of the last node announcement of the node.
+    auth_sig BLOB,
+
+    -- Any data appended to the node announcement that we don't understand.
+    extra_opaque_data BLOB
+);
+
+CREATE INDEX IF NOT EXISTS graph_nodes_last_update_idx ON graph_nodes(last_update);
+
+-- graph_source_nodes contains the public key of our own node, which is the
+-- source node of the graph.
+CREATE TABLE IF NOT EXISTS graph_source_nodes (
+    id BIGINT PRIMARY KEY,
+
+    -- The public key of the source node.
+    pub_key BLOB NOT NULL UNIQUE REFERENCES graph_nodes(pub_key) ON DELETE CASCADE
+);
+
+-- graph_channels contains the static information of the channels of the
+-- channel graph.
+CREATE TABLE IF NOT EXISTS graph_channels (
+    id BIGINT PRIMARY KEY,
+
+    -- The short channel ID of the channel, encoded as 8 big endian bytes so
+    -- that channels can be queried by block height ranges.
+    scid BLOB NOT NULL UNIQUE,
+
+    -- The hash of the genesis block of the chain the channel lives on.
+    chain_hash BLOB NOT NULL,
+
+    -- The public key of the node with the lexicographically smaller key.
+    node_key_1 BLOB NOT NULL REFERENCES graph_nodes(pub_key),
+
+    -- The public key of the node with the lexicographically larger key.
+    node_key_2 BLOB NOT NULL REFERENCES graph_nodes(pub_key),
+
+    -- The funding keys of the two nodes.
+    bitcoin_key_1 BLOB NOT NULL,
+    bitcoin_key_2 BLOB NOT NULL,
+
+    -- The raw feature vector of the channel announcement.
+    features BLOB,
+
+    -- The signatures of the channel announcement. They are NULL for
+    -- channels that haven't been announced yet.
+    node_sig_1 BLOB,
+    node_sig_2 BLOB,
+    bitcoin_sig_1 BLOB,
+    bitcoin_sig_2 BLOB,
+
+    -- The funding outpoint of the channel in the txid:index format.
+    outpoint TEXT NOT NULL,
+
+    -- The capacity of the channel in satoshis.
+    capacity BIGINT NOT NULL,
+
+    -- Any data appended to the channel announcement that we don't
+    -- understand.
+    extra_opaque_data BLOB
+);
+
+CREATE INDEX IF NOT EXISTS graph_channels_node_key_1_idx ON graph_channels(node_key_1);
+CREATE INDEX IF NOT EXISTS graph_channels_node_key_2_idx ON graph_channels(node_key_2);
+CREATE INDEX IF NOT EXISTS graph_channels_outpoint_idx ON graph_channels(outpoint);
+
+-- graph_channel_policies contains the routing policies of the two directions
+-- of the channels in the graph_channels table.
+CREATE TABLE IF NOT EXISTS graph_channel_policies (
+    id BIGINT PRIMARY KEY,
+
+    -- The channel the policy belongs to.
+    channel_id BIGINT NOT NULL REFERENCES graph_channels(id) ON DELETE CASCADE,
+
+    -- The direction of the policy. 0 if the policy was announced by node 1
+    -- of the channel and 1 if it was announced by node 2.
+    direction SMALLINT NOT NULL,
+
+    -- The unix timestamp of the channel update of the policy.
+    last_update BIGINT NOT NULL,
+
+    -- The signature of the channel update.
+    signature BLOB,
+
+    message_flags SMALLINT NOT NULL,
+
+    channel_flags SMALLINT NOT NULL,
+
+    timelock INTEGER NOT NULL,
+
+    min_htlc_msat BIGINT NOT NULL,
+
+    max_htlc_msat BIGINT NOT NULL,
+
+    base_fee_msat BIGINT NOT NULL,
+
+    fee_ppm BIGINT NOT NULL,
+
+    -- Whether the disabled bit is set in the channel flags.
+    disabled BOOLEAN NOT NULL,
+
+    -- Any data appended to the channel update that we don't understand.
+    extra_opaque_data BLOB,
+
+    UNIQUE (channel_id, direction)
+);
+
+CREATE INDEX IF NOT EXISTS graph_channel_policies_last_update_idx ON graph_channel_policies(last_update);
+
+-- graph_zombie_channels is the index of channels that were removed from the
+-- graph as zombies. The public keys determine which nodes can resurrect the
+-- channel with a fresh update.
+CREATE TABLE IF NOT EXISTS graph_zombie_channels (
+    id BIGINT PRIMARY KEY,
+
+    -- The short channel ID of the channel, encoded as 8 big endian bytes.
+    scid BLOB NOT NULL UNIQUE,
+
+    -- The public keys of the nodes of the channel. A key is all zeroes if
+    -- the node is not allowed to resurrect the channel.
+    node_key_1 BLOB NOT NULL,
+    node_key_2 BLOB NOT NULL
+);
+
+-- graph_closed_scids contains the short channel IDs of channels that are
+-- known to be closed.
+CREATE TABLE IF NOT EXISTS graph_closed_scids (
+    id BIGINT PRIMARY KEY,
+
+    -- The short channel ID of the channel, encoded as 8 big endian bytes.
+    scid BLOB NOT NULL UNIQUE
+);
+
+-- graph_prune_log contains the blocks that were used to prune the graph. The
+-- entry with the highest block height is the prune tip of the graph.
+CREATE TABLE IF NOT EXISTS graph_prune_log (
+    id BIGINT PRIMARY KEY,
+
+    block_height BIGINT NOT NULL UNIQUE,
+
+    block_hash BLOB NOT NULL
+);
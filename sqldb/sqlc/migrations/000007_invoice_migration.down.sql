@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS invoice_migration_hashes;
+DROP TABLE IF EXISTS invoice_migration_state;
\ No newline at end of file
@@ -0,0 +1,33 @@
+-- invoice_migration_state tracks the progress of the migration of the invoices
+-- from the KV database to the native SQL schema. The table contains at most a
+-- single row.
+CREATE TABLE IF NOT EXISTS invoice_migration_state (
+    id INTEGER PRIMARY KEY,
+
+    -- Whether the payment hashes of all KV invoices were copied to the
+    -- invoice_migration_hashes table.
+    hashes_migrated BOOLEAN NOT NULL,
+
+    -- The add index of the last invoice that was migrated.
+    last_add_index BIGINT NOT NULL,
+
+    -- The highest settle index of all migrated invoices and AMP sub
+    -- invoices.
+    max_settle_index BIGINT NOT NULL,
+
+    -- Whether all invoices were migrated and verified.
+    completed BOOLEAN NOT NULL
+);
+
+-- invoice_migration_hashes maps the add index of the KV invoices to their
+-- payment hash, as the KV database only indexes invoices by their hash. The
+-- table is only used during the migration and is emptied once it completes.
+CREATE TABLE IF NOT EXISTS invoice_migration_hashes (
+    id BIGINT PRIMARY KEY,
+
+    -- The add index of the invoice in the KV database.
+    add_index BIGINT NOT NULL UNIQUE,
+
+    -- The payment hash of the invoice.
+    hash BLOB NOT NULL
+);
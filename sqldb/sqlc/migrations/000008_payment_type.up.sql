@@ -0,0 +1,4 @@
+-- payment_type distinguishes payments by their purpose. Regular payments to
+-- other nodes have type 0, circular rebalancing payments to ourselves have
+-- type 1.
+ALTER TABLE payments ADD COLUMN payment_type SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,12 @@
+-- payment_migration_state tracks the progress of the migration of the payments
+-- from the KV database to the native SQL schema. The table contains at most a
+-- single row.
+CREATE TABLE IF NOT EXISTS payment_migration_state (
+    id INTEGER PRIMARY KEY,
+
+    -- The sequence number of the last payment that was migrated.
+    last_sequence_num BIGINT NOT NULL,
+
+    -- Whether all payments were migrated and verified.
+    completed BOOLEAN NOT NULL
+);
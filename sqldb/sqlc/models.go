@@ -28,6 +28,77 @@ type AmpSubInvoiceHtlc struct {
 	Preimage   []byte
 }
 
+type GraphChannel struct {
+	ID              int64
+	Scid            []byte
+	ChainHash       []byte
+	NodeKey1        []byte
+	NodeKey2        []byte
+	BitcoinKey1     []byte
+	BitcoinKey2     []byte
+	Features        []byte
+	NodeSig1        []byte
+	NodeSig2        []byte
+	BitcoinSig1     []byte
+	BitcoinSig2     []byte
+	Outpoint        string
+	Capacity        int64
+	ExtraOpaqueData []byte
+}
+
+type GraphChannelPolicy struct {
+	ID              int64
+	ChannelID       int64
+	Direction       int16
+	LastUpdate      int64
+	Signature       []byte
+	MessageFlags    int16
+	ChannelFlags    int16
+	Timelock        int32
+	MinHtlcMsat     int64
+	MaxHtlcMsat     int64
+	BaseFeeMsat     int64
+	FeePpm          int64
+	Disabled        bool
+	ExtraOpaqueData []byte
+}
+
+type GraphClosedScid struct {
+	ID   int64
+	Scid []byte
+}
+
+type GraphNode struct {
+	ID               int64
+	PubKey           []byte
+	HaveAnnouncement bool
+	LastUpdate       int64
+	Alias            sql.NullString
+	Color            []byte
+	Features         []byte
+	Addresses        []byte
+	AuthSig          []byte
+	ExtraOpaqueData  []byte
+}
+
+type GraphPruneLog struct {
+	ID          int64
+	BlockHeight int64
+	BlockHash   []byte
+}
+
+type GraphSourceNode struct {
+	ID     int64
+	PubKey []byte
+}
+
+type GraphZombieChannel struct {
+	ID       int64
+	Scid     []byte
+	NodeKey1 []byte
+	NodeKey2 []byte
+}
+
 type Invoice struct {
 	ID                 int64
 	Hash               []byte
@@ -87,7 +158,62 @@ type InvoiceHtlcCustomRecord struct {
 	HtlcID int64
 }
 
+type InvoiceMigrationHash struct {
+	ID       int64
+	AddIndex int64
+	Hash     []byte
+}
+
+type InvoiceMigrationState struct {
+	ID             int32
+	HashesMigrated bool
+	LastAddIndex   int64
+	MaxSettleIndex int64
+	Completed      bool
+}
+
 type InvoiceSequence struct {
 	Name         string
 	CurrentValue int64
 }
+
+type Payment struct {
+	ID             int64
+	Hash           []byte
+	AmountMsat     int64
+	CreatedAt      time.Time
+	PaymentRequest []byte
+	Destination    []byte
+	Status         int16
+	FailReason     sql.NullInt16
+	PaymentType    int16
+}
+
+type PaymentHtlcAttempt struct {
+	ID             int64
+	AttemptID      int64
+	PaymentID      int64
+	SessionKey     []byte
+	AttemptTime    time.Time
+	Hash           []byte
+	Route          []byte
+	AmountMsat     int64
+	FeeMsat        int64
+	SettlePreimage []byte
+	SettledAt      sql.NullTime
+}
+
+type PaymentHtlcFailure struct {
+	ID                 int64
+	HtlcAttemptID      int64
+	FailedAt           time.Time
+	Reason             int16
+	FailureMsg         []byte
+	FailureSourceIndex int64
+}
+
+type PaymentMigrationState struct {
+	ID              int32
+	LastSequenceNum int64
+	Completed       bool
+}
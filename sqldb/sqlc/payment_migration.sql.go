@@ -0,0 +1,97 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: payment_migration.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const getPaymentMigrationState = `-- name: GetPaymentMigrationState :one
+SELECT id, last_sequence_num, completed
+FROM payment_migration_state
+WHERE id = 1
+`
+
+func (q *Queries) GetPaymentMigrationState(ctx context.Context) (PaymentMigrationState, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentMigrationState)
+	var i PaymentMigrationState
+	err := row.Scan(&i.ID, &i.LastSequenceNum, &i.Completed)
+	return i, err
+}
+
+const insertMigratedPayment = `-- name: InsertMigratedPayment :exec
+INSERT INTO payments (
+    id, hash, amount_msat, created_at, payment_request, destination, status,
+    fail_reason, payment_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+`
+
+type InsertMigratedPaymentParams struct {
+	ID             int64
+	Hash           []byte
+	AmountMsat     int64
+	CreatedAt      time.Time
+	PaymentRequest []byte
+	Destination    []byte
+	Status         int16
+	FailReason     sql.NullInt16
+	PaymentType    int16
+}
+
+func (q *Queries) InsertMigratedPayment(ctx context.Context, arg InsertMigratedPaymentParams) error {
+	_, err := q.db.ExecContext(ctx, insertMigratedPayment,
+		arg.ID,
+		arg.Hash,
+		arg.AmountMsat,
+		arg.CreatedAt,
+		arg.PaymentRequest,
+		arg.Destination,
+		arg.Status,
+		arg.FailReason,
+		arg.PaymentType,
+	)
+	return err
+}
+
+const resetPaymentIDSequence = `-- name: ResetPaymentIDSequence :exec
+
+SELECT setval(
+    pg_get_serial_sequence('payments', 'id'),
+    (SELECT MAX(id) FROM payments)
+)
+`
+
+// This query is only supported by postgres. Sqlite always assigns the highest
+// existing ID plus one to new rows, so explicitly inserted IDs don't need any
+// sequence updates.
+func (q *Queries) ResetPaymentIDSequence(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, resetPaymentIDSequence)
+	return err
+}
+
+const upsertPaymentMigrationState = `-- name: UpsertPaymentMigrationState :exec
+INSERT INTO payment_migration_state (
+    id, last_sequence_num, completed
+) VALUES (
+    1, $1, $2
+) ON CONFLICT (id) DO UPDATE SET
+    last_sequence_num = EXCLUDED.last_sequence_num,
+    completed = EXCLUDED.completed
+`
+
+type UpsertPaymentMigrationStateParams struct {
+	LastSequenceNum int64
+	Completed       bool
+}
+
+func (q *Queries) UpsertPaymentMigrationState(ctx context.Context, arg UpsertPaymentMigrationStateParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPaymentMigrationState, arg.LastSequenceNum, arg.Completed)
+	return err
+}
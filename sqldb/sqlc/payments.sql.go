@@ -0,0 +1,469 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: payments.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const countPayments = `-- name: CountPayments :one
+SELECT COUNT(*)
+FROM payments
+`
+
+func (q *Queries) CountPayments(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPayments)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteFailedHTLCAttempts = `-- name: DeleteFailedHTLCAttempts :exec
+DELETE
+FROM payment_htlc_attempts
+WHERE id IN (
+    SELECT htlc_attempt_id
+    FROM payment_htlc_failures
+) AND payment_id IN (
+    SELECT id
+    FROM payments
+    WHERE status != 2 AND (
+        status = $1 OR
+        $1 IS NULL
+    ) AND (
+        id = $2 OR
+        $2 IS NULL
+    )
+)
+`
+
+type DeleteFailedHTLCAttemptsParams struct {
+	Status    sql.NullInt16
+	PaymentID sql.NullInt64
+}
+
+func (q *Queries) DeleteFailedHTLCAttempts(ctx context.Context, arg DeleteFailedHTLCAttemptsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteFailedHTLCAttempts, arg.Status, arg.PaymentID)
+	return err
+}
+
+const deletePayment = `-- name: DeletePayment :exec
+DELETE
+FROM payments
+WHERE id = $1
+`
+
+func (q *Queries) DeletePayment(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deletePayment, id)
+	return err
+}
+
+const deletePayments = `-- name: DeletePayments :exec
+DELETE
+FROM payments
+WHERE status != 2 AND (
+    status = $1 OR
+    $1 IS NULL
+)
+`
+
+func (q *Queries) DeletePayments(ctx context.Context, status sql.NullInt16) error {
+	_, err := q.db.ExecContext(ctx, deletePayments, status)
+	return err
+}
+
+const fetchHTLCAttempts = `-- name: FetchHTLCAttempts :many
+SELECT
+    a.id, a.attempt_id, a.payment_id, a.session_key, a.attempt_time, a.hash, a.route, a.amount_msat, a.fee_msat, a.settle_preimage, a.settled_at,
+    f.failed_at,
+    f.reason,
+    f.failure_msg,
+    f.failure_source_index
+FROM payment_htlc_attempts a
+LEFT JOIN payment_htlc_failures f ON f.htlc_attempt_id = a.id
+WHERE a.payment_id = $1
+ORDER BY a.attempt_id
+`
+
+type FetchHTLCAttemptsRow struct {
+	ID                 int64
+	AttemptID          int64
+	PaymentID          int64
+	SessionKey         []byte
+	AttemptTime        time.Time
+	Hash               []byte
+	Route              []byte
+	AmountMsat         int64
+	FeeMsat            int64
+	SettlePreimage     []byte
+	SettledAt          sql.NullTime
+	FailedAt           sql.NullTime
+	Reason             sql.NullInt16
+	FailureMsg         []byte
+	FailureSourceIndex sql.NullInt64
+}
+
+func (q *Queries) FetchHTLCAttempts(ctx context.Context, paymentID int64) ([]FetchHTLCAttemptsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchHTLCAttempts, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchHTLCAttemptsRow
+	for rows.Next() {
+		var i FetchHTLCAttemptsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AttemptID,
+			&i.PaymentID,
+			&i.SessionKey,
+			&i.AttemptTime,
+			&i.Hash,
+			&i.Route,
+			&i.AmountMsat,
+			&i.FeeMsat,
+			&i.SettlePreimage,
+			&i.SettledAt,
+			&i.FailedAt,
+			&i.Reason,
+			&i.FailureMsg,
+			&i.FailureSourceIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchInFlightPayments = `-- name: FetchInFlightPayments :many
+SELECT id, hash, amount_msat, created_at, payment_request, destination, status, fail_reason, payment_type
+FROM payments
+WHERE status = 1 OR status = 2
+ORDER BY id
+`
+
+func (q *Queries) FetchInFlightPayments(ctx context.Context) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, fetchInFlightPayments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.Hash,
+			&i.AmountMsat,
+			&i.CreatedAt,
+			&i.PaymentRequest,
+			&i.Destination,
+			&i.Status,
+			&i.FailReason,
+			&i.PaymentType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchPayment = `-- name: FetchPayment :one
+SELECT id, hash, amount_msat, created_at, payment_request, destination, status, fail_reason, payment_type
+FROM payments
+WHERE hash = $1
+`
+
+func (q *Queries) FetchPayment(ctx context.Context, hash []byte) (Payment, error) {
+	row := q.db.QueryRowContext(ctx, fetchPayment, hash)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.Hash,
+		&i.AmountMsat,
+		&i.CreatedAt,
+		&i.PaymentRequest,
+		&i.Destination,
+		&i.Status,
+		&i.FailReason,
+		&i.PaymentType,
+	)
+	return i, err
+}
+
+const filterPayments = `-- name: FilterPayments :many
+SELECT
+    payments.id, payments.hash, payments.amount_msat, payments.created_at, payments.payment_request, payments.destination, payments.status, payments.fail_reason, payments.payment_type
+FROM payments
+WHERE (
+    id > $1 OR
+    $1 IS NULL
+) AND (
+    id < $2 OR
+    $2 IS NULL
+) AND (
+    status = $3 OR
+    $3 IS NULL
+) AND (
+    created_at >= $4 OR
+    $4 IS NULL
+) AND (
+    created_at < $5 OR
+    $5 IS NULL
+) AND (
+    destination = $6 OR
+    $6 IS NULL
+) AND (
+    amount_msat >= $7 OR
+    $7 IS NULL
+) AND (
+    amount_msat <= $8 OR
+    $8 IS NULL
+)
+ORDER BY
+CASE
+    WHEN $9 = FALSE OR $9 IS NULL THEN id
+    ELSE NULL
+    END ASC,
+CASE
+    WHEN $9 = TRUE THEN id
+    ELSE NULL
+END DESC
+LIMIT $10
+`
+
+type FilterPaymentsParams struct {
+	IndexOffsetGt sql.NullInt64
+	IndexOffsetLt sql.NullInt64
+	Status        sql.NullInt16
+	CreatedAfter  sql.NullTime
+	CreatedBefore sql.NullTime
+	Destination   []byte
+	MinAmountMsat sql.NullInt64
+	MaxAmountMsat sql.NullInt64
+	Reverse       interface{}
+	NumLimit      int32
+}
+
+func (q *Queries) FilterPayments(ctx context.Context, arg FilterPaymentsParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, filterPayments,
+		arg.IndexOffsetGt,
+		arg.IndexOffsetLt,
+		arg.Status,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Destination,
+		arg.MinAmountMsat,
+		arg.MaxAmountMsat,
+		arg.Reverse,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.Hash,
+			&i.AmountMsat,
+			&i.CreatedAt,
+			&i.PaymentRequest,
+			&i.Destination,
+			&i.Status,
+			&i.FailReason,
+			&i.PaymentType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertHTLCAttempt = `-- name: InsertHTLCAttempt :one
+INSERT INTO payment_htlc_attempts (
+    attempt_id, payment_id, session_key, attempt_time, hash, route,
+    amount_msat, fee_msat
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id
+`
+
+type InsertHTLCAttemptParams struct {
+	AttemptID   int64
+	PaymentID   int64
+	SessionKey  []byte
+	AttemptTime time.Time
+	Hash        []byte
+	Route       []byte
+	AmountMsat  int64
+	FeeMsat     int64
+}
+
+func (q *Queries) InsertHTLCAttempt(ctx context.Context, arg InsertHTLCAttemptParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertHTLCAttempt,
+		arg.AttemptID,
+		arg.PaymentID,
+		arg.SessionKey,
+		arg.AttemptTime,
+		arg.Hash,
+		arg.Route,
+		arg.AmountMsat,
+		arg.FeeMsat,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertHTLCFailure = `-- name: InsertHTLCFailure :exec
+INSERT INTO payment_htlc_failures (
+    htlc_attempt_id, failed_at, reason, failure_msg, failure_source_index
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+`
+
+type InsertHTLCFailureParams struct {
+	HtlcAttemptID      int64
+	FailedAt           time.Time
+	Reason             int16
+	FailureMsg         []byte
+	FailureSourceIndex int64
+}
+
+func (q *Queries) InsertHTLCFailure(ctx context.Context, arg InsertHTLCFailureParams) error {
+	_, err := q.db.ExecContext(ctx, insertHTLCFailure,
+		arg.HtlcAttemptID,
+		arg.FailedAt,
+		arg.Reason,
+		arg.FailureMsg,
+		arg.FailureSourceIndex,
+	)
+	return err
+}
+
+const insertPayment = `-- name: InsertPayment :one
+INSERT INTO payments (
+    hash, amount_msat, created_at, payment_request, status, payment_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id
+`
+
+type InsertPaymentParams struct {
+	Hash           []byte
+	AmountMsat     int64
+	CreatedAt      time.Time
+	PaymentRequest []byte
+	Status         int16
+	PaymentType    int16
+}
+
+func (q *Queries) InsertPayment(ctx context.Context, arg InsertPaymentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertPayment,
+		arg.Hash,
+		arg.AmountMsat,
+		arg.CreatedAt,
+		arg.PaymentRequest,
+		arg.Status,
+		arg.PaymentType,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const settleHTLCAttempt = `-- name: SettleHTLCAttempt :exec
+UPDATE payment_htlc_attempts
+SET settle_preimage = $2,
+    settled_at = $3
+WHERE id = $1
+`
+
+type SettleHTLCAttemptParams struct {
+	ID             int64
+	SettlePreimage []byte
+	SettledAt      sql.NullTime
+}
+
+func (q *Queries) SettleHTLCAttempt(ctx context.Context, arg SettleHTLCAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, settleHTLCAttempt, arg.ID, arg.SettlePreimage, arg.SettledAt)
+	return err
+}
+
+const updatePaymentDestination = `-- name: UpdatePaymentDestination :exec
+UPDATE payments
+SET destination = COALESCE(destination, $2)
+WHERE id = $1
+`
+
+type UpdatePaymentDestinationParams struct {
+	ID          int64
+	Destination []byte
+}
+
+func (q *Queries) UpdatePaymentDestination(ctx context.Context, arg UpdatePaymentDestinationParams) error {
+	_, err := q.db.ExecContext(ctx, updatePaymentDestination, arg.ID, arg.Destination)
+	return err
+}
+
+const updatePaymentFailReason = `-- name: UpdatePaymentFailReason :exec
+UPDATE payments
+SET fail_reason = $2
+WHERE id = $1
+`
+
+type UpdatePaymentFailReasonParams struct {
+	ID         int64
+	FailReason sql.NullInt16
+}
+
+func (q *Queries) UpdatePaymentFailReason(ctx context.Context, arg UpdatePaymentFailReasonParams) error {
+	_, err := q.db.ExecContext(ctx, updatePaymentFailReason, arg.ID, arg.FailReason)
+	return err
+}
+
+const updatePaymentStatus = `-- name: UpdatePaymentStatus :exec
+UPDATE payments
+SET status = $2
+WHERE id = $1
+`
+
+type UpdatePaymentStatusParams struct {
+	ID     int64
+	Status int16
+}
+
+func (q *Queries) UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updatePaymentStatus, arg.ID, arg.Status)
+	return err
+}
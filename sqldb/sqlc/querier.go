@@ -10,13 +10,34 @@ import (
 )
 
 type Querier interface {
+	CountInvoices(ctx context.Context) (int64, error)
+	CountPayments(ctx context.Context) (int64, error)
+	CountZombieChannels(ctx context.Context) (int64, error)
 	DeleteCanceledInvoices(ctx context.Context) (sql.Result, error)
+	DeleteChannel(ctx context.Context, id int64) error
+	DeleteFailedHTLCAttempts(ctx context.Context, arg DeleteFailedHTLCAttemptsParams) error
 	DeleteInvoice(ctx context.Context, arg DeleteInvoiceParams) (sql.Result, error)
+	DeleteInvoiceMigrationHashes(ctx context.Context) error
+	DeletePayment(ctx context.Context, id int64) error
+	DeletePayments(ctx context.Context, status sql.NullInt16) error
+	DeletePruneLogEntriesFrom(ctx context.Context, blockHeight int64) error
+	DeleteSourceNodes(ctx context.Context) error
+	DeleteUnconnectedNodes(ctx context.Context) ([][]byte, error)
+	DeleteZombieChannel(ctx context.Context, scid []byte) (sql.Result, error)
 	FetchAMPSubInvoiceHTLCs(ctx context.Context, arg FetchAMPSubInvoiceHTLCsParams) ([]FetchAMPSubInvoiceHTLCsRow, error)
 	FetchAMPSubInvoices(ctx context.Context, arg FetchAMPSubInvoicesParams) ([]AmpSubInvoice, error)
+	FetchHTLCAttempts(ctx context.Context, paymentID int64) ([]FetchHTLCAttemptsRow, error)
+	FetchInFlightPayments(ctx context.Context) ([]Payment, error)
+	FetchPayment(ctx context.Context, hash []byte) (Payment, error)
 	FetchSettledAMPSubInvoices(ctx context.Context, arg FetchSettledAMPSubInvoicesParams) ([]FetchSettledAMPSubInvoicesRow, error)
 	FilterInvoices(ctx context.Context, arg FilterInvoicesParams) ([]Invoice, error)
+	FilterPayments(ctx context.Context, arg FilterPaymentsParams) ([]Payment, error)
 	GetAMPInvoiceID(ctx context.Context, setID []byte) (int64, error)
+	GetChannelByOutpoint(ctx context.Context, outpoint string) (GraphChannel, error)
+	GetChannelBySCID(ctx context.Context, scid []byte) (GraphChannel, error)
+	GetChannelPolicies(ctx context.Context, channelID int64) ([]GraphChannelPolicy, error)
+	GetClosedSCID(ctx context.Context, scid []byte) (GraphClosedScid, error)
+	GetHighestSCID(ctx context.Context) ([]byte, error)
 	// This method may return more than one invoice if filter using multiple fields
 	// from different invoices. It is the caller's responsibility to ensure that
 	// we bubble up an error in those cases.
@@ -24,11 +45,37 @@ type Querier interface {
 	GetInvoiceFeatures(ctx context.Context, invoiceID int64) ([]InvoiceFeature, error)
 	GetInvoiceHTLCCustomRecords(ctx context.Context, invoiceID int64) ([]GetInvoiceHTLCCustomRecordsRow, error)
 	GetInvoiceHTLCs(ctx context.Context, invoiceID int64) ([]InvoiceHtlc, error)
+	GetInvoiceMigrationHash(ctx context.Context, addIndex int64) ([]byte, error)
+	GetInvoiceMigrationState(ctx context.Context) (InvoiceMigrationState, error)
+	GetNodeByPubKey(ctx context.Context, pubKey []byte) (GraphNode, error)
+	GetPaymentMigrationState(ctx context.Context) (PaymentMigrationState, error)
+	GetPruneTip(ctx context.Context) (GraphPruneLog, error)
+	GetSourceNode(ctx context.Context) (GraphNode, error)
+	GetZombieChannel(ctx context.Context, scid []byte) (GraphZombieChannel, error)
 	InsertAMPSubInvoiceHTLC(ctx context.Context, arg InsertAMPSubInvoiceHTLCParams) error
+	InsertChannel(ctx context.Context, arg InsertChannelParams) error
+	InsertClosedSCID(ctx context.Context, scid []byte) error
+	InsertHTLCAttempt(ctx context.Context, arg InsertHTLCAttemptParams) (int64, error)
+	InsertHTLCFailure(ctx context.Context, arg InsertHTLCFailureParams) error
 	InsertInvoice(ctx context.Context, arg InsertInvoiceParams) (int64, error)
 	InsertInvoiceFeature(ctx context.Context, arg InsertInvoiceFeatureParams) error
 	InsertInvoiceHTLC(ctx context.Context, arg InsertInvoiceHTLCParams) (int64, error)
 	InsertInvoiceHTLCCustomRecord(ctx context.Context, arg InsertInvoiceHTLCCustomRecordParams) error
+	InsertInvoiceMigrationHash(ctx context.Context, arg InsertInvoiceMigrationHashParams) error
+	InsertMigratedInvoice(ctx context.Context, arg InsertMigratedInvoiceParams) error
+	InsertMigratedPayment(ctx context.Context, arg InsertMigratedPaymentParams) error
+	InsertPayment(ctx context.Context, arg InsertPaymentParams) (int64, error)
+	InsertShellNode(ctx context.Context, pubKey []byte) error
+	InsertSourceNode(ctx context.Context, pubKey []byte) error
+	ListChannelPoliciesInRange(ctx context.Context, arg ListChannelPoliciesInRangeParams) ([]GraphChannelPolicy, error)
+	ListChannelsInSCIDRange(ctx context.Context, arg ListChannelsInSCIDRangeParams) ([]GraphChannel, error)
+	ListChannelsPaginated(ctx context.Context, arg ListChannelsPaginatedParams) ([]GraphChannel, error)
+	ListChannelsUpdatedInRange(ctx context.Context, arg ListChannelsUpdatedInRangeParams) ([]GraphChannel, error)
+	ListDisabledChannelSCIDs(ctx context.Context) ([][]byte, error)
+	ListNodeChannelPolicies(ctx context.Context, nodeKey1 []byte) ([]GraphChannelPolicy, error)
+	ListNodeChannels(ctx context.Context, nodeKey1 []byte) ([]GraphChannel, error)
+	ListNodesPaginated(ctx context.Context, arg ListNodesPaginatedParams) ([]GraphNode, error)
+	ListNodesUpdatedInRange(ctx context.Context, arg ListNodesUpdatedInRangeParams) ([]GraphNode, error)
 	NextInvoiceSettleIndex(ctx context.Context) (int64, error)
 	OnAMPSubInvoiceCanceled(ctx context.Context, arg OnAMPSubInvoiceCanceledParams) error
 	OnAMPSubInvoiceCreated(ctx context.Context, arg OnAMPSubInvoiceCreatedParams) error
@@ -36,13 +83,33 @@ type Querier interface {
 	OnInvoiceCanceled(ctx context.Context, arg OnInvoiceCanceledParams) error
 	OnInvoiceCreated(ctx context.Context, arg OnInvoiceCreatedParams) error
 	OnInvoiceSettled(ctx context.Context, arg OnInvoiceSettledParams) error
+	// This query is only supported by postgres. Sqlite always assigns the highest
+	// existing ID plus one to new rows, so explicitly inserted IDs don't need any
+	// sequence updates.
+	ResetInvoiceIDSequence(ctx context.Context) error
+	// This query is only supported by postgres. Sqlite always assigns the highest
+	// existing ID plus one to new rows, so explicitly inserted IDs don't need any
+	// sequence updates.
+	ResetPaymentIDSequence(ctx context.Context) error
+	SetInvoiceSettleIndex(ctx context.Context, currentValue int64) error
+	SettleHTLCAttempt(ctx context.Context, arg SettleHTLCAttemptParams) error
 	UpdateAMPSubInvoiceHTLCPreimage(ctx context.Context, arg UpdateAMPSubInvoiceHTLCPreimageParams) (sql.Result, error)
 	UpdateAMPSubInvoiceState(ctx context.Context, arg UpdateAMPSubInvoiceStateParams) error
+	UpdateChannel(ctx context.Context, arg UpdateChannelParams) error
 	UpdateInvoiceAmountPaid(ctx context.Context, arg UpdateInvoiceAmountPaidParams) (sql.Result, error)
 	UpdateInvoiceHTLC(ctx context.Context, arg UpdateInvoiceHTLCParams) error
 	UpdateInvoiceHTLCs(ctx context.Context, arg UpdateInvoiceHTLCsParams) error
 	UpdateInvoiceState(ctx context.Context, arg UpdateInvoiceStateParams) (sql.Result, error)
+	UpdatePaymentDestination(ctx context.Context, arg UpdatePaymentDestinationParams) error
+	UpdatePaymentFailReason(ctx context.Context, arg UpdatePaymentFailReasonParams) error
+	UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStatusParams) error
 	UpsertAMPSubInvoice(ctx context.Context, arg UpsertAMPSubInvoiceParams) (sql.Result, error)
+	UpsertChannelPolicy(ctx context.Context, arg UpsertChannelPolicyParams) error
+	UpsertInvoiceMigrationState(ctx context.Context, arg UpsertInvoiceMigrationStateParams) error
+	UpsertNode(ctx context.Context, arg UpsertNodeParams) error
+	UpsertPaymentMigrationState(ctx context.Context, arg UpsertPaymentMigrationStateParams) error
+	UpsertPruneLogEntry(ctx context.Context, arg UpsertPruneLogEntryParams) error
+	UpsertZombieChannel(ctx context.Context, arg UpsertZombieChannelParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,246 @@
+-- name: UpsertNode :exec
+INSERT INTO graph_nodes (
+    pub_key, have_announcement, last_update, alias, color, features,
+    addresses, auth_sig, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) ON CONFLICT (pub_key) DO UPDATE SET
+    have_announcement = EXCLUDED.have_announcement,
+    last_update = EXCLUDED.last_update,
+    alias = EXCLUDED.alias,
+    color = EXCLUDED.color,
+    features = EXCLUDED.features,
+    addresses = EXCLUDED.addresses,
+    auth_sig = EXCLUDED.auth_sig,
+    extra_opaque_data = EXCLUDED.extra_opaque_data;
+
+-- name: InsertShellNode :exec
+INSERT INTO graph_nodes (
+    pub_key, have_announcement, last_update
+) VALUES (
+    $1, FALSE, 0
+) ON CONFLICT (pub_key) DO NOTHING;
+
+-- name: GetNodeByPubKey :one
+SELECT *
+FROM graph_nodes
+WHERE pub_key = $1;
+
+-- name: ListNodesPaginated :many
+SELECT *
+FROM graph_nodes
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: ListNodesUpdatedInRange :many
+SELECT *
+FROM graph_nodes
+WHERE last_update >= @start_time AND last_update <= @end_time
+ORDER BY last_update, pub_key;
+
+-- name: DeleteUnconnectedNodes :many
+DELETE
+FROM graph_nodes
+WHERE pub_key NOT IN (
+    SELECT node_key_1 FROM graph_channels
+    UNION
+    SELECT node_key_2 FROM graph_channels
+) AND pub_key NOT IN (
+    SELECT pub_key FROM graph_source_nodes
+)
+RETURNING pub_key;
+
+-- name: GetSourceNode :one
+SELECT n.*
+FROM graph_nodes n
+JOIN graph_source_nodes s ON s.pub_key = n.pub_key;
+
+-- name: DeleteSourceNodes :exec
+DELETE
+FROM graph_source_nodes;
+
+-- name: InsertSourceNode :exec
+INSERT INTO graph_source_nodes (
+    pub_key
+) VALUES (
+    $1
+);
+
+-- name: InsertChannel :exec
+INSERT INTO graph_channels (
+    scid, chain_hash, node_key_1, node_key_2, bitcoin_key_1, bitcoin_key_2,
+    features, node_sig_1, node_sig_2, bitcoin_sig_1, bitcoin_sig_2, outpoint,
+    capacity, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+);
+
+-- name: UpdateChannel :exec
+UPDATE graph_channels
+SET chain_hash = $2,
+    node_key_1 = $3,
+    node_key_2 = $4,
+    bitcoin_key_1 = $5,
+    bitcoin_key_2 = $6,
+    features = $7,
+    node_sig_1 = $8,
+    node_sig_2 = $9,
+    bitcoin_sig_1 = $10,
+    bitcoin_sig_2 = $11,
+    outpoint = $12,
+    capacity = $13,
+    extra_opaque_data = $14
+WHERE scid = $1;
+
+-- name: GetChannelBySCID :one
+SELECT *
+FROM graph_channels
+WHERE scid = $1;
+
+-- name: GetChannelByOutpoint :one
+SELECT *
+FROM graph_channels
+WHERE outpoint = $1;
+
+-- name: DeleteChannel :exec
+DELETE
+FROM graph_channels
+WHERE id = $1;
+
+-- name: ListChannelsPaginated :many
+SELECT *
+FROM graph_channels
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: ListNodeChannels :many
+SELECT *
+FROM graph_channels
+WHERE node_key_1 = $1 OR node_key_2 = $1
+ORDER BY id;
+
+-- name: ListChannelsInSCIDRange :many
+SELECT *
+FROM graph_channels
+WHERE scid >= @start_scid AND scid < @end_scid
+ORDER BY scid;
+
+-- name: GetHighestSCID :one
+SELECT scid
+FROM graph_channels
+ORDER BY scid DESC
+LIMIT 1;
+
+-- name: ListChannelsUpdatedInRange :many
+SELECT *
+FROM graph_channels
+WHERE id IN (
+    SELECT channel_id
+    FROM graph_channel_policies
+    WHERE last_update >= @start_time AND last_update <= @end_time
+)
+ORDER BY id;
+
+-- name: ListDisabledChannelSCIDs :many
+SELECT c.scid
+FROM graph_channels c
+JOIN graph_channel_policies p ON p.channel_id = c.id
+WHERE p.disabled = TRUE
+GROUP BY c.id, c.scid
+HAVING COUNT(*) = 2
+ORDER BY c.id;
+
+-- name: UpsertChannelPolicy :exec
+INSERT INTO graph_channel_policies (
+    channel_id, direction, last_update, signature, message_flags,
+    channel_flags, timelock, min_htlc_msat, max_htlc_msat, base_fee_msat,
+    fee_ppm, disabled, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) ON CONFLICT (channel_id, direction) DO UPDATE SET
+    last_update = EXCLUDED.last_update,
+    signature = EXCLUDED.signature,
+    message_flags = EXCLUDED.message_flags,
+    channel_flags = EXCLUDED.channel_flags,
+    timelock = EXCLUDED.timelock,
+    min_htlc_msat = EXCLUDED.min_htlc_msat,
+    max_htlc_msat = EXCLUDED.max_htlc_msat,
+    base_fee_msat = EXCLUDED.base_fee_msat,
+    fee_ppm = EXCLUDED.fee_ppm,
+    disabled = EXCLUDED.disabled,
+    extra_opaque_data = EXCLUDED.extra_opaque_data;
+
+-- name: GetChannelPolicies :many
+SELECT *
+FROM graph_channel_policies
+WHERE channel_id = $1
+ORDER BY direction;
+
+-- name: ListChannelPoliciesInRange :many
+SELECT *
+FROM graph_channel_policies
+WHERE channel_id > @start_id AND channel_id <= @end_id
+ORDER BY channel_id, direction;
+
+-- name: ListNodeChannelPolicies :many
+SELECT p.*
+FROM graph_channel_policies p
+JOIN graph_channels c ON c.id = p.channel_id
+WHERE c.node_key_1 = $1 OR c.node_key_2 = $1
+ORDER BY p.channel_id, p.direction;
+
+-- name: UpsertZombieChannel :exec
+INSERT INTO graph_zombie_channels (
+    scid, node_key_1, node_key_2
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (scid) DO UPDATE SET
+    node_key_1 = EXCLUDED.node_key_1,
+    node_key_2 = EXCLUDED.node_key_2;
+
+-- name: GetZombieChannel :one
+SELECT *
+FROM graph_zombie_channels
+WHERE scid = $1;
+
+-- name: DeleteZombieChannel :execresult
+DELETE
+FROM graph_zombie_channels
+WHERE scid = $1;
+
+-- name: CountZombieChannels :one
+SELECT COUNT(*)
+FROM graph_zombie_channels;
+
+-- name: InsertClosedSCID :exec
+INSERT INTO graph_closed_scids (
+    scid
+) VALUES (
+    $1
+) ON CONFLICT (scid) DO NOTHING;
+
+-- name: GetClosedSCID :one
+SELECT *
+FROM graph_closed_scids
+WHERE scid = $1;
+
+-- name: UpsertPruneLogEntry :exec
+INSERT INTO graph_prune_log (
+    block_height, block_hash
+) VALUES (
+    $1, $2
+) ON CONFLICT (block_height) DO UPDATE SET
+    block_hash = EXCLUDED.block_hash;
+
+-- name: GetPruneTip :one
+SELECT *
+FROM graph_prune_log
+ORDER BY block_height DESC
+LIMIT 1;
+
+-- name: DeletePruneLogEntriesFrom :exec
+DELETE
+FROM graph_prune_log
+WHERE block_height >= $1;
@@ -0,0 +1,59 @@
+-- name: InsertMigratedInvoice :exec
+INSERT INTO invoices (
+    id, hash, preimage, settle_index, settled_at, memo, amount_msat,
+    cltv_delta, expiry, payment_addr, payment_request, payment_request_hash,
+    state, amount_paid_msat, is_amp, is_hodl, is_keysend, created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+    $18
+);
+
+-- name: CountInvoices :one
+SELECT COUNT(*)
+FROM invoices;
+
+-- name: SetInvoiceSettleIndex :exec
+UPDATE invoice_sequences SET current_value = $1
+WHERE name = 'settle_index';
+
+-- This query is only supported by postgres. Sqlite always assigns the highest
+-- existing ID plus one to new rows, so explicitly inserted IDs don't need any
+-- sequence updates.
+
+-- name: ResetInvoiceIDSequence :exec
+SELECT setval(
+    pg_get_serial_sequence('invoices', 'id'),
+    (SELECT MAX(id) FROM invoices)
+);
+
+-- name: GetInvoiceMigrationState :one
+SELECT *
+FROM invoice_migration_state
+WHERE id = 1;
+
+-- name: UpsertInvoiceMigrationState :exec
+INSERT INTO invoice_migration_state (
+    id, hashes_migrated, last_add_index, max_settle_index, completed
+) VALUES (
+    1, $1, $2, $3, $4
+) ON CONFLICT (id) DO UPDATE SET
+    hashes_migrated = EXCLUDED.hashes_migrated,
+    last_add_index = EXCLUDED.last_add_index,
+    max_settle_index = EXCLUDED.max_settle_index,
+    completed = EXCLUDED.completed;
+
+-- name: InsertInvoiceMigrationHash :exec
+INSERT INTO invoice_migration_hashes (
+    add_index, hash
+) VALUES (
+    $1, $2
+) ON CONFLICT (add_index) DO NOTHING;
+
+-- name: GetInvoiceMigrationHash :one
+SELECT hash
+FROM invoice_migration_hashes
+WHERE add_index = $1;
+
+-- name: DeleteInvoiceMigrationHashes :exec
+DELETE
+FROM invoice_migration_hashes;
@@ -0,0 +1,31 @@
+-- name: InsertMigratedPayment :exec
+INSERT INTO payments (
+    id, hash, amount_msat, created_at, payment_request, destination, status,
+    fail_reason, payment_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- This query is only supported by postgres. Sqlite always assigns the highest
+-- existing ID plus one to new rows, so explicitly inserted IDs don't need any
+-- sequence updates.
+
+-- name: ResetPaymentIDSequence :exec
+SELECT setval(
+    pg_get_serial_sequence('payments', 'id'),
+    (SELECT MAX(id) FROM payments)
+);
+
+-- name: GetPaymentMigrationState :one
+SELECT *
+FROM payment_migration_state
+WHERE id = 1;
+
+-- name: UpsertPaymentMigrationState :exec
+INSERT INTO payment_migration_state (
+    id, last_sequence_num, completed
+) VALUES (
+    1, $1, $2
+) ON CONFLICT (id) DO UPDATE SET
+    last_sequence_num = EXCLUDED.last_sequence_num,
+    completed = EXCLUDED.completed;
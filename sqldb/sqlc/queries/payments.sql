@@ -0,0 +1,140 @@
+-- name: InsertPayment :one
+INSERT INTO payments (
+    hash, amount_msat, created_at, payment_request, status, payment_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id;
+
+-- name: FetchPayment :one
+SELECT *
+FROM payments
+WHERE hash = $1;
+
+-- name: UpdatePaymentStatus :exec
+UPDATE payments
+SET status = $2
+WHERE id = $1;
+
+-- name: UpdatePaymentFailReason :exec
+UPDATE payments
+SET fail_reason = $2
+WHERE id = $1;
+
+-- name: UpdatePaymentDestination :exec
+UPDATE payments
+SET destination = COALESCE(destination, $2)
+WHERE id = $1;
+
+-- name: DeletePayment :exec
+DELETE
+FROM payments
+WHERE id = $1;
+
+-- name: DeletePayments :exec
+DELETE
+FROM payments
+WHERE status != 2 AND (
+    status = sqlc.narg('status') OR
+    sqlc.narg('status') IS NULL
+);
+
+-- name: FilterPayments :many
+SELECT
+    payments.*
+FROM payments
+WHERE (
+    id > sqlc.narg('index_offset_gt') OR
+    sqlc.narg('index_offset_gt') IS NULL
+) AND (
+    id < sqlc.narg('index_offset_lt') OR
+    sqlc.narg('index_offset_lt') IS NULL
+) AND (
+    status = sqlc.narg('status') OR
+    sqlc.narg('status') IS NULL
+) AND (
+    created_at >= sqlc.narg('created_after') OR
+    sqlc.narg('created_after') IS NULL
+) AND (
+    created_at < sqlc.narg('created_before') OR
+    sqlc.narg('created_before') IS NULL
+) AND (
+    destination = sqlc.narg('destination') OR
+    sqlc.narg('destination') IS NULL
+) AND (
+    amount_msat >= sqlc.narg('min_amount_msat') OR
+    sqlc.narg('min_amount_msat') IS NULL
+) AND (
+    amount_msat <= sqlc.narg('max_amount_msat') OR
+    sqlc.narg('max_amount_msat') IS NULL
+)
+ORDER BY
+CASE
+    WHEN sqlc.narg('reverse') = FALSE OR sqlc.narg('reverse') IS NULL THEN id
+    ELSE NULL
+    END ASC,
+CASE
+    WHEN sqlc.narg('reverse') = TRUE THEN id
+    ELSE NULL
+END DESC
+LIMIT @num_limit;
+
+-- name: CountPayments :one
+SELECT COUNT(*)
+FROM payments;
+
+-- name: FetchInFlightPayments :many
+SELECT *
+FROM payments
+WHERE status = 1 OR status = 2
+ORDER BY id;
+
+-- name: InsertHTLCAttempt :one
+INSERT INTO payment_htlc_attempts (
+    attempt_id, payment_id, session_key, attempt_time, hash, route,
+    amount_msat, fee_msat
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id;
+
+-- name: SettleHTLCAttempt :exec
+UPDATE payment_htlc_attempts
+SET settle_preimage = $2,
+    settled_at = $3
+WHERE id = $1;
+
+-- name: InsertHTLCFailure :exec
+INSERT INTO payment_htlc_failures (
+    htlc_attempt_id, failed_at, reason, failure_msg, failure_source_index
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: FetchHTLCAttempts :many
+SELECT
+    a.*,
+    f.failed_at,
+    f.reason,
+    f.failure_msg,
+    f.failure_source_index
+FROM payment_htlc_attempts a
+LEFT JOIN payment_htlc_failures f ON f.htlc_attempt_id = a.id
+WHERE a.payment_id = $1
+ORDER BY a.attempt_id;
+
+-- name: DeleteFailedHTLCAttempts :exec
+DELETE
+FROM payment_htlc_attempts
+WHERE id IN (
+    SELECT htlc_attempt_id
+    FROM payment_htlc_failures
+) AND payment_id IN (
+    SELECT id
+    FROM payments
+    WHERE status != 2 AND (
+        status = sqlc.narg('status') OR
+        sqlc.narg('status') IS NULL
+    ) AND (
+        id = sqlc.narg('payment_id') OR
+        sqlc.narg('payment_id') IS NULL
+    )
+);
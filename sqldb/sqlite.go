@@ -105,7 +105,7 @@ func NewSqliteStore(cfg *SqliteConfig, dbPath string) (*SqliteStore, error) {
 	db.SetMaxOpenConns(defaultMaxConns)
 	db.SetMaxIdleConns(defaultMaxConns)
 	db.SetConnMaxLifetime(connIdleLifetime)
-	queries := sqlc.New(db)
+	queries := sqlc.NewForType(db, sqlc.BackendTypeSqlite)
 
 	s := &SqliteStore{
 		cfg: cfg,
@@ -0,0 +1,342 @@
+package trampoline
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// RoutingInfoSize is the size of the routing info of a trampoline
+	// onion. It is smaller than the routing info of a regular onion, so
+	// that the trampoline onion fits into the payload of a single hop of
+	// the outer onion.
+	RoutingInfoSize = 400
+
+	// hmacSize is the size of the HMAC that authenticates each layer of
+	// the onion.
+	hmacSize = 32
+
+	// onionVersion is the only supported version of trampoline onions.
+	onionVersion = 0
+
+	// OnionSize is the size of a serialized trampoline onion.
+	OnionSize = 1 + btcec.PubKeyBytesLenCompressed + RoutingInfoSize +
+		hmacSize
+)
+
+var (
+	// ErrInvalidOnionVersion is returned when a trampoline onion with an
+	// unknown version is processed.
+	ErrInvalidOnionVersion = errors.New("invalid trampoline onion version")
+
+	// ErrInvalidOnionHMAC is returned when the HMAC of a trampoline onion
+	// doesn't match, which means the onion was tampered with or isn't
+	// meant for us.
+	ErrInvalidOnionHMAC = errors.New("invalid trampoline onion hmac")
+
+	// ErrInvalidOnion is returned when a trampoline onion can't be parsed.
+	ErrInvalidOnion = errors.New("invalid trampoline onion")
+
+	// ErrPayloadTooLarge is returned when the payloads of all hops don't
+	// fit into the routing info of a trampoline onion.
+	ErrPayloadTooLarge = errors.New("trampoline payloads too large")
+)
+
+// Hop is a trampoline node that an onion is built for.
+type Hop struct {
+	// NodeKey is the public key of the trampoline node.
+	NodeKey *btcec.PublicKey
+
+	// Payload is the serialized TLV payload for the trampoline node.
+	Payload []byte
+}
+
+// PeeledOnion is the result of processing a trampoline onion.
+type PeeledOnion struct {
+	// Payload is the serialized TLV payload that is meant for us.
+	Payload []byte
+
+	// NextOnion is the onion that must be handed to the next trampoline
+	// node. It is nil if we are the final node.
+	NextOnion []byte
+
+	// IsFinal is true if we are the final node of the trampoline route.
+	IsFinal bool
+}
+
+// BuildOnion builds a trampoline onion that carries the given payloads to
+// the hops, in order. The associated data, usually the payment hash, is
+// committed to by every layer of the onion.
+func BuildOnion(sessionKey *btcec.PrivateKey, hops []Hop,
+	assocData []byte) ([]byte, error) {
+
+	if len(hops) == 0 {
+		return nil, errors.New("trampoline onion needs at least " +
+			"one hop")
+	}
+
+	var total int
+	for _, hop := range hops {
+		total += hopSize(hop.Payload)
+	}
+	if total > RoutingInfoSize {
+		return nil, fmt.Errorf("%w: %d bytes exceed %d",
+			ErrPayloadTooLarge, total, RoutingInfoSize)
+	}
+
+	// Derive the ephemeral key and the shared secret for every hop.
+	var (
+		ephemKeys = make([]*btcec.PublicKey, len(hops))
+		secrets   = make([][]byte, len(hops))
+		ephemPriv = sessionKey
+	)
+	for i, hop := range hops {
+		ephemKeys[i] = ephemPriv.PubKey()
+		secrets[i] = sharedSecret(ephemPriv, hop.NodeKey)
+
+		factor := blindingFactor(ephemKeys[i], secrets[i])
+		var next btcec.ModNScalar
+		next.Mul2(&ephemPriv.Key, &factor)
+		ephemPriv = &btcec.PrivateKey{Key: next}
+	}
+
+	// The routing info starts out as pseudo random bytes, so that the
+	// unused space doesn't leak the length of the route.
+	routingInfo := cipherStream(
+		generateKey("pad", sessionKey.Serialize()), RoutingInfoSize,
+	)
+	filler := generateFiller(hops, secrets)
+
+	// Wrap the payloads starting with the last hop, so that every hop
+	// can only peel off its own layer.
+	var (
+		nextMac [hmacSize]byte
+		scratch [8]byte
+	)
+	for i := len(hops) - 1; i >= 0; i-- {
+		var b bytes.Buffer
+		err := tlv.WriteVarInt(
+			&b, uint64(len(hops[i].Payload)), &scratch,
+		)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(hops[i].Payload)
+		b.Write(nextMac[:])
+
+		shift := b.Len()
+		copy(routingInfo[shift:], routingInfo[:RoutingInfoSize-shift])
+		copy(routingInfo, b.Bytes())
+
+		rho := generateKey("rho", secrets[i])
+		xor(routingInfo, cipherStream(rho, RoutingInfoSize))
+
+		if i == len(hops)-1 {
+			copy(routingInfo[RoutingInfoSize-len(filler):], filler)
+		}
+
+		mu := generateKey("mu", secrets[i])
+		copy(nextMac[:], calcMac(mu, routingInfo, assocData))
+	}
+
+	onion := make([]byte, 0, OnionSize)
+	onion = append(onion, onionVersion)
+	onion = append(onion, ephemKeys[0].SerializeCompressed()...)
+	onion = append(onion, routingInfo...)
+	onion = append(onion, nextMac[:]...)
+
+	return onion, nil
+}
+
+// PeelOnion processes a trampoline onion with our node key and returns our
+// payload along with the onion for the next trampoline node, if any.
+func PeelOnion(nodeKey keychain.SingleKeyECDH, onion,
+	assocData []byte) (*PeeledOnion, error) {
+
+	if len(onion) != OnionSize {
+		return nil, fmt.Errorf("%w: size %d", ErrInvalidOnion,
+			len(onion))
+	}
+	if onion[0] != onionVersion {
+		return nil, ErrInvalidOnionVersion
+	}
+
+	pubEnd := 1 + btcec.PubKeyBytesLenCompressed
+	ephemKey, err := btcec.ParsePubKey(onion[1:pubEnd])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOnion, err)
+	}
+	routingInfo := onion[pubEnd : pubEnd+RoutingInfoSize]
+	mac := onion[pubEnd+RoutingInfoSize:]
+
+	secret, err := nodeKey.ECDH(ephemKey)
+	if err != nil {
+		return nil, err
+	}
+
+	mu := generateKey("mu", secret[:])
+	if !hmac.Equal(mac, calcMac(mu, routingInfo, assocData)) {
+		return nil, ErrInvalidOnionHMAC
+	}
+
+	// Decrypt our layer. The routing info is extended with zeroes, so
+	// that the routing info of the next hop keeps its size.
+	hopInfo := make([]byte, 2*RoutingInfoSize)
+	copy(hopInfo, routingInfo)
+	rho := generateKey("rho", secret[:])
+	xor(hopInfo, cipherStream(rho, 2*RoutingInfoSize))
+
+	var scratch [8]byte
+	r := bytes.NewReader(hopInfo)
+	payloadLen, err := tlv.ReadVarInt(r, &scratch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOnion, err)
+	}
+	start := len(hopInfo) - r.Len()
+	if payloadLen > RoutingInfoSize-hmacSize-uint64(start) {
+		return nil, fmt.Errorf("%w: payload length %d", ErrInvalidOnion,
+			payloadLen)
+	}
+	end := start + int(payloadLen)
+
+	peeled := &PeeledOnion{
+		Payload: hopInfo[start:end],
+	}
+
+	var nextMac [hmacSize]byte
+	copy(nextMac[:], hopInfo[end:end+hmacSize])
+	if nextMac == [hmacSize]byte{} {
+		peeled.IsFinal = true
+		return peeled, nil
+	}
+
+	factor := blindingFactor(ephemKey, secret[:])
+	var nextEphem, point btcec.JacobianPoint
+	ephemKey.AsJacobian(&point)
+	btcec.ScalarMultNonConst(&factor, &point, &nextEphem)
+	nextEphem.ToAffine()
+	nextKey := btcec.NewPublicKey(&nextEphem.X, &nextEphem.Y)
+
+	next := make([]byte, 0, OnionSize)
+	next = append(next, onionVersion)
+	next = append(next, nextKey.SerializeCompressed()...)
+	nextStart := end + hmacSize
+	next = append(next, hopInfo[nextStart:nextStart+RoutingInfoSize]...)
+	next = append(next, nextMac[:]...)
+	peeled.NextOnion = next
+
+	return peeled, nil
+}
+
+// hopSize returns the number of bytes a payload occupies in the routing info.
+func hopSize(payload []byte) int {
+	return int(tlv.VarIntSize(uint64(len(payload)))) + len(payload) +
+		hmacSize
+}
+
+// sharedSecret computes the shared secret between an ephemeral key and a
+// node key, the same way keychain.PrivKeyECDH does on the receiving side.
+func sharedSecret(priv *btcec.PrivateKey, pub *btcec.PublicKey) []byte {
+	var point, result btcec.JacobianPoint
+	pub.AsJacobian(&point)
+	btcec.ScalarMultNonConst(&priv.Key, &point, &result)
+	result.ToAffine()
+
+	secret := sha256.Sum256(
+		btcec.NewPublicKey(&result.X, &result.Y).SerializeCompressed(),
+	)
+
+	return secret[:]
+}
+
+// blindingFactor returns the factor that the ephemeral key is blinded with
+// before it is handed to the next hop.
+func blindingFactor(ephemKey *btcec.PublicKey,
+	secret []byte) btcec.ModNScalar {
+
+	h := sha256.New()
+	h.Write(ephemKey.SerializeCompressed())
+	h.Write(secret)
+
+	var factor btcec.ModNScalar
+	factor.SetByteSlice(h.Sum(nil))
+
+	return factor
+}
+
+// generateKey derives a key of the given type from a shared secret.
+func generateKey(keyType string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret)
+
+	return mac.Sum(nil)
+}
+
+// calcMac computes the HMAC over the routing info and the associated data.
+func calcMac(key, routingInfo, assocData []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(routingInfo)
+	mac.Write(assocData)
+
+	return mac.Sum(nil)
+}
+
+// cipherStream returns a pseudo random stream of the given length generated
+// with the key.
+func cipherStream(key []byte, length int) []byte {
+	var nonce [chacha20.NonceSize]byte
+	cipher, err := chacha20.NewUnauthenticatedCipher(key, nonce[:])
+	if err != nil {
+		// This can only happen if the key or nonce have the wrong size,
+		// which we control.
+		panic(err)
+	}
+
+	stream := make([]byte, length)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// generateFiller computes the bytes that the hops append to the routing info
+// while peeling the onion, so that the HMACs of all hops can be computed
+// upfront.
+func generateFiller(hops []Hop, secrets [][]byte) []byte {
+	var fillerSize int
+	for _, hop := range hops[:len(hops)-1] {
+		fillerSize += hopSize(hop.Payload)
+	}
+
+	filler := make([]byte, fillerSize)
+	for i, hop := range hops[:len(hops)-1] {
+		fillerStart := RoutingInfoSize
+		for _, prev := range hops[:i] {
+			fillerStart -= hopSize(prev.Payload)
+		}
+		fillerEnd := RoutingInfoSize + hopSize(hop.Payload)
+
+		rho := generateKey("rho", secrets[i])
+		stream := cipherStream(rho, 2*RoutingInfoSize)
+		xor(
+			filler[:fillerEnd-fillerStart],
+			stream[fillerStart:fillerEnd],
+		)
+	}
+
+	return filler
+}
+
+// xor xors the bytes of b into dst.
+func xor(dst, b []byte) {
+	for i := range dst {
+		dst[i] ^= b[i]
+	}
+}
@@ -0,0 +1,119 @@
+package trampoline
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOnionRoundTrip tests that every trampoline node can peel its own layer
+// of an onion, and only its own layer.
+func TestOnionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	var (
+		keys []*btcec.PrivateKey
+		hops []Hop
+	)
+	for i := 0; i < 3; i++ {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		hops = append(hops, Hop{
+			NodeKey: key.PubKey(),
+			Payload: []byte{byte(i), 1, 2, 3},
+		})
+	}
+
+	assocData := []byte("payment hash")
+	onion, err := BuildOnion(sessionKey, hops, assocData)
+	require.NoError(t, err)
+	require.Len(t, onion, OnionSize)
+
+	// The onion can't be processed by the second hop, or with different
+	// associated data.
+	_, err = PeelOnion(
+		&keychain.PrivKeyECDH{PrivKey: keys[1]}, onion, assocData,
+	)
+	require.ErrorIs(t, err, ErrInvalidOnionHMAC)
+
+	_, err = PeelOnion(
+		&keychain.PrivKeyECDH{PrivKey: keys[0]}, onion, []byte("other"),
+	)
+	require.ErrorIs(t, err, ErrInvalidOnionHMAC)
+
+	for i, key := range keys {
+		peeled, err := PeelOnion(
+			&keychain.PrivKeyECDH{PrivKey: key}, onion, assocData,
+		)
+		require.NoError(t, err)
+		require.Equal(t, hops[i].Payload, peeled.Payload)
+		require.Equal(t, i == len(keys)-1, peeled.IsFinal)
+
+		onion = peeled.NextOnion
+	}
+	require.Nil(t, onion)
+
+	// Payloads that don't fit into the onion are rejected.
+	hops[0].Payload = make([]byte, RoutingInfoSize)
+	_, err = BuildOnion(sessionKey, hops, assocData)
+	require.ErrorIs(t, err, ErrPayloadTooLarge)
+}
+
+// TestPayloadRoundTrip tests that trampoline payloads survive encoding and
+// decoding.
+func TestPayloadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	payload := &Payload{
+		AmtToForward: 100_000,
+		OutgoingCLTV: 800_000,
+		OutgoingNode: route.NewVertex(key.PubKey()),
+		MPP:          record.NewMPP(100_000, [32]byte{1}),
+		InvoiceFeatures: lnwire.NewRawFeatureVector(
+			lnwire.PaymentAddrRequired,
+		),
+		RouteHints: [][]zpay32.HopHint{{{
+			NodeID:                    key.PubKey(),
+			ChannelID:                 12345,
+			FeeBaseMSat:               1000,
+			FeeProportionalMillionths: 1,
+			CLTVExpiryDelta:           40,
+		}}},
+	}
+
+	b, err := payload.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodePayload(b)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	// A payload that only carries the amount doesn't tell us where to
+	// forward the payment.
+	amt := uint64(1)
+	stream, err := tlv.NewStream(record.NewAmtToFwdRecord(&amt))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, stream.Encode(&buf))
+
+	decoded, err = DecodePayload(buf.Bytes())
+	require.ErrorIs(t, err, ErrMissingField)
+	require.Nil(t, decoded)
+}
@@ -0,0 +1,247 @@
+package trampoline
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// OnionRecordType is the custom record type of the outer hop payload
+	// that carries the trampoline onion to a trampoline node.
+	OnionRecordType uint64 = 66100
+
+	// invoiceFeaturesType is the type of the invoice features of the
+	// final recipient.
+	invoiceFeaturesType tlv.Type = 66097
+
+	// outgoingNodeType is the type of the node that the trampoline node
+	// must forward the payment to.
+	outgoingNodeType tlv.Type = 66098
+
+	// routeHintsType is the type of the route hints of the invoice of the
+	// final recipient.
+	routeHintsType tlv.Type = 66099
+
+	// hopHintSize is the size of a serialized hop hint.
+	hopHintSize = btcec.PubKeyBytesLenCompressed + 8 + 4 + 4 + 2
+)
+
+// ErrMissingField is returned when a trampoline payload lacks a mandatory
+// field.
+var ErrMissingField = errors.New("trampoline payload missing field")
+
+// Payload is the payload that a trampoline node finds in its layer of the
+// trampoline onion.
+type Payload struct {
+	// AmtToForward is the amount that the trampoline node must deliver to
+	// the outgoing node.
+	AmtToForward lnwire.MilliSatoshi
+
+	// OutgoingCLTV is the absolute CLTV expiry that the outgoing node must
+	// receive.
+	OutgoingCLTV uint32
+
+	// OutgoingNode is the next trampoline node, or the final recipient.
+	OutgoingNode route.Vertex
+
+	// MPP carries the payment address and total amount of the invoice.
+	// It is only set for the final recipient, when the trampoline node
+	// is the last one.
+	MPP *record.MPP
+
+	// InvoiceFeatures are the features of the invoice of the final
+	// recipient.
+	InvoiceFeatures *lnwire.RawFeatureVector
+
+	// RouteHints are the route hints of the invoice of the final
+	// recipient.
+	RouteHints [][]zpay32.HopHint
+}
+
+// Encode serializes the payload as a TLV stream.
+func (p *Payload) Encode() ([]byte, error) {
+	amt := uint64(p.AmtToForward)
+	outgoingNode := [33]byte(p.OutgoingNode)
+
+	records := []tlv.Record{
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&p.OutgoingCLTV),
+	}
+	if p.MPP != nil {
+		records = append(records, p.MPP.Record())
+	}
+
+	var features []byte
+	if p.InvoiceFeatures != nil {
+		var b bytes.Buffer
+		if err := p.InvoiceFeatures.Encode(&b); err != nil {
+			return nil, err
+		}
+		features = b.Bytes()
+		records = append(records, tlv.MakePrimitiveRecord(
+			invoiceFeaturesType, &features,
+		))
+	}
+
+	records = append(records, tlv.MakePrimitiveRecord(
+		outgoingNodeType, &outgoingNode,
+	))
+
+	if len(p.RouteHints) > 0 {
+		hints, err := encodeRouteHints(p.RouteHints)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, tlv.MakePrimitiveRecord(
+			routeHintsType, &hints,
+		))
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodePayload parses a trampoline payload.
+func DecodePayload(payload []byte) (*Payload, error) {
+	var (
+		amt          uint64
+		cltv         uint32
+		mpp          = &record.MPP{}
+		features     []byte
+		outgoingNode [33]byte
+		hints        []byte
+	)
+
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		tlv.MakePrimitiveRecord(invoiceFeaturesType, &features),
+		tlv.MakePrimitiveRecord(outgoingNodeType, &outgoingNode),
+		tlv.MakePrimitiveRecord(routeHintsType, &hints),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := stream.DecodeWithParsedTypes(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range []tlv.Type{
+		record.AmtOnionType, record.LockTimeOnionType,
+		outgoingNodeType,
+	} {
+		if _, ok := parsed[t]; !ok {
+			return nil, fmt.Errorf("%w: type %d", ErrMissingField,
+				t)
+		}
+	}
+
+	p := &Payload{
+		AmtToForward: lnwire.MilliSatoshi(amt),
+		OutgoingCLTV: cltv,
+		OutgoingNode: outgoingNode,
+	}
+	if _, ok := parsed[record.MPPOnionType]; ok {
+		p.MPP = mpp
+	}
+	if _, ok := parsed[invoiceFeaturesType]; ok {
+		p.InvoiceFeatures = lnwire.NewRawFeatureVector()
+		err := p.InvoiceFeatures.Decode(bytes.NewReader(features))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := parsed[routeHintsType]; ok {
+		p.RouteHints, err = decodeRouteHints(hints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// encodeRouteHints serializes route hints as a sequence of routes, each
+// prefixed with its number of hops.
+func encodeRouteHints(routeHints [][]zpay32.HopHint) ([]byte, error) {
+	var b bytes.Buffer
+	for _, hints := range routeHints {
+		if len(hints) > 255 {
+			return nil, fmt.Errorf("route hint with %d hops",
+				len(hints))
+		}
+		b.WriteByte(byte(len(hints)))
+
+		for _, hint := range hints {
+			var buf [hopHintSize]byte
+			copy(buf[:33], hint.NodeID.SerializeCompressed())
+			binary.BigEndian.PutUint64(buf[33:], hint.ChannelID)
+			binary.BigEndian.PutUint32(buf[41:], hint.FeeBaseMSat)
+			binary.BigEndian.PutUint32(
+				buf[45:], hint.FeeProportionalMillionths,
+			)
+			binary.BigEndian.PutUint16(
+				buf[49:], hint.CLTVExpiryDelta,
+			)
+			b.Write(buf[:])
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeRouteHints parses route hints serialized by encodeRouteHints.
+func decodeRouteHints(b []byte) ([][]zpay32.HopHint, error) {
+	var routeHints [][]zpay32.HopHint
+	for len(b) > 0 {
+		numHops := int(b[0])
+		b = b[1:]
+		if len(b) < numHops*hopHintSize {
+			return nil, errors.New("truncated route hints")
+		}
+
+		hints := make([]zpay32.HopHint, 0, numHops)
+		for i := 0; i < numHops; i++ {
+			nodeID, err := btcec.ParsePubKey(b[:33])
+			if err != nil {
+				return nil, err
+			}
+
+			hint := zpay32.HopHint{
+				NodeID:      nodeID,
+				ChannelID:   binary.BigEndian.Uint64(b[33:]),
+				FeeBaseMSat: binary.BigEndian.Uint32(b[41:]),
+			}
+			hint.FeeProportionalMillionths =
+				binary.BigEndian.Uint32(b[45:])
+			hint.CLTVExpiryDelta = binary.BigEndian.Uint16(b[49:])
+
+			hints = append(hints, hint)
+			b = b[hopHintSize:]
+		}
+		routeHints = append(routeHints, hints)
+	}
+
+	return routeHints, nil
+}
@@ -31,6 +31,14 @@ var typeStringTests = []typeStringTest{
 			"FlagCommitOutputs|" +
 			"FlagReward]",
 	},
+	{
+		name: "anchor commit reward",
+		typ:  blob.TypeRewardAnchorCommit,
+		expStr: "[No-FlagTaprootChannel|" +
+			"FlagAnchorChannel|" +
+			"FlagCommitOutputs|" +
+			"FlagReward]",
+	},
 	{
 		name: "unknown flag",
 		typ:  unknownFlag.Type(),
@@ -1,8 +1,12 @@
 package watchtower
 
 import (
+	"fmt"
 	"strconv"
 	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/wtpolicy"
 )
 
 // Conf specifies the watchtower options that can be configured from the command
@@ -21,6 +25,34 @@ type Conf struct {
 	// WriteTimeout specifies the duration the tower will wait when trying
 	// to write a message from a client before hanging up.
 	WriteTimeout time.Duration `long:"writetimeout" description:"Duration the watchtower server will wait for messages to be written before hanging up on client connections"`
+
+	// RewardSessions specifies whether the tower accepts sessions that pay
+	// it a reward from the justice transaction.
+	RewardSessions bool `long:"reward-sessions" description:"Accept sessions that pay the watchtower a reward from each justice transaction"`
+
+	// RewardBase is the minimum fixed reward in satoshis that clients must
+	// offer for reward sessions.
+	RewardBase uint32 `long:"reward-base" description:"The minimum fixed reward in satoshis that clients must pay the watchtower from each justice transaction of a reward session"`
+
+	// RewardRate is the minimum proportional reward in millionths of the
+	// swept funds that clients must offer for reward sessions.
+	RewardRate uint32 `long:"reward-rate" description:"The minimum proportional reward, in millionths of the swept funds, that clients must pay the watchtower from each justice transaction of a reward session"`
+
+	// UpdatePrice is the price in millisatoshis that clients pay for each
+	// update of a session.
+	UpdatePrice uint64 `long:"update-price" description:"The price in millisatoshis that clients pay via a Lightning invoice for each update of a session before the session is activated. Sessions are free if set to 0"`
+
+	// MaxUnpaidSessions is the maximum number of sessions that the tower
+	// handed an invoice to that wasn't paid yet.
+	MaxUnpaidSessions int `long:"max-unpaid-sessions" description:"The maximum number of sessions that the watchtower handed an invoice to that wasn't paid yet. Further clients are turned away until the unpaid invoices expire"`
+
+	// UpdateRetention is the duration for which the tower stores a state
+	// update after receiving it.
+	UpdateRetention time.Duration `long:"update-retention" description:"The duration for which the watchtower stores a state update after receiving it. Breaches of older states can't be punished anymore once their updates are removed. Updates are kept until their session is deleted if set to 0"`
+
+	// SessionIdleTimeout is the duration after which the tower deletes a
+	// session that hasn't received any state updates.
+	SessionIdleTimeout time.Duration `long:"session-idle-timeout" description:"The duration after which the watchtower deletes a session, along with all of its state updates, if the session didn't receive any state updates. Idle sessions are kept if set to 0"`
 }
 
 // DefaultConf returns a Conf with some default values filled in.
@@ -28,6 +60,9 @@ func DefaultConf() *Conf {
 	return &Conf{
 		ReadTimeout:  DefaultReadTimeout,
 		WriteTimeout: DefaultWriteTimeout,
+		RewardRate:   wtpolicy.DefaultRewardRate,
+
+		MaxUnpaidSessions: DefaultMaxUnpaidSessions,
 	}
 }
 
@@ -94,5 +129,48 @@ func (c *Conf) Apply(cfg *Config,
 		cfg.WriteTimeout = c.WriteTimeout
 	}
 
+	// If the Config doesn't accept reward sessions yet, we will use the
+	// parsed Conf values.
+	if !cfg.RewardSessions && c.RewardSessions {
+		if c.RewardRate > wtpolicy.RewardScale {
+			return nil, fmt.Errorf("reward-rate must not exceed %d",
+				wtpolicy.RewardScale)
+		}
+
+		cfg.RewardSessions = true
+		cfg.MinRewardBase = c.RewardBase
+		cfg.MinRewardRate = c.RewardRate
+	}
+
+	// If the Config doesn't charge for sessions yet, we will use the
+	// parsed Conf value.
+	if cfg.UpdatePrice == 0 {
+		cfg.UpdatePrice = lnwire.MilliSatoshi(c.UpdatePrice)
+	}
+
+	if c.MaxUnpaidSessions < 0 {
+		return nil, fmt.Errorf("max-unpaid-sessions must not be " +
+			"negative")
+	}
+
+	if cfg.MaxUnpaidSessions == 0 {
+		cfg.MaxUnpaidSessions = c.MaxUnpaidSessions
+	}
+
+	// If the Config has no retention policy, we will use the parsed Conf
+	// values.
+	if c.UpdateRetention < 0 || c.SessionIdleTimeout < 0 {
+		return nil, fmt.Errorf("update-retention and " +
+			"session-idle-timeout must not be negative")
+	}
+
+	if cfg.UpdateRetention == 0 {
+		cfg.UpdateRetention = c.UpdateRetention
+	}
+
+	if cfg.SessionIdleTimeout == 0 {
+		cfg.SessionIdleTimeout = c.SessionIdleTimeout
+	}
+
 	return cfg, nil
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tor"
 	"github.com/lightningnetwork/lnd/watchtower/lookout"
 )
@@ -25,6 +27,15 @@ const (
 	// DefaultWriteTimeout is the default timeout after which the tower will
 	// hang up on a client if it is unable to send a message.
 	DefaultWriteTimeout = 15 * time.Second
+
+	// DefaultGCInterval is the default interval at which the tower removes
+	// the state updates and sessions that fell out of its retention
+	// policy.
+	DefaultGCInterval = time.Hour
+
+	// DefaultMaxUnpaidSessions is the default maximum number of sessions
+	// that the tower handed an invoice to that wasn't paid yet.
+	DefaultMaxUnpaidSessions = 1000
 )
 
 var (
@@ -107,4 +118,54 @@ type Config struct {
 	// Type specifies the hidden service type (V2 or V3) that the watchtower
 	// will create.
 	Type tor.OnionType
+
+	// RewardSessions specifies whether the tower accepts sessions that pay
+	// it a reward from the justice transaction.
+	RewardSessions bool
+
+	// MinRewardBase is the minimum fixed reward in satoshis that clients
+	// must offer for reward sessions.
+	MinRewardBase uint32
+
+	// MinRewardRate is the minimum proportional reward, in millionths of
+	// the swept funds, that clients must offer for reward sessions.
+	MinRewardRate uint32
+
+	// UpdatePrice is the price that clients pay for each update of a
+	// session before the session is activated. If zero, sessions are
+	// free.
+	UpdatePrice lnwire.MilliSatoshi
+
+	// NewInvoice creates an invoice that a client pays for its session,
+	// and returns its payment request and payment hash.
+	NewInvoice func(amt lnwire.MilliSatoshi, memo string,
+		expiry time.Duration) (string, lntypes.Hash, error)
+
+	// IsInvoicePaid returns true if the invoice with the given payment
+	// hash has been settled.
+	IsInvoicePaid func(hash lntypes.Hash) (bool, error)
+
+	// DeleteInvoice cancels and removes the unpaid invoice with the given
+	// payment hash.
+	DeleteInvoice func(hash lntypes.Hash) error
+
+	// MaxUnpaidSessions is the maximum number of sessions that the tower
+	// handed an invoice to that wasn't paid yet. Unpaid invoices are
+	// pruned once they expire.
+	MaxUnpaidSessions int
+
+	// UpdateRetention is the duration for which the tower stores a state
+	// update after receiving it. If zero, updates are kept until their
+	// session is deleted.
+	UpdateRetention time.Duration
+
+	// SessionIdleTimeout is the duration of inactivity after which the
+	// tower deletes a session. A session is active when it is created and
+	// whenever it receives a state update. If zero, idle sessions are
+	// kept.
+	SessionIdleTimeout time.Duration
+
+	// GCInterval is the interval at which the tower removes the state
+	// updates and sessions that fell out of its retention policy.
+	GCInterval time.Duration
 }
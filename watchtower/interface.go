@@ -2,8 +2,10 @@ package watchtower
 
 import (
 	"net"
+	"time"
 
 	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
 	"github.com/lightningnetwork/lnd/watchtower/wtserver"
 )
 
@@ -13,6 +15,27 @@ import (
 type DB interface {
 	lookout.DB
 	wtserver.DB
+
+	// AddReward records a reward claimed by a published justice
+	// transaction.
+	AddReward(*wtdb.Reward) error
+
+	// ListRewards returns all rewards claimed by the tower's justice
+	// transactions.
+	ListRewards() ([]*wtdb.Reward, error)
+
+	// PruneUpdates removes all state updates that were received before
+	// the given time, and returns the number of removed updates.
+	PruneUpdates(before time.Time) (int, error)
+
+	// PruneIdleSessions removes all data of the sessions that haven't
+	// been active since the given time, and returns the number of
+	// removed sessions.
+	PruneIdleSessions(before time.Time) (int, error)
+
+	// ListSessionUsage returns the storage used by each session in the
+	// database.
+	ListSessionUsage() ([]*wtdb.SessionUsage, error)
 }
 
 // AddressNormalizer is a function signature that allows the tower to resolve
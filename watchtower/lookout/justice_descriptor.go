@@ -24,6 +24,11 @@ var (
 	// ErrUnknownSweepAddrType signals that client provided an output that
 	// was not p2wkh or p2wsh.
 	ErrUnknownSweepAddrType = errors.New("sweep addr is not p2wkh or p2wsh")
+
+	// ErrUnknownRewardAddrType signals that the session's reward address
+	// is not p2wkh, p2wsh or p2tr.
+	ErrUnknownRewardAddrType = errors.New("reward addr is not p2wkh, " +
+		"p2wsh or p2tr")
 )
 
 // JusticeDescriptor contains the information required to sweep a breached
@@ -238,9 +243,21 @@ func (p *JusticeDescriptor) CreateJusticeTxn() (*wire.MsgTx, error) {
 	}
 
 	// Add our reward address to the weight estimate if the policy's blob
-	// type specifies a reward output.
+	// type specifies a reward output. The client estimated the weight
+	// using the actual type of our reward address, so we must do the same
+	// to arrive at the same output values. A p2tr output has the same size
+	// as a p2wsh output.
 	if p.SessionInfo.Policy.BlobType.Has(blob.FlagReward) {
-		weightEstimate.AddP2WKHOutput()
+		switch len(p.SessionInfo.RewardAddress) {
+		case input.P2WPKHSize:
+			weightEstimate.AddP2WKHOutput()
+
+		case input.P2WSHSize:
+			weightEstimate.AddP2WSHOutput()
+
+		default:
+			return nil, ErrUnknownRewardAddrType
+		}
 	}
 
 	// Assemble the breached to-local output from the justice descriptor and
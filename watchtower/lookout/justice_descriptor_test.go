@@ -1,6 +1,7 @@
 package lookout_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -81,6 +82,14 @@ func TestJusticeDescriptor(t *testing.T) {
 			name:     "altruist taproot commit type",
 			blobType: altruisticTaprootCommitType,
 		},
+		{
+			name:     "reward anchor commit type",
+			blobType: blob.TypeRewardAnchorCommit,
+		},
+		{
+			name:     "reward taproot commit type",
+			blobType: blob.TypeRewardTaprootCommit,
+		},
 	}
 
 	for _, test := range tests {
@@ -438,11 +447,13 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	// Construct a breach punisher that will feed published transactions
 	// over the buffered channel.
 	publications := make(chan *wire.MsgTx, 1)
+	towerDB := wtmock.NewTowerDB()
 	punisher := lookout.NewBreachPunisher(&lookout.PunisherConfig{
 		PublishTx: func(tx *wire.MsgTx, _ string) error {
 			publications <- tx
 			return nil
 		},
+		AddReward: towerDB.AddReward,
 	})
 
 	// Exact retribution on the offender. If no error is returned, we expect
@@ -514,4 +525,20 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 
 	// Assert that the watchtower derives the same justice txn.
 	require.Equal(t, justiceTxn, wtJusticeTxn)
+
+	// The reward of reward sessions is recorded by the punisher.
+	rewards, err := towerDB.ListRewards()
+	require.NoError(t, err)
+	if !blobType.Has(blob.FlagReward) {
+		require.Empty(t, rewards)
+		return
+	}
+
+	require.Len(t, rewards, 1)
+	require.Equal(t, justiceTxn.TxHash(), rewards[0].JusticeTxID)
+	for _, txOut := range justiceTxn.TxOut {
+		if bytes.Equal(txOut.PkScript, sessionInfo.RewardAddress) {
+			require.EqualValues(t, txOut.Value, rewards[0].Amount)
+		}
+	}
 }
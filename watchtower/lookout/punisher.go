@@ -1,8 +1,14 @@
 package lookout
 
 import (
+	"bytes"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/watchtower/blob"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
 )
 
 // PunisherConfig houses the resources required by the Punisher.
@@ -11,6 +17,10 @@ type PunisherConfig struct {
 	// network.
 	PublishTx func(*wire.MsgTx, string) error
 
+	// AddReward records the reward claimed by a published justice
+	// transaction of a reward session. If nil, rewards aren't recorded.
+	AddReward func(*wtdb.Reward) error
+
 	// TODO(conner) add DB tracking and spend ntfn registration to see if
 	// ours confirmed or not
 }
@@ -55,5 +65,47 @@ func (p *BreachPunisher) Punish(desc *JusticeDescriptor, quit <-chan struct{}) e
 	// TODO(conner): register for spend and remove from db after
 	// confirmation
 
+	if desc.SessionInfo.Policy.BlobType.Has(blob.FlagReward) {
+		p.recordReward(desc, justiceTxn)
+	}
+
 	return nil
 }
+
+// recordReward records the reward paid to the tower by the given justice
+// transaction. The justice transaction has been published already, so a
+// failure is only logged.
+func (p *BreachPunisher) recordReward(desc *JusticeDescriptor,
+	justiceTxn *wire.MsgTx) {
+
+	if p.cfg.AddReward == nil {
+		return
+	}
+
+	// The reward output may have been omitted if it would be dust.
+	rewardAddr := desc.SessionInfo.RewardAddress
+	for _, txOut := range justiceTxn.TxOut {
+		if !bytes.Equal(txOut.PkScript, rewardAddr) {
+			continue
+		}
+
+		reward := &wtdb.Reward{
+			SessionID:   desc.SessionInfo.ID,
+			JusticeTxID: justiceTxn.TxHash(),
+			Amount:      btcutil.Amount(txOut.Value),
+			Timestamp:   time.Now(),
+		}
+		if err := p.cfg.AddReward(reward); err != nil {
+			log.Errorf("Unable to record reward of justice txn "+
+				"%v: %v", reward.JusticeTxID, err)
+
+			return
+		}
+
+		log.Infof("Claimed reward of %v with justice txn %v for "+
+			"client=%s", reward.Amount, reward.JusticeTxID,
+			desc.SessionInfo.ID)
+
+		return
+	}
+}
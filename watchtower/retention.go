@@ -0,0 +1,69 @@
+package watchtower
+
+import "time"
+
+// gcLoop periodically removes the state updates and sessions that fell out of
+// the tower's retention policy, and the session invoices that weren't paid.
+//
+// NOTE: This MUST be run as a goroutine.
+func (w *Standalone) gcLoop() {
+	defer w.wg.Done()
+
+	// Collect garbage right away, as the tower may have been offline for
+	// longer than the interval.
+	w.collectGarbage(time.Now())
+
+	ticker := time.NewTicker(w.cfg.GCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			w.collectGarbage(now)
+
+		case <-w.quit:
+			return
+		}
+	}
+}
+
+// collectGarbage removes the state updates received before the update
+// retention horizon, the sessions that have been idle for longer than the
+// session idle timeout, and the session invoices that expired without being
+// paid. Sessions deleted by their clients are removed by the server right
+// away.
+func (w *Standalone) collectGarbage(now time.Time) {
+	if w.cfg.UpdateRetention > 0 {
+		numPruned, err := w.cfg.DB.PruneUpdates(
+			now.Add(-w.cfg.UpdateRetention),
+		)
+		if err != nil {
+			log.Errorf("Unable to prune state updates: %v", err)
+		} else if numPruned > 0 {
+			log.Infof("Pruned %d state updates older than %v",
+				numPruned, w.cfg.UpdateRetention)
+		}
+	}
+
+	if w.cfg.SessionIdleTimeout > 0 {
+		numPruned, err := w.cfg.DB.PruneIdleSessions(
+			now.Add(-w.cfg.SessionIdleTimeout),
+		)
+		if err != nil {
+			log.Errorf("Unable to prune idle sessions: %v", err)
+		} else if numPruned > 0 {
+			log.Infof("Pruned %d sessions idle for more than %v",
+				numPruned, w.cfg.SessionIdleTimeout)
+		}
+	}
+
+	if w.cfg.UpdatePrice > 0 {
+		numPruned, err := w.server.PruneSessionPayments(now)
+		if err != nil {
+			log.Errorf("Unable to prune session payments: %v", err)
+		} else if numPruned > 0 {
+			log.Infof("Pruned %d expired unpaid session invoices",
+				numPruned)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package watchtower
 
 import (
 	"net"
+	"sync"
 	"sync/atomic"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -9,7 +10,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnencrypt"
 	"github.com/lightningnetwork/lnd/tor"
 	"github.com/lightningnetwork/lnd/watchtower/lookout"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
 	"github.com/lightningnetwork/lnd/watchtower/wtserver"
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
 )
 
 // Standalone encapsulates the server-side functionality required by watchtower
@@ -34,6 +37,9 @@ type Standalone struct {
 	// transactions found in new blocks against the state updates received
 	// by the server.
 	lookout lookout.Service
+
+	wg   sync.WaitGroup
+	quit chan struct{}
 }
 
 // New validates the passed Config and returns a fresh Standalone instance if
@@ -55,8 +61,19 @@ func New(cfg *Config) (*Standalone, error) {
 		cfg.WriteTimeout = DefaultWriteTimeout
 	}
 
+	// Assign the default garbage collection interval if none is provided.
+	if cfg.GCInterval == 0 {
+		cfg.GCInterval = DefaultGCInterval
+	}
+
+	// Assign the default limit of unpaid sessions if none is provided.
+	if cfg.MaxUnpaidSessions == 0 {
+		cfg.MaxUnpaidSessions = DefaultMaxUnpaidSessions
+	}
+
 	punisher := lookout.NewBreachPunisher(&lookout.PunisherConfig{
 		PublishTx: cfg.PublishTx,
+		AddReward: cfg.DB.AddReward,
 	})
 
 	// Initialize the lookout service with its required resources.
@@ -91,7 +108,15 @@ func New(cfg *Config) (*Standalone, error) {
 		ReadTimeout:   cfg.ReadTimeout,
 		WriteTimeout:  cfg.WriteTimeout,
 		NewAddress:    cfg.NewAddress,
-		DisableReward: true,
+		DisableReward: !cfg.RewardSessions,
+		MinRewardBase: cfg.MinRewardBase,
+		MinRewardRate: cfg.MinRewardRate,
+		UpdatePrice:   cfg.UpdatePrice,
+		NewInvoice:    cfg.NewInvoice,
+		IsInvoicePaid: cfg.IsInvoicePaid,
+		DeleteInvoice: cfg.DeleteInvoice,
+
+		MaxUnpaidSessions: cfg.MaxUnpaidSessions,
 	})
 	if err != nil {
 		return nil, err
@@ -102,6 +127,7 @@ func New(cfg *Config) (*Standalone, error) {
 		listeners: listeners,
 		server:    server,
 		lookout:   lookout,
+		quit:      make(chan struct{}),
 	}, nil
 }
 
@@ -131,6 +157,15 @@ func (w *Standalone) Start() error {
 		return err
 	}
 
+	// Only collect garbage if the tower has a retention policy, or has
+	// to prune the invoices that its clients didn't pay.
+	if w.cfg.UpdateRetention > 0 || w.cfg.SessionIdleTimeout > 0 ||
+		w.cfg.UpdatePrice > 0 {
+
+		w.wg.Add(1)
+		go w.gcLoop()
+	}
+
 	log.Infof("Watchtower started successfully")
 
 	return nil
@@ -145,6 +180,9 @@ func (w *Standalone) Stop() error {
 
 	log.Infof("Stopping watchtower")
 
+	close(w.quit)
+	w.wg.Wait()
+
 	w.server.Stop()
 	w.lookout.Stop()
 
@@ -225,3 +263,33 @@ func (w *Standalone) ExternalIPs() []net.Addr {
 
 	return addrs
 }
+
+// RewardTerms returns the minimum reward terms that the watchtower accepts for
+// reward sessions, or nil if the watchtower doesn't accept reward sessions.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) RewardTerms() *wtwire.RewardTerms {
+	if !w.cfg.RewardSessions {
+		return nil
+	}
+
+	return &wtwire.RewardTerms{
+		RewardBase: w.cfg.MinRewardBase,
+		RewardRate: w.cfg.MinRewardRate,
+	}
+}
+
+// ListRewards returns the rewards claimed by the watchtower's justice
+// transactions.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ListRewards() ([]*wtdb.Reward, error) {
+	return w.cfg.DB.ListRewards()
+}
+
+// ListSessionUsage returns the storage used by each session of the watchtower.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ListSessionUsage() ([]*wtdb.SessionUsage, error) {
+	return w.cfg.DB.ListSessionUsage()
+}
@@ -279,6 +279,12 @@ func newClient(cfg *clientCfg) (*client, error) {
 		MinBackoff:    cfg.MinBackoff,
 		MaxBackoff:    cfg.MaxBackoff,
 		Log:           plog,
+
+		MaxSessionPayment: cfg.MaxSessionPayment,
+		MaxTowerPayments:  cfg.MaxTowerPayments,
+		PayInvoice:        cfg.PayInvoice,
+		DecodePaymentHash: cfg.DecodePaymentHash,
+		PaymentStatus:     cfg.PaymentStatus,
 	})
 
 	return c, nil
@@ -23,6 +23,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/subscribe"
@@ -431,6 +432,172 @@ func (c *mockChannel) getState(
 	return retribution.BreachTxHash, retribution
 }
 
+// mockInvoices hands out the invoices of a tower that charges for its
+// sessions, and pays them on behalf of the client.
+type mockInvoices struct {
+	mu          sync.Mutex
+	towerKey    *btcec.PublicKey
+	invoices    map[string]lntypes.Hash
+	amounts     map[lntypes.Hash]lnwire.MilliSatoshi
+	paid        map[lntypes.Hash]bool
+	statuses    map[lntypes.Hash]channeldb.PaymentStatus
+	numAttempts int
+
+	// interruptPay leaves the payments in flight, as if the client
+	// restarted before learning their outcome.
+	interruptPay bool
+
+	// dropPay never starts the payments, as if the client restarted
+	// before paying.
+	dropPay bool
+}
+
+func newMockInvoices() *mockInvoices {
+	return &mockInvoices{
+		invoices: make(map[string]lntypes.Hash),
+		amounts:  make(map[lntypes.Hash]lnwire.MilliSatoshi),
+		paid:     make(map[lntypes.Hash]bool),
+		statuses: make(map[lntypes.Hash]channeldb.PaymentStatus),
+	}
+}
+
+func (m *mockInvoices) newInvoice(amt lnwire.MilliSatoshi, _ string,
+	_ time.Duration) (string, lntypes.Hash, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var hash lntypes.Hash
+	binary.BigEndian.PutUint64(hash[:], uint64(len(m.invoices)))
+
+	payReq := hash.String()
+	m.invoices[payReq] = hash
+	m.amounts[hash] = amt
+
+	return payReq, hash, nil
+}
+
+func (m *mockInvoices) isPaid(hash lntypes.Hash) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.paid[hash], nil
+}
+
+func (m *mockInvoices) deleteInvoice(hash lntypes.Hash) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.amounts, hash)
+
+	return nil
+}
+
+func (m *mockInvoices) decodePaymentHash(payReq string) (lntypes.Hash,
+	error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hash, ok := m.invoices[payReq]
+	if !ok {
+		return lntypes.Hash{}, fmt.Errorf("unknown invoice %v",
+			payReq)
+	}
+
+	return hash, nil
+}
+
+func (m *mockInvoices) paymentStatus(hash lntypes.Hash) (
+	channeldb.PaymentStatus, lnwire.MilliSatoshi, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status, ok := m.statuses[hash]
+	if !ok {
+		return 0, 0, channeldb.ErrPaymentNotInitiated
+	}
+
+	if status != channeldb.StatusSucceeded {
+		return status, 0, nil
+	}
+
+	return status, m.amounts[hash], nil
+}
+
+func (m *mockInvoices) pay(payReq string, towerKey *btcec.PublicKey,
+	maxAmt lnwire.MilliSatoshi) (lntypes.Hash, lnwire.MilliSatoshi,
+	error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.numAttempts++
+
+	hash, ok := m.invoices[payReq]
+	if !ok {
+		return lntypes.Hash{}, 0, fmt.Errorf("unknown invoice %v",
+			payReq)
+	}
+
+	if !towerKey.IsEqual(m.towerKey) {
+		return lntypes.Hash{}, 0, fmt.Errorf("invoice not issued by " +
+			"tower")
+	}
+
+	amt := m.amounts[hash]
+	if amt > maxAmt {
+		return lntypes.Hash{}, 0, fmt.Errorf("invoice amount %v "+
+			"exceeds maximum %v", amt, maxAmt)
+	}
+
+	if m.dropPay {
+		return lntypes.Hash{}, 0, fmt.Errorf("payment not started")
+	}
+
+	if m.interruptPay {
+		m.statuses[hash] = channeldb.StatusInFlight
+
+		return lntypes.Hash{}, 0, fmt.Errorf("payment interrupted")
+	}
+
+	m.paid[hash] = true
+	m.statuses[hash] = channeldb.StatusSucceeded
+
+	return hash, amt, nil
+}
+
+// settleInFlight lets the payments that are in flight succeed.
+func (m *mockInvoices) settleInFlight() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for hash, status := range m.statuses {
+		if status == channeldb.StatusInFlight {
+			m.paid[hash] = true
+			m.statuses[hash] = channeldb.StatusSucceeded
+		}
+	}
+}
+
+// numPaid returns the number of invoices that were paid.
+func (m *mockInvoices) numPaid() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.paid)
+}
+
+// numPayAttempts returns the number of times the client tried to pay an
+// invoice.
+func (m *mockInvoices) numPayAttempts() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.numAttempts
+}
+
 type testHarness struct {
 	t            *testing.T
 	cfg          harnessCfg
@@ -442,6 +609,7 @@ type testHarness struct {
 	clientPolicy wtpolicy.Policy
 	server       *serverHarness
 	net          *mockNet
+	invoices     *mockInvoices
 
 	blockEvents *mockBlockSub
 	height      int32
@@ -463,6 +631,11 @@ type harnessCfg struct {
 	noRegisterChan0    bool
 	noAckCreateSession bool
 	noServerStart      bool
+	updatePrice        lnwire.MilliSatoshi
+	maxSessionPayment  lnwire.MilliSatoshi
+	maxTowerPayments   lnwire.MilliSatoshi
+	interruptPayments  bool
+	dropPayments       bool
 }
 
 func newClientDB(t *testing.T) *wtdb.ClientDB {
@@ -486,11 +659,20 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 	mockNet := newMockNet()
 	clientDB := newClientDB(t)
 
+	invoices := newMockInvoices()
+	invoices.interruptPay = cfg.interruptPayments
+	invoices.dropPay = cfg.dropPayments
+
 	server := newServerHarness(
 		t, mockNet, towerAddrStr, func(serverCfg *wtserver.Config) {
 			serverCfg.NoAckCreateSession = cfg.noAckCreateSession
+			serverCfg.UpdatePrice = cfg.updatePrice
+			serverCfg.NewInvoice = invoices.newInvoice
+			serverCfg.IsInvoicePaid = invoices.isPaid
+			serverCfg.DeleteInvoice = invoices.deleteInvoice
 		},
 	)
+	invoices.towerKey = server.addr.IdentityKey
 
 	h := &testHarness{
 		t:              t,
@@ -500,6 +682,7 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		clientDB:       clientDB,
 		server:         server,
 		net:            mockNet,
+		invoices:       invoices,
 		blockEvents:    newMockBlockSub(t),
 		channelEvents:  newMockSubscription(t),
 		channels:       make(map[lnwire.ChannelID]*mockChannel),
@@ -545,6 +728,11 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		MaxBackoff:         time.Second,
 		SessionCloseRange:  1,
 		MaxTasksInMemQueue: 2,
+		MaxSessionPayment:  cfg.maxSessionPayment,
+		MaxTowerPayments:   cfg.maxTowerPayments,
+		PayInvoice:         invoices.pay,
+		DecodePaymentHash:  invoices.decodePaymentHash,
+		PaymentStatus:      invoices.paymentStatus,
 	}
 
 	h.clientCfg.BuildBreachRetribution = func(id lnwire.ChannelID,
@@ -1408,6 +1596,248 @@ var clientTests = []clientTest{
 			h.server.waitForUpdates(hints, waitTime)
 		},
 	},
+	{
+		// Asserts that a client offering reward terms negotiates reward
+		// sessions with a tower that accepts them, and that its
+		// updates are stored under the reward policy.
+		name: "reward sessions",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:   blob.TypeRewardTaprootCommit,
+					RewardBase: 1000,
+					RewardRate: wtpolicy.
+						DefaultRewardRate,
+					SweepFeeRate: wtpolicy.
+						DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 5
+				chanID     = 0
+			)
+
+			// Generate and back up the states of the channel.
+			hints := h.advanceChannelN(chanID, numUpdates)
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// Wait for all the updates to be populated in the
+			// server's database, and assert that they belong to a
+			// reward session.
+			h.server.waitForUpdates(hints, waitTime)
+			h.server.assertUpdatesForPolicy(hints, h.clientPolicy)
+		},
+	},
+	{
+		// Asserts that a client pays for its session when the tower
+		// charges for sessions, and that the tower only accepts the
+		// updates once the session was paid for.
+		name: "paid sessions",
+		cfg: harnessCfg{
+			localBalance:      localBalance,
+			remoteBalance:     remoteBalance,
+			updatePrice:       10,
+			maxSessionPayment: 50,
+			maxTowerPayments:  50,
+			policy: wtpolicy.Policy{
+				TxPolicy:   defaultTxPolicy,
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			// We back up fewer states than the session allows,
+			// so that the client doesn't need a second session.
+			const (
+				numUpdates = 4
+				chanID     = 0
+			)
+
+			// Generate and back up the states of the channel.
+			hints := h.advanceChannelN(chanID, numUpdates)
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// Wait for all the updates to be populated in the
+			// server's database, and assert that the client paid
+			// a single invoice for its session.
+			h.server.waitForUpdates(hints, waitTime)
+			require.Equal(h.t, 1, h.invoices.numPaid())
+		},
+	},
+	{
+		// Asserts that a client doesn't pay for a session again while
+		// the payment it made before is still in flight, and that the
+		// payment is recorded once it succeeded.
+		name: "paid sessions with payment in flight",
+		cfg: harnessCfg{
+			localBalance:      localBalance,
+			remoteBalance:     remoteBalance,
+			updatePrice:       10,
+			maxSessionPayment: 50,
+			maxTowerPayments:  100,
+			interruptPayments: true,
+			policy: wtpolicy.Policy{
+				TxPolicy:   defaultTxPolicy,
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 4
+				chanID     = 0
+			)
+
+			// Generate and back up the states of the channel.
+			hints := h.advanceChannelN(chanID, numUpdates)
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// The tower keeps requesting the payment, but the
+			// client only tries to pay once.
+			h.server.waitForUpdates(nil, 2*time.Second)
+			require.Equal(h.t, 1, h.invoices.numPayAttempts())
+
+			// Once the payment succeeds, the tower activates the
+			// session without the client paying again.
+			h.invoices.settleInFlight()
+			h.server.waitForUpdates(hints, waitTime)
+			require.Equal(h.t, 1, h.invoices.numPayAttempts())
+
+			// The payment counts towards the tower's total.
+			paid, err := h.clientDB.GetTowerPayments(
+				h.server.addr.IdentityKey,
+			)
+			require.NoError(h.t, err)
+			require.EqualValues(h.t, 50, paid)
+		},
+	},
+	{
+		// Asserts that a client that restarted after recording its
+		// session payment as pending, but before paying, still uses
+		// the session once the tower accepts it.
+		name: "paid sessions with payment never started",
+		cfg: harnessCfg{
+			localBalance:      localBalance,
+			remoteBalance:     remoteBalance,
+			updatePrice:       10,
+			maxSessionPayment: 50,
+			maxTowerPayments:  100,
+			dropPayments:      true,
+			policy: wtpolicy.Policy{
+				TxPolicy:   defaultTxPolicy,
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 4
+				chanID     = 0
+			)
+
+			// Generate and back up the states of the channel. The
+			// session payment is recorded as pending, but never
+			// started.
+			hints := h.advanceChannelN(chanID, numUpdates)
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			h.server.waitForUpdates(nil, 2*time.Second)
+			require.Zero(h.t, h.invoices.numPaid())
+
+			// The tower stops charging for sessions, so it accepts
+			// the session without a payment. The client removes
+			// the pending payment and uses the session.
+			h.server.restart(func(cfg *wtserver.Config) {
+				cfg.UpdatePrice = 0
+			})
+			h.server.waitForUpdates(hints, waitTime)
+
+			// The session that the payment was recorded for is
+			// the only one the tower knows about.
+			usage, err := h.server.db.ListSessionUsage()
+			require.NoError(h.t, err)
+			require.Len(h.t, usage, 1)
+
+			paid, err := h.clientDB.GetTowerPayments(
+				h.server.addr.IdentityKey,
+			)
+			require.NoError(h.t, err)
+			require.Zero(h.t, paid)
+		},
+	},
+	{
+		// Asserts that a client doesn't pay more for a session than
+		// its configured maximum, so that its updates aren't accepted
+		// by the tower.
+		name: "paid sessions exceeding max payment",
+		cfg: harnessCfg{
+			localBalance:      localBalance,
+			remoteBalance:     remoteBalance,
+			updatePrice:       10,
+			maxSessionPayment: 49,
+			maxTowerPayments:  100,
+			policy: wtpolicy.Policy{
+				TxPolicy:   defaultTxPolicy,
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 5
+				chanID     = 0
+			)
+
+			// Generate and back up the states of the channel.
+			h.advanceChannelN(chanID, numUpdates)
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// The client never pays for a session, so the tower
+			// doesn't receive any updates.
+			h.server.waitForUpdates(nil, 2*time.Second)
+			require.Zero(h.t, h.invoices.numPaid())
+		},
+	},
+	{
+		// Asserts that a client doesn't pay a tower more than its
+		// configured maximum over all sessions, so that the updates
+		// that need a second session aren't accepted by the tower.
+		name: "paid sessions exceeding max tower payments",
+		cfg: harnessCfg{
+			localBalance:      localBalance,
+			remoteBalance:     remoteBalance,
+			updatePrice:       10,
+			maxSessionPayment: 50,
+			maxTowerPayments:  90,
+			policy: wtpolicy.Policy{
+				TxPolicy:   defaultTxPolicy,
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 10
+				chanID     = 0
+			)
+
+			// Generate and back up the states of the channel.
+			hints := h.advanceChannelN(chanID, numUpdates)
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// The client pays for its first session, but the
+			// second one would exceed the tower payment limit, so
+			// only the first session's updates reach the tower.
+			h.server.waitForUpdates(hints[:5], waitTime)
+			require.Equal(h.t, 1, h.invoices.numPaid())
+
+			paid, err := h.clientDB.GetTowerPayments(
+				h.server.addr.IdentityKey,
+			)
+			require.NoError(h.t, err)
+			require.EqualValues(h.t, 50, paid)
+		},
+	},
 	{
 		// Asserts that the client is able to support multiple links.
 		name: "multiple link backup",
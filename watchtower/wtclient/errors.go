@@ -39,4 +39,29 @@ var (
 	// create a new session with a tower with a session key that has already
 	// been used in the past.
 	ErrSessionKeyAlreadyUsed = errors.New("session key already used")
+
+	// ErrNoRewardSessions signals that the client requested a reward
+	// session from a tower that doesn't accept reward sessions.
+	ErrNoRewardSessions = errors.New("tower doesn't accept reward " +
+		"sessions")
+
+	// ErrPaidSessionsDisabled signals that a tower charges for its
+	// sessions, but the client isn't configured to pay for sessions.
+	ErrPaidSessionsDisabled = errors.New("tower charges for sessions " +
+		"and paying for sessions is disabled")
+
+	// ErrSessionAlreadyPaid signals that a tower requested another payment
+	// for a session that the client already paid for.
+	ErrSessionAlreadyPaid = errors.New("tower requested payment for " +
+		"session that was already paid for")
+
+	// ErrTowerPaymentLimit signals that a tower requested a payment for a
+	// session, but the client already paid the tower the maximum total
+	// amount it is willing to pay a single tower.
+	ErrTowerPaymentLimit = errors.New("tower payment limit reached")
+
+	// ErrSessionPaymentInFlight signals that a tower requested a payment
+	// for a session that the client is still paying for.
+	ErrSessionPaymentInFlight = errors.New("payment for session still " +
+		"in flight")
 )
@@ -5,6 +5,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tor"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
@@ -61,6 +62,37 @@ type DB interface {
 	// restarts.
 	CreateClientSession(*wtdb.ClientSession) error
 
+	// PutPaidSession records that we paid the tower with the given
+	// identity key for the session with the given ID, using the invoice
+	// with the given payment hash. The amount paid is added to the total
+	// that we paid the tower, and the session's pending payment is
+	// removed.
+	PutPaidSession(wtdb.SessionID, *btcec.PublicKey, lntypes.Hash,
+		lnwire.MilliSatoshi) error
+
+	// GetTowerPayments returns the total amount, including routing fees,
+	// that we paid the tower with the given identity key for its sessions.
+	GetTowerPayments(*btcec.PublicKey) (lnwire.MilliSatoshi, error)
+
+	// GetPaidSession returns the payment hash of the invoice we paid for
+	// the session with the given ID. ErrPaidSessionNotFound is returned if
+	// the session wasn't paid for, or if it was created since.
+	GetPaidSession(wtdb.SessionID) (lntypes.Hash, error)
+
+	// PutPendingSessionPayment records that we are about to pay the
+	// invoice with the given payment hash for the session with the given
+	// ID.
+	PutPendingSessionPayment(wtdb.SessionID, lntypes.Hash) error
+
+	// GetPendingSessionPayment returns the payment hash of the pending
+	// payment for the session with the given ID.
+	// ErrPendingSessionPaymentNotFound is returned if there is none.
+	GetPendingSessionPayment(wtdb.SessionID) (lntypes.Hash, error)
+
+	// DeletePendingSessionPayment removes the pending payment for the
+	// session with the given ID.
+	DeletePendingSessionPayment(wtdb.SessionID) error
+
 	// ListClientSessions returns the set of all client sessions known to
 	// the db. An optional tower ID can be used to filter out any client
 	// sessions in the response that do not correspond to this tower.
@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/subscribe"
 	"github.com/lightningnetwork/lnd/tor"
@@ -154,6 +155,36 @@ type Config struct {
 	// MaxTasksInMemQueue is the maximum number of backup tasks that should
 	// be kept in-memory. Any more tasks will overflow to disk.
 	MaxTasksInMemQueue uint64
+
+	// MaxSessionPayment is the maximum amount, including routing fees,
+	// that the client pays a tower for a single session. If zero, the
+	// client doesn't negotiate sessions with towers that charge for them.
+	MaxSessionPayment lnwire.MilliSatoshi
+
+	// MaxTowerPayments is the maximum total amount, including routing
+	// fees, that the client pays a single tower over all of its sessions.
+	// If zero, the client doesn't negotiate sessions with towers that
+	// charge for them.
+	MaxTowerPayments lnwire.MilliSatoshi
+
+	// PayInvoice pays the given bolt11 invoice of the tower with the given
+	// identity key, as long as its amount and the routing fees don't
+	// exceed maxAmt. It blocks until the payment either succeeded or
+	// failed, and returns the amount paid including routing fees.
+	PayInvoice func(payReq string, towerKey *btcec.PublicKey,
+		maxAmt lnwire.MilliSatoshi) (lntypes.Hash, lnwire.MilliSatoshi,
+		error)
+
+	// DecodePaymentHash returns the payment hash of the given bolt11
+	// invoice.
+	DecodePaymentHash func(payReq string) (lntypes.Hash, error)
+
+	// PaymentStatus returns the status of the payment with the given
+	// payment hash, and the amount paid including routing fees if it
+	// succeeded. channeldb.ErrPaymentNotInitiated is returned if the
+	// payment was never started.
+	PaymentStatus func(hash lntypes.Hash) (channeldb.PaymentStatus,
+		lnwire.MilliSatoshi, error)
 }
 
 // Manager manages the various tower clients that are active. A client is
@@ -585,7 +616,7 @@ func (m *Manager) Policy(blobType blob.Type) (wtpolicy.Policy, error) {
 	defer m.clientsMu.Unlock()
 
 	var policy wtpolicy.Policy
-	client, ok := m.clients[blobType]
+	client, ok := m.clients[m.clientBlobType(blobType)]
 	if !ok {
 		return policy, fmt.Errorf("no client for the given blob type")
 	}
@@ -593,15 +624,28 @@ func (m *Manager) Policy(blobType blob.Type) (wtpolicy.Policy, error) {
 	return client.policy(), nil
 }
 
+// clientBlobType returns the blob type of the client that backs up channels
+// of the given altruist blob type. If a client for reward sessions is
+// registered for the same channel type, that client is used instead.
+//
+// NOTE: The clientsMu must be held when calling this method.
+func (m *Manager) clientBlobType(blobType blob.Type) blob.Type {
+	rewardType := blobType | blob.Type(blob.FlagReward)
+	if _, ok := m.clients[rewardType]; ok {
+		return rewardType
+	}
+
+	return blobType
+}
+
 // RegisterChannel persistently initializes any channel-dependent parameters
 // within the client. This should be called during link startup to ensure that
 // the client is able to support the link during operation.
 func (m *Manager) RegisterChannel(id lnwire.ChannelID,
 	chanType channeldb.ChannelType) error {
 
-	blobType := blob.TypeFromChannel(chanType)
-
 	m.clientsMu.Lock()
+	blobType := m.clientBlobType(blob.TypeFromChannel(chanType))
 	if _, ok := m.clients[blobType]; !ok {
 		m.clientsMu.Unlock()
 
@@ -6,9 +6,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btclog"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtdb"
@@ -91,6 +95,41 @@ type NegotiatorConfig struct {
 	// Log specifies the desired log output, which should be prefixed by the
 	// client type, e.g. anchor or legacy.
 	Log btclog.Logger
+
+	// MaxSessionPayment is the maximum amount, including routing fees,
+	// that we pay a tower for a single session. If zero, we don't
+	// negotiate sessions with towers that charge for them.
+	MaxSessionPayment lnwire.MilliSatoshi
+
+	// MaxTowerPayments is the maximum total amount, including routing
+	// fees, that we pay a single tower over all of its sessions. If zero,
+	// we don't negotiate sessions with towers that charge for them.
+	MaxTowerPayments lnwire.MilliSatoshi
+
+	// PayInvoice pays the invoice of the tower with the given identity
+	// key, as long as its amount and the routing fees don't exceed maxAmt.
+	// The amount paid, including routing fees, is returned.
+	PayInvoice func(payReq string, towerKey *btcec.PublicKey,
+		maxAmt lnwire.MilliSatoshi) (lntypes.Hash, lnwire.MilliSatoshi,
+		error)
+
+	// DecodePaymentHash returns the payment hash of the given invoice.
+	DecodePaymentHash func(payReq string) (lntypes.Hash, error)
+
+	// PaymentStatus returns the status of the payment with the given
+	// payment hash. If the payment succeeded, the amount paid including
+	// routing fees is returned as well. channeldb.ErrPaymentNotInitiated
+	// is returned if the payment was never started.
+	PaymentStatus func(hash lntypes.Hash) (channeldb.PaymentStatus,
+		lnwire.MilliSatoshi, error)
+}
+
+// paysForSessions returns true if the negotiator is willing to pay towers
+// that charge for their sessions.
+func (c *NegotiatorConfig) paysForSessions() bool {
+	return c.MaxSessionPayment > 0 && c.MaxTowerPayments > 0 &&
+		c.PayInvoice != nil && c.DecodePaymentHash != nil &&
+		c.PaymentStatus != nil
 }
 
 // sessionNegotiator is concrete SessionNegotiator that is able to request new
@@ -109,6 +148,10 @@ type sessionNegotiator struct {
 	newSessions            chan *ClientSession
 	successfulNegotiations chan *ClientSession
 
+	// paidSessionsMu serializes the payments for sessions, so that we
+	// never pay twice for the same session.
+	paidSessionsMu sync.Mutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -122,6 +165,9 @@ func newSessionNegotiator(cfg *NegotiatorConfig) *sessionNegotiator {
 	// Generate the set of features the negotiator will present to the tower
 	// upon connection.
 	features := cfg.Policy.FeatureBits()
+	if cfg.paysForSessions() {
+		features = append(features, wtwire.PaidSessionsOptional)
+	}
 
 	localInit := wtwire.NewInitMessage(
 		lnwire.NewRawFeatureVector(features...),
@@ -429,7 +475,25 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 		return err
 	}
 
+	// A tower only accepts reward sessions if it advertises them.
 	policy := n.cfg.Policy
+	remoteFeatures := lnwire.NewFeatureVector(
+		remoteInit.ConnFeatures, wtwire.FeatureNames,
+	)
+	if policy.BlobType.IsReward() &&
+		!remoteFeatures.HasFeature(wtwire.RewardSessionsOptional) {
+
+		return ErrNoRewardSessions
+	}
+
+	// A tower that charges for its sessions is only used if we're willing
+	// to pay for sessions.
+	if remoteFeatures.HasFeature(wtwire.PaidSessionsRequired) &&
+		!n.cfg.paysForSessions() {
+
+		return ErrPaidSessionsDisabled
+	}
+
 	createSession := &wtwire.CreateSession{
 		BlobType:     policy.BlobType,
 		MaxUpdates:   policy.MaxUpdates,
@@ -459,8 +523,20 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 
 	switch createSessionReply.Code {
 	case wtwire.CodeOK:
-		// TODO(conner): validate reward address
+		// The reward address must be a segwit output, as we need to
+		// know its weight to construct the justice transactions.
 		rewardPkScript := createSessionReply.Data
+		if policy.BlobType.IsReward() {
+			switch addrType(rewardPkScript) {
+			case txscript.WitnessV0PubKeyHashTy,
+				txscript.WitnessV0ScriptHashTy,
+				txscript.WitnessV1TaprootTy:
+
+			default:
+				return fmt.Errorf("tower returned invalid "+
+					"reward script %x", rewardPkScript)
+			}
+		}
 
 		sessionID := wtdb.NewSessionIDFromPubKey(sessionKey.PubKey())
 		dbClientSession := &wtdb.ClientSession{
@@ -473,6 +549,16 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 			ID: sessionID,
 		}
 
+		// If we restarted while paying for the session, the payment
+		// is recorded now, so that it counts towards the total that
+		// we paid the tower.
+		if n.cfg.paysForSessions() {
+			err := n.recordPendingPayment(sessionID, lnAddr)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = n.cfg.DB.CreateClientSession(dbClientSession)
 		if err != nil {
 			return fmt.Errorf("unable to persist ClientSession: %w",
@@ -526,8 +612,33 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 			return ErrPermanentTowerFailure
 		}
 
-		return fmt.Errorf("tower rejected reward rate: %v",
-			policy.RewardRate)
+		// The tower returns its minimum terms, so we can tell the
+		// user what the tower expects.
+		terms, err := wtwire.DecodeRewardTerms(createSessionReply.Data)
+		if err != nil {
+			return fmt.Errorf("tower rejected reward base=%d "+
+				"rate=%d", policy.RewardBase, policy.RewardRate)
+		}
+
+		return fmt.Errorf("tower rejected reward base=%d rate=%d, "+
+			"requires base=%d rate=%d", policy.RewardBase,
+			policy.RewardRate, terms.RewardBase, terms.RewardRate)
+
+	case wtwire.CreateSessionCodePaymentRequired:
+		payReq := string(createSessionReply.Data)
+		err := n.paySession(sessionKey, lnAddr, payReq)
+		if err != nil {
+			return err
+		}
+
+		// The tower hangs up after requesting the payment, so we
+		// connect again to have the paid session activated.
+		if err := conn.Close(); err != nil {
+			n.log.Debugf("Unable to close connection to %s: %v",
+				lnAddr, err)
+		}
+
+		return n.tryAddress(sessionKey, keyIndex, tower, lnAddr)
 
 	case wtwire.CreateSessionCodeRejectSweepFeeRate:
 		return fmt.Errorf("tower rejected sweep fee rate: %v",
@@ -538,3 +649,190 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 			createSessionReply.Code)
 	}
 }
+
+// paySession pays the invoice that a tower requested for the session of the
+// given session key. A session is paid for at most once.
+func (n *sessionNegotiator) paySession(sessionKey keychain.SingleKeyECDH,
+	lnAddr *lnwire.NetAddress, payReq string) error {
+
+	if !n.cfg.paysForSessions() {
+		return ErrPaidSessionsDisabled
+	}
+
+	sessionID := wtdb.NewSessionIDFromPubKey(sessionKey.PubKey())
+
+	n.paidSessionsMu.Lock()
+	defer n.paidSessionsMu.Unlock()
+
+	// The paid sessions are persisted, so that a restart between paying
+	// and creating the session doesn't make us pay for it again.
+	paymentHash, err := n.cfg.DB.GetPaidSession(sessionID)
+	switch {
+	case err == nil:
+		n.log.Warnf("Tower=%s requested another payment for session "+
+			"%s, already paid with payment_hash=%v", lnAddr,
+			sessionID, paymentHash)
+
+		return ErrSessionAlreadyPaid
+
+	case !errors.Is(err, wtdb.ErrPaidSessionNotFound):
+		return err
+	}
+
+	// If we restarted while paying for the session, its payment is still
+	// pending. We look up how the payment ended rather than paying again.
+	paymentHash, err = n.cfg.DB.GetPendingSessionPayment(sessionID)
+	switch {
+	case err == nil:
+		err := n.reconcileSessionPayment(sessionID, lnAddr, paymentHash)
+		if err != nil {
+			return err
+		}
+
+	case !errors.Is(err, wtdb.ErrPendingSessionPaymentNotFound):
+		return err
+	}
+
+	// We never pay a tower more than MaxTowerPayments in total, so the
+	// session payment is capped by what's left of it.
+	towerPaid, err := n.cfg.DB.GetTowerPayments(lnAddr.IdentityKey)
+	if err != nil {
+		return err
+	}
+	if towerPaid >= n.cfg.MaxTowerPayments {
+		n.log.Warnf("Not paying tower=%s for session %s, already paid "+
+			"%v of at most %v", lnAddr, sessionID, towerPaid,
+			n.cfg.MaxTowerPayments)
+
+		return ErrTowerPaymentLimit
+	}
+
+	maxAmt := n.cfg.MaxSessionPayment
+	if remaining := n.cfg.MaxTowerPayments - towerPaid; remaining < maxAmt {
+		maxAmt = remaining
+	}
+
+	n.log.Infof("Paying tower=%s for session %s", lnAddr, sessionID)
+
+	// The payment is recorded as pending before we pay, so that we know
+	// about it if we restart before its outcome is recorded.
+	paymentHash, err = n.cfg.DecodePaymentHash(payReq)
+	if err != nil {
+		return fmt.Errorf("unable to decode session invoice: %w", err)
+	}
+
+	err = n.cfg.DB.PutPendingSessionPayment(sessionID, paymentHash)
+	if err != nil {
+		return err
+	}
+
+	// If the payment fails, its pending record is kept. Its status is
+	// checked the next time the tower requests a payment for the session.
+	_, amtPaid, err := n.cfg.PayInvoice(
+		payReq, lnAddr.IdentityKey, maxAmt,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to pay for session: %w", err)
+	}
+
+	return n.cfg.DB.PutPaidSession(
+		sessionID, lnAddr.IdentityKey, paymentHash, amtPaid,
+	)
+}
+
+// reconcileSessionPayment looks up the outcome of a pending payment for the
+// session with the given ID. If the payment failed or was never started, it's
+// removed so that the session can be paid for again. A successful payment is
+// recorded as paid, and ErrSessionAlreadyPaid is returned. If the payment is
+// still in flight, ErrSessionPaymentInFlight is returned.
+func (n *sessionNegotiator) reconcileSessionPayment(sessionID wtdb.SessionID,
+	lnAddr *lnwire.NetAddress, paymentHash lntypes.Hash) error {
+
+	status, amtPaid, err := n.cfg.PaymentStatus(paymentHash)
+	switch {
+	case errors.Is(err, channeldb.ErrPaymentNotInitiated):
+		n.log.Infof("Payment for session %s with payment_hash=%v was "+
+			"never started", sessionID, paymentHash)
+
+		return n.cfg.DB.DeletePendingSessionPayment(sessionID)
+
+	case err != nil:
+		return err
+	}
+
+	switch status {
+	case channeldb.StatusSucceeded:
+		n.log.Infof("Payment for session %s with payment_hash=%v "+
+			"succeeded", sessionID, paymentHash)
+
+		err := n.cfg.DB.PutPaidSession(
+			sessionID, lnAddr.IdentityKey, paymentHash, amtPaid,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ErrSessionAlreadyPaid
+
+	case channeldb.StatusFailed:
+		n.log.Infof("Payment for session %s with payment_hash=%v "+
+			"failed", sessionID, paymentHash)
+
+		return n.cfg.DB.DeletePendingSessionPayment(sessionID)
+
+	default:
+		n.log.Infof("Payment for session %s with payment_hash=%v is "+
+			"still in flight", sessionID, paymentHash)
+
+		return ErrSessionPaymentInFlight
+	}
+}
+
+// recordPendingPayment records the pending payment for the session with the
+// given ID as paid, once the tower accepted the session. A payment that we
+// don't know to have succeeded yet isn't added to the tower's total, and a
+// payment that was never started is removed.
+func (n *sessionNegotiator) recordPendingPayment(sessionID wtdb.SessionID,
+	lnAddr *lnwire.NetAddress) error {
+
+	n.paidSessionsMu.Lock()
+	defer n.paidSessionsMu.Unlock()
+
+	paymentHash, err := n.cfg.DB.GetPendingSessionPayment(sessionID)
+	switch {
+	case errors.Is(err, wtdb.ErrPendingSessionPaymentNotFound):
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	// If we restarted after recording the payment as pending but before
+	// paying, the payment was never started. The tower accepted the session
+	// anyway, so there's nothing to record and the pending record is
+	// removed.
+	status, amtPaid, err := n.cfg.PaymentStatus(paymentHash)
+	switch {
+	case errors.Is(err, channeldb.ErrPaymentNotInitiated):
+		n.log.Infof("Tower=%s accepted session %s, its payment with "+
+			"payment_hash=%v was never started", lnAddr, sessionID,
+			paymentHash)
+
+		return n.cfg.DB.DeletePendingSessionPayment(sessionID)
+
+	case err != nil:
+		return err
+	}
+
+	if status != channeldb.StatusSucceeded {
+		n.log.Warnf("Tower=%s accepted session %s, but its payment "+
+			"with payment_hash=%v has status %v", lnAddr,
+			sessionID, paymentHash, status)
+
+		return nil
+	}
+
+	return n.cfg.DB.PutPaidSession(
+		sessionID, lnAddr.IdentityKey, paymentHash, amtPaid,
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
@@ -114,6 +115,24 @@ var (
 	// content.
 	cTaskQueue = []byte("client-task-queue")
 
+	// cPaidSessionsBkt is a top-level bucket storing the sessions that we
+	// paid a tower for, but that the tower didn't activate yet:
+	// 	session-id -> payment-hash
+	cPaidSessionsBkt = []byte("client-paid-sessions-bucket")
+
+	// cPendingSessionPaymentsBkt is a top-level bucket storing the
+	// payments that we started for sessions, but whose outcome isn't
+	// recorded yet:
+	// 	session-id -> payment-hash
+	cPendingSessionPaymentsBkt = []byte(
+		"client-pending-session-payments-bucket",
+	)
+
+	// cTowerPaymentsBkt is a top-level bucket storing the total amount,
+	// including routing fees, that we paid each tower for its sessions:
+	// 	tower-pubkey -> total-msat
+	cTowerPaymentsBkt = []byte("client-tower-payments-bucket")
+
 	// ErrTowerNotFound signals that the target tower was not found in the
 	// database.
 	ErrTowerNotFound = errors.New("tower not found")
@@ -200,6 +219,15 @@ var (
 	// session has un-acked updates.
 	ErrSessionHasUnackedUpdates = errors.New("session has un-acked updates")
 
+	// ErrPaidSessionNotFound signals that we didn't pay a tower for the
+	// requested session, or that the tower already activated it.
+	ErrPaidSessionNotFound = errors.New("paid session not found")
+
+	// ErrPendingSessionPaymentNotFound signals that there is no payment
+	// for the requested session whose outcome is still to be recorded.
+	ErrPendingSessionPaymentNotFound = errors.New("pending session " +
+		"payment not found")
+
 	// errChannelHasMoreSessions is an error used to indicate that a channel
 	// has updates in other non-closed sessions.
 	errChannelHasMoreSessions = errors.New("channel has updates in " +
@@ -305,6 +333,9 @@ func initClientDBBuckets(tx kvdb.RwTx) error {
 		cChanIDIndexBkt,
 		cSessionIDIndexBkt,
 		cClosableSessionsBkt,
+		cPaidSessionsBkt,
+		cPendingSessionPaymentsBkt,
+		cTowerPaymentsBkt,
 	}
 
 	for _, bucket := range buckets {
@@ -825,6 +856,26 @@ func (c *ClientDB) CreateClientSession(session *ClientSession) error {
 			return ErrIncorrectKeyIndex
 		}
 
+		// The session is activated, so we no longer need to remember
+		// that we paid for it.
+		paidSessions := tx.ReadWriteBucket(cPaidSessionsBkt)
+		if paidSessions == nil {
+			return ErrUninitializedDB
+		}
+		if err := paidSessions.Delete(session.ID[:]); err != nil {
+			return err
+		}
+
+		pendingPayments := tx.ReadWriteBucket(
+			cPendingSessionPaymentsBkt,
+		)
+		if pendingPayments == nil {
+			return ErrUninitializedDB
+		}
+		if err := pendingPayments.Delete(session.ID[:]); err != nil {
+			return err
+		}
+
 		// Remove the key index reservation. For altruist commit
 		// sessions, we'll also purge under the old legacy key format.
 		key := createSessionKeyIndexKey(towerID, blobType)
@@ -897,6 +948,133 @@ func (c *ClientDB) CreateClientSession(session *ClientSession) error {
 	}, func() {})
 }
 
+// PutPaidSession records that we paid the tower with the given identity key
+// for the session with the given ID, using the invoice with the given payment
+// hash. The amount paid, including routing fees, is added to the total that
+// we paid the tower, and the pending payment of the session is removed. The
+// session record is removed once the session is created with
+// CreateClientSession, while the tower's total is kept.
+func (c *ClientDB) PutPaidSession(id SessionID, towerKey *btcec.PublicKey,
+	paymentHash lntypes.Hash, amt lnwire.MilliSatoshi) error {
+
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		paidSessions := tx.ReadWriteBucket(cPaidSessionsBkt)
+		if paidSessions == nil {
+			return ErrUninitializedDB
+		}
+
+		pendingPayments := tx.ReadWriteBucket(
+			cPendingSessionPaymentsBkt,
+		)
+		if pendingPayments == nil {
+			return ErrUninitializedDB
+		}
+		if err := pendingPayments.Delete(id[:]); err != nil {
+			return err
+		}
+
+		towerPayments := tx.ReadWriteBucket(cTowerPaymentsBkt)
+		if towerPayments == nil {
+			return ErrUninitializedDB
+		}
+
+		err := paidSessions.Put(id[:], paymentHash[:])
+		if err != nil {
+			return err
+		}
+
+		towerKeyBytes := towerKey.SerializeCompressed()
+		total, err := getTowerPayments(towerPayments, towerKeyBytes)
+		if err != nil {
+			return err
+		}
+
+		var totalBytes [8]byte
+		byteOrder.PutUint64(totalBytes[:], uint64(total+amt))
+
+		return towerPayments.Put(towerKeyBytes, totalBytes[:])
+	}, func() {})
+}
+
+// GetTowerPayments returns the total amount, including routing fees, that we
+// paid the tower with the given identity key for its sessions.
+func (c *ClientDB) GetTowerPayments(
+	towerKey *btcec.PublicKey) (lnwire.MilliSatoshi, error) {
+
+	var total lnwire.MilliSatoshi
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		towerPayments := tx.ReadBucket(cTowerPaymentsBkt)
+		if towerPayments == nil {
+			return ErrUninitializedDB
+		}
+
+		var err error
+		total, err = getTowerPayments(
+			towerPayments, towerKey.SerializeCompressed(),
+		)
+
+		return err
+	}, func() {
+		total = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// getTowerPayments reads the total amount that we paid the tower with the
+// given serialized identity key from the tower payments bucket.
+func getTowerPayments(towerPayments kvdb.RBucket,
+	towerKey []byte) (lnwire.MilliSatoshi, error) {
+
+	totalBytes := towerPayments.Get(towerKey)
+	if totalBytes == nil {
+		return 0, nil
+	}
+
+	if len(totalBytes) != 8 {
+		return 0, fmt.Errorf("invalid tower payments length: %d",
+			len(totalBytes))
+	}
+
+	return lnwire.MilliSatoshi(byteOrder.Uint64(totalBytes)), nil
+}
+
+// GetPaidSession returns the payment hash of the invoice that we paid a tower
+// for the session with the given ID. ErrPaidSessionNotFound is returned if we
+// didn't pay for the session, or if the session was created since.
+func (c *ClientDB) GetPaidSession(id SessionID) (lntypes.Hash, error) {
+	var paymentHash lntypes.Hash
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		paidSessions := tx.ReadBucket(cPaidSessionsBkt)
+		if paidSessions == nil {
+			return ErrUninitializedDB
+		}
+
+		hashBytes := paidSessions.Get(id[:])
+		if hashBytes == nil {
+			return ErrPaidSessionNotFound
+		}
+
+		hash, err := lntypes.MakeHash(hashBytes)
+		if err != nil {
+			return err
+		}
+		paymentHash = hash
+
+		return nil
+	}, func() {
+		paymentHash = lntypes.Hash{}
+	})
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	return paymentHash, nil
+}
+
 // readRangeIndex reads a persisted RangeIndex from the passed bucket and into
 // a new in-memory RangeIndex.
 func readRangeIndex(rangesBkt kvdb.RBucket) (*RangeIndex, error) {
@@ -2996,3 +3174,73 @@ func readBigSize(b []byte) (uint64, error) {
 
 	return i, nil
 }
+
+// PutPendingSessionPayment records that we are about to pay the invoice with
+// the given payment hash for the session with the given ID. The record is
+// kept until the payment's outcome is recorded with PutPaidSession or the
+// payment is found to have failed, so that a restart in the meantime doesn't
+// make us pay for the session again.
+func (c *ClientDB) PutPendingSessionPayment(id SessionID,
+	paymentHash lntypes.Hash) error {
+
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		pendingPayments := tx.ReadWriteBucket(
+			cPendingSessionPaymentsBkt,
+		)
+		if pendingPayments == nil {
+			return ErrUninitializedDB
+		}
+
+		return pendingPayments.Put(id[:], paymentHash[:])
+	}, func() {})
+}
+
+// GetPendingSessionPayment returns the payment hash of the pending payment
+// for the session with the given ID. ErrPendingSessionPaymentNotFound is
+// returned if there is no pending payment for the session.
+func (c *ClientDB) GetPendingSessionPayment(id SessionID) (lntypes.Hash,
+	error) {
+
+	var paymentHash lntypes.Hash
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		pendingPayments := tx.ReadBucket(cPendingSessionPaymentsBkt)
+		if pendingPayments == nil {
+			return ErrUninitializedDB
+		}
+
+		hashBytes := pendingPayments.Get(id[:])
+		if hashBytes == nil {
+			return ErrPendingSessionPaymentNotFound
+		}
+
+		hash, err := lntypes.MakeHash(hashBytes)
+		if err != nil {
+			return err
+		}
+		paymentHash = hash
+
+		return nil
+	}, func() {
+		paymentHash = lntypes.Hash{}
+	})
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	return paymentHash, nil
+}
+
+// DeletePendingSessionPayment removes the pending payment for the session
+// with the given ID, once the payment is known to have failed.
+func (c *ClientDB) DeletePendingSessionPayment(id SessionID) error {
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		pendingPayments := tx.ReadWriteBucket(
+			cPendingSessionPaymentsBkt,
+		)
+		if pendingPayments == nil {
+			return ErrUninitializedDB
+		}
+
+		return pendingPayments.Delete(id[:])
+	}, func() {})
+}
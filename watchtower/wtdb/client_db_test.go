@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtclient"
@@ -397,6 +398,123 @@ func testCreateClientSession(h *clientDBHarness) {
 	require.Equal(h.t, keyIndex3+1000, keyIndex5)
 }
 
+// testPaidSessions asserts that a paid session is persisted until the session
+// is created, and that the total paid to the tower is kept.
+func testPaidSessions(h *clientDBHarness) {
+	const blobType = blob.TypeAltruistAnchorCommit
+
+	tower := h.newTower()
+	session := &wtdb.ClientSession{
+		ClientSessionBody: wtdb.ClientSessionBody{
+			TowerID: tower.ID,
+			Policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType: blobType,
+				},
+				MaxUpdates: 100,
+			},
+			RewardPkScript: []byte{0x01, 0x02, 0x03},
+		},
+		ID: wtdb.SessionID([33]byte{0x02}),
+	}
+
+	// The session hasn't been paid for yet.
+	_, err := h.db.GetPaidSession(session.ID)
+	require.ErrorIs(h.t, err, wtdb.ErrPaidSessionNotFound)
+
+	// Nothing was paid to the tower yet.
+	paid, err := h.db.GetTowerPayments(tower.IdentityKey)
+	require.NoError(h.t, err)
+	require.Zero(h.t, paid)
+
+	// Record the payment, and assert that it can be retrieved.
+	paymentHash := lntypes.Hash{0x01, 0x02}
+	err = h.db.PutPaidSession(
+		session.ID, tower.IdentityKey, paymentHash, 1000,
+	)
+	require.NoError(h.t, err)
+
+	hash, err := h.db.GetPaidSession(session.ID)
+	require.NoError(h.t, err)
+	require.Equal(h.t, paymentHash, hash)
+
+	// Another payment to the same tower adds to its total.
+	err = h.db.PutPaidSession(
+		wtdb.SessionID([33]byte{0x03}), tower.IdentityKey,
+		lntypes.Hash{0x03}, 500,
+	)
+	require.NoError(h.t, err)
+
+	paid, err = h.db.GetTowerPayments(tower.IdentityKey)
+	require.NoError(h.t, err)
+	require.EqualValues(h.t, 1500, paid)
+
+	// Once the session is created, the payment record is removed, along
+	// with a pending payment that was left behind.
+	err = h.db.PutPendingSessionPayment(session.ID, lntypes.Hash{0x04})
+	require.NoError(h.t, err)
+
+	session.KeyIndex = h.nextKeyIndex(session.TowerID, blobType, false)
+	h.insertSession(session, nil)
+
+	_, err = h.db.GetPaidSession(session.ID)
+	require.ErrorIs(h.t, err, wtdb.ErrPaidSessionNotFound)
+
+	_, err = h.db.GetPendingSessionPayment(session.ID)
+	require.ErrorIs(h.t, err, wtdb.ErrPendingSessionPaymentNotFound)
+
+	// The tower's total is kept, so that the limit on the total paid to
+	// a tower holds across sessions.
+	paid, err = h.db.GetTowerPayments(tower.IdentityKey)
+	require.NoError(h.t, err)
+	require.EqualValues(h.t, 1500, paid)
+}
+
+// testPendingSessionPayments asserts that a pending session payment is kept
+// until it is deleted or the session is recorded as paid.
+func testPendingSessionPayments(h *clientDBHarness) {
+	tower := h.newTower()
+	sessionID := wtdb.SessionID([33]byte{0x04})
+
+	// There is no pending payment for the session yet.
+	_, err := h.db.GetPendingSessionPayment(sessionID)
+	require.ErrorIs(h.t, err, wtdb.ErrPendingSessionPaymentNotFound)
+
+	// Record a pending payment, and assert that it can be retrieved.
+	paymentHash := lntypes.Hash{0x04}
+	err = h.db.PutPendingSessionPayment(sessionID, paymentHash)
+	require.NoError(h.t, err)
+
+	hash, err := h.db.GetPendingSessionPayment(sessionID)
+	require.NoError(h.t, err)
+	require.Equal(h.t, paymentHash, hash)
+
+	// A failed payment is deleted, so that the session can be paid for
+	// again.
+	err = h.db.DeletePendingSessionPayment(sessionID)
+	require.NoError(h.t, err)
+
+	_, err = h.db.GetPendingSessionPayment(sessionID)
+	require.ErrorIs(h.t, err, wtdb.ErrPendingSessionPaymentNotFound)
+
+	// Recording the session as paid removes its pending payment.
+	paymentHash = lntypes.Hash{0x05}
+	err = h.db.PutPendingSessionPayment(sessionID, paymentHash)
+	require.NoError(h.t, err)
+
+	err = h.db.PutPaidSession(
+		sessionID, tower.IdentityKey, paymentHash, 1000,
+	)
+	require.NoError(h.t, err)
+
+	_, err = h.db.GetPendingSessionPayment(sessionID)
+	require.ErrorIs(h.t, err, wtdb.ErrPendingSessionPaymentNotFound)
+
+	hash, err = h.db.GetPaidSession(sessionID)
+	require.NoError(h.t, err)
+	require.Equal(h.t, paymentHash, hash)
+}
+
 // testFilterClientSessions asserts that we can correctly filter client sessions
 // for a specific tower.
 func testFilterClientSessions(h *clientDBHarness) {
@@ -1285,6 +1403,14 @@ func TestClientDB(t *testing.T) {
 			name: "create client session",
 			run:  testCreateClientSession,
 		},
+		{
+			name: "paid sessions",
+			run:  testPaidSessions,
+		},
+		{
+			name: "pending session payments",
+			run:  testPendingSessionPayments,
+		},
 		{
 			name: "filter client sessions",
 			run:  testFilterClientSessions,
@@ -0,0 +1,57 @@
+package wtdb
+
+import (
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// Reward records a reward that the tower claimed by publishing a justice
+// transaction for one of its reward sessions.
+type Reward struct {
+	// SessionID is the session that the justice transaction was created
+	// for.
+	SessionID SessionID
+
+	// JusticeTxID is the txid of the justice transaction that pays the
+	// reward.
+	JusticeTxID chainhash.Hash
+
+	// Amount is the value of the reward output of the justice
+	// transaction.
+	Amount btcutil.Amount
+
+	// Timestamp is the time at which the justice transaction was
+	// published.
+	Timestamp time.Time
+}
+
+// Encode serializes the reward to the given io.Writer.
+func (r *Reward) Encode(w io.Writer) error {
+	return WriteElements(w,
+		r.SessionID,
+		r.JusticeTxID,
+		r.Amount,
+		uint64(r.Timestamp.Unix()),
+	)
+}
+
+// Decode deserializes the reward from the given io.Reader.
+func (r *Reward) Decode(rd io.Reader) error {
+	var timestamp uint64
+	err := ReadElements(rd,
+		&r.SessionID,
+		&r.JusticeTxID,
+		&r.Amount,
+		&timestamp,
+	)
+	if err != nil {
+		return err
+	}
+
+	r.Timestamp = time.Unix(int64(timestamp), 0)
+
+	return nil
+}
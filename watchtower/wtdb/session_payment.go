@@ -0,0 +1,88 @@
+package wtdb
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ErrSessionPaymentNotFound is returned when the tower has not requested a
+// payment for a session.
+var ErrSessionPaymentNotFound = errors.New("session payment not found")
+
+// SessionPayment records the invoice that a client must pay before the tower
+// activates its session, along with the quota of updates that the payment
+// buys.
+type SessionPayment struct {
+	// SessionID is the session that is paid for.
+	SessionID SessionID
+
+	// PaymentHash is the payment hash of the invoice.
+	PaymentHash lntypes.Hash
+
+	// PaymentRequest is the encoded bolt11 invoice handed to the client.
+	PaymentRequest string
+
+	// Amount is the amount of the invoice.
+	Amount lnwire.MilliSatoshi
+
+	// MaxUpdates is the number of updates that the payment buys. The
+	// session can only be activated with at most this many updates.
+	MaxUpdates uint16
+
+	// Expiry is the time at which the invoice expires. An expired invoice
+	// that hasn't been paid is replaced by a fresh one.
+	Expiry time.Time
+
+	// Paid is true once the tower saw the invoice being settled.
+	Paid bool
+}
+
+// Expired returns true if the invoice hasn't been paid and can't be paid
+// anymore at the given time.
+func (p *SessionPayment) Expired(now time.Time) bool {
+	return !p.Paid && !now.Before(p.Expiry)
+}
+
+// Encode serializes the session payment to the given io.Writer.
+func (p *SessionPayment) Encode(w io.Writer) error {
+	return WriteElements(w,
+		p.SessionID,
+		[32]byte(p.PaymentHash),
+		[]byte(p.PaymentRequest),
+		p.Amount,
+		p.MaxUpdates,
+		uint64(p.Expiry.Unix()),
+		p.Paid,
+	)
+}
+
+// Decode deserializes the session payment from the given io.Reader.
+func (p *SessionPayment) Decode(r io.Reader) error {
+	var (
+		paymentHash    [32]byte
+		paymentRequest []byte
+		expiry         uint64
+	)
+	err := ReadElements(r,
+		&p.SessionID,
+		&paymentHash,
+		&paymentRequest,
+		&p.Amount,
+		&p.MaxUpdates,
+		&expiry,
+		&p.Paid,
+	)
+	if err != nil {
+		return err
+	}
+
+	p.PaymentHash = paymentHash
+	p.PaymentRequest = string(paymentRequest)
+	p.Expiry = time.Unix(int64(expiry), 0)
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package wtdb
+
+import "time"
+
+// SessionUsage describes how much storage a session occupies in the tower
+// database.
+type SessionUsage struct {
+	// SessionInfo is the session that the usage is reported for.
+	SessionInfo *SessionInfo
+
+	// NumUpdates is the number of state updates currently stored for the
+	// session. This can be lower than the session's last applied sequence
+	// number if updates were pruned.
+	NumUpdates uint32
+
+	// UpdateBytes is the number of bytes occupied by the stored state
+	// updates of the session.
+	UpdateBytes uint64
+
+	// LastActivity is the time at which the session was created or last
+	// received a state update. It is zero if the session has been
+	// created before the tower tracked its activity, and hasn't been
+	// active since.
+	LastActivity time.Time
+}
@@ -3,6 +3,7 @@ package wtdb
 import (
 	"bytes"
 	"errors"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -24,11 +25,19 @@ var (
 	// updateIndexBkt is a bucket that indexes all state updates by their
 	// overarching session id. This allows for efficient lookup of updates
 	// by their session id, which is currently used to aide deletion
-	// performance.
-	//  session id => hint1 -> []byte{}
-	//             => hint2 -> []byte{}
+	// performance. Each hint maps to the time at which its update was
+	// received, and the session's last activity is stored beside them.
+	//  session id => hint1 -> received time
+	//             => hint2 -> received time
+	//             => sessionActivityKey -> last activity time
 	updateIndexBkt = []byte("update-index-bucket")
 
+	// sessionActivityKey is a static key used to store the time at which a
+	// session was last active in its bucket of the updateIndexBkt. Its
+	// length differs from that of a breach hint so that it is never taken
+	// for one.
+	sessionActivityKey = []byte("last-activity")
+
 	// lookoutTipBkt is a bucket containing the last block epoch processed
 	// by the lookout subsystem. It has one key, lookoutTipKey.
 	//   lookoutTipKey -> block epoch
@@ -38,6 +47,16 @@ var (
 	// epoch from the lookoutTipBkt.
 	lookoutTipKey = []byte("lookout-tip")
 
+	// rewardsBkt is a bucket containing the rewards claimed by the justice
+	// transactions of the tower.
+	//   justice txid -> reward
+	rewardsBkt = []byte("rewards-bucket")
+
+	// sessionPaymentsBkt is a bucket containing the payments that the
+	// tower requested for its paid sessions.
+	//   session id -> session payment
+	sessionPaymentsBkt = []byte("session-payments-bucket")
+
 	// ErrNoSessionHintIndex signals that an active session does not have an
 	// initialized index for tracking its own state updates.
 	ErrNoSessionHintIndex = errors.New("session hint index missing")
@@ -98,6 +117,8 @@ func initTowerDBBuckets(tx kvdb.RwTx) error {
 		updateIndexBkt,
 		updatesBkt,
 		lookoutTipBkt,
+		rewardsBkt,
+		sessionPaymentsBkt,
 	}
 
 	for _, bucket := range buckets {
@@ -206,7 +227,12 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 		// consult the index to determine exactly which updates should
 		// be deleted without needing to iterate over the entire
 		// database.
-		return touchSessionHintBkt(updateIndex, &session.ID)
+		err = touchSessionHintBkt(updateIndex, &session.ID)
+		if err != nil {
+			return err
+		}
+
+		return putSessionActivity(updateIndex, &session.ID, time.Now())
 	}, func() {})
 }
 
@@ -297,8 +323,17 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 
 		// Finally, create an entry in the update index to track this
 		// hint under its session id. This will allow us to delete the
-		// entries efficiently if the session is ever removed.
-		return putHintForSession(updateIndex, &update.ID, update.Hint)
+		// entries efficiently if the session is ever removed, or once
+		// the update falls out of the retention horizon.
+		now := time.Now()
+		err = putHintForSession(
+			updateIndex, &update.ID, update.Hint, now,
+		)
+		if err != nil {
+			return err
+		}
+
+		return putSessionActivity(updateIndex, &update.ID, now)
 	}, func() {
 		lastApplied = 0
 	})
@@ -313,11 +348,17 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 // the tower's database.
 func (t *TowerDB) DeleteSession(target SessionID) error {
 	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
-		sessions := tx.ReadWriteBucket(sessionsBkt)
-		if sessions == nil {
-			return ErrUninitializedDB
-		}
+		return deleteSession(tx, &target)
+	}, func() {})
+}
 
+// PruneUpdates removes all state updates that were received before the given
+// time, and returns the number of removed updates. The sessions of the updates
+// are kept, so that their clients can continue to send updates. Updates stored
+// before the tower tracked their age are treated as if they were received now.
+func (t *TowerDB) PruneUpdates(before time.Time) (int, error) {
+	var numPruned int
+	err := kvdb.Update(t.db, func(tx kvdb.RwTx) error {
 		updates := tx.ReadWriteBucket(updatesBkt)
 		if updates == nil {
 			return ErrUninitializedDB
@@ -328,69 +369,231 @@ func (t *TowerDB) DeleteSession(target SessionID) error {
 			return ErrUninitializedDB
 		}
 
-		// Fail if the session doesn't exit.
-		_, err := getSession(sessions, target[:])
+		// Collect the ids of all sessions in the index first, as the
+		// index can't be modified while iterating over it.
+		var ids []SessionID
+		err := updateIndex.ForEach(func(k, _ []byte) error {
+			if len(k) != SessionIDSize {
+				return nil
+			}
+
+			var id SessionID
+			copy(id[:], k)
+			ids = append(ids, id)
+
+			return nil
+		})
 		if err != nil {
 			return err
 		}
 
-		// Remove the target session.
-		err = sessions.Delete(target[:])
-		if err != nil {
-			return err
+		now := time.Now()
+		for i := range ids {
+			id := &ids[i]
+
+			sessionHints := updateIndex.NestedReadWriteBucket(id[:])
+			if sessionHints == nil {
+				return ErrNoSessionHintIndex
+			}
+
+			var expired, untracked []blob.BreachHint
+			err := sessionHints.ForEach(func(k, v []byte) error {
+				if len(k) != blob.BreachHintSize {
+					return nil
+				}
+
+				var hint blob.BreachHint
+				copy(hint[:], k)
+
+				received, ok := decodeTimestamp(v)
+				switch {
+				case !ok:
+					untracked = append(untracked, hint)
+
+				case received.Before(before):
+					expired = append(expired, hint)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			// Start tracking the age of updates that were stored
+			// without one, so that they expire eventually.
+			for _, hint := range untracked {
+				err := putHintForSession(
+					updateIndex, id, hint, now,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, hint := range expired {
+				err := deleteUpdate(updates, hint, id)
+				if err != nil {
+					return err
+				}
+
+				err = sessionHints.Delete(hint[:])
+				if err != nil {
+					return err
+				}
+
+				numPruned++
+			}
 		}
 
-		// Next, check the update index for any hints that were added
-		// under this session.
-		hints, err := getHintsForSession(updateIndex, &target)
+		return nil
+	}, func() {
+		numPruned = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+// PruneIdleSessions removes all data of the sessions that haven't been active
+// since the given time, and returns the number of removed sessions. Sessions
+// created before the tower tracked their activity are treated as if they were
+// active now.
+func (t *TowerDB) PruneIdleSessions(before time.Time) (int, error) {
+	var numPruned int
+	err := kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		sessions := tx.ReadWriteBucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		updateIndex := tx.ReadWriteBucket(updateIndexBkt)
+		if updateIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		var ids []SessionID
+		err := sessions.ForEach(func(k, _ []byte) error {
+			var id SessionID
+			copy(id[:], k)
+			ids = append(ids, id)
+
+			return nil
+		})
 		if err != nil {
 			return err
 		}
 
-		for _, hint := range hints {
-			// Remove the state updates for any blobs stored under
-			// the target session identifier.
-			updatesForHint := updates.NestedReadWriteBucket(hint[:])
-			if updatesForHint == nil {
-				continue
-			}
+		now := time.Now()
+		for i := range ids {
+			id := &ids[i]
 
-			update := updatesForHint.Get(target[:])
-			if update == nil {
-				continue
+			lastActivity, ok := getSessionActivity(updateIndex, id)
+			switch {
+			// Start tracking the activity of sessions that were
+			// created without it, so that they expire eventually.
+			case !ok:
+				err := putSessionActivity(updateIndex, id, now)
+				if err != nil {
+					return err
+				}
+
+			case lastActivity.Before(before):
+				if err := deleteSession(tx, id); err != nil {
+					return err
+				}
+
+				numPruned++
 			}
+		}
+
+		return nil
+	}, func() {
+		numPruned = 0
+	})
+	if err != nil {
+		return 0, err
+	}
 
-			err := updatesForHint.Delete(target[:])
+	return numPruned, nil
+}
+
+// ListSessionUsage returns the storage used by each session in the tower
+// database.
+func (t *TowerDB) ListSessionUsage() ([]*SessionUsage, error) {
+	var usages []*SessionUsage
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		sessions := tx.ReadBucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		updates := tx.ReadBucket(updatesBkt)
+		if updates == nil {
+			return ErrUninitializedDB
+		}
+
+		updateIndex := tx.ReadBucket(updateIndexBkt)
+		if updateIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		return sessions.ForEach(func(k, v []byte) error {
+			var session SessionInfo
+			err := session.Decode(bytes.NewReader(v))
 			if err != nil {
 				return err
 			}
 
-			// If this was the last state update, we can also remove
-			// the hint that would map to an empty set.
-			err = isBucketEmpty(updatesForHint)
-			switch {
-
-			// Other updates exist for this hint, keep the bucket.
-			case err == errBucketNotEmpty:
-				continue
+			usage := &SessionUsage{
+				SessionInfo: &session,
+			}
 
-			// Unexpected error.
-			case err != nil:
+			hints, err := getHintsForSession(
+				updateIndex, &session.ID,
+			)
+			if err != nil {
 				return err
+			}
 
-			// No more updates for this hint, prune hint bucket.
-			default:
-				err = updates.DeleteNestedBucket(hint[:])
-				if err != nil {
-					return err
+			for _, hint := range hints {
+				updatesForHint := updates.NestedReadBucket(
+					hint[:],
+				)
+				if updatesForHint == nil {
+					continue
 				}
+
+				update := updatesForHint.Get(k)
+				if update == nil {
+					continue
+				}
+
+				usage.NumUpdates++
+				usage.UpdateBytes += uint64(len(update))
 			}
-		}
 
-		// Finally, remove this session from the update index, which
-		// also removes any of the indexed hints beneath it.
-		return removeSessionHintBkt(updateIndex, &target)
-	}, func() {})
+			lastActivity, ok := getSessionActivity(
+				updateIndex, &session.ID,
+			)
+			if ok {
+				usage.LastActivity = lastActivity
+			}
+
+			usages = append(usages, usage)
+
+			return nil
+		})
+	}, func() {
+		usages = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usages, nil
 }
 
 // QueryMatches searches against all known state updates for any that match the
@@ -515,6 +718,184 @@ func (t *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
 	return epoch, nil
 }
 
+// AddReward records a reward claimed by a published justice transaction. A
+// reward that was already recorded for the same justice transaction is
+// replaced, so a justice transaction that is published again isn't counted
+// twice.
+func (t *TowerDB) AddReward(reward *Reward) error {
+	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		rewards := tx.ReadWriteBucket(rewardsBkt)
+		if rewards == nil {
+			return ErrUninitializedDB
+		}
+
+		var b bytes.Buffer
+		if err := reward.Encode(&b); err != nil {
+			return err
+		}
+
+		return rewards.Put(reward.JusticeTxID[:], b.Bytes())
+	}, func() {})
+}
+
+// ListRewards returns all rewards claimed by the tower's justice transactions.
+func (t *TowerDB) ListRewards() ([]*Reward, error) {
+	var rewards []*Reward
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		rewardsBucket := tx.ReadBucket(rewardsBkt)
+		if rewardsBucket == nil {
+			return ErrUninitializedDB
+		}
+
+		return rewardsBucket.ForEach(func(_, v []byte) error {
+			var reward Reward
+			err := reward.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			rewards = append(rewards, &reward)
+
+			return nil
+		})
+	}, func() {
+		rewards = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+// PutSessionPayment records the payment that the tower requested for a
+// session, replacing any payment that was recorded for the session before.
+func (t *TowerDB) PutSessionPayment(payment *SessionPayment) error {
+	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		payments := tx.ReadWriteBucket(sessionPaymentsBkt)
+		if payments == nil {
+			return ErrUninitializedDB
+		}
+
+		var b bytes.Buffer
+		if err := payment.Encode(&b); err != nil {
+			return err
+		}
+
+		return payments.Put(payment.SessionID[:], b.Bytes())
+	}, func() {})
+}
+
+// GetSessionPayment returns the payment that the tower requested for the given
+// session. ErrSessionPaymentNotFound is returned if no payment was requested.
+func (t *TowerDB) GetSessionPayment(id *SessionID) (*SessionPayment, error) {
+	var payment *SessionPayment
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		payments := tx.ReadBucket(sessionPaymentsBkt)
+		if payments == nil {
+			return ErrUninitializedDB
+		}
+
+		paymentBytes := payments.Get(id[:])
+		if paymentBytes == nil {
+			return ErrSessionPaymentNotFound
+		}
+
+		payment = &SessionPayment{}
+
+		return payment.Decode(bytes.NewReader(paymentBytes))
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// ListExpiredSessionPayments returns the payments that the tower requested for
+// sessions that weren't paid, and whose invoices expired at the given time.
+func (t *TowerDB) ListExpiredSessionPayments(
+	now time.Time) ([]*SessionPayment, error) {
+
+	var expired []*SessionPayment
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		payments := tx.ReadBucket(sessionPaymentsBkt)
+		if payments == nil {
+			return ErrUninitializedDB
+		}
+
+		return payments.ForEach(func(_, v []byte) error {
+			var payment SessionPayment
+			err := payment.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if payment.Expired(now) {
+				expired = append(expired, &payment)
+			}
+
+			return nil
+		})
+	}, func() {
+		expired = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expired, nil
+}
+
+// NumUnpaidSessionPayments returns the number of payments that the tower
+// requested for sessions that weren't paid yet, including the ones whose
+// invoices expired.
+func (t *TowerDB) NumUnpaidSessionPayments() (int, error) {
+	var numUnpaid int
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		payments := tx.ReadBucket(sessionPaymentsBkt)
+		if payments == nil {
+			return ErrUninitializedDB
+		}
+
+		return payments.ForEach(func(_, v []byte) error {
+			var payment SessionPayment
+			err := payment.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if !payment.Paid {
+				numUnpaid++
+			}
+
+			return nil
+		})
+	}, func() {
+		numUnpaid = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numUnpaid, nil
+}
+
+// DeleteSessionPayment removes the payment that the tower requested for the
+// given session. Nothing is done if no payment was requested.
+func (t *TowerDB) DeleteSessionPayment(id *SessionID) error {
+	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		payments := tx.ReadWriteBucket(sessionPaymentsBkt)
+		if payments == nil {
+			return ErrUninitializedDB
+		}
+
+		return payments.Delete(id[:])
+	}, func() {})
+}
+
 // getSession retrieves the session info from the sessions bucket identified by
 // its session id. An error is returned if the session is not found or a
 // deserialization error occurs.
@@ -545,6 +926,107 @@ func putSession(sessions kvdb.RwBucket, session *SessionInfo) error {
 	return sessions.Put(session.ID[:], b.Bytes())
 }
 
+// deleteSession removes all data associated with the target session id from
+// the tower's database.
+func deleteSession(tx kvdb.RwTx, target *SessionID) error {
+	sessions := tx.ReadWriteBucket(sessionsBkt)
+	if sessions == nil {
+		return ErrUninitializedDB
+	}
+
+	updates := tx.ReadWriteBucket(updatesBkt)
+	if updates == nil {
+		return ErrUninitializedDB
+	}
+
+	updateIndex := tx.ReadWriteBucket(updateIndexBkt)
+	if updateIndex == nil {
+		return ErrUninitializedDB
+	}
+
+	// Fail if the session doesn't exit.
+	_, err := getSession(sessions, target[:])
+	if err != nil {
+		return err
+	}
+
+	// Remove the target session.
+	err = sessions.Delete(target[:])
+	if err != nil {
+		return err
+	}
+
+	// The quota bought by a payment for the session is used up once the
+	// session is deleted.
+	payments := tx.ReadWriteBucket(sessionPaymentsBkt)
+	if payments == nil {
+		return ErrUninitializedDB
+	}
+
+	err = payments.Delete(target[:])
+	if err != nil {
+		return err
+	}
+
+	// Next, check the update index for any hints that were added under
+	// this session.
+	hints, err := getHintsForSession(updateIndex, target)
+	if err != nil {
+		return err
+	}
+
+	// Remove the state updates for any blobs stored under the target
+	// session identifier.
+	for _, hint := range hints {
+		if err := deleteUpdate(updates, hint, target); err != nil {
+			return err
+		}
+	}
+
+	// Finally, remove this session from the update index, which also
+	// removes any of the indexed hints beneath it.
+	return removeSessionHintBkt(updateIndex, target)
+}
+
+// deleteUpdate removes the state update stored under the given hint for the
+// target session, if any. The update index is left untouched.
+func deleteUpdate(updates kvdb.RwBucket, hint blob.BreachHint,
+	target *SessionID) error {
+
+	updatesForHint := updates.NestedReadWriteBucket(hint[:])
+	if updatesForHint == nil {
+		return nil
+	}
+
+	update := updatesForHint.Get(target[:])
+	if update == nil {
+		return nil
+	}
+
+	err := updatesForHint.Delete(target[:])
+	if err != nil {
+		return err
+	}
+
+	// If this was the last state update, we can also remove the hint that
+	// would map to an empty set.
+	err = isBucketEmpty(updatesForHint)
+	switch {
+
+	// Other updates exist for this hint, keep the bucket.
+	case err == errBucketNotEmpty:
+		return nil
+
+	// Unexpected error.
+	case err != nil:
+		return err
+
+	// No more updates for this hint, prune hint bucket.
+	default:
+		return updates.DeleteNestedBucket(hint[:])
+	}
+}
+
 // touchSessionHintBkt initializes the session-hint bucket for a particular
 // session id. This ensures that future calls to getHintsForSession or
 // putHintForSession can rely on the bucket already being created, and fail if
@@ -591,19 +1073,67 @@ func getHintsForSession(updateIndex kvdb.RBucket,
 }
 
 // putHintForSession inserts a record into the update index for a given
-// (session, hint) pair. The hints are coalesced under a bucket for the target
-// session id, and used to perform efficient removal of updates. If the index
-// for the session has not been initialized, this method returns
-// ErrNoSessionHintIndex.
+// (session, hint) pair, along with the time at which the update was received.
+// The hints are coalesced under a bucket for the target session id, and used
+// to perform efficient removal of updates. If the index for the session has
+// not been initialized, this method returns ErrNoSessionHintIndex.
 func putHintForSession(updateIndex kvdb.RwBucket, id *SessionID,
-	hint blob.BreachHint) error {
+	hint blob.BreachHint, received time.Time) error {
 
 	sessionHints := updateIndex.NestedReadWriteBucket(id[:])
 	if sessionHints == nil {
 		return ErrNoSessionHintIndex
 	}
 
-	return sessionHints.Put(hint[:], []byte{})
+	return sessionHints.Put(hint[:], encodeTimestamp(received))
+}
+
+// putSessionActivity records the time at which the given session was last
+// active in the update index. If the index for the session has not been
+// initialized, this method returns ErrNoSessionHintIndex.
+func putSessionActivity(updateIndex kvdb.RwBucket, id *SessionID,
+	lastActivity time.Time) error {
+
+	sessionHints := updateIndex.NestedReadWriteBucket(id[:])
+	if sessionHints == nil {
+		return ErrNoSessionHintIndex
+	}
+
+	return sessionHints.Put(
+		sessionActivityKey, encodeTimestamp(lastActivity),
+	)
+}
+
+// getSessionActivity returns the time at which the given session was last
+// active. False is returned if the session's activity hasn't been recorded.
+func getSessionActivity(updateIndex kvdb.RBucket,
+	id *SessionID) (time.Time, bool) {
+
+	sessionHints := updateIndex.NestedReadBucket(id[:])
+	if sessionHints == nil {
+		return time.Time{}, false
+	}
+
+	return decodeTimestamp(sessionHints.Get(sessionActivityKey))
+}
+
+// encodeTimestamp serializes the given time as unix nanoseconds.
+func encodeTimestamp(t time.Time) []byte {
+	var b [8]byte
+	byteOrder.PutUint64(b[:], uint64(t.UnixNano()))
+
+	return b[:]
+}
+
+// decodeTimestamp deserializes a time stored by encodeTimestamp. False is
+// returned if no time is stored, as is the case for the entries of the update
+// index written before the tower tracked their age.
+func decodeTimestamp(b []byte) (time.Time, bool) {
+	if len(b) != 8 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(byteOrder.Uint64(b))), true
 }
 
 // putLookoutEpoch stores the given lookout tip block epoch in provided bucket.
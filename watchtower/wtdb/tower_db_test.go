@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/watchtower"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtdb"
@@ -240,6 +242,120 @@ func testLookoutTip(h *towerDBHarness) {
 	}
 }
 
+// testRewards asserts that the database stores the rewards claimed by justice
+// transactions, and doesn't count a justice transaction twice.
+func testRewards(h *towerDBHarness) {
+	rewards, err := h.db.ListRewards()
+	require.NoError(h.t, err)
+	require.Empty(h.t, rewards)
+
+	reward1 := &wtdb.Reward{
+		SessionID:   *id(0),
+		JusticeTxID: chainhash.Hash{1},
+		Amount:      1000,
+		Timestamp:   time.Unix(1000, 0),
+	}
+	reward2 := &wtdb.Reward{
+		SessionID:   *id(1),
+		JusticeTxID: chainhash.Hash{2},
+		Amount:      2000,
+		Timestamp:   time.Unix(2000, 0),
+	}
+
+	// Adding the same reward again must not count it twice.
+	require.NoError(h.t, h.db.AddReward(reward1))
+	require.NoError(h.t, h.db.AddReward(reward2))
+	require.NoError(h.t, h.db.AddReward(reward1))
+
+	rewards, err = h.db.ListRewards()
+	require.NoError(h.t, err)
+	require.Equal(h.t, []*wtdb.Reward{reward1, reward2}, rewards)
+}
+
+// testSessionPayments asserts that the database stores the payments requested
+// for sessions, lists the unpaid ones, and removes them along with their
+// session.
+func testSessionPayments(h *towerDBHarness) {
+	id0 := id(0)
+	_, err := h.db.GetSessionPayment(id0)
+	require.ErrorIs(h.t, err, wtdb.ErrSessionPaymentNotFound)
+
+	numUnpaid, err := h.db.NumUnpaidSessionPayments()
+	require.NoError(h.t, err)
+	require.Zero(h.t, numUnpaid)
+
+	payment := &wtdb.SessionPayment{
+		SessionID:      *id0,
+		PaymentHash:    lntypes.Hash{1},
+		PaymentRequest: "lntb10u1p",
+		Amount:         10000,
+		MaxUpdates:     1000,
+		Expiry:         time.Unix(1000, 0),
+	}
+	require.NoError(h.t, h.db.PutSessionPayment(payment))
+
+	dbPayment, err := h.db.GetSessionPayment(id0)
+	require.NoError(h.t, err)
+	require.Equal(h.t, payment, dbPayment)
+
+	// The unpaid payment is only listed as expired once its invoice
+	// expired.
+	numUnpaid, err = h.db.NumUnpaidSessionPayments()
+	require.NoError(h.t, err)
+	require.Equal(h.t, 1, numUnpaid)
+
+	expired, err := h.db.ListExpiredSessionPayments(time.Unix(999, 0))
+	require.NoError(h.t, err)
+	require.Empty(h.t, expired)
+
+	expired, err = h.db.ListExpiredSessionPayments(time.Unix(1000, 0))
+	require.NoError(h.t, err)
+	require.Equal(h.t, []*wtdb.SessionPayment{payment}, expired)
+
+	// A payment can be deleted without its session.
+	require.NoError(h.t, h.db.DeleteSessionPayment(id0))
+	_, err = h.db.GetSessionPayment(id0)
+	require.ErrorIs(h.t, err, wtdb.ErrSessionPaymentNotFound)
+
+	require.NoError(h.t, h.db.PutSessionPayment(payment))
+
+	// A paid invoice never expires.
+	require.True(h.t, dbPayment.Expired(time.Unix(1000, 0)))
+	payment.Paid = true
+	require.False(h.t, payment.Expired(time.Unix(1000, 0)))
+
+	// Recording the payment again replaces the earlier record.
+	require.NoError(h.t, h.db.PutSessionPayment(payment))
+	dbPayment, err = h.db.GetSessionPayment(id0)
+	require.NoError(h.t, err)
+	require.True(h.t, dbPayment.Paid)
+
+	numUnpaid, err = h.db.NumUnpaidSessionPayments()
+	require.NoError(h.t, err)
+	require.Zero(h.t, numUnpaid)
+
+	expired, err = h.db.ListExpiredSessionPayments(time.Unix(1000, 0))
+	require.NoError(h.t, err)
+	require.Empty(h.t, expired)
+
+	// Once the paid session is deleted, the payment is removed as well.
+	h.insertSession(&wtdb.SessionInfo{
+		ID: *id0,
+		Policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeAltruistCommit,
+				SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+			},
+			MaxUpdates: 1000,
+		},
+		RewardAddress: []byte{},
+	}, nil)
+	h.deleteSession(*id0, nil)
+
+	_, err = h.db.GetSessionPayment(id0)
+	require.ErrorIs(h.t, err, wtdb.ErrSessionPaymentNotFound)
+}
+
 // testDeleteSession asserts the behavior of a tower database when deleting
 // session data. The test asserts that the only proper the target session is
 // remmoved, and that only updates for a particular session are pruned.
@@ -327,6 +443,96 @@ func testDeleteSession(h *towerDBHarness) {
 	require.Zero(h.t, len(matches))
 }
 
+// testRetention asserts that the database reports the storage used by each
+// session, and prunes old state updates and idle sessions.
+func testRetention(h *towerDBHarness) {
+	policy := wtpolicy.Policy{
+		TxPolicy: wtpolicy.TxPolicy{
+			BlobType:     blob.TypeAltruistCommit,
+			SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+		},
+		MaxUpdates: 3,
+	}
+	newSession := func(id *wtdb.SessionID) *wtdb.SessionInfo {
+		return &wtdb.SessionInfo{
+			ID:            *id,
+			Policy:        policy,
+			RewardAddress: []byte{},
+		}
+	}
+
+	id0, id1 := id(0), id(1)
+	start := time.Now()
+	h.insertSession(newSession(id0), nil)
+	h.insertSession(newSession(id1), nil)
+
+	update0 := updateFromInt(id0, 1, 0)
+	h.insertUpdate(update0, nil)
+
+	// Make sure the updates are received at distinct times.
+	time.Sleep(10 * time.Millisecond)
+	cutoff := time.Now()
+	time.Sleep(10 * time.Millisecond)
+
+	update1 := updateFromInt(id0, 2, 1)
+	h.insertUpdate(update1, nil)
+
+	var b bytes.Buffer
+	require.NoError(h.t, update0.Encode(&b))
+	updateSize := uint64(b.Len())
+
+	usages, err := h.db.ListSessionUsage()
+	require.NoError(h.t, err)
+	require.Len(h.t, usages, 2)
+	require.Equal(h.t, *id0, usages[0].SessionInfo.ID)
+	require.EqualValues(h.t, 2, usages[0].NumUpdates)
+	require.Equal(h.t, 2*updateSize, usages[0].UpdateBytes)
+	require.True(h.t, usages[0].LastActivity.After(cutoff))
+	require.Equal(h.t, *id1, usages[1].SessionInfo.ID)
+	require.Zero(h.t, usages[1].NumUpdates)
+	require.Zero(h.t, usages[1].UpdateBytes)
+	require.False(h.t, usages[1].LastActivity.Before(start))
+
+	// Only the update received before the cutoff is pruned, the session
+	// itself is kept.
+	numPruned, err := h.db.PruneUpdates(cutoff)
+	require.NoError(h.t, err)
+	require.Equal(h.t, 1, numPruned)
+	require.Empty(h.t, h.queryMatches(update0.Hint))
+	h.hasUpdate(update1.Hint)
+	h.getSession(id0, nil)
+
+	numPruned, err = h.db.PruneUpdates(cutoff)
+	require.NoError(h.t, err)
+	require.Zero(h.t, numPruned)
+
+	// The session without any updates since its creation before the cutoff
+	// is idle, while the other one is still active.
+	numPruned, err = h.db.PruneIdleSessions(cutoff)
+	require.NoError(h.t, err)
+	require.Equal(h.t, 1, numPruned)
+	h.getSession(id0, nil)
+	h.getSession(id1, wtdb.ErrSessionNotFound)
+
+	usages, err = h.db.ListSessionUsage()
+	require.NoError(h.t, err)
+	require.Len(h.t, usages, 1)
+	require.EqualValues(h.t, 1, usages[0].NumUpdates)
+	require.Equal(h.t, updateSize, usages[0].UpdateBytes)
+
+	// Once the remaining session is idle as well, it's pruned along with
+	// its updates.
+	numPruned, err = h.db.PruneIdleSessions(time.Now().Add(time.Hour))
+	require.NoError(h.t, err)
+	require.Equal(h.t, 1, numPruned)
+	h.getSession(id0, wtdb.ErrSessionNotFound)
+	require.Empty(h.t, h.queryMatches(update1.Hint))
+
+	usages, err = h.db.ListSessionUsage()
+	require.NoError(h.t, err)
+	require.Empty(h.t, usages)
+}
+
 type stateUpdateTest struct {
 	session    *wtdb.SessionInfo
 	sessionErr error
@@ -704,6 +910,18 @@ func TestTowerDB(t *testing.T) {
 			name: "lookout tip",
 			run:  testLookoutTip,
 		},
+		{
+			name: "rewards",
+			run:  testRewards,
+		},
+		{
+			name: "session payments",
+			run:  testSessionPayments,
+		},
+		{
+			name: "retention",
+			run:  testRetention,
+		},
 	}
 
 	for _, database := range dbs {
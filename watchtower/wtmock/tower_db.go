@@ -1,8 +1,12 @@
 package wtmock
 
 import (
+	"bytes"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtdb"
@@ -14,6 +18,14 @@ type TowerDB struct {
 	lastEpoch *chainntnfs.BlockEpoch
 	sessions  map[wtdb.SessionID]*wtdb.SessionInfo
 	blobs     map[blob.BreachHint]map[wtdb.SessionID]*wtdb.SessionStateUpdate
+	rewards   map[chainhash.Hash]*wtdb.Reward
+	payments  map[wtdb.SessionID]*wtdb.SessionPayment
+
+	// received holds the time at which each state update was received.
+	received map[blob.BreachHint]map[wtdb.SessionID]time.Time
+
+	// activity holds the time at which each session was last active.
+	activity map[wtdb.SessionID]time.Time
 }
 
 // NewTowerDB initializes a fresh mock TowerDB.
@@ -21,6 +33,10 @@ func NewTowerDB() *TowerDB {
 	return &TowerDB{
 		sessions: make(map[wtdb.SessionID]*wtdb.SessionInfo),
 		blobs:    make(map[blob.BreachHint]map[wtdb.SessionID]*wtdb.SessionStateUpdate),
+		rewards:  make(map[chainhash.Hash]*wtdb.Reward),
+		payments: make(map[wtdb.SessionID]*wtdb.SessionPayment),
+		received: make(map[blob.BreachHint]map[wtdb.SessionID]time.Time),
+		activity: make(map[wtdb.SessionID]time.Time),
 	}
 }
 
@@ -64,6 +80,15 @@ func (db *TowerDB) InsertStateUpdate(update *wtdb.SessionStateUpdate) (uint16, e
 	}
 	sessionsToUpdates[update.ID] = update
 
+	now := time.Now()
+	sessionsToTimes, ok := db.received[update.Hint]
+	if !ok {
+		sessionsToTimes = make(map[wtdb.SessionID]time.Time)
+		db.received[update.Hint] = sessionsToTimes
+	}
+	sessionsToTimes[update.ID] = now
+	db.activity[update.ID] = now
+
 	return info.LastApplied, nil
 }
 
@@ -97,6 +122,7 @@ func (db *TowerDB) InsertSessionInfo(info *wtdb.SessionInfo) error {
 	}
 
 	db.sessions[info.ID] = info
+	db.activity[info.ID] = time.Now()
 
 	return nil
 }
@@ -107,27 +133,136 @@ func (db *TowerDB) DeleteSession(target wtdb.SessionID) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
+	return db.deleteSession(target)
+}
+
+// deleteSession removes all data associated with the target session id. The
+// caller must hold the mutex.
+func (db *TowerDB) deleteSession(target wtdb.SessionID) error {
 	// Fail if the session doesn't exit.
 	if _, ok := db.sessions[target]; !ok {
 		return wtdb.ErrSessionNotFound
 	}
 
-	// Remove the target session.
+	// Remove the target session, along with its payment.
 	delete(db.sessions, target)
+	delete(db.payments, target)
+	delete(db.activity, target)
 
 	// Remove the state updates for any blobs stored under the target
 	// session identifier.
-	for hint, sessionUpdates := range db.blobs {
-		delete(sessionUpdates, target)
+	for hint := range db.blobs {
+		db.deleteUpdate(hint, target)
+	}
+
+	return nil
+}
+
+// deleteUpdate removes the state update stored under the given hint for the
+// target session, if any. The caller must hold the mutex.
+func (db *TowerDB) deleteUpdate(hint blob.BreachHint, target wtdb.SessionID) {
+	sessionUpdates := db.blobs[hint]
+	delete(sessionUpdates, target)
+
+	// If this was the last state update, we can also remove the hint that
+	// would map to an empty set.
+	if len(sessionUpdates) == 0 {
+		delete(db.blobs, hint)
+	}
 
-		// If this was the last state update, we can also remove the
-		// hint that would map to an empty set.
-		if len(sessionUpdates) == 0 {
-			delete(db.blobs, hint)
+	sessionTimes := db.received[hint]
+	delete(sessionTimes, target)
+	if len(sessionTimes) == 0 {
+		delete(db.received, hint)
+	}
+}
+
+// PruneUpdates removes all state updates that were received before the given
+// time, and returns the number of removed updates.
+func (db *TowerDB) PruneUpdates(before time.Time) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var numPruned int
+	for hint, sessionTimes := range db.received {
+		for id, received := range sessionTimes {
+			if !received.Before(before) {
+				continue
+			}
+
+			db.deleteUpdate(hint, id)
+			numPruned++
 		}
 	}
 
-	return nil
+	return numPruned, nil
+}
+
+// PruneIdleSessions removes all data of the sessions that haven't been active
+// since the given time, and returns the number of removed sessions.
+func (db *TowerDB) PruneIdleSessions(before time.Time) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var numPruned int
+	for id := range db.sessions {
+		if !db.activity[id].Before(before) {
+			continue
+		}
+
+		if err := db.deleteSession(id); err != nil {
+			return 0, err
+		}
+		numPruned++
+	}
+
+	return numPruned, nil
+}
+
+// ListSessionUsage returns the storage used by each session in the tower
+// database, ordered by session id.
+func (db *TowerDB) ListSessionUsage() ([]*wtdb.SessionUsage, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	usages := make(map[wtdb.SessionID]*wtdb.SessionUsage)
+	for id, info := range db.sessions {
+		usages[id] = &wtdb.SessionUsage{
+			SessionInfo:  info,
+			LastActivity: db.activity[id],
+		}
+	}
+
+	for _, sessionUpdates := range db.blobs {
+		for id, update := range sessionUpdates {
+			usage, ok := usages[id]
+			if !ok {
+				continue
+			}
+
+			var b bytes.Buffer
+			if err := update.Encode(&b); err != nil {
+				return nil, err
+			}
+
+			usage.NumUpdates++
+			usage.UpdateBytes += uint64(b.Len())
+		}
+	}
+
+	sessionUsages := make([]*wtdb.SessionUsage, 0, len(usages))
+	for _, usage := range usages {
+		sessionUsages = append(sessionUsages, usage)
+	}
+
+	sort.Slice(sessionUsages, func(i, j int) bool {
+		return bytes.Compare(
+			sessionUsages[i].SessionInfo.ID[:],
+			sessionUsages[j].SessionInfo.ID[:],
+		) < 0
+	})
+
+	return sessionUsages, nil
 }
 
 // QueryMatches searches against all known state updates for any that match the
@@ -181,3 +316,112 @@ func (db *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
 
 	return db.lastEpoch, nil
 }
+
+// AddReward records a reward claimed by a published justice transaction,
+// replacing any reward recorded for the same justice transaction.
+func (db *TowerDB) AddReward(reward *wtdb.Reward) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	rewardCopy := *reward
+	db.rewards[reward.JusticeTxID] = &rewardCopy
+
+	return nil
+}
+
+// ListRewards returns all rewards claimed by the tower's justice transactions,
+// ordered by the txid of the justice transaction.
+func (db *TowerDB) ListRewards() ([]*wtdb.Reward, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var rewards []*wtdb.Reward
+	for _, reward := range db.rewards {
+		rewardCopy := *reward
+		rewards = append(rewards, &rewardCopy)
+	}
+
+	sort.Slice(rewards, func(i, j int) bool {
+		return bytes.Compare(
+			rewards[i].JusticeTxID[:], rewards[j].JusticeTxID[:],
+		) < 0
+	})
+
+	return rewards, nil
+}
+
+// PutSessionPayment records the payment that the tower requested for a
+// session, replacing any payment that was recorded for the session before.
+func (db *TowerDB) PutSessionPayment(payment *wtdb.SessionPayment) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	paymentCopy := *payment
+	db.payments[payment.SessionID] = &paymentCopy
+
+	return nil
+}
+
+// GetSessionPayment returns the payment that the tower requested for the given
+// session.
+func (db *TowerDB) GetSessionPayment(
+	id *wtdb.SessionID) (*wtdb.SessionPayment, error) {
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	payment, ok := db.payments[*id]
+	if !ok {
+		return nil, wtdb.ErrSessionPaymentNotFound
+	}
+
+	paymentCopy := *payment
+
+	return &paymentCopy, nil
+}
+
+// ListExpiredSessionPayments returns the payments that the tower requested for
+// sessions that weren't paid, and whose invoices expired at the given time.
+func (db *TowerDB) ListExpiredSessionPayments(
+	now time.Time) ([]*wtdb.SessionPayment, error) {
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var expired []*wtdb.SessionPayment
+	for _, payment := range db.payments {
+		if payment.Expired(now) {
+			paymentCopy := *payment
+			expired = append(expired, &paymentCopy)
+		}
+	}
+
+	return expired, nil
+}
+
+// NumUnpaidSessionPayments returns the number of payments that the tower
+// requested for sessions that weren't paid yet.
+func (db *TowerDB) NumUnpaidSessionPayments() (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var numUnpaid int
+	for _, payment := range db.payments {
+		if !payment.Paid {
+			numUnpaid++
+		}
+	}
+
+	return numUnpaid, nil
+}
+
+// DeleteSessionPayment removes the payment that the tower requested for the
+// given session.
+func (db *TowerDB) DeleteSessionPayment(id *wtdb.SessionID) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	delete(db.payments, *id)
+
+	return nil
+}
@@ -56,6 +56,10 @@ var (
 	// contains a non-zero RewardBase or RewardRate on an altruist policy.
 	ErrAltruistReward = errors.New("altruist policy has reward params")
 
+	// ErrRewardRateTooHigh signals that the policy is invalid because its
+	// RewardRate exceeds the entire balance of the revoked commitment.
+	ErrRewardRateTooHigh = errors.New("reward rate exceeds reward scale")
+
 	// ErrNoMaxUpdates signals that the policy specified zero MaxUpdates.
 	ErrNoMaxUpdates = errors.New("max updates must be positive")
 
@@ -118,9 +122,9 @@ type Policy struct {
 
 // String returns a human-readable description of the current policy.
 func (p Policy) String() string {
-	return fmt.Sprintf("(blob-type=%b max-updates=%d reward-rate=%d "+
-		"sweep-fee-rate=%d)", p.BlobType, p.MaxUpdates, p.RewardRate,
-		p.SweepFeeRate)
+	return fmt.Sprintf("(blob-type=%b max-updates=%d reward-base=%d "+
+		"reward-rate=%d sweep-fee-rate=%d)", p.BlobType, p.MaxUpdates,
+		p.RewardBase, p.RewardRate, p.SweepFeeRate)
 }
 
 // FeatureBits returns the watchtower feature bits required for the given
@@ -138,6 +142,10 @@ func (p *Policy) FeatureBits() []lnwire.FeatureBit {
 		features = append(features, wtwire.AnchorCommitRequired)
 	}
 
+	if t.IsReward() {
+		features = append(features, wtwire.RewardSessionsRequired)
+	}
+
 	return features
 }
 
@@ -163,6 +171,11 @@ func (p *Policy) Validate() error {
 		return ErrAltruistReward
 	}
 
+	// The tower can't be rewarded more than the entire balance.
+	if p.RewardRate > RewardScale {
+		return ErrRewardRateTooHigh
+	}
+
 	// MaxUpdates must be positive.
 	if p.MaxUpdates == 0 {
 		return ErrNoMaxUpdates
@@ -72,6 +72,30 @@ var validationTests = []struct {
 			MaxUpdates: 1,
 		},
 	},
+	{
+		name: "fail reward rate above scale",
+		policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeRewardAnchorCommit,
+				RewardRate:   wtpolicy.RewardScale + 1,
+				SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+			},
+			MaxUpdates: 1,
+		},
+		expErr: wtpolicy.ErrRewardRateTooHigh,
+	},
+	{
+		name: "valid reward policy",
+		policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeRewardTaprootCommit,
+				RewardBase:   1000,
+				RewardRate:   wtpolicy.DefaultRewardRate,
+				SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+			},
+			MaxUpdates: 1,
+		},
+	},
 	{
 		name:   "valid default policy",
 		policy: wtpolicy.DefaultPolicy(),
@@ -65,6 +65,41 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 		)
 	}
 
+	// Reward sessions must pay at least our minimum reward. Otherwise we
+	// return our terms, so the client knows what we expect.
+	if req.BlobType.Has(blob.FlagReward) &&
+		(req.RewardBase < s.cfg.MinRewardBase ||
+			req.RewardRate < s.cfg.MinRewardRate) {
+
+		log.Debugf("Rejecting CreateSession from %s, reward "+
+			"base=%d rate=%d below minimum base=%d rate=%d", id,
+			req.RewardBase, req.RewardRate, s.cfg.MinRewardBase,
+			s.cfg.MinRewardRate)
+
+		terms := &wtwire.RewardTerms{
+			RewardBase: s.cfg.MinRewardBase,
+			RewardRate: s.cfg.MinRewardRate,
+		}
+		data, err := terms.Encode()
+		if err != nil {
+			return err
+		}
+
+		return s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodeRejectRewardRate, 0,
+			data,
+		)
+	}
+
+	// If sessions aren't free, the client must pay for the updates it
+	// requests before we activate the session.
+	if s.cfg.UpdatePrice > 0 {
+		paid, err := s.handleSessionPayment(peer, id, req)
+		if !paid {
+			return err
+		}
+	}
+
 	// Now that we've established that this session does not exist in the
 	// database, retrieve the sweep address that will be given to the
 	// client. This address is to be included by the client when signing
@@ -93,8 +128,6 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 		}
 	}
 
-	// TODO(conner): create invoice for upfront payment
-
 	// Assemble the session info using the agreed upon parameters, reward
 	// address, and session id.
 	info := wtdb.SessionInfo{
@@ -21,6 +21,11 @@ type Interface interface {
 
 	// Stop cleans up the watchtower's current connections and resources.
 	Stop() error
+
+	// PruneSessionPayments removes the payments requested for sessions
+	// that weren't paid before their invoices expired, along with their
+	// invoices, and returns the number of removed payments.
+	PruneSessionPayments(now time.Time) (int, error)
 }
 
 // Peer is the primary interface used to abstract watchtower clients.
@@ -67,4 +72,26 @@ type DB interface {
 	// DeleteSession removes all data associated with a particular session
 	// id from the tower's database.
 	DeleteSession(wtdb.SessionID) error
+
+	// PutSessionPayment records the payment that the tower requested for
+	// a session.
+	PutSessionPayment(*wtdb.SessionPayment) error
+
+	// GetSessionPayment returns the payment that the tower requested for
+	// the given session, or wtdb.ErrSessionPaymentNotFound if the session
+	// isn't paid for.
+	GetSessionPayment(*wtdb.SessionID) (*wtdb.SessionPayment, error)
+
+	// ListExpiredSessionPayments returns the payments that the tower
+	// requested for sessions that weren't paid, and whose invoices expired
+	// at the given time.
+	ListExpiredSessionPayments(time.Time) ([]*wtdb.SessionPayment, error)
+
+	// NumUnpaidSessionPayments returns the number of payments that the
+	// tower requested for sessions that weren't paid yet.
+	NumUnpaidSessionPayments() (int, error)
+
+	// DeleteSessionPayment removes the payment that the tower requested
+	// for the given session.
+	DeleteSessionPayment(*wtdb.SessionID) error
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/watchtower/wtdb"
 	"github.com/lightningnetwork/lnd/watchtower/wtwire"
@@ -25,6 +26,10 @@ var (
 	// ErrServerExiting signals that a request could not be processed
 	// because the server has been requested to shut down.
 	ErrServerExiting = errors.New("server shutting down")
+
+	// ErrNoInvoices signals that the server can't charge for sessions
+	// because it has no way to create invoices.
+	ErrNoInvoices = errors.New("paid sessions require invoices")
 )
 
 // Config abstracts the primary components and dependencies of the server.
@@ -67,6 +72,40 @@ type Config struct {
 	// DisableReward causes the server to reject any session creation
 	// attempts that request rewards.
 	DisableReward bool
+
+	// MinRewardBase is the minimum fixed reward in satoshis that a client
+	// must offer when requesting a reward session.
+	MinRewardBase uint32
+
+	// MinRewardRate is the minimum proportional reward, in millionths of
+	// the swept funds, that a client must offer when requesting a reward
+	// session.
+	MinRewardRate uint32
+
+	// UpdatePrice is the price that a client pays for each update of a
+	// session before the session is activated. If zero, sessions are
+	// free.
+	UpdatePrice lnwire.MilliSatoshi
+
+	// NewInvoice creates an invoice over the given amount that expires
+	// after the given duration, and returns its bolt11 payment request and
+	// payment hash. It must be set if UpdatePrice is non-zero.
+	NewInvoice func(amt lnwire.MilliSatoshi, memo string,
+		expiry time.Duration) (string, lntypes.Hash, error)
+
+	// IsInvoicePaid returns true if the invoice with the given payment
+	// hash has been settled. It must be set if UpdatePrice is non-zero.
+	IsInvoicePaid func(hash lntypes.Hash) (bool, error)
+
+	// DeleteInvoice cancels and removes the unpaid invoice with the given
+	// payment hash. It must be set if UpdatePrice is non-zero.
+	DeleteInvoice func(hash lntypes.Hash) error
+
+	// MaxUnpaidSessions is the maximum number of sessions that the server
+	// handed an invoice to that wasn't paid yet. Clients requesting a new
+	// session beyond that are turned away until unpaid invoices are
+	// pruned. If zero, the number isn't limited.
+	MaxUnpaidSessions int
 }
 
 // Server houses the state required to handle watchtower peers. It's primary job
@@ -83,6 +122,10 @@ type Server struct {
 	clientMtx sync.RWMutex
 	clients   map[wtdb.SessionID]Peer
 
+	// paymentMtx serializes the creation and pruning of session payments,
+	// so that the number of unpaid sessions stays within its limit.
+	paymentMtx sync.Mutex
+
 	newPeers chan Peer
 
 	localInit *wtwire.Init
@@ -95,14 +138,30 @@ type Server struct {
 // clients connecting to the listener addresses, and allows them to open
 // sessions and send state updates.
 func New(cfg *Config) (*Server, error) {
-	localInit := wtwire.NewInitMessage(
-		lnwire.NewRawFeatureVector(
-			wtwire.AltruistSessionsOptional,
-			wtwire.AnchorCommitOptional,
-		),
-		cfg.ChainHash,
+	features := lnwire.NewRawFeatureVector(
+		wtwire.AltruistSessionsOptional,
+		wtwire.AnchorCommitOptional,
 	)
 
+	// Only advertise reward sessions if we accept them.
+	if !cfg.DisableReward {
+		features.Set(wtwire.RewardSessionsOptional)
+	}
+
+	// If sessions aren't free, clients must understand how to pay for
+	// them.
+	if cfg.UpdatePrice > 0 {
+		if cfg.NewInvoice == nil || cfg.IsInvoicePaid == nil ||
+			cfg.DeleteInvoice == nil {
+
+			return nil, ErrNoInvoices
+		}
+
+		features.Set(wtwire.PaidSessionsRequired)
+	}
+
+	localInit := wtwire.NewInitMessage(features, cfg.ChainHash)
+
 	s := &Server{
 		cfg:       cfg,
 		clients:   make(map[wtdb.SessionID]Peer),
@@ -2,7 +2,9 @@ package wtserver_test
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtdb"
@@ -356,6 +359,349 @@ func testServerCreateSession(t *testing.T, i int, test createSessionTestCase) {
 	assertConnClosed(t, peer, 2*timeoutDuration)
 }
 
+// TestServerRewardSessions asserts that a tower that accepts reward sessions
+// advertises them, and rejects sessions that offer less than its minimum
+// reward terms.
+func TestServerRewardSessions(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 500 * time.Millisecond
+
+	s, err := wtserver.New(&wtserver.Config{
+		DB:           wtmock.NewTowerDB(),
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+		NewAddress: func() (btcutil.Address, error) {
+			return addr, nil
+		},
+		ChainHash:     testnetChainHash,
+		MinRewardBase: 1000,
+		MinRewardRate: 10000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+
+	initMsg := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(wtwire.RewardSessionsRequired),
+		testnetChainHash,
+	)
+	createMsg := &wtwire.CreateSession{
+		BlobType:     blob.TypeRewardAnchorCommit,
+		MaxUpdates:   1000,
+		RewardBase:   1000,
+		RewardRate:   5000,
+		SweepFeeRate: 10000,
+	}
+
+	// The tower advertises reward sessions in its Init message.
+	localPub := randPubKey(t)
+	peer := wtmock.NewMockPeer(localPub, randPubKey(t), nil, 0)
+	s.InboundPeerConnected(peer)
+	sendMsg(t, initMsg, peer, timeout)
+	remoteInit := recvReply(t, "MsgInit", peer, timeout).(*wtwire.Init)
+	require.True(t, remoteInit.ConnFeatures.IsSet(
+		wtwire.RewardSessionsOptional,
+	))
+
+	// A reward rate below the tower's minimum is rejected, and the tower
+	// returns its terms.
+	sendMsg(t, createMsg, peer, timeout)
+	reply := recvReply(
+		t, "MsgCreateSessionReply", peer, timeout,
+	).(*wtwire.CreateSessionReply)
+	require.Equal(t, wtwire.CreateSessionCodeRejectRewardRate, reply.Code)
+
+	terms, err := wtwire.DecodeRewardTerms(reply.Data)
+	require.NoError(t, err)
+	require.Equal(t, &wtwire.RewardTerms{
+		RewardBase: 1000,
+		RewardRate: 10000,
+	}, terms)
+	assertConnClosed(t, peer, 2*timeout)
+
+	// Once the client offers the tower's terms, the session is accepted
+	// and the tower returns its reward script.
+	createMsg.RewardRate = terms.RewardRate
+	peer = wtmock.NewMockPeer(localPub, randPubKey(t), nil, 0)
+	connect(t, s, peer, initMsg, timeout)
+	sendMsg(t, createMsg, peer, timeout)
+	reply = recvReply(
+		t, "MsgCreateSessionReply", peer, timeout,
+	).(*wtwire.CreateSessionReply)
+	require.Equal(t, wtwire.CodeOK, reply.Code)
+	require.Equal(t, addrScript, reply.Data)
+}
+
+// mockInvoices hands out the invoices of a tower that charges for its
+// sessions.
+type mockInvoices struct {
+	mu       sync.Mutex
+	nextID   byte
+	invoices map[lntypes.Hash]lnwire.MilliSatoshi
+	paid     map[lntypes.Hash]bool
+}
+
+func newMockInvoices() *mockInvoices {
+	return &mockInvoices{
+		invoices: make(map[lntypes.Hash]lnwire.MilliSatoshi),
+		paid:     make(map[lntypes.Hash]bool),
+	}
+}
+
+func (m *mockInvoices) newInvoice(amt lnwire.MilliSatoshi, _ string,
+	_ time.Duration) (string, lntypes.Hash, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var hash lntypes.Hash
+	hash[0] = m.nextID
+	m.nextID++
+	m.invoices[hash] = amt
+
+	return hash.String(), hash, nil
+}
+
+func (m *mockInvoices) isPaid(hash lntypes.Hash) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.paid[hash], nil
+}
+
+func (m *mockInvoices) deleteInvoice(hash lntypes.Hash) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.paid[hash] {
+		return fmt.Errorf("invoice %v already settled", hash)
+	}
+	delete(m.invoices, hash)
+
+	return nil
+}
+
+func (m *mockInvoices) pay(hash lntypes.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.paid[hash] = true
+}
+
+func (m *mockInvoices) amount(hash lntypes.Hash) (lnwire.MilliSatoshi, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	amt, ok := m.invoices[hash]
+
+	return amt, ok
+}
+
+// paidSessionHarness runs a tower that charges for its sessions.
+type paidSessionHarness struct {
+	t        *testing.T
+	server   *wtserver.Server
+	db       *wtmock.TowerDB
+	invoices *mockInvoices
+	timeout  time.Duration
+}
+
+func newPaidSessionHarness(t *testing.T,
+	maxUnpaidSessions int) *paidSessionHarness {
+
+	const timeout = 500 * time.Millisecond
+
+	db := wtmock.NewTowerDB()
+	invoices := newMockInvoices()
+	s, err := wtserver.New(&wtserver.Config{
+		DB:           db,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+		NewAddress: func() (btcutil.Address, error) {
+			return addr, nil
+		},
+		ChainHash:         testnetChainHash,
+		UpdatePrice:       10,
+		NewInvoice:        invoices.newInvoice,
+		IsInvoicePaid:     invoices.isPaid,
+		DeleteInvoice:     invoices.deleteInvoice,
+		MaxUnpaidSessions: maxUnpaidSessions,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+
+	return &paidSessionHarness{
+		t:        t,
+		server:   s,
+		db:       db,
+		invoices: invoices,
+		timeout:  timeout,
+	}
+}
+
+// createSession requests a session with the given number of updates from the
+// peer with the given key, and returns the tower's reply.
+func (h *paidSessionHarness) createSession(peerPub *btcec.PublicKey,
+	maxUpdates uint16) *wtwire.CreateSessionReply {
+
+	initMsg := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(wtwire.PaidSessionsOptional),
+		testnetChainHash,
+	)
+	createMsg := &wtwire.CreateSession{
+		BlobType:     blob.TypeAltruistCommit,
+		MaxUpdates:   maxUpdates,
+		SweepFeeRate: 10000,
+	}
+
+	peer := wtmock.NewMockPeer(randPubKey(h.t), peerPub, nil, 0)
+	h.server.InboundPeerConnected(peer)
+	sendMsg(h.t, initMsg, peer, h.timeout)
+	remoteInit := recvReply(
+		h.t, "MsgInit", peer, h.timeout,
+	).(*wtwire.Init)
+	require.True(h.t, remoteInit.ConnFeatures.IsSet(
+		wtwire.PaidSessionsRequired,
+	))
+
+	sendMsg(h.t, createMsg, peer, h.timeout)
+	reply := recvReply(
+		h.t, "MsgCreateSessionReply", peer, h.timeout,
+	).(*wtwire.CreateSessionReply)
+	assertConnClosed(h.t, peer, 2*h.timeout)
+
+	return reply
+}
+
+// requirePaymentRequired asserts that the tower requested a payment in the
+// given reply, and returns the payment hash of its invoice.
+func (h *paidSessionHarness) requirePaymentRequired(
+	reply *wtwire.CreateSessionReply) lntypes.Hash {
+
+	require.Equal(h.t, wtwire.CreateSessionCodePaymentRequired, reply.Code)
+
+	hash, err := lntypes.MakeHashFromStr(string(reply.Data))
+	require.NoError(h.t, err)
+
+	return hash
+}
+
+// TestServerPaidSessions asserts that a tower that charges for its sessions
+// only activates a session once the client paid the invoice for it, and
+// doesn't activate it for more updates than were paid for.
+func TestServerPaidSessions(t *testing.T) {
+	t.Parallel()
+
+	h := newPaidSessionHarness(t, 0)
+	peerPub := randPubKey(t)
+
+	// The tower hands out an invoice for the requested updates instead
+	// of activating the session.
+	hash := h.requirePaymentRequired(h.createSession(peerPub, 1000))
+
+	amt, ok := h.invoices.amount(hash)
+	require.True(t, ok)
+	require.Equal(t, lnwire.MilliSatoshi(10000), amt)
+
+	id := wtdb.NewSessionIDFromPubKey(peerPub)
+	_, err := h.db.GetSessionInfo(&id)
+	require.ErrorIs(t, err, wtdb.ErrSessionNotFound)
+
+	// As long as the invoice isn't paid, the same invoice is returned.
+	reply := h.createSession(peerPub, 1000)
+	require.Equal(t, hash, h.requirePaymentRequired(reply))
+
+	// Once the invoice is paid, the session is activated and the payment
+	// is recorded.
+	h.invoices.pay(hash)
+
+	reply = h.createSession(peerPub, 1000)
+	require.Equal(t, wtwire.CodeOK, reply.Code)
+
+	payment, err := h.db.GetSessionPayment(&id)
+	require.NoError(t, err)
+	require.True(t, payment.Paid)
+	require.EqualValues(t, 1000, payment.MaxUpdates)
+
+	// The session may be recommitted with fewer updates than were paid
+	// for, but not with more.
+	reply = h.createSession(peerPub, 1001)
+	require.Equal(t, wtwire.CreateSessionCodeRejectMaxUpdates, reply.Code)
+
+	reply = h.createSession(peerPub, 500)
+	require.Equal(t, wtwire.CodeOK, reply.Code)
+}
+
+// TestServerUnpaidSessions asserts that a tower limits the number of sessions
+// with unpaid invoices, removes the invoices it replaced, and prunes expired
+// unpaid invoices.
+func TestServerUnpaidSessions(t *testing.T) {
+	t.Parallel()
+
+	const maxUnpaidSessions = 2
+
+	h := newPaidSessionHarness(t, maxUnpaidSessions)
+	peer1, peer2, peer3 := randPubKey(t), randPubKey(t), randPubKey(t)
+
+	// The first two clients get an invoice, while the third one is turned
+	// away as the limit of unpaid sessions is reached.
+	hash1 := h.requirePaymentRequired(h.createSession(peer1, 100))
+	hash2 := h.requirePaymentRequired(h.createSession(peer2, 100))
+
+	reply := h.createSession(peer3, 100)
+	require.Equal(t, wtwire.CodeTemporaryFailure, reply.Code)
+
+	// A client that changes the number of requested updates gets a fresh
+	// invoice, which doesn't count against the limit, and the replaced
+	// invoice is removed.
+	newHash2 := h.requirePaymentRequired(h.createSession(peer2, 200))
+	require.NotEqual(t, hash2, newHash2)
+
+	_, ok := h.invoices.amount(hash2)
+	require.False(t, ok)
+
+	// Nothing is pruned before the invoices expire.
+	numPruned, err := h.server.PruneSessionPayments(time.Now())
+	require.NoError(t, err)
+	require.Zero(t, numPruned)
+
+	// The first client pays, but doesn't come back before its invoice
+	// expires. Its payment is kept, while the second client's payment and
+	// invoice are removed.
+	h.invoices.pay(hash1)
+
+	numPruned, err = h.server.PruneSessionPayments(
+		time.Now().Add(2 * time.Hour),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, numPruned)
+
+	id1 := wtdb.NewSessionIDFromPubKey(peer1)
+	payment, err := h.db.GetSessionPayment(&id1)
+	require.NoError(t, err)
+	require.True(t, payment.Paid)
+
+	id2 := wtdb.NewSessionIDFromPubKey(peer2)
+	_, err = h.db.GetSessionPayment(&id2)
+	require.ErrorIs(t, err, wtdb.ErrSessionPaymentNotFound)
+
+	_, ok = h.invoices.amount(newHash2)
+	require.False(t, ok)
+
+	// With the unpaid invoices pruned, the third client gets an invoice,
+	// and the first one its session.
+	h.requirePaymentRequired(h.createSession(peer3, 100))
+
+	reply = h.createSession(peer1, 100)
+	require.Equal(t, wtwire.CodeOK, reply.Code)
+}
+
 type stateUpdateTestCase struct {
 	name      string
 	initMsg   *wtwire.Init
@@ -0,0 +1,235 @@
+package wtserver
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/watchtower/wtdb"
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
+)
+
+// sessionInvoiceExpiry is the time after which an unpaid session invoice
+// expires. A client that requests the session after that receives a fresh
+// invoice.
+const sessionInvoiceExpiry = time.Hour
+
+// errTooManyUnpaidSessions signals that the server already handed out the
+// maximum number of invoices that weren't paid yet.
+var errTooManyUnpaidSessions = errors.New("too many unpaid sessions")
+
+// handleSessionPayment makes sure that the client paid for the updates it
+// requests before its session is activated. If the session is paid for, true
+// is returned. Otherwise the client is sent the invoice it must pay, and the
+// resulting connection failure is returned.
+func (s *Server) handleSessionPayment(peer Peer, id *wtdb.SessionID,
+	req *wtwire.CreateSession) (bool, error) {
+
+	now := time.Now()
+	payment, err := s.cfg.DB.GetSessionPayment(id)
+	switch {
+
+	// The client already paid for its session. It may use fewer updates
+	// than it paid for, but not more.
+	case err == nil && payment.Paid:
+		if req.MaxUpdates > payment.MaxUpdates {
+			log.Debugf("Rejecting CreateSession from %s, paid for "+
+				"%d updates, requested %d", id,
+				payment.MaxUpdates, req.MaxUpdates)
+
+			code := wtwire.CreateSessionCodeRejectMaxUpdates
+
+			return false, s.replyCreateSession(
+				peer, id, code, 0, nil,
+			)
+		}
+
+		return true, nil
+
+	// We already handed the client an invoice for the requested updates
+	// that can still be paid, so we check whether it was paid.
+	case err == nil && payment.MaxUpdates == req.MaxUpdates &&
+		!payment.Expired(now):
+
+	// The client didn't request this session before, changed the number
+	// of updates it requests or let its invoice expire, so we'll hand it
+	// a fresh invoice.
+	case err == nil || errors.Is(err, wtdb.ErrSessionPaymentNotFound):
+		payment, err = s.newSessionPayment(
+			id, req.MaxUpdates, payment, now,
+		)
+		if errors.Is(err, errTooManyUnpaidSessions) {
+			log.Debugf("Rejecting CreateSession from %s, too many "+
+				"unpaid sessions", id)
+
+			return false, s.replyCreateSession(
+				peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+			)
+		}
+		if err != nil {
+			log.Errorf("Unable to create invoice for %s: %v", id,
+				err)
+
+			return false, s.replyCreateSession(
+				peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+			)
+		}
+
+	default:
+		log.Errorf("Unable to load session payment for %s: %v", id,
+			err)
+
+		return false, s.replyCreateSession(
+			peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+		)
+	}
+
+	paid, err := s.cfg.IsInvoicePaid(payment.PaymentHash)
+	if err != nil {
+		log.Errorf("Unable to look up invoice %v for %s: %v",
+			payment.PaymentHash, id, err)
+
+		return false, s.replyCreateSession(
+			peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+		)
+	}
+
+	if !paid {
+		log.Debugf("Requesting payment of %v for %d updates from %s",
+			payment.Amount, payment.MaxUpdates, id)
+
+		return false, s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodePaymentRequired, 0,
+			[]byte(payment.PaymentRequest),
+		)
+	}
+
+	// Record that the payment was received, so that the quota it bought
+	// is known even if the invoice is removed later on.
+	payment.Paid = true
+	if err := s.cfg.DB.PutSessionPayment(payment); err != nil {
+		log.Errorf("Unable to record payment for %s: %v", id, err)
+
+		return false, s.replyCreateSession(
+			peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+		)
+	}
+
+	log.Infof("Received payment of %v for %d updates from %s",
+		payment.Amount, payment.MaxUpdates, id)
+
+	return true, nil
+}
+
+// newSessionPayment creates an invoice for the given number of updates of a
+// session, and records it as the payment the client must make. If the client
+// was handed an unpaid invoice before, that invoice is replaced. Otherwise
+// errTooManyUnpaidSessions is returned if the number of unpaid sessions
+// already reached its limit.
+func (s *Server) newSessionPayment(id *wtdb.SessionID, maxUpdates uint16,
+	oldPayment *wtdb.SessionPayment, now time.Time) (*wtdb.SessionPayment,
+	error) {
+
+	s.paymentMtx.Lock()
+	defer s.paymentMtx.Unlock()
+
+	// Replacing an unpaid invoice doesn't add an unpaid session, so only
+	// new sessions count against the limit.
+	if oldPayment == nil && s.cfg.MaxUnpaidSessions > 0 {
+		numUnpaid, err := s.cfg.DB.NumUnpaidSessionPayments()
+		if err != nil {
+			return nil, err
+		}
+
+		if numUnpaid >= s.cfg.MaxUnpaidSessions {
+			return nil, errTooManyUnpaidSessions
+		}
+	}
+
+	amt := s.cfg.UpdatePrice * lnwire.MilliSatoshi(maxUpdates)
+	memo := fmt.Sprintf("watchtower session with %d updates", maxUpdates)
+
+	payReq, hash, err := s.cfg.NewInvoice(amt, memo, sessionInvoiceExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	// The invoice is returned in the reply, so it must fit.
+	if len(payReq) > wtwire.MaxCreateSessionReplyDataLength {
+		return nil, fmt.Errorf("invoice of %d bytes exceeds maximum "+
+			"reply size", len(payReq))
+	}
+
+	payment := &wtdb.SessionPayment{
+		SessionID:      *id,
+		PaymentHash:    hash,
+		PaymentRequest: payReq,
+		Amount:         amt,
+		MaxUpdates:     maxUpdates,
+		Expiry:         now.Add(sessionInvoiceExpiry),
+	}
+	if err := s.cfg.DB.PutSessionPayment(payment); err != nil {
+		return nil, err
+	}
+
+	// The replaced invoice is of no use anymore, so it's removed rather
+	// than left for the client to pay.
+	if oldPayment != nil {
+		err := s.cfg.DeleteInvoice(oldPayment.PaymentHash)
+		if err != nil {
+			log.Warnf("Unable to delete replaced invoice %v for "+
+				"%s: %v", oldPayment.PaymentHash, id, err)
+		}
+	}
+
+	return payment, nil
+}
+
+// PruneSessionPayments removes the payments that the server requested for
+// sessions that weren't paid before their invoices expired, along with their
+// invoices. Payments whose invoices turn out to be settled are recorded as
+// paid instead. The number of removed payments is returned.
+func (s *Server) PruneSessionPayments(now time.Time) (int, error) {
+	s.paymentMtx.Lock()
+	defer s.paymentMtx.Unlock()
+
+	expired, err := s.cfg.DB.ListExpiredSessionPayments(now)
+	if err != nil {
+		return 0, err
+	}
+
+	var numPruned int
+	for _, payment := range expired {
+		paid, err := s.cfg.IsInvoicePaid(payment.PaymentHash)
+		if err != nil {
+			return numPruned, err
+		}
+
+		// The client paid, but didn't come back to activate its
+		// session yet, so we keep the quota it bought.
+		if paid {
+			payment.Paid = true
+			err := s.cfg.DB.PutSessionPayment(payment)
+			if err != nil {
+				return numPruned, err
+			}
+
+			continue
+		}
+
+		err = s.cfg.DeleteInvoice(payment.PaymentHash)
+		if err != nil {
+			return numPruned, err
+		}
+
+		err = s.cfg.DB.DeleteSessionPayment(&payment.SessionID)
+		if err != nil {
+			return numPruned, err
+		}
+
+		numPruned++
+	}
+
+	return numPruned, nil
+}
@@ -1,6 +1,9 @@
 package wtwire
 
-import "io"
+import (
+	"bytes"
+	"io"
+)
 
 // CreateSessionCode is an error code returned by a watchtower in response to a
 // CreateSession message. The code directs the client in interpreting the payload
@@ -29,6 +32,12 @@ const (
 	// CreateSessionCodeRejectBlobType is returned when the tower does not
 	// support the proposed blob type.
 	CreateSessionCodeRejectBlobType CreateSessionCode = 64
+
+	// CreateSessionCodePaymentRequired is returned when the tower requires
+	// the session to be paid for before activating it. The response
+	// includes the bolt11 invoice that the client must pay, after which
+	// the client requests the session again.
+	CreateSessionCodePaymentRequired CreateSessionCode = 65
 )
 
 // MaxCreateSessionReplyDataLength is the maximum size of the Data payload
@@ -36,6 +45,45 @@ const (
 // the Data field, which is a varint up to 3 bytes in size.
 const MaxCreateSessionReplyDataLength = 1024
 
+// RewardTerms are the minimum reward terms that a tower accepts for reward
+// sessions. A tower returns its terms in the Data of a CreateSessionReply with
+// code CreateSessionCodeRejectRewardRate, so that the client can learn why its
+// proposal was rejected.
+type RewardTerms struct {
+	// RewardBase is the minimum fixed amount in satoshis that the tower
+	// takes from each justice transaction.
+	RewardBase uint32
+
+	// RewardRate is the minimum fraction of the swept funds that the tower
+	// takes from each justice transaction, expressed in millionths.
+	RewardRate uint32
+}
+
+// Encode serializes the reward terms so that they can be returned in the Data
+// of a CreateSessionReply.
+func (t *RewardTerms) Encode() ([]byte, error) {
+	var b bytes.Buffer
+	if err := WriteElements(&b, t.RewardBase, t.RewardRate); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeRewardTerms deserializes the reward terms returned in the Data of a
+// CreateSessionReply.
+func DecodeRewardTerms(data []byte) (*RewardTerms, error) {
+	var terms RewardTerms
+	err := ReadElements(
+		bytes.NewReader(data), &terms.RewardBase, &terms.RewardRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &terms, nil
+}
+
 // CreateSessionReply is a message sent from watchtower to client in response to a
 // CreateSession message, and signals either an acceptance or rejection of the
 // proposed session parameters.
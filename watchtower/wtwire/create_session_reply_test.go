@@ -0,0 +1,42 @@
+package wtwire_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/watchtower/wtwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRewardTerms asserts that the reward terms of a tower survive a round
+// trip through the Data of a CreateSessionReply.
+func TestRewardTerms(t *testing.T) {
+	terms := &wtwire.RewardTerms{
+		RewardBase: 1000,
+		RewardRate: 20000,
+	}
+
+	data, err := terms.Encode()
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	_, err = wtwire.WriteMessage(&b, &wtwire.CreateSessionReply{
+		Code: wtwire.CreateSessionCodeRejectRewardRate,
+		Data: data,
+	}, 0)
+	require.NoError(t, err)
+
+	msg, err := wtwire.ReadMessage(&b, 0)
+	require.NoError(t, err)
+
+	reply, ok := msg.(*wtwire.CreateSessionReply)
+	require.True(t, ok)
+
+	decoded, err := wtwire.DecodeRewardTerms(reply.Data)
+	require.NoError(t, err)
+	require.Equal(t, terms, decoded)
+
+	// Truncated terms can't be decoded.
+	_, err = wtwire.DecodeRewardTerms(data[:5])
+	require.Error(t, err)
+}
@@ -11,6 +11,10 @@ var FeatureNames = map[lnwire.FeatureBit]string{
 	AnchorCommitOptional:     "anchor-commit",
 	TaprootCommitRequired:    "taproot-commit",
 	TaprootCommitOptional:    "taproot-commit",
+	RewardSessionsRequired:   "reward-sessions",
+	RewardSessionsOptional:   "reward-sessions",
+	PaidSessionsRequired:     "paid-sessions",
+	PaidSessionsOptional:     "paid-sessions",
 }
 
 const (
@@ -41,4 +45,24 @@ const (
 	// TaprootCommitOptional specifies that the advertising tower allows the
 	// remote party to negotiate sessions for protecting taproot channels.
 	TaprootCommitOptional lnwire.FeatureBit = 5
+
+	// RewardSessionsRequired specifies that the advertising node requires
+	// the remote party to negotiate sessions that pay the tower a reward
+	// from the justice transaction.
+	RewardSessionsRequired lnwire.FeatureBit = 6
+
+	// RewardSessionsOptional specifies that the advertising tower allows
+	// the remote party to negotiate sessions that pay the tower a reward
+	// from the justice transaction.
+	RewardSessionsOptional lnwire.FeatureBit = 7
+
+	// PaidSessionsRequired specifies that the advertising tower requires
+	// the remote party to pay an invoice for each session before the
+	// session is activated.
+	PaidSessionsRequired lnwire.FeatureBit = 8
+
+	// PaidSessionsOptional specifies that the advertising client is
+	// willing to pay an invoice for each session before the session is
+	// activated.
+	PaidSessionsOptional lnwire.FeatureBit = 9
 )
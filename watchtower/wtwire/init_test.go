@@ -59,10 +59,10 @@ var checkRemoteInitTests = []checkRemoteInitTest{
 		name:      "same chain, remote-unknown-required",
 		lFeatures: lnwire.NewRawFeatureVector(wtwire.AltruistSessionsOptional),
 		lHash:     testnetChainHash,
-		rFeatures: lnwire.NewRawFeatureVector(lnwire.GossipQueriesRequired),
+		rFeatures: lnwire.NewRawFeatureVector(lnwire.StaticRemoteKeyRequired),
 		rHash:     testnetChainHash,
 		expErr: feature.NewErrUnknownRequired(
-			[]lnwire.FeatureBit{lnwire.GossipQueriesRequired},
+			[]lnwire.FeatureBit{lnwire.StaticRemoteKeyRequired},
 		),
 	},
 }
@@ -0,0 +1,280 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// EventType identifies the kind of event that a webhook is sent for.
+type EventType string
+
+const (
+	// EventInvoiceAdded is sent when an invoice is added.
+	EventInvoiceAdded EventType = "invoice_added"
+
+	// EventInvoiceAccepted is sent when the htlcs of a hold invoice are
+	// accepted.
+	EventInvoiceAccepted EventType = "invoice_accepted"
+
+	// EventInvoiceSettled is sent when an invoice is settled.
+	EventInvoiceSettled EventType = "invoice_settled"
+
+	// EventInvoiceCanceled is sent when an invoice is canceled.
+	EventInvoiceCanceled EventType = "invoice_canceled"
+
+	// EventPaymentSucceeded is sent when an outgoing payment succeeds.
+	EventPaymentSucceeded EventType = "payment_succeeded"
+
+	// EventPaymentFailed is sent when an outgoing payment fails.
+	EventPaymentFailed EventType = "payment_failed"
+
+	// EventChannelOpened is sent when a channel is open.
+	EventChannelOpened EventType = "channel_opened"
+
+	// EventChannelClosed is sent when a channel is closed.
+	EventChannelClosed EventType = "channel_closed"
+)
+
+// Event is the JSON payload that is posted to the webhook URLs.
+type Event struct {
+	// ID uniquely identifies the event. It is derived from the event
+	// itself, so that the same event always has the same ID, and is sent
+	// as the idempotency key of the request.
+	ID string `json:"id"`
+
+	// Type is the kind of event.
+	Type EventType `json:"type"`
+
+	// Timestamp is the unix time at which the event was created.
+	Timestamp int64 `json:"timestamp"`
+
+	// Data describes the invoice, payment or channel that the event is
+	// about.
+	Data interface{} `json:"data"`
+}
+
+// InvoiceData is the data of invoice events.
+type InvoiceData struct {
+	PaymentHash    string `json:"payment_hash,omitempty"`
+	PaymentRequest string `json:"payment_request,omitempty"`
+	Memo           string `json:"memo,omitempty"`
+	ValueMsat      uint64 `json:"value_msat"`
+	AmtPaidMsat    uint64 `json:"amt_paid_msat"`
+	State          string `json:"state"`
+	AddIndex       uint64 `json:"add_index"`
+	SettleIndex    uint64 `json:"settle_index,omitempty"`
+	CreationDate   int64  `json:"creation_date"`
+	SettleDate     int64  `json:"settle_date,omitempty"`
+	IsKeysend      bool   `json:"is_keysend,omitempty"`
+	IsAmp          bool   `json:"is_amp,omitempty"`
+}
+
+// PaymentData is the data of payment events.
+type PaymentData struct {
+	PaymentHash    string `json:"payment_hash"`
+	PaymentRequest string `json:"payment_request,omitempty"`
+	ValueMsat      uint64 `json:"value_msat"`
+	FeeMsat        uint64 `json:"fee_msat"`
+	Status         string `json:"status"`
+	FailureReason  string `json:"failure_reason,omitempty"`
+	PaymentIndex   uint64 `json:"payment_index"`
+	CreationDate   int64  `json:"creation_date"`
+}
+
+// ChannelData is the data of channel events.
+type ChannelData struct {
+	ChannelPoint      string `json:"channel_point"`
+	RemotePubkey      string `json:"remote_pubkey"`
+	CapacitySat       int64  `json:"capacity_sat"`
+	CloseType         string `json:"close_type,omitempty"`
+	SettledBalanceSat int64  `json:"settled_balance_sat,omitempty"`
+	CloseHeight       uint32 `json:"close_height,omitempty"`
+}
+
+// newEvent returns an event of the given type. The key identifies the
+// occurrence of the event, and is hashed together with the type into the ID
+// of the event.
+func newEvent(eventType EventType, key string, data interface{},
+	now time.Time) *Event {
+
+	id := sha256.Sum256([]byte(fmt.Sprintf("%v:%v", eventType, key)))
+
+	return &Event{
+		ID:        hex.EncodeToString(id[:16]),
+		Type:      eventType,
+		Timestamp: now.Unix(),
+		Data:      data,
+	}
+}
+
+// encode returns the JSON encoding of the event.
+func (e *Event) encode() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// newInvoiceEvent returns an event of the given type for an invoice.
+func newInvoiceEvent(eventType EventType, invoice *invoices.Invoice,
+	params *chaincfg.Params, now time.Time) *Event {
+
+	data := &InvoiceData{
+		PaymentHash:    invoiceHash(invoice, params),
+		PaymentRequest: string(invoice.PaymentRequest),
+		Memo:           string(invoice.Memo),
+		ValueMsat:      uint64(invoice.Terms.Value),
+		AmtPaidMsat:    uint64(invoice.AmtPaid),
+		State:          invoice.State.String(),
+		AddIndex:       invoice.AddIndex,
+		SettleIndex:    invoice.SettleIndex,
+		CreationDate:   invoice.CreationDate.Unix(),
+		IsKeysend:      invoice.IsKeysend(),
+		IsAmp:          invoice.IsAMP(),
+	}
+	if !invoice.SettleDate.IsZero() {
+		data.SettleDate = invoice.SettleDate.Unix()
+	}
+
+	// Invoices are identified by their add index. Accepted and canceled
+	// events can only happen once per invoice, while AMP invoices can be
+	// settled several times, so settle events include the settle index.
+	key := fmt.Sprintf("%d", invoice.AddIndex)
+	if eventType == EventInvoiceSettled {
+		key = fmt.Sprintf(
+			"%d:%d", invoice.AddIndex, invoice.SettleIndex,
+		)
+	}
+
+	return newEvent(eventType, key, data, now)
+}
+
+// invoiceHash returns the hex encoded payment hash of an invoice. The hash is
+// taken from the payment request if there is one, and derived from the
+// preimage otherwise. It is empty for AMP invoices without a payment request.
+func invoiceHash(invoice *invoices.Invoice, params *chaincfg.Params) string {
+	if len(invoice.PaymentRequest) > 0 {
+		payReq, err := zpay32.Decode(
+			string(invoice.PaymentRequest), params,
+		)
+		if err == nil && payReq.PaymentHash != nil {
+			return hex.EncodeToString(payReq.PaymentHash[:])
+		}
+	}
+
+	if invoice.Terms.PaymentPreimage != nil {
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		return hex.EncodeToString(hash[:])
+	}
+
+	return ""
+}
+
+// newPaymentEvent returns an event for a payment that reached a final state,
+// or nil if the payment is still in flight.
+func newPaymentEvent(payment *channeldb.MPPayment, now time.Time) *Event {
+	var eventType EventType
+	switch payment.Status {
+	case channeldb.StatusSucceeded:
+		eventType = EventPaymentSucceeded
+
+	case channeldb.StatusFailed:
+		eventType = EventPaymentFailed
+
+	default:
+		return nil
+	}
+
+	var fee uint64
+	for _, htlc := range payment.HTLCs {
+		if htlc.Settle != nil {
+			fee += uint64(htlc.Route.TotalFees())
+		}
+	}
+
+	hash := payment.Info.PaymentIdentifier
+	data := &PaymentData{
+		PaymentHash:    hex.EncodeToString(hash[:]),
+		PaymentRequest: string(payment.Info.PaymentRequest),
+		ValueMsat:      uint64(payment.Info.Value),
+		FeeMsat:        fee,
+		Status:         payment.Status.String(),
+		PaymentIndex:   payment.SequenceNum,
+		CreationDate:   payment.Info.CreationTime.Unix(),
+	}
+	if payment.FailureReason != nil {
+		data.FailureReason = payment.FailureReason.String()
+	}
+
+	key := fmt.Sprintf("%x:%d", hash[:], payment.SequenceNum)
+
+	return newEvent(eventType, key, data, now)
+}
+
+// newChannelOpenedEvent returns an event for a channel that is open.
+func newChannelOpenedEvent(channel *channeldb.OpenChannel,
+	now time.Time) *Event {
+
+	chanPoint := channel.FundingOutpoint.String()
+	data := &ChannelData{
+		ChannelPoint: chanPoint,
+		RemotePubkey: hex.EncodeToString(
+			channel.IdentityPub.SerializeCompressed(),
+		),
+		CapacitySat: int64(channel.Capacity),
+	}
+
+	return newEvent(EventChannelOpened, chanPoint, data, now)
+}
+
+// newChannelClosedEvent returns an event for a channel that is closed.
+func newChannelClosedEvent(summary *channeldb.ChannelCloseSummary,
+	now time.Time) *Event {
+
+	chanPoint := summary.ChanPoint.String()
+	data := &ChannelData{
+		ChannelPoint:      chanPoint,
+		CapacitySat:       int64(summary.Capacity),
+		CloseType:         closeTypeString(summary.CloseType),
+		SettledBalanceSat: int64(summary.SettledBalance),
+		CloseHeight:       summary.CloseHeight,
+	}
+	if summary.RemotePub != nil {
+		data.RemotePubkey = hex.EncodeToString(
+			summary.RemotePub.SerializeCompressed(),
+		)
+	}
+
+	return newEvent(EventChannelClosed, chanPoint, data, now)
+}
+
+// closeTypeString returns the name of a closure type.
+func closeTypeString(closeType channeldb.ClosureType) string {
+	switch closeType {
+	case channeldb.CooperativeClose:
+		return "cooperative"
+
+	case channeldb.LocalForceClose:
+		return "local_force"
+
+	case channeldb.RemoteForceClose:
+		return "remote_force"
+
+	case channeldb.BreachClose:
+		return "breach"
+
+	case channeldb.FundingCanceled:
+		return "funding_canceled"
+
+	case channeldb.Abandoned:
+		return "abandoned"
+
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,883 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a single delivery attempt.
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultRetryDelay is the default delay after the first failed
+	// delivery attempt. The delay doubles with every further failed
+	// attempt.
+	DefaultRetryDelay = 5 * time.Second
+
+	// DefaultMaxRetryDelay is the default maximum delay between two
+	// delivery attempts.
+	DefaultMaxRetryDelay = time.Hour
+
+	// DefaultMaxAttempts is the default number of failed attempts after
+	// which a delivery is dropped. With the default delays, deliveries are
+	// retried for about two days.
+	DefaultMaxAttempts = 50
+
+	// deliveryBatchSize is the maximum number of deliveries that are read
+	// from the outbox at once.
+	deliveryBatchSize = 100
+
+	// paymentBatchSize is the maximum number of payments that are read
+	// at once when the payments are replayed.
+	paymentBatchSize = 1000
+
+	// invoiceBatchSize is the maximum number of invoices that are read at
+	// once when the invoices are replayed.
+	invoiceBatchSize = 1000
+
+	// maxResponseSize is the maximum number of bytes of a response body
+	// that are read before the connection is reused.
+	maxResponseSize = 64 * 1024
+
+	// IdempotencyKeyHeader is the request header that holds the ID of the
+	// event. Receivers use it to detect events that are delivered more
+	// than once.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// EventTypeHeader is the request header that holds the type of the
+	// event.
+	EventTypeHeader = "X-Lnd-Event"
+
+	// SignatureHeader is the request header that holds the hex encoded
+	// HMAC-SHA256 of the request body, keyed with the shared secret.
+	SignatureHeader = "X-Lnd-Signature"
+)
+
+// Config holds the configuration of the webhook notifier.
+type Config struct {
+	// URLs are the URLs that every event is posted to.
+	URLs []string
+
+	// Secret is the shared secret that the request bodies are signed with.
+	Secret []byte
+
+	// Timeout is the timeout of a single delivery attempt.
+	Timeout time.Duration
+
+	// RetryDelay is the delay after the first failed delivery attempt.
+	RetryDelay time.Duration
+
+	// MaxRetryDelay is the maximum delay between two delivery attempts.
+	MaxRetryDelay time.Duration
+
+	// MaxAttempts is the number of failed attempts after which a delivery
+	// is dropped. Zero means that deliveries are retried forever.
+	MaxAttempts uint32
+
+	// DB is the database that the outbox and the cursors of the event
+	// sources are stored in.
+	DB kvdb.Backend
+
+	// ChainParams are the parameters of the chain that payment requests
+	// are decoded for.
+	ChainParams *chaincfg.Params
+
+	// SubscribeInvoices subscribes to the invoice events after the given
+	// add and settle indices, including the accepted and canceled
+	// invoices.
+	SubscribeInvoices func(addIndex,
+		settleIndex uint64) (*invoices.InvoiceSubscription, error)
+
+	// QueryInvoices queries the invoice database. It's used to replay the
+	// invoices that were accepted or canceled while the notifier was
+	// down.
+	QueryInvoices func(ctx context.Context, q invoices.InvoiceQuery) (
+		invoices.InvoiceSlice, error)
+
+	// SubscribePayments subscribes to the updates of all payments.
+	SubscribePayments func() (routing.ControlTowerSubscriber, error)
+
+	// QueryPayments queries the payments database. It's used to replay
+	// the payments that reached a final state while the notifier was
+	// down.
+	QueryPayments func(query channeldb.PaymentsQuery) (
+		channeldb.PaymentsResponse, error)
+
+	// SubscribeChannelEvents subscribes to the channel events.
+	SubscribeChannelEvents func() (*subscribe.Client, error)
+
+	// FetchOpenChannels returns all open channels. It's used to replay the
+	// channels that were opened while the notifier was down.
+	FetchOpenChannels func() ([]*channeldb.OpenChannel, error)
+
+	// FetchClosedChannels returns the summaries of all closed channels.
+	// It's used to replay the channels that were closed while the notifier
+	// was down.
+	FetchClosedChannels func() ([]*channeldb.ChannelCloseSummary, error)
+
+	// Clock is the clock that the events are timestamped and the
+	// deliveries are scheduled with.
+	Clock clock.Clock
+}
+
+// Notifier posts invoice, payment and channel events to the configured URLs.
+// Events are stored in an outbox before they are delivered, and deliveries
+// that fail are retried with exponential backoff, so that events aren't lost
+// while the receiver or lnd is down.
+type Notifier struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *Config
+
+	outbox *outbox
+	client *http.Client
+
+	// newEvents wakes up the delivery loop when events are added to the
+	// outbox.
+	newEvents chan struct{}
+
+	// ctx is canceled when the notifier stops, which aborts the pending
+	// requests.
+	ctx    context.Context
+	cancel func()
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new webhook notifier.
+func New(cfg *Config) (*Notifier, error) {
+	outbox, err := newOutbox(cfg.DB)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create webhook outbox: %w",
+			err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Notifier{
+		cfg:       cfg,
+		outbox:    outbox,
+		client:    &http.Client{Timeout: cfg.Timeout},
+		newEvents: make(chan struct{}, 1),
+		ctx:       ctx,
+		cancel:    cancel,
+		quit:      make(chan struct{}),
+	}, nil
+}
+
+// Start subscribes to the event sources and starts delivering events.
+func (n *Notifier) Start() error {
+	if n.started.Swap(true) {
+		return errors.New("webhook notifier already started")
+	}
+
+	log.Infof("Webhook notifier starting, posting events to %d URLs",
+		len(n.cfg.URLs))
+
+	// Invoices resume after the last invoice that made it into the
+	// outbox, so that no invoice event is missed while lnd is down.
+	addIndex, _, err := n.outbox.index(invoiceAddIndexKey)
+	if err != nil {
+		return err
+	}
+	settleIndex, _, err := n.outbox.index(invoiceSettleIndexKey)
+	if err != nil {
+		return err
+	}
+
+	invoiceSub, err := n.cfg.SubscribeInvoices(addIndex, settleIndex)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to invoices: %w", err)
+	}
+
+	paymentSub, err := n.cfg.SubscribePayments()
+	if err != nil {
+		invoiceSub.Cancel()
+
+		return fmt.Errorf("unable to subscribe to payments: %w", err)
+	}
+
+	channelSub, err := n.cfg.SubscribeChannelEvents()
+	if err != nil {
+		invoiceSub.Cancel()
+		paymentSub.Close()
+
+		return fmt.Errorf("unable to subscribe to channel events: %w",
+			err)
+	}
+
+	// Payments and channels are subscribed to before they are replayed
+	// by their loops, so that no event is missed in between. Events that
+	// are both replayed and received are only added to the outbox once.
+	n.wg.Add(4)
+	go n.invoiceLoop(invoiceSub)
+	go n.paymentLoop(paymentSub)
+	go n.channelLoop(channelSub)
+	go n.deliveryLoop()
+
+	return nil
+}
+
+// Stop stops delivering events. Deliveries that are still in the outbox are
+// attempted again after a restart.
+func (n *Notifier) Stop() error {
+	if n.stopped.Swap(true) {
+		return nil
+	}
+
+	log.Info("Webhook notifier shutting down...")
+	defer log.Debug("Webhook notifier shutdown complete")
+
+	n.cancel()
+	close(n.quit)
+	n.wg.Wait()
+
+	return nil
+}
+
+// invoiceLoop adds the invoice events to the outbox.
+func (n *Notifier) invoiceLoop(sub *invoices.InvoiceSubscription) {
+	defer n.wg.Done()
+	defer sub.Cancel()
+
+	if err := n.replayInvoices(); err != nil {
+		log.Errorf("Unable to replay invoices: %v", err)
+	}
+
+	for {
+		select {
+		case invoice := <-sub.NewInvoices:
+			n.addInvoice(invoice)
+
+		case invoice := <-sub.SettledInvoices:
+			update := &indexUpdate{
+				key:   invoiceSettleIndexKey,
+				index: invoice.SettleIndex,
+			}
+
+			// AMP invoices stay open after they were settled, so
+			// they're only untracked once they're settled for
+			// good.
+			var sourceUpdate sourceUpdate = update
+			if invoice.State == invoices.ContractSettled {
+				sourceUpdate = &invoiceUpdate{
+					index:    update,
+					addIndex: invoice.AddIndex,
+					state:    invoice.State,
+				}
+			}
+
+			event := newInvoiceEvent(
+				EventInvoiceSettled, invoice,
+				n.cfg.ChainParams, n.cfg.Clock.Now(),
+			)
+			n.addEvent(event, sourceUpdate)
+
+		case invoice := <-sub.AcceptedInvoices:
+			n.addInvoiceTransition(invoice)
+
+		case invoice := <-sub.CanceledInvoices:
+			n.addInvoiceTransition(invoice)
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// addInvoice adds the added event of an invoice to the outbox, and starts
+// tracking its state. Invoices that were added while the notifier was down
+// are replayed in their current state, so their accepted or canceled event is
+// added as well.
+func (n *Notifier) addInvoice(invoice *invoices.Invoice) {
+	update := &indexUpdate{
+		key:   invoiceAddIndexKey,
+		index: invoice.AddIndex,
+	}
+
+	// Settled invoices aren't tracked, as their settle events are
+	// replayed by their settle index.
+	var sourceUpdate sourceUpdate = update
+	if invoice.State != invoices.ContractSettled {
+		sourceUpdate = &invoiceUpdate{
+			index:    update,
+			addIndex: invoice.AddIndex,
+			state:    invoices.ContractOpen,
+		}
+	}
+
+	event := newInvoiceEvent(
+		EventInvoiceAdded, invoice, n.cfg.ChainParams,
+		n.cfg.Clock.Now(),
+	)
+	n.addEvent(event, sourceUpdate)
+
+	n.addInvoiceTransition(invoice)
+}
+
+// addInvoiceTransition adds the accepted or canceled event of an invoice to
+// the outbox, depending on its state. The event is stored together with the
+// new state of the invoice, so that it's added only once, even if it's both
+// replayed and received.
+func (n *Notifier) addInvoiceTransition(invoice *invoices.Invoice) {
+	var eventType EventType
+	switch invoice.State {
+	case invoices.ContractAccepted:
+		eventType = EventInvoiceAccepted
+
+	case invoices.ContractCanceled:
+		eventType = EventInvoiceCanceled
+
+	default:
+		return
+	}
+
+	event := newInvoiceEvent(
+		eventType, invoice, n.cfg.ChainParams, n.cfg.Clock.Now(),
+	)
+	n.addEvent(event, &invoiceUpdate{
+		addIndex: invoice.AddIndex,
+		state:    invoice.State,
+	})
+}
+
+// replayInvoices adds the accepted and canceled events of the tracked invoices
+// that were accepted or canceled while the notifier was down. Invoices that
+// were added while the notifier was down are replayed by the invoice
+// subscription instead. If the invoices haven't been replayed before, the
+// notifier is started for the first time, and the pending invoices are only
+// tracked without adding their events.
+func (n *Notifier) replayInvoices() error {
+	states, replayed, err := n.outbox.invoiceStates()
+	if err != nil {
+		return err
+	}
+
+	if !replayed {
+		return n.trackPendingInvoices()
+	}
+
+	if len(states) == 0 {
+		return nil
+	}
+
+	// The invoices are read in the order of their add index, starting at
+	// the first tracked one.
+	offset := uint64(math.MaxUint64)
+	for addIndex := range states {
+		offset = min(offset, addIndex-1)
+	}
+
+	ctx := context.Background()
+	for len(states) > 0 {
+		resp, err := n.cfg.QueryInvoices(ctx, invoices.InvoiceQuery{
+			IndexOffset:    offset,
+			NumMaxInvoices: invoiceBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range resp.Invoices {
+			invoice := &resp.Invoices[i]
+
+			state, ok := states[invoice.AddIndex]
+			if !ok {
+				continue
+			}
+			delete(states, invoice.AddIndex)
+
+			switch {
+			case invoice.State == invoices.ContractSettled:
+				err = n.outbox.markInvoices(&invoiceUpdate{
+					addIndex: invoice.AddIndex,
+					state:    invoice.State,
+				})
+				if err != nil {
+					return err
+				}
+
+			case invoice.State != state:
+				n.addInvoiceTransition(invoice)
+			}
+		}
+
+		if len(resp.Invoices) < invoiceBatchSize {
+			break
+		}
+		offset = resp.LastIndexOffset
+	}
+
+	// The invoices that are left were deleted, so they aren't tracked
+	// anymore.
+	deleted := make([]*invoiceUpdate, 0, len(states))
+	for addIndex := range states {
+		deleted = append(deleted, &invoiceUpdate{
+			addIndex: addIndex,
+			state:    invoices.ContractCanceled,
+		})
+	}
+
+	return n.outbox.markInvoices(deleted...)
+}
+
+// trackPendingInvoices tracks the states of all pending invoices without
+// adding their events.
+func (n *Notifier) trackPendingInvoices() error {
+	var (
+		ctx     = context.Background()
+		offset  uint64
+		updates []*invoiceUpdate
+	)
+	for {
+		resp, err := n.cfg.QueryInvoices(ctx, invoices.InvoiceQuery{
+			IndexOffset:    offset,
+			NumMaxInvoices: invoiceBatchSize,
+			PendingOnly:    true,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, invoice := range resp.Invoices {
+			updates = append(updates, &invoiceUpdate{
+				addIndex: invoice.AddIndex,
+				state:    invoice.State,
+			})
+		}
+
+		if len(resp.Invoices) < invoiceBatchSize {
+			break
+		}
+		offset = resp.LastIndexOffset
+	}
+
+	return n.outbox.markInvoices(updates...)
+}
+
+// paymentLoop adds the events of payments that reached a final state to the
+// outbox.
+func (n *Notifier) paymentLoop(sub routing.ControlTowerSubscriber) {
+	defer n.wg.Done()
+	defer sub.Close()
+
+	if err := n.syncPayments(true); err != nil {
+		log.Errorf("Unable to replay payments: %v", err)
+	}
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+
+			payment, ok := update.(*channeldb.MPPayment)
+			if !ok {
+				continue
+			}
+
+			event := newPaymentEvent(payment, n.cfg.Clock.Now())
+			if event == nil {
+				continue
+			}
+			n.addEvent(event, &paymentUpdate{
+				seq: payment.SequenceNum,
+			})
+
+			// The payment may have been the first one after the
+			// payment cursor that was still in flight, so we try
+			// to move the cursor forward.
+			if err := n.syncPayments(false); err != nil {
+				log.Errorf("Unable to update payment cursor: %v",
+					err)
+			}
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// syncPayments adds the events of the payments after the payment cursor that
+// reached a final state to the outbox, and moves the cursor to the last
+// payment before the first one that is still in flight. If all is false, the
+// payments are only read up to the first payment that is still in flight,
+// which is enough to move the cursor. If there is no cursor yet, the notifier
+// is started for the first time, and the payments are only marked without
+// adding their events.
+func (n *Notifier) syncPayments(all bool) error {
+	cursor, found, err := n.outbox.index(paymentIndexKey)
+	if err != nil {
+		return err
+	}
+
+	var (
+		offset   = cursor
+		inFlight bool
+		marks    []sourceUpdate
+	)
+	for {
+		resp, err := n.cfg.QueryPayments(channeldb.PaymentsQuery{
+			IndexOffset:       offset,
+			MaxPayments:       paymentBatchSize,
+			IncludeIncomplete: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, payment := range resp.Payments {
+			offset = payment.SequenceNum
+
+			event := newPaymentEvent(payment, n.cfg.Clock.Now())
+			if event == nil {
+				if !all {
+					return n.outbox.setPaymentIndex(cursor)
+				}
+
+				inFlight = true
+
+				continue
+			}
+
+			// On the first start, the payments before the first
+			// one that is still in flight are covered by the
+			// cursor, and the ones after it are only marked.
+			update := &paymentUpdate{seq: payment.SequenceNum}
+			switch {
+			case !found && inFlight:
+				marks = append(marks, update)
+
+			case found:
+				n.addEvent(event, update)
+			}
+
+			if !inFlight {
+				cursor = payment.SequenceNum
+			}
+		}
+
+		if len(resp.Payments) < paymentBatchSize {
+			break
+		}
+	}
+
+	if err := n.outbox.mark(marks...); err != nil {
+		return err
+	}
+
+	return n.outbox.setPaymentIndex(cursor)
+}
+
+// channelLoop adds the events of opened and closed channels to the outbox.
+func (n *Notifier) channelLoop(sub *subscribe.Client) {
+	defer n.wg.Done()
+	defer sub.Cancel()
+
+	if err := n.replayChannels(); err != nil {
+		log.Errorf("Unable to replay channels: %v", err)
+	}
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+
+			var (
+				event      *Event
+				chanUpdate *channelUpdate
+			)
+			switch e := update.(type) {
+			case channelnotifier.OpenChannelEvent:
+				event = newChannelOpenedEvent(
+					e.Channel, n.cfg.Clock.Now(),
+				)
+				chanUpdate = &channelUpdate{
+					bucket: openedChannelsBucket,
+					chanPoint: e.Channel.FundingOutpoint.
+						String(),
+				}
+
+			case channelnotifier.ClosedChannelEvent:
+				event = newChannelClosedEvent(
+					e.CloseSummary, n.cfg.Clock.Now(),
+				)
+				chanUpdate = &channelUpdate{
+					bucket: closedChannelsBucket,
+					chanPoint: e.CloseSummary.ChanPoint.
+						String(),
+				}
+
+			default:
+				continue
+			}
+			n.addEvent(event, chanUpdate)
+
+		case <-sub.Quit():
+			return
+
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// replayChannels adds the events of the open and closed channels that haven't
+// been added to the outbox yet, which are the channels that were opened or
+// closed while the notifier was down. If the channels haven't been replayed
+// before, the notifier is started for the first time, and the channels are
+// only marked without adding their events.
+func (n *Notifier) replayChannels() error {
+	openChannels, err := n.cfg.FetchOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	closedChannels, err := n.cfg.FetchClosedChannels()
+	if err != nil {
+		return err
+	}
+
+	replayed, err := n.outbox.channelsReplayed()
+	if err != nil {
+		return err
+	}
+
+	if !replayed {
+		opened := make([]string, 0, len(openChannels))
+		for _, channel := range openChannels {
+			opened = append(
+				opened, channel.FundingOutpoint.String(),
+			)
+		}
+
+		closed := make([]string, 0, len(closedChannels))
+		for _, summary := range closedChannels {
+			closed = append(closed, summary.ChanPoint.String())
+		}
+
+		return n.outbox.markChannels(opened, closed)
+	}
+
+	for _, channel := range openChannels {
+		event := newChannelOpenedEvent(channel, n.cfg.Clock.Now())
+		n.addEvent(event, &channelUpdate{
+			bucket:    openedChannelsBucket,
+			chanPoint: channel.FundingOutpoint.String(),
+		})
+	}
+
+	for _, summary := range closedChannels {
+		event := newChannelClosedEvent(summary, n.cfg.Clock.Now())
+		n.addEvent(event, &channelUpdate{
+			bucket:    closedChannelsBucket,
+			chanPoint: summary.ChanPoint.String(),
+		})
+	}
+
+	return nil
+}
+
+// addEvent adds the deliveries of an event to the outbox and wakes up the
+// delivery loop.
+func (n *Notifier) addEvent(event *Event, update sourceUpdate) {
+	err := n.outbox.add(event, n.cfg.URLs, n.cfg.Clock.Now(), update)
+	if err != nil {
+		log.Errorf("Unable to add webhook event %v (%v) to outbox: %v",
+			event.ID, event.Type, err)
+
+		return
+	}
+
+	log.Debugf("Added webhook event %v (%v) to outbox", event.ID,
+		event.Type)
+
+	select {
+	case n.newEvents <- struct{}{}:
+	default:
+	}
+}
+
+// deliveryLoop attempts the deliveries that are due whenever events are added
+// or a failed delivery is due again.
+func (n *Notifier) deliveryLoop() {
+	defer n.wg.Done()
+
+	for {
+		var retry <-chan time.Time
+		if next := n.deliverDue(); !next.IsZero() {
+			retry = n.cfg.Clock.TickAfter(
+				next.Sub(n.cfg.Clock.Now()),
+			)
+		}
+
+		select {
+		case <-n.newEvents:
+		case <-retry:
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+// deliverDue attempts all deliveries that are due, and returns the time at
+// which the next delivery is due, or zero if there is none.
+func (n *Notifier) deliverDue() time.Time {
+	for {
+		due, next, err := n.outbox.due(
+			n.cfg.Clock.Now(), deliveryBatchSize,
+		)
+		if err != nil {
+			log.Errorf("Unable to read webhook outbox: %v", err)
+
+			return n.cfg.Clock.Now().Add(n.cfg.RetryDelay)
+		}
+
+		// Deliveries that fail are rescheduled, so the outbox is read
+		// until there are no due deliveries left.
+		if len(due) == 0 {
+			return next
+		}
+
+		for _, d := range due {
+			select {
+			case <-n.quit:
+				return time.Time{}
+			default:
+			}
+
+			n.attempt(d)
+		}
+	}
+}
+
+// attempt posts a delivery to its URL. Successful deliveries are removed from
+// the outbox, failed ones are rescheduled or dropped once they ran out of
+// attempts.
+func (n *Notifier) attempt(d *delivery) {
+	err := n.post(d)
+	if err == nil {
+		log.Debugf("Delivered webhook event %s to %s", d.eventID, d.url)
+
+		if err := n.outbox.remove(d.seq); err != nil {
+			log.Errorf("Unable to remove webhook delivery %d: %v",
+				d.seq, err)
+		}
+
+		return
+	}
+
+	// The request was aborted because we're shutting down, which doesn't
+	// count as a failed attempt.
+	if n.ctx.Err() != nil {
+		return
+	}
+
+	d.attempts++
+	if n.cfg.MaxAttempts > 0 && d.attempts >= n.cfg.MaxAttempts {
+		log.Errorf("Dropping webhook event %s to %s after %d failed "+
+			"attempts: %v", d.eventID, d.url, d.attempts, err)
+
+		if err := n.outbox.remove(d.seq); err != nil {
+			log.Errorf("Unable to remove webhook delivery %d: %v",
+				d.seq, err)
+		}
+
+		return
+	}
+
+	delay := n.retryDelay(d.attempts)
+	d.nextAttempt = n.cfg.Clock.Now().Add(delay)
+
+	log.Warnf("Unable to deliver webhook event %s to %s, retrying in "+
+		"%v: %v", d.eventID, d.url, delay, err)
+
+	if err := n.outbox.update(d); err != nil {
+		log.Errorf("Unable to update webhook delivery %d: %v", d.seq,
+			err)
+	}
+}
+
+// retryDelay returns the delay after the given number of failed attempts. It
+// starts at the retry delay and doubles with every attempt, up to the maximum
+// retry delay.
+func (n *Notifier) retryDelay(attempts uint32) time.Duration {
+	delay := n.cfg.RetryDelay
+	for i := uint32(1); i < attempts && delay < n.cfg.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > n.cfg.MaxRetryDelay {
+		delay = n.cfg.MaxRetryDelay
+	}
+
+	return delay
+}
+
+// post posts the payload of a delivery to its URL. Any response with a status
+// code other than 2xx is considered a failure.
+func (n *Notifier) post(d *delivery) error {
+	req, err := http.NewRequestWithContext(
+		n.ctx, http.MethodPost, string(d.url),
+		bytes.NewReader(d.payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(IdempotencyKeyHeader, string(d.eventID))
+	req.Header.Set(EventTypeHeader, string(d.eventType))
+	req.Header.Set(SignatureHeader, Sign(n.cfg.Secret, d.payload))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body, so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %v",
+			resp.Status)
+	}
+
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload, keyed with the
+// secret. Receivers verify the SignatureHeader of a request by comparing it to
+// the signature of the request body.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,619 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTimeout = 5 * time.Second
+
+	testRetryDelay = 5 * time.Second
+)
+
+var testSecret = []byte("secret")
+
+// receivedRequest is a request that the test receiver received.
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+	event  Event
+}
+
+// mockPaymentSubscriber is a payment subscription that is fed by the test.
+type mockPaymentSubscriber struct {
+	updates chan interface{}
+}
+
+func (m *mockPaymentSubscriber) Updates() <-chan interface{} {
+	return m.updates
+}
+
+func (m *mockPaymentSubscriber) Close() {}
+
+type testHarness struct {
+	t *testing.T
+
+	db       *channeldb.DB
+	registry *invoices.InvoiceRegistry
+	channels *subscribe.Server
+	payments *mockPaymentSubscriber
+	clock    *clock.TestClock
+
+	// closedChannels are the closed channels that are replayed when the
+	// notifier starts.
+	closedChannels []*channeldb.ChannelCloseSummary
+
+	// status is the status code that the receiver responds with.
+	status   atomic.Int32
+	requests chan *receivedRequest
+	url      string
+
+	notifier *Notifier
+}
+
+func newTestHarness(t *testing.T) *testHarness {
+	db, err := channeldb.MakeTestDB(t)
+	require.NoError(t, err)
+
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+
+	chainNotifier := &mock.ChainNotifier{
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+	expiryWatcher := invoices.NewInvoiceExpiryWatcher(
+		testClock, 0, 0, nil, chainNotifier,
+	)
+	registry := invoices.NewRegistry(
+		db, expiryWatcher, &invoices.RegistryConfig{
+			Clock: testClock,
+		},
+	)
+	require.NoError(t, registry.Start())
+	t.Cleanup(func() {
+		require.NoError(t, registry.Stop())
+	})
+
+	channels := subscribe.NewServer()
+	require.NoError(t, channels.Start())
+	t.Cleanup(func() {
+		require.NoError(t, channels.Stop())
+	})
+
+	h := &testHarness{
+		t:        t,
+		db:       db,
+		registry: registry,
+		channels: channels,
+		payments: &mockPaymentSubscriber{
+			updates: make(chan interface{}),
+		},
+		clock:    testClock,
+		requests: make(chan *receivedRequest, 10),
+	}
+	h.status.Store(http.StatusOK)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			req := &receivedRequest{
+				header: r.Header,
+				body:   body,
+			}
+			require.NoError(t, json.Unmarshal(body, &req.event))
+
+			// The status is read before the request is handed to
+			// the test, so that the test can change it for the
+			// next request.
+			status := int(h.status.Load())
+			h.requests <- req
+
+			w.WriteHeader(status)
+		},
+	))
+	t.Cleanup(server.Close)
+	h.url = server.URL
+
+	return h
+}
+
+// start creates and starts a new notifier over the database of the harness.
+func (h *testHarness) start() {
+	notifier, err := New(&Config{
+		URLs:          []string{h.url},
+		Secret:        testSecret,
+		Timeout:       testTimeout,
+		RetryDelay:    testRetryDelay,
+		MaxRetryDelay: time.Minute,
+		MaxAttempts:   DefaultMaxAttempts,
+		DB:            h.db,
+		ChainParams:   &chaincfg.RegressionNetParams,
+		SubscribeInvoices: func(addIndex, settleIndex uint64) (
+			*invoices.InvoiceSubscription, error) {
+
+			return h.registry.SubscribeAllStates(
+				context.Background(), addIndex, settleIndex,
+			)
+		},
+		SubscribePayments: func() (routing.ControlTowerSubscriber,
+			error) {
+
+			return h.payments, nil
+		},
+		QueryInvoices:          h.db.QueryInvoices,
+		QueryPayments:          h.db.QueryPayments,
+		SubscribeChannelEvents: h.channels.Subscribe,
+		FetchOpenChannels: h.db.ChannelStateDB().
+			FetchAllOpenChannels,
+		FetchClosedChannels: func() ([]*channeldb.ChannelCloseSummary,
+			error) {
+
+			return h.closedChannels, nil
+		},
+		Clock: h.clock,
+	})
+	require.NoError(h.t, err)
+	require.NoError(h.t, notifier.Start())
+
+	h.notifier = notifier
+	h.t.Cleanup(func() {
+		require.NoError(h.t, notifier.Stop())
+	})
+}
+
+// addInvoice adds an invoice with the given preimage to the registry.
+func (h *testHarness) addInvoice(preimage byte) lntypes.Hash {
+	invoice := &invoices.Invoice{
+		Terms: invoices.ContractTerm{
+			Value:    lnwire.MilliSatoshi(100_000),
+			Expiry:   time.Hour,
+			Features: lnwire.EmptyFeatureVector(),
+			PaymentPreimage: &lntypes.Preimage{
+				preimage,
+			},
+		},
+		CreationDate: h.clock.Now(),
+	}
+	hash := invoice.Terms.PaymentPreimage.Hash()
+
+	_, err := h.registry.AddInvoice(context.Background(), invoice, hash)
+	require.NoError(h.t, err)
+
+	return hash
+}
+
+// receive waits for the next request and checks its headers.
+func (h *testHarness) receive(eventType EventType) *receivedRequest {
+	h.t.Helper()
+
+	var req *receivedRequest
+	select {
+	case req = <-h.requests:
+	case <-time.After(testTimeout):
+		h.t.Fatalf("no %v event received", eventType)
+	}
+
+	require.Equal(h.t, eventType, req.event.Type)
+	require.Equal(h.t, "application/json", req.header.Get("Content-Type"))
+	require.Equal(h.t, string(eventType), req.header.Get(EventTypeHeader))
+	require.Equal(h.t, req.event.ID, req.header.Get(IdempotencyKeyHeader))
+	require.Equal(
+		h.t, Sign(testSecret, req.body),
+		req.header.Get(SignatureHeader),
+	)
+
+	return req
+}
+
+// assertOutboxEmpty asserts that all deliveries are removed from the outbox.
+func (h *testHarness) assertOutboxEmpty() {
+	h.t.Helper()
+
+	require.Eventually(h.t, func() bool {
+		due, next, err := h.notifier.outbox.due(h.clock.Now(), 10)
+		require.NoError(h.t, err)
+
+		return len(due) == 0 && next.IsZero()
+	}, testTimeout, 10*time.Millisecond)
+}
+
+// TestNotifierEvents tests that invoice, payment and channel events are posted
+// to the webhook URL.
+func TestNotifierEvents(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+
+	hash := h.addInvoice(1)
+	req := h.receive(EventInvoiceAdded)
+
+	var invoice struct {
+		Data InvoiceData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(req.body, &invoice))
+	require.Equal(t, hash.String(), invoice.Data.PaymentHash)
+	require.EqualValues(t, 100_000, invoice.Data.ValueMsat)
+	require.Equal(t, "Open", invoice.Data.State)
+	require.EqualValues(t, 1, invoice.Data.AddIndex)
+
+	err := h.registry.CancelInvoice(context.Background(), hash)
+	require.NoError(t, err)
+	h.receive(EventInvoiceCanceled)
+
+	// Payments that are still in flight are ignored.
+	h.payments.updates <- &channeldb.MPPayment{
+		Status: channeldb.StatusInFlight,
+	}
+	h.payments.updates <- &channeldb.MPPayment{
+		SequenceNum: 7,
+		Info: &channeldb.PaymentCreationInfo{
+			Value:        5_000,
+			CreationTime: h.clock.Now(),
+		},
+		Status: channeldb.StatusSucceeded,
+	}
+	req = h.receive(EventPaymentSucceeded)
+
+	var payment struct {
+		Data PaymentData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(req.body, &payment))
+	require.EqualValues(t, 5_000, payment.Data.ValueMsat)
+	require.EqualValues(t, 7, payment.Data.PaymentIndex)
+
+	chanPoint := wire.OutPoint{Index: 1}
+	err = h.channels.SendUpdate(channelnotifier.ClosedChannelEvent{
+		CloseSummary: &channeldb.ChannelCloseSummary{
+			ChanPoint: chanPoint,
+			Capacity:  btcutil.Amount(1_000_000),
+			CloseType: channeldb.CooperativeClose,
+		},
+	})
+	require.NoError(t, err)
+	req = h.receive(EventChannelClosed)
+
+	var channel struct {
+		Data ChannelData `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(req.body, &channel))
+	require.Equal(t, chanPoint.String(), channel.Data.ChannelPoint)
+	require.Equal(t, "cooperative", channel.Data.CloseType)
+
+	h.assertOutboxEmpty()
+}
+
+// TestNotifierRetry tests that failed deliveries are retried with the same
+// idempotency key after the retry delay.
+func TestNotifierRetry(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.status.Store(http.StatusInternalServerError)
+	h.start()
+
+	h.addInvoice(1)
+	first := h.receive(EventInvoiceAdded)
+
+	// The delivery isn't retried before the retry delay passed.
+	h.status.Store(http.StatusOK)
+	select {
+	case <-h.requests:
+		t.Fatalf("delivery retried too early")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	h.clock.SetTime(h.clock.Now().Add(testRetryDelay))
+	second := h.receive(EventInvoiceAdded)
+	require.Equal(t, first.event.ID, second.event.ID)
+	require.Equal(t, first.body, second.body)
+
+	h.assertOutboxEmpty()
+}
+
+// TestNotifierResume tests that deliveries and invoices are picked up after a
+// restart.
+func TestNotifierResume(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.status.Store(http.StatusServiceUnavailable)
+	h.start()
+
+	h.addInvoice(1)
+	failed := h.receive(EventInvoiceAdded)
+
+	// Wait for the failed delivery to be rescheduled, as a request that
+	// is aborted by the shutdown doesn't count as an attempt.
+	require.Eventually(t, func() bool {
+		due, next, err := h.notifier.outbox.due(h.clock.Now(), 10)
+		require.NoError(t, err)
+
+		return len(due) == 0 && !next.IsZero()
+	}, testTimeout, 10*time.Millisecond)
+
+	// Stop the notifier and add another invoice while it's down.
+	require.NoError(t, h.notifier.Stop())
+	h.addInvoice(2)
+
+	// After the restart, the invoice that was added while the notifier
+	// was down is delivered from the backlog of the registry, and the
+	// failed delivery is retried once it's due.
+	h.status.Store(http.StatusOK)
+	h.start()
+
+	req := h.receive(EventInvoiceAdded)
+	require.NotEqual(t, failed.event.ID, req.event.ID)
+
+	h.clock.SetTime(h.clock.Now().Add(testRetryDelay))
+	req = h.receive(EventInvoiceAdded)
+	require.Equal(t, failed.event.ID, req.event.ID)
+
+	h.assertOutboxEmpty()
+
+	// Neither invoice is delivered again.
+	select {
+	case req := <-h.requests:
+		t.Fatalf("unexpected event: %v", req.event.ID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestNotifierReplay tests that payments and channels that reached their final
+// state while the notifier was down are replayed after a restart, while the
+// ones from before the first start are skipped.
+func TestNotifierReplay(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	payments := channeldb.NewPaymentControl(h.db)
+
+	initPayment := func(id byte) lntypes.Hash {
+		hash := lntypes.Hash{id}
+		err := payments.InitPayment(hash, &channeldb.PaymentCreationInfo{
+			PaymentIdentifier: hash,
+			Value:             1_000,
+			CreationTime:      h.clock.Now(),
+			PaymentRequest:    []byte{},
+		})
+		require.NoError(t, err)
+
+		return hash
+	}
+	failPayment := func(hash lntypes.Hash) {
+		_, err := payments.Fail(hash, channeldb.FailureReasonNoRoute)
+		require.NoError(t, err)
+	}
+
+	// The first payment failed before the notifier was started, the
+	// second one is still in flight, and the third one failed after it.
+	failPayment(initPayment(1))
+	inFlight := initPayment(2)
+	failPayment(initPayment(3))
+
+	h.closedChannels = []*channeldb.ChannelCloseSummary{{
+		ChanPoint: wire.OutPoint{Index: 1},
+		CloseType: channeldb.CooperativeClose,
+	}}
+
+	// None of them are delivered on the first start.
+	h.start()
+	require.Eventually(t, func() bool {
+		index, _, err := h.notifier.outbox.index(paymentIndexKey)
+		require.NoError(t, err)
+
+		return index == 1
+	}, testTimeout, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		replayed, err := h.notifier.outbox.channelsReplayed()
+		require.NoError(t, err)
+
+		return replayed
+	}, testTimeout, 10*time.Millisecond)
+
+	select {
+	case req := <-h.requests:
+		t.Fatalf("unexpected event: %v", req.event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// While the notifier is down, the payment in flight fails, another
+	// payment fails and another channel is closed.
+	require.NoError(t, h.notifier.Stop())
+
+	failPayment(inFlight)
+	failPayment(initPayment(4))
+
+	closedChanPoint := wire.OutPoint{Index: 2}
+	h.closedChannels = append(h.closedChannels,
+		&channeldb.ChannelCloseSummary{
+			ChanPoint: closedChanPoint,
+			CloseType: channeldb.RemoteForceClose,
+		},
+	)
+
+	h.start()
+
+	var (
+		paymentIndices []uint64
+		chanPoints     []string
+	)
+	for i := 0; i < 3; i++ {
+		var req *receivedRequest
+		select {
+		case req = <-h.requests:
+		case <-time.After(testTimeout):
+			t.Fatalf("no event received")
+		}
+
+		switch req.event.Type {
+		case EventPaymentFailed:
+			var payment struct {
+				Data PaymentData `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(req.body, &payment))
+			paymentIndices = append(
+				paymentIndices, payment.Data.PaymentIndex,
+			)
+
+		case EventChannelClosed:
+			var channel struct {
+				Data ChannelData `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(req.body, &channel))
+			chanPoints = append(
+				chanPoints, channel.Data.ChannelPoint,
+			)
+
+		default:
+			t.Fatalf("unexpected event: %v", req.event.Type)
+		}
+	}
+
+	require.Equal(t, []uint64{2, 4}, paymentIndices)
+	require.Equal(t, []string{closedChanPoint.String()}, chanPoints)
+
+	h.assertOutboxEmpty()
+
+	// All payments are final now, so the cursor covers all of them.
+	index, _, err := h.notifier.outbox.index(paymentIndexKey)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, index)
+
+	select {
+	case req := <-h.requests:
+		t.Fatalf("unexpected event: %v", req.event.ID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestNotifierReplayInvoices tests that invoices that were canceled while the
+// notifier was down are replayed after a restart, while the ones from before
+// the first start are only tracked.
+func TestNotifierReplayInvoices(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+
+	// Both invoices are added before the notifier is started. We wait for
+	// their notifications to be dispatched, so that the notifier doesn't
+	// receive them.
+	ctx := context.Background()
+	sub, err := h.registry.SubscribeNotifications(ctx, 0, 0)
+	require.NoError(t, err)
+
+	first := h.addInvoice(1)
+	h.addInvoice(2)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-sub.NewInvoices:
+		case <-time.After(testTimeout):
+			t.Fatalf("invoice not added")
+		}
+	}
+	sub.Cancel()
+
+	h.start()
+	require.Eventually(t, func() bool {
+		states, replayed, err := h.notifier.outbox.invoiceStates()
+		require.NoError(t, err)
+
+		return replayed && len(states) == 2
+	}, testTimeout, 10*time.Millisecond)
+
+	select {
+	case req := <-h.requests:
+		t.Fatalf("unexpected event: %v", req.event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// While the notifier is down, the first invoice is canceled, and a
+	// third one is added and canceled.
+	require.NoError(t, h.notifier.Stop())
+
+	require.NoError(t, h.registry.CancelInvoice(ctx, first))
+	third := h.addInvoice(3)
+	require.NoError(t, h.registry.CancelInvoice(ctx, third))
+
+	h.start()
+
+	events := make(map[EventType][]uint64)
+	for i := 0; i < 3; i++ {
+		var req *receivedRequest
+		select {
+		case req = <-h.requests:
+		case <-time.After(testTimeout):
+			t.Fatalf("no event received")
+		}
+
+		var invoice struct {
+			Data InvoiceData `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(req.body, &invoice))
+		events[req.event.Type] = append(
+			events[req.event.Type], invoice.Data.AddIndex,
+		)
+	}
+
+	require.Equal(t, []uint64{3}, events[EventInvoiceAdded])
+	require.ElementsMatch(t, []uint64{1, 3}, events[EventInvoiceCanceled])
+
+	h.assertOutboxEmpty()
+
+	// Only the second invoice is still tracked.
+	states, _, err := h.notifier.outbox.invoiceStates()
+	require.NoError(t, err)
+	require.Equal(
+		t, map[uint64]invoices.ContractState{
+			2: invoices.ContractOpen,
+		}, states,
+	)
+
+	select {
+	case req := <-h.requests:
+		t.Fatalf("unexpected event: %v", req.event.ID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestRetryDelay tests that the retry delay doubles with every failed attempt
+// up to the maximum retry delay.
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	n := &Notifier{
+		cfg: &Config{
+			RetryDelay:    time.Second,
+			MaxRetryDelay: 10 * time.Second,
+		},
+	}
+
+	require.Equal(t, time.Second, n.retryDelay(1))
+	require.Equal(t, 2*time.Second, n.retryDelay(2))
+	require.Equal(t, 8*time.Second, n.retryDelay(4))
+	require.Equal(t, 10*time.Second, n.retryDelay(5))
+	require.Equal(t, 10*time.Second, n.retryDelay(100))
+}
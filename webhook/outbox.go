@@ -0,0 +1,645 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// outboxBucket is the top-level bucket of the webhook outbox.
+	outboxBucket = []byte("webhook-outbox")
+
+	// deliveriesBucket is the sub-bucket of the outbox that holds the
+	// pending deliveries, keyed by their sequence number.
+	deliveriesBucket = []byte("deliveries")
+
+	// indicesBucket is the sub-bucket of the outbox that holds the
+	// indices of the event sources that have been processed.
+	indicesBucket = []byte("indices")
+
+	// invoiceAddIndexKey is the key of the highest add index of the
+	// invoices that have been added to the outbox.
+	invoiceAddIndexKey = []byte("invoice-add-index")
+
+	// invoiceSettleIndexKey is the key of the highest settle index of the
+	// invoices that have been added to the outbox.
+	invoiceSettleIndexKey = []byte("invoice-settle-index")
+
+	// paymentIndexKey is the key of the payment cursor. All payments with
+	// a sequence number up to the cursor reached a final state and have
+	// been added to the outbox.
+	paymentIndexKey = []byte("payment-index")
+
+	// paymentsBucket is the sub-bucket of the outbox that holds the
+	// sequence numbers of the payments after the payment cursor that have
+	// been added to the outbox.
+	paymentsBucket = []byte("payments")
+
+	// openedChannelsBucket is the sub-bucket of the outbox that holds the
+	// channel points of the channels whose opened event has been added to
+	// the outbox. It's created when the channels are replayed for the
+	// first time.
+	openedChannelsBucket = []byte("opened-channels")
+
+	// closedChannelsBucket is the sub-bucket of the outbox that holds the
+	// channel points of the channels whose closed event has been added to
+	// the outbox. It's created when the channels are replayed for the
+	// first time.
+	closedChannelsBucket = []byte("closed-channels")
+
+	// invoiceStatesBucket is the sub-bucket of the outbox that holds the
+	// states of the invoices that haven't reached a final state yet, keyed
+	// by their add index. It's used to replay the accepted and canceled
+	// events of invoices, which aren't indexed by the invoice database, and
+	// it's created when the invoices are replayed for the first time.
+	invoiceStatesBucket = []byte("invoice-states")
+
+	// byteOrder is the byte order of the sequence numbers and indices in
+	// the outbox.
+	byteOrder = binary.BigEndian
+)
+
+const (
+	// The tlv types of the fields of a delivery.
+	typeEventID     tlv.Type = 0
+	typeURL         tlv.Type = 1
+	typePayload     tlv.Type = 2
+	typeAttempts    tlv.Type = 3
+	typeNextAttempt tlv.Type = 4
+	typeEventType   tlv.Type = 5
+)
+
+// delivery is the delivery of an event to a single URL.
+type delivery struct {
+	// seq is the sequence number of the delivery in the outbox.
+	// Deliveries are attempted in the order of their sequence numbers.
+	seq uint64
+
+	// eventID is the ID of the event, which is sent as the idempotency
+	// key of the request.
+	eventID []byte
+
+	// eventType is the type of the event, which is sent as a header of the
+	// request.
+	eventType []byte
+
+	// url is the URL the event is posted to.
+	url []byte
+
+	// payload is the JSON encoded event.
+	payload []byte
+
+	// attempts is the number of failed attempts to deliver the event.
+	attempts uint32
+
+	// nextAttempt is the earliest time at which the delivery is attempted
+	// again.
+	nextAttempt time.Time
+}
+
+// encode serializes the delivery, except for its sequence number which is
+// the key it is stored under.
+func (d *delivery) encode() ([]byte, error) {
+	nextAttempt := uint64(d.nextAttempt.UnixNano())
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeEventID, &d.eventID),
+		tlv.MakePrimitiveRecord(typeURL, &d.url),
+		tlv.MakePrimitiveRecord(typePayload, &d.payload),
+		tlv.MakePrimitiveRecord(typeAttempts, &d.attempts),
+		tlv.MakePrimitiveRecord(typeNextAttempt, &nextAttempt),
+		tlv.MakePrimitiveRecord(typeEventType, &d.eventType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeDelivery deserializes a delivery that is stored under the given key.
+func decodeDelivery(key, value []byte) (*delivery, error) {
+	var (
+		d           = &delivery{seq: byteOrder.Uint64(key)}
+		nextAttempt uint64
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeEventID, &d.eventID),
+		tlv.MakePrimitiveRecord(typeURL, &d.url),
+		tlv.MakePrimitiveRecord(typePayload, &d.payload),
+		tlv.MakePrimitiveRecord(typeAttempts, &d.attempts),
+		tlv.MakePrimitiveRecord(typeNextAttempt, &nextAttempt),
+		tlv.MakePrimitiveRecord(typeEventType, &d.eventType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Decode(bytes.NewReader(value)); err != nil {
+		return nil, err
+	}
+	d.nextAttempt = time.Unix(0, int64(nextAttempt))
+
+	return d, nil
+}
+
+// sourceUpdate is an update of the cursor of an event source that is stored
+// together with the deliveries of an event, so that the source can be replayed
+// after a restart without adding its events twice.
+type sourceUpdate interface {
+	// apply stores the update in the outbox bucket. It returns false if
+	// the event is already covered by the cursor, in which case its
+	// deliveries aren't added again.
+	apply(bucket kvdb.RwBucket) (bool, error)
+}
+
+// indexUpdate is an update of the index of an event source whose events are
+// ordered by their index.
+type indexUpdate struct {
+	key   []byte
+	index uint64
+}
+
+// apply stores the index, unless the stored index already covers it.
+func (u *indexUpdate) apply(bucket kvdb.RwBucket) (bool, error) {
+	indices := bucket.NestedReadWriteBucket(indicesBucket)
+
+	current := indices.Get(u.key)
+	if len(current) == 8 && byteOrder.Uint64(current) >= u.index {
+		return false, nil
+	}
+
+	var index [8]byte
+	byteOrder.PutUint64(index[:], u.index)
+
+	return true, indices.Put(u.key, index[:])
+}
+
+// paymentUpdate marks a payment that reached a final state as added to the
+// outbox. Payments don't reach their final state in the order of their
+// sequence numbers, so the payments after the payment cursor are marked
+// individually.
+type paymentUpdate struct {
+	seq uint64
+}
+
+// apply marks the payment, unless it's covered by the payment cursor or
+// already marked.
+func (u *paymentUpdate) apply(bucket kvdb.RwBucket) (bool, error) {
+	indices := bucket.NestedReadWriteBucket(indicesBucket)
+
+	cursor := indices.Get(paymentIndexKey)
+	if len(cursor) == 8 && byteOrder.Uint64(cursor) >= u.seq {
+		return false, nil
+	}
+
+	payments, err := bucket.CreateBucketIfNotExists(paymentsBucket)
+	if err != nil {
+		return false, err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], u.seq)
+	if payments.Get(key[:]) != nil {
+		return false, nil
+	}
+
+	return true, payments.Put(key[:], []byte{})
+}
+
+// channelUpdate marks an event of a channel as added to the outbox. Channels
+// are keyed by their channel point in the bucket of the event type.
+type channelUpdate struct {
+	bucket    []byte
+	chanPoint string
+}
+
+// apply marks the channel, unless it's already marked.
+func (u *channelUpdate) apply(bucket kvdb.RwBucket) (bool, error) {
+	channels, err := bucket.CreateBucketIfNotExists(u.bucket)
+	if err != nil {
+		return false, err
+	}
+
+	if channels.Get([]byte(u.chanPoint)) != nil {
+		return false, nil
+	}
+
+	return true, channels.Put([]byte(u.chanPoint), []byte{})
+}
+
+// invoiceUpdate tracks the state of an invoice from the moment it's added
+// until it reaches a final state, so that its accepted and canceled events can
+// be replayed after a restart without adding them twice. If an index update is
+// given, it's applied first and decides whether the event is added.
+type invoiceUpdate struct {
+	index    *indexUpdate
+	addIndex uint64
+	state    invoices.ContractState
+}
+
+// apply stores the state of the invoice. Accepted events are skipped if the
+// invoice is already known to be accepted, and canceled events are skipped if
+// the invoice isn't tracked anymore.
+func (u *invoiceUpdate) apply(bucket kvdb.RwBucket) (bool, error) {
+	if u.index != nil {
+		added, err := u.index.apply(bucket)
+		if err != nil || !added {
+			return added, err
+		}
+	}
+
+	states, err := bucket.CreateBucketIfNotExists(invoiceStatesBucket)
+	if err != nil {
+		return false, err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], u.addIndex)
+	current := states.Get(key[:])
+
+	switch u.state {
+	// An open invoice is tracked unless it's tracked already, which is
+	// the case if it was accepted before it was first replayed.
+	case invoices.ContractOpen:
+		if current != nil {
+			return true, nil
+		}
+
+		return true, states.Put(key[:], []byte{byte(u.state)})
+
+	case invoices.ContractAccepted:
+		if len(current) == 1 && current[0] == byte(u.state) {
+			return false, nil
+		}
+
+		return true, states.Put(key[:], []byte{byte(u.state)})
+
+	// Settled and canceled invoices, as well as deleted ones, aren't
+	// tracked anymore.
+	default:
+		if current == nil && u.index == nil {
+			return false, nil
+		}
+
+		return true, states.Delete(key[:])
+	}
+}
+
+// outbox persists the deliveries of events until they succeed, so that events
+// aren't lost if the receiver or lnd is down.
+type outbox struct {
+	db kvdb.Backend
+}
+
+// newOutbox creates a new outbox in the given database, and creates its
+// buckets if they don't exist yet.
+func newOutbox(db kvdb.Backend) (*outbox, error) {
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(outboxBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(deliveriesBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = bucket.CreateBucketIfNotExists(indicesBucket)
+
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &outbox{db: db}, nil
+}
+
+// add stores a delivery of the event to each of the URLs, which are due
+// immediately. If a source update is given, it's stored in the same
+// transaction, so that an event source can resume after the last event that
+// made it into the outbox. Events that are already covered by the cursor of
+// their source are replays and are skipped.
+func (o *outbox) add(event *Event, urls []string, now time.Time,
+	update sourceUpdate) error {
+
+	payload, err := event.encode()
+	if err != nil {
+		return err
+	}
+
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(outboxBucket)
+		deliveries := bucket.NestedReadWriteBucket(deliveriesBucket)
+
+		if update != nil {
+			added, err := update.apply(bucket)
+			if err != nil {
+				return err
+			}
+
+			if !added {
+				log.Debugf("Skipping webhook event %v (%v) "+
+					"that is already in the outbox",
+					event.ID, event.Type)
+
+				return nil
+			}
+		}
+
+		for _, url := range urls {
+			seq, err := deliveries.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			d := &delivery{
+				seq:         seq,
+				eventID:     []byte(event.ID),
+				eventType:   []byte(event.Type),
+				url:         []byte(url),
+				payload:     payload,
+				nextAttempt: now,
+			}
+			if err := putDelivery(deliveries, d); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// mark stores the source updates without adding any deliveries. It's used to
+// skip the events that happened before the notifier was enabled.
+func (o *outbox) mark(updates ...sourceUpdate) error {
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(outboxBucket)
+		for _, update := range updates {
+			if _, err := update.apply(bucket); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// putDelivery stores a delivery under its sequence number.
+func putDelivery(deliveries kvdb.RwBucket, d *delivery) error {
+	value, err := d.encode()
+	if err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], d.seq)
+
+	return deliveries.Put(key[:], value)
+}
+
+// due returns up to limit deliveries that are due at the given time, in the
+// order they were added. It also returns the earliest time at which one of the
+// remaining deliveries is due, which is zero if there are none.
+func (o *outbox) due(now time.Time, limit int) ([]*delivery, time.Time,
+	error) {
+
+	var (
+		due  []*delivery
+		next time.Time
+	)
+	err := kvdb.View(o.db, func(tx kvdb.RTx) error {
+		deliveries := tx.ReadBucket(outboxBucket).NestedReadBucket(
+			deliveriesBucket,
+		)
+
+		return deliveries.ForEach(func(k, v []byte) error {
+			d, err := decodeDelivery(k, v)
+			if err != nil {
+				return err
+			}
+
+			if !d.nextAttempt.After(now) && len(due) < limit {
+				due = append(due, d)
+				return nil
+			}
+
+			if next.IsZero() || d.nextAttempt.Before(next) {
+				next = d.nextAttempt
+			}
+
+			return nil
+		})
+	}, func() {
+		due = nil
+		next = time.Time{}
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return due, next, nil
+}
+
+// update replaces a stored delivery.
+func (o *outbox) update(d *delivery) error {
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		deliveries := tx.ReadWriteBucket(outboxBucket).
+			NestedReadWriteBucket(deliveriesBucket)
+
+		return putDelivery(deliveries, d)
+	}, func() {})
+}
+
+// remove removes a delivery from the outbox.
+func (o *outbox) remove(seq uint64) error {
+	var key [8]byte
+	byteOrder.PutUint64(key[:], seq)
+
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		deliveries := tx.ReadWriteBucket(outboxBucket).
+			NestedReadWriteBucket(deliveriesBucket)
+
+		return deliveries.Delete(key[:])
+	}, func() {})
+}
+
+// index returns the stored index of an event source, and whether it's stored
+// at all. The index is zero if it isn't stored.
+func (o *outbox) index(key []byte) (uint64, bool, error) {
+	var (
+		index uint64
+		found bool
+	)
+	err := kvdb.View(o.db, func(tx kvdb.RTx) error {
+		indices := tx.ReadBucket(outboxBucket).NestedReadBucket(
+			indicesBucket,
+		)
+
+		value := indices.Get(key)
+		if len(value) == 8 {
+			index = byteOrder.Uint64(value)
+			found = true
+		}
+
+		return nil
+	}, func() {
+		index = 0
+		found = false
+	})
+
+	return index, found, err
+}
+
+// setPaymentIndex moves the payment cursor to the given sequence number, and
+// removes the marks of the payments that are covered by it.
+func (o *outbox) setPaymentIndex(seq uint64) error {
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(outboxBucket)
+		indices := bucket.NestedReadWriteBucket(indicesBucket)
+
+		var index [8]byte
+		byteOrder.PutUint64(index[:], seq)
+		if err := indices.Put(paymentIndexKey, index[:]); err != nil {
+			return err
+		}
+
+		payments := bucket.NestedReadWriteBucket(paymentsBucket)
+		if payments == nil {
+			return nil
+		}
+
+		// The marks are sorted by their sequence number, so we can
+		// stop at the first one after the cursor.
+		var covered [][]byte
+		cursor := payments.ReadWriteCursor()
+		for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+			if byteOrder.Uint64(k) > seq {
+				break
+			}
+			covered = append(covered, k)
+		}
+
+		for _, k := range covered {
+			if err := payments.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// channelsReplayed returns whether the channels have been replayed before,
+// which is the case once the channel buckets exist.
+func (o *outbox) channelsReplayed() (bool, error) {
+	var replayed bool
+	err := kvdb.View(o.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(outboxBucket)
+		replayed = bucket.NestedReadBucket(openedChannelsBucket) != nil
+
+		return nil
+	}, func() {
+		replayed = false
+	})
+
+	return replayed, err
+}
+
+// invoiceStates returns the states of the tracked invoices by their add index,
+// and whether the invoices have been replayed before, which is the case once
+// the invoice states bucket exists.
+func (o *outbox) invoiceStates() (map[uint64]invoices.ContractState, bool,
+	error) {
+
+	var (
+		states   map[uint64]invoices.ContractState
+		replayed bool
+	)
+	err := kvdb.View(o.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(outboxBucket).NestedReadBucket(
+			invoiceStatesBucket,
+		)
+		if bucket == nil {
+			return nil
+		}
+		replayed = true
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != 8 || len(v) != 1 {
+				return nil
+			}
+
+			addIndex := byteOrder.Uint64(k)
+			states[addIndex] = invoices.ContractState(v[0])
+
+			return nil
+		})
+	}, func() {
+		states = make(map[uint64]invoices.ContractState)
+		replayed = false
+	})
+
+	return states, replayed, err
+}
+
+// markInvoices tracks the states of the given invoices without adding any
+// deliveries. It also creates the invoice states bucket if there are no
+// invoices.
+func (o *outbox) markInvoices(updates ...*invoiceUpdate) error {
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(outboxBucket)
+		_, err := bucket.CreateBucketIfNotExists(invoiceStatesBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, update := range updates {
+			if _, err := update.apply(bucket); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+}
+
+// markChannels marks the opened and closed events of the given channels as
+// added to the outbox without adding their deliveries. It also creates the
+// channel buckets if there are no channels.
+func (o *outbox) markChannels(opened, closed []string) error {
+	return kvdb.Update(o.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(outboxBucket)
+
+		buckets := [][]byte{openedChannelsBucket, closedChannelsBucket}
+		chanPoints := [][]string{opened, closed}
+		for i, name := range buckets {
+			channels, err := bucket.CreateBucketIfNotExists(name)
+			if err != nil {
+				return err
+			}
+
+			for _, chanPoint := range chanPoints[i] {
+				err := channels.Put([]byte(chanPoint), []byte{})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}, func() {})
+}
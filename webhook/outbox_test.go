@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOutbox(t *testing.T) *outbox {
+	backend, cleanup, err := kvdb.GetTestBackend(t.TempDir(), "whok")
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	o, err := newOutbox(backend)
+	require.NoError(t, err)
+
+	return o
+}
+
+// TestOutboxDeliveries tests that deliveries are stored per URL, returned
+// once they are due and can be rescheduled and removed.
+func TestOutboxDeliveries(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOutbox(t)
+	now := time.Unix(1000, 0)
+
+	event := newEvent(EventChannelOpened, "chan", &ChannelData{}, now)
+	urls := []string{"http://a.example", "http://b.example"}
+	require.NoError(t, o.add(event, urls, now, nil))
+
+	due, next, err := o.due(now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.True(t, next.IsZero())
+
+	for i, d := range due {
+		require.Equal(t, event.ID, string(d.eventID))
+		require.Equal(t, string(event.Type), string(d.eventType))
+		require.Equal(t, urls[i], string(d.url))
+		require.Zero(t, d.attempts)
+		require.True(t, d.nextAttempt.Equal(now))
+
+		payload, err := event.encode()
+		require.NoError(t, err)
+		require.Equal(t, payload, d.payload)
+	}
+
+	// The limit caps the number of deliveries that are returned, and the
+	// remaining ones are reported as due.
+	limited, next, err := o.due(now, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	require.True(t, next.Equal(now))
+
+	// Reschedule the first delivery and remove the second one.
+	retry := now.Add(time.Minute)
+	due[0].attempts = 1
+	due[0].nextAttempt = retry
+	require.NoError(t, o.update(due[0]))
+	require.NoError(t, o.remove(due[1].seq))
+
+	pending, next, err := o.due(now, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	require.True(t, next.Equal(retry))
+
+	pending, next, err = o.due(retry, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.True(t, next.IsZero())
+	require.Equal(t, due[0].seq, pending[0].seq)
+	require.EqualValues(t, 1, pending[0].attempts)
+}
+
+// TestOutboxIndex tests that the index of an event source only moves forward,
+// and that replayed events are skipped.
+func TestOutboxIndex(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOutbox(t)
+	now := time.Unix(1000, 0)
+
+	index, found, err := o.index(invoiceAddIndexKey)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Zero(t, index)
+
+	urls := []string{"http://a.example"}
+	addEvent := func(addIndex uint64) {
+		event := newEvent(
+			EventInvoiceAdded, fmt.Sprintf("%d", addIndex),
+			&InvoiceData{}, now,
+		)
+		update := &indexUpdate{
+			key:   invoiceAddIndexKey,
+			index: addIndex,
+		}
+		require.NoError(t, o.add(event, urls, now, update))
+	}
+
+	addEvent(5)
+	index, found, err = o.index(invoiceAddIndexKey)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.EqualValues(t, 5, index)
+
+	// Replayed events don't move the index back and aren't delivered
+	// again.
+	addEvent(3)
+	addEvent(5)
+	index, found, err = o.index(invoiceAddIndexKey)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.EqualValues(t, 5, index)
+
+	due, _, err := o.due(now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	// The settle index is tracked independently.
+	index, found, err = o.index(invoiceSettleIndexKey)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Zero(t, index)
+}
+
+// TestOutboxPayments tests that payments after the payment cursor are only
+// added once, and that the marks are removed once the cursor covers them.
+func TestOutboxPayments(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOutbox(t)
+	now := time.Unix(1000, 0)
+
+	urls := []string{"http://a.example"}
+	addPayment := func(seq uint64) {
+		event := newEvent(
+			EventPaymentSucceeded, fmt.Sprintf("%d", seq),
+			&PaymentData{}, now,
+		)
+		update := &paymentUpdate{seq: seq}
+		require.NoError(t, o.add(event, urls, now, update))
+	}
+
+	require.NoError(t, o.setPaymentIndex(2))
+
+	// Payments that are covered by the cursor are skipped, while the ones
+	// after it are only added once.
+	addPayment(1)
+	addPayment(4)
+	addPayment(4)
+	addPayment(5)
+
+	due, _, err := o.due(now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+
+	countMarks := func() int {
+		var numMarks int
+		err := kvdb.View(o.db, func(tx kvdb.RTx) error {
+			payments := tx.ReadBucket(outboxBucket).
+				NestedReadBucket(paymentsBucket)
+
+			return payments.ForEach(func(_, _ []byte) error {
+				numMarks++
+				return nil
+			})
+		}, func() {
+			numMarks = 0
+		})
+		require.NoError(t, err)
+
+		return numMarks
+	}
+	require.Equal(t, 2, countMarks())
+
+	// Moving the cursor removes the marks it covers.
+	require.NoError(t, o.setPaymentIndex(4))
+	require.Equal(t, 1, countMarks())
+
+	index, found, err := o.index(paymentIndexKey)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.EqualValues(t, 4, index)
+
+	addPayment(5)
+	due, _, err = o.due(now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+}